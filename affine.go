@@ -0,0 +1,119 @@
+package secp256k1
+
+// AffinePoint is an element of the group formed by the secp256k1 curve in
+// affine coordinates and thus represents a point on the curve.
+//
+// Since there is no point on the curve with both coordinates equal to zero, the
+// point at infinity is represented by (0, 0).
+type AffinePoint struct {
+	// The X coordinate in affine coordinates.
+	X FieldVal
+
+	// The Y coordinate in affine coordinates.
+	Y FieldVal
+}
+
+// MakeAffinePoint returns an affine point with the provided X and Y
+// coordinates.
+func MakeAffinePoint(x, y *FieldVal) AffinePoint {
+	var p AffinePoint
+	p.X.Set(x)
+	p.Y.Set(y)
+	return p
+}
+
+// Set sets the affine point to the provided point.
+func (p *AffinePoint) Set(other *AffinePoint) {
+	p.X.Set(&other.X)
+	p.Y.Set(&other.Y)
+}
+
+// SetJacobian sets the affine point to the provided Jacobian point in constant
+// time.  The point will be normalized.
+//
+// Use BatchToAffine to convert many points at once since it is significantly
+// faster than converting them individually.
+func (p *AffinePoint) SetJacobian(point *JacobianPoint) {
+	// The inverse of zero is zero, so this also results in (0, 0) for the
+	// point at infinity.
+	var zInv, tempZ FieldVal
+	zInv.Set(&point.Z).Inverse()           // zInv = Z^-1
+	tempZ.SquareVal(&zInv)                 // tempZ = Z^-2
+	p.X.Mul2(&point.X, &tempZ).Normalize() // X = X/Z^2
+	p.Y.Mul2(&point.Y, tempZ.Mul(&zInv))   // Y = Y/Z^3
+	p.Y.Normalize()
+}
+
+// ToJacobian converts the affine point into a Jacobian point with Z=1, or the
+// point at infinity when the affine point is the point at infinity, and stores
+// the result in the provided result param.
+func (p *AffinePoint) ToJacobian(result *JacobianPoint) {
+	result.X.Set(&p.X)
+	result.Y.Set(&p.Y)
+	result.Z.SetInt(1)
+	if p.IsInfinity() {
+		result.Z.Zero()
+	}
+}
+
+// IsInfinity returns whether or not the affine point is the point at infinity.
+//
+// NOTE: The point must be normalized for this function to return the correct
+// result.
+func (p *AffinePoint) IsInfinity() bool {
+	return p.X.IsZero() && p.Y.IsZero()
+}
+
+// IsOnCurve returns whether or not the affine point is on the secp256k1 curve.
+// The point at infinity is not considered to be on the curve.
+//
+// NOTE: The point must be normalized for this function to return the correct
+// result.
+func (p *AffinePoint) IsOnCurve() bool {
+	return isOnCurve(&p.X, &p.Y)
+}
+
+// Equals returns whether or not the passed affine point is the same as this
+// one.
+//
+// NOTE: The points must be normalized for this function to return the correct
+// result.
+func (p *AffinePoint) Equals(other *AffinePoint) bool {
+	return p.X.Equals(&other.X) && p.Y.Equals(&other.Y)
+}
+
+// Negate negates the affine point in constant time.  That is to say p = -p.
+// The negation of the point at infinity is the point at infinity.
+//
+// NOTE: The point must be normalized for this function to return the correct
+// result.  The resulting point will be normalized.
+func (p *AffinePoint) Negate() {
+	p.Y.Negate(1).Normalize()
+}
+
+// BatchToAffine converts all of the passed Jacobian points to affine
+// coordinates in constant time with a single field inversion and returns them.
+// The passed points are not modified.  The resulting points will be normalized.
+//
+// This is significantly faster than converting each point individually, which
+// requires a field inversion per point, and is therefore useful for things such
+// as serializing many public keys that were calculated in Jacobian coordinates.
+func BatchToAffine(points []JacobianPoint) []AffinePoint {
+	result := make([]AffinePoint, len(points))
+	zInvs := make([]FieldVal, len(points))
+	for i := range points {
+		zInvs[i].Set(&points[i].Z)
+	}
+	BatchInverse(zInvs)
+
+	// The inverse of zero is zero, so this also results in (0, 0) for points
+	// at infinity.
+	var zInv2 FieldVal
+	for i := range points {
+		p, zInv := &points[i], &zInvs[i]
+		zInv2.SquareVal(zInv)
+		result[i].X.Mul2(&p.X, &zInv2).Normalize()          // X = X/Z^2
+		result[i].Y.Mul2(&p.Y, zInv2.Mul(zInv)).Normalize() // Y = Y/Z^3
+	}
+	return result
+}
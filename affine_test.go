@@ -0,0 +1,129 @@
+package secp256k1
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+)
+
+// TestAffinePoint ensures converting between Jacobian and affine points and the
+// basic affine point operations work as expected for randomly-generated points
+// and the point at infinity.
+func TestAffinePoint(t *testing.T) {
+	// Use a unique random seed each test instance and log it if the tests fail.
+	seed := time.Now().Unix()
+	rng := rand.New(rand.NewSource(seed))
+	defer func(t *testing.T, seed int64) {
+		if t.Failed() {
+			t.Logf("random seed: %d", seed)
+		}
+	}(t, seed)
+
+	// Ensure the point at infinity converts as expected in both directions.
+	var infinity, jacobian JacobianPoint
+	var affine AffinePoint
+	affine.SetJacobian(&infinity)
+	if !affine.IsInfinity() || affine.IsOnCurve() {
+		t.Fatalf("unexpected affine point at infinity: %v", affine)
+	}
+	affine.ToJacobian(&jacobian)
+	if !jacobian.IsInfinity() {
+		t.Fatalf("unexpected Jacobian point at infinity: %v", jacobian)
+	}
+
+	for i := 0; i < 50; i++ {
+		var point JacobianPoint
+		ScalarBaseMultNonConst(randModNScalar(t, rng), &point)
+
+		// Ensure the point converts to the expected affine point and back.
+		var want JacobianPoint
+		want.Set(&point)
+		want.ToAffine()
+		affine.SetJacobian(&point)
+		if !affine.X.Equals(&want.X) || !affine.Y.Equals(&want.Y) {
+			t.Fatalf("mismatched affine point\ngot: %v\nwant: %v", affine, want)
+		}
+		if affine.IsInfinity() || !affine.IsOnCurve() {
+			t.Fatalf("affine point is not on the curve: %v", affine)
+		}
+		affine.ToJacobian(&jacobian)
+		if !jacobian.IsStrictlyEqual(&want) {
+			t.Fatalf("mismatched Jacobian point\ngot: %v\nwant: %v", jacobian,
+				want)
+		}
+
+		// Ensure negation produces a different point on the curve that is
+		// equal to the negated Jacobian point.
+		negAffine := MakeAffinePoint(&affine.X, &affine.Y)
+		if !negAffine.Equals(&affine) {
+			t.Fatalf("mismatched copy\ngot: %v\nwant: %v", negAffine, affine)
+		}
+		negAffine.Negate()
+		if negAffine.Equals(&affine) || !negAffine.IsOnCurve() {
+			t.Fatalf("unexpected negated point: %v", negAffine)
+		}
+		point.Negate()
+		var negWant AffinePoint
+		negWant.SetJacobian(&point)
+		if !negAffine.Equals(&negWant) {
+			t.Fatalf("mismatched negated point\ngot: %v\nwant: %v", negAffine,
+				negWant)
+		}
+	}
+}
+
+// TestBatchToAffine ensures converting several Jacobian points to affine
+// coordinates at once produces the same results as converting them
+// individually without modifying the original points.
+func TestBatchToAffine(t *testing.T) {
+	// Use a unique random seed each test instance and log it if the tests fail.
+	seed := time.Now().Unix()
+	rng := rand.New(rand.NewSource(seed))
+	defer func(t *testing.T, seed int64) {
+		if t.Failed() {
+			t.Logf("random seed: %d", seed)
+		}
+	}(t, seed)
+
+	for _, n := range []int{0, 1, 5, 8, 9, 100} {
+		// Generate random points with a mix of Z values along with a few
+		// representations of the point at infinity.
+		points := make([]JacobianPoint, n)
+		for i := range points {
+			switch rng.Intn(10) {
+			case 0:
+				continue
+			case 1:
+				ScalarBaseMultNonConst(randModNScalar(t, rng), &points[i])
+				points[i].Z.Zero()
+				continue
+			}
+			ScalarBaseMultNonConst(randModNScalar(t, rng), &points[i])
+			if rng.Intn(3) == 0 {
+				points[i].ToAffine()
+			}
+		}
+		orig := make([]JacobianPoint, n)
+		copy(orig, points)
+
+		affinePoints := BatchToAffine(points)
+		if len(affinePoints) != n {
+			t.Fatalf("n=%d: unexpected number of points: %d", n,
+				len(affinePoints))
+		}
+		for i := range points {
+			if !points[i].IsStrictlyEqual(&orig[i]) {
+				t.Fatalf("n=%d: point %d was modified", n, i)
+			}
+			var want AffinePoint
+			want.SetJacobian(&points[i])
+			if !affinePoints[i].Equals(&want) {
+				t.Fatalf("n=%d: mismatched point %d\ngot: %v\nwant: %v", n, i,
+					affinePoints[i], want)
+			}
+			if points[i].IsInfinity() != affinePoints[i].IsInfinity() {
+				t.Fatalf("n=%d: mismatched point at infinity %d", n, i)
+			}
+		}
+	}
+}
@@ -0,0 +1,158 @@
+package secp256k1
+
+import (
+	"sort"
+
+	"github.com/KarpelesLab/secp256k1/internal/batch"
+)
+
+// BatchItem houses an ECDSA signature along with the hash and public key it is
+// to be verified against as a part of a batch.
+type BatchItem struct {
+	Signature *Signature
+	Hash      []byte
+	PubKey    *PublicKey
+}
+
+// batchEntry houses the values needed to verify a batch item that has a
+// recovery code as a part of the batch equation.
+type batchEntry struct {
+	// index is the index of the item in the batch.
+	index int
+
+	// e is the hash of the item reduced modulo the group order, q is the
+	// public key, and rPoint is the random point of the signature recovered
+	// from its R component and recovery code.
+	e         ModNScalar
+	q, rPoint JacobianPoint
+
+	// coeff is the random coefficient the equation for the item is multiplied
+	// by in the batch equation.
+	coeff ModNScalar
+}
+
+// batchCoefficients sets the random coefficients of the passed entries to
+// values derived from all of the items in the batch.  See batch.Coefficients
+// for details.
+func batchCoefficients(items []BatchItem, entries []batchEntry) {
+	coeffs := batch.NewCoefficients()
+	var buf [32]byte
+	var pubKeyBytes [PubKeyBytesLenUncompressed]byte
+	for i := range entries {
+		entry := &entries[i]
+		sig := items[entry.index].Signature
+		sig.r.PutBytes(&buf)
+		coeffs.Write(buf[:])
+		sig.s.PutBytes(&buf)
+		coeffs.Write(buf[:])
+		coeffs.Write([]byte{sig.v})
+		entry.e.PutBytes(&buf)
+		coeffs.Write(buf[:])
+		items[entry.index].PubKey.PutUncompressed(&pubKeyBytes)
+		coeffs.Write(pubKeyBytes[:])
+	}
+	for i := range entries {
+		coeff := coeffs.Coefficient(i)
+		entries[i].coeff.SetByteSlice(coeff[:])
+	}
+}
+
+// batchEquationHolds returns whether or not the batch equation holds for the
+// passed entries.
+//
+// Each individual signature (R, S) with recovered random point R' is valid
+// when S*R' = e*G + R*Q.  Rather than checking that for each signature, this
+// checks the random linear combination of them:
+//
+// (∑ a_i*e_i)*G + ∑ (a_i*R_i)*Q_i - ∑ (a_i*S_i)*R'_i = ∞
+//
+// via a single multi-scalar multiplication, where a_i are the random
+// coefficients.  It only holds for invalid signatures with negligible
+// probability.
+func batchEquationHolds(items []BatchItem, entries []batchEntry) bool {
+	var sumE, tmp ModNScalar
+	scalars := make([]ModNScalar, 0, 2*len(entries))
+	points := make([]JacobianPoint, 0, 2*len(entries))
+	for i := range entries {
+		entry := &entries[i]
+		sig := items[entry.index].Signature
+		sumE.Add(tmp.Mul2(&entry.coeff, &entry.e))
+		scalars = append(scalars, *tmp.Mul2(&entry.coeff, &sig.r))
+		points = append(points, entry.q)
+		scalars = append(scalars, *tmp.Mul2(&entry.coeff, &sig.s).Negate())
+		points = append(points, entry.rPoint)
+	}
+
+	var result, sumEG JacobianPoint
+	MultiScalarMultNonConst(scalars, points, &result)
+	ScalarBaseMultNonConst(&sumE, &sumEG)
+	AddNonConst(&result, &sumEG, &result)
+	return result.IsInfinity()
+}
+
+// VerifyBatch verifies all of the passed ECDSA signatures against their
+// associated hashes and public keys and returns whether or not they are all
+// valid along with the indices of the items that are not valid in ascending
+// order.
+//
+// The signatures that include a recovery code, such as those produced by Sign
+// or parsed from the compact format, allow the random point of the signature
+// to be recovered, which in turn allows them to be verified together via a
+// single random linear combination multi-scalar multiplication.  When the
+// batch contains invalid signatures, it is bisected in order to identify them.
+//
+// The benefit grows with the size of the batch.  Per BenchmarkVerifyBatch, it
+// is only marginally faster than verifying the signatures individually for
+// batches of 8 signatures, while it is about 1.4 times as fast for batches of
+// 64 and about 1.8 times as fast for batches of 256.
+//
+// The signatures that do not include a recovery code, such as those parsed from
+// the DER format, are verified individually.
+//
+// The result for every item is the same as calling Verify on it, including for
+// otherwise valid signatures with an incorrect recovery code.  Items with a nil
+// signature or public key or with a public key that is not on the curve are
+// not valid.
+func VerifyBatch(items []BatchItem) (bool, []int) {
+	var failed []int
+	entries := make([]batchEntry, 0, len(items))
+	for i := range items {
+		item := &items[i]
+		sig, pubKey := item.Signature, item.PubKey
+		if sig == nil || pubKey == nil || sig.r.IsZero() || sig.s.IsZero() ||
+			!pubKey.IsOnCurve() {
+
+			failed = append(failed, i)
+			continue
+		}
+
+		// Verify the signature individually when it does not have a recovery
+		// code or the random point can't be recovered from it.
+		var rPoint JacobianPoint
+		if sig.v == 0xff || sig.recoverRPoint(&rPoint) != nil {
+			if !sig.Verify(item.Hash, pubKey) {
+				failed = append(failed, i)
+			}
+			continue
+		}
+
+		entries = append(entries, batchEntry{index: i, rPoint: rPoint})
+		entry := &entries[len(entries)-1]
+		bits2int(item.Hash, &entry.e)
+		pubKey.AsJacobian(&entry.q)
+	}
+
+	// Verify the entries as a batch and bisect it to identify the invalid
+	// items when the batch equation does not hold.
+	batchCoefficients(items, entries)
+	holds := func(entries []batchEntry) bool {
+		return batchEquationHolds(items, entries)
+	}
+	verify := func(entry *batchEntry) (int, bool) {
+		item := &items[entry.index]
+		return entry.index, item.Signature.Verify(item.Hash, item.PubKey)
+	}
+	failed = batch.Bisect(entries, holds, verify, failed)
+	sort.Ints(failed)
+	return len(failed) == 0, failed
+}
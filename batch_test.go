@@ -0,0 +1,216 @@
+package secp256k1
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// randBatchItems returns the requested number of batch items with valid
+// signatures over random hashes by random private keys.
+func randBatchItems(t testing.TB, rng *rand.Rand, numItems int) []BatchItem {
+	t.Helper()
+
+	items := make([]BatchItem, numItems)
+	for i := range items {
+		var buf [32]byte
+		if _, err := rng.Read(buf[:]); err != nil {
+			t.Fatalf("failed to read random private key: %v", err)
+		}
+		var privKeyScalar ModNScalar
+		privKeyScalar.SetBytes(&buf)
+		privKey := NewPrivateKey(&privKeyScalar)
+
+		hash := make([]byte, 32)
+		if _, err := rng.Read(hash); err != nil {
+			t.Fatalf("failed to read random hash: %v", err)
+		}
+
+		items[i] = BatchItem{
+			Signature: Sign(privKey, hash),
+			Hash:      hash,
+			PubKey:    privKey.PubKey(),
+		}
+	}
+	return items
+}
+
+// offCurvePubKey returns a public key that is not on the curve and has the same
+// x coordinate and oddness of the y coordinate as the passed public key.
+func offCurvePubKey(pubKey *PublicKey) *PublicKey {
+	y := new(FieldVal).Set(&pubKey.y).Add(new(FieldVal).SetInt(2))
+	y.Normalize()
+	return NewPublicKey(&pubKey.x, y)
+}
+
+// TestVerifyBatch ensures that batch verification reports the same results as
+// verifying each item individually, including the indices of the items that
+// are not valid, for batches of random signatures with and without recovery
+// codes along with various types of invalid items.
+func TestVerifyBatch(t *testing.T) {
+	t.Parallel()
+
+	// Use a unique random seed each test instance and log it if the tests fail.
+	seed := time.Now().Unix()
+	rng := rand.New(rand.NewSource(seed))
+	defer func(t *testing.T, seed int64) {
+		if t.Failed() {
+			t.Logf("random seed: %d", seed)
+		}
+	}(t, seed)
+
+	// corruptSig returns a copy of the passed signature with a random bit of
+	// its S component flipped while keeping its recovery code.
+	corruptSig := func(sig *Signature) *Signature {
+		badSig := *sig
+		sBytes := badSig.s.Bytes()
+		sBytes[rng.Intn(32)] ^= 1 << rng.Intn(7)
+		badSig.s.SetBytes(&sBytes)
+		return &badSig
+	}
+
+	tests := []struct {
+		name   string                        // test description
+		size   int                           // number of items in the batch
+		modify func(items []BatchItem) []int // modifies the items
+	}{{
+		name: "empty batch",
+		size: 0,
+	}, {
+		name: "single valid item",
+		size: 1,
+	}, {
+		name: "all valid with recovery codes",
+		size: 33,
+	}, {
+		name: "all valid without recovery codes",
+		size: 17,
+		modify: func(items []BatchItem) []int {
+			for i := range items {
+				sig := items[i].Signature
+				items[i].Signature = NewSignature(&sig.r, &sig.s)
+			}
+			return nil
+		},
+	}, {
+		name: "mix of recovery codes and no recovery codes",
+		size: 20,
+		modify: func(items []BatchItem) []int {
+			for i := 0; i < len(items); i += 3 {
+				sig := items[i].Signature
+				items[i].Signature = NewSignature(&sig.r, &sig.s)
+			}
+			return nil
+		},
+	}, {
+		name: "valid signatures with incorrect recovery codes",
+		size: 16,
+		modify: func(items []BatchItem) []int {
+			for _, i := range []int{2, 3, 11} {
+				badCode := *items[i].Signature
+				badCode.v ^= pubKeyRecoveryCodeOddnessBit
+				items[i].Signature = &badCode
+			}
+			badCode := *items[7].Signature
+			badCode.v |= pubKeyRecoveryCodeOverflowBit
+			items[7].Signature = &badCode
+			return nil
+		},
+	}, {
+		name: "single invalid item",
+		size: 1,
+		modify: func(items []BatchItem) []int {
+			items[0].Signature = corruptSig(items[0].Signature)
+			return []int{0}
+		},
+	}, {
+		name: "invalid items with recovery codes",
+		size: 32,
+		modify: func(items []BatchItem) []int {
+			failed := []int{0, 5, 6, 19, 31}
+			for _, i := range failed {
+				items[i].Signature = corruptSig(items[i].Signature)
+			}
+			return failed
+		},
+	}, {
+		name: "invalid items without recovery codes",
+		size: 8,
+		modify: func(items []BatchItem) []int {
+			sig := corruptSig(items[4].Signature)
+			items[4].Signature = NewSignature(&sig.r, &sig.s)
+			return []int{4}
+		},
+	}, {
+		name: "wrong hashes and public keys",
+		size: 12,
+		modify: func(items []BatchItem) []int {
+			items[1].Hash = items[2].Hash
+			items[9].PubKey = items[10].PubKey
+			return []int{1, 9}
+		},
+	}, {
+		name: "all invalid",
+		size: 9,
+		modify: func(items []BatchItem) []int {
+			failed := make([]int, len(items))
+			for i := range items {
+				items[i].Signature = corruptSig(items[i].Signature)
+				failed[i] = i
+			}
+			return failed
+		},
+	}, {
+		name: "nil signature and public key",
+		size: 6,
+		modify: func(items []BatchItem) []int {
+			items[3].Signature = nil
+			items[5].PubKey = nil
+			return []int{3, 5}
+		},
+	}, {
+		name: "public keys not on the curve",
+		size: 10,
+		modify: func(items []BatchItem) []int {
+			sig := items[6].Signature
+			items[6].Signature = NewSignature(&sig.r, &sig.s)
+			for _, i := range []int{2, 6} {
+				items[i].PubKey = offCurvePubKey(items[i].PubKey)
+			}
+			return []int{2, 6}
+		},
+	}, {
+		name: "zero R and S",
+		size: 6,
+		modify: func(items []BatchItem) []int {
+			zeroR := *items[0].Signature
+			zeroR.r.Zero()
+			items[0].Signature = &zeroR
+			zeroS := *items[2].Signature
+			zeroS.s.Zero()
+			items[2].Signature = &zeroS
+			return []int{0, 2}
+		},
+	}}
+
+	for _, test := range tests {
+		items := randBatchItems(t, rng, test.size)
+		var wantFailed []int
+		if test.modify != nil {
+			wantFailed = test.modify(items)
+		}
+
+		valid, failed := VerifyBatch(items)
+		if valid != (len(wantFailed) == 0) {
+			t.Errorf("%q: unexpected result -- got %v, want %v", test.name,
+				valid, len(wantFailed) == 0)
+			continue
+		}
+		if !reflect.DeepEqual(failed, wantFailed) {
+			t.Errorf("%q: unexpected failed indices -- got %v, want %v",
+				test.name, failed, wantFailed)
+			continue
+		}
+	}
+}
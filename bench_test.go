@@ -6,6 +6,8 @@
 package secp256k1
 
 import (
+	"fmt"
+	mrand "math/rand"
 	"testing"
 )
 
@@ -65,6 +67,64 @@ func BenchmarkScalarBaseMultNonConst(b *testing.B) {
 	}
 }
 
+// BenchmarkScalarBaseMult benchmarks multiplying a scalar by the base point of
+// the curve in constant time.
+func BenchmarkScalarBaseMult(b *testing.B) {
+	k := hexToModNScalar("d74bf844b0862475103d96a611cf2d898447e288d34b360bc885cb8ce7c00575")
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	var result JacobianPoint
+	for i := 0; i < b.N; i++ {
+		ScalarBaseMult(k, &result)
+	}
+}
+
+// BenchmarkContextScalarBaseMult benchmarks multiplying a scalar by the base
+// point of the curve in constant time with the blinding provided by a context.
+func BenchmarkContextScalarBaseMult(b *testing.B) {
+	k := hexToModNScalar("d74bf844b0862475103d96a611cf2d898447e288d34b360bc885cb8ce7c00575")
+	ctx, err := NewContext()
+	if err != nil {
+		b.Fatalf("failed to create context: %v", err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	var result JacobianPoint
+	for i := 0; i < b.N; i++ {
+		ctx.ScalarBaseMult(k, &result)
+	}
+}
+
+// BenchmarkDecompressBasePoints benchmarks decompressing the embedded
+// pre-computed table used to accelerate scalar base multiplication, which is
+// the one-time cost of loading it on first use.
+func BenchmarkDecompressBasePoints(b *testing.B) {
+	if compressedBytePointsFn == nil {
+		b.Skip("no embedded table due to the secp256k1_precomp_runtime tag")
+	}
+	bp := compressedBytePointsFn()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		decompressBasePoints(bp)
+	}
+}
+
+// BenchmarkComputeBasePoints benchmarks computing the pre-computed table used
+// to accelerate scalar base multiplication from scratch, which is the one-time
+// cost of loading it on first use when the secp256k1_precomp_runtime build tag
+// is specified.
+func BenchmarkComputeBasePoints(b *testing.B) {
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		computeBasePoints()
+	}
+}
+
 // BenchmarkSplitK benchmarks decomposing scalars into a balanced length-two
 // representation.
 func BenchmarkSplitK(b *testing.B) {
@@ -128,6 +188,142 @@ func BenchmarkScalarMultNonConst(b *testing.B) {
 	}
 }
 
+// BenchmarkScalarMult benchmarks multiplying a scalar by an arbitrary point on
+// the curve in constant time.
+func BenchmarkScalarMult(b *testing.B) {
+	k := hexToModNScalar("d74bf844b0862475103d96a611cf2d898447e288d34b360bc885cb8ce7c00575")
+	point := jacobianPointFromHex(
+		"34f9460f0e4f08393d192b3c5133a6ba099aa0ad9fd54ebccfacdfa239ff49c6",
+		"0b71ea9bd730fd8923f6d25a7a91e7dd7728a960686cb5a901bb419e0f2ca232",
+		"1",
+	)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	var result JacobianPoint
+	for i := 0; i < b.N; i++ {
+		ScalarMult(k, &point, &result)
+	}
+}
+
+// BenchmarkDoubleScalarMultNonConst benchmarks multiplying the base point and
+// an arbitrary point on the curve by two scalars and adding the results as is
+// done during signature verification.
+func BenchmarkDoubleScalarMultNonConst(b *testing.B) {
+	u1 := hexToModNScalar("d74bf844b0862475103d96a611cf2d898447e288d34b360bc885cb8ce7c00575")
+	u2 := hexToModNScalar("6df2b5d30854069ccdec40ae022f5c948936324a4e9ebed8eb82cfd5a6b6d766")
+	point := jacobianPointFromHex(
+		"34f9460f0e4f08393d192b3c5133a6ba099aa0ad9fd54ebccfacdfa239ff49c6",
+		"0b71ea9bd730fd8923f6d25a7a91e7dd7728a960686cb5a901bb419e0f2ca232",
+		"1",
+	)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	var result JacobianPoint
+	for i := 0; i < b.N; i++ {
+		DoubleScalarMultNonConst(u1, u2, &point, &result)
+	}
+}
+
+// randMSMBenchInputs returns the requested number of deterministic random
+// scalars and affine points for use in benchmarking multi-scalar
+// multiplication.
+func randMSMBenchInputs(rng *mrand.Rand, n int) ([]ModNScalar, []JacobianPoint) {
+	scalars := make([]ModNScalar, n)
+	points := make([]JacobianPoint, n)
+	for i := 0; i < n; i++ {
+		var buf [32]byte
+		rng.Read(buf[:])
+		scalars[i].SetBytes(&buf)
+		rng.Read(buf[:])
+		var k ModNScalar
+		k.SetBytes(&buf)
+		ScalarBaseMultNonConst(&k, &points[i])
+		points[i].ToAffine()
+	}
+	return scalars, points
+}
+
+// BenchmarkMultiScalarMultNonConst benchmarks calculating the sum of the
+// products of various numbers of scalars and points.
+func BenchmarkMultiScalarMultNonConst(b *testing.B) {
+	rng := mrand.New(mrand.NewSource(1))
+	for _, n := range []int{2, 16, 64, 256, 1024} {
+		scalars, points := randMSMBenchInputs(rng, n)
+		b.Run(fmt.Sprintf("%d points", n), func(b *testing.B) {
+			b.ReportAllocs()
+			b.ResetTimer()
+			var result JacobianPoint
+			for i := 0; i < b.N; i++ {
+				MultiScalarMultNonConst(scalars, points, &result)
+			}
+		})
+	}
+}
+
+// BenchmarkMultiScalarMultIndividual benchmarks calculating the sum of the
+// products of various numbers of scalars and points by multiplying each point
+// by its scalar individually and adding the results for comparison with
+// BenchmarkMultiScalarMultNonConst.
+func BenchmarkMultiScalarMultIndividual(b *testing.B) {
+	rng := mrand.New(mrand.NewSource(1))
+	for _, n := range []int{2, 16, 64, 256, 1024} {
+		scalars, points := randMSMBenchInputs(rng, n)
+		b.Run(fmt.Sprintf("%d points", n), func(b *testing.B) {
+			b.ReportAllocs()
+			b.ResetTimer()
+			var product, result JacobianPoint
+			for i := 0; i < b.N; i++ {
+				result = JacobianPoint{}
+				for j := range points {
+					ScalarMultNonConst(&scalars[j], &points[j], &product)
+					AddNonConst(&result, &product, &result)
+				}
+			}
+		})
+	}
+}
+
+// BenchmarkBatchToAffine benchmarks converting many points to affine
+// coordinates at once with a single field inversion.
+func BenchmarkBatchToAffine(b *testing.B) {
+	const numPoints = 1000
+	points := make([]JacobianPoint, numPoints)
+	var k ModNScalar
+	for i := range points {
+		k.SetInt(uint32(i + 1))
+		ScalarBaseMultNonConst(&k, &points[i])
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = BatchToAffine(points)
+	}
+}
+
+// BenchmarkToAffineIndividually benchmarks converting many points to affine
+// coordinates one at a time for comparison with BatchToAffine.
+func BenchmarkToAffineIndividually(b *testing.B) {
+	const numPoints = 1000
+	points := make([]JacobianPoint, numPoints)
+	var k ModNScalar
+	for i := range points {
+		k.SetInt(uint32(i + 1))
+		ScalarBaseMultNonConst(&k, &points[i])
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		result := make([]AffinePoint, numPoints)
+		for j := range points {
+			result[j].SetJacobian(&points[j])
+		}
+	}
+}
+
 // BenchmarkNAF benchmarks conversion of a positive integer into its
 // non-adjacent form representation.
 func BenchmarkNAF(b *testing.B) {
@@ -214,6 +410,51 @@ func BenchmarkSigVerify(b *testing.B) {
 	}
 }
 
+// BenchmarkSigVerifyPrecomputed benchmarks how long it takes the secp256k1
+// curve to verify signatures with a precomputed public key.
+func BenchmarkSigVerifyPrecomputed(b *testing.B) {
+	// Randomly generated keypair.
+	// Private key: 9e0699c91ca1e3b7e3c9ba71eb71c89890872be97576010fe593fbf3fd57e66d
+	pubKey := NewPublicKey(
+		hexToFieldVal("d2e670a19c6d753d1a6d8b20bd045df8a08fb162cf508956c31268c6d81ffdab"),
+		hexToFieldVal("ab65528eefbb8057aa85d597258a3fbd481a24633bc9b47a9aa045c91371de52"),
+	)
+	precomputed := NewPrecomputedPublicKey(pubKey)
+
+	// Double sha256 of []byte{0x01, 0x02, 0x03, 0x04}
+	msgHash := hexToBytes("8de472e2399610baaa7f84840547cd409434e31f5d3bd71e4d947f283874f9c0")
+	sig := NewSignature(
+		hexToModNScalar("fef45d2892953aa5bbcdb057b5e98b208f1617a7498af7eb765574e29b5d9c2c"),
+		hexToModNScalar("d47563f52aac6b04b55de236b7c515eb9311757db01e02cff079c3ca6efb063f"),
+	)
+
+	if !precomputed.Verify(sig, msgHash) {
+		b.Errorf("Signature failed to verify")
+		return
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		precomputed.Verify(sig, msgHash)
+	}
+}
+
+// BenchmarkNewPrecomputedPublicKey benchmarks how long it takes to create a
+// precomputed public key.
+func BenchmarkNewPrecomputedPublicKey(b *testing.B) {
+	pubKey := NewPublicKey(
+		hexToFieldVal("d2e670a19c6d753d1a6d8b20bd045df8a08fb162cf508956c31268c6d81ffdab"),
+		hexToFieldVal("ab65528eefbb8057aa85d597258a3fbd481a24633bc9b47a9aa045c91371de52"),
+	)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		NewPrecomputedPublicKey(pubKey)
+	}
+}
+
 // BenchmarkSign benchmarks how long it takes to sign a message.
 func BenchmarkSign(b *testing.B) {
 	// Randomly generated keypair.
@@ -226,7 +467,41 @@ func BenchmarkSign(b *testing.B) {
 	b.ReportAllocs()
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
-		signRFC6979(privKey, msgHash)
+		signRFC6979(privKey, msgHash, 0, nil, false, nil)
+	}
+}
+
+// BenchmarkSignWithPresignature benchmarks how long it takes to sign a message
+// with a presignature that was generated ahead of time.
+func BenchmarkSignWithPresignature(b *testing.B) {
+	// Randomly generated keypair.
+	d := hexToModNScalar("9e0699c91ca1e3b7e3c9ba71eb71c89890872be97576010fe593fbf3fd57e66d")
+	privKey := NewPrivateKey(d)
+
+	// blake256 of []byte{0x01, 0x02, 0x03, 0x04}.
+	msgHash := hexToBytes("c301ba9de5d6053caad9f5eb46523f007702add2c62fa39de03146a36b8026b7")
+
+	presigs, err := GeneratePresignatures(b.N)
+	if err != nil {
+		b.Fatalf("unexpected err: %v", err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		SignWithPresignature(privKey, msgHash, presigs[i])
+	}
+}
+
+// BenchmarkGeneratePresignatures benchmarks how long it takes per presignature
+// to generate presignatures in batches of 100.
+func BenchmarkGeneratePresignatures(b *testing.B) {
+	const batchSize = 100
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i += batchSize {
+		GeneratePresignatures(batchSize)
 	}
 }
 
@@ -320,3 +595,51 @@ func BenchmarkRecoverCompact(b *testing.B) {
 		_, _, _ = RecoverCompact(compactSig, msgHash)
 	}
 }
+
+// BenchmarkVerifyBatch benchmarks how long it takes to verify a batch of
+// signatures with recovery codes as compared to verifying them individually.
+func BenchmarkVerifyBatch(b *testing.B) {
+	rng := mrand.New(mrand.NewSource(1))
+	for _, numItems := range []int{8, 64, 256} {
+		items := randBatchItems(b, rng, numItems)
+		if valid, _ := VerifyBatch(items); !valid {
+			b.Fatal("batch failed to verify")
+		}
+
+		b.Run(fmt.Sprintf("batch/%d", numItems), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				VerifyBatch(items)
+			}
+		})
+		b.Run(fmt.Sprintf("individual/%d", numItems), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				for j := range items {
+					item := &items[j]
+					item.Signature.Verify(item.Hash, item.PubKey)
+				}
+			}
+		})
+	}
+}
+
+// BenchmarkSigVerifyCached benchmarks how long it takes to verify a signature
+// that is already in a signature cache.
+func BenchmarkSigVerifyCached(b *testing.B) {
+	items := randBatchItems(b, mrand.New(mrand.NewSource(1)), 1)
+	item := &items[0]
+	cache, err := NewSigCache(1)
+	if err != nil {
+		b.Fatalf("failed to create signature cache: %v", err)
+	}
+	if !item.Signature.VerifyCached(item.Hash, item.PubKey, cache) {
+		b.Fatal("signature failed to verify")
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		item.Signature.VerifyCached(item.Hash, item.PubKey, cache)
+	}
+}
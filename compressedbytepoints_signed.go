@@ -0,0 +1,20 @@
+// Copyright (c) 2015 The btcsuite developers
+// Copyright (c) 2015-2022 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+//go:build !secp256k1_precomp_runtime && !secp256k1_precomp_w4 && secp256k1_precomp_signed
+
+package secp256k1
+
+// Auto-generated file (see genprecomps.go)
+// DO NOT EDIT
+
+var compressedBytePoints = "eJwA//8AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAACMKKl7+CmLwNI9jHSUUqMuaUtl4wqUcqOVSrMP5TJMqkCjBGOjMFGTN4/t8x98wOt654TwRRy5RZ5x3HPL75SCqxrBhyo4ovGWvtWmBH8NosgTD+jeSfxNXfsgH3YR2OIT9KN6Mk0XoemqXznbakK29++T0z4eVF8BpYHzxCnRW5cpJHAywN/PRbSEH81y9umiQiYx/DRmz4Y+hxVHVN1AkdGiRCZf6h3NFcddy9TfNpDa6FJVrK9JOEtJLyqjYUMlZP6bW+74LTcDpgclPzHvjqGzZXct9DQiau5kJlGz+orZ96YGeDiQlfoUrhIDkl8U832ra3mBbtuC5qMB5RItiWN/l1gKeW4FB5GtWi8nrxgDZF2V3wIaPC2C64wsp/8tH+EkjIiEJNV7nPFUNXSJ+HvGo45C6re+1BXhcEk+aHHvpOJqQXnhEoYLiPyYZYpL28WcerbU+AV8NTMMeonuFF+oH4u2JK6e+ywysXKUoiqq+riOXpoLT0iTKcE2ai4wgTjnG+JeCS/cnaA9U1dCG8coA1ahOBphhtY6DKjdfyjR4tKIKPySXjnsRdFAjhjIFlZGQ0rZFeQV8keKksfy/z1hNv+sWwy/xsXAww3AGn6j1WwgvTEDsXjj064YAGgTMjm+hOQADkDQNyzdlq3BVHZ28kAB9eZwprtuGIxgd1dfxOgqbetl0eV1DIW2hi9uwAkoGZLiBsDcxWiGaj+xb27bkEKm/KLWcdvCl46H2u0ztXPGo68vCbjpCpAmVa0/qRVIC6uK0lMeNCukNVXn30XhdYOZitSVTn/cvSElCr+bZpBUmbAwz1vflgO7EnwuReVaAytft5HIbAL6p2cxpeyQNrZOq3oifyb4Huoqj9olO7vOIBApIbaopHkBF9+3ncZiXsFAQAxZeYOtH6D3HIRh8FYUo2PYEoEz+GRi/lIEW8xY4HEko3XqAEs1CKyA5iXaIQbHT1ywI0mN4FRfGz8x/OvjEjrUMO2cIM4xK3k7nQ8qj9ryNuo0wFBsPZGhU9/pEzELCUneeXYUY0m5WjmMsN4QRykLD5dcFyrXEv2U2EE/sFsvxIMY1fHxuJq9BT8oWvCzKcil9lONSP3SWLVDbr5HL9uAFiyNI3YDY13ke5Xl5bOKifE9DDIgR5+F17awVc1nLE4pE6JS9t6Dlq8nxIKbs0FEpzdeVn2xeppUGsaveUYVk1w00IjtyCTEQzqDvbWngQMMNwERz1s6tmFIwcsQarfK/hrzaI9HX1VI/uJSHqUtY/VXXzakSuQI6pZmE5UnqMHdlM5PBx/SPIs1DFpLszdIxLoRH6zK4GIO+rvI7ieC4k58DPuVxdc1t4O+nPD46VWvNKMOYrlFEAR8/0TUpBUH2+ECOYG8kXwjQ6S2z/5HcpORTBqLimJgKsCUqJ015edYT5secz29Sqtzq4/fDNB8mRNMN35IhS9CYTUAsmFYi/hq5MS1Y7oPeIy/PRjvlqjZWYdKVnKdcY4BhmL8849QY1M93WV0Zz5aqmsY1ZAGho0Il7VDllC/6OMvcHSWQ0vmTMFZAcLgTwj+Z0oUuWwz+9rnDBQjkhWtRPMw7UFtUnuz3p4PvuiQIlzTLuJ9KBbzK+VemmsZB4VCQcwYnM5ExpmLoCY8zZvlyyv2XeY0wHQRsGqVsGcvTun+rnC0zC8Fy6ATdToYRGHQPqyTB1bve5X2TFJyJnSljaEE6plnfNJ1LvLyaSYSE3k7RpjkTSWRVd0TxI7vmd4Blh/qORx+PbDx0HVzofifOx3odeW8MWifw7Pru+poqXVQDv6jDRL/qdjJSpO/P69rijuc+jx20o7wOpLVPmgvi/TPqaL15yFqA1e+ERRGWtgjAUPpMyz1O6sQ+lPsABrrNS9Eu6eSiud2eRfRLeVQEf6BqkLoMHiO6M6PmjXdy+xRLAsLOHDtEynwWF/5FwJMnsbooPkmjJqEL9sCYgTpmRuS+oxOTo7+RWaWYHMxnoDTpU1LerYc/MR92qXVYvwV3d4rJ/HZ5q0djAlXMDzgn24aVfVw3X4AP+y6HJFTUpLohEESFs8ib9C6HIP28bqQ4K0DNTOszF71yW6t+qku2JA5mRNcgva3VOe0c/5uXx8wDaNP6FgWzgVC5zNqFRsdDcUGJfaA+ME7WFetLtw1xXHUyyyUts2wbM68zqMLYrikqTP7qV+fEWWLJr6M/aD21weokn+ZbRk711yxs/C2yf4uOL6yu1azzzxpPOJWXEdVkAmqlMcKeT3JhoVhRMb8LSBd4t9CXTV1J0b//ax8kEKzAJeA63XvK6JQ33MIQPtUfUWUTtB3hqP8kQkEXFI/TKZiL1o7lmPVw/KwvOD38g5uBbLm/MIZLI5BXkyRy0Y4OsTWZrHFN0MVI8UwCmBbFlFtP1gOAZ85R9aGugqvCXJD906a2ZiA1wsFYfk1G7OEP9yVaPo9TZqRVHRkkQ041W9b2QFl07hLtyCexhkjeKKnyVmsblfmERnedmxbzzoV913AoOrJnP5GjOTUxovSZzoGRx+3uPY1x/4SF4fOJYEQBiI5yZ62qgMwUykoDzv5OtuuP7HMwOrA1/SUzNg+XSq6DxE4RtADFUYWkpqb6UjBp7GjqiODZd+LYg4LlnvBhPUEOmKQoF58eMmTwl9jcXYiDNnzpm3zFVWdgmPPKiOkq1q5opMTHaGQtjJTFU/t6U0oc5iQSZA4CdeYCp8E/54Ceh1u6/PW/JWQz8Sj9sr5IUyhNEkGgib5NUpzYcgprNuiJJP6GlPEAUdP+IGyNSeXPswBdmwz01g40tkvXE3AiqOo7o3m0HtWYp1FCBb0iSfAP182VFNEVPULAFf1/5y0KeTwD+opgUt6Jk9Ww1UfYbBkC34ZXQYXpWpAu6z3hAvJ/esrzvUd8896uxBS04r7VPiS089rA7T3VFKTxlZ/8jxh+v9uz6GeO20UFZTS5E1LfgCJcY6HhQGMjk2YEScCYkYC/Yf1d3ic/YiXq4Fk6ooQN8ZYA9b/tOLJtvG34g1B6Ew/Qb/44zt/xpZThgU2x9h5Vks+n2vuTvfbH2yWkazcvtBxAxqn08+XWUoPzHsTLPgj0gPV+bHMyWtysfIy4E6WyBzgPg0LL/6ot/tsFC7eLdlJTfto0Z491OITXweEAELOatJhY57A8Jf4dKdbTHjD1vOhxvweHbHf7SJ9gWHudc8TlLK8RVzNVBawHJEQsfS8544Nxq77qr14eWPm6dZQrhsLEEFYgTyEpCT0SZOfyWUbiw0WW6ByWavw4Ui73SUEoRZag38vYe6nrnE/jZEu8fINioFpNvLKeM760R/OIAlPUlQ9sdopNN5Gptkh+HIFZ+a0bmNiNqftU0g7E+0glYRSMiWszfpoaWfZbYMoVAGzqDrAONW3SOmG6LOLYlEyT6cZXSLOp9fFlAwOWXX3uRnjuFFBy+fOdF/hm2F7oraNZPA5HAkdVnrQfodIR9RC+wY5H6lbeS0w5IQ1ZhPSnNOKD4BLCGk2eyklPhKPQm7kTsPZI0TiERH2+1z27Ozaq2xrDoQaR4j2vIM1YguYh6i19kvZJUMrlPTizWWC4EYkTI05xf4E768x1ODABsGXzT5CY0DkWUkTOPo2kZrEcppSw28aFkOH4KZbpsydhc4YzUJ2a0pNmvcfjxNEEqivoYXE4BUmHOSPS1Mle+AcyyN5hsG5Z3qeaY+5YrEI1rL73INnJ9iLmj+esLVfq2PNub3CC3kzxWafPO37LOL+vbWNn+H7/Iig/I07opqTrQfbrTem1Lh/LiZysV0zHR9r9PLJEZ/+MezCOC/OjH+a+qhxvdbLWsTpE2t25xi/6ujOm0k5hItbF436pJ6VkDPMK6iwbXi4uSQkljKaV0644rT61PiLb8J6jmsLTw9S78p4+SlmgRjrf1funOUBdNZ/JRiWvA187Isc8iPcPNjrbXxfsRc16YPCNLaScaPeyti7+yuZeZSnQVmhZQ2fjeM6/SVypJq75uJewQj1dm7eXUPmRM1SBzg7JPvqFCU6w3sNQhJjtxajQZJRbqCDcCGkC1lmoG9eVM9wasTtuiBEz1ZtVOpaGej2rnS7jCsECJ9HhtPG53L3KkIWmgR1xKNC+NqXocC86DAYPv7NCj2BY3sF18DYGrRo9QmxYu2CWw8Lg0phoO1FItJo0jEL+szbG0Z0zre18NLdkcS8tjBhbqnjsulex/b0Mdgb1ie2LQSsgbka+MdsR7GKy8v0wDB5UFOhI/Gqxe1l/oQot4LHJSXng9JTAdADL3PYObasg1M3PuaWAAiu/vQKkgFWjOL/anFsyy1d7v43mKGFfIlD3suuJTf+MCNrQJ+dzA+I2csoqibeCgddw77W5h4KKxDPIcbQk0R5pa04Zhlqk7bh1ZEOkj6paAgk8yC3TEX5RF+79FBnTTdCSAHNR3yPJ+MHqn6bmyNQNB+evj4q9vWVZ0y0v2LuwY0mDyB8tcJjysSjf3dYrIXDfN3HnulcVDlt0R/jwKosfMLqetWJjH7Li6CH7zwYh985Iw8PtUCIMuEWpRf9YJCbLBrBEiVuyV7kpiS+gAssZOJOtQq5Tf8ApvJUxd9rQEktCf1DKDXlzKIJwUhwd2KmyZJ00ZeGPYUhgKQKKnhU/EsIamO6Y64oUQDG1EyjnevkZd5LS2DTBgyglbdTcm23HsejwPLXkOKdTxztGE2SK+Bz9rKUoBvtoLDbaDLkvCPvOIl6hZhTXucm764PFbGerAe5y2PVZ9n/f5p0HVgnzuWhjzPO8AeGylb9cqikHMky2EfEnV2AycC4FC69jMdtz9D0oEo8Hm2vsx6ni5ln3dZOGHDAlZT2cRhH3bhwC+PDyFeEMIW0FF5FFmqs7ZVoQoNDKFKYLJN6Ca6jpsQl/cMj1EWdJ6vDJJYIeeVb2s4Cggxf4LvSLiqUF7MaRycXUxsuSfKO09/hWwiyCyb8mZb7oNF4fH2O+AzJ6wSwqggBeSLSL3MvBlX09oCQW5eO1Tcmgrh5MVvQNHQFbN0+nEzbe4G7ZXXx58zhqsLlZPobHpauHidBXmDXYMWmspVjb1MOeysv4Shp9dqpA2HdBwwJDUMLsVt831cK8Zui3QnaZqHdPaQ3QFfYmGO8sSnaBtMQm3N5/TBPUuEJwuiB1iKrvYNsl75YiNxMhnNNQEltctijUEkcD6h1Hz3D1XBicQ74600XuQ+s2HwqFygq8YtsXXWfA6Z1Mhkkt+LfBOD72PDmIhQWXzUAfTlcCDOamUebYzenFSY++i7xBeunlXhaj/jCcz0UFpsIooS2y9KC+4PQQafjReVQvJOYJK0SzQntYDrsRBGBlqUVhsLKM66nOh9JH0dx+g6/D/rAhXJELaAeJNNh6VjY6ZdWDMoqno3+PM5rqz6C2CpWiFRMDHtV3DGXi03izLO31GbVYQHf7aXBbmUfuse1rWCLls9eAersF6Ahgvlsz1JS52NzFu+OE0jKwNqSOtMK6rP0OnxUAJIZ/NHLCj9Sd9QIRIE3B8/Fs1S0fHY3rGbFq6/ZXof0fUiq9bfdJ4XiAo4HRvmnaZ2y7sakEWNz/ejlx/Rq89gclrkSzcDi3WfQDtbXCBiIvHw5YX1PfDQ3tKzkYUAhdO12VhCQg4Ej+VLUFIgGRl5xWDEkN1Z4YC/MrKNvRVAYFe9gVp7vI5MxXNywEs3IehnLW2xuttu7q8w9Gc+AqkMioR+nfxRGGYX1RV9rmSlJMi+wkqn2WGFTwBekJsyLfSkhFtiTtv6CMdsJoOgCnlo9J98j13ZwRdYc3g4uE06gRWNI2U5OTj/aCHFVS+ngJGbPx5QILeWpENGDoVq24dc/ehXVVu1noFl5fPRFfmCMT80mX1PenlDF5n3dOmR0KwUBPg2sFI5KxivycqJcyB5js6m7YED1fwJMjvet8hwdydXsAvM1FB98o24UnfYyOEiLeFCJNXL13BUEYxSZNGD8vhoHu84Rc2ONdOkcvt9gYk2Ik60RDEz2Ub4FD2fyY1i9l7kmPoYxIvyPa19POimrYyVxMPaEXYrz1FFe8/cG4ECg2BhHwRwpc2m3W8QwwESRIop66oNWmOzw9VKa7vRpo2MFy1KQLM0ciXRzvy8RqfnjNX338u4lQAFoOw7zbFWh+N2z6rSZkEMqb7tCERCvnFAyYkKMww4wlc5U4+zGFsfEmQGBT7yTyftbFAqYA72RWDI5ZmtPMyKXlhxSJlvW2TYq+x7bT9NWyh8fGyv2G516QMZyA+KVPsiX28EXldCUWFKnKjPVXOXh039NMJcrqpmqZ4aw4RvhuW8ff152i98BA8fAXOoWpoiVQr8KTLOsL2WJboEf8kKP0A2iQd3h86WhVWBP2R1Xr4LNpFL7LrWzCqqC3xWnNovB8PIi7sYzcAwBGKsCLXtElp0Vmcjm5U7GjQHjPIP1s9MIw8wuNn9cWRXQq+Ms+SK5vWJi/t1kWwC1Kfz8mc7tcghsYC0aYgKdgS+K4KmlsSt+O/EnYbyyH4uwkgYVlRzDppfYyASYeMQ9TjXvZyNIAfcf40fpy7sZ54LYcn7kOIldj6ukLA+cUT5yC2x04VhvgvllOYja5uL4ItTXgsohPBoLVsm4RbgjVxmomhYRCnBy7gBD83+RBP3MY1VoADT9wttxuAXH34FJjwYZwVrhEoe5ctgMOxtNwPYr94vjbw0bR3LN2G3cbyKitjgUoHp5oXgNwHdP14tuJMUPmQXZSiDdaqZwp5m/2psjKCKNvUj45oQy0D4C7W14nlnGtg15DCsNHOM2g4GVx5dcHUY4oTbKW+QTmONaZiTSpzA6AeZHLgnj69s1czaq/RgQjGwlhGKvy2roBXcKZU3fTKEH9VQjhONTQhPqoGOMNikgsHqtLwD62WFLmSN+DZol1bauOkPlCnoJGwMDiRw+bSFjXKhT/iPt8pIAHItcroQ9+LzeF0CrBiF59ZP808Fl9BhLeAMGy5eovEwfykDw4Zq56BucVSyZUYXO29v3WNOiGy4vfCqVmqKUOL3H+s/gTik5lBvGktvv3WAq73j+iC/eMY5hzTjr6tqprX6lSQpRN52sUsQfHJOcONJc4s+Ns2X+z3ql4hYed4h2nXTZ3F9pvdxeEUz3vFxfiCNghhN1li8JFHn7rIOhnmth/Bx/Vt2vwDXfLVuj6Z0bwb9m45EUlYbN7aKn9pXmMrs2ckFWWEXX0rqZAz0hm/yCnvBJ+/evEXrprr/M8o0RE1ZL8wTQj6rxFuq9i3zOTiaBH2QEjhNHp4yypaYsxLrioceqaqQBkiax3KYsd1CrvdH0Lta9AsRlc+7OgjcllKLrqyiY6f/IrTWE5G2ZE1rmkPsXvZsj0KhdgyMmNG4Gw7NNb6IgfxjPza9WurYrUMFVd3lH0kgYH/V5jq4KFRhDMPMep2fe4zLp7O9kIxBUcn2nOfiq+Kyb7nRxa2wf9WLBvDZXpl3jmakdchFXA/RMOBmhh4KEeETcXCUSy/11gsZYEvAdHcSMH3pdKT0a5RDV34hYNvmC7IsaIFRh8RC5eDpVsI7tcHoKUe8lMqBHkeaoI+64BD10KcVmetcxpwOg7jv5Mm7AY9bXaOpTlNRUzlKFE32ZjyPlGFZfJQOPFv33aG7U3ZpZsC75XS16pb6UjzhwE3a3oRl9/gDFL/vLK3uWiKDDAHVX0+hwF5IrbXxeTXWcfB2tQuO9aeMNMtCIFz4CudHNAOT32UWq1cGmyUOf3IxegEEYJQEk6KX52MHlY0oNIBb0DZsOqWzxbtJbWieU/3rTXZ4FXX56hfCX6YF3nG4cQNdLBWPmMRKMBmI2Cbmaj4ffhBflMzHS40iaJLWhHlKuqTbPtat6q/SHMlmyKoGn2HE+gKQ7Gdb3tG7FpHTobI9XafhfT8uaC+dtCVseK3mBdCA1oMPui21PsBAkvWuNcTjyT9PJ+JuBFlx2rBdOtU+pxEg4wKx0F0l/dw69ATyRrHFWZewB50C+DhT0TKskdKqxiZCcZQzh9gCIjgzOHsYko0meUJrjMJy5JMjGKtZ9ZzGF46g1yjd1z3sKCZifjMhhw2l+TwQQFjf4b0hjhcevlO5Clcm5C0IO8i9kMOHmx5iUvZ9+dN9JMBRBEWsyiJXmLUKS5GIYvUnkyZL/mYBoEUoQXB3GwCE5tAjbzy3OBraBhClsKHUYOibitCjVLl57MGA3BNIOYr3f31jIZZQajFRk4BfWtFf0TlnkeOouAz2ifL3NGWGXY6S4cVXweYh+ZzXHYROlxRG54Ik9EQgblo4tFdP5Vg8eFO4Us2UIoldGv+/L7QV6r8I7BbJIGlIAMIgBe33cnnPqXCYm0k7X0QLKygXqiBe5vcEgQsEX6VaBLm8u+982TQ+ZZFbazQwzVWXq2dDdKnYe41gcdgBvM1zt1KkOd36MLjnX+rOPTBgWiL1GzcxS13LRSXLl3Gtkb3SwTi+qvK1mUaYfMPOMk0SIy+D3ocSntkLrdn61IrbSVwg+Vf+UOvLIIHByvM1SmjoewsIc3nc3PXD797AXMy8NoR3uVdEovHF/yeuafE1RRWusEfX+dY7G57Vn2kf27o64sO6/S0K/6IiU4QaIlfLF5t0rmm9ByUNcbg73vlVtB5UK4tGjBeY3ryqw5rT9vgqrlMMC99uJfSnIctEr9rskrgSvxkLm+L8dyb/mFM0mCk2moSy7LAtYgQHoAmZHi7vrE7/k40h+CpHecmX4H7THZVSfXtVgv2E142+SYSJAnnF0EjnzdhofYMJipZtqMIScLI/dY0BrYH5bdKosu8ifgHBD8eSzFe08DFNXnlaLcDai2qL+ejpSScVuuD/kr6Lezr1gxsMkXxe8ahNJm8m72LFUYKF8OmfraHl+6Dpfh4muqVHDSaArfPH1gULEa5StxOjVFpyEbO0GOh0xgEYW1775rJn4ClMjxn6QZfv6D1bSH1TghjsAS/A1a1iPjmGndpHPKQ7DLg4uKcQj76aykV0CEPEAdZTIzWZQaFENAAIDqh4JoP/hBML+t3T/4dIdXAWJcdJaGOx+p8NExM/hcDQncrV57YVvqr7WIcAMyDVzL9PUoKvoRvzwwritYSql/21oTM0nzHMh5dV59rOgBQaofX8+o5GELuHD72HEy9OHW51q3+QoE/jeqdNEcC11fMa7u5BHVjgtYVGxiX26Mguji/c0tu6uJhfyMhkmqJAHUySI5aiYw+obEOj3qodM+WS9xgIiXe2EnoAAARQ2lF/l03lGmaZG0pK7qT3l4wEBn07TFsyYW10OrRXj9MPKeYchecQEkLpzL3lTaVaF60/EdwprwITf8KZIoryC6/qCn2RnI2KvB7fUgkd9YeVXH59GjSWdf1kTQQQ7PYNuoGGaUxAB+2GtD5v89X8Wg3YKEWu65xzr5awQlOO5nf829wrzDCa2nyiqj8mDltVuxyCnuGwahCxPQMi55odJP5OjIAgDBYR1BSN6W6sSa+Fzw3tPQJOv+hWpgi85XdVZ4v5cKg7vveznMG5/tHKYXzkb1oB86HxEFujBDP1i3NS0WSrF0EE+h6/6TidMkJwE3GaSLLw9bZMF/mOObVqVskxU2t2paCsORbwDN4TcOOCc7N/ODwaQSi3JMlqDhzaIGpJfL/nbSJQJKk2xRn6Zdmv/7WxuIQxQEX9uuLBXLbtGeW4TdVq6x6bcHP9F722vCd03OUDhSMFkKHMT5VUq1dCRX/JOEAT4hZuVIJxNbd5JNu9o5I/kqCiKqyfK+4sEV0orgHmV+nAej1W+DG/qGAqf6KnMIesOkpEXZhhA2vnzo4HkFBEq0A5h2HvOvNn6hQZbCLMmBz9YBa6GFsCAl68tvuqRwymgqLJa8eh2ZGXaT/Y0EqCF6MxWAr+beQqDt0JwVc1ILC/S+fE1ew9JspZxxHXsDqBUrX1YpsvFGLRcY3jBnpI4oxscE7bDlkqR4VXD3A+nzo/A7BP7GFyJFtxMfE3GQlNar+TD1l77rcGEzJojTWFdcBAAIZmv8Egb3I52wfWvsHqYIDJQE86N+yljMJgJJdNVB8lu2d8HTm641y4+axSnrqQcZH8yNmTtUQ7Y11WUYCsrCr07uT8dyqban9dktPdpMQQsGEQ47MshcOD9dATjJHSCz17RZUJsnMKcHROqaOTbXzieDPXFgSkAVY7y2muDNG/jacFZ5jCasjE1WOv7ayLtr5MOrI4Wd82fOwHNlJIgv45UZtu9nKwM2fd845KL8lrinjy7IQELPhv+lcJLs7OoB4FJnvCpB9PO7AaTdFu742XPloopXTlbaG0nx4AnCK4tE4T3xf+CTf2GvO1NoCwNatMeyoj2C2XGf41OrtnESJRHt6fF0AXppse3rdSa5if3ZIrAOKzX2/xOC8jq6pqKe2oQdwVWGQk/zKAtdWjU6bctGJ0JKvlG0lMm7lXYJWDjGcxFEiVC6IqS6i5CZkJKIugykv9qK8F83XEQ9tEP4yUjQtvVYQmDwYd/dmiyZkGWRTwpFpxrkYLeEP7d2C8JuRVRVopZp9UJgecTITXVE2T4POr+/BdehSUIFOOljxaxxfJncCBWZMyjZ7AC2471cwpUtw6aImxoWddvzLtZFNbLWgzg0HWfggaJUfOuAcTzYCzRjc+oS1UT8ggSrF6u6U1knJ8nQzMN+cUsWbACO/etH4LghSNO0ou0Zlpg6+Xn11EWMSNODeA+hPeksSw8JiYcNAJSYZtjGTqTx/w4CgbTKyP3l7PbkY7WpggFMbgBsy8a55Fvwi5HTdNUMZXOuo3tOIh7k3yATfm4c7PBNN6nxSA/hfDA2TshgrO9QFX+440IDz4pXLM/zK7m48Vy1leJdpCXaiW3eC6msiybdyXVsjeQpCvmPhslGtbJT97wcnHsCq2jHbbD6L0yBD+L44T8a2lJGdVe2+jVD4iqgflFF/AE9BSey1jRCkc96xmIDgAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAATRkpXp4ECqmK2l5roF/Rjf/z+08SxzjC81jA/bK9ma2m+FZAEYUWA735DNFPMsMpI8wCoHQlC4T9JWpB/bswn6zz49TIkU2LsBciMhf4S0ZGCvn3Oq+V3x1hJxgZQhK7IM8eCItnXAEP+Y9zv3KSh9StFxefb0qZvZ8H/+WuUgN3p1RTdnuaWLG7Wsr8Ftc/d6hcblPya6/IWsgmOyl9RuE5pEzzigRHYkfNLCn+PCVD+6+jeiVcfmtaac9DGOPe98aZ9CS2Zl096YPIYRRmypXb8+YSiAdn45by8wuml0ECVkCurZaGqqAvlSoa/e6qmKAth5WJkYc209wGFYv97/RpiECNpk3Nun0BusSBLF5rtUT4grUbsL5BrBZmciOQayXtUucjCC/sTwGlUl3eC3Gtx1JXMAPKZyMkWWVvI3w1TjLHbsPTI1cRU9ooo2lmjRXWJJKTW8BzMalWgmwzKyBlrrHo+wRDLCo1cQO7Xz4U0zZnYkTTJcxsUfgySngVO5IUx2MKWufE//OTyyG3Hzy2lc42JLWi9UVtbX4AYzraPntSBC/i1/HtECNDHxeZxB0lxtOgGATw1phseSDgfaIVqbt3E7CnNW+JntkSDtIw7QZZHfaYqveX8Fzsn53F3oz3xT3mh+xO2/UnBn3tKMx0i8pOwczphGNYqB7vatv12zJw0xADb/14xTFW4wHDLoFeVCUOS9wKFLpHxRzZ48gRlQ3hxQ9rg/uCnPQMs1mBiju4UAchDfhZO6o0UT09M1djPuenHuIDkTZm/Gt7S6LnGD+17g2a18AUyzuZrJFduroLeuUtVn+Tow5zW0QPKYeReEtL67SIe/br0CTL9haKrozEjtAIZ5s6CdLTqISxpHkTxl1Ru3wC3FI16IZOcEphX6ysFFtgsYILRzAKf4cba35w7EkJ/DIPUsM3POsNS1Q+IKPeyWsYEcnDqIQfToPJWhTbE1WrjA87t1+V3vncoOLp2ublqvVMUW855+wCoq7K2EhcGPKT/iPjZtXDSXdv8c8/+dEQLbWxXg2UPNa6N0BngOwU1sDBbN3VosHo6/3WT+EiTi+rvdM3n2SuDInOIHjw/AGChOSbfOZKcBpFOhrCkOp/ght5xq2NenqhQUfVv9f5xozVj6B5xJTYrfnkoUtm9BSfYrOrlrCjAiWwiawr3qdoqJHcTgBEo8UWBNTFD6UpyVfB+mpeqyAVkGrX3+g/6OFX+URinHXwTn1hY7BSXmti1f1Dxd91pyXAgBlVCvZa5lji8VTr/Y8+8Sp31txP+LprvQwvMHcl6DNnM7eLyhYjK2joNLYPzZg06gcpueFwGODk3rfS3mMqm6Kn7+lR7FtdY1mZYHzPBrsCI9WmOJga+RYtKoODdu4ezXY+b2IKOgAqIvXeGvU34lfgWvJfyP1CYg4LDWk+puzeoGvS5tQVlUCHy07G44j/nPX996v+uCg7hgUKUVC96/2KebXTY3ORvrRDeZIRsIgM/H2Zt1Fcuo+8XTXTZObOMgT4aCN8Nm+B8e69wEEA49TBTpW6eYsFnq6b/M+meqknRRF9+dr9XmaLUpJrwPsfFPKiZO1by6R9Hg1MFSLA6rtFzz15F0ynrnDjjBCAs5YmZEHuNKQOtxs/KNadJm8QmK+PMy5RrEdN8wgnimzaRvf2rhAtxQHCnGGusJ53H2DaGvBtmL9CYeHLXhO7YDN2g3zREqTH5GvxtxbVYvnIXBsrzorp5NBgzjMwRGS4sT9a1u32x1q69D/3aVhlBycKsuwc/SMrTM+CgB1KuonNhBQL1LL+TEqp3ZXDcqq/IiUplHeEdCxnQDawBEVsAmg2slAHb9oa3f0QBraicYGGqNoICdh9lIsupD6JUcgSoPanHNq9xYUObH5oo0Wm1BQSVuwlURce5+epnUnGWhnU0o5LP16THfU+31C6yh0MuJBJsX2UUUyvw9XHbQixqE/udfgqzOR1gC1XZB9LCJIuon22K9G3S3ti/N6wjhoJGsDib4B9LaAz/KouBahR19KbSrTBaomBtKdg+CulpYFrspfAuED5rLf9n/G0Ccu7t2hMCNa1GCjD+w3B6djroYLUm9zyVygOiRUCQpXO6h9QvOA9FbsXFj62ECbffiwDLJ9XYOPtpDI2mfxb8q1aFsYmpfCFIUijmygPeuAkN+cq26ljrwbRJCddKHPmBXSfe9ZqHmJU30BNn+g7hFF9o9ckOSd/Q3SNkbjFudYg5en6kBCN239aOB+XvZTTBFzLxQ+hMEOzJ4+L+xmTzOu7KQlEw0eG0JN3PRifdT9N4gO7rBoln4nZNFm0zBY2vOZ2piiomXovOg+XLWX0ag7xBdgvNRPZaxMe+mc+zcV2R3zY9qeRQ7hE9358KPo1FosChHaNw3EvXdPa53B/u49CRcBvRRgAewkerFLtAlnFbsxGVTdP9qzIcyN0jppVEL1Fsy0t3F/H7PDfpprYo44mC0jNf1yeEXJsP0LJ1boU3K9kcW+b3Xj8qRbCQVc2rO+aQ6uUJLj4EpKP/I6J2wiW13/TUK+5XMuy35L7yFove31mTgTQyrANqfpkV65GUOKvR/ZEIRtUiharpu4hFHjE3ihWJ9N7LlMwgExEFnl2hXwyEm9BXGOL1TlcfWaHYv9P22kM9XhHOzMCrxcdibOe6tC6JsiH3hcQJKC3lRfP86xnkmNvTIagQMB3rvcSvleUhjnf8LZInsndoTnEgpvXMZLfE3hZzEbOk9fEG/B/E/Bu/r/7oddxuxXOCXTtfk0OMJABJfCO7Hl1fHF0180244g1PNghoOqrWkCXs67DCtUQidDafCda3QGaAiYYdc5QgqBuzYIjTXNqBsWmfc/v1/Tnl/i510IDIirJ+VFkUA0z2bP1tJnIQH+9fwT1TyLs4IWcLucrZqKqYXuwxl+mC1lwoxM568rdbicakj35KwAMH/6biNTYdWpWJ4YE9E3KGtNQx9ND63HWVST1WqGwRpROCUpeaHnXEewvwclmdXGbBuEABq1rSw+IynEEEpyNqZCJsv/i4eL5B9PmsfH1DRYfepGjN9cvI3AKNKOPGUHBtx95/8fN7f9iOs32Z32YtidgRzP4gof/5KK3zbnLWk4SdVRkPQi6MslNOvh3GtoMZm1IDWboshwAzrs3TRKn027An+FPzYWH+hZ7ur2aaluufRUOZe7qVVxzdcVhqpaPJ1j7qIXivQrLKeokbON+1jwLWI5YYZ8xNHE0EGRTi0nq8AGxgfrumluHzRvRV6JJHZQ7NUUYPmSuVMDnlBhBHpLkoco46WzAtNMROwEsECTwrg9OZgaCmQJGWqeK45YCnJycg4cOTIGI8Q+bcWhmVlZAhuAPpDuuWuwkWc4Mpzid4k6U1BZbTfpYWlBFgbtK/3/kRxkt0ClbLlKvmrt3k8ysl/Mq33BeyqyhCkcQzWU9UW2x46SzDC4GJsav6uuJhEHonfJt1spc+3voyhdnr1iCHAQ1PiEX7reS49BvJAv+sQM8e+3bqSD+Dx3M2EcYNhZaGcvAXrhpAA9xfQvD7I/eNXAu+qI5NBgaQlrdsT95fV5Qz8WcZuOVR4s3QwT9s8tKrIHfMKLW/myLRacVW8XllycK/fcdh1YNBUjOZiTOFFt8GqPFmB10H0Uu53P/GC7BKgpKc1YUXmXzl5hDMuWvnQy2mK6xXEZvRzwdE3+jbFTQErmjSlBuTKzw/DeGPL6ZN/Ji3UVeC7U8rvca9aufMr1r7A1TSc4DzLqhkzHoqx5bpfV80RZA09GZPsYT1fuYIfdbcM4UhdogGRHpVdcMJWEBDHjh/86k6kZTt/XkBZOPSpHZcNi6JV9Kld87C96hBCkXgUd0Bti0msc/0XHBxA9AzhhkgCIdeoptZxA6ShG2pa2t1r2sIHnZw+YvMoolCPbeiamazikiSmrlrEgfjOYNfftFdyIa717Bi73lt56l2Nt8FU6PWqB5y3mtoWJa0Y1u1noS0afZP+QrvcCMQJvygv0GWkoOYdmxo8jnXNJ2TMbN408cl26sAFTRylfGO2hRqZrBqLjL3Er48j5KbT1bvO/f7EnVUxmgS6O9e96XspBZEzDAZ9Bxv9lxKoOS3n7OqBaVJtg+Uv7ERxZSq1W1cl/zbd1TXqLWqJpCZ/ZTbjnZ5aUeHFNW7ztQ+H6e+B/x+tHt+pPLai8JMrXpqFGGeIN1slTskN9TfNNhyi1PevSyXWvAxsUzQ/7q091faiFPOAVRtead9aBSOji5gdElV5rrIJUWYRndZ5LzzPuO7WthVM9RpCHUogN862wWCJmESNhBCixmU7AZy4xSCXTJPPES53snAhUj5pHLTpB5SIYptEQ7pjGVMw5Igtp+fekwpKSW6aFIiQqY6vJC2pzD0pW3IVHMov1cTX4FH2kvRLSYWZOOKJ1szHY9/yZUWeehjalwBi+L87RFRL5AQGpWUBfnd9vouRIgJxsNJSCVag6n44GFhnTQimj6Q7nzGZNvz9rFjnfo4xcySqDVT4oRU1uSLE/OVIdN+vXwN1A3RXpH/iixMOTwWWT1nWRGMw5PYZIrT9KxXWR24UZr/V2oAE9WCYTyZid48tWxb6+YZMHpMtMZpxnvl+KLB3CYAeQYgCmAieesjvI7SwiZ6uv76wP+FLKrIjkqYRFC/krUyofI42FvFnCWnnlVJNPS7aN4IepFHyYqIpgKsmV9eewoZVgDwbEeiRl9YgE/8X4AChv8Gkc3YiU/oS9YPL/1JQoeDyD011cQ9PHy2dKNJFDC06WyGF7gzMxJTpNHkmFEZx9pKMDhLWXUCiB8Gx++6d6uX+0ILVAq13Z+wQQixQHBZxnJtPCKXZlc/tFBkwY54DIxn9RW67PzYMaEwMFmsiN3ozxVPdapIN9y4as+R5bKT3uEqZdltBoCluF+1zf4URp5XN2silFtSKUm5HXuPvgoaPcVMI8xApnrj6cTUHg6bagKKiZ1wkeBcxhTHmjGdwRBz75Yq+hflfDeMADJEKFp68VjR9WD4Dj5ASXMCB4ACv9+QDZtJ2nSQ08RCXwP8XTTf4Oeje2xIKXUkg098RkON1dlM/CE78hhC9trLft1hZDqBbMb2CCXkjlcS88sbMtnit7lvKJ8+XwCbe6OE3KpIQmhX8CL/2eRXAO/qpnVvM1AUIWiWTYb4xFvWN1lVmC9kdcRYmgD7VLIvBZFsjxJhsdxIhOdjM6NHrNNFC7pGOuc+sCtdJyaGdK4wq0nX35vVfT7Xi5vOfKAOxe6xIwwPzLDKCoKlwDWyffx5NIjR8V0LIpjkNmNB4GmQf2EBHuj59XszmAv3sR1NWmRByfY3dAZkPpVGiVJDnsQ9NJEWYa0OzPDFjtjuTg9hDGNpX2YGPY1WdBsPQTQDHo4e6ejH7BwcEWmBwW79vMSDinIgfgZU3idcWs9pKq1+f1tGWzU92dCXApDWdDtwZJgnxb9zsJzCqE6xb2Z6V7Py7P3yCTdGOnh1EPdoSVoivN+lQI5JN0gWXrSSScYz797uTGtmdDi5tD3T6ZMk4hQgvF0fwzTOwM5AhPut9405MTx/5gs6UxhMwMOgH6d7ZcwX8CWkSonfSWxt5yZAP69gj/NNJwKpAHLwikekKYRHT2rC6jYqERx5N1i3Iyx7ol4r97NQ/96Idhp4dlomMEX8SzoQs6VmPnjfQ7oXuSyCYqCqvFnFQqUQre66PrtxI/fk5q3fUSO7GYeMdMIh+Epp8M6cIZnEBU3erYmEDIPTtiD8aoDmpEakxgXCf0L+0L3Poip/rf6VJhkeKWvNfDgkhIsRifqHFLpw3knT6uSGA4WHBn3ebVxV51/LFLKk79QHHFrQMgl3fAqhCwLfzwFLfiVgvKjpmhyW3omReYpqtkegwEuQX9h4ET0xIabyPulWI7HGEj7H2zCGVHOID+z+ku8BTSLM3wu82zQQr3uCUGHsYk3bpcCE2T4qnIM+jdhfzuga3NtHtq3rRLQJYe6CcR7QkYC3cG7QcSEvRCev6PsiUBjORhcbBjLH6bY1u7FPemFlJ0t0egsCFJmY8o/qbtAwylN0/J+q0dcFkh+GcL0T/NjyRM8SYQK5vKq7necvzq4+cOnuU9EsIG652dme916m77THCvI0H57IwZHYJjrQKghXD80ots59pIPe/LJ8RA99CD89sWwV4XFlFwa5Gq1q5nR/9RXVU8BVMmPs/S5t5nRFNJzwy4ITg8FqMN0gmZUCaWl7V2y23CjtoC9omGZGxI8auu/z+3h0wTvqMmyvHPSHwqosU43gJzdoCyyjG9ByJt/maSuEWp/PYcaQnGfuaaLdK5/CwoWkWmjCzg/fBVb3Vo6Ad7TT+tdsnRvD9O8+8e2+9ZZz6R/GMPfRFMmdDHzpVL4HJK5SQZL9GiJBueZ5qoiskIBNsEnj6EcSF1SJ/zcn6voPuZcaoOE7PKYOHVElYSbxybFL0RYmHOA8C8s2c3OoHr5qWq1LAuPolZ03s7U32opasuNFlavCL/S7eBbc/DZ9F3nwbUvT3xVQngPkfLKlix9F1RQvq61pXuNk+6pof7IloLslWqj5me4vwCm0amrUgW+8j86OqJ7kE98IYAauL6JVc3RsVSWLM3CwkY0+HdRD2yr+kFFIS1l7sX9l8+4S5InK/DFJYVQXDJ4f0FWhDN9vrF8hQJhqKHuorBW58bqKnyS130jI6hD2e4ud0DduUgogqEM8kowiKnhvhqIeSyIhaTy/cuHgnS0cvvstHEzf8KD88MumF/fcHdCeUc1sKZWvJsaZcjmU+C4DWq5PvN938isaJatDE/nfmJ7zJsNlFtBABPGMfpCglWYfyOLHWQmgPCnForAECx+Xea/9BGi+NoS4aRTLmGJGJMnuQN185ZnRc0b9yDACg0I2qXcHRxiZOrauCVAihEPj0V0YWnQ3tye8pSvN7VtEO28eFz/M4G3xUPxLs06d6bzcJ+ikT0OMk/q7GC7ofGfo/7BKUW8qVRMcVRTNWHmR0wnGxhcp5bXzP2gJOwhbAZyiYtlw+r3qCdMcuMqXjKifSFooqVAsr1OdPrO9ouq9dA9WqGkLmGO08lg37/WgkIgi5qdlZOUsaY3Gul2O0ivByDZjI6z0RKURFby9y491lHG6xysB5mWUBDpFBnRahbVheW1gMNDd8Q70p6FJeTHfSSnmtiMtU2dQdltS+l1FdxeB/aEgrkrtssNvh3q8hV9w9pJR88Z2nJ8OHEXnxB2rf/juci6dWHeWUabUrLc816GHXjF+OX3yvg8h22qckZkEXVKgu1vawXD6sH+iGaNDuub1hB4ImdpCeIZADKXsPzpxgKo6oyCEDXeZjaOF55GJ8fsD80wl2M5xzLMsvBuX7Yjd8/351FBXZCiWX3rXhO1oO8EZ3bgkVHeEtpVsEDUQV29mZe2sKS5kGdzCAH01gBu7itZ1XS0ttQQhUbhiKYc8PvTY63Q+DlzqxUmk9ucfhfqLMVqz24J+PgnzgVOXeSdInZRCiUNjSN891byfzq9+h09ptbqLpBPyuykcJPD+F7PXeuGRM9kotAVADiWhCTi5Fb1ft2ymQr3e6pdDtDM3tiuij8HRysLC3948s5rX42iVk1/XuNxepLygu01NwmgHqDp17ZPeynOt7Az6j69Y0ldIXkpvvwrK5NXd2dLeEdaK2++PxubmMQXCw398vhukZhxbQD99yusSBkOMAhh41fFTAPWbZmLamHVGw3EjRJmBCgJRoQoxBIpFjYq6sAb9H+GlS++601AiBLd9XTxvQabVnXj77H9cVwIPcYqm1ThZl9oFXMKchhLA2aIakq+GPv+TtWsESFPaMl9CvRovSgUxZTHRct5GkLs/lHhARdY84dokcxkVQDiqg1cizbkRecnlOgOWvPnhTYWDUvMDhe7TPzdlV/6JjkzOxlX3FI9T/euWpqj19eTrvxG0X4b+V3pYjqyA8fP+GAEBwpkJ14xl+bplMimZBxj1kh3dLkX1t8To33jYCQ1HyOoD44tvdYuV6OO4UsWYTKsC2LECkNL5bKfe06o4z/pS935j+JOaUs9UBFzWWnB+xoJqhwTrVKjriLQ3QKdAK6rST3XMnqtxYHJUa3S/ZVWnRQN1Nfurk58lG7QxnMkPPg07MTYmrlOFeWvEmzAMiLpFU97Aqkp3gpNyRwfUSZpvUCv/t6/gnCHFonB7EhXKPhG7dynMwArdVCdC7AE/cf69y7olWg7iICb88a58zmuUA2AtfZVyvhVhvxh9CmjkwzgppSI9ezbG5bY+JsKAoGwcT+bJJIrWfnyEzlsFeT4FisxNUFEElILC0QTSQr27SwJ9tLFEZREcyCQyA8OVAsY0RvzvB8SmFuEvSDQUW2Yd94j4/6RcoK8I2Kcjctaf//DbOxlF2qi/R4I1mPEUmNiVHBr+okKh8ky0bfAIN5itcWv8bE4iiSRmlErAwr0u0a5zAe0Kz8t3GLSY3pLV9pkrQ2GUJdCHYZbYZ+CrWLZy0LsICU+6SUe80qoG6LLseu/Jc+ByWweDzuAmN0I1TCS7LSDbwfxSwTyBv/2PgKpgrmwuvPmNd7hXWp+7DfkJPhz8VDtOWBQfltebLoh4iB33SagOOcWL76OP1aZx+5/nIApk+OeL1XofHLUO5yfA6VHh8om+O2tn6hgpcZpbuZA8b9m7bcvLOu9/Xrn+XOrDq+A4B6vU0zpZ1T66vv5kgWmX0MAMlNqrim3Z1t8uYFeYBVRIVVaN4O53LJuBd4byRqPpsDho+hKM71dYjuFsvw+1gfJd51Pgi+iDCrDPvBvkw2mM5wb2XnxRvzBthroe5snj0h67HEAuB3V73rOGfxxnDhg/FCTvPELJPoNtUfZBBb2zrVK0JMpqWrs2oL0Gg3Em6iUCHG9Sgm5L5seLvW6GHLNF7c0gf/OCRGD8q+B+0Rk0l3PR0jG9uutPsJ0vca9MqAHBmZFmCOp16wcf5UXf6045Gw+deqb0l304wLhHKfij/zWMsWw/OGBq3Eb0bAdTtxcJuiFC/kVnYmHNWlCvn3rlNMz8JEDGns8D6cWcuHPGeoBt7luArbuZG+2+LNYkbhp7660rZ1lK3Vk5y5EvJdVkAdKIBd5UMQq7UhTz27aUQbbX7s+sB5p+PbevQWX3js83N1NmqMYlGvB46RffzWP62Thb5LoGKLf5DMBhhWyb8OrD4ho6s7zIgCK5h37dXG4aFAQ4pNl6ZZYznTZDUW6lntUp92gbMxu65WKT1Aas+Bhaxu2NTI1aXdMNJaYhfmwjnxvxbZ+vsOoK1Quu38VYvfz3X8khn4K6996NZ1mO2tH0nOabHZKfr5Ua967bU9poNmCohGNPuwtOKp+48aXjFUaypkfulYwbZOxF+FFYBYgDBaPX32hkiQ2yJy4lhNrWI7bLi/ZPEMmH8/JP9Ntuc4BbS342oVwhhm0rCA4A9BDod4WQxbFjP8sAF3jA1+yd4qG2Qif+lh+CrREE5JdzDbjbK204HXq52yFU0yHRcOjYcmjtjWv+I1vR9HZYrNzUxlCcEqR6q1t/2j2p610kVzplgecgWChYbObq91RkKo4utGEC7x15weQCwVQcArGqTpfxM3ySbL7fquVbb4WiC3Ltzg64TrJ49z70GOHGNR2/ATXyOsNH9BbptASMBNRZUternC4bAkV5ssAu6/gNZv2n6nAcRrVbD7AK1svFX6w0jaxQE+8IyqVl27B9D6JIFCgtxnoFfrXwqHe6IzXAJsLuYY1PyPdwkDN6FtkBLdS77wRlBN0vH4/dndiYF2dI06Ed1zc0ud4sn8z3BecLV8rHCB8pDOhxCxAFbX1zOmn1+I1zQeYFlDRT47BIDVYxUiudXeqDL2DF89DyC0IODIGFy+NqNSPzLQc1VKwbXC9tbkZLQGqjyE8DHs6Rd4xz9G5Xy6bh+BOX718gH1T6RMSpDQN8XIyzQgAZFSQPMs5qtgvW793wRmu1n2ChJ2Zg96Z3BVvrKZTKhQWDzHG9jin8h8owXXacYaO20+SSANqVsovx5TCdB9oTIvpDTQmNTq54FhmjHsoo7cl0pxjEySZbkQfb6laHOf76OwI//yhjZbi8KHitE1mYpXJsvrOOhIV0aNb9kh9XmCADMOYGeHk+2GQPj7IMnxenpbZG8RrW+KgoKQlNAAyi90MabV1hV2Dqbud1M8L4+bMgqxuEv6srooKOsUCfD3j01vOkpMbiFHA72MYq+fEJCARU7iGaqH/6oXsmNl2BhRL0INvf9B8uWn0YaxjVyufK5tXiL2fXPhjBgRP3l1cG/7fqDZTyH7UAPwCU9qUlnAAj6V06R9mceO0iVQhqBPAo3L6s5tx7eLD+kmi3kpEKc5sSPNgyH4PMe4qi3rme0PA9dO82EDCI542GPhkLaswqHGWz1HAhFlZnsVrhVj2lC8dkTOPribhTz7l/9hI5mkGYfVx5dPkfZA41gnmVfCIckfx0kBXapFB1dZcnUB5+G7oLVYgaIb6KDEXQKTPrUnW3V0uzXd/EDZphfTdI5aliNqCNJmUhmFemLfndLEDwNwmvm9Q12vVoy/7MR0Q5ogpa6/jXe4kw624wWjW5+WM0to5cMPRL2DtM+mGLoXcn+Sne5unlPN1bkKoWmySf+vRgs0+Kp4K/YjkXSyRf8ZjOsv+70KTEZT1s6hzQPUGLnGUYJQPBqPX4jXYwqWPLNpV0VyK+hpNag0/Eg3sLqFntK/MDCfJEQivnNG24WkTTqPxvJMCE9i2qnBvdR4745boKvIOjn6PpMJzKVrRAaxVr2t1h0/BFpT8SIcBrHixSl7rk/AqgZDB2xKd0YOSrv2z0DLFWLSviFzOTuortrdxn/WF4RjAo4zWXqYeGB6iNYRVdPgDYYmV/c+nJvzY/x6kVkrvX/4H0iLbRgaGr1YiV1hwGPnyCFXwiOdDwGWStXG1JWnu7Ax2rHQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAABkWH4jNUceuJDueJbXz9yGa6y9vTg5MXs0Nvm0Vhfgc9mfzdW/aQLirpbdZEfCmaGFuQo5Ezrqs1gpnl6fr2WJuGbWsULflA8s8otUyS8MEpTgtqIqkfLvRLzYjEOESA0ZFLCzQmrrcImieNfqmtesJOUigEsdhtYOZZtHDEyvqLxHe9VaQgP4NqITz8WSoXrDRgTAfABIWa33FHIOED3W4x4eJCmo3VJsusVSxt6mOV0pNXLGO0SsY5CC2Nb3w0PsK7iQhd6BnsTZ0WRhArqH4tUq5O1P5FXSKc2oHbINbMzswXZh4BOhMy9m8GUJQMYzojZL6H76mKDpnE1inPSgWJ20/lpruDgDA7QjJnv46ArFrxQE5jSQfQ3DsNROqzFiVURcEIqipPYHBiAkvZDzH2EOVSFI+NuUGuvnUTYfarHSXVG0VY9f0My4aDr5qc9ioWnGkWJ/pZLYCxg2aV+UcG3acgMOkLH3vqCsGauoF2D28Y2rhj7cpbfrml7jJzYTObM30W4vouqKGGCtbtt8QoMcHFYDNsZ7+WFymgwsQZ+bKWNix65bp6MkD9ETo0BrEif4fekjpBhfBUup8bwrccSn44nils7TnXXvXlRZBeUAUGQPUL7POKYOyyOwnQ8TE/rbc3rzugrz4KKS+BCqeG8rCEpi/8djex8Bcg3bYn/zu6ETY88XzFAym1ABtWio3f7IMsDN7DcEsfhY/EevH2unroAYpimgvIkbdHNEaxelOxrRi6wpf4YWTPm6Q6f8OV3EpRFNyLyw9/ADpNPpOKh6PXjTOGTR3Ey6nkHiDjhm4JEI0H0JTOuBqYvdttYCIUdaaF5OK3etjMLnz0LujsZwRjzrYLthQek5X+x/YQC0KTsj1CqZAYTWAJUhN3WPWKZvoNpb4wbmQ0Fc7e4OcdqYoLzPUekOAQ8+eEH//4UPjMU1AromqNFYJ2Wz/dC3ww2OdgQl40mXEBi2+DIxvEqdvgKnkFPQv3CGuCzHiQqFv6F3Itd/SgManV8ug3OD3mG0QecBu4nbrD/tldSVrmqnL+K5XC48s/+NNFKruzLw4zSjTGCfpr+2r4xOFWPXnF82u85CkYoFF2sFTrZuU/6K+Y+UGfsURbtqlHUNRka+nze3FVdjiLEQZN8ub/qTKIF+e1FUYhZAz2xEZN5cX7X45gO9cMIqHrNyZOkutT12Y4mPUMHbGou1wA982GgzriWykqF7FelojCb1knbx03Zpc3oYfdtgl2402tgzPvMhmCAZsZeGAeSmW4WcvLOEgb3g5OTYhbOlRtPlSd4ELwqmzqJQ5/01jWyG3UXkWDjue4y6VATdhKJb85zsssqQCnnEKyYuVW1ksbWXeQV+Pu4eVs/JuSZVXtxuAlHn0sQ++badG4fSoeIOqOtPAk9MzJCo0IwFLuS7mGvlD4Sy0iP65I7YQy3P040x0L+hCDqgncBVRpd/c2n6CntgyUsOq4ahENha93uCZHV9Z+r1y8SzDFWToUMS++KA3H5BqAgCzS/9ZdC99YN33fsL1lfB1NCGwJJBFPhvO97fndFnOT1q7QM75+2whZZ/vXS+DqfEmS+0fb6LJRt9U4yjlCQ5lgmIrbp56rFo8g1nJ8h9JdT1Heu7n8yeCl/uRl/ZwerGh8dhe2X/ZmS3EQZiJWK7YZ/o6l+lGurwS84HD+zk9LthUBHxtfHsEUcfbxSC2ptbSNdFP0cO89niq8hyPYFfz3AYudPK2g+G9ONVbZ6gN0oEFnwCHGKG7hnM53qt9ykPHQQyLZiws6FPlH/YAW9576WEL7ifTghfVW7EvhcNXB74ij8/dc9rIPwaCWmErU+PtVAbJwzwsNYFepKqJexuCgwxyTIQkNrsxmXzN6gVJKD7hI7DnfF87HIKtjRIzuwWHmyj4U2B6z+m+uhdmmgB4bVGMqwX9DVCdPm4Yw3MkTQPWnTrAqWUfqhSz0AQwS04G4BdDGUUZdr+kwkbwfYXoZzy+snOeKLiC9v5NDlZVFHeCU0rYb9ZqvLzpDECX0NS1dYnHkWkNxDK6hRC+wDZebNxwaUvdZIK3fiE44AA+iBMWADS83iVq7vl1u02MpFJFKzdYatIrkn3AUMh1FAbQVYEeCEP6jzLWNOGhbBP3eajoIfvQdsjWeTnynywZEJDAefFy7W8S25QSvOvSDfb6KTjS0xkgdyOcdlBtd1EdAq8/eDqncsYDn/u8qxxRCG7FruOzQz2rpAlVorvEh/tNyj/zunqFI/NgY3vvwQPNUQeiTqwsGD1b+gLFeeJGjmxfVa7KwaZxwBVQpNYetr5O3gNhdfgOepoT68Mtdvd77QAIvHDshwTKFz+AX+R6qv1HfPYKDASuC0DKBUAmEEv9wrZeTDlTgp2AiD5OCDsRWY0kdpxpOWCUqgJokM7lF0/91t+zQUCBCfAur6PQYb0/z4FEdht++Q7GlPGXsTV8anHsWwgkCuQzP3fKf9po40SZ7GU2hM0/SDLj3f6Ac+enO2QQxB+QdHsQFfrYRWiWEXkYdOQ8OagDgr1hXJd0CXP/AW7R+dGasS7KxkpW1DrQcHCEvCNdk13lNKJKo+UkffKk8YT4nKTS4fFb8CwC8LyFW+CoxGJHKm1EssCJ3omSQW7TyWra9JL3W8x47NUlimkUL04Oouf1Dxs0dSSvzku1gUplWHd5UQzUmzp8RS/Q5soDF+211dr79IgzvZNuSVZPlxWr43KOXLEokqjkZBhtyK+0iRbls2qmWUg3XBhn0TCYbxACY3+bhSPE0zVgN7EllaItrU+poOEDe2FhmDXYh/8HWjRiNzGUz/nIpizACsA7FvhVM2OUiZLl/XJ9ESzBQj1nEy82l7fnRDciAgWUZqVLTfccXH7yR/4J95Ogmv6Z7EzO56BxofQ2iX5Pke//jqhEsvkBuCuUqZ+TImFb5hHM7esjNIh/mbW5hf5BOxg9au2qV9Tncm0zd/TjBl88dmVaUQ6bRIVqnt+8SlOkRdUglnsySJpVt4B8DUhqxDQ3ZPWlX9mPKr4m4HFqHmaUd6dMpNACNwSNr2Twtc1ftVgZDICVZyq+1bIrSih1DvgZNBgQXUZaU+yRrZc7VoFNdj6MKWPXOB0iy4m9fEan0GezkjE72sbXwKgWTPgw/L65Go43SI/taHmTH/3oRrV6lnGRLKN10AqX5apcGjZbi7rd9WaD+DC/MxrJS/HHIYq6v72IMkShSxdJVvksEY4zo5zChxwcHMHLgwkvxlIR382tyOI7ym+rhONbtNMndfcSVJD6Xk2KAvV4889GegjcTA7sKV/crMYg6xJMycDo0AP3YnlV1thczhgVLJt2oirR8S8eqcNyANRRn1q475LnPkW1FQHS5mW3Mpus/ZKirBLVyfC2NcptZpTWufiDqdlf4wa+APihdWzpWeGVHhCy5lMMlW/Ts2EHi1VqEmjGBgD/rpdRFgHSsagSkRGc4gvI56mRQ95dWh8BqzR/17l3TOuyovoqO1jadHq43nnne1rwNhR+nabXo1lhXzzwcGObJkMthFvxFtj6fkksFdhdlG96wNF1RMkvme4d36rFVlDRB1gD7WdnI1mB8aiNnxFq8oMoi3ZPnpR4fRWyHOcDHx5e4MzU7lXFaUP7Hpk/pNjM2YwEtRrpca/P/lZfWs+CFGuu19h20WdjdUq1W2aUM62gdMBXVH48u+9ae+ZBZSvX9F0UplG6WsLlk7pidVn1qTknKIvYo8ms7sfuuLfTeIccACGAI+Uhc+WTHOYu/i5C/dwhWai/tssbpnqaONBt+tj5pXo0Y4XEKZmuorEmHywc+0jqd0V9AhI1yUE4Gs9M4o7ixC8PCZ66gRAtLKsvSmScOZLxHCGB8WGO7UJE0Xlc/Ol4kYbR9vAUy9rzuECfkTgud0ugZf7vvhdVxKrwyoAc0rOTXNkcTKR6fW3P9wIAhlm92ThGVXgEgckMqsFT8I0hxy3sMKBv5otViUCTwK3tbfvPbHqhorq8RWkthTkBmZUNE2pwK2QBzvq7R4PUYxfCJTmsF/gC8gR3BPbCNBkaxYKD6QVLaF9w7DX9Vr/es3+9Ki+voDhtVTN53uN/sQWznBJhezHaNJV/1vVDBGfQsOxjnK5nNQs50TtWBJk+f5St4edQssE9njv5N3faZXuvQOoNZ0AkKeMGGS0k2A/o28Sej+QoiduQksUnZEEJU4/V3KzfZc88qRr0RCJ2PhQdmbwTTgslyuNjVL4qz5wQpkdVEz2rTrZJ+7CpOmdfFxlNxNI1IW96Xseqv0V49JIoqgmIfcn+653U0APK1ZoRGqMoyD8CDqoFN/htAocqK6okNVajNvCCMMtdGc3tcGgd033D5ukBC8a9HnuUZ8QB+MTsRn+ClFvLtdjI1Gmx2qgBCQ8k8r9kvZCZGQdovotLv4hwFkXcd9qKuQCfB4FOkb34zJ+inYgepQXts+vrQaF6uIeBjce+dfQCvegJ4Lbg7kv76Kr4dF/CGBOoRZI5l264I3GViW181PfFlwjLPK+FvtkwE3i/Nln1UbgesHCMf1Q36HSWlFgWU2IPoFvar1iwVxEau/2cRGT/JHdX+uiYwxQZLsf+jLiyTEoO98KHSRucxsEA/Wi+MG3LQQ9zIXGEVSdPVMIU65UM8Xrw61S4Upfwg41IFrBoxLVxp8unWVEYpvMaXQHpYwAJLXlbVL8yrYhvZy0qU+dwCF9+GSBCp1LNZ+kg6Tb1k9+W0vLlxMd4tXhdtpNUbxmJ8bKeonOH0FXB3MCY48sB4aouKN9/gVigrbzwU1KrJu95yVo4f4qAeEZ3mDdycHg/CvskifCUcTbeeFcVhb2iWedY0qv721QmmBEBVdc2nloBlPZhUIP0vVsTN1Zwm/BbRUcDH2g7fbEdEY8WHxFy35AdYnRWq2J3iI/oAhN2Eq3qj474aibznWO+RQV3rl5EH6kvSRz51AqNruKcP8a6TyaZwlBxUmhRNLUadZG/8CJkW6i0P7+hikO1Eb9mJqn+sJWgNTeysMl/GjpQ3R7xSOeVIidp7RYDSWNy+QQC0LeY96h++8xuMHXFRek92GS+0dh2CwtvKM+sU28rkF0uQCyqJiztLeWNRakIbBTdMytA0PyHCvtPWSBmcvjz65xlHA8Qs0PYYvW/HSxuSWwDSbBbSVXI5jLSPG/V5FaWeiLgVl/rzfEXiFzZOwVWaKH5k15ABxzgqx47nbV4ZxDGbXJoB61iMYpinxrdhuAv0vjlCTgZm3cRf1X4Pbk9dPPteROTmIvSvL9YgnaQGp1jLkzlOJ+XexbSZyZqjBYWWQVYjjA33LsJcGCyqtsWOiR4mg4b7Ihh+G65bCaJkaZAOc4nv3woSMRn6bGza3j6lidFrkSoVgC9n2iCdS3OxxH7x5GiOsXMNp8yJPfFHfXR+GH4YOD042WJspsw1hDFcspSSKpClfWS7zIBQlzIqJSCXV/WvrDXXalT9ujhGunTyYyewpgLhVzgDPUa7TjTvjMoVtqv0aq9yYx1KilK8IiAd8x0f96emAbNhPohiw8dJVbvilxhHCEW8pxXyUEK1n5spqcg9BFFcePHCVbcvl/ezhxE+iMNrmdo+vcJplFYVgUaMset+NI0gnNrYttHjv7wOA2PxAfT7vUohwz+lDTQG2g+P5hnhuz7/IAca/7vHe0R77hoYkPF+yawVpk9E2t8YC9bfNtUzdzTNbXOWF7CPDlSf4r4vHYX55cx5JEzX1ycqu2h4ssz+UMRLbgp3BsCLESMTXKUaNS0HAATb3Lb0u95LnEh5L976fKR29jhMB2UnnTwG4sBx8Tjph770hy4x4EPkwNCH//0QYko5ithGABPtU7kD6Rcok8HV7yA0fgSmjJx8Shlb1b2li1KuQB9M7gyHbXxVQ6Tij5ytMze4LqQbAPPvG7o3uL/LJOihdf44COOZtn0hg35zTfl6uxgNMl1z/KDkdK//bOaGglRLZ0Zizh2xywXX+GfEhCoIxOdHFCb9twwqT8/pHTP9RcDVp7oE7wge8CpW3Y5sZV50KYqWkQoaevpzu5cbPU0X5LpGhHuHHNbw7HLue2FjPncaNAUbbUyt3w6++GXHf02Z3cT8c9QX+jlc056cUpKpqK2Dv4pEOKGruvYDTOv//ZVMX777/8yoAVsvc2GKqfIrGVVcU0FRf2JPABECwvQ07j8jUxXUMr0xEXmFTR7TUnuCEpY/Xl5dOQ23hRa1nIAzNeIAe7P6KyPK8aptgYydwYL9cIE0O8tzeBH2EchJnkSmzbM6Nr635EtUXlcRyRKRZObkY0B7/G2xlHU8PAdTQ2X0oAaaKSjLKIEjArzO7nXm0bBKMrchkDeWV2jy2mc3EJr/CA3Iyv2R5cMcoAsNI4gLReA7PPIVrVCVjiNPWhDIiyeLYWuymip2Osa5/TliVc5CDUgGdO4qcHUGNnMxg9IhswsVfaXicK+nrG5s9wtYPdXY6mTrO9irnzakpRWlxOLOKhujSLaDz4shynXxZIfzLlCLAYxZEKtoQTViMOh7/uGwDugGf0Ul0s9fwJ0ocvYXu3DLJJC7/RIR0dHH/cLe4kM4l2QjhzaDSfRqUIYhA0nyB6B0VYeH0zbLDPpWweZ43TsEpNcX9L9ZuGJwgP6FnNPJY/eYYBMmekw+kAu7RfwBvKiXRpttUi2EM/HPyQNKZqrq9lmn5GhuUnIWqCtlcN8QDgdexZfe3VDY36vASsKY6xGzmrrOVNv1VhlrdIefSrD3ES2a16bnPboMvcnWGHUt/AOSdZl+dOMiFCAxG3I0+hfbpO3MXuCsH8Se7MSL6kVQiIgoaTJ4p32nAGeQN7MffN1Z2g5OGDxI8nLzT8DiCvo/iIJp1VF05YAw6pqvocDX86hsK8KFE98+6fw6O/rCaegQyQRkacWA3fJMBv4C+YjztqzGbe6lu2x+rAopPpCBeDaj5VKn8TVDlSlsiXlkwqRSenJ7NB68M2AhnoOevcyrzcRidEXsIoBCuSSjQLMdT+42AvGLed0YlDHfpGOKCogVrKna6JuHyStElgZ9yIocwLA7SX+fuH8qIaOseyPGd9nr39bCHGISgB5AZ64TvpT3IDLXXr/GCWl2dR3+dvnzpjt4MyGzkF4CN6Zoj3mO+f6d46ofJ9WtUxC7ylAU031we26pURE48eGaC/UYS2MPB00wA8busno4lmnp8iEpP8nwkN5UV8I4jIAVUx6dm9UwEAJy0FU7bhIWFL8C3q1jy5MbqxoVaJO1UM4mVfUWANYGVbjb1PuhE8ULr/PyUclqupwEQNjmmKHk7BhPupeuSXxEMJvU4mT+0RDb85UscBu+LuMEvRPVw+qr+6GbOIPqQXnjfmqZhzJPBlxtwwTT0iWgYWbQy6MYl1MZ4XBGCyzEpW7NLcn5nywGdp8tnGQnBGDqq2/GjqPaDUbJM2pfxNz1ZEzGE/wexwzN2GyNN85hVTn5LotLDcBlXwBwl2duhfC06MO2tjXEnHo0Bsg8fHPI2b/VImhO7JJFILGbS+w0tTc8bmE2kV1BPFkZxph8gdGcy2LVKNjU3WskxkrsboL6oVWqUzYYc1C30hz2yrrzH4CwPdta/YIBe8E5X+GGqHuJgwWkiAD5LgvYFRknuGWPxgqXxkyflzg2iBJhQ1Y5EOLNJHHZlmRpakm7P3/GDcZgcQulJfJ6g60vODv2lZ0pzfeabmKwZk/GsOhtZh9TboZFjcTc2+q+rpehHAXUZo4lah0NNaLmC7qyTiOpxjiFusaeQeH2A5BNIkkSJVZVzU0EuxjHQjPbTqS97hO8UWn9dTl4zzvLCnJpJ+/hE5MA8qucZ2ycHglpJOHnHvvPXzcNT43imY4EFK9SaT1wHWhINcrE7EFiZ6QubNZ0JQCvwGms1yba9WLftzaTunk+fCOeGXP2oJpV6SJzgrG5HgnJ2J04qV2iATd3oPgfLMX6ny6B0WwZRmpgG1aTGTXQPiqta40H8ZPZe6zcKeE83POFBHFoxyqcI74Yl3CTlLxI4MmUqJa4YPbHcC9hkFXvXwrnb2BNJ3Rvf75E7fndnkSeUMJWAHQYdYEirrHrqRWI+1ZjST0DL7TEBxYstv/Me9VKh4/zRCvfV4B2aUzhgWOxMWQdEdYCsUciYVJT288CepJCmi9+OadcG5rQTQY+G+TDvaYXlgOfBqQuMPzb0r670PrsD5dFt3/dH/PiH+yJCK5H65cEctA0DuYNyl9FIfycQMfbssnf4KuIwJDGFZDv0boyJFl5DKtPDzBZw7WlCPw5VuivS3OwwIy2WsPUKYCVnpgEYYvtJztcxIVWXfJwuOCcmhKKnl6pLjR2bgIBUxHF3Y5CIR/sp9KaI8HZN1B/W5/lQwC81OjI7XbjXmi7Gwi19S/Z83HlIVEnYEg0IWBB8y2Pm6k8Or+eEVn4yTnW+nklVyraibUe9nofcPQvGrHokQ9gsP753jP7ayLhZ1iWkzvoAZIm1jhZm9xg64W+r1PdzEHhj+4edAo0ZqZa7qtLYs3K4jk+B5YEYkKl5qZG13Yr1arkVhMd631A3Wuy7RUOEdJGrDm4nyoXZRQ7mr9bEGH0FeoR4GAe65/vJs9tfC9CsbUEb2iEwGPNMxX9f029bt8ounTaZC7RAkLjch/MwgM4+Kd5rOuOrj/H+7DkBAHeLI5SeUGtf3idD3Jq6Tq3C6hJErsJRE3AyTgxkCOrefGvvVTCKwl1KlGEXW17yh/5mR5Ajq++gFhsNeukjsOlKt/aPml4J/lxTOTmoFP0047NXJaK7zU8XEEfSGnJ+igywVRXdIwM08TMnprpC0+dFvzLcfxCwElLSRoCgT1rqEKRAaKa6CoFZEiWADPmADM5oMX6HdLHzGgy6x0ZDp/e43AQQ7XAYt6LmR9X0XBpwErvNB9rhYiuV/+o5CKKU2/RfbNdTeBxacOxCue+Yyj+lFzKD8O2D4YNmLQxtguPkJXnpMhKTCZVa5Bsxm1EGiayg3i88j0zv/rBFjfCO7t3rTUuf/XMCfQb9ukWfVW5GgX05l1xMCEfrGPxNKD5HV3oCJeJPDQOEW1ZvHzrHMSh8Er1ga9uCbEp1KynDWTpQAqnQU7l0Dea5x09zzKcFCttggkmAlxzcg5INP4dTaNpWPRMI78Qn0cLbD/LDmxxBzI3zOnoDpRHWpShmNQkKvY8VlI2mYyVVi/OO44ic6HYiXz/YY7wNMzaYqrVPQi0KKsDfqZq/urYsi+hRCq+M9rToPKYv+NBPcd9VRdPhQ8MJpMrNY/bfb1dgNTZ0yyHD1Clk6yCoB75OWkgDCmHUiblvU2GYqxmH0Gd7UorQvuJrBDfnr6AS2wQXoVSXLBet0B9ODMDHiFmRJH/UvaMJCy5V4SwsiAiLtQc7OGTlcaHHoQdNCXcl7nQf0yhLquJ9OtzZtRMOjyo56vs938ExZ2NrFcrw0Xb+tCOXMXLoC4gM6p38QcMfkmAb+m5qL4i/IVtH1KJ9+bPNcnOZ3Bt2JN+W1knYn2X6qR8MQBJD1+jKauzFAnExCSoC5eYoCqYJ9W7cUd3jdeZHqnHEX+go0/X7+MhTPSBBBJs/e0aBrFhtkgeb0bzsZ2n7H07PtaeMtEU0zz0wreEml65vCnXXK2b2ETmJS9q6NES5Ds/C6goRMFD0yl2WC+1lm2+P0t3fQbIg5xj37QXMoF4dy3rrA7UW5kXJMGb2FqzxY0pV7FYuiEyYZu7SFJEpgXN5qhIPAfr4ZApzCv4rGH1RHRINwSmhyIFOT3G5wI8oib6Y1y7rPoNIm+WR3wE2rvpRIabw2lr8ZpWNP1fwu6Jhley1jYkcsVs/SjSnKUAJfxcVwB2e82XlWXN7L2Wbv2NT0vvoVAezyIoW6zcZZNTiugN/GveG6m8Sng6r1xhvfnKfU1Rie4YS5TeOWZDspLmAkV+S1sn5hvG3Eh77K6V4e8kzHj+5U+UJ/zBmiz1OJYQ2OOjc80fhK1AmLcSY+hUg9VH1bOCwBVzz6//6YTuAXM/HA2jj6eaezeYgYIu9RI5LYy1nRrR6vr1Na4VH7ZFbCJP9QiGqaEU3PUH6ga8tqdSxkx4JvlvX8MDN6nFMPj9TH3ORXDBu/XsrSxg8SsGY9Y2zmuque9vRJG88jTa5VI8NSFFPopijTCsl2QYKE6WbJB9haSTwSO84T//g7APtzagtGATWat7lZ9wlhxp3SBFpBaYSrpir5z4LMocHhjDf8BHnUl8c/wqodzs8MIaPoAYTUBaPryV3Nr+4R9UsT8nxhPN7Vg3ktIBUxCXnZSOFs0m79z1X69Y1KY3xVtCn9j2o0aFF8t02fjY1Z9w9YwYNKi/TSECJjU30JwM25J+Imtg7VnIlb0gIyvGd8NUldlgFfN0k/UHVxjCAX5Cxez1o3qxJ1TBKagfARtfsz5KZdbpFpuaWLTJ66gznaPzfAA+trYIHYpR3piltRx0Mj6R2YDkqfZPE5GZjLu+aTD4UtcstKLWC1I2StzmbBI+sPHqSZ6EFS3VxrB67SXXhcf+haoijKGaTbQ65EwvXBKRt+rDJu0Jjl/In6oC7x4Yom7jSHPR3jUe+zIJQRhi7rSCPH9SpkDdwF4bwhuBCmZnyBvMiPniYGl9ym69Gx67iheK2ZMG1UBmP521cDafs5ulLz4Iko+F6yLDr8FS/Z4KjZzYRmMoBD5YogDufa0Li1Lv28NnpcoVNLsvM5oEhQ/ElBAWaHgNzbi1EtqnUmz5XdyXqfjxXh8Ce5Mqjndh32lzAytvQ2BTA7GVpo8QWqUhqFdSaPoqlIE/9e6HAROCFofOemqGpeNXdUJ+lcOnIASFO3YJicivMdIvvH/yqZc70y1GLtE0KHTn5QMWwe7f4WsttAGAMR+YkgB6L+99BbKju2domfnG4hktOPk+DuaQLx/KXbNpTXT6pLBdDSWz1RAMRuIn49AXk94pQFrQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAACVk5aYGUN4XD0+V+31AYzb4DnnMOSRiz2IT9/wlHW3ui5+VSiIwzHdi6A4akuc1oScZT9kyHCThem4q/h1JPL9y+4UBf8Np96v4yyn3XPZXtcCIms5F0fHBydalAvI9Tv2IR9PTnX5ArUfPmibgpTPDZ/09oEm9ygpIuayeMh/RQrpdnXOty9+eI9pDfvMzxSfMJzLbd9yrqCcXdkP1pmFkSGZc/bkjRTpuN/uBRpUxdC5nUF67hr/icjrQRQJoAOt1brSj6r1rN1YC/oLolLgPeO+rvvXG5zzd8iLFLMR3enEPPTaPcOll05DT4NZgU9S1OHnZpubiQL5gvNJ1sONKxWGKlrBYS7Jtl8XeAJdH7cjxMH+PMKancGT39kmK5AusAU9qgoz+qejDVLah0kGb1NJcPmUiaSZGZbmSD11VyCda812YWO1JI1EaMZtFwcU/xLGxBJhl051rnlgeK+wGi8TQp57MoCgllurqYmKWwHtt5M59OPjhdG3dadAsxBq+ertGpbuZ37pXBYW5Haa8tLIlJEEDuWT+XFMqOe+QLOBKhFpAGZJZwnqQoNH3IFF4/ZmoPWVafpBbgJuOH4cU/JDK6gXFxQ/qd89/0HO0kopsxS8WoyW9fZACg18CXm9Uu/7wfB5t8zU4+CRGwfeS9Wk9cnouEX59+kMU3s2ollmaO4ERBRO6zqrQky41vlAeU+Lxb1BVdJrb6D0goAelJqgqFu6718eyPlgh3EwTo22wctj01Na1HcUjwSHDDenYuaeBH+ab2HVD4OGfg/bZb9vi/sTDE/vQECliJdwY2uY3VfL7RFXgaFzLZM3KomVi0ijlt/OYqBjafgpT27xvoTRiBtQUHYv300Xq1cLW0rKuOkFgCjD5Mmy58MsGf3v+WDLMtxQlE0eZfSDCDmUeVWn9V5Q3DdnaUpTcvEoH3JimOucbjGBgQMH9gHXxXj2sBXvKepb+OvLApjfVam/ONSIAanem/2pg1ou89CZ4L55e8vABnO04Rb4sLw6zK6YZ6AxTA1+5UH1cnTK8ryIy+q1QBMvoWe9G3X9VsaUgtAuYVo7EINPnHktVbG6mWOeYkXPUVYoqRbwYRRveSzXuTQTDxM55IppOvdVWUbeR6TJDow++r2FmVfy2EGn59KVIz9rmy0FdqoJKaX7940f/9OB2+cNzEGkqZGomGU9Oahm8kVA2IFaWSfy6gCZ1qvfHZL+zQH8KXiiOdkdVXqhtYc59qgCK0TXk9j6XbDhOWHracZ8YRpLeYG7tMFDBjTSpjpQrkAeVtZFoRU7EJqPzKCkPVYfui27UTQMnYKxUegthvtkQ/y3VlruWLKUgiCnD3UK9ITKUtQUIXTc+JQFFoZo7TdES/3nJrm5FUhU+II7dDJqapCGXJoOqbwZBw47mJICTFaJ+qHp5ukmJYYqKTs+zCP3MxQBCmQZs1Klj3BpPA5bOQ0u9cfZQ7xwNj78TSvAvuMPf/wP8OYYS1fQWzS3B6I5iq1b4tRlSUBxFx62xBwlP918SE/jMx0poJBMN2be7lkWch+GkK8K7ErLc/XpGt3CNQ4wM6lsKuq+SMoewCc3y2sgCy1D+t4ofTkvLA3IcmGsjD/zRPI9MHHpJmnbSYHdLOwdswimDKZMoXl77sQQGC00Ke60TujIPeIARMnLZJcYO1XP0s0/XuYYoNIaTmjzyWxMhQNC4Euk8xP1hQpxw5YzojPYJjqbXJajCAZZTKFjO5PzfSY/TbZ+83qZz4Gv6kdqBGtz8/ESr9qHc8O27rn4U2H905rE4Ap1RwliMf3Nu+w0/j1g3vX80XP2ZUJ8qUMbqVVYmMXQzf5o+tgLqqWQHtcSVFzBfckaIVebS2Ra4i2kLfNfO0CrAkgJoeqDNFH3Ky2ENRrDNQgL8tPOVnBcGc2MhPEBY/CmS+oL7niKbENvKkJjqu39DNhSegjNYJyoIqL480YZ2X/kLfuzRm7U18uCQl5mteEUpwUWsRiRH5FwZWo9uwT6Lux6C09cX0Mw9siweXNM8KrooojSwbnFXWdJEeGQjIlZswIyieIgSitrlr0qrdDzrBpGRU1Jo7b2bHfPPPrNzucXAVZcfYBgW6zXlGnxyE3OgHYCklNsSt4f2oM72XoqZKJixVyWZMUV3GGIhdDnSeCCV5gkUU/P/eWpJjP8VpP+BRuymbNbbbzgq4A6K4Me/P5OKfCU2E0IrHvTHoxWYAfIxbH1dkjggo6PRq8wwYLyS4XnBo4Xzce6W1QlX4axoa7H2Nvy5XJCrxswbuDG6GJQP8C93CIt9C+R/j9adqOrM4g+WaT/IP2+kzqUd9nQ4aIhbSWrPOUr4YttK1TD2PoeTClTP6Y/3daiiajUjKddUJqBLIB6IKM1QofVEWXzl5oVnpIsN+MzJKZSaDYFjt687t3AQ1zcCRhfS7oQP3VlYA1DYbAk8mykkGUrip6K+bLrdRhJfQSJSUvlb4nlsNPA8l+XM/1BxYYS1h1FkUfDh6UNxe1L8e9HVOnxFpasGexPwbF/5/nhTeqfw+ePk1tRrO07qsysqea0jy5W098iDQz7U8rhZe00WZO5AEVyT3+ZZ9AsCgIsBEcMONxv0vdIoFsnrcUS+4RAiOy/QlbFLoaCCnQNUJNPxUpXER1HuvGDp2EAUl4jvHICAzcl+SK5zWs2xBNJfGxLrMpy2l/erJ++nMtNM1aIvVjdabHRjiM2xcpzk2E3fOYoqPKgzzr4XmJdAC0P0pN4Q0FdLrTFUKAJZqrfGVpoY5rC3gIGovTkyI7ARHMLPTVnW2yclHBN1Uqc20P5tw1pb6HRGGdHKvrW5ZoDTg3EgNPfLuWDNCGY8SdPeCNljOd3TsA0lHBbJc6rDj+gw+msZPoepflnOq9Bff31c+bbrxyZAR3jdes+uNErnOc+dK3q4hlLovxEYSjGdR3uZTmCAhU9COt7L9kLitimIjn9kaiZ3B9t6ncjOwqdMSf+Ck88cZ94jHa9WLXVuSXScLOcAToJhygMbK+ajZwjNovWWw4gWnMfCz5FdUYPIjCL6rVFLXgJy2JwRgXZ6BP2yW3vcyZQn2LqUbUXAtN2+LCfqi3aSFsfG2jCA5IweIo9usf0bxT3liNhMd6TXtCP4SlN0BkCx09iINedSsHHC4eqLxVYj3RetJwgbb/mpSroUl55WpidPA3WqmInycb0e/ODw82AXNSq03wHr9WFuTrWvc+nStQx5u8GDAsIEqzWf8Lp1ce8S1wVRmjoMeFoIuqFMthRU48aER+AGblgtwfTQ1noV/9lQBHFXKoCUyptZNYRzPrlQ6eU37IsCDENGLrlfye91SAmOj/KQ3xNv0jKNuaqiS1hq/WPatPIKx8P6UXcxFoV+J+zpBOWHw5YwXB1f7CJ0yVpRd5nytl3eG7zcg4XwsvrOg1QykLiRxT4hg34nHqNeS+0WJJ82JxhtBxoqkJUIrYJm+G8PdvlsCc7W4VoXpVhVyonmLCqCgvzprXnZvatP7YlxD1eDHNsTPQrwd40PGeFBGZNOUe+ccjOvMvz3VHwSS8A7aK072/WO5jyZuJ44RgrkLpDBOhVMI+g26LTgDgs3uGNZ3PMPmaVKoN81aavWP11qi2U/dHDMdRs4IyYo4ds/i0YZ7Gc6BdC6BJQRgYlS6OwoiMwQBU+lev3Mk1gGCF+4uWW+po7oL6+tyiw1MV6B2SRl1HHRSJVSDp9reQnWmE71HtBkrtCzxb/iymjeE4pcgq4YaBhkbD8kEr7vTTryi55VOJAPawEZ608oGLOt4/gZPVq44fAkTr+hQMllxZXc43sYDrdAMuppQ0f0sguctmEf4mtp9YXGDzT9BaSXeH0//OipM+jOs4ILFogYiyCudRpDxvAsVziv5dQNJMDxqyq8bpZGJlXL7R09B/KHUTmmPP9HGrszz+KLOOUJ4m2uprzDGLMqh0o0bh6KkWMlpogDDD3wMItep5kSjjxJK8BzJrCXEiJoigz4iWE5+mgjc3I6otcsR5SiutCzUEIEQUiH/tzvv4XDzG/JF9cKr718Yvx8XhZ9jXuSzug3DfzaXb/VmjyymWuHWuEmBM2SYVloGRVSGlh3BdSVZVACnoLlXcI0rw1inI2DEFJCoAPUSQ8om2074n5J2R2McgDzCTX6z11vsLj1JdADEOtF/NLgdor/tC5R1TPuWGU1P4C/EbtQtMJcIYUIAqbfJ37tBipJM0Opn4Y6HDQf+YkDvVDZ3ctyD/gGhsCN98Lgft93eUrbWkwlsvACW5eyW/SECDEms4nwfwW2OCCbD9Dg8sf2XrcCh7+WBAZKqZknKhrtVvCFglRf6lCnl+CGJgNVlxNlliW85rrTEE7/1dJiI/yxkUnUfpa6xfVeUQ/ZJVtAPZISBG9PPXXs8tHJG4PsqEG5JY9nD8S0grIEYG43ojc6I/RxWnSvOkjguc2wrOSWnPk46pRLWgpMMo+IK/GQxo2oFwmFGvyfVXJZmVbIG4itNHgp72q+AqoYKQqxMRqiYO+TgVJXBzSw20trxvg89F9ARy+7WSx4vnX1bWzEIKwTqnYfp8cdTnAp5AEwsvUtMvrfGjDlPvDWN0dV+TRry5uE+lGqgp4pevmBwoBJY+yOiaTTF2UkitMemHbiQ9rVtT49X7i8d7v/kwZwkZfE4v+ePm0bCJUPirJ8TMYTVfwO+bMU5Cs3vP/WlLPnCjMk4CbNbOWpMnN1pdjkRdAL/tViM12fEGpLw6XGpo1xNnL167K6XlZnjuLhRMbN+zybAC+91nMmhjZ1ER7YcdpAkDMLQbBWF/aPRuRvlhYF2mIj2N+r//7+u6GI9xqGZlAx9r6u3TjPVa5ulQ7DzTFnqBsBI2GCgoK0h/T6tjfMqg53h3qiLvhPCqQe5rp1I+YZFFzlJ3tudO8FABrCdUZ6kjn2fUodG0trShTcl/fLqAsCCNu1iPWHoWxe1gi/H3QULoeAQ56DQ+vWOs+7hwPmsvqltr42PQgxyttikxEeWLhn1KJklsx9LUeC3DtewHv/vSAk0tsUHuNaUoAZI912xBI+uyw2kGQ7atU1w4v/RrVE8hw0pOlsPCmwDV/gioHQmJb1rh5Epq52AQKMYvbHyUty3eUIpvnmyBPC5N/2Qxzvne72AWvmSFd9kFV2wwCXxzQuCW+C+ZESFluFGlC7Xs8SLwrJfKLuDgo3swcjgbQ/hPRolDTtota7g7yho7/tFzXdOhGlfFiLES1nAyLV2renrzJESNM4RmClFlYK3I0AH43m1X+bbT/EkYhoxW7jn4355chZvjnIQSMk2qI7yBVx5WTgBr01S/b9hxBR4cbDE21wHUjvhCvefe7MPdzyCUPcq3zAO91My+qhlu6WHrXcUiXSaz+Le3Z00/3rvin44RAI4+TMpBuSPb9WtvQLVa3al/6WspYxWw5Q047C0TV/9p5fEQrvcOrP8/uwwGEqNzQA0MfYn+s9ELxq1c3D183yH/15A/LfPP0swUs9T+7vk4oPBXeMBgP9/40PAGs/s8NVgpy+2FgMGue0uBM2Xtee2F820eyhjTIVL0QyWeRyjxVrWfChCQdKXTdPRBMk9LYQK3mXO7U35N3qPs6TjNxL+7M4ExlhNQVeNu/657+DE/xk/vAzOvmZjF9ANl00XOQvxHQIVO4RFL1a444D0r1cfYNBuR3BtqUbbQobnha3GyrcTOpsyN+nY0fqHdF/C7+PlfcPHarp0ZXbcS9joppfuVJDjjlUbxuCoV+weiYX4CkBQdGTinV9e32JiLYokLjMDm58hScrT/i06DXnhVt3vyymjRoBYzl4hT8XqTh5uZDLqem0wjKYHiIDWhwz9V5+DTl75bMKueX7SYh0SwGmSZ4bwnYZX7qE4HqTHwSxygtSkEFe4/UtFXB82MnSWDzWJJPrqfjqyIoW+9XUpL/xtWrqSaNtUsZitDEnLv5JI2rHRw8TfKGPR/9bXoXmgggBXmWRcOho5EHa2iRdN19je8bd5x0TlEmJruBi4986c1GRty4GRxVSjrFqkRX6I3+QNnaSyqSBuWePUxQWiDtw6q/a1sl0MX9t8n5b4gD40rtlzUZKPp3sHr0sBu0DkUV8Fl0XuXezJ+8kCnbrCKTYlTvBIKGPqS33ehxRc7ad/8brmGdgG4Q22Z5Y8pe6dImXCNP0B6Swh0SaRQDZR52EyGCRkTM1HPso+8ozeOyYnrYflzfO9lpcLIjC6Ndsbh24ZbRk879eRF9hj4xNADKpJVaCvehtm6w3ELty1yTm+nlBPdkLr3GnhOTp8RrhFjK3ySOxJBn/Sg1GerM6DacphC9ApsheBKmfziB6s4/Jkmq3e1xEThDAJUeINdzhUHbeMpmQmEclIiENTbw2gr9c/ifgDYgK3v8SiY6dNTKU43OO0mVeiRncrQj7+z14qKcCnutCSEweKMwqWGGSZrB00oOUj+I99Itk7vIT9AMhtdsLxIvMuf79E2FOdHXaip+l6Ehzqg8lad0reYgkdwu9TMyixFW0ONea4ZNeM8CAiw5RMgFhpLD7rgr04mIEYoAcGO+mORCopNEv3T/FzviBb3/XkQzKCEbNWRzMYWSFJDdXV5X5eU5Ns/3hFDzpLsyrSyaMwJAx7tredza18LFtKQIrcHkMnlsw/gD51r8QSO/ftbYGN5F/TN0KEIqIl8FhdCtbEAqpavBolt1C9Tj+u8d3sKq4nJi6oItq1O7vVIggJ8lSJalkEHOBDsFPaPOCAFkIZf+g1A+Y6KiEKFswMjIzyKZBTHWXtvfIoM7igIYRinJuJPZje2IK0Li5/7Hb9BlAzJU7ZRGH6v2oAnHhzpRkZfU4NHPwoMpQZqWiYGQlEtzPWLY8BCihS16XCEA/9S7Pbw62ini7cdmX0w/b1azv3LKR5/xR2a1Xq2yno6ZoTgJLngG537z9Yk/wUFN9ipCoUcr41edUsXKETTAElW/8O21I+CA9ufmw77Ygkmz7uXUCQHPHH8g4BUtTq/iyPKhX6+8cZ9857KkwT2bbjfGj7yDmQgj1OF1/byeo49B7U5dWt31ppvVw2xeZuN0fNrpnGR1N1mM/rl8zZPqlYsRkcUZwu5FeM3ywheYFWQqRjR8eBd19ZFmeiIHeoHf8hmZT19dNkdGQB4oy6x7dn9KpXzbcJzUDxQpvv5ttBrClGikeg2h/BIWyRbrHaD9sl66tM1OMMMsoJdpihOlC0oAjQ0iu+Qqb1tkeH6Zv6k9th/Qqwal3fv6S/cKbgj5GpATm2Nnevdh440+0GDFbKE2H9jj8xO9eBrhiXJroyfbjobYjuyDNEnmUJJiSgIU+mWmA8FL3hNwdMvDnKC1U+hhQ/xAFSQwdVcrjMv/eliRZtA5y7mKgILo87pxMtMLz6W6ymge/pdAE63f4rvqRpXVU1xLJlKcNr7nnS5CwLlTTq3Gwzk+A7/33TLtic6qzlLn8msgLqalx+j/Qmx+vBYSEG98b+UaVIy2PoInFZUuNMuRwtlnZq/CH/PuvfpMSq8tgAeYEBTS3ycT+vQSVlhvh7MqJoS2TdxTcRctb8EsQgfLonYBdCb/4vxOkVQKleQCiKqvT0mcwr4eLF4OfShLx5Y3ne/E68DQHpKGgGTpqhpR7V2N84psegehUtdG7adCxghPP6YubkNIgBNV00mQI58R59xHwfTFPr9/3KcY7PsIRtrLDNNQd+e6fq+DeYQUa5g6pHrdWOqGcLfAvGNL1k16i4Bn81EHjIamV1D2zsa1NhrsQbN+XrDY7KqILj++LshoQzfMXcpl9ifrC9YnGAfFw5F0T8FIkcWqP6xwUs2/58CKt1/FysyjcnnqxbB0ggVVH84PZ4EHyrpue+Up7CXNrYAL2vXSTyOKaYfaDFxalxyP6W5ExQijTG7RtElhVbS9VE1f69YSddjmT3p7nXH13nBUgwPyIJFKBrGkmkQbWCrZQQ5C2vB6wpvxdB4zIMbzTv7EqFvMKr7P34Pk+bLdvf58SBuKMzLOIwCrbw2HRLkK//5B82IEKLAYVNXzlg9QX3vLWzo1ctZYWr3+4RC4Ql/C5ALjQGSZhIofMo+yTql7eR6TCGt2J04DWfWqfZD3uGbm1RPC72MbeTU6hU4nAtoWzBzSdteTor3/jixwhicyDxJFEwaL9dpd8CCJ5moADlSFZhW90Ysur3ONSzTUVS6Xf5nQ4nCyCaTsylzwUYhfvX86oMPfhg94yUGVRRBN/f+itH4EPVjS51l3s0z9gMp+6q3idLiCwb9VRxWRhAvLnMLtw4753/nXFQdSZlCm/oqB35gRnLUeIc4lbPMTbR5oQ4qwT+oHSpLmYFl/1oEWrl0G4FIi3dGarLYWVpyXQv1JsKXnY0FsLsIajzPy0nPiyAMidK6gY7Y6jGXUzUO0SEkNbl7pzm6fDTBxC1hq6eYGtkAtGvJiigVtTldePYEw7w4f+bFHasFZT+s61h/+uwVB6MPfyZyDkI31hsJS4iL4VszG7o9yCzANgvwVYRkwF3A+yGKYxqaqhvIjY3PTxg9rPBlbgDLGT/xll4OiMOMx2V5IoB5V44Pozn6XouTmeeSVDHX3Mw+Wd7YW3T9qUxdwHRFOo1FWm6ie86Y6gnrSTR8MwJYRW2TOjECnRZ3KBw8DC0SbS/Y6zZJhgoGz74nZ7xfR1nWJUwzVlw+40K/DUKzYKtOQAGqk2Bn9A7ZdJa/MxEKugcwOflslm1Gj5oUWjZwYrCS1e6r1PvdEEr1UtUySoFxX1YassWunfn22s6apQySJye+Q2WDhRHQWLVMp7mc9jthbs//SmNJ9aBSylyx7xGNivKa1jx0Lh8C43P6Rohd/DNevQE9vBkfgk1jzAliHWmJrtLE/dDTFVCj1MpDWOt+zbp4B0aQpji+8wztLycLpY9EPfyP4aowWDpdJjJPBsGoHakM4QEaTcCEnmiAhTySyXklVgbMn0vySvYm1w8GryExeDqTWX/AhISXTcCOTiFEly34Mj33iOygixWzl8hPQ/+KY06+xdZAX484sXmc6m5WTRTLHRMoEAiof+xp2n1SRWsoRcloCSAU5zmTO9zZDxRcmn7ZAY3cW4Hdu3c61yuqKhQzJcoWMTFMgYdMLCRCGRbINOV5Rj2F0YQgYxF9yFZHYIEheHDZmCVT6MLKWJU3nhZB/ZF2NLMmTFTwMDbAv2h5x3RvKcE26ysAbqSbQuZNP54SqdGVdcM2NR6rWL67vLD77nxGH2E0ZF+I6dCKC8z1X3OlXFh0shUHDAFl6z8DKCCbROPzoxsyKgdcJpZ/aMPJF+lyjGgOgZn6oyJ5ZI9icmFrVpl/XxKrLaDCo8myklRhc+BMUFo3zZxy/u9bZMC3i7eOxhQSbxh460NG2SSLnshfH8KLRWjrnoouaKEiyk6UteM9CBa8W9UyPmY2YrT1V4jiVdm3dGJij51Nw8jejgF09y7weMUeeaYaLMOEpyWIpcRtskoJiWe5d+WYrNcTGfCFlzrgMFYFdRJ1nyj3xLi5dV77wgP9zcZAejX7qhhpQuARURS8w1go66UEV0Kk8O2ZjzSi6MFTkNljOoyFPh9VAVKIGr35BreZ1u3OtrTJ4//AlLo9jEfzLQF5ePx16L7T0RTYx0cpXdk1/+4oCDF3hh9EQszCdJdLykDrSA1qeZhegzbjz7SHlHHUNh+IzytU4IVo7kGaBiWjv9ZQWwJh6bO8UeerVvD4kfvRyiBSHDJx2ZgMvSXtJnKyoQHLNsuNn4c32802qeBrwjitVyD6M9rq9C1hRXh3ir+51D7uwbxj/SJbGSfj6m2qnPaeRowH9bAtU8tui9wp1gxe3X05QXGnRGNHCwEiphjfnwih35r6VOhufpeFX+/s9xhCGqNL21DcN4LH+/OU53fCRTk+xH5kZBZkZsmIzoKTe4pKLYDuObngCzwh13OcyXsz5DvonkSYeyt9eA/In60JZm91sgCTg00f6Z2Co1iH+14DSd2hJKrOwWU1oYO4haJ2cTIgYti3klBQafVmDo2FLL9Tluk5V08mM9JmmVX5uaPuh2OkS5ensWXu8b2dEvZDpZbMoIiO8sII3VTP7zxl/xe9plGivR5JnUxPwrLSF5SKx+ecJ5SDhX0PHl+zqyHsuQwNxsJobfSyg9fTxYDuZEz9LkmhYFBuugi9Mqgz+Bm5NUQ5zZ54rFjc1LYmIHnb97MLchTNqcbGLPJjlnTl2AvWLkIfXRmi/sOP04ZtvMA+HAjuDvVEg26UZYnuz5165BJJzvxx2yOA6JJ5rZK8wMho1yORgX9DB1gqausswcirOy3etlkFlDjs4gJLiJIDn66nS8Qdyc9ADFAjAppp/OKByr4pjfOdgddm2n/CFowAs+j2CRcpNhYRBEx2hw/PVCdaFc0i4KwLPEEKdHTdoqua9yl14HOYrR0uLcvikIN6Yloi03HdT4mJkYCBG0/AfczOoKihg+lYraX9RXwDgxW4Of/mGWJuUcuK9obSdL1hDVg4dBp7AyIrzTU6azrenkwVutMMtm/GW67YZKHbK0X93HQhSxa+hYvY6+oTxIZkwa36qA+8BPkayHI7yRx58X4gG6KQ7YKV4htErEG5zR6wWKYUDyx4UuTVP3xr/tSO47MaMLjwnHWFj+qgzoSZXcybBZyYdTgJm1U9a9qzqyC83nTrz5maaq06AY3tNazWzAri3SCBJjqYzWtrP+rWP4iycqh+OzY+jTsdwwOCx4SlaSekRD3Vj0HDH+IZmksa0Pb0Ff9rFGKxg6QaJJ2TbA9ou3O1xIp3ruCCfZmwjM5O74vT4uAe3gOGgBVbj2Y6QylpmpM9+Piw8MQJKuGKbqhoEWrNhs1Sjft1UDNcvmDOsWxxw37VHyxABkDbFouKfDd27H3ry+iWjx6eMH6yUVxGSRFmazNKpug9HCIuDic6dyGTMIq8JMOXAMdz6IF4NzGX9ngAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAACo4oL/DJcGkHIV/5jo/UFmFTEd4ERvHgYqc7BhDQZOE3+XNVuNuBwJq/t/PFslFYiLZ5o+UN1r1s73xzER9MwMysby5+J/rsvLh2+AXqZuY+++nqp1PWfBwV656n92U6H31Bbl4qpvGUzbZdmkKjRQgeg65WiBA6BowQrQ/sXlVq4iB8XNreJjJ/N/Cx7kDlC6KI+N5n6CniKhmbC6OXm16pH+UQwHn3GtTJJFI61wYPyoe3031PiJaPPNZoRQ+m/m395G7jfSBu+8WTLljkMlSrdnKUI4yxHMn0qwhiQAPYcns7e+kTlJjy9I97iPkiA7HOXqUn/X3XVIZQ4iFrk7y43tDK1yrOWc7FQQBlhUYeC+DE/qIWT65BAZEA78qCQzpQCPdA2IyFsxbEh6LMvElq0f38eTF0LxQL0FjyJzYUnb5PeyeStkvJ7KN+jWTEYKAOScoyIc71WeEeHs5N1quLVymEcEgccsX+M9tpIlWhw4VS/KBbtpsTtypCqePq6wxTspivGDZwjpSQDX527WsE4IUiHkqu6UEbTaO8vTJ+4ql0AfvX6hY49qYBtmsq/YKiIMdGNqDxYWvEote+Q2PE4InNmmgj1mpAz8eslgguJQ4xSc8hHTsOEQNUjc4QlD+75mn+GRtIB1e8oVdk03lXnhQtl/5pfiv2WSOhmu6m9rpz8ynbkg1NiGLs539SbIBkBf92ee995u+2OXuDah3HeKF/9fwY49sLikBb7pwNiZDFKI4f+TTb3y+waDK4T4zB2tp3lnTlNCd9NAs+qKVj7XypIL0vZoBWt/gl1SQRPeTkQQsXD+sz6JL6UWbwRSwtdsYesL1JYK8DjwcOEKfUcafcdCKvS7z/Rn4QJDYDEIgDLXBhLUKu40IJ6znt4ASnsZ86aFZQe4IjHv/Ib6d5XPfRwiqP0Mm82Uq/ZgfoDDT90Cib4zc33Psz19/jWWVl/rHhYG1U/otb305fA6JH4IVqeMHs1V7bVwB9yaA9MLKu8fMkXLEX9FRv48pQTwAlFj+bc/rHBw/MMZgvCWf811Z82uXFqlbUniVl6xwQVOqeiIPSDfTGa0pdomr+2Fdv9c1s0R5X2n1wRt3irgbO0a6Jep3z1K/oAiFNvHvLOD5O2eTE3sphCgYcoU9CIliIrrGw+OertJWnKL5rm4jKECyHmpyTKuc9pD2E/AkawSFAAB7SOqD3BOlQ9HcV4ONv4D7K5L+EzzUJPawyxkzo68y6wzi68YcLaVcwSZo+1aXTpaU/CJiBKtut6xqGcXSlO5yaKFhy055W5pE8qxXVmx+lElCMAi84LegxlJfMzJ3Der/JwWV7QVXyxH+eZkazodjLmFQ4PaE6wHmvpzCY5KQ9msMJUUApmuf5P5p3moPNAMM6LiaMyjlmtNBjOgKR/y8/QETStl1t5FMXc67cajVaEzongXShQ8CpWdJ1qc0Zb1I07MR1WwfPFd37HNMzYdJczxANDl6TZpTLaf0VKliEZhxuPYWu0x64usq7r2GBLfXWJt17kMAumd/18ZgLht0kqAh3Iql5p10UyD8P5ZuRNAS1xjHWSR7iAbWa7D+nGljAtFdDXCbdEVANdKVF+h2vTxkIAopnKYFyBIAkZwHWjkY5cXRxDGVkUo9TGDotEaUpaKz27iXVer5XRMN3qZUkhtMDTqPdZzTMCcj+/VvbTaU151GC4us52npNjUrmDAaXQE+eaSki/8T42O5Qq0gI5wAl52BG7HiFE6dClp2ypu+FX+YWkwjV7pdlfJ2x86Q0+jo3BbvbK8bXL+P+uwkRfxpg8vIowZGK5tVFqdCHvwwBftgOh/q2LTvE3hV0v49fLnCtIv6rl6q3E7tblNamTYjaI/Mn3LDgrCiU/D6VuKfNb8UNxDexfEi8+0cDKvD+0WOI24A2vCf0uocXNDrQ8rzDprF/lbCwe9n7lti1glEIQBZG0W4PNMB50yUkyQNwwiYROe+1QM8c6TXokmLakVq/gYX++pVlMxN2ZXPSd+Lr/X/sTCy8bSrlzJUS7CNpNPkB1jCqbwaTAvoK3pmcj5jJoauEGd2K1a78DZiAr3c755jcommUP0Sv82imXIbb5bEVKfTh0h9tA+j5j/HerSw1e3/WClbWgMcIi+Pc/CXNAErwu+0qHS7sMleFwCDa6aVL8qPIvwgvKr6fUj/nWovfhCp3SPPIz4DcA6XSnHtgEP4WHbZqaUhPrXpv61aK6coMRWOsgUMSUhmnz36jFkCHi4tU24iF6y6b54WimcVAZma8wS9Q2vS5cGjJjOr4xbaifqbVYrYkeHDTQJ/Kf4w5sq/BleagZFW3IOuMxhrRH813XQhIG7tTx7zB5br4OYa54ka8atzv9z1We+4/YpiAb9HZ4KH4T7l2VPMEkdUsengJUCzYQ2rDmS71v3t9GfVk5opjDi8WspVHPukCsQaRj0/XwGQHOOk6rxYSV7Sbx2UcW74C++lybniLwAM3fIw5k3KOo/L7t1pmjEYOBUrbpV4JQoZ0WfYdvJQTu+soQ1GRhrOVSMolWcU3lbFr0bdRGr+BduHk7TGGfma64lDpoH6FP+ysxSJGJn5ygZM094BKh9v6jp3dnOqKzxwkCKWj9ISSWTJJ/pgUha1V3N8hFGBQrJsSpgIObi55aUa7Ywxwce8bkuo9U9KA4ORQERX12jb4QN0nPTrXr+TxVZ5EoLoa2Xh0ZVgR7JeT2oaTzAfP0Vu0a1k1STAzgoB4AS2GgDM1HEFmrjEL3Xho/3etzv50g9ob0jEv76nIvZhpZNSjWDpOnAS6QMDXn/Z/oTvCBT/CO7chU6I58Jw4RP26PsrwmCO0Hki9YE5sY/A3JMoeQTtLoP52G98oWogYy7tF/6xzq6qoQnUqwpW1hRLa3tjvoSsQFMmCqS0xUJDlZYMdyNDbuM69wYE+txAsldfAL2w4xdWD8PEG3tmMOZJzZ1ESTzDg1OhqY3kmj2dTyhYkO8/YMBnXKfVRyObVpMKeqxSisbFZ5JjsqeJTfodNE0D1DL4mBjzGNRoBymHCd7jFvrVnxRVMgSxxCnngl9C/5t3Sm/YiAwPjh7zJ1ztXopPPixlaarw55M9UXId6ovVz4Pt5jt3iQ5OVpUP92wjXD5fbL/1mVVbWwoqTdXjTetnwF5rFZ509kFX27Z9AEkTztM+GLciCTARMxgQ8ZPkpHiYK3azaBbzlgahSWRCn82+iyjPVYqBSJy/WNPGmRaGGmgV8Td7Sja+t3VRGNUXczmtPj0dz/Lfsrnq4xc9EMkTqDm+aGaR9b/KxOtWzr07wi1C+hutGjpxlnMoF1kQQOgbb8fB74ciyUOYI8MuJYtO5pAKXGLRHNEE6k2q/hZuCjScwm6BbL6/PU8t7MhNpVDgHCw28Rdzl/+NsESqwRqIsoAR05aeYrUSaZFu4Yn8slzM5edNQSY36cHHigwPa1bcAWtYmzYmwkcJmCCkQU89/kfIKiVhAzrOO7GsKj4O36tDMJBEsQlvicUFrl/xHaA6EsUPPr30dp09ofEs1atmQM4lsc4TResqDT0WmVYjTd86e82JYC4ogTRhLhC/UW0sfkURFtHehiPrdrQW0ZcDDbFMvXsMv0JJeLvOts2b/LiTHGgbB1aH2uWW3SOgYPe5FCb7M33hZEYwQJkyPOom5v0PcSoNNH0VkfjPldMJUMuCB60SQ6Pnaq1peLqU8wzmQf3nleyEyhoaoJJZB2BfZvpE+qVzgyoFg1GkUcZsHfNAbKs7YkW/PgfvVze3m790cB1snulAzIEdl29PpjDV5TU3cPx1PWrCMdo6xifm63U+h9s0BlYQ5kCqXcT36x0s+dbFw4boIAthA8Upmur+bPhR1Jq30Gew6koxyTwXQILJuva5aiFzkmMvBvlM21ymXGDsnHM8p9rGwsWQretpetKXCXLUmqN4wsiBypWffJ6wQIH8OdTqXNxlP/Kbh+lwAoohplWwl6jU9xUrjWK3LVnz4sKUdgM3noGzR1I/CNjAq3APF5aCUrkRlJuQMql1FhDal23dbd9Z9UGv+lIBz11S4Nnror4yaR5XgXCyQHxVypLenlqqe895f+kRTKanZHOhcptDJw4mW0ubapeg39QVfw+ObwlNfzfNw9wnJxT0K5ziCuCCEt41s9oiwaIiNVSSGY2r6TJlAjREH9WdbrfJ44uFZQd+zqvml9V/QUL/+oNa4U9VgkTcSheMIaSfxSJa0cRYDZk2VFyYVX9Uljcj/YghBKepKmH3FqVSnzrovKAVSqlb77dHDdR5ctFRw88lzKF/Wmt3r0k9gWgjyJcN3wR65k3r0o6bOyuBUn6CQ1Wcxf32DZZtZFKuAYMT8nDpEktuPA5ZaKpwsT993aPjgbhQMuqn2qq0byA33+A27lpnRxlRRDjT3uG/IcSujaJ5KK7PGDtYPEZ6c7NqNFM9KAOe0j6shtlbhdFJoXGXEr+8KbYEvNQwnQKL7+WFhJ5wqbbdXIG4u+DA/pDKabVV6GY5pthN7Cn5sKDOKoE3kXUBko7yMZKFSuF7KLg+2dGv5rlY64wwyluV7MieGdh8Vu6BJ7dXfLpVNN2KvriFkGpGMmRMFhPSuyXHllstURQJZCFLK3hwrL4GTu4LN/7Ib5nfmjSH2vgHzCPoKMLc8a+sGvVQ+zn/HNGYFeAitsC+aO+LBCF9bpWT+l8+JdmfbwJaixB3ifDvX5QCOcE6efjXbmMgsp5UFdhFHzqXZPZU8AtTbM2Dw6KQLE1Y3QRlK3D3zfO0HVPeEoOhtyD2HcY3PZZDBZwv65g3gmrvjCCmIqU4jEMvqzA5YHWfaPC5BUTHEEeGMFdtEdrl+dp/jEraQJXPTj719FF8ZIjw7TARrS/3Xb5jRBd93azhS8hZ3Ch/Nh21o+qeNYl47SquyGfTm2gKaQDFNIyWmJxXMZaRvVadVK3BUcEqzNMElRUZIUasyI+r/2jQas64FFyUxtTtHvB97yh8VV8cHNDwsLslGgVlo+8ByZnvS1Il1EduWsWVx0ZoyUUSWHUZa7pBXJ/SL+oKEUFHO+XZ7VYL2iCuhwSKo5PZRBjMAd/FlPE+yYkBwKd9XDrZVFnX8UtoyY7w2xdWN+f/7SVfisxBiPTwlz+fFdYzU9Q5VMg8ht+jzqw8df4uJvB/Yy8i2pKdBTYJp6fg3O9EDIRCKk8IET7ngJNZjdXT4oyNCjjOlyu61WuFBjtXDJ0CUlfw0k1Efu70YjxJeOettMaZNlLvXZqAD6fnfoSZt+NQwdyMzPpzvgeSM8Uksb148v0FJZ7RpU/RtJtAugXMeWPpo2DTPY1aTI/aWDxr/Bd58abV9ONUGa0h2a6igYwSghRcFgTtxjgWeWSDk1w9czm6z7bYJS9GdyODKgk16NR26gCgKB+cdtwNa5oE2zCTKPntU8wGgd2dATsVgdZGS1B3FadJNpiz1fP9gQZ0vkQKQuEy+wSt+2YuRL98VG5BjzilNEkvmUjhju1USZLMhksDyv+/6qq81x/IrrnbkuzkaHEl48Bo8oFwBDngziVjlaKESOebuaRF2YmWL4iHgfkdB8UiVprkh7JBgDI9wVZjSL0mHGQPapGg25tAZms3lB2aFsphyaNb6FOcG4sDx+PjH6uvD83LeXo1nAY4x1as3nvT78HtNtvI10yPR2Za+09BFk6doBOMP8vau+EHs8wvGWO4qewyehXtZksxqxFuStPTCoK8sYuWWNUpB00XoLTHJUkhWyEjFfOBUUy8k8hi8+HoBNqA+q/TD7a2lgQvokqwzHGgwvH1DKul3IGDxfMbCURAkbM0hdpHcMqNgIunUnRSiLcdnPIgacQhgo0HEGBDvD5rIyIQU4vgaWDWwJZjDMcu2aSP3FER+mMedSKym4gRJfIiosBonDrfaUceBoJ3djDKDk7fJU89nigEHzyUxhlauYHSAZogXf3kXPpl5NuK7OQo22I1szXYAUsB3881fq6IROox7hL4R+3p52Qdz1eEpbMkzfOlCQhW4sIuEMJJlzLS5fhHoicH7y1IfpNov/pMxHWWBmfVrOnbpOPPTjIueGqB5R+p5MUHZeM4+H6EHrvTiX6Bu07vvXbAYodrQhBwpNdEc8Zfaf2xi5UDQ5pUBx7uvmyU8YOnF7iTiseUywf0rVks9O8SC07O4amwGHr7nKfE7Aed+qJwSJVrls5AjbTxfjhHSbumF1T3a/9XWrLNypy0rFj2IforQKJ/k3U3MYHk8gbqIzDjRrghEkuODIYKD2IsTPjqeIDtbHsfsoXiJha4hTmt7Wr3+NdEIZ7CstYuDiiJ3gDJVFBZ6HL3w+c9t8WuOlxH/aWDGik87G9uHMwF8sHnmHY4hNK9ush4Q+tPXp7xPb2BNl5ettDgpqo4GaFDzudLD67T0vnMJbzXedbJZxbZhkItLSzh3czVFBxtF0K7MfCCw8guFlYXWx4RSZFkG5x+P9W0bycF22pYokG54ts0KB83dFJdXPE7NGgfhEj7jBgbu4uH2jwYchyWQ+WxIa/EBEcNdJ/Y23XxxHhHUIBbBliMhhHlzUpxC3uZ7UWopWIFia4T7JF1SnykegHyEkRTgiIw/zM6o2sC6pUjKinii2x/z4ojSlPj6kefoMnyg6x8OBGoIURMHEuZgSgUQFfLSUfH4szgmDaKmgzlfgyKs3fTPSRs/28fOWpGnMqVV1ZHkTsiTJHAHNZ0NCjnTOlr2kbMJfKAVu7IM/bVXmKil2B9Z0NbWU1CizBANTgrblwLoWd7MuDMQy4LealLxidH4/K8ZhXk5exDZ2HuAGYGDdkCUfS+EZDIof3C1iYyXji2SPoIQBIcUDc7Qd8rYZbEycrHCt0QJ32YVGvxtlrKqphvOwzJSHLjcKsxCMvmR1BGcusy3tRlbxamGNho7HJejKQM6le87jnYSRgTP7w+fADENKRSlRIKRWr8O5KkzVV0jPyTFkCQXqsOkhtKpe7eFPlTqL9HSebgWeYlh6Uu65eVrXe6zHGVOu51ClyO6lJmL3Q/InqI3RVE5JxKUFx04rBummFD0G4BNAxtMUoj/AbO4nGLnjGDIGaGf1jxXfR9Mw54o3DpLHcEAHvOzcvfB3GHkKjqnFhYeAhqc30gC8l59g4wJtoTkOytdf+RTJsjtbNWEnvEVArfREak2RwzK2/S62IZWWV7q1oOvkeuaV2tAvnmmzd0ENdh+9PXBWZ8SQtsaKcow9KkI76uEuTbbyNY7I//FHEo+7ERCukAiY0aoq1bZXJHYumAK29074j7VwJ/yJf2/wkFU0g8vz8UplXISLRwc0CSLtZq5Q17jLAoPiDdebfEC7fBnUKjM56r3imU1PFq9PUZcIZ+3W9UmcIyeVAjjuE7EnVYr+04wmf3k5wBqriu72frGit/whTIzvonaT+OSOqA8BqDRCwQL5SbOpaY4zBHFWYD480BPffsR3MtL1FIqo+Dv3VZy7gTuGCoF0C181N9zIEZ470XUrtvEhxPL2zq1vTbSBQ6yGVggV2o8DFSCHqXdgBj2pAi3ny7OiNPy7cbkxcpUvo/8UsR7oKLlqIYjPbx6t9RFcecc5GR7sDu9GEyJuRHYQMxhNkZ4H4BQSnvr4wzpYz2DlskHKvWXBjTDhpgeFKTcW45UsxRbwVSBgOvmDwrvZtk6KEhXNNaUCVmijAUiBjLr4nd8z+cC72WwcRmsN22YRw+vUkuwWjbRm5QprbnZ6h0AIMIqvS16+vvUQcyF2TfqJ4cKFl77vbKv/glTScvVvySqi9lxOA3PQv+va5QidwI4xTrXVJp7LM3qpOGBh/8t51K30DP03zOoxnrSvDyJ+tkW8iBcbs5hYuatrzMOb+oeS8T0Y/X8Q85aDJ3YaCqqifNXHTW4zJ5foomhwuQLRxDiKCn+Rngbo6Wy+VaUBLc6mvDf8a2Iskdb01uNlCzkBTKR+GJDiDkcXUq1LZQv5TV1wNLck7WxynoMvxYDvXZazuiQtCnhdgoy/sBFCmdL8XymiNrXseRe6RX7FmdZlzMIl7WpHWiO5HDuT9F+yeYVqK+wm0WjtGPlx91QIOZ0L/uNfebc85roRnp9o2+jagGzbB6edosgq3XZzGKjvx8tf2f7XknHDcRC7XbBFSrDYAeqF8haX5AvF8hFoFpOCqoHsF6DYMvK1ZY59NQEO4XyIs2m6uyBdn7V9yxgEwp/lBFKx6icTqZv66S1HajXB6zBr1tdchqjzUuECAQFKKeoElrBkjot07iqv+MuyDt69Z4xgvS/Wu8bn/4vtNVMLWZHQyiSYt9oOGPgxDFHJZ3ZrJnGhW0kpZ1o/xDNxZsOqL2i2hAKubvUD5vMIJK0HlwDNRt3Ry2Rof2eRQwH28xKonLbJCX52HLq/fBBoKxffEhcUvJlu9ICCgTGXeNNJiJikOp3TSdv0H/JtRDu8vUgWQDkPucR2dSPWyZ4j8oTJrr9fXWB65Z/otWNbRdAHalCz++wDXozAaQP73M39O7qIxtb8E8/2l5Q0f5fPZZgznf7NISTxFyIC3fqwJJHeMHODz1TLrPUOM3fA4HPKHo/ihiVJ3t4GvBeKjaadUG8nGs+JxABFzimz3DsDwhHIwhMPRIXBfr/COynXk5olnmgLW9kjSsf9ipruOewQmF+Tl6+ReGYYz9tVpe+uwpKWvKDpGPG/5iJZDfnZpVar8uyiKm2ufKspmVwt6e6cZ7SWBSJCWcRTavRcZZiNsWRFj9Ho3gLKhBLtbvQJQ952LRa4mKIz6RWgl8pE8uxsqle9rbnyuWPaZeG6Jir+JARFbkL5tNbPN6yvoKDtpXpiITCjCrdJiydd6abzyDxdFArH40IDTPvSxvUAvSKRCF118Os3f4lQsC4OyMNUX7x5c7lo0jZ6neBOLxAxAZ48e7IjfhADon0sG3tBnpJUH75oQDEcvOmlG9ujU+pjVceSTh4t7iFoSgH76F330Du/Vb/3YXXUA3g0K8pEwoDTq8amquuFFJZzq247TPtyIXdyGUnuKyYRwA9cqGRMCbIU+Q5FDVCmLvc6tGi3/ETo+KwC1c2OluMS0o+lS8Z+WCVktr82z7QmNH3a3ijM2t1SJF6w1wMejLiKnLFEbrsa/MZL+dVgEnf3gJCn+3VwUpbRoFD46/jVqOhTcJ9uf1qgkpa7v7KuOd2PhQb5JpVd+RT9zBWsENWiJQ/iH82b7VBuSR53i6NKUTMBxQa6JF+KZ/nqS44+WyHAcBCe8KrerVKVhWSPibgg8mkT5J5t5jclLWp7iFDDtjcU2RmqBm4u6uKbPFtRF5II+GYkj8IswQjqWkSSGSpfcOYSW7dqLCsnI0raTyFOy2hA569WO7zTc0+w+y1tYY2Pgm/D4sejAa1hJdJrb/5gdoxzur4l8X3Ksqn0zIzkDpQbkVp0NRVPVk+4WCNhr1R7dckHQBhH5WU8U/V4el9oRCxh+NbQmfZxBcwgUFCLMr83WaIAmxHcAqBDhBCWSndHKtjwDZlITaO90wPb3oZruxKZnYy4gRbGicLsggRpgnJyu6B8Ywysqk97ZmUn8aBumlEUBy6mJMpW6fwqexqO3crcRosJllV2z+igzMjc0UzKh+LBibsqgceGv1Kwa0gABX1Y6zSR5qTir2EcQe01nDyt2Ju8ImvrOhPEgjS6K3KJgid+RyitOf6egw3f6STV/XB8OYAkInIJYClLDeunKADDkx3ayC20z1v6H77LX0eNDl19KGnQspDDGaq+KDKOMI8M+ulta5DZAB2eUKg7/ui3gJRa8idB3nA/lDtYikUL2NGXo00v4EOLlssxnisR6b5JROfgzAqj3cfE1ikqke/7byUSF67s5Fbfdlk42twlpb11cquU1TsS1NgD+Oeb7RY0ZYqiuAe4AV5ILe8zpC66wEf28AgdJZnmMgtUDXdsHFReRZ3VMmVx0rpGfLNxoDr/B3AwpYkB5bK7NwOJIqLmkLPch6cflkd0w86aqh0BI4T4kvgyVCQo8ualwucYcL2LnjTSScxr9cDCiEyZhMXQm05vgUXjcNxa57/MQkbWSfIgkFwgb6wzReB/Hib/zDDqQJbBM2l4/jYoGDw5cqHA8rR5v/w3+S1C8N20kV88akI21XFN8Nc3WI/h1qoKgkad7TwAYnWR1NkQRTRSprH9GZvRph6N94mSQscUmdOEqrw91l/F0y3Ke7uxn113WHr+m6wji3sXHUHln/s5+Fq7vB2DnhNMUoZwdI4SJkRQEjCcPAtNTIwH/4eudN/a+mOyrqBCmIR/BLyO/LIMdc8tCnv3XcJVhHXRR4S2n94qLtK8EHtc8GXbRPPIjbYKz27NDSs98meSoDXrE4BmZnrkbvHEjmUy0FP2WBKnqE5QuG2hw4OWV7Jx9RaUO4Y0g3mc+h3o/ZCmV3iE70nox1YLGzqmzKICux3r8oGfwmNMpVtSdvAhxQVpy+z532klLs9ts8KLMTszAMk/Xo6tZW9J7z+FQfoI2xGWxqsttyMm+gmRqwbz+jnFX27DHGfg74CkacvMM1paaQ7aj6yGeNXld7feOcFOX5Ob3ImiP9D0W8iYR52jgdngAX7yzumVnL9gQdqN8bT1gZ/kzVpKgnUWpAVwIOMange+YqhwX31TPinHYi1yYsbNd6PYmFUyLT5mDrSA2hHmLa6GKWtlr0ABkW4JlzbDh6YXj4cKc5dFVJpKv5dF5mtpRnDnRM6eNodUcarNHQqV8eUmWPaNT7gKTiXa3vFQRYfdVLjoJl+/fIMTmgAeM1VJ3Ojr51RVrF/2VwJvNwZ8jqoFcN7iVEikDjIx0lsNFjBFcUH8I7ejzT3TQ8ZMok87TAp9x9ld7NAsIowaqjmwiMc+irZKDgnw797tQ2lHJgtHF+mNVPj1mwa/bWCGjIbSv6Wr8XqgZJEGTzDvjAzSlJjEbxjvd5khdsc/cH7vEx0u8ZA6h1FFlrgAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAD6UMD2HSLl8H46zrsaoHsSjQASIJoouXdtdqh5MYDu+WuExpIjl+uptyzShyKBpopeaDKTpXohOzjNjX0/TygRY5ZO7mGQdOB4AUD+AukINucjKNJEg4bUWcW+Ixh/UEg7bPs6a4nPQaOf+bHDS/vJPVgLk03ebIQ4OihNiTCd+PdQLjxDeeMbzaMp+TocorapxgpAFcrLKXHuDjOR2l4SPFf17dZ8+v1ZlwlFw9ZyBHuG0y73Jc68ekucXoOF9OtaPOJbTRW34i0Uad3w/J91r9fxKtPL2jH4FLoeutsqZYs0EluS4F9jhzptv78/ma8+4ovD2CX+jtixcM8dMn8dEv54+YOuWGKjpPZiTjRVs/TKXEuU5XyfIHSTMRC30QUgYvGjONa893hrWqGZp8t3HeziZdbukLhFisb7n3lKYNQgEdYBBhOID+xrfz8zKyAkqzGPKpun7SNzEgc+MkeOuuXU6aN9TgwbLV9fRL+Ee2+ySy81UIzDHOBWgdBOiNR2qsMTR99HPVjEuxAoCEsaSA5sUKpXLa/2IdgzngxdBe9Vdu8NXHDvq+8yyDICYl9LEiXPAV5u41VmGbdR7OY+XOYFr5j5PtppEL408N5B/4Xby25pqPoAFqczdUqfRNBM3c+b7CJr/nula9Axx2xYqzyxv6MuzMbA0F80idMBBaZj/lu+XFzMh+YMMUXogQTGtVw0nJoa7J4m9IX8U8CGtUGZf2shH79dGxAsiYI9w5kezKVzmU/A5pxQMqUBYgHgNG0hEh/3Qf5OqiOTikNHpx30Qp5VNZ6qINaRyfQIQD7mg3E8mYlW11bUqX7nuiomnNOabr0z7cOaGq/z185y3pVSegIGzYJbfKECeuK989gyZolDT5xumtgB7Ut1hXRM0+BWrJPRTk4h3IR/Fp7XuuahBRVaLetLffH8+2cjKhrhrTb2Tpnt97W+tsuHYa8ESxEAIeoANVanI3uhbdzTcBYFSYegkiBZx093UhBEiVlhat38u2E8fMRhnS5+sreNprzgZtMq3b/tzL5EeFrqiUxKK4ljRmjXebir/YVHTxP8bGrzHtT/hP3fXQcd//ELHMWTBdvdel/fnH09e073ETitEjM3kPkg6Xn2pkcSdCqbtWcCNW48gP//iQqd5TOzVSW3WHUdlIbw3D6flaff9nm9C0VKQABqi3CU/TMlc3MprMfPs7o5sb1mSeM8k77r+iamRY6v0GtdQlZ90ELqknJJNUG3bxSJKE55T6to7hhIl3Md+rTwrajP7C9clKHaHWHQynIaEbGlv2t9iOhCGiiKtdW7pSIOU9MrXwZ+woFX9Vp8mTBsecB2YWHJHilmpziZ0nm0imVfug8a2Dbxzm8CSXchctT4XkRPjFLsbW7Q2zgurkzaojTytzJRgjyTpeHFmwcKFmq5TPHWz0ytp/nNDk/gE70QFS6IUeG1SfXky4kQHpDUsvUAl6oLIvrfB2ky9ARem3qc6Na6F4eAPtE7Uakkho78XkTw2qYcXiHAff/5rzf2i+/mvufhRwSmqoQ8iNdAwhDw5dOQFbuFEWl+eegBW/ydYvMu7tHnVc7Zy5MCm8cO+G8o33ARNkSyXRkbFjbOsMRZsRmDXCQuDUGVJVzWjgvIr/nhixv1gE0JdSM6uhIwdKHgIzNtSSnsazR927UjbSIQ8+AjJ0kbRjNko2kGLAEaWiIMTcYmPlo+o1bYMPeN4H2Hc6OPCg04uszEiEmQCFg00hu/3ELe+gkEpHk26p5AQdl+LUoCCFD3dEM2Q4vbn1H5d5I1ykY25Ls25i94pqYVU1E2pzY3E/6L6ByMNGCpS7ZCT3yA3u1Mdnw+/4YGT/6SCARfl6KhR4rn3LOaP/ggD/gkSVbMZaukNnNPKXPbjwEcdnK3x0TT+C3G/NnYLByDO5EbOfhcyYWziWkFLu4/iwBWh7QlnYOaSTwVQVlUeAeQyx7+keLG9LsID5s8QZfPPfLFoGdMEybDGS0GyqmT8EOZFYur+pJ4YtPAseq9Ers2Ef71xkEbWIuQSf0/WFPPJ616J/JQQE7i2cBZGncikvoaVyyNv5bOfUnZLp7I8sL6OadaqWOGsMDTExcV7fnu7HhamnA+yahTGthcvqoD8omacOT0YatPl2HJZEbqenXeftNiJHtc6LnntELDQy+foOx0NV4MbprU/oVu8JK4k9Z2F6sILOWgzgFSoEKv7BWoG7Z2VF3am+Ft+wGDX9q5B934ahPG/lGHOJp8Q5sDwvD6tqmX+mVkRjYWXGT4YWfa/CQQcARQQ0f60ous0eFzhH89AQPVtkptsxLSYwExy0AB6lLk4KyZJq7mn3K+q4Wq214ng9x1whfTG/QHADN8oYNnTNBWTPv8r7cJwkCCFw+dyBnVD9rtZGwTCi5c8Hjd0DUD5JBWcVm2R1UYeqtnt5X/PbcjLlnXnJgYi80mNX9IN+QDbXBMbEqATGVFJZR4GVCdumUkdqwsm4cc4Qj6E2pzl2aHmnbsYNeHrKG3RHpn+K/YSZQJJDLF7GwI9ThJKs+WLaD3sigPo2hXiQgJuDhJfIchrctKE+dslS3XCr7ntHz7MAahc5E4cphuEpsSa5F2J21dDKGdoqsMNJ2mBmk/MTVaYXDNRgUCIyzUWMK9mzuXnHvgC070xETfhdX2HcEoOiNgVIPh+Ok0s8IQ0izTw2mSIMDedLINIFKibUVc5AFIPjEVOhZ2nL0p7j/rojKVFeSUxi1iELIGJSUd5Hk5RNaZTklncNuGRtzFcp6AAEddW0IQXs5x0VV/f28vrA39YZciBOsQOx/heBVK/xKSjJfNPU6H5DPytQXcVcaj8oD8V/iZ4fjwd38DJwXz6yRVHNzqJ6SK3hsccZYNZFtlzfxUOA1/UrbJpbOHDeLBHtbtTmZm97Lc7LaLJJf0BcAcWQL8cvBoA5T3HJlJxYneJlxYOiZg6yel975VVDJZu9cimLuQBfneKuhNN0hEewZFs8fA5qmU5oxzbecBU88merGY41wf+FalE95AxJPIvBOH9t1Sy8SavDptu52GZHaMsrJRiOdGNNvZrMtNp0TPSrn3R3+gYXZsYvRpaSsQL0gRvg4y7xRWjYLoAnJW7a1vPE//fwwv9UIP+mS01A18cvS6Vm4H4ycHYts6QfYpxhmPJv2i5NB+xhPe1Y4U7oTkIepY5blb7taY2Ef2pg2xhfaXw//9QyknSOL5Gm0ktGniDa5wRLg0tSZp/fJ6EnWjJg3RESB5xbrrhY+2pV/2PfvwSGGMvpAmqjT6fuwQsd57JnAw9GRD1PTO3ladNNsYTkJBtEpIFdOTjXray3Rn4ufCNoPBfMAVO82LdqPrkl2FxRlQGyw2s1dxJE9nzVhw/x1fjToWyHnEYRFsNbSd+ROM3rqKP2mp+vjFDCaBRFMDHEB3D/FJzh1aOHqpr+XS02oc/iDMOt6keRGfa+Ao3Z7pW+umgYav8+cQNTOJFEKM8guT6UBGatW5I1BodCadUQ1z/uIWpQPjp9awomda2x0ZfD+uTUODXLawQ/XUSQn1kNM2EQQ8lLJaTBj7PsJRFjR+MWK48O1vdM0Pt1Hc69yJEU7lpy9WTp59hnOnzl3vqY6ztYNPbVf9LVfdbJDfr8rZhE7OBP30q2+ZUPseQ0zOlaM7eZHXeTsiRtAlB/pmvc9KkF3LCNZVfWH38Wzbdxtck9wJ+UArONmJ/k8VwLeCahHPxhQ05OipstksnmAz8IxNE2ILgsX1uQqh8ZWcE/HhYakFo81aByki9yk/QM6e2bgxedrHyIUpLgWJYk6G4901kvbUW7Gi6wpqxJWBydsb4HOjRbf5rqauBdnGT8haIVg28fKZfZsJ1NBkc8VcRs0wBnDYXW9rVTGqyNoEPxCgReFJHiFQQnwbfwMrZ7IwnwbguGYXS8CxIukGtI78BKPe82JkcBgQ11RIRBo6nxHkfJqE2lbZ+nRHmptJzk3TNsrIfRapCb798qOmTLGEKuj4kF5h2etDX36ho07U5OPpDaRjA90EGelmRpkbFyO4PsDER5029hXYdzL6lajb++U7wAVsF4uwD6qQxwLnavux75fMmE09EBbPfxsNHPlMJp1zOLfMrfewa5YXBDateYotU5+1ldYPX3TagNXscHhImh9ze4iYQKRaqiMUZtCdcj7W6hOohlP4iY5+XAbavldfmQhCGHswvLMSmH6iGD1hNEIIh4gEtefZIBLljZzqsf1PSow35C34ZbC1kQGgoMiv1Mzdr5uxTHTSwPXkyAQ/3NqnbQJwg1E6jJhQ/tn347a5cXhy32ZkxyFnMQNKA+jsO3kHOJJUoi+dwlGPsWq7ADxsiGjiOIJZKZavYWY0eCZD2kpVpMj/QIs2bD1I+7GaWrMX+rWHjIPhgoHUvxMIilmgtdSxHScmUJVI3EP3XINR46CQ/caVcq8feXsUPLDDjmyYG2Sgx6FrQnU4V4LlUg1RUxTWYNIw1wxniuSeE4xPrEMhpZQ3mdDuGCP6kM0XUibNe5w1tqHva4nLQDN24KW5PxW2xGuS6uMT6i3F0LlhomI0h4kZz14l6a44WzA+QQLbm4XfUf60375UwJNIp6K3WfgH1mHz7mlC/j7lKrMZQT3/PJOAsJiu+dgZmTCFteks/XceVT8fBluAuukgkP+qE135EF5pfKubO5phuknbBvsnwskEtb9t786pK8SyhnGUbn3MwkM2FvsCqQTa8am670LTpguspoMKZvKmg3qS0ZR2kxsjLGH2yED31dhbgJ+iEMCmZQAPR3GEmx6GyqK0Rexx1Un453jdsF+2H8igV/Uz8NWDSfls47IpydJiScNdxnIGLh/8wtkIBX/ohsl1+R4yYRzJ2dKTKar/H2Htujz8by/fMEM3IFurMByWW1AeTy/8rN4A3RJ7JQThI1pvH/B4EUqRBmsWRO1JtS4VChD1AZD+2koe/1es3OLOj8b/3LE6D+73WGVPzeneHSvAjrlVJGhqYw2oQprAUOF38NuRHbqfW9Ip5weJXrK6JxfFZ7AtreVIQNL9954ro0ACRn487iYgwKsUzwaTFVeyDyn1DNbkTnhKaXhaIkJ3uZ76Y25p13zcYNghAM9x72zPFxUI0A9MGMesTFlMvN8vmEi+GYQjojZoxMypw5ivRoe6VJfwdC29ekStEDzGLJHiEP3OmwfKhTZYe9q19DNUkGxR24A/MRMhmI17N+p9xyo6C05yQSgUvB4SMxfqPhzE7eNfyrXTHJPMMwtRRTVsdOtM6vj3yfGpnRleUNr5ZDP4Cn7doi7NOak2KF5WyUQr6EndAztT8LFSg3j4c7k1bnKQZlXQhHVj/pjKgCB1KNt1JkN6lLq12IdNVXt8yuD/RE+Ux2BcyEMZDHMPzYP0KCUmO7VWZLI4zMSRdN0GpwVBF452vNkte7jJ42wLwc/qxfvO0dgjLeX9toOtvq7N8WJ79OhtVfvfSqmt8w6DjEPkLxeUzg6Ex9ztkgCYkHsZLgDzgOwgTG1BbnABmwJoYTkhz3p9w7WM6+GZ4sY6OoSnYaFr5b7inaXazw851oaxetUYOn0wDxv9AVxXVB8IqWtZQ0PS74K/ILtuDtZzY5zFanVfLtR4c2p8kgYGxKChxZRCbFikXeRpJ1/HF7Fea9GOBzq6Nex+f4RL+hIiryWUxdfdD/Uh4FTtXS7hgWf1rZCrg2ievHYp3ZXUs70v+W2JGJLYY9Y9p5PWkqTg/D/hUTixppB2i1NQWBsyza2sloOcSYpXTUHWBz+sjxKvZfOlIrQmOb8Ajg8rcqW4eqC8rQ9WpVT9yI0/BnP+wNZr47OrJ0/DBuviWACa2OuLf/IIeLGPiXf1JQGVi+V2CPg6Y7f/oTDyAWCzzzmnffUlK+eXhNvYzq3Er0dg5CAcTb2cmcXtoDDwQfUd9kj3exPsIHJNiba+8knXkxQmn6gp9INRjstjruXDVmF7i+YAMjVfN6soor59nCQUj2T4cL9hCbgAL1rStM8r70hr1Wk4UU6/iV5QwFsAGFSegq4ypSYwMj9Wu9Gv3NBxHrEMOc9T6Fc7tJ98xRI5rMgBvfBSCjTRsqDGIPL4sYBFv96QQN3hkSHQi7mcTwf46/ZFUZfqmVTtmuD0NTuZwBD3Jej11sUzYO/fpjrye8FzYmOT1wAH5+iFmZ4YMrVc65I8P7qix5UmLh+fCYqriflpinvPtft72P8PZpt6dzMMNe9T8/P7wK/ZHx3o9y9lNXcpBJFmHbY0Dn0cqY24vC9Q9VcoPM8RjkRvcZJa3psCdbWpR9IAalph1hVConF0V4lAarVA+siffNLjKBI5E9UXM0fKK6mE1bifeXU1q/i7dACeUf3cA6wPGTflKx2IQHN/AO2evI+SL+PpptiXQu4Ioe3v42DDuLaJfNrVJrtoZdoJMkKgcCjpVrym9emNL2wMr3ICo6CA9yRDjaeOW1CT34U41pgan3jJvyt4iDjIXCtqOVdQs1rDmRwP4fGquXiLbLbkCP2S3t4xS9uGjqPEIEIwPzn/w3Bnhj8YC0AdODV/1U4H9X8AwCSskauP1H7vBcL6gI4VurXnPTlu04z2IQxUJrOl7VlGmdpsuLmAaaPXuSbK6f7bLpYl0gAQh/6APobqHDedqodpUy/9A42cQoBRLHA1KcM1W6jG3P+PRvgJyz/08dbtmpZTmIYkbdanjaBNzygYy+cJYLBCy4qJYiBHVBdKa0kfeEq9u3M2mHhiILZUjEWSmsmZ4b5Wn0/+xzLj13WotlDf1cDAHHgqTpeVkxVAF6r9ZBEu4hRjnOLhMY4vLmDpZoL9dcadlxm2kwvIgCg+9EIiUIXzekopQres8pW7A060QZfsN92L2T6rL6InIxn4foqwU2DZoUeRqqdRyRDWZRAm7GQkr0RsrjkiLcjF67kHw58nu1Td5rcTXPFlxlPH9dtUJQG8sKQePMZ3mqYZSsHRioMLAdo/tLVsgelJGNnpHSm1vsSVg9ZTjiA0CX2dniL9r4AZ8jV0nSDw/E48pZ5+UljFAJYsWlRlkRNfm/df00YfheHz8DN5Y6CWDb5++AjV+UXKrPjbW9uD3enj+q+rb/NcMGdEXXxx8G9GdOuv9PPpVDAGvG2EqSfyAqZuvhUGyswHOB9ZZtdWZ546G6hTkVwJG8krS3b/8Accl4dHyCqRgEoP3YEOoRbe4KoudhCxFeuBulucGc8ZyGheoDdv8DXorZag30M2diGZQHx4c8ofSjbMBMdFUtMP98VFMKDF5ox+CIJ9I2aPMAa5ZssZaYoGba6lVrdBCHOdDWQU2OjdeMnC/2U41FVErNHJpx52vitOEBrtRwKJaSrrTt3o3qDrT0ybjq/+M6elibSsKYcZ5W6kemFnmY9z8WgMLlxzt4iQPTy33ZFBROdn1DuUxGTSWZhvgl/jv5Tk615bkAE7kN5IzUVVqmrjulraKIF2ger1tEhJd9CyKC9Fgr+oQZn53JT93utgaQlXG6xc5+E7c4XzlLmfSryLdX2dn4DUoU+OnUxGkEd+zqj3I8jJIo51XEkjHOAEPbPf8fyftxKPEL9HhUtP8jWL6uj6Q0ENdM6WrLamPcC2EVdpLeTTqjFydwyKU9XhiVzf22HBojlahmutgDdzI+pDyndUCPekFBzGIhAGNmhe4bIvUFTPCc3WB//krVM9qLZR2LYiStTf6pnaJBgYIiCgsoJqvR6FvU557oiVmpgfxU5TuG/dDxOgmrKOepDrn1GbTPgrpnQRgU81Yx0UXntE86Rq054eKCU5Aq+2yw0XVkCPGWPEaXG3Yo0Gd95IZ31FwRMuQoX5wOQOskqR+TSACsbwah7oxWdgXbZUHSOup1XWAUH+9/9E4AlzANARk1nkFlKTgoYvzXuim3LQ06ljxJ7ZuHpool2Ct8khyJZKBBNa86JNeGQ3EmgN9VDQvuRQBSbvNecXpMt1+eOhD/3ZAr5dHSs+0Ys3YuQulYsCTOj4ku4TqzXGdKgFQ3uDlzAg2NEAERhZEdOgEyFL9xt4gzMNVyDR3ZdjhdyXXIa9UruwEEEQ3spd9CWs4xyQS7YdGOL7JAYLzEuSapBgwhfVVNYTgOlS8SiSPO+CKrJDz76/6k1+Hc1XDxQpWik38alBLuFMzjOgX7J0iZKiz1mNQfT8PctUHQzm/qdTY98RUHEbAxxEhECDsTEIlWAbXSQaUqgaitc4u4a/VsM2gK8Zju0dl4ZzYMBMKYmayeNhpBtQF8Bh7FkWZZNK+qnqVMjk9j2usx5JkurMA40IG/Daf7iMUgv3rWl1WRuEIu3b+vnSwnwo3YTdMHEqFaDet1Ld3uaCzDRyLqd4hPNmldt/fQK72vZPMbM+Tn4rB3AIqiKg8kWYFAafENqIZVqdjml5jmVGPhBQfAWHNkHJGXbjAESziB9kyXgO3MoI6m1uLU/Yx8BNdr52pny3QhF2JEi7gMrtjIPX5emkBS/+gFzOpR2yiQDd5kDFQ/oP9TV5XSe/GBUUQWQkuOtX1m2q5t8q17263NoRn1jpkFBGQ/iimh284u+WuLeLNw3M46yBuXz1lRpwYYebpA5uxMU+Sg5s99id9LBElV8oXS+YY32z0rwre3qjiNW398l3vfmATzIUkbxm8Q1kzN2JPF6i5OcK/MFhrEcjLTt38WyVVMmHcJp6b2TAz3qxXlp0vjgrLzqSk290zSaLmEdJEt+MGVmIq2ypmZVtV6+0dFCkyaehXkfhH1f6LWtHblpgtfG/Alpm+TDitl5ronggbgemHWW5d8XXd8OPLdywHJGtBle7ZtUSpgjQYvZ1WSTKAF+gKKvgO36bTHFghcPaA4kgr+i3jaKAQvqKXqPS3pwwf66o6UNKuaguz3JBE2V4LVkKSOYJSp+r+XjDsTHc3gVxq6XHYNGGIoM77Yy6LpUFK9ea33qptYtbjMYm0Xjh+vtHgpdPaKUusINm6kNHa6MQsp4i0MvrpFJ+yyTIixgV8YPm6jzoRp+tpbiLgFKI1oKEc7oDaNUvIUXnpKoYcNLIe2rRNLgJT+7BS45zOn3a96Bi4d5nI55QwGtmMpfmARzvxjZ3rpqN6m6W/SXrSL0BnNV6U4GMiaMTYH/zJLyrkYGzkTrkYjyJlfqwm4Y4Z5rJ86vjR0I0PKqzHMNTlXmURyh+2RSnxZLWTKeydRotsbfQXHma0YFa61Fg4f4wYL6SffPqDA9Gq6J3ybWP8kwgY4M9b3Yzw0aPAhYxx3HI306qep+sEl6kcBhRzlkEaHl5JEnsw1cPullN7P9KWW3QwAojbXSHS7ja+PEfNOuX7uEvmBU+9DKHtUflfrUmFnFALbiQXHCXKvlrcgAr133xJLvMVBjyF/bYx74x4ZnDfYlAvWZuam3uAU7EmVEFyKKSNep0hJlbADkkQ8HdhhU5CMzNhJgZ1ol8DFf0mr3N8DGVfocKzTRaCCsUEreQ920MPoamxZh0uApK7/tJBzH9moRChmFLzkaU/f9DH2ItQRNKwbFxoKQfRAssSxJT4bPBTj74toFFH2GgpaKYMn/nqkOPbW3mUZcjqnJBuqoENVZXcka3t/54zviZ22JpI2jdzAdv3P7SdvBuKRVfrAbZAFNo0csZcWKajVTVZ6Z1yfjTJEqbzAx6+/soqRqgwOptxDAklJZxcp+RMpmiiho78q957yvxNA0xiApxwqrTgk+33yP8T7fVSykQnfHW5yDrHDokAxUl5vpr4s0oim12E5+FBnrlkqpte/KICJE/p7OwdyAKW97MYmMT0GQYHBIMrYCS2zlxvlo1cXqWb97KJed/I1gvR6qMSYh4GCgYMNZidVRtWucAhFfdUqGZI/WmDpi07X5bQ6MiO2LF8Pz31iFNcm9z0oD1GeT3XlNg7NwbljK0Vyh8A0avEkxIrlbtb+7Xv8JxDQsdA2DJ+CljkN0LzK6Exum4rEVIPadDjAdY+vcZqPdU+OgVhLycsBBGfl3si6tRicRs8I5MnIYid9rvmG9AzntEMqene5HsbbambqSN5CRlB5ouziIPutoiFgGEBUbsIpXCosON4BV+CgH66cqdVntkJOpvQ35xeZixXagBcu+/r/PD0w2I54VD6BEo44qiX/KsI+rMdqgBA1T0RyecmnqNb4VeMtZLIBTrqWscSwKQmxN7R667s+uy/MUVy6gdHCLgISzijkBooIn2gs5IWa7YaMnGTuhXLXmedltGJ0fzBfVKr8XooTm1iKxZjhVFKq3q0/IW1ICug44MkTsWnfFgwK0lvnXETtfuJFBArBY3h2xp+k5y2bd/BSxm3nDA8MtZjKBs6W3UrE30kHsbD1PE/3/GfgeN4XsYqwXPXDpOY860p3vN3aD+zTmpnGOHV72hvzAlckhqP6CK4tb/F+bktuohQLP8S0SjhXXHlYThumvZk4cROaoP4yYOyADmOgQhEj3tV2pfNd/y+Wiz93AsORWj2lV04sKAt92AxlyvECM/oqvM0RlheiNS29LDWauoGkF857dxfRZOwY3k5sEteQ4kuD8deMQfuWjDvI738nsZsGffdZMxtNXy+yFfLhvUobPYyNUnS8npPWURcp995rzzizdPjrYB9ZeGLWHJ+QUnCDgBOqBOsGmiDHMdJrA3Im4vCVTvhv46lt5mcYmhXQufC3UeTXaGdsGu5CDPPpkDDGcysdSi/aDtSKW+dHAhTuzPFpyVFKhUj34RMadDhGkE333C+rlkYiDleZ1tP2ctutGsXVUOiejI+qRI20mwtXAQ1hIHqOAGXFktOpUyQxEFhJGGdJESddkG4RtrI1jqX0pQOlswbwwTgfkwH/Hdcr/PwNP39ooibcvuDM2+gFuvn17I1CW4jHdtukdhoQmQcXVAfxtY8BDUzaTGJRHlnbftzyj1R22ZXPOZRLJrZPFDtFVDROPVUPNtNAETTMhusB/ot3RHHSpCbn76skI01QAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAeyA/vNgy92VQWD62rNStrkrU1dqiP6klHFzudQwC/Ga7v6TdWtTQNLzpJWKerv14BRud/YpWge2cc3BzBB879W+fqNRnwS8bL7qoDRPyQu46EYvbr2JBWDa6AXUFP+eQy8y7D9jjmBUd/iQ9lWrf+DpnGMCEZowlAMLB4R+C9u6bciApV0fLoO8/dq2cQZTHE/wtQjARSuUsXy7xS/qH5fvGoVH3DZ8MDhoOhFqy8UAV7idt+aPfmOxwU5HvDRelY8JkRbq5OZQgT/IaY339c1QAoZJ+FOZHj+1RfTdt7uH4HACqv/hEaDWL/dhRjgGZQfuQGLRdDAr3sc1guWy1u/mupP+pCRZkr3CKceKSBuoxsTOh0hlY3yNQMPwbWybN+6RjXQFOYcra7QbNFQTtW2YDxvwXC6bAMLHiPqUi9+yMgtcr3tZt85UKEKAL3TDQTS/xJW54rEIthPncceYXEx5+UPciL6UMYxyG4H5vKk9lvECEc2euNT/Tx30wsbUQV1eLxRvyYv7AgyMLcCN7RuWSAbkQsS2RCLxBzDPlRUaW3LjGRX9Tsf5DhCXiQI/m7IE+5fdjA+UgqB8wv+OzysPnkubKXkLYzvMBP2GDLD4I9jRpMwaHBQTwWBsyajixJ6CvxhDrebUHLsLkG/ePwM1DMAsFxzudsIGbE3z0NtITA6HJWiER62MfgCSeuKb44FLJSQe9lZD14ELkpalZYJlmDgMFgIsSgh+MZBlNyXtOmGpgfTx/SLZZym9gbgNFW22KAZwqR7I+tBM4hPVn0lLNXzxyaEkxaoC8p2db61NoNO5TbVyckUPWRAW1oMMf1uLKhox5cyGepwP01ngg2/taxxxqTcx1WUmTnosB3wnRDQZFC/hV1N1u1yJTcag+N4qvmsP//CoR5GBrk064jE3TVkwo/xAnMuNctl2/H4BJefsg5M99Wv7z5fkJUqJ7atmL115g0bXn+CwvKOoCc4NP27eJUyziId0fK9XIaNx5OOy7wf8o92oyQCpSecNkqak3ZktPLxw/O+GBAI7nYLxyOkzlyDYbbOIXxGOKTVYZ337p8Sn4Mdg/cgeJo+Q3+kFo6ZMTHbABT4A+1zChyoayz8RhdIOG/M86X3a89jxluI16vy5u0f1KVEedoEChVqIOmGruym2oEdYgojwxaZu7LJx+g14gfGkY+FKEcneQtkwJsknVG2XZOwjX65zXBrdK5NJ146x+fqI/hSNq/ZBOGLG4thLDYs4zr/fOFMDJydXAZ536513LHVoEeE2EUaneMBGcML5GwCvRoDfqLzjSQcX1Yuoid21koNmZCvrMY4OwzVAKK3WaYJ/nUsocKqpcdL35e0dCyl0g9g+/QjOk7F3uD4n90et7iHwZuVmcxCMZOgStopdYMF6wazmnkWGiT2AUm4lvJ7BgwVQnlLUR9uvrqANTbiJV78/QEmm3SY5CTWl00TE+qv4a+nhsVBx/+X+ZX9qLqO7TvQQFm4HCQ/pqgxB/Tz8m5Cki9Ra0zmzKaDX3ocx0Cu2r5wZ7wZx67vad9thb6hd8urT8VqVRDW+ltikTKb6WdCIr554/Qt2gAjlh+qPmoY/LbqVe+fNrRu8+dZaIMcuUz1jvde507AThnb58JxFZ8XHiDbSaEkAdSMJyKG2pfz9SkrzVnWvVqhdDGyxbjqOs8POVNd/SLLoOX+W29Ao5cV+nTqZRRzOI2GmK3wLgKW9/ci3ie1K0nvt+Gxu50ZcgrOE9wAvpOtqiafe+4JrrpMae6EEQfKlqmbT7D5FDcBfTdEpc5gOVGf9KxSV36Y0Za8bzZ3RHZ8Pc3hVSjNYtKj6jDE73SshFiakINGVZjrARh19cgLiu8PYgqMBmPRI9FL8AoJuRZAnDPxi6NZ5z3h4Ykhznf1XwlgTxyqUUi5OgI1Q9CtFYwSF+nvwQ16s7Kjr44lfbOS7y38Em6Q3upuIWGDQWsg79Jj36wB5UHrNX7xYf3WK+ptqHuUUyFf41ginsf43mcbPdW5vsPrPQiRerG9bPvA7MQddD4KGY3X5Zcy5jyaRxEUg3uF0GcKAczM2WNFbz6OmVwBF4XpidEcChz8wpbLYFzF8KGch2Qg5t2SVLs44gkRGJUumeSGpFFcxjcbMa7+6gnPoZLc4/IGS+MBMMFfDNIgylepCCA7U/a/XDk+hs465WqeqFVBeBAm42QYWL/kAj6/Yii4Vvi5bxjP4mjHcYT28W8wTWH+Cedbp7XLo6Sea00a+WzcFViEXNF33Odv8yfJdaCEnynkZhnsOFJLnrM8G/Kq2DpX0vU0rvTcNCVYwRBj9WZwI5tSlj3PX4LSMwKoglcYZ/rX0DXRu/dqUaKrqroaf3TwKscojAW9dRyGzh/B8zWQn8mswtr73HombE5AC6HK42hykw++GyaZtIFcutKzwi7TRpQhhheTeGRAT+iQIZfJwIxUGZ482/w3DJPp0RTkwAeI3Jr77JP9HHS9ZQefUjIKXMxWt+eeidBFlsFtemjN4EsqHfIPuiMZy5TX7PLXjYXGIcXs0kwBlB8yn/K6/75eg5O3glSbOZFf1TJDtiBq1V8BXkaj0SFV1uecWl7FyApbHlwipFRU/jaSjT6g92iz1E+cBo6UsWLICyDBTgSoiPKrXLZV07wzopZfiTlZwtcC80Uz+78mDx+yyYZEbI2VXneXKwJuZkwKB8ZxzvWraBWm3hFGiYKe+8QAIyuWa6mx1pIBX4VUYx2N5WXyQUQZdh7UFYjWWXmtyToIhgU5u0/7VGUxH4mLBg4rAOzekLQzOikHlyRmbWzTTM0Iel6QEfekXpiQ5RNqUkZqTPvMmwGsdz8FrIZFGehZ3yz/zfyehT6NK/MurhgcqHy7U1kMXVADiLrDefxrbL1zq8y8VP0WhXWx7Y8GZcA0v/91H6wsMOezGZpC6fph9iIyCSQ0wjUoE1URtThiglmQj1c0QLcP3XdDl8m3FPf6DGKQmr5bE8Wy1Ow7cF+XOPnif0QMppIqAxY9hiqFBPlJU0QFy4ONfQdyr4Gz9aKTISBVTMRnhlj1Ko6rFidyiUPj/zWk8zPFCFCuxUD7ocPKE8eWgEI15vENe3P5WAhBd5bIsOC+1qTkK8FhIgVunOgIG8n4ktvswsN3OVf6rJKnqTdEpvEk4kx3b/w/zPFgb9h5ilCV4YLpkZ9f0niF+sdngINqXPnyNLJ91BR+5YgYpRB2Lv/VvLQLr/L9txFIQVvV0Og0LXiH+GuYv7L1u7/fmogZCvOqqhx9UVJSbzCgZvgYnNPRLQ9Mi6CPEiY6ie5HDjQGgIPa93THgmKApB1Db4+NtjJP0pf4FffXSUIx2Edwh1BJzn+Pnz56WcIEt/LsAJVTUTLIsxHcXxg3VhC6D62RJpCtbbWTV1hRoqIkxfediB/OCfTeWforwJAzMlBLP7GP3HEpFgOV4n7HahKxkHpsj3Bt9DcLC2hS081MND+wxHsD7BV5ITHf5mQGeca5dilPJEFpR0qVz9w6eekzVfMEwaT3veJK6jPy1yckFO3lIh62mPX6GAmNdBgp6bOF5UPEaO8r7tP2powCX565g+J2YLuKh06ddZUjrwQAtVoQlSMmHW/X8rVqVO2/dMiPu9VRZVZkrq3Du6tCR2uKSU0S1+8+9xapWX9+Fn5BdvtPndkwI7FGaQepTgzpgsC84Eo4MU/bVIfu2J4L1LYnYilBjmPaj42V+4+Ru4FbzdiE/d5IgYI0N/RXAwOZ40gT/btF3Sb15yDL5nfAg2gF76mvRy+qPQoLrMsUDploK4wFptA/miXucR28NCbU+TWT9IiW9TEtXxZt/uwGVJiMef6Pf/CyxU0esY8EGq9B45aWw0FGzeHa77Fpqz/P2ZNj+CMkR9YJhdHuwe3Xa0svHWk96aNxfLl0QLTcr+TrMGST9g5kW4GUwatuWfGUTlrp+tEM8tXGlZ/zz29+9biq1KV5g6jCjHevHsvUWyh7Jq7y67TcqkL0+LGUrGAl+6EmDXaHvd5OfxpbAQGYJNf9CyJmCChQqE9ebvVSuhnKZ2ThzgnMVRD4M4n6/g7WJK78OHN1pZ3tUh/9IYQm9GxdJDWC1fSiWAiblOnPvCOTa7hrequ4YHn/1w/3Gn8Ank0/rpIMFxCPPxCzW1bMtByOUKLxJNLXCnOL8maoN593EzzO/QXmZ3FyhKx5mt/vxPlAZ/Z9cuoxNjq3VAST0/9MHlJKzqeS7RsvOvFA66WsCvWofbe8lPyaLrwYC8ud1dIR0ynT06V4ioQnNMV1fakzP7PmGJRPfLy31ChushTxGjPsQGmdJET72iZp43whJiB1FKX5mCSLkFMOBw2gGR1eny6x3hdEarrr49zSPCLTcxUZlb3MzMoYQ9nX/PzZ1vKcQ1lTGMiTbRYACXv3Sbwi62HjdwpGvJJYmrQH/S/wiFWO/Orn+heyfXZBgaRhd8A4DV9OT/xPf+fSHuWcj/zLEeZbKf+dOVh9hZCykOyn3+pIxpJboWMhDMrMdPi5BsK/U9TQNHAam1kYu3NOF8ogqxjfUWqdu1gtzaqbtLpA8oqcdKqKQGzgCj5JVbFQ8puyFOWqylCa4p1PCpB4dnqorbXRkqft8Ga3EYUcN7XGTacUYzTMyDbdboXrtUXAshjkpSoxdaQYtzN5ez2guhFLn3s0rEgmX7r2mhSYYC8GoAfbzcoKOm2Y9SyJHstRB1oCgQ3YEUzzwHcSMm9y54Q4CSZYPlmstQdo0dp9F6aK7kIzLueGXqSs+eYyb/SOV8nmQaAQqMcME+dU68yoYpv+d2pjPPexs5CyaQolV+B0zyOWzRACgmdsZaDe4nTSrZG/MbgSNpiX4kcXD3qGwmpS9QnkGh+AVtxxrLDkShD1vjQAPFJ6MSTKUYDEYEzCGekNMIrJ+OAqJpmNLaGMzBa6CqTYYmB8U3HQCi7OmbjMoKPAhXLaKLWvZ34l0WlmJGcFesmZmxvx6ds7UDL+43k3XFVLwG3PVGAVpHkiHswIbbYpQnA8yHA+zJ6DvcCmYhi40DX9yksx4Av3u3iB8wEnSyTp3/EPWlYCs1xXRAC1dhXRHI3I9aVlgjs5G/nK0k4Vp7vFnhTvmU1Y+HlBBQ3qEuSGE7/2IvtqfqRJKRwFdW+rcY0k51KtNwN7VrkqzEBwKaVwojjKDTWXPWIAZahlGTIAuUIfT1vLAgnmlMDw+ovlWpCbOjNgYz6iC1CKw/rno4O6/9C1CRkXWBi26wTG9x6EUMxziQjRGLVFOlCxFXsIADG1+2f4em+BkxGMALP/K3Fw/H9vx52lIO0/tWBuT6XOM7tvpas5N36nK47p3/mugI3GG2la4x7G1qbSCIrpAopqgCEfeivWAD7Vcvvj1qUJshCQ7LT2X55nYv5+F2Qk5e5jINdEKdwwa7/hkWAjC10Jglm043btGN2usleaqqJJ11AOtO15IcRvo3E7r3euFCDPC5SISdcRrZLvRYPIDVGrg+VTMt2qZcqW6EA/FPvxMIscSjj/r6TJX+qM8INXC3bR87jXfTEC+YyLih6qWvnNpon0FKIw6zaNZLIiGCJGbGOnjYhpjfioHtbcQq4YEE6ETzdQi0jPhAwJweyUqgLHfQolWX5h76l2K6lwIfml0zkmBcD4tCDO76dRRLvV+SVe7DeHxZwvBkqrUiHybM+lIGgKbDcJ1kaAAHKPTbFkuRCQBBC6SfX8jM+ErVz0eI1O1Jl+hrOCx4SxL4rL8GRijOtv9Rz9q3iDDYMkc7BIpj5AAQH8RnkDk3s797k3eVzB5oodTh3fgpaJGQJchbfm8IsOlmWOH51hPMjfdBhsXSqvDoHvRQfg/Imk7H7nRSfn4P0HJJaim52sTm4SMdaakAYSYQedBE2/10pIftqlJoyvJ/fiiNDuO66/bhqir0tvJHDMUGnnjCK2+3wQs6Kujv5SZVwu/ssWyo66mUMNksYouyG+wzlN485t8OyX2x+FIW9AlcpjOuC27KDPe7Yfj02HXdwUFmwJjy0MJuhD10Y54NJFvUne2Cphw+qwmXzNkhyh1ZOthfxd/S43qRhFPDC3TkVYsPxlDaxpuQdBnQ1++gn3ma1VVVAxTynoMzHh2RXPFom6KSpCYOZANlgjT9eU4uvzvlvplVSDBmSUbpX7U9Atec+zH+8Xk3cBKRzIEOXIAqGF0vNhafo3MmYIH32J0WyQrvLX9FvuNim4ApIOjB6DuGKSVr3w/zkxYTkghhfft11TCAaX7s2DDtKZcWh8o8RUgcuWGEhWfqkRA0CophwtSOgWx/EoHff7Xfl+JiN7FiNR4dqsXCGkuMT3AeARixVsgKkFB674qnT+m25Gvcu8sRAhH97gpljmsID91hitkFcNY0TWI3HLky5D+ehbxZrtjth1i2dgW30xsoKxkiw9qFvEcSiip3yNpHEiIm5PWOcHcpRbolVF81HBHookpazdhoNGFRp2GsL2OaOm+iZ/swTIUZ6nPPhbv6dQtm8B7WUY+8eMi8I60CziDR98SaxS4BpQp+3aWPNsU01Gx+kAdW9ERuCx1NIDdTZTKkCogmUCdOKawmWjM4zb3PQa/GBauoI1dyqluiyFZVP8YQ16BY7fTpeWkvXgwmK6j5xAj/86WVHpxYnV3/f/US/crTy1+lWSodTMwPhwcQhp2eXFnEl3NEY3JEWUTbs8wjFkhRwKaenvn3tloqegmXy8DvT5Yo4tFF4oxQN+GveTeNNcvLa1nYW9BsbqZnQMwqRl0ryIf9P8q0a5Wo1Yr6wko+j3XnGLmp50bsvXBb9tOtVUjh9U1ADymTR5D0CwJDtERxXKjv/wjSECcPfqfb0hNdaK/RR+fGk82o/a3sX/jj9vnc6v8RpdFHf37EZKrpuqA2hizPuSCOpl+VSwn17sj2bucfcJc+0I5Pjiu/n0irDpF/VPQ+pUWWTv/Rwy5Vhdnc9VS+nfRpwoExFGZw5GzC6USWdc70R8D1xwPDmJvlRhbncdWOudn5MmlTgfcpkuVLSyR6JlZK/FTjr7W7wY0XKaqN5rAdOiqCHD7vAAMoL0uNlng1NkEVkWQexAH6Mf+LWTMkupizPa6BsafZJtJINguKllpih7iLjvwzeBeHuL1cfJbcSEMxk1X5pG4DJAMNtL+gPuL6MgC5PfhpoqR0ISJHZAhmd5+1+/nr31RhDWj8xc2ZyoC2KU3/4bwQ3CLSnS1ijMu0BCsmSJ0Zvz/dB0meDk6q/S/OwhxYvKOOodRef41hk2ao7mJMdShsH01PL79tgTOGUdWGvWLnPjZIi0EhOO9QzGDHt38Yxz4gktXrBfoTC30xmBCw/biIYdR0eTZfSiQ4R4uAyXJBN2GUcjriiSm42haq79oyaZxcIwMOJDzt/4NvlgVjfoXIg6bsihYWJdAe6FqhNXRQ7m/++THjQ0s5aKAqLVfekx9Tdzznbq6UhgXLDalNQiDJFOczd2EbsQljnLc1xQAQUkXo1I7ValtmivtNwVreNQx6uWK8IS8x6HdJL+hurvXHULE0GwAP5REatLydf2T9rNE8tlJJVUNlQI63up2eTF+305RTjxJmLWOLkKvPLGYHUqdf9OYa9hWpfltBqkX4CIFeTmd0z15Mge61NibABLcODV4AuoxWMTCDBcZza0FNH1wwFA5UIbKHxpaS/BPiWTYSk6SxrKyz8PLuzvLrIn2bNJsH8FSr+yv5LGjc0BOpx0/nHZQxBMtCJKfidK30IR0w/KQ5Yas/y/eXH7zqisGNzszmRrjLzhLjfWpI4H4Ml3M9u2E5QlAVLNmyc/baKEAox4jZa4EopTD/Wu/WXRl4u3CbRSTMaTlNlaNkBFAzkbqjQSmxlXj8mNa/LquHr1scpK1FH7j24+Z6e/t9dqED7px6VDz9yXYFPeViTJ1dxfTt6dn1M3FJqOMmGKuPUqz4PV3uq0OsZiwyajUB8IQi3W7jq47OK8yzeEdcy/ZGWbvIWtYAcnj29pSS2AjpoRcuThxfacw/QWJ7toxORLq3kIlJ9DEyq3VzGG45SvpailcWDl05NL2rlRr2EEuxYKK7oUu2ewtvfpM5TUQ2AqjK5/FnOSytvQaEC97fSUrjYIZ2laa5dsSRpKuDVX/O6CKDohlcA70M4zfWH7TvyAOVBhciq7YiLYH82Ff18a1tW9bpGg5+prVC5mmuJuFPerVJrhx1whYpRoWveUToddRi56Z0RmuS+Qgq5tXtmhqTJXBpaK5g8S3JlMDhwaWmhj2CHYipOZrn4BonEh6qrLhy5FA+SrIIb5oBEU42/kxedg1GllI1jmLUga1I/YgE2fDmq4JDOCDUbm7owMYhu/+/qamA6ASomDH3OGjpVp3GNY6iEqk5eiFxxXpu52G4D1h2JJislpDQj5KOQkKTvfdcGvwCZoQiutoumhhvyUSDjjo9czSvzrMSEriGDkYZhWimXSO/hltV/WaI3P3Pr6Kd8xgxcxExqhwb0VktzAmr/6nFtOygz4u1vxkuC7wpRLkPZGXtffTPeiZ4thY+2it7kcsh4YYugHTA4OEXBFUz4IyKG5ACl/3kWGvoYMPsSNjMstmJv22GxmuEjVkDyjbQVO0uMOWYRgWptgXk0CyG1Ol3A/rasVsTqAZxYn+sv1+ybA8UbZG6D+2x3/jaZhN0y+eOUpA89ifWph67UAAHWCk1KFrZiJPriEqs/qzldIEooJl0VYQsxz/LwLNmSbaqUwtHO644erD0h+U9VyNbkPFjXmwxudIr44hdemrf+LsCRYbgJuaxfFd56Q+710zd2/78LKhLmwLz/3v2C/jQcyc2PIaBo94vOUSnAkIS+UuSNaIv0dOaQt3IDYONi385e9GAdhzh+sch65KXJVb25qznYYXYgcD0S52Um7q5tDThWKgEEL9bVlv1THevjsa4kwdzrvt0cuh/jABF+g6Jv+zTG6HRdP8zeCHymYcjLKMLZ5EAGWJfLB7oQ/8R0FkrBpn2pmbwkJUSlh/dr5EUrVIwIxw6jzpb7ZwIHVl6Rz7rfTGveJJZcIcrIsZFGCAnGeCbdmr99pkogEnjoTEdXm+tqbS0Bbz3vFxrvyjxk0tEcjgsbyRtvQXOFZ4rFjThGmUC1MWZcc5BJg8pflfg8yjCWRt3LY4vOBxjq+P/X4oTeY3fifa6f9BbNDvWILuO8HvwB5IycbgwDn1G3uDFHmD11tKai88k6oHwqgGetXLEovqiw5//F+r3hJsFnUpfdeDsqaWKSYNV84bsCDst1j7HzQ0kjxf+eBrUPN08rYGwVWGff9pF6nejG6tFjJO9+ItUhQWHpSOO1Yk6PW/TzQ7Av81CcXI2Jg7nlOdHe3U4HF0Tas3eCZsWpyeimhVKHqCqOXbOrZvy+Etmh+aRW9y6AHtyUDFTnRENr3xpvaSmDOVNP9XJ4RRYhkeLr/TIQxJqgu09POUUaHQYtbZ6acs1LYEnleZ8drv2oTcriTsHMXQ2OElA1TRBvQ/d1pNdbqrWbSd45iwkSGzpmknDF6ChLw2ZYyBNzkErbXVru44pXf2F8D36FbloAhmH/2vchXdydq9Me7YLSmvtw9pcXZoHzmg9/91Itbl0TeIp03ss+OQNxkUSihHIBIMLwZ2i5JMi8UVxo8scIpT79kdp/6kWxCIFTUv1e9MnEOQjAI9OXykeTdXThQLH/rD36+Bu2sgdK+s/S/rF07nalC81Uwop3q2jst1IqIi3WQwgP42hZoLalGeWxUXI2ahTbrmKb72zQP4nriKe/7JrQ8Ls6wOwy37JVPRQcJC5r3DjoYrwpF8/8ZqYaqOPgLM3KUP1FfVFP72IqnktuvZnh3eYBcrmk8wve7JeDXeE2nf66v5W1bBpOQ5vJDT3DUGGG96TLUWjr2L8YyBDLieRbu+/tURWreXf1HtZ4DUV98bbO4OOmDET3IiMal2mNrSvUS9Tg5f7CCh+ePj0SrhEor0zLiSXyeX7Fdqtov/9siMSBQzzc8VBqNfT886J7Peuog165lCXA0ouxY6kKJkQH/l/EuyqWRgNhpqFcL0UcF37fGJQjQ9SjNeh5lDNg0Hqdl+q11kvXyaiEfhDlX0Ze1P7qVyzNrODRkLV+CjHtYiDevVKu9c50kwCCjlrOA9y50mTZ/pMZErn4lOG8ito0shT9/C937MFtXHZ2fjFumFD+yrvTIO7azBKC0/0hCcJva81GIF7BDZa4BtyjXcFLqoI99RhBEsSgjUNFmThrdj+pmRBXppnrRTO6ZWYnfHEkIDGTxmDRqhHWSQosS5Olgz/r0wcG/syVwct23NvMe/bpj/OykA6JhsAb8ZcTqDrs6KBs1/9fclddVaN6L26xdzI/egl8wZptaLvr36KAGK7kB+JFZWSHtQOv9sbfupp1Bm/aO0KIM/Bx4Qq9/g07WLmzThGymXzZ+Kbhl3ol22Sxz31cTasKPE/hyFocXPxNgJGNORvWIZWR6CEPDcWjjiigWFcwzSs6wsaWXjlEhRudi4XPh2kAHRaNJ2gpWMtTTF4uYd495OtzY+/8Cxx+X+fwKo8Wk7SYxqICyVBiuQo8ji4S3D/EMTF/45IirTeo510WrsU2Ej/vgrd8O7DRxwGRu1TpNjI6f0ehwAmUw33CylUkBYo5BKuCs/8XgikMY2lQ5h+UqF0+JOLoDZhrBpe353Xu762Mu64eyvpSHuL6rXnJ0ALcouF1kuzwAmrvKUijf9Lixu/caZfpUgZjggR1qoZvwg7MzzFfs2Ew8hue6LUInNIKChN9HUxO909a0nVK63oX8L5+7l7PuibdC0FJ2ip70no9Sx/syhmfT1jc8Bo5nPl3o886dtzDK4+F40Vtz5pDSw2YazHjtbO5EpFcsaplMoizGJh7l054LLvuCrK+eM+PSUwSAED7k5aMbUt+1gccYIENcSaEqo4tY6g7EAodkJ1iPMOD2eYq6JGsUQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAADqpknyH1G9uue+SuNM5uUhelj9zn9H+ap/O1j6ISDis74yee1bu7A6xpqA+Jh5qloBprll8T9+WdR6UwW6Wtk9Otuds765l+7CYj6lACJ56p4ze1xwXz20U9vBzB/JsKg3Ti1trudOcTx3TeB8CV/2qtnI+YcCZsxhrnl18Fu92ktypekEL0q/9Icxw7hQR+IpqrccxSpqmPWD/To/LgcNWZ4dTh1q4c9gJ3uzbQ88ELC0Zd3SlIw95EuoLult14ASnlOsQo6cu34QlV5Wxfxp/v3/VpY+fK8FTp4MkK6G+UFey5WK7popstohFbcSGD+yojL9FrPgG4Iu/c0eichdqfyT/GU5yOKFpr++teH9YT71SZZYUSWh6c5/2EoCWRycLOc53FOHFz6EwXoakWXlyoiMQV/PclN5Ct3vab7C3KpxIdTj+1t4arSZaUqvBUMn+coE5GCRE0KHAKD4WRLsxpDwd9rQlQnFBSZrlqLt6/7hNKjOBWy9sRTLJkrjWXjJQAF8Gm+fCmp9esEgmwJ3CaKLrhPLvC4RAG4OLZaLWTnZIlAMm4YgE7ihv6W1dCzihCy2Q5DJyP79dkCN5XL7YBRElMj2lEhbhey2ruEJVsdWJn0SiUcRkiJD1ehVuNqLtdZp9oHmRp6L4f2RMuZbVDlVwn4/KkutUAWQ805LvR+Eu51+7QAk7GeTpfcL+OAxA4jQc+5d5qKHMzVyazMy7nJtByvKnswlR8J/dbnt8MK8zi1DbcOiVUQooxTo1S498tBXyLufAo9o4CE+2hd2wvIuPiQezOud2Vl5sZcry9x+scZAyG7q1uayOwRqaEsbkUSWgBlHcbKCLNPWXQnfDxPgiQlF/NBsqBV7OZHpx3JIRGgRrCeZXNqlSs3vqY6t1SHxdk58UDXj+LCns2LbKH5wXhbOrM3AindpvihnZ0uZFVM3IPk0ukgHDTe9edl3XiaAsXcUzhuAaiT/0pLc4yd1SWEQOiJt/Ap0tfAfV5ODI8RbN2r9Qzpf/lcJXKbd4ZGlUd3sfbNyih0tWCbT6bOMY8GIji+l4cA76fWba1sr+3gCgnXL4KdX1hxS4U6LVzi75xVP7PatlikmyxQQg8QDl565XpN+pIJf/zWcXBgclAG/gRhP7u0bNCO0PuspV1/yQ3zuPQv8HkbQDWGylba/yrVT+0Cv80CG1IT7UV+rAS7tg296WhbXv5e0FoKob1c1a+RocbaMVf3JxraZLZv6cKPD0UTp67QRMNYve5CrEX/qiDUZsXMhUsHGw6rkpC1Dxc8WnZOR323s9C7lQbbY8MmhN0AeI2Mt2jTST02fkucW0cc1JvyZzPuK00zohu7fqNjk8Tp/cTHeupQU0upCkLKba6Cc6gImGGYNSgt9h9Bj3hUu4uMDDGWLOgLA4B69goav7nUrMFYJozkkdyeGL3tcTuTOfqGkRE3aYYoDhiZF1veU6CfV7bLyiXQ1Y3xPd8NA1j0HqoMxZtMKV+AfP6CfI4D3PvlP5+FlHh4CFdk+yQDeBVFW1mMZHpQOODp5uw4RU2h4mzWN7m62bUwIzBRJfZFvQhY6ZyPWL5v346UL0U4kicyYQlZj9YNkfqII/RWfazVwyf7tw2vwWpE7OyQTLZrO6/cMpOnJwSYt6wP61H1gQSajLxmFl0XUUGJ4m9VC/k80MuNmr3xn/ceKHYnTFKDd+1NsSKTouuTqe7I0KtadtsMpGQA3jrvN+HNS99txzbA6nHIeTnuEgmAu5x/7gMLXCFah2NyfVZVRjiHnei4dlGhDQXTjBMKSfeJT4eVQzOGund5uJ87RKhYgiOm3386wmgzEdaFV2oDLAPIc2YK88cl6Nh33/JGiLRNwkymh2mNWtBjkaaCgkMSL+tHtRirUyA9/cmQm3gQoGuADzd/2WNiHiIs19G7bsZ5Q1P9qaP9vSbO42F038k/E2Kw5fFKt6k5NE/xypB63y02XHlMWogm9M4/zbtnPTw2oEfNizUqVg464TaIz2YQyiuR8hP+CbzvNC97Qq6M2yZmBzwrpy46lUxfEPxhx2Rghjn5ufPkzQb+tuxDS8XjWCrBKGkIXe14EyD6RouobjjzxRgS/5vrahSjN+GpNd48w/UK7Zu90dwi4Kvc8c9EMKVzHlPKbnIYsGaJ0WrMh+YYTvDLrTL0lkgA6Wt/hgML5M+8MchDbWM1pjjUdSq4IUDflWRRzx4hMgEDamXqXOafN1auCKg/xqZjvTCgFe23OYocFEbCkjxg7GHwKqtsUASSeM7kYnMr7Kcp/F3VAp65FGNLmuwzaU8zruRh6uEUfHBNiHBEo0MeaafTe8aNoheTFDPOoCnh4v4q92Yd65nNUb9zyEnBng3GXGQaQBEt71at5CnqqdbiWTULaq5JJEDaMw4+pBCuDO4FOWyTpbqAPMZJk0cQ767Y7jqPo4/G4m5w73W0qfM1smPvjU4tny03gKqJSkodB/1cRUW7CyfneHlona0jpY75oIZPI8HWCIoJZW124fk8gN1iIFj8N32lVc2KwlUo7rQT7BP3G+wIZODKihPodVYVkPJYUVqSk4QpmKxJXNsEYypyQfQGpumDWENWaIiTGVe0gXhyst1MpeU6DcQHLUEJ3HLSqxkx18UZNyE9ts9lMHPR2/WRR+4TPsY0+8Kz4VsTvTQVTxWL3rk0qMD8uoz6PYrvnRc6ysYcVeLb+elwbw0TM+iq0ktIA6D/QrZCGEywJEd/N+fXI/LYcoYc0CImgP+olzQq6bgXQDd+XaSOgHLv04wznZ8oX6EmD6qE+aKw9hI7FsZ7UR+cUGTV3EEsASJX8iPWSLBVuS7MNtZdioe/gRe4g3SjRg5OToz1LvXAn9KAib1FydwomsShiJgG8q5t3GEejBwmOR1GR2BzZpUhw9q/uWL1inLPtIgYwHtWtwlPfAU3Fk96BWG4ECMRJvz3/lClPkMBLdKEnxRWc9rUZI7vMNSbOxZ6naBO0gPyx9zwtFvzjuOBHdRVJMNBGB82QbJcwmn3RwGQzKn1DedBM8TRBf+Qiyt5FLl9nH/9d4RzsNZ2u+NEjYAIBg6a7HGLJl5c3kmfZmTR+nzVOcnlkk2R5wd1tl9H0SQhrI8ykT/gv93BAr+AleShAoa3N7DYxpW1zQkGAy/4PbfQlZvB1olwezlQRTH69tjQlM7S9p8zY9zrb9+biHUEYiRlckFdgpe6dcmyxl2NCDSfDrtvw4eFn39RPjviGv1H9n78g3EPobha4KMkYj+9thdZ3zFPnqChWzKw6d972NV3CaAov/l0Dd0f2LHHxJgEOFqcRyIJI904jV9Wu98NyWwrx202qpIVF4OIm5n++SsbJBAr/s9Qnxh/2w7jDII0Utb83/6a8aqbNKSexL/1ht0kWN9e+fnKino9c2HLizXUB8mPwzttE6ZAfDcZ7toQRcjn4/AwICPhYq8Ofx757dRVmldjkVAbSvVcG+qWozKYw9MY7KN9C9gOh6pp3dg3nPfGXLi97/7VVyL14/7XbiV8X8/0lP9GsII0ka1WlYKe9YZitm91in4QPIINt6nITxkeTvG4jLA4zIWRQiwxMJc5sxgrMte78I6k4Y1L8r9X3E/NbQwfdyImeOUDJHaYyKANYP/+NudCA91iRpL41NxtIdF7B0EqxiqdddhidYXyU39E5KNnPX38cSQMIDCqJFL/U5rT638hntjStGyMNRDTr3eCiE07loXj/cVTHhrrLSy2OMJWyPet8jIhSvarMiyEtxJGq6srsYWD0UEiTdtLtA2WFGtBAdZx0ChQ1B6UuzmWwZCFbzoKjaoervWZkNYMlGH1PdS0kfXpKklL7i1FB4jOVJlotXy4noxpWg5f37A4YfPtxlDdvOT4VF+I2LieAjY59Vnv0ERJskQLgFb9kVP3JLACjNEm4deeX2SWBBt+p+nirP4z8WAcOa4Z3cmL5s59IVyM+vNRsDQEzm45SWVfsGKR3RE7/2tBboT5iudLqthL2s13MP5lfabpd1r5Osj5BljThVqOiSUm8jo05b68JQwEjZ3s5K1yEEK876gxoASPFnZJLIffzc8v+NwaTBi+hGUYwPNoavLtv9xpF7bYvKnXigvHzoHp2vrNOULqSdBHKjXEr9Qy60s7tbPj+i5mwA0yVv2GVIrKhL+7n9JgczZ1yJ8xcnHtdctQgxMqgdr4JmpUNSVv4pshoQtnmEtPqCE3ft8QYdPPn2vhfMXMI1dBEU3CdurLXny2uKXVZwJwLhcef+fAm2zLFZGmd5VUU8VNNZRLI57VZFV1/ATCKuMwNg8TG6ztemtqC2WUOPZqC8tPKFAM5dM1KVxVh0vW1SBxQ/JjsTjsheuFgj7SFf76gfcNdzU2+sk3nScFm3tUoUzXPWor4O+TJu3ELN6F71g0kldmjxs0BXPx8FYoBtjdaxyIhMjqaC8bMEmfoe/nSqfzjqEfAdLgZAOMlQpPGba+Idgilj2ua31LRvQBeM9EbKcc4LCedwP28OLZpELIiOz3C1PYZAUkGQ6TXjrHetUvUrm1E4LZc6Z1wgyEgL9TV6GnF2SXKXo9NMH6iJ6wFZVBVVGcpTYAtEL+Ct+IApy5kDmIU8TnRlXU7ng+L+5s8kaBMzFFE/ZK1ZsRprSeZ3T5OYSi7/D9cN3MHUpioLPyMWiZ7dXKfLodZoWbj8+49vrNcnP9o5QQXtImJx+UR3J2r1QdyV2Uy79fbruGLwxJHfh3Ui+rLs4UVKqjpvx+G3/53Ls17ZpY+T3+gs7WBcU39Y7HagFqnp4KqAR4Ri2OzMHwYGMKvsLbTVSNcwRHvdiji5lc0/nmwme7ewoO5EnNSeEAntzM6E+Kxos1VQo2oss90DSKvG4P6d3PXurSxghmD3LV/N9BLgmvqTc9lDgIERxugzzQVl0QchlnYduYa1YB26UE0naHfLIOt1eXD/tz170DRQMCxxN2sdmTRWS/eBwGiLMx8KRxYLSnwQ9TLzd5W/n+vBnaGZKe1MYr8mfi5YvJUMsJlSa0xC5jDrBIEtdAPb9wjJl1Nm8vq/5FJYcB/qKIXObOsjYZeVZki+2m9o8NGpOQAIAqrWrAF26jJhDClTkUNlpGaFkys+uxHec9LJhEvSUycf8WzXtnQCphGETIF1Xyoh44AQ9j4ib5WoIYJNzaPQKczgzsXLF3ED8zfsdGKpP0cc+DSn6mNH5R+685I+CN12FBJ6RtJE6MpoZoq4R81Uzn1A/uT0dxbSq5GZbwV2eGbi5JPuA2sALSuvXakl2OymYa5pBt1kd5OKFbJF8lJyVlMMDL4klUNZi1cG7viEz0zMFnJHHKNuJbYMs5y3OcpArRbu7Kc3VKmedznKQzlxoCJKBMilkhVgDQD7zUZcoKLnSMe7iB8skCGvHFugaBvntu7AELi1dzzx6H6HR+51f5pa2UsvRmu9iac0rGW0SRhyV96AgYuCv1pTrtFZw50KTN7bNZ1ZiL8T89YW1qB2UfDD56W7jYXE5XG1JvhqbdoIWw16DcZDiYqZ5m6/Vrx4yNgVLA+okRH+J3urFrtYlbPIlLH3lkSvl7W0bP181+osxw+LmFApHHQvBB2IzXOTTvvoDLAdSbAR+OkbcAg3gg5UsP+wSl7s4qT5b/n2ogD0UERgzcKgQYsAdvpXOg6knq8FvUaiZQ2VzLU7AX7g5RUBSay4UuQbge7uqfhr2bBv1CBQ677YjKSTZ9JJFLPv0QJ6ghEb9IW3Vj4IZCbJiRtwPP3zbn+AzCCb9U3okoxdNbuadu+iBu/yUs/akxTRhNS9VoMh+2z6P2WlhmYyuk42uHs6NaixLWdomLiqu6mT7v9N2vq3wCNycsw8pj8Gjl34PCHcmrgOR1Gpw5hPFLPV+HeFDZdLcW0BAVR1lbp1semaZFduW/xG2AyDYJRbsF8oUE32oLaWmInrdRUD2HDoazX9ZrR7uzo7CxQdK30rmW6qjB2NDjB4F5t3pqfOMUJKUP5um3lrEYAXbwOckTuotcIdrv3VY3BmSuDHYAQedQGR03dBQ2fsFNAKjCo4v7qeVm32KYU7tnunnQ/MXu7Vgz/2Wk+spITdQzFe3+rznTUPmurlSFbg2/lDOkP74wYgxFj60of6wDVmoNKOSpmotr9kChA0a9Hi0HM3bHgKA72KgWnp8ygyYINS+SNE6cClFIc4SWK2yrm2QHxJ52YLHguGSK9U7m33OClFvmAXAlpQAedJrZKpD0YlkhP+VwRPZ9NEPDxqLCNhEEsJ8g20nIhqoQS/Pw0WVx4YO7Vm5dwSp+ebL2d6wqVI3UnkuqT7AzXbn/PzVFER3q9AwJLstxzlHIuAZs1USACqiX3+zNa/HoFkRflgcybtZ/0k6R1S1W4BaF1POwEbX8ukVAgSvzxDajckJtSAJZ6e1kVg6KP2OTt+rws7OG/cxO5o22LCorI3yIjSUvbsojC6Qz4+2SWN+kPGWXXTrWwAzSwltKCXqjG8ZPFSZEI4j1ev57pIZn1x12PjQUsDuAFjfnwV2h2YMZt6U/6yPemk5JRKVsySulMKMovfqwySREGlMOYscWNxpYyYOU3dbpT1UH/aNZll2YBPW/G8uJXFTvc4ulQwm4aHKEJZZ/o90u3vXu/TXpuhYxpRea/dlQDVffROlszdtipLJ+eA0CYIMCpN86hUlI1mUeRIuEmNmliw3Znp8fJEiSll911UxodIlYdVWysEsNBKMq5340H59EhyninsmW+tqAkpEr9uh+aJ9jcjZQ49UVddQju1KPmL38LV263tkw1HJiXr3XSPJOYJNcyYeBzT+5sKiymC9Matu9vj7niuDJrBj2KAE9Ik2ZIn205zvagCbs0/tJRQpf0WTyMHNnVezd5N37ft7xR0DMekinviJQ5WWVR4n0M7KA36/9l/VL5xR8NHepXTxk4RT5hDNnlt7KAitp2IyyjQpR5L/Uw5Y9zk3bS/1lpa/rJdEcCZuLEHgmkrGsYrM8u8IkCbnKtXbYKMjlgRLosXaix7dXga8b7s0/+2BrdvPhT+R94VbS2vB4ZRDgHi/SXhMOSnbNXpuQvSKh48vTVN6hqwuCOqYlJquPbko9ycSZgefsIP0y/P+i9wKqmecJFLEsLeWP3fJVFpKuCf2Aw39OegVTiUBwj0rietPXVYVNk7GAzikfF2FsJEHcX2DyRpJLjhoRUS5YmG4uJ/C8w4b9GvrEw/+EDWhSgV19I3Qb+lT6xi6nR9o/5S5b52febZBRUMISCQIwfABO2K0C/LfZzolpWDqVVkur8ZXpprbBFu07PpEJHrWFbgB7pT2HB+v2TLgM93DturTXl9GjAMikXDoQd/W9aj6n0+KSOphRab/iJuXKOxRUBPc1uk05RD5MxnWmzK5jZ6oWmUXRq9GoydHwMVhyrgSMFmFLwZ741ELTBCBUjB1Rql3cthsiaVInO3AhjnGTNJThgjbL9ZXT/IAJVoz87SM4pB/bRLDF0gkE+0/OBvwJPuMc9LRVw3oZ/rPWIHWzfqJnyMy/gZOEjGV9tN4iFW8uX8t7GW62j43YXijZYE4yH4TNj2aJi/41/8a/yCsneRE/XcL0Ngpa7aJgIqDT5OJrG+vJJiCnXXCcSRGKdRa12aF/SoyNqczcFJcWUxNpCyMr3w9b2Os99Itur24oRfQLevcwdkJfxOAPlp0oY4j8Me6c6ZHzilqYgQ0uSPFtGapnOdlNSMi3Ll+Rw/wNJeO5JTZGvQpCCe8ElQL9s+Iln9Oyk6HgQpgQginrgfA6wFMCvKV4qFD/l81vGIrib3g0aZEFqvwGBCIxP44T8Qot+9s5ghpaw+Rk1BClS9y9DPc9CIN7ASLh6/ZLx7nXwSIbq2RbudyTD0qD7JUgLzZijC6BN9pdmRhCKBPgK0St3ThBl2P2k4d1vu/YyVOtkCG71iDcR2niZ3GqpFEQpidEH+vAodnX5G0JcFkyMdqyb44YMuXVBz3PNmoGvmcthGLEFTFIGMUoUKGqKCqKyjmHWUowEqxGm28gi+/z0DjpPc8/Ylq0++m8fTReuG+JTdx94cbZoJoHAPNMIVV4dlcDscWNXBDssgJuPm/v7deDX6oEALs2FTWqz5LOxyPuZc/Kd/NmdccRmqPE6meWNxy/LDGKkktKFBwRiHd9I8fQz5pqYFMQX1LhKM8LIzQ8L6ZWqa5HNHj/gbrbjfUcQ8tlihZkPxVP9HIHyJ8jCsBlpX/L6BJzpUiM+Uv6zdh4o5QetKpwkH9FIsil2u4l4V3IhvmF3XbQ/IepQH0j78RaNm5knpchsxGpXklMpvlH9JvzNeHVrGLOWcIKGV5bELaBtFYbBqFSsi8k0OF+xNxl1diP9Bzn+LmhmsvbOXI1CoXlsRpaAx50ojIRHeklObI+7uWWIP42sj24da62TaHhwsfxJPTfNc3ClIzoFgwHUtIIc6YX7WPqqeGcGSCVJszVHTS7D5qtoB80Ikw1a5pQ4qNBUvZYr2pLy+kwmo39eo4erMERG3/YuWTQY9ha5pVarY7lebDsiq5RACU9XikSm9vCrVd7+kVDntbb0OklOviuL9bfiVwWZOD0sESZTbP7UA4NQcKi1ISeSeXSxi08dUfsq1cQhW8Zf7PmaJOmlWCJhuDpTgeWyUHf+vKaY+7YFbMNCwv7TetvwzZkHkEM6zE/3Z5DF8Tmwq6vFm9ju5E8Hy29R0L9Iiy35zxQwqAG1ejKZt7S29pwEouRbbZopwrcuPJ4FdCQXlt6+9+RsImoCIzCbTkMvZ6fI3m6an7eMQ7mlrRwZpvzO5IEygcOdC7CVWp3e1NPVX/qiYqR/rZ71H79sdtz8wt3oFy7tMt7EAx1miDI2NIFUWkOt4NUNruNipPrbmCJc0nag+XO+8Q1FwqJDw8AU95n7+U9mPFKspDeelTjA3ACeSElZWEgX1lWVaqXwQwFD52yMJah7p4yj8PET9XvtY8MnJZwmN0rfh8c7bEw4hidWlinU2gVKQlRUQ1Qdhc0XlR5Z4bfTydfqAhRJhPAsTRDc8BJTxeBrAlkpQKaDObi6qdXGJe/d2nn6YvvS2e7aciekVqbxgM4iWCj+i38NZVi0/eVolOrk/+LEZIu6Sg1lQ0wOo5yKtO5ir++dAg/te+p6U9ZojGR4JlBO/xoqWc2yKerz2wHp7lNmHhFuWE8lfiSoegM3AmU3DW3AZf8L3fUX8YyW5e8gIbAtqQjZ1VcVCkMHn5RQHPhATDi1sTgGhokc2axcjdToTwmdaY/V9zf7JA2uajSqCGgQIS69TzBgy4D+rdjkdG8CiAmyAeWpO+9aZNtL0qek9Sk/yhcgwzWOy55GieJmBCD7MBxnLZ2fYoPbqUXAVn+3aqQ8YWaIbpGejOH8m7ZDsVIHmeEaKpgNeAgeDfXejPP6Y3iVUQH/vYekPyIkDXwtuHqdRHPkx64KTRblGe9vSF4PDXArIT9v3VkVNpVbZP+duc+mGNNokgaZG67FEfTzuWOtzui3JSTFWO5UQg1KkSov5UVDk2DC+3Qo5iCOSAcamNcT3kxRs5qKKD5FEELRgunWlBUEgtJv5Epfy3b/5SWbg1DpdQFSb2zTh9hvGEKLpsF1c/0WJqo7xrj0EPYgRmHJu1A4u5OE2hNvinOg/9lHUUvb3B0ivZ6hJWWd98UDlGsOby6aof7f+zI+A7GNbD/Y03HJ5gkhzRs1EnshcMPq6meIu4NViwyhpxLFlNF7xHLRm9kubAVdruPAjVWTzxC2MRQTgq0EONfSB8MMF9uQCHoNukCsT63JmWhfKBtbzcoop4MQb7PzITvrwtk/wkqWHuiAXUq+m5GiY4pHE4vz/wM62nS00UoY1JdO8Sb9uaHFkqytPTmgz1vhUqpDIIjlhYNOP81JRnNLyaPuf4gejFJh9rRkHkZRMZqJvFLX4QC5dDO53AiY6pF956PPHl2ImbYEuQOxwxib7nQMGyJeY5iyiTY/U5SyAvhtKhjAK5TZQRQYNTqlR3xP0jBpmbtKv+fpZwTjNHNUEmYhrzUJN40ICkG9qXXfJjAfn4wU5vzJIDfnZ1G8qm9g+YCsBq1YEBkP87p4ecZXclWEfSN3y7ekdQyTa7R/26GbZbx9O0yXE9d9kdroFs31Xp0k1nD3XqZd9jcLZ4r8c+Og6pkJJuiAnnTXqpelJdktsbPnZWYfQR5WNbBfoYULx634B/edIpTHTcwfFwknANKhJappi8SJCaAIj+M35t6KYamHP787upYan725taBWO6ybuF4YMgD//wAABAc1V3JA5HVLxczb4gjzKXkPpevcbNWh3M4jZsx/N23HIdAN/osPqBSUskcJ36saSuagRktoNnV4/+VTgWeEBBsck/SA86XkGR/8tWFXNblbk/pPNB4sPUNaDzruwtJxKM0wCu20FfR9FAbwC5Nbgmp2l5IrGlrnKtQPWxR3ebt/o4HyfjqL8ObF62JNGD0EIi9wNUpuLh2nOEa2Yz3QkcXbk39Xib1oW11sMzZAMXX+vmuw5v16ckhjxHhZ4AId1XPA5ykAtxeEZknQI95rRIla7w4cuoFhpzzfZFZbwdsaWTOtMRMJ/bXIWohxhR6QnBgSDk3bvJmZv5AhHC3FdyZSuhZTkLx7WZXnr5SeCNyLZo6TJ4y8H6B5G+VbvvSoFu91FAG95iq40r+kSl8mUfmiKc1iNM9/Pv+FGFjjk8WTfNDiKfZXAJlIWi/l1SUOsPyr0AfUAEPW+Gtd0ai6gtzldsBEHQ9SSTQjXFrrVM2Ts2gxn344Ov0H4xXxdy5u93J/Se+SR8eRC2kD1sLd+gTOIHj2UjIQwVdANyl/0lXfd3FyjVutn04ZRfW4VPw0mEaN8oF1Jz/RxxzBxRm3X/DMDqM+qKnrFNRlqyw0biER4cD8AXxXJXkI1A8Z75TA1fmQejtxHIovsj3SA7X75mP2B08mYRP1Q96r5ZevRS/mAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAP8rDc6X7s6Xwcm2BBeYuF39+22IgtogMI9UBIJFJgh+ST0T/vUkuhiK9MTcVNB5Nse37W+5DizrLJUeAfDCmQcpgtu8XzZsn3jinrvssbsiPetcTuY4tFg706mvMUn476YbW+mvZiIKufpTOce1vJ0JXbmUEuPthFbnJrAWx6JIx0X98ndfIwiIt/8l4CyUsGbODu+sj+ufxZBU/nnWgflZAiLy9rnl54pxOUxw6BhnjNvTNcZ9RcdgMXNDe7sSRxoo5QQq8MD2tDbrWQSX21hgAR9FgOF2WIUon2EjgEQbVXeaeZbFnat8eDKaiXbw7QSz51tG7meusF9gaoRSryWrsnnzqXUFCyelnl62cufys0R46CDKxIFeBM7tNc0Oo13uEDu/F5cNn7S+DAMHjtR8l2kFngLztkcJMTN8MHvO/XPAUrGUxsbdRqyp1kCYGux5YAkXpWXr53/VNGSaIRViB2jByBeIRAIKurAm99bZBPYBVi6dQh8EnKt7prps2j+BFQtZ/GgoJ/rqp0Jn7RHqn6GpY+c4LFwuLz8bye4+GciKaP2/qC1Gcb6zxHlWYnYjstz82oFgPOrbDFmargYMi4PpU18wYB0lDMC9PyAULt1et5hdgyQu7w45Yh4wpw3McHcGX9rHuFDj8X79yFSqytI3uYcTTb6/e+uf9ojeXPgTLdAILeaVlLozqlbH7BXs7s8IuzWLYXG++vjkoAe0Wu5cDWH6DfStQViEjD32yJRvlsecf+jOY/CQkGi4g7P6BUWDUQ1rkSJnEQS6x/S6SuX7uq1yMDAD78xMPBjhqFwkdZNA9Wt7oYKYBk/m/apVpJnxDOVCyxH77hKqK0+21f5NDUuklGU+M5c8QE7SG8NvkzvP/7EJVv57JsLUp0fzc6E6u9bBTfz5IJ3//Y50W35Ob148yBGNzn466GNxWk1G3WWVGmdugWX+EmxdXe4Mt4QSKO4R/bhsNRuBay93ySOWyg5gqwKD4n+PPfzWtQ5sxfs+zOuvl664c+hsYGHIu8Bmz1iHBrsbFQApsCLqj7yP8dx23FCTNiL8Zt0zk1meI55U9wNEZpmUuYXT+2pRBurCggyMo7up87bxC/pP1pmxLHIMzXuXflvcYiZxGKwwKL1jWyb85kjPM1ysu8bJDvP1EaCu2Uedv822HiyqmY6y6CI+YTOy76iOGtg5lOMrpXQmeFGHyfU3PsO98YdjNsFyhsj6mAW3zN1rL/gNG0SLQ9oE4ZLx5IhmLZ6MszrwQlJr/hOt1IVVLOGvPoJ/u+Sx6IDqntKy5jAbIStX8e4UjNbdKHgOXiz4VuJBxg+ckjxyewtxvvLGfR0SaH/3pjGGkDFm1gW2i67Ck+wrtFUwbJanUQyE+i8f7hVpuh4K6/kWPEs+T1k7j3DG5t/bGpubO5rQ7RuECiUTQ91GIIYYPEmTAgxwZtbkQlusXNmmxOnTctuQpl8RAfGhtfKLw7g9BfWZOZeckVJ36rE+uL3wggOxCyguD3PjrciOeVcoWO8yj+h9RoOpnNeBhzOGHmxQMfB5e6jY+zcfD/VCy9tJtI0fUWRi/r3ENzA66NkDtRWkPU+gc/LKfEskfIecUthA576lx9I0nFK32X+KZDYJrQxv1V/KcZWBbLeqrRQ7dU1v6JwjoL56X+MmWt++3AiFMNpeVKzN/wqEZseOdRI4oV2rEFRN4/J11g/UuEBNhD9vS7afAJJpLmZrOJIwSGkP3lN6Rjf5CfPqwr+LYG6B/fgkVLOJgHgixxOjwyumbVTVNFOlAnu+URKZD2RdpFRjNXv7YbW5MhiXpzuHr2B3gUh7yK8aZrpJvF43tG2lVFWEQ+amnP2hDnaTSkm5zb0ydcoMTkv7ToBQbZvQxNjpGrrU4WI3PFXxQ1R2gqc2ePxaUJ6Qg0GnIU8eQdiH54XWSkPhnEILSf0TwxLUER27/94Hm5+S65QSLpLWG7wveeah+WTCXWfBbbJOaQZ2051O2ZGfMEQExvHOWecoH8tMjdXxqHgdsW+tG8WjbImW9A+DlhjQUyGSN5qil6a3JJWvQCQyRVIGIbRQOyn35rp1cwpc9hke0JJEoFjjo25J0kgETGBdSIV/JBuYUqo4OHoajjVORe54U56aLaqRhvCVQ9xk261YJWHKuWo4ib5UreHNsn1nSvg2IrXShI5Rzir1jE1gq1DlXNJxq+TrCDFDbYZT2Te5K5hVaJ4PNa+YNTQEX8bV42imoJhLPr/jgRQ1wD6NkAkrZTu7kOdJ+TRXConBHqFfKTYk5EC5VwEWfgXSMVoQcPn7Fwf82dhT4/TPHU7kdY1PZTUFGj6sirsZPC8lO33WPQj4tHQGQvFq7ngs42SPTn5BSzw5x86+jDxbs1TmKL/fcL1+gX31sDzkovSr2arPSgzPzJ6oe8KAFix0RYCNJA0DxQnpx/LtsUfqKMxosOrUs5W/gJDJzu8U2N7sy1F3gHLkcjI035mpJe8un55XII/mU3P/lIdrFEg2jdRTQ2xZ0PKoV+J3IwF+vGgGb6kG1djrw2/V1ZWZCAgWmtEfTpDrzNrSEc/F57W0b9FELpsRNvmV7ZqMuYZJGwi+PCYISH0VqjUl1LEqCYc8HR8CaLKf6/eZ2RshsXs1IY+pgFmWETTf7kC3fxKn3OVrlz4tfI1Xbms2YEcKkhi4dGHvbkS3DLGBXUtvhbFPhqzEPwzvs3PMLmVMQvD5GkSBbWuj0ryOV9vF6XLyesn0uddKY21x56o7/fwgPJmGqYvNWyTEIt32B24GCGiQS3+Y3n+N/CMbvFa/fKsPeVlpuyydB6E9ptpxE+5d1HK17+up0ONU3CEijSJAppCIhC0A3WtjWOQwnR9b9DFsU8ArX2dhi7twp82bxV1ejQ0QDh05854VZTORf1O8YVOyytrXQ9mYJF9n152W2C4qLJ4+6wiN8a91vlpbcM9U45DIUUfbMG8ZvKkneW4mBNFj4s++TUfaFweiRBi4Uku/R7acSXqD+RURvxDZwO9B1bhk1g5r0IitI2fllEX8JA+L8P5hQXQwbUcxjSQHN9N+SrOHuDGgNskLV5rowGXkHPtjHrjD8U9D6X+kAShxmea27g3mDmzGRsm1Z/wB17+qmqUn6XKyOsV7IfWfC4oxLfjkgz4YteIBpZWCnrBuC0AVR0/iUNNyQA8BPvoLcMTo34FsDosAjSenqBaNVA/iUDd+hyMI6gcDPRjpzshh4is0k3cwQvFl+t9S36/wGuqVN3TiR8RREnG3Oi/I3JTvv3XlRDiiis+/R8mXxTnOtW9Zt6YeoHbO2R3Ti8Fj5swmqUtM2UEAnnTcnQzUJSSg/pug/CGStsIRcCDjvq/s/ip3yCjA7hhzEbkEaUzTvpQFw6e1eciASkVsiwXbw8cJZPK8eNezh939rYzRFM/ctf0R+Lm2k3HKShODqep4PIAYkyklM9MIqU8hPHCa6u5xSj71AJhM1M3VbbiX4kugxWe8ZrNyA/MP6fWLxTpUdqecyX+4CrPo0x5a0R9c5HRpbSPx7nqrOSiEi1VvSD7ohkOiOJwuEInuS/26fA+COCNjaYUDB8AZSRG8JYplAabWO/AhNMZrfEB1zg53pDA+uvhTtiZ5xPfgeA7d7QqIxnY+zmWHSgQVzjdyvOJWLKXqy0326EJyPuaWcJEl52+CppNrjiLIcsO+zXRA+yhCQ+p4G7JwiYU5Vg0zXkmImeMmHg9jiKqiPGAaM58t62sHxGVejgniq1ikSVRRc0NXw0pZESe+JxIwZC6KiJu2QF2q6BSCjIuY6pNdVUvlnGHRNnxEmaYkkzxOKJNN5eOgNDBp4aewEh6bjpstCWBzBmUCd6ea52gtxfNSa4QRNC60gnekmHbKp2HsaBWaHxPD9W/NqgMPZ/cyaCbV2SQtG4XWqOBRbu+Fg0kPMsUV4qrMepeVMun0ksFpx1krC7X7Wl9dTGCYgFhuoJiJtHqqOYBEUranpeZnPkAwtrr9wgjyb390kcze4XGCbJjFCeDuCFfjH2MIwvvA0aBr0yCBnzqn2mu3BBOIY0SFyzu4D6f2Q5NCOuAXIJLXzJ0dy3FHFy43BDzXAWq9mCEfCTZrLYrlpZ1pjkGYMBBV7rlfZPpW6u3U2w8mtt4X9eNtuKrTAVm56Jm6i+cOJ5D6TirL2rAO2ByMKjxyHtimSmMcaNWXH3oTIQaAo/9qEq0XSBpj72JNDOQSEWZ+Y6r3AJyvzqIdAuTOthze/Wfzgndbb+fZB8Dni8f54WQkcsuVtPM55I+Bj7ZsQ++nbvUbDVksvuXk0z9gkeZMK6J7aqeo68LbndxJMSjf3qJGkA2OXmQdy8Irn4nMaLlBLIj4Kwej7YoJryauSCyHSG7LXAW+NsxkzCgd4K2j0NsdSFLO+NBKV+ddaIoxY4YWLcO4puzBOShRwpBvq1nkbdphDlC8CxTAIAao4PEVZvforawaMAnJSj3Pvza3lat9b4zoiVkHBgeC70WBuShtYLxBNtKWgwSHJF68+OcylmxmOlftkYhgXGaSjKOabiJnLB26i7YzG0Gn9DynInamr9PiCWQWqcXn4yA76hgpXD20FrSAHNczVJ2z8iBUTkcGWMSoMgHhB0wO9i9tIZoSDiwy0s8pT3Y+1LaB9VegksGgp+UD/x2BDLyK5l4DjjUKuIXERzwWVH7dVh1qxDSOhlUbcyT6EmH3OJu9AOETHhVzkgY7iJiN8890LOmYdUFXByL7HFKVQ4PeXRknp2a+hpvP5Fc/D6eiK5wVjS406N4E/c22rw2NEDGPqxH3TFwlBtn29In8JQpnSVX31TFNlMuC9A7z8dM1rLb5pacTwtJ8GhKaGXJi0/8vmrZb9SOZjWQckudq3zQW1Lt43nVwsenpEoOj6Py+ZSTXRGPe5oLoTlJywJU8axqrp9PoAALN7Js26Zp/bP9nlKkHdLfn7WHmNjGXnmPa9UPjY0Do55c/e4TWT5bfj11BBXJEVljD41LH57MJDNiGCHMtpluxGXELOGXHpmYlxFkiuZbWDHFLvXuRFr8v/pIpHG2c3LA17U8CTD5n7ZICNHnt9Zk9ErRMiM7Hn02zkEYcHwFyBoFdru/5saLEASn2mHAUsZcaZO2b1fgiLyqICS/zTEYhIJWWiOT50vRMhv4HmSI5MmDgMO+mg41kwa45DajJoVsQxDzhM1E0xnSao6cO+wb7/4m3XDZBs4eEaNqYezO3m+CNpf1XzuKY74b2JDu5ddovQmHo91MdkrqidXFLC6ls0XSQiCIQH4+m4iyAKVLF2KiL+qN1nBXfWU2yRLm996/XjzZHQ4eJv7ICVCGy52XX379ycGFeJGpoKwWqY5g1/YbqOTsx8ihDhKo0dRrjrEXfNrH9y/xD70iXO5QwGL8SR7MIsst5+VbYWNjfSXfFlw/l2tLEVWXsdh91aE883BtkN7s6AURa8VEbNZZYBHe4O4eQdfrtB+ka0KMhdpa6ZiBgPyFxm2NTXV2RdicJXp7LooOWwlcRpbuOfcrLOuIbBr/JYamwhgT1qW1Ho/5HCKrXQJiaUAwYZ8Vg3XTCdsTfPvvjuV3KTqVsRWPMeuIl6+qQ4PXiEvrTYc7fKtEM4oFhySkVBDJAEnXXOYEhBGviCAxzNPo6z4eTQZXlizgIsToLhHmJnmeiIS40pLMmyFzQNvnWd23bmaooZN3bkj85/axR/FzchdE5h62aGqmVEfmQ8a4wFwNNXVYYbso2acPusXKjVGSfBr2qINFhJsucj05wNgWKN+4wRTU1DLnbzxpHUGLidKQMEh5JEeCE6aaQGQeL61qAuz0wgsSggDlavSCldGXFLZobs8DEjVe+8KVqohyD+IlM6yS0SCC5C5msOkYuAvZeUd+aqkZ8AVs4gEtNbPZhJ0cl4ssZ+VNZLaclZdhAXgmSpkKZmtdO1bQfXSREy2sPQdwFfYUTzt6Eb/1ySeLvbYm019tgwF8Qbm1iRU0f18TemTz+U812gtNDzbkyMouzkQ8eJg/t8InJTf/NYshFsfVHd6UOXGgz3dCQCzScR3Z7a7JGJKIB0I3YqpKV2vP66ANxZQt1plTyrwKfwCFAVuTpHdNsTpZ/EZa3PeHNeci1R89A44jbVIb3Ky6YpXY3j3kxrimi/IwsiwbFSqqeg60Fr9GyTacLL8h9ulNf2z3yMSljV9dvIe7vdppw0O+vLyFConZr2fehR70aDJ2vg7u6jVnCwz2+Vvcs13PES5+hfTYhdNPHicTgCIj9Sw9O6jkpz6/qnp90OZAe1rD+RNQw9hDSyL5rrvA/qCNowB5El4vXbhb6klpLIIT/IYJwVVs73vLy4dehaN/SVxOdRHxHJxbLgOs8boodenlTngL84CloQaXW8RS/EkvUtS9fQK1a5P/r7uyoKEA2/M+FiMMWqQYKX8UVgmDLUedPR/5robo8dG6ngyzxdR/r5G1L8MdhQ6pYa9sq0sCZpDWjji8lSVwe/WsEVmDRRRLTau9d6h7gsLv0XuFols9mdHN90CJzO0tG9HZiZP+XmqtJSwHQHc6PtHe8KHK/qlhAJhWrQj4XFx1VhTDvydRdivntMAAf83sbFpGaE1oxKH46PTg+88qUdI4zClLBRlhtxLlijk2ikDyiSNlKcuD5vSVEuSBZsh8T1e06w760Yi2k5Jc1PZAw34f/OJ4is7zE+AEACOnqPhI+eQZGNznRuU4wOWZdZ0xBeXjhe01GdQevXOSHc46Cr0NdlKmx2T823ygoqszqVr1gBDVFxlV2SmcunLuLeOdT1Jb10Cw6CccOYytpMi74Gg4VZ6iWZ8do67f1/FnORE2h6LnwOILwV9pOOvqyo0enEgTvc3JkQAAwY2Nvp+FneGrp/c3k+E7oARJGddlPqE2KJRSrQHS1NQ6txlNglKS5/5kQ+l3v5Ks4BsiDHs70VFwrOHN4tPiBc9PxzH250GR3TWA5Twrsr1r2DNDlt0R/2KjLGZNBp1wSwFINcib8i1vVV9tfaZj+ygVDpyzmHwMPs1+shsWxkDORmb/Zd7mi4KhGUnr3bqN9bsK99yMNUUmHMZrEGbUDVet4/YrFyVea/fVchy0bfa5zSCjkcxp2DUr/un0Luh8Hi9PYskbySwCi6NXF0eMxdc1C51dNf1kw9OHutKSFU2q3idn8t8eA9bjzOWRO++3VwldqSi/G+Q+L5R6vcsxjwftQHHldcGl+EDZLBPq7lLXvr/NPE1YeKXtzR7RWNFZ0isXaTOcwF20FIySYs9sLi/iEFSPvKfW/+rMaUm2/dLFr+sOjqjkfdARmdcuoeAjBcYXAPApFPP9KgQe8IBzLNmowmSHu3XwCA4mDSoAYGMmTckIcrlTq4/6UhvsLG7+wllzbWfzaDqX23A3RuPlQsowI1ERQxkZDV6CuzLmZZhLTeyp/qqQVdRCvSk5HN6n8vZoSltYjXrSSrkXaj8PLp9FXAFWlxWOWTVvD3IE7JDz0dTUlP8efZR62m026jCflXXjWe+j0HwYeenmH+4m555VktploaidBPps1Ni/xgO4ZcsjAEMEF32mQ93/cTEzMr+UWEYHwEMwK5AQNC1t2UgVHcFsVqldXfZWnfOOhJ2l2ASB4pVxG1yGWoUjS/fZQW9BZ5CPF1Sle+swrgq7FH12ZW8zSMtmvelImXr7QUdqd/ou+KCJqaVK5z2XxQEsW0SvkXNak5xDn/lf2lqXQMTb371asdkFQ5Jp+naB9UaQsynPniwokWXqJ6mh6o6zfzc+7imWal5NymtAE2TEPvNWrtaREuPWtu8JobtaJV8kc4WxVm65OEB/MYc1Fs0HBLyrW/ENClAf/NC3SrRCoqgD6UhLOfilIgBaBZW7XMDuMmCpjumVjxo3WGDrlpdhQXkE9chRoJfJ1yUVZv2RONNQ/K8jUHAWPnkdlUnhpW76CRD2vWHZSFzRGk/nio4nPMYuIt56M0o3IWOPxphVRRrmvHjaX1Xt0NcZJ9sO/hXTKGMnvk3AEZAsnwoYNsFBwHUWiPacbUeTkSnXlgLbdMtSeg4IO3jQkp7xw6Cefa/XUJLt/WOy7cFTWJt0qpjlXuiwBx/8meyYiu7sCAMtOp2863FkT8Ky7tvOChTTJr16x6hJmFn8rgYSBppuHBWUjlzQWYR0DiTeIM6AKvnttbNMhpOLlB47j/arOESvxFF4lscl1Leu+Goiq5ikl9PRQvnpVGwpHbU6zTD1lrJB/JXn9cefiLZJV1qOGfEbDstTbGvY7E1mnMgY192l5RgG7BJ0bzJqIilix1VB5+t3Bi8/6XxzTRCfXGt5+ebKwoXupZtBTJAH5mW6+gUPBkYbSSst+Qufc5iIHDLsbT+aUwiT+8hELJyij8bYDoBo0KRozjuuWi+42y2HBh0fSgmqXkqBYshkHBzfqDZ2mSYeDPYpx16r/dRAZJlFtuY51YbhvdVYCxTap/fF5Dyhd3//QbL0FABp8Lxs/b1ONTH+U1sQzEDgKFgXpFNb+JHaaC0d9bBWldRBvKoCxeUm5XohdnkVPMDWvrvdH+zeovPQDtGLy2ExgB4MLQVleaP/OoAr+lAiIDdbVTtHblcDx7GVz+qvT53oP3ZkSwTwTWXdtd28R1q3Yb8V2qED0ugnsXHdECK3jCpSyNrmVl74UvUIRMLaEOJWoXzYT78+gzpeBqW6HG0CoaLMD8DzvzPGVW4IX1PCv9vpY/AWv9sKjVxcLQIuPcerzdd0opEt7mm0iWMgHTjs3s/0S+mXtq4cC2ffFL2UJnBFzv1NxyQ+k1BfgSfZrZA5pDFnnH8r9mflISD5YpCkzuP5EVkUUpCU7VEMKAYoyawt2GtlmCOU/HWZ9yNrKoN5du4XakIJIhz6Fj+dZhw/sdHs1eoIJbc/S1uOxOagW8a5sm3V6hnjQskA+0gYCSjZ3EGcwFS3lfncdhKun5hOkbEP0GqZm+MtxuuKrfmnZyFZl1sk9FDkkjBA4s+PJcLLHZMn0rYvcFc+ZVpCAf10JSoSXh50njRju1s7qVSc4KOwxq8ZG7aJhPrSideOWswMHsZbdSBpyDftUcfvsDesPxVdFeR5X/IN6E6Q0RtDy3eZek84cS/z69571gi6Wv4tPLy3h3ORdnmbK78u1X9lsgGeUGw3CJbh1XI7MTceeSL0xxo7nUIJuhGS/Vl3gM/M4bWvaFW2ps0+/ILzrWEJ0uc+xZbNU5+E0WeZ6rgPrHVhjhczYR9hwN65DU3guxCD/uOfoSZhgSalNqqILCug5QyvOamRxA6cSTNxHWjub3c23x+q9O2i9k64xicyCGRov+V6jGfmwlQvbASPqI9g3VMsunwm4hO/00acIvBgmxln+pTv1LFG0n+DiG4FzluTzA8SBqViWY7CpXuUDWo7yacKf1E79HjlMkjg4rR+U6p8OzMEnrv/9ni+B+jWPzYvLRDwqW9nu6v6k7gyaXAMSMP3+rftZfkE690J1jpouZ22gYh4TKupkxorQbaLztiWgVaZhRd2yybzrik+S2Pac5wYKrGAdxS9z5nS1Yg6hWWFMaK6ABEqQ8yp8S5Ii+jF8M/pTsJOMjg6TeUELtZ/V8oUDFErnY07NDsXT2apEA6Rebp1OuIkIKH2L31OYXifN8/tkQrKcGhcDJGTdrX0cYf6N6FiaOskxZfvdj0zh4qUcDr/140yxxq7dh4XkFdznMHEZFDu+mj5/xjL56P9lEYKyfuRdQOsum4buQOXEQTRXICVvzxUrf8A6GIihslhsRxzt3V4GXc6W/ZpGWO+u1OA05jy96+uzwzAU7Sjd+ipx6A7mlDuBzPyTCBCnW1IpfbILvW54yg25n9DdPwsRQJYjjgvGJSFE02qGt6p/NaNtLstOqhVqwNrUu/KJG19l7N9nQSJYzX55iOvFit1GTJHAvA43TPxIjwEj94d7ULVwXx7iUEueDRa2z1Vcf2JanJI+wiQ/tubL/NYyyVGdQvtK37Zt02nwDDIRYJPFsy8mwH1bN5D2wnhzjtCjgSy5JE17wfW2UgrbsN8i0V1BsXJAcf0pfQC8jLsBByia8QJdQc/bIS5pFMRQgMri3+z9uGm//pEbOPS5Yt5Meu7qxx7ZumtRibbApMEzDCV4c70SV0LlCBViAkednbjlsLTDij22p2v3U6Sk5m2PXcsRr1gD0A+YcYHun4DJHstCQSK2kfVo6LGh/QI4iDXLGFU7/prWbcJgImnd7SwvarjxsfPhFLURRiRT9oC/+2JSQ0gm66GH+audcesrk54kuDq5D+0IiRAuo0IJEgEGVOYLhDwEjFmE330BE+STai8LtF3Sb3s4giu3IWCDSECPToTNKwF1RKxuWX3P8bk2XlVe3pEwr5n5lrIBBiKcw2wepYsGXLU4rzpdLOwRRX4DrcNLRoQsMzMeHi5n+JYEPaVgW/xSRXGBf4rsN3yTlI+eyHnt9a4OlhLVmv3yUzE4ByuSwDFW3UsSTCk4nUoeobRkpU3oXq6x+WcpIHDmfy46tkKa9RCFQSSo6ddNl+mT2FmQmYQnw5oaiS0SaEhJwRrNaszXUSyo9oNUnudp15ZRv2gvk2ShHkuPoeo/o9cTII4iTyZ8TqQ1X9x/rpWrzj7K/yTZ6cjGTT4PhBBxUGLiuk9oubwp45dHVsCYRzNqC+UnCxm0bhoQxj9q58k9ZSeW/FgXQv7pIhtWmrGJrOtXyRh6UgIRjE2+VzkG4WRrAyV4HBNSBXTtFQ4CuHuXExgLv8gA+itHbt8m8Pc5D7ZgESsWH9VdGXQy/ShFJabXLFgR+uBoz4pZnNfSenTTzOg7+WmdViGfoodvbIuv+Vvymu/kCtTXzBG3F1Z3KXbPSatq+a2wxpiTetmaTwHpMSWOjrVVnG1pcnKKcEwXC3daJltFJ9Sk1NdCu/1x+vseMzZMP97g6F60FpyVS0CzlGzhu1418z2b0NMXTTMHAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAkolV7mN6hEY3Kf0w56/S7V+WJ05a1+XLCe2pwG2QOswlYhAD0/Qqgnt4oTCTqV7qw9Ju+oqNg/xRgOk1vNCR9Pib3uN3HTUNrRY7BMsYrWfOXpxVtY8OcjEEemD1ndnsp5UtUieh9pXEuvTAQ7skceSIJQZjjfXBAWrjIBVrBJPgO4H8Dh5agFPfDfsjC2rr5BFbOVPStBgREodXh0uDnROuFj3/B/QsRPZgdXGY9mfeXF8P2rW42gwc5WfAWUusuegwTK48WoDDlrrKLDxMmUtmjweaJFv1KcMUz/8BGXYsfSgB64DmoScljN/wiJF0Gy0YwBXgokwzTgdjuYnB3mYsC3ovRJLNYvooOqKSLF8VG9E0WzAj86P3jmg1elE6sLGTzmEkUjBdriCKEhpBnYA1zWJVONiRkqIBygF9B+wsWKVCgN9jlHeP/goiwjS16DqMGI6llFm66Q07mn0Zdy6jKINm4JJ1cwQati7TmmCkfsRUWtgkhOQcy/ie616UC/gLHJTPbLhDhix8vjWH3Cn+ymB5wUsEmV9+/fN9JCtXndNdhWqvBPU/6bGyb+EnQyHrpCvTVYPR2CeaZAUIji80mw+JxpvTyM8qQQcw3Fjgvu1HBIxYwV+f/CUI0swh/rLygPgnI3gYYK7HYCFbpCNEvo4Jy9s340e9jg1MBPPRT+l2AdynCAb5eBONtZ9qwHH4XiNKfyuvKzZFlaNVgWxr3W6EaB748pqTHb9W4UguCi4UfB1yfGrbOsZEPfTARwqHLRdWNoaARTxqd0lMt4LgNU5Md81RD8cCQU6kF4CJmnqcEQms1nYUXp/TnAyxm1aHBphNrqB5RJ8bgJi9bdb6VA6C5lJRzBrE0skkhlJSjYHH4/dXDt6fWtgrH8JGfmK36x0xhv42bx6/FlfnfsEN5r7XMiMEpDHEYOm3TKQtxQ/8uk1iSiGiePVATqzcocOWkAZhHq103m2WM2khelupofZjhbHX7Xj18WC6hTp6E57KjgHoQcMJfiPi28VxP6Lagt5V14D4aUmao9HoAQhUe3/a3wKIHqkVk90I4CE2M4sEVV2nIxM1Yga7Qyx+ZEr7sZpCuX2lVB3DywNBCVgrecSwrvdX0fgrucynXdXcu3p9iuewQj8azpFkap6SIGfYAO1Cbu1Oxqzur45qQBy7XF8TuC/7xNdJOqVqaY0GxIUdPHCkQ/fBC2csaCgoebVt07fWmmrCM6uZRO5BpYFf1MBYTQrbuwrNT09jCRm3uENGFi7iUvH4Tpiyleb4XQ/vPsbbEJOZBk86DjsoVWRbSpB601RSeq51Fj2CdRHwNkhBOjjAvinUluWCz1Zj6HUelodzMVgsI3ok6x+WIfqH6ZUkH+3YIfysg++XqCS5ujvxwAiPwgKtymDUFVGRg0V3HB36dVT2uSrNRv3R6ZmotXdplisAvXSbeWuwkl9ubLX2Mr0y+1KGStMqZP4uYKeAzXv5KYddLmHXy3yrmI3CMsbasgKEq8UsYzFSDZZtROvPbun+A/+NaFMTl7cAdQ4yYyFioYwzwbBxVSUYO+ZxQDxz+YGPoIgmGaiVU503XmQN/EZ3d+6X1HbneftBlXnaeCR22IQUqVJt7jzIxoqGDP584IhZBVcRqYRT3k84OwOYAvmmRCfiCNPk10DSAI2VIatOFa8e3Q4mPtaNyEeJKgSrPOIuq+C139ABNhHGR/NKrVsoyZoBwo3G5rKqih44bp0qfj1o2nZSPRZm2S6OhlBeC05UBPYAzxb7R8pii1V6cV4mEvD/ynecvpOg3aw6VaOmfIA843N2C9h0hgGuakA7cA2540ib6gsQUUmSBLg97AdVvxl+DlIbzutoLeXsyBu8W4q+Mt/xaDoirhhcsYB2LiQT4sli1dBnKVoDH2abG1Bm6M4qzghxXtTq9ATTUTRTzb3g3kU9MyMKVD0u2YLsRPkg5ENsN3BefqBBfHZcqRoSArB0WeNxPV4bvN+oK7wmzJJ5+i0lklH6Jy1+XVEsdX9TSWhnZnxig62aSxh3Vh7omZMEkCvrxS+rZenF+9Xb1NboIZSesAHVu3UPKqYreK+46KMAU07rIISx9a2biF1ZDXs71abZvfHcamJphxk1WNSS05kW8f33RmzwnoqRVTEgVGI8OH4hbV+25UYzlJ3Se/cZLcBVXQ215Yx8JxvRWRl5lV4GuNMg3s0coObEl5fa4RErZCs288tlqvVWX9Rli8jPDO4/EGte7ghn1RwCrLhU5ctm5f77I00cLTUQH6P5spENVbMZzkgSOsld++xhBiyZGATc+CQpy8b9kWE5003HF3B8MlOVFgUDpwdfmbOJVO5AR6PsBMjjMmAVDoAWPa4HqafzAR+Cm7uaz1xw7If3goXzCNSl3FkEd9gvO30TVXsOUwZpv8n5HMHzbcgPinBTUw/hChQgP477mJqEtGDpVH56FZ+RY/9kLAvM6hdQgy1JuF4nuF5lFJF6JObFrGjkXmt2b6IJqG8epR5DrG4c5nTipfQdrWkuZU5nJ1yREn+O7RkBs2+blbjAU0zv8hdaDTPGxgZIx5Rsup74LNudLslhrnCeYtPON92oB8sg2oxjiBy3d3PcOU/RzcvVtHyK2yfUZaPKlDpk3s5UJRCyHm/R8tvtNreQ9LGaTE9PYHps/NEd8EaLSC4AlxH/dWNW0UHV/K3tA6mBsv+es+8pZmH5yuCcuoP6W0e+JrCrb/+G/DONP/gIu59N21tIpnPUKBqo467S6m9aDgWc9BMcpC2IopgOYXsXpCF2+5I/fX5ovhOPQC68eDahF87794ruhzv5xJjsLwkqU9FkQvZFBogjYadFy5+eGqHnJI+PFnodc1ef4EXk3BaJm+UPF+2VkTSNnGgnvudCDyYmcAIYqTGEY2zEGW1UtLBzBv2xEmv5ry/CFhtMPr8wiBA102cNpgsMdj5SrBCgugQy0XCRcql0Cal6DoLP08Usd3KlWFJbHldaFRKgylbFSf2yKPJajwxSdRReLG2EHZYmAP8DZS5ROoxJwBoT3yyErpzVNkX7kqoFKeG+/NZkFZfNsNridYlgQrP81n3DyaWQPC5142wm8P3WPlVkCkLQJO9RNDxZwgY2K5MNYCpcfOk2FUFtqPepa8mEg+gIvP7MtDcEqDiUsZA1r1SOGlipoXcqYxCfYgr+FTmvNGZCXyyT4dWSCADpbwJv+1nhzASkf5UwADhxPxsubjCCV62z8YVnuqN8X5ZJx4RcnCO4cVL5bY28zmutTcfA+R5YmbbsJppjolKDW4WwcffIKS6ovp2h/sVuCl4Bd0o4/1J1Qg0WF43MGwMgoRuGcXu9DwGZkKwBopluNPCRKb9go6cdgSTv2Gx3ec+th5an4Ib16X2icLsM6QJMD0Ie95vDNMb0I/igwjM5rf+ZDmKfVu/g9m7zX15Mau4YhEoJW0RqV4OoEFsP1DjWN8+KRxCtOXf6a2gd9GFgvXWUC6LwI/LmHKH2Eqqg5WN3UEY9BUDmNJYpFaUMxy9pRtvhrSDlN0wNkL73u/HcxpaQOpgcyhPijAQlhfWEFHqu0hAHTkWoY9oR6+vAfMUPLxw8paq1obK9TpNg8RkMA2LDC5OJ/SgcPbzpqE1uw2E0ay4T+Dbstu5h6nqzSCiq+G/p1Nl1pZrIwv2CeHEBvlRZb6rCq/ayy19XLRiEauq+qNtXx/4czcS+PB1mf+Zw/asSicpvu1TT/Np/reDhbuLDNN8I6RPtvdpyINkHG0X21kePOTiruaeM4bcwb5uXPejK6sM837bh4dky2TbP705jucs0nPK7gbKfu8YM8Dynr/zEe8cLyn0Gkl98hpq6vmCGQFzu3ZwXgEzopJRlBHBRSxISZKy/IZIuGPRv4O3fDhR4VhNFJ3YWzralHco/xUo9U1FBT7igpmBuBqov0FNPPuhsLja2fxnqIAi8oB3EYTlxZh80mtD8SLouXGXqC5Mx7pAtTeaGX5hXufkBo3Js+frwNBmrxPhso6IWk98a3AOE2rSsEUIsO+fC0m0V2a55NA5TVHigZquZVQYezeTPPW48W80bnKQ7+07W2itMbwH0MKM5dRunO3x5ayJS0FZm/RU+HFbQWIUI2QeCb7+kQjpaeJdVH38WucIBq6LiW2v0OZDRxoFdVB3IKG9SNOxFAhsPgB3bFLNN0Y0lzsoeKeF+riIDVlohHbqFjQN6OmGK9nZcQhWvOcd3KFjBxxyZ1w4f49eyPW8fAtZWQmELbCu7rzLs71DYk2ULeTIAqNWRozJhdSSCidhxFom09Vh4HlYZjeqDIX28AE6K1LfcUCVR381e763JaonSLQMnLFv1LAoFas16WqqCYNANX2Ah1j+qGtCjAqOI3+dSQp9/CqKI7lngVydNE8t9JQbUBAQd8UJO7z0yPzIQ9rpARyefZ7OdPHGAmkvN9VBy12ziyjDiIHu20v60pYrG0m7IA+Y+hIUVRB+VEWjyFppT1YqdXxTFYYwW3WLjcx/CTo3CpzdUlZAUauuQ4Wd8Sw6IvBuVa5pWx4qMJ80RxLqPcug/j7HummILeD3NuxqBP44Qwy6T+DYrwnHgVCpyYQgLAKiH7JOUARD7ATbefMbWhrkm8IWF/WlNvGxoIaLwcUJQyxNCnjWcXw5/qdRM+pEZZg2aamzJI+/2Q5Eld+ZveUh219fMulE54sI5zhZ/E9bhv2u713uNuo/XBKPwIbxBjA+FJMScz80U38v38EAMmhjcfnv/4MZNqEmKpezXlCAm3lcAiOAiqs8rcUfdfxWPBOWOTWE921wnSlC4bSO/+CQlCLUfcoBDa1QO0i96i8asG7v7faV5diw84kNgReY63uQoVbHXI3dS9j/KOihCZWrxYibUkhi1KMY137RnZfKo38Yd/KjW8XI4zQJdiNVJpJ9q/ryaIbAHdl54XTCDWjgjQIGI1J+WejP6wscUd67j/nDIDrwpfSOZkGTkfpfp75AgcrcG2LX6dtthp7Lw4skq+3nngW40cbRYtuksPO4TfaagprrSkaUVGEsqE0I3zjqG2AXarG/QzA7cPRbgTw0l5kN/WRoJQTdfV4/OoPUbSoMxsa/2sg4zfZpcxWlkGy7oWy+C+jydHdeTpay6jKrhOkAGRHHdQTPYk8HN3oAsLiiMuAV+ydl07AZKA5yHcnhWTmPLqb//o2YxSpjw7QAYs+p0m1ChbX7H3iAe1qdNmyViIp95LScATYJAd4ndl48cO0hNcYDbxbM8rpKxyouIknTwIeUhDXTy/ipa298hru8bZNKbP6zhmRcuPqulHaEHbo38m0gha6lr77+szK8sxkBDyGsExXqND6AUNQFc9KkktkASKvu5mSbigGyAmk54y+4y98C9bnXQRipQyAa0EUmywtfr7TwWLDZ6zE+P49JHnehZeL4LsMzavjo+DLyQ1bktt8MFQrQVybmQLPKLPseAXvSQ8kcOkumDOQM6j2yLrMaSoh1fspJlN7NY38IMK3xF9S01UhGZWFYfyktSMjTbpaFJB/KFgAFQLSzCVmIL5d5uptIi8CIVhECf158wIXgorCXfZW292bCCijHpgQeopgRvOww7Q5c3F9rBtJEuXO7S0c58O9e2wxj3RMVhvzs3T0amalKh7ZXSp34nO6QdRctP1TKJ68wd1s0sdU5TVERLLX3+22P9w6IuEC8iYMqeqiK41aJG2loxc18HVIdDEBR3qkSOTxomxfUQj3S7AiJalqmPCEpP7b5Udiowhrdyegmw4Y3xbZTUyAwUVMonTyvUCP1kayPWSvx/kKKc3AoUCnRAUG0yNDUZ50kjVp5kSNyQFaUgkTD+4mQYggINmyT8y3lZPEso5Gef3Kvw8XWvamF3EL7dk6D2vgtd+MR0KzR/7PaL2oFNk+WT2+lRy36QxQ5uK0MRzEy5nLvsS7T1pF8Yk7N7dd5kNTCNDSIsH6r8v6AuBZwfoQXm1x2QD7a63ut23RdCNOGDoDy9yFs9onzPWRof4aVtaBR6gp1+90opyCbBYNAOSYm2nJVyZ894h2c0yYTPJZtBEmWLqmazpEIhKjvRi3TqFl0OPN/6R0F/MIb2SiQCnj/ZvHpGRug5v5IyoNUDSeyqykJBMF1dTKLDHRZ8jd2so+qBOkJaAo/nnm33/BXYZjkVjAmHG0rm4CghL0gNmn+iMdAfE8Htp55YWahd1a3z18pgHUB8jl/EyXDozhdhf0H9BhZJ/DmhuyBXiqsrn0ynrjjjWHyVkUYJdFw8yrXEm153jFdH0aoMg4ZL7FDEqnzAmhJiSHvw4XvZCdZjj2GE01SmI1d8tWK0wSj7iTXvKw+X5okTqdXXB1cDeY4TbuxkXmFllw/ei+WoNS+6FOOIEWLZ73KhXGi3FgAR/qmzDV0FNbIZfb5/PkzzitlkMqD8FNCLMykzzuK/xpTbyBEet1DJJFeJYSi5IrK4ckyalS8aLh0irH2lrxn9MKdviGwvVfaM0KxPqdh9IIi/t2s6bCywlWP+1Uo8PAETWkKl0b3RAN0UUJ1QxYd5RxEqbSg+Ao33NTM48nWZmFj39YJD68yfMhbqdzTHtmq1DHH3Bawv89QKkhILSAiuRX3DeVzWdGzgKIQ+XOshIfqlvoBsyeokqdC2HtSz0O00DnI7BTpEocZsHy5P3OMphr/moMkmSxIfWKT2jDc73AnTzAkWiNax5ZR1QQuPp9ajjzoCV8rHVBYNDyrNkpAtMzQ5zlS1AhX2p8R3UCVFhVe04VJQwU8OwOt9QVqkGxYQtOgu1IQDVfXDgOy4uYrz6SHlI4wqsS1T3Ug12Akh/HPjhCdH/SXADYAwSTnW6Go0eS8HkiIMxRahA5gRCA0m3YZeEaFrMFG+Nruqll8NEV+3oWln3HmmE1XpNkVIDzTuYKaaSJTele4aoLk/nGAk1Hi3ELYqADtknAy4FYS1GzdrrtOWEnRNFLs7qCx9tbXmJPQIFESG6BEwqergTjYobpewc/w9cAMyl67TyuEvjq96QNe3Qnon/O2wwZS6SAs36zPM8T3jp7CtR1m/Lk4gMEpkxFbvO0KvOwvIrmBlgU+e39YA+6wWfZwvtkievB2Dr/5fxHb5grI92YLFNzPmSYeILQmdbbOaXe0nfkDK6Ao9SlOkLq4OBhLx9KC8JJL1uytjj9VZZZn8nVadr841/aOb66LJH5qixXjS5hmWY03qKbJ5PQfdar5WdfVvosUFgS77MUsF7UcOatzaOuKuYwEUJGgSQ/b++vtCJysyeNvA66PFtjYwykT+pCmzfO4U1OMOL6Cwy2fjyIuyvjcEz8/ylkJwn8CremDxPjhqXavFkW5ApevzFQXwvD/uDsESLEyFJnl0pJdEbOzHPG3GNd+qLPjVzPSoags/fHK3Mm4Ph/2R0g1/GIRbg+OZ4q9K4WjdwQPp3Eok0R+CCC1pxihi8J+TRs/0Ijye0toQgtRu6v80H3hm5y2VVhuWeN7y2ffNGHBoB6J23/2K/uEDNhBwCnG3aw76BGsYxvgeen+KipY3zVZp95SldFNoTmYhzYq1LhKQWYqiALsPOjlyBnw+WkUrmym9sCg/g0/ySjsv5UgdSwIqqoh0EUCR9Gse0uBZZvHxnVv3A34SQJufYpnkHY2aJOXD0xGHwH/1m1sCt5LHsjEzdPFu4uE/+bce95fKm82sGg3qlcyjq+rJupx2kq6LLDNhPyelH9Mp8Ir6iUwJYy0LZJblFigtiOPtzOciZ1NqE+nieTQYzHBxjt1Rgd0B9WwMRzEuktyvDLdH2/Nw2iQgMyhbtko93TUH31HA7gPYpubcq2UTYyn9dm69P8MBKH4jxiLHsvFYSSkntmegewjF/iZnPKARZgKyoCeuhCQNX49ahsWMn1kgGMxpwhHmJYCxa5JYQhBZTbk4AHI+HBd9km3vk+r3SySsYuAxb814RuWIscIKLux7MpVvbOEbSpZABdjFNqoiS2nci31s3XKkEpTb+IyjdlbkUAANDNj455AnsQ/pU1JIkF2KgrePQveXn3uRm5W7AI2gRPCNBGG+JGxAt097d6qenyefZuduVzbi4UbaO9ly/jKIfzTOnV1X4uXXJ9zfXI95Z3IL1jDPR8h7KPHceCdzKpd8ntijGHCUm/4VyBmMHvy8RBoOGSFgQCUh4fikNmoIqXJidku3yHaPemTQlXftKFh7qkF0Q2WsPvy8zntHtWRruK+ZK6WbbcExFF5ma9wkkXpcxXXEQKJL5r3/fdHZd/4o8IDE2Dgod6TCtjSn/N45IO93pdi/QypyyVd4e+EEJRDrekMUbeVZ2H81wo4acWLHu2Tmbz2ToiP+v8xofHD9X7FxWbXibaK0pC8d6bxPqYQJ/WQrL7LXnKZCrGT0ieHdgRUY6yImpVYehS0jP86xWpFmy6VnLgblzUIErc13sO+/n07+wWU2+yJHr+yHKvVZ44hEFBL9uGoadcCaRmT9J3l/8OZoH7XPJiOjujJ2+xljNKO/wOABQe/p8PhIH5QdkdAQbdpwl8C0TKo3tbCVZkiRhLN6wGqCYxy5KfFU7J9/DlNMSfBZrS/1MdW9LM3pX5BzqfEaS8T03feyL5jfUqcKwIvnb1MxkAMwlR/gaY2kRZfpMsLABASic4+v6k8UGTWep7z0fdWIAnUfc8m2VciyIkXxuwaPV+aNHbb41wuwa+dVGnzg30F2Bd0PA6Ygg+vfU9JXLQt0Y98B5Ked64OPfgMKjkZEFPmmeFvAp7DMB1MOVC1UYtcvb7twFLhMxyZ7KpqhuQ/XM4kBgYI9nDr2Ikn35xm93QOrFb2L3Zd6z2WgDZVwfObGvVXotLDvOBHsplsgmEVDMNezB+UY6nJPhR+GFlE1QPsTre9Ues1wgZ83AIwhT8hDcZHivLiLT7TStsGR2M1cvdZUathyZeJxBBMm/eI9z8MZmAWgUUKr9fYe7KIaiKveCMYPWIO91Q2/dVcZ6p2bcmZlDz99jdYqJa47BK2dz5/Yn1D0fs8yFejY/TYBpdd6rI2A/J2SaosqtEpYk4LBv8gKRBtDaa9d6ONpbP4IeqDEeqJUeenqV+5oOmenyQwv/MEyskkJmrBQNXFjgGcAbaAv7TxDxhZg9vpPoozVmSbQEk/hoUsKUaf9Atn8jVrHIHLfolPpOeReECZNS3aB2+tAEplAewKyWpdGTdLqSbfi6xZ+Tc5s2q2NLw4gscA2hgQ3hKbvvwtsrRAy7IZS9QwVgYPfWAthB56MA2yUjHFvYZy18V6amaD78hxaRCtIhOMr8Qs7mthe0EpyxfkHH/VChYr6adGytqLGgvyPxhAOzEzpQ27+ZTLva3rox/Gwebtazhw+4aBzVfz9f8l16mnGsHh55Z0WE3w1t00XLPC9yGOW1fqgYmrVBaQdJ04s+GWIHmTlSdGnRWnM/ZD4enKAE1vV9bwUag5fxFFDdwJ2wR2/Qb/q9EYiaxiL3USRwAp9dfnhDz6o27XMtL3uNSNUoBVe5NH+tC87t4LCh+xBw0Z4paDdrdEwKnVvmOGI9jUu/VQ+lNI0ptB08l2S3JoN1XL837d0zYRo3mDW1CQFWd5EWSTYlQt/Kzc1ZTwqrHVQWefqi5dbOenvxW2DTSlJ5Cm3WW52G+FvDd+rWyFSG5dNZ3bBf/LHIO6MlLe5Rp2f9w6uQyEou0za+QnTAudRyqx0s2p+SZTo8HYxDRAPewK3QfVHTKM+MuHtMK+xJOWXadhCs311HyDDZaBwW2fJb+1PKkgglhHZaK/hxm0tWH+ECEYP8yQAkbBNkyBtjuwa+BwNcN3XwTv85uy9+fE3f8xZ58sZCVryt1Ja3+r9cFPXmLHRFnUKFBEDB4Qpw/BXKJUnpIaQqkg82SMmj1IVsam93eC+DpZu9gM2FGfGpajrjs0Xqy4pD+XoPWJ8hg2sNn47JtCHE72p86qmONKoQkPe0+rHX9bE7kCd+O1GIigX5JzdBWV8rOvTzYF5/7YXv0hlX/qmAmY08MJ6ASZGGL7A55uXCjaAWl5z4M0HKxxO5N77qU+e+1gjAh58Y0PgfefSzE8ZcjTs1r29ICdBvla51MWg3J/FQyEde2c4ZOpg/32wZyjKDYLuQWsVM8QWWg9oskSEf4gtmhIQWv9nKYPAzYgzibsFc0nF4dUmrS7CdXXoe/ds9uttJgbKETc54TOIkk1fUJbQd2/9v2lLnFDJqa4KtYl//FyjMggssqK4r8/ombnh2x16ny7syduyX9/HHs/zlhKlhbylkvheKMQbwhj1hNU3cd5oY6mLn1KsOOfBzqpbq90FOcM1PSb8XBC4PnMWXlG51TlvV5NcPyHKrvQd2ZCob4VD+y5ozZaLBI+95te3zU4LogBh5cbEkTBTyf/+DmwhjEWK6jF+SaaDcn0xtnYUVKpZxMW4rKnJY20fpqVBpMJFJVl4aSQHGNB23zO6XhO0rsO18GXFCMRv1dEx2OfoQZvGxGwHfeUgN+tArmtRr54RBkMXDHziMLfFac6NZTlWNqTBQJYsGipZe/yV9FmcdcNR0WQAyvuZ8kiKsbkuVap9leig/XJv80afaCW8HHoILZGBKIB7Y3hXtve6Gwrmd3NJV3l9BpR8AsEMNmP/BE20ba2RPqaL0oBFAktUKStNPZS59/czmSAzJ5m4QyYZu/ZuTH99bzS3kwiGbo9YVlpEqC9JTrMVs9OBMfR62pN+ojcY4HnEYwH8o+R1I1hLU7wkN1pXwtJPSfWTu81ZwtrZ2/ZvBOX1uX7ldNIjewRs9dQWY/VyQxVWhkvvaNCVqX6M1aD2MLG2J5Combier/TjOxVd3sZRAtUvrbqO6gZuFoPJdaQcmtH4+8S4TcmGftQ/0qireaCQUBzRnR+tT6TnyEnfrsPf4ocqe6ZkqbggXCnOv43d0o4/PT/I/hlxSjSP3+VHP3DoWLeBi603Ex7/NzJu0iNDxQ83BNAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAKV2344joIQRQhQ5pFGNoxiAzvD7p9TfErGmlz7suUJmQKa/IOdmQLLJK5ev5YzYLEMuEKf1FNnz7ovhGuGyjsieXcxi7ztaO1RlIIZ75xuubzugY8ms+43OxXJb2nBIlm/t0S3bkl8+pf06IVTHYSJ5YF0YYDD1Ekjydp3Kgsg1Aygzbct09T6Av8GHcF7dDsJOdFvD1ZPWtorrWM2e1sFxqJg4Ev2fKMRtWUOiDXyMJlv03yXLSUrexuvm+Pr+7qfeCDdbh0Wt+Nbp+XbwOyDjNiWgXO9YM5U+1YdEv36gpj2WsFetpeUhBKCzNIiOmmRaR8D+vFqi4EwFU5u8q6rOT06ui5EcVNxjkm1w/hUx44oQNwE7zrKRmpqNMjWYOvPJ+XPDkPu7Pq3gJJ5wdUNSbGUijr90Cuz9axkNPN7PaQza45g5GLnb3qKnRjG8HpjEmW79zdn4a3VkimbaV+SECtx5Z3t5pHqf2Rv1lYlN+GPY+86Vo9QrYZW0kz8zu02+v6VLmGIieOKPs234vL3bX/nPeG5Miabaq2ZVsObJEP7nsDyROu30WmJubiIp81iTEbK8UE766Up4VV6yzCXCZmWOaJCAycE8NawBz/TL5oBl/elJ5KOp+PoQStkW++foWThU59qrD3mBcLJGJ6trj+zf62ETiFau9SugiHgUpCokC/70XCGLLhGPyhyhIGnI5H8DQz8BZOAaEaWFcpU6oLPyYQBdRWemsisk3aTEJ6HCLNFRk5VWs3f+yn65waS1PMi/U80U2t7TgujMw98VC5aHm7n9Hiabz9YAXFtAzu8IzoMAW4BElcoAPf2lhxmcypv3Ulnj0fXtFtHGG/004SsvlvsiJnp/H4bAxJnBVQrgD2rkaAMiIP9Vb83AmKZKwt3XtdMi2XTLyEF5ctsE31Ew8ZiD9mTLGt5qdHTiz/YnUfsWR7SK4RfdH9Z+gzrQmpLA2jUIX5iYqlz7gDbN9O0mvVQtGsX+NLWIYUPGeKWJ2PYDKCWEPztaJPWd4BD00kDZu5Hym+MaIY9NZWg7fnL0Fp+3YRzW616PFzuShQ4tlQkXU60hGyWlozkIupC7dp0Uhq23yQIAbWMxRsLeofwBinjzuEMX+JNiNXPjhp3/JWd0neHuZmDt0EOT109lScyULlxQ078TT/bWbgOvbe+aK3nMvb+X1O+VcQzN6EboC2m2d+dJ8ZuXbUDMhmoR3rFsrMXK7m/R2ajYSViinLj8iUx+JUHb2RBHmLdvrVGjNnHgwaZAxGR7eILPn3d4p4wo3sPjCgX+linejDi7MNH1z5o6II92OIm+WK1xNGJtmrWlsi/3CY4S8v9YAIezhBH/JKxWO1E/wf2fQ6z/dFohQQrsrKpkr4uYnci9PeQmNCKT2mjzKvlXTDgksj678dCVZrYmO8CmAYC0imo9GH8VgMKAuqnqrEvYGUYEgW3BplmM/p15kR67b/EES+X3l8rOcflXBkiyjEB6tmpGjrPJuHS0Ua55AcLhpnKrGwgT+c++uCsgClTCjL9eU+Zk5XYebp2jbzEAUv7u868CcnDkY/3z9rYkVD6nruJIFxPH6lGNCpdCwsdGIAQ6w5O2hUTyWCkF/L3ZvsaTiKZEn6UBWp58FCiZ7tbtGHTw0rp+KxoWgOBCSSLAKhQ2SU8rPZ9vCkBEgryEvex2HCvE/S3BopxyX6BiK0fl95UcDJVDAO7Rx/dF2YW3s3HoRkhteUMGpOBPo7Bvpc6JV0bw5pyDpTV6Kcb4p0S2yllXcWCcCdyUrs3j6pB8vxwFDuLENV2KWmJ+RjdPhSsyY4KvrPpnRjAjQa8Heffj1LqvtIq8C+BDsqq8qX3LmDt2rTuHoqHU9o+PcSWTYjsQztVmqy6tZED4q54VjMaUL3wb2TGzXwxz6Sc+z9DAYYfal4utN4xxAkavK8u3sGbI+gN2W0efNnNbRcE8Y08x7R4s6LY5dx0Sffl2FDemTbtOpy7gTmMRQsoj12koSuDr7EdfOC36BB8uEa5xlVeX//IEvg5i2lgV/gNcqeHXLrPYp5HBl24N2yeASzvGwNqvZXP3GChAmX6mtaT+TSBQnLr2P3PxfVrasBP39uqOWtMLfaqfaWIH0bs3KjNKHHN2JHdCcyO7HGz4BoGAckDCtTroKsI0VCZiZFDvuhPI0FCgDsx7EC+0EVH8a9AKZtTBxCPN7XRjdzinLFtZeVges1DvbzTQMVGyveBhe8ajeq7lICikISO4NJoz7gEtjdqcLLA+eWfWeQ5gXEQI/e6s7piBsUomCC2pA+7t6YcrKIOUVNVdTLlNYuc3n+zMmuBxPYOVG8FhgsGG2xQYVfnXDWKaYro344MEAwNi9uzUWk0qEHG4hUmIJuyxlCF3xDUkuM1zRLY0s0wGsOgOv1RtyXtJ6Ozw6rq7MZfFYuTuZfLetNo5G5eQLvAYes+2bhebHzlYvC1n0zikmoW7CXZ1XLXiO1OTisxtC4cbGEnoqNIood15kLO7nCfH2uPyzjZS+z/ILV3r7h7Gjte06Iu6Uv1sNL3bF8Mhpp3f4l2QmBWlLztPQ/AvuwIYqLo3fY+/jeAOjc7sH8pAB9jwY84NFsdZ0redDHQhP6iGjBepUdt4+VCSD2V5WFY2Y+4beCbtTavnuXlrXKAG0WMvSC1/D+OTLPFqWuEE7qen6hwlEHPoeRK4mIwZFp4v30IQKnN8wcqctb8l7amK8zjnEIm6qJ2Y0xRXWEVQgkCWmuKISohyPCkVBjODB0Lbm7q5K8sKTIZgHYm+aFo7CyowCCK7QDl9AnGsTOIEePicLYvcFoy3ozxp/hmVk5ZkMz7Mu2Hp1YCtOlyXJtaSABcfnMV74dN0+Ps+u8pwp5ItTLw7CFP0waNSTH6ZkvARFCj0vSfbYdfn+gelSDyPJGX1cXm/yp/oFuwP8Y/9gYYvqC3KxJIgJa2kWw28TmN2LzbINLnJEA7YK6NkYAZ1MNqwyFyUBrUkgBquV+FZ6V03YtqMmdf8TRWyxIt97f6M4SA6B7c8+ZTZ1ptyiAKFmnH3VHFsGgdaz8Uh668VZdVcOrh8RItwXa3mrMXImlYiaHeslMrdEAf3IVLaHsL4yRPYilWBvlcby0pXhG5nNB+kcpssn2u3AAuAlYmd5v0nGZWzQFQm3FeCCH6fQzVPfztGfTIMrNKyaBI4OuIZSxrr6sG1IlfTjzbqZ0qMCX+sjApjO3rSlMTZfdEFZwcNcMivWiJTQygFuyEgqZ7De9s7t3efNisDnmJ9hL3fdT8tXAAyZz8OjUKMP/j3DjD35aRXSi2NlBdyO9YKC4B8YcbERqgo+v1+2By8ZILY4YjIV776zzNzy6QJ9YqGglQ1fAyGhLh1E71ZpvM0VFmo7DZWjB046U/utbcAm4hDtMfYyZoognqjPkrXDj3kmbQpQVENo19ANM6HPCTaboykd5YRkBOh6Yqs1hBkq02SjQMgUxzyieGSa88Wu1Ub+TAnACjgJloddeIr9W8FZg1bAkBH9Xrfg+SflBaEs5HqcJatgBQ18XBDcuVbwKCcEVDD50UcumkO9Pl5koBaaA2cTogfVgLsmwFGDSThsXDLSkpMBWNWkwFMTezCBzSCx3c77hNstftElTolVjk6ZF5bFi7iGyo1GPofdREsvonk5OgXzEQPgcKIVeSrNx84UvgsYhrmmMaOLvEca3BAFqEu4IzLRic+UOz5eCY+HNi9Xluxwdf5VQAQSm+RATFcMMGY1Z8O5Kqk7RY2AVMpjO8YvaHKg+bgPqMkxQKnLB+dYcpdwzP7+U4KvIFvbhXPgxhabP1445KdNnI5uPPpYOfnwekUQj1Tgd5kbtqG2JEeefrxhcXn9urvqNddhjPmd/PKJjcFo7t5TfKqMO4iWcG5WMQv4yshuUy14E0FcJJhDaI8pNy26C6puV2katnHBwynK9vgVBMValt96DEzu3GafZOHslO+KI2PiSdVxjcl9F09uDwpo6KIGbgqfzLaO6hiOd/AjsBAh3PoMBgEXZgXP8jQGDkCL8ys3Bip+V12HrJwL3xurjMZyGmsX8V4JQO3tvhmJDINYi47yiqEyko9mnv8DHbJ0rhW8GFEQuIcO5DChUJNgqE2b0sfsa1dPjDbYE/9CLWzuWyzNGnvg08O1lawGGcEYSPwL4cZ/8bnWX6fL6RFzGAErKaHU9pHNykYLvQwWqAFkDAfKbeg1HJX//5EdGRcsyS1Lk5xLPmOgrunyEbH5M38QXLbFUoR93FNuy9q//aQV7RsfEWV1nb++auPeBpXgiPnpzxz+BYY/PlWGModEEurheCIjp9XKs8EttIi805vWNqtAh6xFtNzfiZFbrWYO39XFgnIZ9A2Wk+Nzf6HBzNNMP8hJaw4gWOoiuVhjGHXKFlePbJi3xztYHMgbianzoXtaBdvPRLaFR4N2nO5ZLPs/VmxIJ4VcltdsxHKD49hQqJCT1XDw5e8jBSJYovhohkVwrJ0qxGpv0d5qlqJQ1IWiJCXDypqAwgA1g+Q88c3+Uy/0PTfF0Tqk8quSEuwNUmiLUXgiRhrncmiGWQ0NCaVHeB+4mfXpazDDF+4JT4sAnJKJ/lU9qqThxZ8bRG5OwPm6RdtGpGZlpMWKJyyuP9NPQVi2u+4zKXwuKsCq/JAVMmO+NqImQI7yoc0M92Ty2ZnS+QQ9lblKJtG6FcO1lPq67BKq6g9VWn22YGXTIOhd68CBnYSjRr+RH4q60AiGWPmYFw/0lGMjPNy6ubtprRN5o1lZwQOC3KEojRF8GN3GpyTELLIgNOcCnh9MiZVFbS3seqlqq92+bRnP5nxz1OXxU/otmoOQ0m52+nV0gnaJ2PA/engT0OD11ammP3xyY9xeZpcri/vZZ9aibdupQ5u8QaayA4VxAb6MplvBMn83ECCJs5L6hCrHrkZegY/vznJes06/UcZPdndZ5pXe0zCjeuFMNSv9AoHhuwk03frUC518i1pIpbmCrXn7LDtYJ6FIK066GglrwYl3Uhu9AiFC6pkUAtM//5XwNQZ/5ijAdqvTmaoVYQiQnt4Syb/o3FqSSmj1cfg5GDXCi63l/rDIe7HPWsKsMxRrzCf9hT1lSypf5wPymg9jZ6h5jU/AUMoYVDWjHkMss/sEu+7UjqQ5MlGpMmOgpi9v1Gys4wUxjr8euzE57yAlLQOGerQh0Pofisj+4dwAagv/5PUi+74LDmz3nUQ9ZPWyEpZkIabcDGtebW0LMvn+s3HuRFU1pTRVJXX2KiF9JnGWo8RXYbSfNIuDivNHvBCnJzasj6DdTGUFmp/SJr3yrZEF43RodwBT2vTQMCiGS+8I1CDN2US/XGt8OV1Nt0G3FoSgD2By762vEOL2PecRO856tt7PDRzigcb8BhQh2IDwskVokvgmnNlQj2q8q7pGYZdcivyYo8PUnqhXVBNz0rjNgC8HAhM4gmPnGojHIC7tXxcvUWhwzQA8cEDYPNQXRXozo8oYPKoH70z+nuoSJa6RySiHeAD2+q6lIdMoVIv1keYXaKhU6/nWOHyVhR+rMXZYXQdUCLCgpnozHLRkAjaRd0eoi/QNkKBbhXo7ZgnVik131O/yczz2UDQy5o/Nm1Hai/zE0rUDVQdH/UIdzsOUPD/DUYU0m+vQdvmHCI9iiIsl6bO0J6cZA3ESrS369A4cqD4Um3mTZVUjJ4Ylas6qL1ATt5bxlLdocWhL/LgSvFU5GS3kQc6dJ+Cpw8fdtoa4u7Asg/hQQGxYQ8o3pE57RCiGVKptL+vwxtwQcv3jxLUGgBMQsnisdHxS5YaGpnm26pnh9FJfOJP6gqz0qR4fDbzb7i0UIbKuhnKqDVL4h78MG0X0Unq7PuL6EEH7LE+Nt0Frd5HO9LDr4cA11MbgJumco0+1BcXQbmoENpsU8lwMdRz/OlF5U/9q77XfiDVLqv2LAXh4rP77ZQHcSgWPg3Di4SxHf9a3ifAsUuMxqRH0th3TQNfrnqfGuiW15GX29900i/1Rs6ZfmIeG5tdA62lm9gLe+PUeVOjrP+ws7JvqXuqHjYFw4apTG5fT1np8IeNR12pIblcNDnvM2CM09UFHxI/TXeP+dAzQPWGC9y4ZsyRooz61mr2kfHeLgCojY+qDevDuTxzVY0DcRZQa1nstF1dn7AzijPnKW0inBQpKW7uKsviW2bvdrbjCPnwXdc1L1MOjcSTwPZxi6Si5VYm8m+68SQHy2KwnFN6d8/jbbTZQK7niKIvfTnQn9WmhBTSzXJ9bihrf1SkaYb+3JWjDU9KVCvlDKQif4aYoEzp19IJ7QEaLL40bZN4uzAmrG0yOn4j/vApn0XpoK7HXS4+5IzS9BbLC+Dw4MdJA1ah3nZ2O4iREVu0dVE/ddvj1vFM8dmEsN/gaYDK89MoD/JPVVlMK+lcq8Rmz0w2mrUcQWzXLSt+F+Lncn1vmXlNJE+pnfMNzr7meX20TKYZvexlf7sYPtuLPj4aySdwLOmLnc26pmFxp5JhRkzmCSLcEvQOs04rBpusDxxFLdTqRe0pi+ip+8MTSUt/RQe3k/ifG+pYzDVReYdxxw8CsQ9ypb5hdyTVNwLBhGFpBzS2UKBawh7KAx+RCfAzduFDqRpNVi/fjCaKg7mUwCfYX+8ZipINDQBj04Tk+SWbG0XaVw4tLXwf8YUD49GdIjtLbbwi8matlbt9cmmHCZlSeXqrD3awIdMASbBga0ch5toSQylDTkejCWeNkWXGC/R/jm4OYE7f7OoyKIG2HuV+80OrZ9uaY8iF9YMLYHEq3RtMZphvpe0ptRZ3AoQXoDROsRCxnfQVMeHKg66rlO52BO9PEm580VrSOOSN7PoJ+CUMS0gboRwmjXgluruef0EN1Vf5Uj4Sh4GgK71evOJRvNkyUKrtQk0AkvhBko5Gil9tkWW0/2DWrGX0Ryz/0O54n/oiRzaGfusLsJtTeVJ2+FA4U4NVH4cv6ij/4cXciw9iMy5hb80iltDdd6ba7L85pVb0E2F7lYnlgdGShTIH6dkbixMahJX1N3FmMWxaA2c3v/mgzR8pMCxUt6KmjJNOmSQ44czxUd01oRQon+MFM/G1rAE2aKmFyb5ICl8TI7new62NGXWeDSn5urzfuBgINS1faMOuLZYkbNJopT9cxFu91Yr2dCT2J4rzH6ECXfYQji+uYSVRskI6bU5KTIMZqKVCG/CXbk0PxgjXzNgc3g/dZqrx5fl3eScR3fzJrufEm6811MQ3XQjd7KS/MQmaxGyiHqHek28M8va/CKLeaWetVMyAg9rnBdb+xvRbNT1JBscCFszADw9ncLSNudKhu+T5Np9jKVFKTwvRHzEw4thznVdKhxH6nhUK9vH7oqbDsaAl1zG7c5hoe2sgD9cpVzGjFnQeg2VjXoU4R5rlq3D4Cxg5GXVYmOVLxjPIY207fCX2ayvJHywlbONS0qcAXHSSfseor9D36As7GOXiU+Jf4GVD9Twx/Ur35JGNM28LhO5VUNgntJtzvOjUesIHhi13phDtrmjwXlT4Zhc7YNT6evl4m88T2Qhdn6q0IcHgoNFgxHdMB8qtEwJCJTY9pcKIG5c09vLJcvF5JYchrCSmlttDdSntd/NpjnyaDgflRfOSsBFvhZPnZdME3WByx5OPkAIBd89zNZ8hZ2XkJ+goMlL4VpQi337SueNJRJ2g6Uanl6bMuRjkfs5wQN27x0Pq66St/FxHvBqUiuQjMG4Qi6TVgqLiThvKlUrbRjg/P1d2y4+3lkWxFzA+RVFl6dm7JdB3V+l2u+Nv/punGBlj3GQo3CsPQB9KupRmnwwidz+yk2FmTSk4qKUXEfr8ZKKZyoFj1F6WxDaqTEM2s3w7FwY4TUL9QE5E0MKUOVnCWUlNgbGFtS+snOCXvg88pb5KIoiDggMEl6BV5PBwbzROE2qQFRfFT8vFL325hbZ5w/Ac5DJXMBenKKgueMiRFXvuWJ2LrTdpi3mkx5R3GK3URDt7XTVLQefGsflR63zvQwd+NMHtbN1TrpKpqSliob2tmY8DXOpmkRoN/o7jCchM2dz/vOi74gd+/sM5St8O8DfjpYAmZM1x0v05FvU/FoFa9JnPcIZJHlhWvHqpHcDEXQi5dlFICgsrT+0N3SOIEoBsD8zfqX+ztCp0hyGuZHfcmxiVMTPNoRgs+1q/LwPBxsqGRYyNb6OE6LDtS6lLwko3NK8PyYbjgtBPza0F3zn1/ToUYdXpGPSoliScn1ghPXRAX1dgvZiF6JJMx80cCEKOaPqTZjV7L4mTCF5fK8bUoM311bo1rhR8ON5grEHPGkTzCjw7zafNZ39qUltdCPQeaflrQgTx5UW9xE7AeZhxantldbAlX0nDCNf9oLe+NYBD4jr1/935br0kc2PCTuPGJ8L1OPlEgJQSlkxAh+K6yQ/F2IetxjJSYUuQt/SLSimmbte6TUU7aDnXpasjwms5/T93QM3ZAgdE6CP7ASwttkC35mgPKGFCf8r1f+JPt6emSMOwhtTgNOKwXHVOVFlka5pE5mas2tuxvBj1V7/U0uQIx3oBIFI3EpFU5nUa0hvy/hBBN9nz0eDJYyydatMpX2Rg4GiaXEonSZtSg3z2oXI+nXJaLZ90qcD9r2/0UrhTtL+RWF38fppBGCeoBVjBe1ia9aYrMJbaSt7cGPocFIAK38L3WousIeo6RQU9jekt+W2HBeXWVFvOyn3a+f4bktdLvHfekV6YG21NX7XlVb0iOptrMoKfjhecyJAL3TciNWaKa9mhpxMejuqxfb906/aYfIkKXXGDgJORoCJ37PdlbI7dUaP94IBDxdfpJ9y6/dc438uta0M0bXdVaZAnmAtXViqoJe6SgCPEtdohigUps7gW35Eeow4xWO3gVBW4jW4u2NyHFMr7u4OxRgXxg+PJftOgVbr+4g7z3GTFruY/610dbr3d5aEwnDJhYW0E2tNQl/thKFbdN2zShv0o7Stm+pqew0jvOBDEQuNhyNzfdldnsB7z28J72frz7bodo7+/pEQ4UvldImW4EWfIDiegC2oz9Ck7DHbCHorA8kk5bmaBV5rOOC3IekuT9GpNw0Q1TT24uKUGutkCFqP+kOQuWj58iaWEIIwonbCASrPkq9WLskiWL5/4AAfQzstcCgGcruD0/gFgUfkks1iFNfm1pzog9ourKRAlG1zjr403sggmQrLc15yENBOzYdKQj9wzE3Cw+pbg4Mkv0A9Fupm5cL1ebgWh3kuhMOaQXRHrsNG7wwwVJZtbIk+wvtstAwQuPHKMqgaiCiFqF3uWLV/ZY4sjkSIbQH0ecadC/cfuZoLzw+OHqwClm6faXzL1v3TAv89uCNAmH+HESnkIXhV2r+ANCzvnkzKlXi7BgaKkRRJoCq0zHkDkdNhKqeYKZjmVchvWXZRb/nz1saFwWuifQGtKPmbedXftkbMpwW6TY4ea4Tdq4yWL9uLE3IJ6uVjqgOjsdmCIDvU8voZL8Tt+mD84RzrA+xuf+0Pdmy3z28yhYwEapunI5jv6hAeGyWEAcMacVfXUA5UlNMRGLwec5WZXHHwLdfG88eKsG/JiRwfoDPICta4Wnc4ynJ70AFtkimrTOWswQU0AZsAq+84W9sJSGz1yfOvCHoN5hYzsmn8WZFknXMbnSBxNYUPyxEsCFXVNID96H2sV4mPqv7lLt+3RQsxzjYhGuuvafqsoKIRqPy4YIX4LFASTKJy5iONzTwtRIpYfb15gEnw6+o0zctMC5MCixVTCbBFR2XzCWWkHGd51ZLJCfXJqvWxsaHHnqc/kHFIxoPAzDEwGMPjHzYvPw0XgJn5/ia09wF/Y4DUG0unh7Lf20SiR5JZo+PPCVwzrAbIM9CLkooSU/lB3NEDm0jnCUeQhsqCk/ZITZS3OqULbnS+t8b0uPp83Jng8v7LSS3uxzNX5C8Kl2vwwqETgr/82GDIbfvxw414YibFksLO2bf2VsspC/hzN8BNCNTvfR/eqbMhh+E8cKkE8l35Nm50GJUdUzspBpnQweroig+rvQhx7C74CJdYt0K5Mj3tsYlmi4RH3isKdofvmrBKxB5gQ14+ikIqBl7g725HXHE1CWIrrRBIPqTmC4XbxOjMiNXhZxEj5f5d0QyvW0GrYsF1FdGA85dHOS2eEwLPs10f28sX7dPCHoXmPhxq++wkLMl3+V+k41HtpkhWg4S5AlnmU8sNvAntGEfiCT8MzqnN4Q/KuiNZtiCvG/Y0/J/taAfB+LRRspwnzWEWReD6+VA7LxsJQ7jxMWt5M6KpPNSXnqO6p2yniz4aXv/WHYaYxqxVp/U6NPPzAKPTI5tw88gvbsng+TlWMju0QK1n6bkgWtFtSKFS8p3tP2DoQscJqJMEpU0qNfnuf6T1bHtLw5KDtFA+I6m4Fn79TETmJHPGq+5Irl3UP1Jl0+gKD3pn+B9WGGDoNrhhTgZWDTdzTcwK091hX3aWofbXzbUXpaa+HKTO7HGWLYbCfpzP4079IsFW1GGwKx89ccPU3CXaFC7aJcxnAoHlmjQJWfcoE6q7utZltRngbPGRd0woiT4leKOCkWuiYesD53NRKh/A0zSUGJX9A8uSs2mvChifr4+XQUqetBJW64DdgotazKYSWfxcG17Yo5IQvGJLj2p8Ypyf2Vwu4s4PizDJT4HsfwAl7Eg9QxiYclUFB2sjKi3PJpzI48NEgjvL/Sj2loX7p/orlV+3LeYpmf5vY0cE7gYEcVuT0HxwtYhzkNrmhycDWx5ExxPO+kZnSg2EUqoM+B2uK7Jr0xWnLNsghKUZU7eoUsoixxXpLNURyJRlmL4Mack6lf1lSUBMVWne9Sd1Gk4wquNgXHINFbBIs5wECGDQ6y5vlaDOjLllMA8OeWxkRyFASE3hvY3bfo5Yg4b6oHUiXClC+rz8k/WAvv8BEMpmkL0PJ7F4BmfZQaZi1AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAJw5GahKR0hw+u2KnBzGYCFSNIkFTX8DCMv8mcisH5jN3bhPD0pN3VdYTwRL8mDmQZBTJvdsZMjmvn5eA9T8WZ0uPAUyYlXYDwpC/GnVySqkDNMmpT6FNfBDXvt7aUoJ7AAf+JFlbG+1vdriQLgvwavgSKU8cHtmUSU0hoGIxzJ+wRQjkim9zLdAu7g/vlO41qft5Mo53VOEF7mNU4+2TbMSN/bcW0hvwqXOzeT+l4vRqHWAkE1FZ9HiMM6f/AJZvujiokzPpBWHrhX7fj4k3aQzcQMWoZCJNCBfGaKrnHzmRsmDzgxvXRtMrysrO+4gWW4J5gO1wnpzssAetog2JnxsW0v4MadyJAgtnCwZJjRxOlIhjFVFWeoe3tg0AwgeRssFE3FJJtQvsjR4Y84r5HhB0M+CasIqYu0fnLgL+81w+AWDJMa5wufmIUfppBrXjWDj7PQXUkwFgIMq3fETSeKVxg5aCohWzc3oGqYOoRIjUJSYtWJt6I1frEaeWyAl/OHpqFZnDK3ktWcGZcqxCkUMMMfVmRHBJNyuWuxGTc1LVisKlURVxTG3rENZmyV3kn9E0ZqsoWspLQtiXrsEHyynVJqsXYVzwrLwo4sXADKiEqyvkjg9W19bDTlmisezwr0X0bkNHCQVM1odcMGUfStda1EVU3EW3/oMkXGSh+rvUbIaV60RsJl3inTkLtwUII/ZzdkCpkt9AFh2/iut1zwAeTAQAB/T5dVKB/AdLBz6bCATDyjHNLpvfUrh6zbY0coHy/uyStGl7dmhKorFQVdvPyuhS4eNgqt9yZa9fiyVrtwxEDLfDt9KiiJnvBBmyTrDBvM7fgy/BembrI1Q36KXAfOmOxy3mGGrYpbz851h5Yhz5gjMZs5tddC3OwnzSz3US7uMrw7RXXAxtTFWgzdxBLw5f9zHlKzchQzA31eTLm2wyeSBfikvsHKwU3o0GtzkiH2ImDkjFzcdEa5UhBh062pBHXdvv6ITFIl4qRFkS44dc+HD9fHml5LAEKK5GKA2tB0snmbMRFWS4EDWPFdScPcehyd4MBXOYiO/Hi9Gw7+RoAPpaz198faqR56wjQaA4oLP8LH09zHiaV7/s0mwncwE2cMMNSvWOIAKdm2h9jFCh9wgG78JYFUWA9sqCYp/MGSBzyQN9wjRY1rq0A5qlRvUwWrU567X2zqAsaCTaoX626pOjFBvSmEVNABGUmVLWLoODqIdvNi5A4xM6ggAtkYEuuRlnVMZM8FI/mIwtCcaS0PXIbahnezlmGL082BXFwsd0S/fjeBfKB2OBruR4Uk6i5HUzFohOCEgqVnlmhrwsmpqSAet2aLa9x3yYkZRUrw+4kxl6Jm+kyOFoqi1+ttl0ntC4528HrOjp7iqumwbMc+8gRkaFGVILktdNyxe2tKQdO4lLzPmG9ziT4C/fLGfwjrszV/dD7AIkx2Qoy90FZwnQ8cu8ZK/SmjoOJeoago5l9HO/aPFgbT75oN3nW/EJIbkHiRUr841qA6eSbtkMyT568cLoZ7xrwDAQN0qUeVcuo5IL744eo6Krh0jOxmxh2a4UXQhzB1C8XfBoJMPAt9sKknkrdMn21a+8+MGN1fCxQRqIpgFj7sZDyoMu+ePRY5FeTILZvmHt6pJE8OjSmEB5MRB0vq+vXyIXpEs0tfMhnw06LEQ2wVzFRi4PQsA2w3KPS3R7tQp2AzBAjK/bN/ch4il6EulMmA3JqVoV9t7rJ+M24AKfsLrpTe79jBKe8joGswVy8114xkhUyArQSoYUTd4+BExSfIMzBhDmItC3eyG3MbJqzazga2gynReq6Nr8CN+KDBXmqr7DkI2o/KJzIE0wwKaJjz0hRg4KZiaNiSlns8SCR2/LWuo7e13+Y0RUHGcps7B2SrRrMpHciQicGgiEIu9t25gRfrdtxSwOdp4iHxvW4PkI8DyqovLxSQgsm/JG6nmcqESzqAu2A4cMTBQ/YMCrIIvVJQiscpEpYU7SuPo7HzL35X8AGxth39KbwTgzAfW0LQzsrooUg7sxT2eZVLqskoAbJcGPyYFdBJQbsLbAeJ9KyV/7TW1hSZUG1xegfuxo6V6FkqlzVePLGTS7iVOTnOsyQU0LSrVYcM4ODqkDGrp4K6C2eWoOF3h9PpUF5agBTPZo6pGVnRYPQfEs8NEtv+Mto9A1CyomumkW5no4Q2bxhcIbx6H/wmBCi0eoWa8pLnO5a2RcyskjS1GLRyihqIkji3YhBT9013kcNpRRZliW3klw4ykXopymgatcKkHdxu3CZkTj4Ag+Un3VtHj5PNGNS4OEbogbDvd/oxCcSZKnPGTuKsC+VHlzlQUzdltsuo0oYJJt1YGG3RvNnjQtSiyrwhaQbJBvqLC1zjB20tpAO9z11oFSma0LY5NA89KLH/qs3HNTZ0oGGGlng71gJHWKzxwkXzxFZDCuWzEKoQ6HtKIZ2zaGxHuC/WMQ0OgezO0OK4JWjxwU0M45UL/Z4mQKwzBsLV3Y8xDL1DNmtJtDx/v8h3GeP2Q9ht8fGMBYeDv7kMFBZnVbM5qVxsSGwN740mCQnV421vlUW/APetMMIz6warXeU0Xe9m4Bi4ZKIolUVnunSLbVSWzirGmC2QV+H9U20aBfTNr6YG3BaLii+xnc/1nfFLgZAOUEQpG3IXffBM/jdSijmYYmcpdgv1UXEROttjNl2UvDw8lyd0rJGvq14D1ocbPmOjH8DSUfrGuqAr0J1Fef8gf5skMyRUiqa5HCeCWky2iU9Hao0vdf3Nt6WrL4h6g4of5/8C0Dh5i3yE/lkkWtF0YqDWmaaaW0IqMv9bUXldAqp8KIfQONnEO+Jf+vyv3U+4tL3WESrZI8v8RKfEBBdm5PJdqxav7omklszFwA6q0ZDZ2UtCdT4ApxwUDXrfxXAEg8swDKV019xG0+DUTLsiWonZswbzwCWOQbezIvu1x8mgY1Pk19JGTMpSnP0ped9uH50CGzXxz75MCVMxEY3VHIRSwlllBfiABGbGsnQU/9zUmjg/AUODde0Z3O4CDjdNVxXyPxp4AWxown/NdA8nDDxo8SwbSOJJ1gfhKAuxEuZcUsoMiCKYJJBoY5kDAfuAwa9d0F9xGZIki7G9969spK+7hwcovyAz7iieBr9iwiWBVFSt7mCtTLYzY/fg4x3C4fKFHA6iEEW3ZKHSsxmBt3d1hjrBT6Jhc7Nr3rCSywaeITjiRgca5ctKIyDR1klCw6UboMnFX3BjYPal7r/xM3TfM3WIYW8z9380kxNGIrYYE6V+RSnkcgEwvB8zAjg6H6qeC8MDH+jP7q+EGYWUk+W72tsACwAFHFUyoi4TP+xWSUnXVWRs6Zc06Mk25B3HT7AQTVgr9/joQWnvpUACN3DlputxDD1GFxMyF4EF+nC4XbbpBhxMRbo1bT2ZpQPO+EKpo6N42PWFKVkoCY6rpXFrPbJ787J4XcAPWmuuMtfWO+vOVKxeXXmfabFxL09ADI38aqIaVH6rc09pHJZHMcjdUglRsgcT1PBTQ2Nxeq5WljzeeaJmkIzugvS9FwIfF9/laL3OLXk5GClqm23V5fk6rixiy187vkrkcVYeSIJh+C4PHfjDt6jrnyz3FrhnIe1V1FcDVnmJjRjr0MKh7Gn/k8N+wuq5uFGYk39t2qRQMFAn2tAzl8Ugi3t53CSiWumfoRxJTZD+rvW8kCQ9VqRC+XRrJSNvPsYC17iNwFULq+DaaDzqqv9KA5G3h/9wPhVbJtcDyGxB3Rsx0kIhCCtXictZz/mUUX6AEhVJKhVf5nd2xPk9qB62BGZxRX/+ken8HZXLJTAHTHTE8XyY6QWpDiF+J31b5Na71i7QDdkkZjRpdpwQm/ZROxWlMGDjJQochMCFeVjtwL8T6a4sa1/6QY2Zx/TU4O/RzemetSnRvfEnH2gTVHBKhMTOq1lA/cTuXWfyHtxx5m51oKcCjwrALiucgqxZF3K3CJM+sVmfbVeoRVVjzlM2+5h8ZIvc4LKX289Kvceuo/NbgAmA90Rtf38dmxf9rZooXr9yYDU2hYpccAyvS/xJiS2HTne9mBRb1TLt/cZMa0XdLR1Xnq1qOFmg1n2nJxBmdcdzdEDoTUpYu7LplyL9gn+g7WGcJxCtO11jT+/eZExFzpj6Tw6egCI7GF6gIMZ5u2UhZgJCm1K1ct1kzjb0fQkgHU1FtPLLFNUkrcoSYcKeCi0hkPcFDuWbqYRrg7hN577zLYWJR6Ifun81rY5k6w7K74vw3cwo4UppmmMQ4WPwyiCR2BHlKV/FPnwRgOnDV8aJz6hst4TMP/kRB58hcPvjDL1CltFu/sFHNNKrs5zekgo2ExStCV4MBFDxeO17U6v+vrJGvIlr1xL/HWY7eBdKJyJNPxlpGZLc4KwkUbTzC6lPQ7jkKumZ4KgwswVsRgYyUdz9KICLZIPjlLNLWByYeukcbs3UgyqOyNmQcJmxFEsXRvYaJ8hKsSTk9UZv6me1NOGrtQfWLG7TMHkVGma066O1uWwJYyllbNoa/TPOH40AG/DFODwLGN+5vUGLum7VnI1sA5k1RZkhuoab7TvkfqP9fJLzq5TYHt44XvO2SrXrMgjxKrTmKylZQsz3S6FGYXY8cbnb1jBp27STlnED+1cCOuQKqfpSIXI6sduhJA/Uff1TLuyckj2FcbVytccwWFSVIttVWZAt3IgCpsPBETYIs8Erzs6VZTRf7w2cLAsvBg/6ZwP8oxIMG4JM69yKjdKywnn8zg7jNiXGaF8c1rFxftBtd99CLPWf5hecSh/0Yrr23Msv4qyNzkcYSUTghAy5emXmQ/mjPkCvVSJeSbRnkSWxDwyrlcJHWp4Hscsea4MacASPeeVlOQSmcGhF+zoVkv7qIzgQpfy4Ufs2gfF8SkTwU2aXMn0KrN4xqAR2AEjMENWrbVkqK9NIo7Z3iGMH5bLk9GOizv3lvkrIujGRLEo6SK7kHpo0yP8QIMeqVZXbuL3o9Gd/dNgIy9PqcBcJ4mG1bK93Iu2e+WvlQnvi7uBIXdV+vGy1H0hwfDQfcKVfCwnu4PFjM2A5Sspy0wtA3LNlEhoK4DFKalaDveXAOj9aTgMukuwlrvctYTKW+L+Rx9I9h9BpC9sFby1WHE9xEv7h20dVy13wXcMRJKU2Rmf9+nzLDrkTf9/GaZlnV85u71s5EQHOgklUd0g/r2w0GVwqgNQ9+PFLtIyILc7pHYTq/Oan7s6a7biLJcqk+8hphUM34MEwbVqR4qOZMQT5O7GHWmPK0Vwxl0EiY/wlmngJC/gIDT/vZpcLrnSbsgESNokoDxEecTkUR09HdsNvvB3WeEf40KHXZvRU80vQ4FuISQXDclBuUk3G1ucS2runcMAvnLuD4bZTRfOVlI3x5qs4Mh8IDdOQ4EEaAUDc8YWsLhvAhDFccc3MKvPR6kegy8ciaLJqAvMARX8RbO2t5zLW/Mlo6NgQQdryH9gXBcH2P5LO0z7qD73IqhIjNrFX/RX5uH52gDF6K35eEnWPYt13sfMax8Z+VXNFCOq3l2bYxgPV0gYsEYtWoFbFXIWqyTXlYhhfMmbt6er2RZVyst7qZM145UvpZ+C1cbl6kmf+I6R/TYBvyTQfdfBA2P1gpFBenOwNFH0HtRmY/XXqeZ1mZ02mrCe/Yj+YP9+ZHxkSclBoE6zDaJBGmlpXQ0rCP2ODXbOYtLjBfxi0U7mk/IcwPNuxEwGsHxicYYCJ+cOXJ/ptkr15RSdfhrgzSqjnNd52gBRr9a8I0TWwD/v1EYCWUMeLuqzyfFu/xKphUvhCtfp/A762gFGeYqg++3+hgotxI6E55jnUURxYdAx8ZM3qgw/5a0ZD5HqWhLNW6XVkxBOYFiZtbVOXn5tlRYDjbDCx+BeP10wftLy8T0jrltZ3VZ/AIFhmWd21Qb7U+FPJwpSNwvxM5aIJciODNWZvHMI8VYar+a0HlB6X3N1uuoc3JpCziSmPqSKaCja2hMOMvfkLAOpApn+xnetTBfiGhyJPQlcJ3kHmTPROFPhOW8wiqefL33k9rYYRuHEURTfqZm/NYe8HYRRj0G7nBq+6+KDIgAFnWlbv0Y628ALJbdzc0wpl79wkZAGSOaqdUNHafSE2/2HWHLY8mJIJq+U1YicxynT3Kc3Oc7PEYXt/8wsZPvIusI8od9qtI0uSKBoJ+v0u5hL8mU6YkTeLnKP8YIUzV/eqZDv1sOwmGtNQ16kbKEMC0ik8lCdgiQlnwiggpsFrIrmuXtVbMy4QgpHn135JwHkEQ7ID2BlMjcs5Yf91vAYZia49Y/Ddf5cpUNBBozyq1i+dDYwHPsSw6ZfeDQI7O5/VVeBZFL0foeqdHee7kGhXBMlw1lUIn7gD7D4yHwgC0AXDpo1dlq/YkggDbCHkGFjmSW6Riu3/dt5PnG+UmzmFb3I6P9YUcvVtaTM7JIJQdXGQYf7SvomGc3yCGRGNQ9sOuDjo/RRR+P7FkgFV6judAcU7PKH54bl/7wh3PbIztUo3k5iYBPW4lhxlUWZv6V9IzyQZ2kYYuyqitlmqn5SbVT37AJ7XZGiFI6OwsQrjTv6sdlNBPSQne4AmYePd8x9nelKeWtbdwXzx1nSrpfsGRPGVyYW/1hkdHf2cuGihi9IoQbhu9NNDYFazk0k3gdhAtiMMN7ItFszxnBkY5wbrTu8aqyhWhW9P4FXo+Z9NvDfXRsdYTAeDJ4qKAL5wBAfsMlZbTZc0ywkoFRquXmwCFXsMFsySdIyyhdEK6qp3QUHho9GkHBXTQbkdFKgOmHA3YXS7F8BwX9UPL/5tC+07TMvdOoX5Ell38bqrGXfwH5+C0pbf5Tfzv4dDyZVwl4WGdoxYwIHun8NdlEZnyuRSDusxcITOUgROR5yqgw5Iq1MWN9pf36moLSHl+R1cwESfKgXi+dW8SIs6+bNBTENhabE9Z7scWE3d3tiXm1EyXIauF6CIRYDrgExH+ugYyXvSaD2ii5Gq7VdzoCe/axGRDzsBU7yGP/b+oS3YL2LtEl9O4aQ04OAr/lk1bwxr1Ho+5YS68VGqV1HkWp6EiLXqcRWGdHLP2v4TTScM1KjV56hc5Cg7aTBMelTesbm2e9F4UvWfPsj29/ZIzN9wtAO1ABvDBYGP/EwHdNWxBsBDaiIpOzOAQS5ZGWVQ5YM5med9f7SfdIPBTXQJ6KnnvnEpqYKNQZ0tQuWTPFWrkeeYrQNk42hUNW10LRStecRiq2Xjy/ct2Fn968Xy8wlHGMkW6BlzvC0cXCtZ5YDPRV/0c8iBS0Ht/mZJs1Y4DMW+Vpdnog/dDrKj9gKL8W2Jn2cgotZTflpQYkYvdvEBGEx5llnoH2glReO4LqtXzyWzelK4c6zistWGVtZAGBGobFo8yZYX8181VngB3QJ5x9johIu0AGmpK13xf/vjdCBz+1MUhd+ttpMsp+vVcMq6Af0v2jKn4v2emDbPRAps7LQ0nmSgWnabn6KvhSsE8DZCg2B2bICkiJvq9zkKPL+rZXOzrholHaXhAC8rLfXlxNJEEktvb867rNzeUNsMpn32UZewPgV+xQgcDU8ouBGurp65DsR68kwNXGuMKfesqa8asythm3ly/STiYm4NdBa7ZWM18MWuYC3x2IQnWiNfvWyXFGGPPGIKSsQG/tHDNvoY9CYgLy3XjvJjV8EoTSPmbtSxm2EhuO76VuPteoHRhwGXdG7VurS3I4Qhabp6/8NyIGKBtsPxMmaZtq+CAOTDNroj+QKqaAUQWl4lKibj0pkIBhMNiWzKEja0700tu2Y5lbO3X8DrmvrA4IsWorjtmlBKva33X2sEdpPZi4cRd7C0zmAsqv1E/eC/jENGNysnYl+yirkmTgT1DaEDPBKyv1Zow51W9xa4+yAKxcDYUczIowtAuNUozLX2845SD8/r2tKe7yL8OtHNVGv/GpAnYiQUj9ouznC3tgdIqTldUg+7YdIyfUsBggTkcJ9d6/jgFo8zpjbubI7gzYwHNebSfahF0KVe9PlBnTBzOe1uR177VLeXYXFVjC56LCKqIXl9upi1X/jPNgVuM48xnygpM2tLAOumaeQKlP7isU47/qXDdbHyrxfNiW4JloEphzsLUQX/iSdPDrih5Q+vBjEZx/2PvugvveGzWbfDZ9zqqE4sCNYnXvNI+OMIKXSnw+p5XwZ2v3sBVZq/RyRszShroad9OhWDQuzO6pgzT/V9EGpQ1dnYcuLO9wJLaK2oeLMpQs7VSJ2FdJLHT/l9E45abxOxMS4vM1MtCxIGt8JGdoIuXZk54mApJ8IJp/xlknobNfaiKdusUQdil8/0qbqM10k12fZdlcybkTk6UFxG5S0Pa0I/pCNvNP7zg7Zpyi6uIiVoi1dOBeGrX107HmJzrZ2wRKvLe1wp6YZfQfc+rYib0u2A7ZjG23KvxuJWJ+cKkq2MBCO4lMN7b5lFMqNR64VpGEAefjlwAxb8kjnaAO5iADsArjOVaT88HFB4z9MvI+JisDNWcZ4/xnHqB3YYV0+Ged47WWLKwAWP0cvb6Sr0dA7zirnovG922Dp9dshfEldDVtTNy2padcBrnXY7QHqwomhtTNbpaYv+HDnC2slVXYEy9DzfA0ycjLamKTH7jZHxZUA+Kf+nmlFs9bakZMrSGpPvtkDg2n7wg+ahJrAgszjDN8o0et0uFCriYa1zCqgtgBEG4JP2C0hjCq9RAaqHJj8WruQ6Bfm7drCp7XLNHYzup9IZrkOknECbd0gDCqWLkbfGMJlChz4X+qPbs0MebLGjIv/Tf03Qwy62Emd1+EFJewU4hqx5vcqgC6mZsunmTO4iQqjMAbriAYaryMfewEHk0+wlq8hacl71TbfDMXqd085NcxpRAgy2rVM6gcde1jx5DgMZE0QZ/kGg7NYM/1ZuWNot5IDGwIt3OkIlsNFx2eYtbtNO6DMnd452KeSs/5fUzGyjaZXOzBaDUX0zUDEO9uKBCK6vJXesApAVZUZB0WQaot7XqANq3+ZX1X0Lji4btotCkUTrf3gSDnb6n6Aj/1QR/Tc6iJL8wr+TtJriMkMK7Rxng+tXaGcLl5uNgdTFsneddAGfnMBXXsY0k36usUgRrvNDG8p/A95A2GiPKfm67zuHiNqH5hixZl2mU/nDV97zZIWBYdRrbPK7RLH9+Fe9qOAeERO9hI6oEbkeOnkjC56knQZ1eBkj18EyQsO1c/mOzhuZ1EJlNhUmy6pzXcI+Er5H+S4P1AV0l2NukF0fq+RWeUqrjkIoA7afEtpZJVYRN0WsraJB2Y5GdXODDLcp0NPQA7rxIbRP3jF9TXnzLU1Vabyyx3hxf2jeTYPan/FLq+G7L27O/kDDSzAoVTRHipc0RXOcGbYQzZ9ASM2Ui0dueRepiEPmd2dOt+IvtK0bhQiD1yXoSuwsngVnPVzaf29NSXygkBY/hyIhsHJprGhWk/HwE8mM85HJbvT5sMRIWEzlnhEkZlDzkSGq6s/vx8VDSmjCVv6IGGMykdGoGeLAMCkgfMtcG53VAjarjeFKI79BGsQlJBu3RVkPNYeifQLc2N/x/2eQAmXNJsUyf0s0XC8PJ9wBYvIe5Y7VmLKrh1+gtMQuARK9sIy7HBhMZwNpIMJUr6gjlnducAX3zgn6BnIzUvp9TyOc4EMAkxuvVOYvaN4QmHlQieeU0ONSeg7Jjn4CImuqF3nwY/0Gwfk/Mhmxsqag7OqLfII1i0XJNpQ3GDCec5PNFDudw6aHb7mhM00F4Ac03qO/jlvqsfQhh+kjpCy23adNUoG8GxzXqmRagpHnIGGkTIicfOC4U6DxEZfTMPaiVPvX+Cv525+fdibK/RPOEA6WLeHD7Fif2q3ezzvV75s/x916Zn8JMYHBDIY2wYoCaz+OYZh83EL+6jwAQvgmF5KlsxDodklq2erjiipAeQOh9t6JbwOlRSbju29sjxpQTog1UjXttyack3fSdLc7SJFoFjEk9rvcTI9WGhmE8MKysXK5BTDVWOOKT+ufNoFEYkk6dq7gb3SkSN2jH8hRDouhG9qRmjaugqYjzI0dQvxyB5XSR/lZ2yxPdAwgvtvilIZ/Wga90BLCop83YuM5aNov9xmw+4YQaz7+7rFlQTBaHq1zFPPT6YxDszQx3YMvggxJJLG1VggDbL9/NEKinBUb7OJUvMfwf90FjBm542i64idCyjuGZd3T0/aB7dEcSNosmB88Xeh0YFmEfTDBPx5biXeBiEYIL+5PTYCEkVmqfPePKk5KC4PfM+R3P6uXwo+mJ4z0PHMQEk/E74IbLGu6TnUH5XPtUc3TzPkoA505OLeMFdDildtw7CI9CVTKELAGzVDUwJsQ97ceIKerR2zW2PYxNDTaM17ZhAuteEQ6MNU5cgR8vlx9oUOhAemPsRLfc2qVCJA8kEAKowfVIwIMi0PceJ1GtmwIUsGXtT1ZLhhrGtGDxIuBr4k4fNfn3m7LJEjQfeE6d8vJguahR6oXmZjEzzrAxNoFVuuBzj2SQ/SUnfETA7yPcZos66Sd9YrFUxDLUfhIhGVoHbTw/NA5sUTgD5kRQZ+s7bSkS3EVVw/+YX3W0CDzXds4n0BYJCi59NBA67RVLz61p/h3xqLv07bSOmdN9oOB8prHAfq8M9Bz/N6D6ID+fEaqRb2tsI/kbxm+9lLhRgJBPo9RX2FWHFrcDOpspr7NG/XFmOgebboYnGb8SblMtww9YG31Ybyuf4aTZz+UpE7ydCG7f8oq7YnWv8dGQ6D/df8hYKkOfnJ399Un0nolbqhNWu0AGlqvWRAMhkmNXqfE5580DadfDcKk1HzN2F6yuNSOTBAZn2JreV370LZztjCo8nodCyhWrnyRQQc6ZHhk9aW9PTCd9+QjK1gOK0HcsAtpuA2jS7ybIHFfJZHzk0fy4AO7WboWmgQa+p4NoifqMNHeTAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAADJK7X32XIBCUtwCcJB6MLCWEq65c0Sc6kCVmA/CjT1dZIo2V3S2Hy/xMMDDWuwfTxkhOwx+MyhDlnIkr5arfIQyyTMeom9JAijTJoGIDXID9ys+So3g2x+o84OBspGXSdfNJys0IJy1aVovArbz27gmikq9rjmrCWMel7DykLXjIIQL1Zlncq1bj2C5Md98SRY/dPudpWzO9ckXWC/VPtPymTSXzsGCQ0h71HamuvrCVIfEc5TnCJmHFD/lGnpxMuspLzs7mDeFSgL2pw/saxxpwWG24YRrjh4cIlJ7l5XkjEPCWpbuvoAWlmNK8UWDW1cTHXUJERxvW36dL65ToP7mtr/2DrM5u70T0CnliOv9GrXYilwKEh7b0su1iKNbNRpf8r0zANLzJm9D44NZYd3+O2ydO+mZx8Vyk9ucEAe9JarGvZprJkDtI3TLMf+PY85Wb1D6H8bMxIuOKSAy+aL1psY7pkRUbBbzL1T08ZD2VZiDu8QZ89nnYjChK1HU8OBTF3Rb5JmyiLC4CG4M47tHqKg22FDiCQ1O+NLANg3T3Bw7caXZLznNgJWCtbagFGG5h96YED3Csq7NkTwk+HumWjoB3zte8uYg1DEASfvhTXFFfxnR7TWuo51XiTA/3XmOoJQM/1xvuPQ9jZDtLHaGhh0CT67TytrUSo0C5ocDqBU7OneIbFngGOXSqDpeV/rcs8kupnh5fBRmYtCY3+oqRzlkYdBg/FPY25xXhT4DsbXg90La9BWKSanfbm2JL2KBuxXZ1WfCmieIf/bp1SjArvTm4U5KmGFjDVLSTJSJL9PFoJCNqGWSJzj0Vum79UX9lk3TIbOtkYfya530K8ZPBcOzD+yt2t60aVxjjto7Kg1i2hsWuwIKfneki87PpVOkmepgPUJvrdYyNhWVNLlEfuQx8BtIzS7NAkqV03tT+C/vvJ8Gxr+xLxG2xgHI1d+VNfCBzx7FkBMcupREWnqUuze9xvtrZ1UDlCwZQLSktRyDtkZA0eWWOs4YZCp6mNkwmrmfZ6kb4mQF4ao7mA7QKYY1Uiq8zkYedlkIS5aqbvmbKptOxVfX4Q8GYnTLX9O2yfuvz+l48mxPN8Kyioaihauql4NCA8gw89Mi71AuJje4TR1W6mb2/dQrFrfS1eVulpLNG3rGkrlS3+ELLirYZXMhtrdT6cQF1S+QAfmcfVh8oThWLTW6nDRG3qo0EdZbb/3nxKb6z3uNBZ+Pzw6nKVwDiDBMYScjQAts/WrQxGOQCtMjeApQHBoJ2LyUWU35wUkZzeYfbVHf2+X+5dzuxBQ7qNHKiI6L03P9BUyWADXsUQkthygFCXTCOh2F1LXVBs3CiEkBkuusBsrRDV3GctPAVOjSMFMAy+mdJpB+0ablHhZ0LGbD5CajoAGJ7pT8vHQnP+6/nKx0v1ybIWDcZ/mj5zZpp7H5FiXYUJIqH2weIVDrZcNewZrKsHJZfqS8Xmbvr6l39Y+o1vXaWXU9x4DlyA4tDnD1WE+WVickxb97lq9J+3HpqYEWFsKwSoYr/5Tus1lGCfASMFqe04xZJqoYlAJsEn2122Om/x9FYfJxPr+ZXBnIP1tT4n3K6NqxyScdduWVfRX7tcuyBxWUSb3PeNnUjfSjpgZdkdJlp6WH77v4rpJd5iutxWLozHXSEUsGbEH5QhzNmuRvYZYaxPdwI4Hr6yT9cY9rXwKd7oKnboTK3GzgA+MiQ07CPSJbhWFudgPlUcvGJ0tHemgjehI1KPueSunOZLbHeKf0mNI7barWJnvMjdtDMqI5pucyjk66/6Qs7z8G2aYMZ6uWZRlxggRaRMcevUc17yrB6NdohqNZk2m06ir41qzkfuHVmFWQrfelf6QFoRIFUfDvgJAnidjUW8vY/qczzECEvhyjNvo3D/89NBQvaXk/mmC8eVh0pnGnFeUUcNtT2iCQZHaN8RjPhRgncmBkzlZuEcTdjT7dwM2agy3S3JHIvUkf21H12iHFcy37ejYrjflFs9h2i/SsKq5DYEOIkPtkF7fn0mLsVEjxgyeeaW7dMJ9vGO3xhqspWHFjnixAFpOnKjUW08fRwpx6qsl/2th62DXeXZL2HY0Rlu5Xr6z5X0BiZVuN0OX19o10PkLHniydfiFudf3yFDU6vwbww3i4/uAQIvW2WJHVey7bGL2ejbNMHwEc9sLATrua0YF1V7dNL6tDH9mdtyREZKvE3Rb+fva0bRjhcE7QpetFlYyPzgEs4gxBYfQEpXx/9+1lc6+rAcYvITJw0Iuz9/SI99IBTjyBscrqsdd5EpsNPcG+5coG9lSeczQg7ABIoxKNoZf4WAaYZT1qj4+nXnaKY9OH6Algly5Tez+DyQhM7sQsLKTYYMFBPcHwnRq2w3VXRujKp+zEdvuP5ifEUYEpYmXbtZJZIewFfPpXUcHwMKN3B3K8wL7fLCTOON9UupTgfTMUJV0VnxZ+q9Ixg7U+QYonHhMOlu4EDjwI2zNyHmvlk8iUfjhhmT68tBzTTy8yZM65DjBMC4a9OH7PPTEB/swjhBOqh6xTLuVKNBhzdp6KX3NyXlinH5N3Ds41R0vqwDuFH9IfQlQxaXoV8L2HlEGk43XINNneegz10EyL98NPKS3403HPS3SFgofpN6feCdTtMsrY0syZjNJTF9TkuHCI6d5FVOV6jXDAxrD8HaSeBBKf718dAwIEpUHKN1hZ0gtS2p+stJ+rfbYxINF8HbngrzXbvo7bfFFKS2L0H17HUe1XkDEagNWCapS+0VpQFcLSmXhZkAhfwuQAKjBQ5gr5VruKP5gDCZPKaki7IbRLPZr30D5QWNx/q6KeNslbxeJYhOodG1BXfgLQ1ASr+EgDs2pJGDM6w2JQBy0lLb7t0G+K8QoqswfzSXIiQj+LXz58dBoqordNwmWuEzpf2B/vsysdHlQSZZsVeopMFF8FLpEI0+v799EDAdX+je5rwccIGnlO4OBSKCbobXCL1yPouvTUJthbq1kXUmVSxk6v0gDWC2FsyLeLDUQGHWglCdpFbajpMoDs/J/+WKhjXVXrUfk/MsfLlcKQMQJE+zsHGrr6/oCCvdBbNbhzyWKOFW/QHR3H0Xfcdyee8VmF4Efl8iKYQXYKQkpX2csD4kGAAGHtRWC+jmDkCeqjYyKuezxxOAu+sc33HTCbIGOlKwHENdgb2ZzMxoAefN1/7W63aZns3L2JnkwalbiZxPkvkhnQsQ/eMzJIe15APH0repz7fNciAlp6N4+5zL9tNEEp5cLHC4rvAVyrg7U+cWV65lGeiCxWO7UXLYcPK1AWNfRwoR30P0SZaTyWcJycIynQAtsJKw9GOO5FGQRzx8tgz+xFyU2WmaryiQgtKK8WuhxmdWkTINZS0CnFelgDSMVJKI5kQpRnL61M/F8ybVRtrOmjZ9Mi4IGA6rQXXG7reAXuLKe3s5OZPHUCVR+PIWApByUiCQFPqyKivOo9HysBoazToI0E4y8n9DFcLrokPBC0QihPXBcYJCRafjxlCSKlCyEce+smq9/8VtrTEgWFLx8n/biRq5V3s6zYcy91Xkewqh7Ir++0SXY5qeVWTzyV++/vujBb2y+9y2QsQ6VZeRd6T1iJ8AwdyJ9F1WoLNVo1mbZn8rWm6H4wBQAuf5mWXfT8ktZgs69V53y6yTAtBA/2SaLhooukK359GiM5hA8mkCuhXaZajQVKvXy9XcE7TxYav9gZ0H1KvXPDd581O8peCN82RReje3I3h12AHFcppP0X4zQaynY1xAB/ZbM6H4zSw+olDKKkS7rj1Lq4dknQfVwPv8LH5RJdrTdjN+ys83eOx2gGEE2I9oiZf+KMrd9Wkmlog674Ep6K3YX/iaH07fwActWPjS2qEmg1TBBXltvTuPWY6uC580BJVcy8nOTHTBWqXK+yeu/jH6J1bB8PHmxC8wxXiyx/Y1ZDxtDX2sT3+9+8VmOVJ60Q+YcgS/TkWHEvNh33f2Ha5q0KmgYbgpGfMgsXhCUMFCiSKL+/pUmjLbJ+LK8g4L0cCeO2SyH5u9aYm6J+9PIl2l3vAB15m3p5Btlmy99Mww7YpZRW6xQeKmLJcFrdXbGn8GJHg/a1t+kETNELci6y4eLay1S7PRS9nsJXkuWGc16o7JZ3fOpz0tYzq2MXEdb439FLBgdk+ThnN8CEyWcyzbV0sv++Usi6PSpz3HhmViHh3Pmgaqn6VeD5KhOX9tqBgqr2lshwIpoP09vEvDE6m+0PScP39dwsbwLu4kzIrZk44lQO0Mx1J8vB56MrEO3GIuo/QJ8CFEgCUOidW3UMhZ5nMSO0rtYgQfPxy3BpYCkq+QdoWfivQ3ZXvCruGUkMiWSX+40ja98iHDPvnQBOvMVzNy82qFM1/hooG5ED/vf3g4SPO0517VWOxpBZIcU/C3SyOiG0QaFvhPhmLVVD3wmlVgn1dDCkK4eVtFMMLSHNUZ8fhEq5nYuqW+K7ZVGIRSGuyqkzCk4PmrkyoZIHu1YwkeANclgAcyf8Kyd1RlEISwIlX+6SRKrF0qCk+eRx36Q/JbMcz+rF1/66MlgTtsXa8w6P9IDbgjCXI+pCdS4gJ6cUBb4PDBmS6uQ2ZtkaYXsNBAVI7QLnQRi45jQGzdOhEjhUoufNOTZIV0+S8w1pFZKs3rIwa+KImO7esnImTcTWIod7vmPVNLzK1fKDN86hvnFjWJxB+ol19Mhzj1dXnMDYBEchjGnaGjTuBq8MIV9sJzyw0SGKdon0fyRRt2tcPsOMrkCjzFysrmZ3RZc9Wo7nCnDn/lffxiBZCGYKJx/DAs3qBH07ACsicn25CAOmNjjaHd3GbXtNeh/xW5i4je2cI/x9nGIrw2fWPr6m81GTFxUC4MLJHZKzhmhEgfoWdq9R77KuHTeegaR5MATaPQDxC2N7v+qANlbZihZVqM/v0XDIZ/74X91491ibPeOQpPI/F+WW1iDIrvvFLDt9alvoq1M2MDq79vT14YKjNATd11LnqMW9nV+trq6lVYvD2Bf1SAcGPZgLpVheqvfD6g0RN/sFYTOsO/kdWhUsa3gInD1vayZzany0AcYK57L561Kog0uQp5sXOnNg4BwNEKgQJqXn9ZlRrJBVo6JF01mGlwIdxmwZDyNBDbLEFlGiTRf7ISLCr0gC3ViRr7cK6zCr2Nd+dKGRYDLy1zrjK/txqdJpni7I4XnYRZxEnhxwUGO11pHHWjc4bG4lK3pNLcUBWvq8u3WJTPYbBKw023Dt8/bzsN2jBWFaIRkHE0k8djIoCwJOKjfpwpvbE6kXrOjitBLTqKgBRghcXxIaH9uaClTNTwwJmXdtmfWfJGFLXJPwOB2svdtQZCHytHR+7iJ7Aig8jslUeHYznOouJDEW7eghq3JNY2DxgEnkERxwNm4ow23LY8NAFst0GNTog/hVre/L+GP1PONn0NQRVBbPWYs7GcYU7CPv7U4MalmFLd/FuRpDhI6A2YY2zGlWZ1xkyD/BAl5NjYWEQD1xTiR/aSlvjyODTneEv4FolREUxJaTTFCYn0RucU+7hnVRrgCN4dwZECHHqCf4KGU2t2wdgtRSopvIncRm5TQ30ldZvCNcmmCsuivWhP0P8WSKaddn/7M74OKP7Xt5dq0Xebh0HVOPMyYa9Xp7Q6kptra9dRv830qwPVg2HLsVWHhH0YFxBR6QCez63oxsISU8x6Ms3xickfMBlDanmARbh0zso/yj8E7Cjd295ZIw+6IOI+vzm1i8iHxu+lzo60K0jHDaUjYLKPdM6BLSIrJ0DHYEC2qo/GXk2SgvWujUT9OFL2fmMTDlLDg9J6Pa5d3SU+2F7kHl/rMivdNPTdx4aXPSoFjmWhKzbE4Mb+CFA3LhOh2PPRqR8Qk+GAW5XMGOBbGKf9YJ31T7tKD4dsxFNgN1uug0Xowc5u9bMkG3noD2HHaUZRwr1EHKUNUr4vI3JO1rI5hEIEyRit2LkOswLIWCvvF4ITBeHdP1B/Ic3xeElIMm4h5GHPfGiR/EqjDhXkpAn8ML1Wws/YAHDKfPb+fRx14xQQVbGmNRf8BR82sXJkbze4Pkt3DnpEI++7UBwjiBxSu95LnVqsw4cHHez3lpACUtvRKLu+LlGZ5Gg3yPQdCtI0PXzuF4+B/kuzfXvfIu6qraC1WH2ZL9Q+bkJROh+ySDXwmcvLlhud3k/Rtj2z+y4fSkeXyuF9qRE0qaK6uw/URcq9cRJ+MNgjZ78emwN52AWNeb2Ar+kq29EQ4DKjarIgpbxwMMGkyljvBvj99sS2A4R3Vr40HilJmIheFp7Bq9oZSpRhwbqX1lNKO147YbV/O7vjnvOvBDd/wdiar4ev2w/6x2gR5P3bEo8UJ4qH2TxEHjJ7kBCRtJcwFbWTJ24GtwdXqpUNM/NE7ry3huRpXFtzEjOsN/3X7lIlDSn+Wi6PQNYyk5F28qOmGFVb7Y+IoeeBBaIOqw9bLH4JbKWNxYWOwiOFxt506Tn9Am2mEuCGe40mZrTf1EztgcGT86+CJHFUr4XyoEIdnOkRHcW1TYaDHF9WqKrgnBgj85nYr+NfaSCtSHKVPGIxFHIrZe0RpxU1OjGPs0vo+JC+VX5MYdM1BkFpqbwlXRo4LM7QwXXSSojU06HT1NeIXP/U2y1gC5qggKPDEE9Jn6cFrT8s7Rh/oh8kTaxrPSwvAUQeK/Nrueoq/eUHQlJzXj/eMOgxbiI2Xhq/BYkjTKla6OCdgx/cuRUEIRbzc7OVhZDQx+eY8w2gfs0SPwi9mDUy5uyVTe+zwJ7FoFrvHlxS7Uy9I9zMSiTayDBBqVSd7y8Fexc1cb/583oSVxZgeUu5RimvJXfrn1E6ZKYGHk7tQ7b7Q9vPPH4ZQxUbNkHQYMUhoADTJunrqSWVSRCGXcPdL6qD//wKi7wqn+nVTpUny8eP18i3YxlgBj3483ge29xiITDlvm4VmI6Myy1kfboNEIkDJc3tGnZG8AruWocbS0tmpKsgBL6Hm4iHSbMBxdPKGieAcaUE9hUobK7GO+WflkNBnMlERQEN866CoyWfyaNCSPZk+gimkh+gFKkkWgtrzjE2O/wzsMuFYEq322jpAXeP7An5tiIZjzvX/ycWGYM1QAwxuxcTB9zOgs6aNX2mt4YbLdFdcuBwXPm7ucSV0MxNmsTZo3wDzGOYj7qovg0cyuWKfb6bkjcVwbO3E1YkqLwDLjavqbP61NJ0Yyh/V3hf2dRhNHs7nMX//LULK/f/j5DjKsg6IuQ1lK7Ymlzx/2DKHO84798E2EXnL3OolBkZUcDApI/myEZe2Bql74laz0g8xHwj0H1iQztXYhuXmtqfm1kJZlc+PHspiusqB9WQT7Pv81uHADE2O34xOyx41nQMckUWuFKAi6NYKOedD5xgNlr4QFogTTh/7Re6cND8Bd8weEBe1VrQKhL1zoDyQOx1Qk5GMmji5M4CQPM1x4xGFQHhjO3LMuPFF3rePlQfY4uzCRY5WKaJP8rPzWUKHuGhLATsFzUL9Dn2p41erZe018PT+0KMr5PvwBEPtvNLXm+M0kvKj5Z59HTOxF836qcHM8tLdPxYqjZMSu8EkzZ7yzPtKV3HIADhvO4taf0VvYiCTOOK6Gc/dwie7s/wgys386+4AaQ/NMM97liWmsFaacvRQeQw3BlVxxDC/2Ec/n86R2ClZobf9D/q/xWfEHd6yn38ziaa7NuTkw+/mAptfuRG8K4aOWgiI6NSQ0ZCKlBhYFIixjoXxw5CXt+iM69QePtEB5jn4CJX7YOUgvMsW+xveRSX2eGR4W2WdCB5UoCtIJMmPL/Oy5f5t0kYqpripgCJLmp5LKDJkwyQXScDqwfYcj0x79ayRMgUwHZQ7IxA1ARf8szGZJ4UmyxDnGS7dpP8YE/Nj93ca+VeJLg1TBn6LvYVVISN+QQD7iGiTmh83peYlASPOdJFHtzhA57SEs3lVZDTaJBVbDUCUkO3iylqP4cYhxq9isf2xVTgqC3LgoxZO75DIdfAIAzfNRbHc2UvUik5VjJusEqaO6BSzBc++9WfcDT+dHPXIU1ac85T/bzd/sGMS4lCwJxDRRM2R+QB9wnWAKzaolE1l7ZZa3AYCWoXQ1zjgV5pI9tSarh/KGgALPToP0a1onT02MGJSMUEcxokd4rEIG43pkHzXXeBANjtXKrO1gpvGNKM5TmwD6OSq/Nnj/8MXMq0F3Y+lfOG7Ea/UryQ9Htqf2KJaXT7TF6Nr/Qmm6YedkQ4rF+Vv0CWsyACKniZO3t1kgt7h1Odi1HfIWSE8KaEF+lMVGZFNt3820N1hVUIrd+9CowrKla0HWvFOBUPJshRqQh0zo4uKAKAA351JbtnCl4WEw9EHqOolrsZ14NyUEhLeUHjqX17/Qjs25IjjvgLsGOBruexr7OSQd8ey8TruSxHz3PS+A71NavtfDhXck7NpAyhwezLITqT/tCwpyXIKqjT96rgyW3+9i7Ipt2Y8FTG6Q+zNJXw4W0AZGENQyku7Hq1wa27VyJZxNB/8W+6EXW/wy4IdzQas+bUBntEEmrnlrM062JDYR8XEaIIXRGY45EatvUbSEB3rKeYHy6BC6zenIusQkNn4dCg3jBJsE98TG1U6sbiXn/k6zJkFjA92SNQIhj6/iLRsRZNqIEVU6zsfdKGV2uEDMHPP18XyyqwOWoO/ebbEhlpU3LyIQKCrM6fxJUMYMXx0goQOVZQS07+In17fNrTNpzWmhn9UemtBnZq7sBD/6SwV8zTFVLQYoOjejr2DMWa4SlntJP++1vxmPhSzOfYiEhK/xuZNmfgTm2spjFUiIrCaiaIdq9LdU+4dofKc8TP931trCBOfZXZXzI1fXcdU35Hqlsr95DP9k6dBFfXw04udGCFHjcOoOurHS+KNiJWikhKW3cH0L/YSRjQ9TZKpxbTSc2107nDwshAh+FBxYuF4SoYXqpncNOvViYHra93N4JJkTqjsp1MOSXBbVDkmQ89kZTkG2mjbPNUAi52mOkoA6dyRSpvjRP/ao4OAsuCgPjgIk8eVYJ9q1sIevNKZvQxmmZvbzUAGs47123MmwnK5U+zlX6iOrRCNkEIqt1jR5A5uA3GF/gSY+j56tdt5cK2IAeyAjwCj48/r++62KmSKnAfF/bzJVDxaJ2zRIvuI2Z+TUDCVWFisUVPzXnQhvEGfKUjdZBR+kK+RPqjGZ2ygGnVe1Z1IUHrl8Q2TgyT/jK8O2AQURjqi0esS0I/eckepv8FrsmuV6rHDSNUDpoMCExI59UXLUGIdx8AQBqSPMqI4gAH+RcOfFbrEa5HrmadQlYcvuKj6xC3Y5LyfyJ9kzYjs+Z0hZl6T3/QiaN7t4vvoQaM2eeiSycFg5NFwCosY7OiFKlrXz6RD7L5l7bvcXStZnoln8TohdHow177n1G0UeWymdwTzfmlUlAByUJdn9z0yHp0dlqDD4FOR+13RiL0F+EvUSSbm96a62EONdLqrUpyJPogAxUUeDrVuelrqMgQiEY/EoY9rAZ/3zoPvTYwg0u+VOMrs+2lw8S3D6OR0J9T1ikk7ApQ91zTxHN8R6InJLbM5RQCMoES0c//7IGkYGDrwr21ln2VoG4loFCaBIdM99xPpCezZIWt5O3OEjaN2tklKqfgRqG69xEBdAXjzk+a2h9QODUHbZ5ADdu9AsAfJSmDX8T61601mnPcli+iKhKon8bCueQPBA//aq0o9NMRwJUadoO1DroryllE0fupLWEUJAcYcUWkUkOjRUkqhIEV7nnY15wE/mOXpi1FIlte/e6ImxThTR4CqJTRKqb9by52ysruJr8Gy08/Gdnh6amuhjIT1NhrGDZGqOtxV3ZL3r+PM9gj12fuWrZXu0hLFQvrpWpmzT3cBZnzJScCxgvb85bzasFGkOuj385ikkDRJeq94r+ayu2kt9SE9bdowpGsyhlospZe7ikljYDNVbAqeyZ8qHGNm5yhqucQEdS5qx6oDKWSasR/L19rnO7c/JzU1SOCOIpxx++103YVv4Q5xYHgFFGEIzX9akHyFkFIFR0WhgrAruS/R4nGzLjXp5CuktVWw4Sm3WvcZx/LMgRkHsj5TQ5CzgD39BqleNCbbwdFIwbJH1fp91RI8Cl7/SU4NeKHRCKwGdbg2shgwaEmIl/R2UnvS68U2Je+bprqyJHfcDIFlU0Lq3QZJ3AhpZWkw3v8NMXTFYdj6F887pcH2f7M5KOhIGviZg4wH0jQLV48G0Q9M50rgYnGbr0sqHSZEtVKXPLQBidh+eFvdGouXq5RJLaIf1IRJEtwqUjjr1HCNZmSE7sUPoDT+BXIdBWr9yTU84Psxlfe4rIrBmB9Joj8pHAgr82CQvXJwpYvJSGHLmjnsrgwGlhlswkGyAgkvZ27Q8P6YyYYQBF485PFixLTaY7R0HVYeJsYAeQNxE0WAV8LekLpkPn2StmJ8cxdAR1roqKzDC0l2LP9eeRHPGgWnsZZtg81eiq0/Y6peMNNwMbS6LjUpCldl72sNczRYUnByQX+hNdBKXo8l3tlJ1pmo4K5YJCn3PEfL9H96K5WTbBGgChRD9Mf1cRUFOrk0LF9v/izFvQV7P9Dm7WHgS0UjRTrNrfI8XSe2in+el7lWLpmrVntEuExvhT0ejSe1O85vOfMhUnGEC74bETGH52nc2ySWhwDfNeuE4r4lvIYdSL+wLb3mo5Rtn8n2zwrzoBQ0wt2L86Ukbp1Pff2WZRslugwwTj6o9MS3z4llfziB13yAuRJVVfgvh11cC2/xiSmGdFDNI3ODKnyGaWrTJhj7iF32sejwNUholteHF3N1NCsFjbmIKQviZO4uGKMiCWbInKsGsShOEbyE/DYmU5RrSEvRVRS+8lpOnLxSlR684Bun7/1nutEF0LvvXbCPyjD3EReXLDoR6bgseIF4sOtE9lYxlNjvP7K2+AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAALZFng7jZi7I0jVAwiO8vcVxy8uWfXlCTzzynrPea4DvBnyHbQbz4G3h2t8W5WYds8Szrm1I41sv8wvwthpxukXl2Ojw2YI8iOTTb3MB9BWTtokFdr55whElPvN1Az61H03B6beGHj4Eq7FqV9j+7vDlCdxG2fD1SXnVvZZaYqLZH5DqdzrDpuLd5g0wKWaBZz0SumvyRIqLQ5zyeTGYiOmJvjZ8FdqhDpWBU9Jx65aoITdR/uWVIuZW/fyX7xE7eanKJ/d9vIw9xWsPcyG64N2rZr5PqKMBFzemdkgPFV5k9LszVnj7FNTRl9IkbALQBIddQYIbyvCuHz8zPFYbMpcTpOVN7f/grW5wK60zS1K63gNEeZHm8sYIXvtvNWIiLMnWfU5YFugTssrC+B1gmlLU+cV3rckEwIyxZoyoIAFF0C4bPNbBBcfudGVIFs7nTvd5PZsRmIdTk+qz3cG/bEKaC3Tzyo4iWBJzInDQ0kUmvKWHm5MbaqwTNjKPh7xUzGGZHr8jPKrdHUB8BdhFXb0S7Tpj+sklJUN+TcD0O0a7AcXjCPP95JKVLzhxZvut8RyocpylLs2O9I9ZAcwgqEho+3GABobX8l66EFYRz+dZH0eOhH9RzuBtS8Yp1u4kfM0S0jRi3ZY2c3NUJ1AbDAeajVgLBMc8na4fgi0aAYZd6ieupLeH04dJA5zbDRYmEYualE9Fcn2Pflvj3dIa3TvHD/Hm5CoX3lcjzrIyXC9ieLIS1o5OG+cbXikdtozn3XE397I98mDrmHVQqxk0URYbK1LCIxQl35NFq9vi2h9UX+eK2AQ1gHTlzayxfyYAp+vwgdVGz9u4SvjUNs3D7bxZRlE1a3/9VLqQEPi24rbam0zpdBxMIm5uivi7YR/3VzYRNgzl3ydQBCA40BXEBvmWG7x7f7/+OyimbsqJTQMahFaoUryhdX/qFzmBOdFDSD3nZBTI848coe9H8Y9sFcLhudpG1IQs3v00nsNxB55/TMkPCYVvtnH/HvwyixdBNBaybjkV9zKQ6EOveXAJHsDMxT8kcOfrwGdiWKNjYWC1Bg9WWmMC74u2NkNSRjZU1OE2Oqxwuj74FGJfv1ih3A5PFRV8mDUc5lwC7M8s+q5N02ruorDS5Rg9Yd6Tjht5PsCU42YHnbk5/J2p25zUoM7A2v9LNUpHqrT8wf3DupiJAktSlcRVn5Mb6hQ8hCzqhcke10n2R3kfOWTSiTezLbhgn++PW/8wnHtuKCHMXlvoYqtoESBbgalZRU+qqmT3YNaKgMgoC7hAeTI0qhGPBiMdbx/GfnPFpd7aD1tJaUPo24up//S1htAMSx+Rd7Dii1sOe494RSlaKUyEJmsTMSBGXMKpJxvo1LbKEyjtXpnl3bqD/5F3TVPYUfy4edBHeHvJC9h2wj8MHkGT4ntld/5ftZpBejGGLuZWQQ1xddh/FvRngh4/xcmZhnecn2Ak4nCOwYJaqGQ3o6btXKebBGcrY++5JdSBvpcn4q7lGfwsWwtTZCvU0OrmCIPimt0B1kTFwI+NaSYglUCugOaCUNRG7BYtVSTthvn6Ntvnx4YQIF2q84qEmX3V9OqRifIVmb0vaJ6RF6jHHnNQiMoL0qaZVBSsELQoZtLfG+IWJT+bL4U93ui1xc3GbkPehxrxs8dnhvejkG/vfPgmAmDaq0fiolNYjdO4nKiUl5ux5HkDpvRxtyzMhOt6WPgd8JRn057aH1qUMoilgbw0LzC+OkB6BeFaAovgzeaU7+GNb5vWjGlK9gYr7M10a7KYsvEsUIv/yC2XH2PrH9TFKXCf6Dpzx9m6WAyCUOAqXMAXqCbwDwmRrCBkch0nxNIEFR2vCpJCKw8rXjyjh7jdIBRtl+CYtO/MjeTCVvjdU0XNYklV0eekpjx1k8fj8CTqScy7W/BjgShAVEb95VafQMXhayBOeIABkA7eZ6JiJ1MgQ1aZp9LlmNAvMEkYAkap/r4YBNefzmkyRwXrJ1H67OffYUpiogzUS8EBcseYHuwQv6LeU/AHeVGbyGVvDLw5VZtMjd7cH4T4Ah8sGPBRik3xQy/AXJUBqxd8oJg8DOUAf6CPRG629AxCOUNb+Ou6mqcroNwzVnzB6/e+9bL7CFHSv/b5O6PIjnqRAitDOHVqxlbuErxzKNrnlQZgZwEXmNvdW0d6le0pMext3rHYhBWwd205MeLMqIRIGOEQ4OPfabFj2fgtsaV817ciXtkqsHej61F8FXXBs24Hi+QENrPDe2hWV2Uvjdho/2ReQejEnM2OhBuUh4kZRXdK2mUMTBIsxBbRpwNnVJwupR2HOMKbSgxSNaM2qHc1wipJgG+fNjf9MKyPQ74Fr0zcE/SOI0ssNgm7FHR5frtLkNe1JjbOIBNwR1bK4IcumCu78ebElv1FpwMk56U23osS3PacJxhmcbm7+5EEv/GD16U9PvY5y4h9JKgcPO2YATQ82nT8MrFte34x0cAB3wfnY14OcYiMmQJxYp6vlgfYq7L4hMrc9+Ynx2V7vxngoVDWsR88X8ietVWihMYw6MqHETc5wCbvREPPog6W5lEF5pM8DeIUFU8H7IxHxooHv6iiW+eTuEffzK5gKO1+SKv7fwwSnZpdwy0zpyy6qsg8RM4IHG7qXcjxakCcZ3pAvkAvjvs3Ujc8rO0FPG9wK4KL2iIspBK2/SpBMRcUUyeZ16anWnTjDk4WVAZZJJ68pCaNrnfsoFLaRFBoIEVo2o4JigK0nd/ax4+XWfcjQU86LZt8HizIUjC2Gq+WmgvcysHiKGDGqZ6AKGi+ZI3IAUNpzuqQv2HJ8avogsqtCyp0EpefychJwsClI/s8bXKAsAzfUAjd3QVtCBTXKwAqvMmn5zN999qs1VUbpdiyu64qpHm5e/Vc+9FsZzzLGLgd8ORhuuKOL1WgSG2DuVz1AngZOLrwNrcAOJ1kzhx4jLGD2Zc83sAeIiOdNUW6l21ilRJt9GK0c2xYSCLd68MVP1CtZYw/qgYLToS+0btV4xnh4Zgb4V94rmHlxZhnlTFxk9WBu91FHW/FN32lzsk+LyI90rEzqZqVQ8Maf/zh/K+xe/meCWgIDY3/UqZReg9mblijzyg27aneV23lUr0D9OSJDIqksDIYV56LRhsFTBQWQ+oMO9S3NSDpNXIpoQlzu61wwhCOI+U1wu6EZXTmrWZ8dAqc/lEw365eSgfAAFmxRG4VU5D4cPjJi+CvYUKeZnjhND/B6n4a/xHruHeBpNFEeUAECyPP6snK8L8zx7Y1BFJ6+sWl9eQJTYNfrbEhrFz3ZmOa+aIExzm/4Hj6y1I61kXCnehCGxpkPxt3l9C+NaWJS8GzhTkraz335zvhu2wZcAjWDXmk6U/rkcyoWiVbANAhweOuSC/crVeBnm3aaI7lxHtXuQpNPT9AmMGIzsmxPZ8bE8/q8/IXrFYOlzeCbbJdB8peHwf1etfWF2aL9XfbeY4pB01PhKy9DK/H3GfYqfjeTNKrrvaFlfBEFTyIU6TnJSi4cB2JvlAur9qLgioqhjlG0GcvVqY6DNw7NwqMXgE/2CokdPlffCU9NvYs40pKZk0PNrIBX1Tlz9KvFCSXrAnUf9NWJW+LZ+GC+QeAKZ7n563HPOp1GdIYgWIWFGusKReIHKZi6mLJo9va9E1OMsoPJQXLiba+MvWsAsmT+vA2gyEU91zMRpge6MQjWBf3IQi2pFDlFRU6AIisIHzd8opOMI8njkCqy4LlAcfRWrogXiY+UuquQJuNLNtHgUmSTH/qq0/DXe8VpgGyDsQ1B0ah1Vm+2u7w9fOp0CRZAQ8TfG9HMocybQrX8TpIO8YeiAvzdu7LOGfWkUaSDJT+DnyoHZewtum+PCqq7AfbL+ahjXEa4DSzJ5SD2yBBd7eGrpgniCC07rSarlDXITVgn254HR4Dilqc2s5otjIO0nFVzAsh8wZTh24m6wJCOMy/sHWCxXJuGMkx2CF73lFI/65dZ22C9sxZhfHEgrF7DvkB7E5YeBX3jyK9coxMQLg9ph8W9E9O/jTKt+KCNbQqoYyhxPnBxgHozTmvan3ZPPMUWMNdXLQt5hV30eGaseo2t3i8FYYknFs+4oTEZGSkTGs/dbOsj863AnRGxN5CuQ9v0pgQJm2024BPZMY35h/YC/26N6WRvYLgWWHqlckeZ9NVMrrYwycBffxwZsDE8P1QqKCZwLKYCNHEiMh7nov7uQ/0j4W7CLJZYtHc5quSZyYjbOAKOI42TJS+ZqEvhyUifVAIadqizGdJsuY37dT9Hhzhs7XWIZ5zFHV+nPo56haQLo/ov6JNxQyPmNB/SRxJP5Lp5S5QFdeDO6wZefuB3xzW0uUeGAO5kaGbMmj1UZrco6XmIoCoNWRzP4hOs+q/fg6ih918nNeG+AagNaDcYSGpbjygly2UXHO/sOXK50iYopswWX77DNXDLQtne52Rsc7JkCUEWkKjNBb9a5ztwkIjV7hRdElZKl1pH1Oo4B7a/uUIaN6hMZS4BkQZmD7O8iJ/dYp1UU0/EsWMBDZdALQdA0MLJTA6gijTM6A7VzI8uOfFAx00SteAKUGTNituNxAHnzrlW7GhL/4dsb3CYHToLjt9kZOgiSDZc7IqrqYZiXqEnNkaI/CrkRn4a9uWMSzyY943doosBGzpPS0NTTdRm2mP5ogbwzTZy0EimCfRSbRbnscInKag5iQzCnLNLCqSMt3OuMlv/YaGMZsdphlPey1m3BTDxtuxYwClTytlPOHh9RZF6CXGKhgOKmLf2JNaUN3H6m8u+SFmL5CynsdZShJAK6VEu41CUw4wnUcMj6GYzsPgaZMWMSKuVFuMp1YvM4OfnSNbgfmxhkTaeVsD4EAOOF6hrbXHA4j69UXXG2I3rIUrGZcvKvX67gEEwcyzqC6xYd1TxpVU0Q7NLSwjpNv16FIrz2gSlOsGx4ch/5fZvq9YjFZI499HsOJLFZ6uWaVuWtoA1Bmo/H1bR2Z4OxrmLP0fVj9U65koteU3bSmrD9xlJVdbywiYeD8yTEjuAPv3kLASGKIw9Y3hl2lS2/nnkL21x3HD5FBy6HWdgxNI/Wujvb5aSntXXT+ADGcvvjEtkPG4W3Dzqp5Q8AFDsFeFS808NtwM4sGRHw0zlIO2PBMwpFwru0XQ9N9ys/+xNsr3fsCa1DVNTVTwdXJmeC2xgWdRj25h7NLzhGnmiy9TrCJKzR9AqNreiUkzu+y96v6HyOwEjMlAoHkPMTl2HhuzB8l5TAJ4TAZt5Hc7dyyf8h+ZKJ32G5rF0JcijIg+S/Ug7YwKlOxSnL5sb20O2QqeTd+jCrcXDy5owF3jqOtMwllZ86bT8EYlz+eJBL6VVqoO4zREzIRJ685IjeNMTEFUtQardm9C++N/aZl2+E24n08va+2YMlwaC24ybdTkI60HUEPFmIiUxuRNYQJf9kFOqdnR4i3UbIWSlQdd7RzAHtGO9imfaKq07E+iZEftkTTX9vCkd2TA4FB/mZm4TSs1PMF5YgCX1Frx5m0gLpVK7eDpMw6TqI/+2U9BRlzOW/GM8F00PwUweF9ThieciyeViNyhekSvsx6XBaSGgBypUkcScw7nSjtELXUdSVVlSLvi4/JMwCVMjPdmJ0xynwL+8zeM2qOiS3csfzOUNE+TN/GB9OEp2IQMDQ+Y1/5r+Z+wIQdICnj4NyG7ul5rDEbfbZQZRpD0Fc6Hfq90HZR5Un9atz2QH1d22L5lp899Ufbp0AskFTButHwqhJV9qVoKpPMNk2TBYxZzT4ueilDE18TxSw1KioR3JjMw/mqvLP+5ikeg6B5gJtbB2wDJ4sNa+Mk0OC7jzSj5/+Fp5Mi9sNhCgZPAgWampi2dCQX+nQC+wG90DGJEgTYQzADFePDWO4ZqjhzVYeLbwAnEoMFzJtJOG0rXnaCJcuZhslBmczXTyQvicIbfl7wkJssE3q5VsqLHhEjY7fTS/FzFyG1uxbAjabdiQzJsq90EH+V+ZDi9qFTc+mtAAbIC+HA9P+zndk40YY2xpBUnlASxS7epyW39TK53O9xGsABtGRmBw9do5WtVb6egKE13qW2qD61kXbSXPY+nkSVz1tdCcuULDTPVK9UQrgrxMlRtacxvXXeXL9G8CP8PUxsKcCIJ4k8/N6jGFY8vI8uVk/2pUEoaQ6nl7xjNclM7vxFcRxiKSNs4e4MB4b/i6zmPLIPC3dGcE6Aev9JBv5pisSDwNgXoQ7eQbl+e9R6EfhoPlvKAjjKpDbKoPzn+94GFBKiZo5k8yB6TUVFlR3L9uidET4LAwWR5M2d/qquX/jsWmmBrjyIufupfEZIHFNdPOWPdJHU50gCinnV6jn7eRxTqnDamKXt27cyiJrMaj2npc4cpGMSdHTtwFpgp4OUjNGB+ost2p+vwL+irUpaG3p/17oWNjerKloD1Sh82eohynPW4l4SSACmDae390f/rrmQyE8zVdYhoLBmHhWZ6ylwi72ZQLLqmLwHu9s1VRQJO0J+YVSX9x5kWVqtxuckebb8HVZTL5Q4xqYeeccOJun00ofameQ8cMCbTs3JLumvD7OiE6Pxoks8zm7rc64/wvOcALMA2Ivc5GvW1XUGaxgTY7V2QG5S1jRmWK1Tg1spNr5i/4SJKETHfqIu/yiZeoeXkyItNDrRIUojYVMZ6JvLAO34RhheikqJFUC+dbgCj7RK3WG/q8qZH4tSSarViVLVOynAEUUOj7VAs98OmWZhy61rDknPNoOxAF243fGQBamxtUS32ge+PTY5tJuHD7oyFMIxBvkJXQzyOnQHTlEHDGZhQ7fy9ou7XFcQDDw95hEbHar6i85Ohriet0XAoWwCW25MHPZhdTug17oepFy83mZKeFGrHSSGbDDGhYOVtfUv+cZeqKfgpU6Dp7muNFYuUSiFUjQ1S7zgmNcNABOexLpIu0Xb7S4dbxCtqr38MITD8B5dhJ6wwH4SirlutsJx7e2YJWYiiwVNsoADbpP6mCiH7pweGn+qx/KRbLMG9rs0yn57heC9iM4OKkM7meaqUE/aZkNwrKR4UEXRTYdxAELQmy4p/iu/cooIDEgMEi6Eq9ZupC1+DxkLsH5X8yDluq2paQ4tIGOT4gtl/oCXdebnSPRcsRxwPPhfkXs8n9Q9rauzQN+nMo0UYnY3jlRINpwG2XqbGqlmOSCYK2/LaYEK+uuGKGmVkuvQJNwfbRALmRmAnjaXBIpz+rfZmzRd1rV8W9dPjeNTp5I2XuuiePbMPFz9ouq+96dnTBZpjUKr1vw7aXhp8jgTjYk9oC9nylFvEwjyB4VyTnEQ6XKt8X5VTfX1Yz86eTuQ59OCJGYUHWo9FdkHp9T4+qwGufr5pajpphfAiUijgJ7LgdiiD+9YFHTY0Q+LGe/YshXU5WE030iO4ovI/L2tiUl0x/wjOut8FHWddRqgyFbokOcSIrdGyd63VtjA5GB/0wwe2troixeutdcUxAkKBQoF7Od4lkJSbqqo44s86SBjZ9x0RgnNjoL3u1yE3ax6+7uXt7gZ425xrwcLPhhITVMd8g/yS0uyV5jiyHy1AKZQpyj6g8HOIiIyzVA0UUAsZZ2w/IyBkBzF2uZ66SghbId1RA2vNehbagK8wjygEbQyUSxBY218nzxmVofA+rJF2cL/EBuEVmA21qyeOBc90tiVfQBMlvmGdLwQlvKe8/TUapGSrJwZty3wWyi9NgJ5i2u6EbCQYOf4mCJ9Qk7/ideRCnD+hnY7VZdCLgC1jEGO9tg8kOevNHOs99FAK4GuZDRkujbEm++MFgLjTlERUl13Vr9Y/UBnETgrTpCV6qnIKuDSLrEterxppGuGG6J6Ro42QGeCZMjidyFzA1vbab6ngpe1p1m77t0j+kp+sf9xPL6dIY6uUaktWIaC56vESwdXXfcq7Rkhl/TLY6TIesEt/bhKG+U+ezU1XaK17Yf7j6gNx8KAokPHDkYk+8qF1AQVS5mDEkz65qa5YXjsOUCjfPbenpwrKVg5vroiWsKpiKMXI8V2RkkitXqyJP6M7uFADsNdDFrR7wCt9Fm8VqfMVqnEF6mAIpNOAktamWTsQr33zcH+o/za/RTKIeF7KPnXr04qBGka36f6ZpOpJL7yQMoG5JGgx/lmTYK9TveTOi0PtWZZoPOgeo/HcVw4+4ISgY+v1UIeUqe9SdFwu5O0R6Mhcruni2Kn93caR5H1v9Q9cZVUKsUoVluAvmdS/B1bCbSB8UHvKoPd9w11fFBguCtMwdmVmm1WMc8rUB6AZ5Cl2ajJC4sDOi4d1yXhaGz/nWmxLnQl/Rvx6AAhUzRehQeLAQfbsLY4uEojsBzL+YeHkZsurZgnqUEL32TE71YEJsypxT84nWQkG5gXDE5CnuW+3vxhzMmkoS0caew0xdIt7HSBBaBgidQeJDDiO5rtZh8RPdVW/aaZnruN77mNIsltaF+GNlyjfL1GLQPsXmHEXoABh8zQkxzlBHElxQsPc+c+tOG62yQOe+Vss9nYNPNsIUlUK9Hl30+4+9lFdHvvS4wA+7XvWaI4kQwmKt++7sdCJmagawkINyJh4pPFA99FrBn4vDTxOh4Ke0vSLOuyv1khmODAFlwpSS67xmtX3W9HXgY9FMws1ZW57LtiXUdOfWGEIG5/Fr5H3eLaHfEbysX5wZUbJkHo/DZDB0mU0kSzLamYIvwOmp2bCT/rekieEviwkguZQfO0KQh67FGnZEYHB6oc3LYF4NP8Ur+dS1a0ZIPKhag0PeqbOy8l4QSap2Yo7ZoY468Db+IPQUJjQEhj1p2s1uqGu5qKZVehzEDFI5YqXliqdHTX1EM0MNyq7YbQZgLfPGB4hGfugpdVZV4jJFxjFa+piVuPntKzKn/U4/5AVnQ7Gay7ptabx1lt9uDWVB6l0PON8lVNuLCgarAYbkfid8lPN9nVHYNW2KmHFGUQeAcmqYOYApFR+KlG3aBZldyEDI0LwEQc/gOr569btA4+bdB7nJd/PGJX60v/vCI02yN2oWt8fEOhAO9u3CCWpw4ObuFSwnSy0wXuPTN7dknYym3uOhwrjH3EUjb4NSt+7mTrL2XcHhz/uCEiaE4Z+eNDCRv+1TKKQvFexmJGd4p4BWvYDtOESeqDzexKMXwM80qTKXVYPXZVt6XCA8oBNB3NVN+VugliODJ5cyFiAiLUNn2H8v6bNVspw/CFRdnVojDBVjj9eF6EX2q9fbm67Rkx1xCgS4yyCVFvuexyq1tyYp87ROcmCZ3dO7bXxP8g+7KDfwmWL++81NbJteF/X11qmDWY1XN0qPWOMYvlpXf1vKb/X/BV0YyftMR5aa0U27YrTZ2B52TXRPP+crwOpc6MGmnXeKT57IppPB/kFiIo09A8/tQ1na97rGS6DIIrLbJgZgAWCrDAH9SLQdOlf8hTqGGMMW6XbVI4aiXMdywV5QkLwqOm8uejhB8zU1kW12rAJVpxwDQAYByEtwdoxdEf1hBGwJ1IkMcHQOyUWbG5YwNv2pTHyQLsjeibBdTuXuH9djGnSOEFnwlnYt9NoNsXXqBUl9Rg27Oz3TW92fOtyVPE+WM+nDYcBwUMuAzNe/gLuNgDYUzLsZXexFUIQEzdSPmtpWmiH8y0t32Qwi6h9GmrEI/WgjRQNJRdq8bAsyGMGQ2mMy3hEeMcM7EY0J+rSOYbG/Ldim46wsZeHBQgP87idkAeSRDaJBTGLyqRjIUMpalgL5t8CUndKZOrbeIvrPhV5UwvIzierkOge1dl/4szlyKuqwuHFjLU8swwGY2X+W1E1KasbMzhZdvNKiBXvNqeZTPgbqCEH3kCQ5Oj58kjbDG8gXirSExilxZwDHop+w4HMWTe1Pt7QWCClNDhYLV2Gnuw6KvB0INpbgTdidPaCXPSuiyJet0IL80WkMnfnvYS+sFxFuOcwNuhtCCbHKsO2n7X2ESU22BaSr8dsoGFAkksFCspVb13XNiIsp9t+dSmNFn0kO+nmpVdPV0E2EzXtFerAc8Yx/sbzGn9idhanE9plpC5D++GfL0kus1qnrwVZlb1oh54/p8WH9rmU75jbb2S+FlNW2jumt5zCFo0YvxSeIAUXutOPBccErOcFkG4Z7d/POzNj6wgK74uAYIi0HRCiKjvulvn5QWWy0kJ5adyFVqhjpmjjTPrPhbc0D1Ih5nvm9uoRSaoT+xyrRMoZzIkBRVIp/UmX7u5VJQ1NiKINo2WfnqBd9fjr3aJlQ9s/9ZnKAveUPLnr7FVdacthmz2y81Q+k30ONobhz6GOocCKiVSw5Bn5Yj2auiTYauY518GqZHZuA5DlsSpl/mFZd+ggDbIYt6DfUjsm8tHdrEmXK7bjMGkgN34UQ+e/qQ5FdH7fTOyjXPAF65fOejctsLNf1LCY+vmDzzkl+fsvkypQWkTVcxkS/tQyyuBBNIbSFq9TAasnjEvmygiIwfVYKBNTInwa9t2kBE8RY340sjH1yqBqo/CZcsZ9/MkXZRhskkdz9h8tD6de2h2KMDuodZPZanfnF5w2t+V8bdPBXDtZph7ODbu5aYABsxCs3dyr1OKSJmyLz4jq/qq3S3/trAb0zrr92TXeyRIeOWIe4e67+CMgRMg/tsrQ7Ym4APD5Qnx5mTsNIMW1F9NJPCwWnl4tgdMqYeRg/NwEyzENliXbxGW43RFwUFsAlVNJuFrYGvAekVMykaCsozbunLH7GVs7/UF9dA6yOeYOho2x+CO/SLe6iAdxKjcypuovOMg/lNiE/87xqlFWd8gjl+aW1JkRv9TV7yeZmdegY7MV5Ki24OqiNz3bT6k2eUuJCZJgTgV0GZXBKGynhjLULZwdnwf95YPpsYy3PGUnacyVwQgKjU0LWVbqk+huSfxyfd9MUaemP38CRppn2xBxdMqdS1NYk8W2weoaDXLkxuVU5Vl+UWTqKkB7AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAIwA+psY6/Mx65YVN6RaQmbHA08vDU4dBxb7bq4g6uKe76RyZ/6lIaGp3DQ6NzbJdML62vqB42xU59KkxmcCQUskz8AXbaK0b6i7W/lja+Hv/X4pfykSL7PoTJqwwYraX+v/j7sHnGGmmGhxTV3tqSftlZyhpPgU8mj6YTmXilhrNjYqp+kH3fh00HoITCqNIFCmgObuVMnqw/lWA+v9kT1I8nhnbLiv1TQWJENw2iqC2DC7ENay+q/ET5rqxS4kPQBKfVjUubyC6i3tcqEpLsYW3dZ/x/BX7fEDGJWUZ52iuYrFt2cCy3XmsdgUfscbO3HDtJSWP6KKSHf0hHef/iZEh5dt8yoeAvKVrZYt2SAN/LHjure8bJZ8q6v5rRMolie9WGDRFa/h78mpB3RWGjMGtA1aYnaqekjAGxKvaFJIjzzPMfi3S5tiSl0bfLEJbiAv5ecjN3eqhZhk03dXMsBn8c0+LTnlMYnvRtmXzlPYqQ5od1SFiCXnO95eZblBW0pNOsKLy4N4i2qcqoM1AyR0APgroGJz5uCBVva/omcMcKu5HAGEWk8X53Ed3vArnUkwWUy/Kb7tgIuf/dbBdk3ufWnEy9ABx/x2xeLAZs5JlviAih4HsqnM806tyHxLZezIYm7BpBOCGhkqvwMPLuLDPomZuulC5SPo9E7RNqlaX3stGQrpGALDrm/Yn/j+GJAxCMY5NFxTDZav4kyySqcHcDYAxP3yszCj+Ao85nxHzo3rcJPIHQxteAdDSmk9fVDXdbX3LRhrJmoU5SVMtapJ/YYzgcNrCYQmMtf/AEEwNAHuOw9OpVfFFyQ0YBAGPyl12UXu9qTDRWuMZN9uQG1ROXRRM52Q0CrsJObZSIbYe8RkoV/kCQpBkbxfL3+4vfzXFD5HZDvzS4j+/a6BXEf6/DLT4/mNWoCUrC2DkIwPh5kQJvGyEZYh6jRpsWmw68T+sotFCV2n8qH4OTSInz/d6rlMpakdliEy8stxfyed6TL2G/KGA3tJ4t+bw7t5K8+DFtYr+9+4qkKREr8BSIfqyGTTa7vz7eK3SRXuNspzCf61jetdnXPjhEUIkNzKaByWFZjYjXEPW0khlC7CVTRuIRTtKXukT8OSZgO8A6h8rz6fLIvYTmSvzZhGvZyPCgVZGgzCbwbifabVD4DF2R0JC+x10PlZXr15FI3RaiybhhDemkxLtJ3lOdKGaM+mFlou+8dfNHZhPIk31rdS+X3TGZegK2MLvJN82ZslM/meV6tNn1RVQ+CAV9zUHxsa8ueibOad1IKfPhDOwKnpjtMUPQhPMIuSwJl/3fxgyz5BKnWOMA+nmEtHGwBqGq+7GNCmssBCDoPiDoqUIc8s/VFEEwxcIgIVpV4G8litHKQh7Kr0MYzG/FcNZJedPkaTUARMjfzlIJox18NxgLC6p2uZCws1n7F437XAqR9qVjU5NzEWLmao0tgP9N8+lZUBA/WgP5qGvzflAGBXRs9L6EYeYofK71CiUI20G05s0gU53HZjMIHBp1SkDNmimYM9BxXRUD4mB2UgKOFtfoqQroPJOnTWWMR/xUjLar3br429B/aIQOeMU4G8lrURtsHdyF8S5MW/xDR2lVycC7JY4P7+0tBtpZrvrD9UiRaJoJx2q0T10F441EmE9Snnd5VM/69rjey/0KfydLEsj8b7veaT6LbCg4kEyKwKpl1aEpoWERMDfUvDvxT47zOD/JDD7WCkiSgDMkv7LD/hVUcXvfhjVFmVT5vTRFIvsgSkxrQ/0/ZoE6XWHLUiCoKVUNxMGQSqd84V3Zf/R8ESWne88OnTArea6KkZxqh15e3quMLszl9iO3ZW/b/Mt8kJz9KBfqgOaJ1WSgjYk9iRm6n1Xx+Hb/vEbK0anv8OsC+m+Zyb2IKoDSgcuX70VaK/hRT1UDArB1n9+Skdu/NYWacTn9kf3hXnYRap4tXtj2WYf2qgN9evlRffVxhCIcED/7N9mkksp0hXQjvsTdhQGG+k4H41vHqSgakzyPRzs1LGGcVWxCPqiXmKZaTJNth2rgIBZVLXZCAmc2CGxd0E1Nu7KA4sR3PmpJA66J+2qRdAJiPjKYhcG2EwfqXEWm1RHWtZOrMX4WnM5HbW0TrVCC53vXbEwqjo8eJXqPzQsRswY1xeeC/tz/mbC5WCjhNpPuCvc8X3VEuIhfmUthOz/lWk95cO/1uMGqohDoOlYnr04CKB9QUMsPKtvTmi8YtDXIgcnGc1fMkrk5kP85DiC9pUxBMn/oFa997UBpLF4gY8JZt7S6rZdrmnCE6ScgjG0XI/u2lfKyG42FP6N13/+ihNqVStDkS/wpHIFWB6rM+/2hiTUqlIyB+VUhToFZncVPlTTQRPHmmGWRMHVzB1boDCjdn9ClnLXHSVgy2UrQApBdWZffVwrzX+l0qgNtO4yy+BhbPYiYPyrUlWlilIvSQHVZXA1QIUZ1DI9INYFla55RzmvS9lHP9uYUsdZTXPw6LF0tpTB7AcvT9IZVYjwlVQORbI6k9CQw1iaJwoBMoGOkqz+JZXnMroXSlyIYsaO9iEtuOMrJLPuCETHE42SkBPD5e4bVswNcGwlp6VPac2SxiLabfNQKnZ53vyv9wIJBCTqcaFUSPiL0Vkpgzg1+Et+MT1yvq6A2v40A04v7Z3IIn1IDw15NbjL6nZfluRe0roYRnoO4oCKm2Be/+ZBGQIORWbOyqcVS8F88ycI5wNgiOcQrTb7MtmuotyEczOCGJ46lomwV6sztrPNJMMB/EyN/q24A57dnIac9ZOE9Xb+nZ/fttWePOyLgubWXzRgS0DPLXEwQsbDggTN97vb8CJ0uHuFNotDLtI5nS329RCniLr8CU9uvTvZrhwlaxddeE7ASW8S1bRC2HacG1pPHc7eLdQR4uEWsWhfILDiE9tDVVRvBzwfwEzK30v94bvn/LNGxJ8AyovARRoppQ+EDnZhGozm6uedfCiqxe0JAXtnLwlMmTEokE4sLl7lW13eo9WR+56KrfUAJqobEYssIOrjFJf/PwvqinBDM9rTp2ziyO2/N8hwrlYUgvsCPT06DkSji02kjdSivGUGMlWXlBwX9KVOKz3f91GdFiE0DxW2nJG92eM6SlvJwSHAdim0vZBnJhChHaUh1xZsP5aBvqWVL8enZVaAvaEeFIn6GZJGZClYsgC7RsQa7VC0C0pk1QgZwQKC5n6iPhMwO6V3AGmbldvVqfAAvnGvMheZVce2i+EvkK8Z7pmlxew1Iz8qIKq+SVgCsVbH04D1Ccc0NbvDKkvEUrH0qNMFykOX46fPbK9ETTyC3U3dNJfet1zPEgRy3xpvkudgr2dmOgkc5gATuiLeqgsaqGIX5E/BHnc8/hGk4nsUy1PLfqUx7VLKYTFOOy5Ceblxm9li0PuhegP7GEaMM4DiGCIdMJV356i1Os/xKV8HwII16IMUoaP5UvqjByFUTtQORmjPnVuQFOZ5yUtXj9TEEe/4z9Dusa4eGVVJzWffo0+Pc3qNfYD2PsVwL1jwIw65NL+jLZDSq4ucW1fKb34cEX/Irl8KmjYDDmAw9r4on/VsVcU9z3zuFa6Ni6RMr0eO5y27O+xjybysFgBPkGIsqkLMw/YwBtWQWxVPT5xVkpwlor0yh5/TJp43GGZVtNg+88UMa85WEpszGVPEtLRa7dCFEQ0pa2pfFB+UTcdhO4rHNE1NmbIRxYB5Y6UDkJugFfOQVyCq7XOMEYgLP/bXqY+DfBzb7hZi3ynSIJoz5nDoMjEKECUyMu18Is8M5AbXJb9vhkiCp6j5m0cprpJ2j04Lgv71U5WUKiBjPTLzirsdYnJnruDVz+deRdhcK+0Cj55ioi5j/tsV4zRq1a1t/QULrLYSlkbAJ+XkeKpfcnokqcxoDU5srLXeqJO+CKt6jjgS/iVTyzjnwshbc3PMshwnJXdjXQc2WOjTeGEFzE+wxVvq5OQjq0wdc1u+Kygnu/DCLgRJndbg3F05/6S7o4GQ9NZ4a/qZlUhzDQn59m1kVD8e27bkey/wdm7vFtHMHFKcPn9K/ruQtvqJJhBpSHGoYheP9P7IVIAEOXLrY5yQi3GIpwBFdh72wTtzUCli+L+16mfgvP7aCdMoHajA5bkTb11Z6ZWNuffTWvBQfFHIOoCL59b+9fp8QR+gYBUTWTpI+rP3D57q8u1KJG/WssAGajRC5SH+kfEIvg6AO9TnpFEvZ961QdXFlGnmsVOmFYbSOETKLC1eHnmPm/Z1j/+cmD30YH6bfEAGHOG4sWUMEVcv7pve32SCExXmz8rIrpcCu4ehcSf6ZD997Q/50tN4YglZz9wIhdlGdkB7YlYrl5j58nUNtyOOABAQvWcIwNwM3TbZqa6ho2qB4TUnMJXD32s+zPVFUbVGV8YY/F8ZvueF8f9jeEInamZHPQRbyWl4E/xgxPwGPLtMrHiuUciJrIpCZQyTyh3TVmoQTM1/e5KvRlTE8hBZuCrfYM/1CDjuWKEog+E/kwYyUrMRitmIrrBL8xusdv0ilAtCLs8wdpDjFeA4hIMUBLBqXKKqra8XZCLbiqD29sF/RnUeighh3MO4mFUv5rcRF4G7az/lXYMW24yImak04M/JJC5ZjGEfIMneTj1AIprfxq1k08eLkC7Xm2Jk8T7klE+1xSEgJIOGtnRpJzQPGzkh9c9cw/CH9YSB7B/CZqM9Y68Vn2B3/Qu30i+SDKz+4ObzaayEUOjEchNVCpBT9MkClVQsmaLw4q6no+YIbHNbPRvphjf51QM7W40v2uaO1rLar2AaeKAE+LJGDpjhs5YCXX4z+Ul1e1t63SE6E750dQMTd4gpa2fsyrd9RFtkOxhVg7jWMQpEJUt7Ci/nK5QOiu2JQ/2buYV2AQcrk51EWD+rvfzO90f6q2rI2tYkawtV3D2CHD2krZZ0GeIxF19SopUkdsHsp+zwnp8VcOCkyKFOhde79Mw6vXwrMPGK4oIKBadur2/W3LVb+/MXcWPPnK0N7mCbSGCCtG64U68ki9DT8+tBSCsopy/8rsHvITzbsklx11rkcNa4RpZorcnJKGLUkEiwrkAQVAI7dGbmzeO17XkZXOerjZLSje7FqcZYa/Cpcp409Ia+3KrmarX2eUj7VcL/CxBu2eEmjWtTSYcISNECRFFc56gHv4EtZOe0uDhzJgWNoTNa39fTIBzpK4BY/ki8/M5a7OvY0zdxeleKkZzbZu+7nR0Nkv54dJyTwxDUizP+gWofQeL6fn29wR/ZRabZ12TJpis0g2/a25VjDuX/PFKd52FJ5TqmU2c2AqqKiSXIefu4WbTDBwukReIQq+Fp+8hmw7giXpRZ9c4Kj3mNLhQBIOiHS+ctnD3hpPC5seXhGuERlVC6Ok4kCTOtj8fEt9RVtfoBUKPnlCcSUyYIIT9TNe9KpZRhkhohyNyZZX5KHq69nGq8Y1xEM7Gdwv+/v3it154aCRngSlSSknn0Ny9wZqn6SnoWEJ8I4z6tDHwPIv9hu9KJNdkqzo24KRQWAYztD/kJATw8suqXyq4+HNBWRpGqxNu7ozVPoq2QoG1ytMj8D/QbXqhZuvfkYW0jQ6w5hvQvGG4ftnC8DrnG39hoViAYg3H4RhJEVTaPiajQMAwjQImGi1dDv3uEwq4pyoSm/4WbA1H+t6iD/QLFqRgdmX6kouFH7CWn9sSTkB7bidIc59pmh5dW/nHboWVmKElV4LQu5xnDcrnquCq+1zqVw5ho+WTPblNdNzw51NLV4crvJsy5huh8SQhd9CCGJl/EqeIWgZWs67n8rZ49mPn4pKKrI5i9Zibt3DW1tbrPhO8RmpVBTW/lmqsbsbWVEg0a1XvV8W5ITMmmnuRacYU6cYDorVl78RrEPmefKddeD4oFaKbi9WqcvyQY8L4vQ/sl9ow3NKls2rueJRGOvcEJ5UZmMl5Em/2okDSxZjS0OXw9oyDshcMGIw/tIgsXcuiJFSZ2pa9O/zAHmM0c64myoHVRbWUpI/d0mOgBxuguh8Eu0hFW5RQHk02e+EGPP0zhXYU38WStBR46fbVGER5YKKUetDpeDXho9KWkFiW/+YfGpO+3RLO6GCYUfqkUdQZJgspVp///QbKwFokBoW9c2dbDl6vV3I9si7SgoMt+0zosK+XiXvtEmvdL9JqV+g7sRFGdpT5XmmLANr+nL4Y42Te4zFjWsKqhX1HWAetXCgTTMm9+PkDoSVrJFxPdCDMUgWD2CDVS+aX5BOWxj1j+W+96buiNqsWSTwVYVk9+B1pyQb+YHYh6Ja8xKmH4IXd+fNWfaffLzgiWSpJjQiP1jyUSlz8/NJaVvVrqBNFSzHBsCJYEaHmJGnrqA2DC8WU56eKi99/g0jKT5jlDPGVbC9rXMdnlhdBzzgq/ywfRzD3sFUbOkP5XG4xckxqKsMKAn59/oAzOvDGaIaIaTTS2exJaAxWr8SXa1DYhvczky243HDtsw4XmdGbPJdjcnv2mN539YpOE2p4vOGtTC+KRhuHM1VfTYI4sgEcaENRvn/NYGLjXtFWcCiqf0xHT7k5J1xjxnZ6n0YoW1gkUjh2cITN6doP6za4ZYEq5L0HiT5QWu9vFL/JDBtC516lCRYVqqIQF4Tq3KyEhvTVngSZaS9Z3QDOEBXtqh4jFHmJxnock6NTUPz6iYaUlTciXeWh45iRmOmzpu/hM2KjkCwQpy+hWQCj7YLT8hqX1ZBiusOPhndLntB4eDHUrswrpGaR/F9vIA6UjwW6zZhEXeyDxYX7y1z0sddcFY6cQQ7/Q2bGftSghoZMqJ/7WiozgvRQpmAROUEcIyinHD+i0aKhgA+eK8pL1eZpNUfHLnx7FxGlBAPR54JcfaHOK47F1mHKvFYRccY4JtFhsV5/+FFrVIz03C4w6YU10QAgoeO5jHwnMhuX7NEN6Wvy5Kb4eODzfhVWnzy5cXo4f7mJKG1l0XFLgbMB0/W5NpXRx3sYDE65Uz6PLZLtRqa0ilb+Iqyv6A/KoU18OR/iJgZhxBanl9VTyJLfXVEJjSICBRGrDEkGn4jsniStpBmsKOXQOCwSRMsoNvPVOpYneZ0cPQK8Ti/hp9Xm3qbgzvtAdKKAHDge82tyo7K68OSuZbI051YRVRF//mk0GT1w9g42QEaX/31YglC0ETE3Sqqm/xaPtlNuA0ZAfaMgr4dqyJdgBIl01sovEpzVFYDY938KHdcEufyU8pO4dlKxSYQINEPZLQ9tZ3xcMKvtJ+EOcR1mPp4C7YABm56I4aRLHiBuq0n+l68wvl5HJeEBHzEqUiLp6AgZs/JjV5wgGtHvzoOx3YTVpSnkjsHSbFRY8PWuH0qd7m2/Om5Cbvec36+2YkZMenbuLQ9hPLFIC7b6FrqgcivqQfgTLBOZzd3vN6Q0zaXQC1tJVZMLbKprfmIIfdQMsbCkqEnEzMMxz3fdX1yf1YVT5Om4+WfZyKoJ4WJIrmXMSQ3N/i3PhYT75fPmIHjQ1r+MJlwGEHkD7aQxzPEdKwB2zVHUN5WbtSbO4p3D0Fw826uUk991ZkW1mcVyCAph6y00b0KM/h7MmDGg80seRmFOCCAGDlcB6GIhI7kZoNVIA5beeDaEMVbTWeAdZPqcj9iyxHw0koLdRZr+idlPNZBtogxtAgh6XcC3D1i7cDqBGrrGhx00kNRBq3m7iCdZyIoHgAjTkKWPg0iebTBaajCm+YjNrrq2/xFj7DlJD4Ys2+5Ng9wVDDKeOb8Nzu+F7ikVA44ArpqixgMZ0lFoHYMiiP1bqukeuxNbOLYGI+8U66MbsuiIbQ8ZvbrYvh1J325Zq+rQeF4lxVwrpjcPA4xR4tNiT/UI2VOGF9JQHnq9LvQE6MJqw7Ne76VGtQTYLZ0d013E16Y9qRO8C5G0nVitpJUNKLdaGzEHOr3v09mhOrbF7HUaijEJsOitmB/NYaR3D/SnsYr7p4LWNz8MVHNPG0uFTmO/KjtPI/V6PmwuroYlxpHGJc+rVd10aKhIzeJfm66dtprQJ+vq/op704l8MOXJw42bnCU8aZtw7q0kp9Xao/IVMiyqqIEpArcGj9KcGLH2sl7PETHE5eEHOlsR8SFM7xBAdKEvNbfNV2vFXWWcCRcMVrsUjwGYGX4gF3cIM178yv9fAWAK6AAwywpxZS6W5PGglYSlYTAKM76wPti+MOqfcxPR0gBK8OtYifAZ7rBYLtUNPUr1LLycea/DMrsNOIqRGdHYocU/0fTfbGhZIUYN3YiptfflQfcdE/qK+cWsE5kdfHejQo6aJvls7BXdEQVDKYqq+vIOncIkAlZJPvGfVjDba/Y3bko1a9A0mPG+foyKrjyaxQ40sHPhdcuArzXr0/wf1v+A1jiUSJtK0Svcx8rwAGQU87yWsjua8vCxkok7zEeJF/VKSeF042e2gEYJgBh1WGdcn7eCG7vCUVyDlnMhLNP8CXHw/RItZoi21rW5A6+F6Ob9BgtuHdl6lFY1NgWr7wAvvJTRNBWtHqJ28jl/oXMINOvuejHTGkwcNH05TNln7iXDpiBVdIVtBPcsBLz6JX+3cKuDhSbArcEdJUEQJz37blQReKAqHw9Ektlfp4KbWeeeaxKmp8w9p0eCPC/uTdOXS8AdtwVg6QF2YF1h2wWJ/ZF4ply1mWlwAXOvyoEj9mOsX//QuY9eemT1aJVi9biLoLuFKyKBSceKPddGhlgiWvUmayCDq6LtUY/D5EziDszSEHJjf+PPUwUpFPXCE6uK1Iu1KGaRlHEVkdLzfYH3nFZR5S/huqSEgsNnNAmcp44Lze3Cj2ihokYyW5Uc+RVymrt2Ptm+4dthU/kfUwfIijychbFrXCRM7DjTokvCeI3jTNPOzwuN/D4JCT5mY96I265GSJ2cXCv9+vaFk26rAcI8EAqO4CCjQM2pZ8fzv+fYLmP8WVDcTCQ78LkrGj/fTmitLF590G2HM8IY8DO/Fsw3WADB2IcYW+ZfC0JUrZ35BoHA2zQozKoRGU3znoN6q0Vk4folTm+XvBpi76tVykp/yMH3MD/VVbCn6/mny+GYga9ogKQnaFMixgKSgK/ECAojKKp/P8qJizNsZr35VvZnIvDgsgeZl9lOlV8psX9D87ZBGYSLJg34zFegOUkvkQNXIZFQcSVyHwHla9+oaWi1nrJJqrBCj5yPykev6D/1wxptIOvn7PBvI/zr+dXDMTt4q590U9Tcev8wtlPd4pSNC0Me5BgpceIWUbi3UA9PuVMxT+pOz0YXJn97ooaLeMCEU0K4W0vu4Tw6c9u6YezuGsnfTAJRHSnjwIf1RcCk6Ssxyx18y/yfcj2BGBgEb7dRKrv660bTUtjamzq+gZ84YfnJljxYO8gg+ajA1EvXk3TBAQlr4dOxtyb0bOMvWodbStKafg21oBgU4SZX7bXj5aEd9p40pPQUjGKq9xoohQpKiANFWgK5D9p+XEaoI9UOPuMT3uqiWUawX1GG05Lp2VWdQM9DxCWEGWKSkZrJBlb8RUUdbUOuGfTSjqZMFcDL6my3VCwh/LNbHxzbJEgB5dqkzHUT1fPjoYbkwq17O+vjGWcr+r91jC+bqNskl4w2axB2z/Db9kbPp0ebtWsXHpVn/pNDgsK2ye6WEQTLVnbowlhAW0b61zzEvbMbdvmJN7R1JjN00F4U9g/mylCiPpM55En3cZjoQtSApy1fj15MBkNUfLl5YcQVlOYfDSF8BXUWzfeSKZF8dJyBWfyg8F99pu0zPutD0EGXnbSBm2UiRqA1uRw6XkE+0NVv+bOHEyzBweswolYnoX1nT91YSTgrPsP3z6+MNZoe1GC1yhCyjcGmk4CqytRwGkRYijxSakmjBK7ypHegiyEXTbLQ/IgPFD9Odher8fYqddfeWrDwQkV2gyLymNMrKKk9tCx8ogtlK3n/GumkWRcSzRiCkepSodvlkje4Q86bVgj43c8Jaz2jQ/LGpvuzXxiFr2PEcrFQxi7IGQludOXnrzqSdRSFjMIlK9nyadKap3fGmYnXBpFAPf2QJbSRugPd8gDauMmvfcdk5MZHozS4tgSAuPeVMZxtsMFEvexQogNqAlhpGDlPQ38JO4j3FiziqvnSaIzO/qOn+edleO1R4VmdWh86ZmIN0qsrErSvWh4EO/JD/4v5sMnV5sdsMfNQNL5AtaTVIvI5FxP8hQvrNvmMhOLFtx9PpmHuKWGXs13QTyCdnfb02bXJeKcSqpilOImB6R/wJMtpfzB+DBngyhPp/7N2qwB1ozvpCz2Uw2Ic2EeOQbNEVG96n+r5KvurR5OWumP+4sA+jk8AgO+pRqt77+U2VaxMCezfx0F0r6zyLJ5/QwzK3iL3V+hg7QTbYVlL1kda6Bt+1tL6WIDK0Qp3VvomgQuFQw1VKmaxtuH9ZOUdqdNvbtXjZVkyID3M4l/5ZYX3ntul2cjnLp2OZ8hgdbLaaThS6K8mHtJ3wtNml6qT6F6sJ7LLKyaNsuqEm10nU5yFLANedKO5lykSM4sJfSrNRkyAuYos4ZShlHwLQz6IedV9Nxnx72DX8pnJ0Ho/IGB2cHoT5SADTAi6qqntAzaNpbblE2DFe6nTfZFKrVSFIROS99lmIozr6Oh5oJDTWfDQFanMpzXmnTp13kuWnpT7GcZCGj8/RvNzNcZFDGefqYUKuDvJV4yEwAuVLKcVC844eh0nbBZ2jEM6AAutxHsQuk4TLPVIDmWug9Gmiuw00sAMdt015bgimT0otSJJbXzRXXZZUZhplhIn9CKwAlTUIQRG7dy+b5WWj4FKKQN8VquXqSUaYTmcD9F4CEruiTeZAGzke9R2lNr5HMoYYFLZa/aJb7KkNGXyZ4ir9ksAsaSd2m8mRVnYeCkla+0RaQDYLQw3nw5NElPGjm/PLUGufN2ri3nlvRkm01Es73vGNwNActd6hjGvOcrx5sn2S0UAHeRzAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAI9oudL2O18zkjnBrZgfFi7ojFZ4cj6jNRt7REyexMDaZiqfLboGOYbeHZDCtr4hXbvqLP6VUQv98jy/eVAf/4JNSa79eE6BWPyv6+d/2a9Z2JhYredifq7mhH34TPJwds0y/FmhDdE15yPyEDWcpvBuDy0affTYRmuQtmIDqngeODgdvi5QnyKLqTNj8kUfCP2EXLNR2VS+GOK47dI4Cfrkoy0KD7kX3LCUBaVSDrHMNoH8yzLY8k29cHUYMx/tUnVkU56F1W+FN9Zhnh9cWqeNKj3giJ0dTujby1cptiAmwdaFQTdJs8ZSMd9SSnIpJWhKrNlUt58zQXLI+trODPNJJick5Dcq5vaSG4KqRpmh8YaupUASJjA+pCZIl8KjEBM353O8p6v5Wiz6VpcUMDttFjYSp1/4zgxBtoFeJ97QamZKNWqlcF5oCKbtfESqK6WjYpGfXQuB+BZsGQNmPaREmhJZVP3piyn4bsGWvwzVCJkWEn5sBMnCgxP7M/wixOMGVowaJAyQ1eJTs+R34vhNzBpW/wbbjROEsHnOvS0xDqxv43iTQmCIjysQf30Ntv+8gEK+NzgmaStAg5JUbkQhCpF62d8neWdG/zAa2czIePYaXx/0CCtTZNrNV7SieGcOG1RQteV7ejm+gfjWc303ieYar/IL/H8nE/0MeyIxO54QDiQozvwnGw52I/vWM3Tr+NmqtB3ZxTDDnjYxNrD6+5gVLRa7cd8VM+uPR1smoq4oozrTH4GVPsFvbNu8inrqypPAbFVBd+mhlG2zgVbB6AJoUoHIXeqY61PdkBJO37KbGQwTkPBPrK/wRsHZUVm8G/m5CpBLP1B4ECICHjG7Cq1JcSrJqSt2yoD13t73F8oHaIEHvu6WCPBHL0hdP+ppnFPFg1R5js0gH3KX2jSJWlr6MWcL/+eTklA8ovl1VWjaxnn3SjLrtfrSGVR60Wb0QKvBwBe0cPcC1QXtgV56hfh0J4i6ZFgNb+AdBz8r6wX37uJYIVHZu/ZMAGAt8HkJCsjk7vzA1OjrGXr+ARPh5YtNsBEj3kru0zo2cY3J6qtyK5GQW48T2BbLzZqqVt02r7cLqQCLljMisRz658VgHp6ISAeUPLybrmAMdDbDLJvnQC7imTKr7BvcLkSgyFp6Iqpv3I7McUuM+p/6u7FCTgxaa0bmWVR5NBK6AOVX53yBren5e1UcA9u85Um6Zo3XHefNd1rSomlpTH9gx9E6zxR47vgTIcX8OzI+qBVDYxRw9xwphtNOxYHygtckSeTz+wF2r4XWX/mf+RmMNgkfSOhlA2gePmaG/VBTIx4RHmNjOtDvTxwWYabQ6gK3KGzH507JUdHJgiw4V2/rc7zeK1zp285RHPLYh4497YfMPWM9rqLUU0FQH7OkVcz2sPEFdvPTw+Dhu/cX6YsaN0RluMRaxmMYuzSCnrcREAZmcNWL3CVfEaMBuWOiuxwUz2/g0y6Qoei/Y7101z9u8lBowcB+EFiLJBAEqsIMy72WVbrNVcjkBCmW4Ch0WZOvQtB73f/UkaL+HqWfvXwSEhcpZZyl3kZliyaxRgsTwDxWsq1HCM08l90PnEDiod4E1WHZY/+Mwu6na+P2DCtAXOeaqaf/VN66oNMNwGkXFE8LHfXnmFsYikZW2CNxD2rrRVM29/xmJWWyajmyWNjHTPdXjdOHQDWoiGqxjConR5+rgkA+fF0wFq83ZexMOWzo4Q+NpFxDS4JXEO2rQbtqTVENCWYb4ny3Z0RWut2z6EBRmatszE7GfBsR6S6te0Y7GUnlXtIBDk9zYadUxd2qL6uQdaJ6KrAne6tFWjv55/JdPScs9dUX+hRfP9Y6hKEQuOZ205i2z7ufmtNVRiTEJIjq3DEx+9Ym9SY/qljEyvTZkw+TPZVBwj9DjLSGdQaAz9PJd7HJtKiXXGSzbJctXQGjiPudw3kadDYJT/Esh6kUhxlagNyDvk7PydS4KXJcvhEQHCYBPJjyZBdTrx7oQPgGAx3MmWzjrrFfbdtKmiE43YnCcJCo36gCKCaQZ+7TlTkD7l9nWP8kxRiks4Z0j082vdZct354rGCfKQn8eYfZDpIZTqFSQdZAkVk0vSNHSY0iKhhJJ6jasM/irhgr6Dur+EErmaF96n3AeUGfIlCR7h4oJwQWXD0J2PK81vZ9jSOeDhmSkDp8coPulIuBVvk/cez5wcIRPsjOkaasvTsb0IA7eDmrSKOEdb3M+anyGVdZw0Pbv46TI+H4glvmpdksw7GA/ynJfqEuwV+zi6+kBOzwbOUdHSQolKkmZFgvCy1JIWiTS0zlvm+OIiFh3iyA7MoeaBKrOdM7FTTlPa2sazo4ybs585mIhBmdB6yH+O3N0E/boJDI49GHBFhejrQfVvCWsYp0maFTpa6s+L4FhJbdI9qObBkhVij7wFZ+0P7yK4o7UvSQgwBENgtlzWnJQYn0GpPA1R/BPLQ3nf9Y6HCcnWPir/UbUM+P/WYOGQtocYGyjjeingmjzPsdwgg5TmkvWhn0I+hjsdDtsDoufJHOgww2fT1VBmY+wrmBGR2fabGkpHQy44b1JQI0qjB56UfPzyAyl04PwFtjjiE72JjsF5ScB2GzhQDDorHaJFQ41bPT9vcgQfFdbuTUzLtLMMu3aGdz4B7GQRCr2zYviFMagluhcpU7/uIjO82vL3TGNQJlu2Kbb54sV3fDxKkf3zyB5DSFdWgDPUY9JrW3ASjJE02dy3gS/ENhBcZ60FUhM1TcgAixrrWp3Ptinv3+4+VK3RUmENlyWTYAmdZiwWDI5OxvduRf9BgYC+Z8lqzxC/xyQNgPCVPPJr0CQiqWkHKmG+hAYPbGH5CuRpgnbYhZDpoXSmFbnu9Sh6hXTnoHWCZ89jpAgKFOluNw9PMh7AEvWpW5TSRLjVGXVgdcMB8oVI4sUfxJwOPZDRqWhS3vIQV3r0l/TH/vcWlJ8o50GO2m/V/BYtEo3hk83giuhKUXt+BSkOrRChteTc5FZPe26qzXX5xLPmrgD9QHdjh7nwv1tg7ElIiG6oTUvC2EGXIQbGxB3MAfhtRprEFet2iPUgL7nYvAnkgOiUx8/HR2HDvnfa+xHFhCKDY+MxzFlrp9WWO1Qacux8q9kkA0NBo5Pq+J7r2UYtnCGMcwLNOiiYrbzy+M+afY9qCArDcFDuduLQZRgVQsM88MIl2cHlu/alu5Oj92g0vhT0tyKHRy8OlCpEGe1vHiqF9+6ihY+1ye6hy5qPWzMUwwAKUNNQdE0O8Oi79oLk06tPfwK68p+4hE4Y/FUfsovSaVxelcaGjgzH5SavCRFX6d+2MEGKTSgCQR9XfBxdCPvEV6Rr1Cj00qspR16u9iKHZZPknwG3qrblP8vU4jf7Q9hR3HiH0RUN2teLX/srHymE+EuODxR5/iLu2uPy9fal2Eqd4dWTFo7HtSOA3gs2JcysA0IRpkLV0v2IuCE7UNGj+9NBnAtGMMSDUhMcuFay4idkYGwWJzSmTabhd1Tqp/AfMT405KC74SbpbRUNLJVi5LAtzJKmDJACY7BXpCXXG5qxFYtrLbX0gJc5Bykq78odNm9CJz7cXoGZd0k8Ww4J/g7/oA9g97KJhWWmn1x7K/GnlQs66iOI/ZeNkp8aHfmElTWLZGkfxPULUwkGrjnv572hJdpOdCt8t29Lb0/qq99N1ayMCNmYY0pkUrqsSGsx+ad+6Oyoob3B+MCd35FDLHTNY8QCYf3iAWpDciseSP02F0mfhIDDBQQ3i0fRCzKznaL0ltWfSxRihWVvBa15vvUhTgAdVdjiJCho2L05fLWqme2TDkN+TmIVFx2nrmYmSyzTttUnDtO3I3cg9w4kPOYS/AcS/bCKbJ7ITrUiub/qVvqlI3DMPYRbij1gy3cs44YlHz5D5gDU/ou0teif74cPIDgiV/5HUSgNdP38aZPIZCCcAA1Yf1djW8lla6mWpvH8Lccz3+UssGJlpPHaa18fkw2/o542WZcxxnKq2LUdR0kAwyQb7kT+kIMvUf60cN7KL1bgMhKplGU5nwTmvwW9ZsMfno6LHw9flccgRXCyQ51phTWDxO+xXeUq078A01iyOhNjKRJThr5F9OGyrMhHpi5er59tX0Um4UXn/s77JLkSl3fgZDuiIr+Behr3FV8UE8w3A3NLpvSfFfVZloVMBTq4aFGO6MhPglwg2qsCmGFtA8Q0T2bKs0BA9QCzaL9KSC2LaszDzZy5RtHqFeUQeuxeNjUCb2SF3SXKv8rvrIZlnqYFimZN6jV5GhjFze1Iz1WT3EQLnUijCsNXkUnwsq3McFqF2Db7NHtp50L8bDUwDR2OU08f/IIMbWsvIZnZS5lfUeSwl2aUvra8BpjigLccvyqxd1OqbSLayrNZ1szCxw8Oi0l0K1fPGNdg5wWc57A7LhNkErW/+aTFLJ8UxS3DvG4azTtH7Ob2yJ/So+KJm0h0FCEDMDemfdG6k5/F2us0YyrRB/vYPZ2hWjxP8ej2uj7lGT4nCeicND0FdGyXDagl0XgNSo7v1G4Ykm4OfSuoUfjsZ1IyE1OQAUd3usQwThCL5ITY7yeb07mHhIKE6p3MZgyi5ishyZwU1U0FJLKpeTzVdFvZGJupyUe8FpP7zrdfB09hc3axDVVzVRnoCZ1ssj+xqd65LMPoovtaaGWsY97OygLRRuG7zIC3H3l4HnpBN6xHqanQCdI5s3bND6PLn13kaMuloRD/zdafLvRYd2kfeS+h2ru+mhhib4TCt65btx/Nknb5PQ2IfUcyGBqOK8WVOSPmw92WDpt1Jbe5XlkGmXb+eRVheCdW4lFsazVZLrCkK6GTwLrpir48QtlhSPHYTtrGIaci5II5ZZCeo0wGISBQ50YOPemlKDrMYjbPRyD5rFxWsTnUSY0kndljfu5jG1R8noqhkMCZfCvyn91YwWfC3C9fysgo+46JQtkm44/fM4SWrC72/KSaeuPfdusV2NVwER5QJmS3mQ1W596liOTWcLoiAx5seXJIZBaeUdd/eS9e2++vjr/dL85XxUxnZVMgXGPuccKMOiWXrDXA59wUGXxeCK2updXEEnGbKTq48a8vmDdjEUFINZuw1L/00lGlpvvtdI0RROiPY+c6v/csrBHn3cz3kZ50TmJ4lBrhjRt5fgmOTlBjzb813zxlUMWBl/6lRjPRWHBc99wus7Tgn4PDAhg3wBijPmfholHo0SsUMEKOUFuq2pUrhcaDDS9MKv5TWZ2Tv06VtAlqP6eXHNhW8Gti9LF8nU+55iiXeMdI9gmW98lDbj3EiezY4tFqc5ycc+PWDlvJNoFXA5dbjvvVw6kIEUYFMfUMtuv9GqeAbqhOf3jo12srOmbV4DoL9gOaflnMu0NKp64XANzRWyCxdGSBfsEXFQUOD6GS/+nCmmcwWfShogCrTavRdWLUkjOLXfrUHUXk8K1fhFt9qWQiJ8BwydPCVhelbRC0bZsBoXENGT6EDgBd9mnnYZNsJ1IIkNuWu9wLxMSum8wt/um4Lam5Qfif/N6K8qygRGfON4Uh6jvKJDlhlCzoQYGHw0pwNoTa+5WoQs8Xzom3VqA1A0e0pY3EH8/CqvMqIC1TUEx1xRwO8uIGv70wAwOADctrBIWCn5jlD1G3+L4Yxq4LRTxPLQrKWosOYdLQ3ahQY/23bI2vO83K6OAxxz64shFAWAY1im7DCtN5GG34Djx/Dl0o+1SE9Zjtz/TTO6tjuS/kKD/ExCqKlaWDenT+/p5HR/dyRWjQt31Hwv3JPmGIxUvEIh59qGNig55er8e5UNIRp81n0wwscdqjYBgF4xHdYEbheol1KU124aU4rwdE3CLJRIubDnEsgHsLkuaQqi4GjM6H67vqr0vZacERS7pU9nDI2ZhYCUlpHYWAy7RwfqNL/kVkO6dqrTh9g/usYQnHJVuUtyD79810XmEsNA7hFR1+caprv7oNtL9/bBuzYohobyLCuojOnC5LdyybdtA6AXBZqnuZengzSDVmAnL8gWSWqp5xDxkL4WJDpODxVwJwotktypCM+Zo8vAb935twKPJj5ZOxDJgtUb+J7Jcfaj9GDlO/TLL2rzI4EhSy6YG7wdqkPu7rjxR3axUpg55yg1WUiVHl3PEPrHzy/WtYBM+AxecY0GuUyD5SUzyQ7zI082tyLtz8B0pe/zCWmaxfiUJJYQUcz8ZhndZOgQ+pxQS/f4zp7MRF1+ZCsxZg7u9DZlcj7+roMxUKOc5+LnpMO35u84GIiAhTYkkBtDrQwIQV2nZEBl/Gn+FIPD6FFqcCVcEVpn3e4wkJA0ovPRt8rbJqi8qsg2631XYYmZ74fuTsKR3IszNVSiwfZvc3lE1hHCAFG6ByNmY6zi2inB4HY85XGS0OGZ56bGnMZdu7zsyQ2feTNETrES+/O1YGiGqRDJgcTKjg/iSzJP3xqBF6sSuVfkXT2BM+SbXQ42T0EJ/Xp+uAZmDkoALG4y6jfINs04jjVw+3sVRZvwG6F6ZJbVa89l4Qdk56osCDNGLfoJjC1ODSKi6w/wVFVh5a40S+aZEg0SRdtr3qlQD1+ge+eYJWT/POQ1pH/EdOhppu+EUP2ioHeg4cynbd+SB54F1ozklnXBUjVztio3uPwHxnfazcc2uD6scyzSP6EIg2+tI2gYm6u1oHg+IyJ++k6rLG4A2i0cLqV/ynp/j3IVJQcJvTC9gzaU0/qhSVT2JRRF+D5C1Rcw0whVoOuDTTx66FZajQNiqwWQqtYozDQDwjPNguDe73sThSV6fCjMnxBcUMBZ6rET1OU2k2prckFD/3SmBfaKZtATw1ybIBg4pM3pZC4II7s0d5WWe3qpAEGKJepv9oP9ez1CuI2Q0SkhkKvbc96nhuwFL+NnSJJjna85KGtGkGO2iQMhBjnH87QAD9DY1n1fMrDXwP6+h6EuQaNU/koKw6RqYQu9LLP44N5jX/LznTcjd9k4UOMgkhz1TeqE0PzgjjzfLzcgddkbnoSatuqB5b9VBXUa3epYlq+rf07/L9OYYCf5FoNcZJJK+jjAaqdIcKXnL/Ke/Lzks+fdlRybKZZvK0fQB6x2Kbts3aPkEJEIYEKxXG8/bJLrw57eu5lASMQXT+ZpLK4hjm/wmmkiLgzOcdG8psgrT9Q+YfJnzFtpWDNE1JmhPaSty7CYBa7lZ/accamCSOieZPngWeAVveAaYt0YFY7pSo7nYq6han7JEu/MU4LrbSIKw96ohagOPaEsgUeyjhmD0iFB/w3eZexMa41VmrAGKdBKSIeHNOLMY7+SnxzmveNV9I9cRYG6a5/y/Dxw71tiBtFTFQppdICW9zfAvFppGk3JPWMqW2Nu1aNKWL+8mDFpGpHWtfCXW/tagrTBvAEH5uVXekSedb8W2S62ug+ctNSWen7gnPFgWu9oLPoxzzlaHOz3tonKA7hAc5KD6cMAOgEAZeo/O9F+BpJgwOmtQDJzofJFKbhmgDU/KEXV6PWGq5j8lRcZ4jrNSgHntCFHVEVrghvw9w1f8s7ttp3Zasi7tR42NTo21QqxwaaXQM73B1ISzhGZP8EgiAKGdFzd6pQAY3G036L0em2Dt4nG0S5dR4ZfyROVFV/cIawbxFAPQObaWXyhzlD9XKWiVFRu4LIpCDGVno4KZaVLvPlUsoEFjC2lS1QUK3uUkQiTHfG1478pSVFElv4LhSd0C1zZOU7cM7MwyR2ZbHiduFRFcosjeQrt5do1zno0P3RcQQ3jj0xTvCq7QbuuwTJysekSeCw3PbyUJo3CQWajhdu+NxDyX6ns7emV3sJobZAtrIMe4/LfEsEDw2wAaUgdZN09vgMJWFx+L5tcDrBlMg1EuMK6pVNUw4K/looWZoJnxEmJRkkG9psRT28GdCtgNR117IAoCb3cRWYaXyizGWds37WxndbSltGCjIgXmmMMylli6MIRfUJ0RhgqY7TYwwIRQUdkd1If7+hlD8NILymtk5SXwWxNu1QrRBRAuTC1UJjlm9XwCBMe7xn3qwSozTXC4Gv8AVA2q82DEbv6FXT/tJgPuJP4r4T1GfRab6NEJkmmk9D2ongZRuBLOFzQBCms0/b1VCrKA+fHiWV/Z2vxnbgZKd/kgFzVNLkaYonq7fftxvFY66PwDQ3Vvm+y96cuKU2/Ztgm0AZy4ZtDTnWIoF5N0fGWHDkp7mlEE3Jg7EabUmFkQQ2fgfNSJyp5n7SvviRZyupsUR+k6rdXiYANmnqukrwUgAuhn808vuaclbk5b0mC//XU59rAq+xxU+5aeWa7qZ9TU7xW4uA/NR/C2ridylBgmKf4PIp13TmxxB+D9eH+PfMtx8M0qdsd1zsipSCYSsZ5RQfIK1ADIbeeAZ1I74FlNY3p1zdyj5qbMYK09W4lkX0ewF/G+qD8hRcLXy3cNyQmQDypxFBknfiLb9MtDxch3MscfX4VXIPqdH7FldueQMOKvgkwG26yCJYtR6rKVPwupRegXjzrT8yghNxt9kfxiQ7oMex7+I1upfEaoOOdGVfjMBDk4hHfPYRGNw5CjDS5iaQ23dxwTmgXDSxAF4xWRoQesuJkLApIiYeo7bHyQVglHEZGygTcPmQ2ljQ4Nvl+cZRfT1Ejer067r4G9f7IhE2McKyr8nd6t9VvqfA/HNKQiopxQsdaeYFPsknsAh26AAKRIthxF18sFFYOLLTOIaUCOI8tFSbzjg1Lu262F4L5CRV7QV5iQ3vrvWpfGdpzftpkpVrP4gZlQXqeT+fFRrrt8rFskvFo+ZxC9q8D7e4pDsw5BMTv/+hXe3LcJeGKGPADXCoF6A3Ws4gwf7OxdqZq5FGMVkDbdUDdJMRXxBTBlNY9aF8VKetwZGyO6qkdGgKuwRGbbdY50mzzmS4K8UZtT+xe+DAJmM4x38tvlXPRTBZG5DccYqN2+kvQ49ppHkOWsusB46kAlkNlUNAA5jHXmuOHFpDVKfnoJUp5IBZpwybNglmcq/4sYkz6ycdIc06jB+7NXi3cCDjtJGVHW4bWw2Ki/Oet4jCrGbEO3b0RUNoKsbQKSFrmHr/+21ZLrccAePIPMdESSWk0UT6ZA4z1ucMBCEODBXIdERqOKklX6EIM8tXZfWV/FgK6Jq6H1UCLL6lEje84FXo5xih/CPUj+CcauItBdwDJxdcKu00fP1+uBLRTPgA9TsO4AKebJZtJzrBEwkIZJ2knIBVNRXVyciWftjxO9FmOHPPyBopN2J0YEkcIjTxCSRY+aDImYnS1deVEMNAHOyS98qq9GQCn9GIUJqse4Kp+MxI4HF/h8c8OdcGn93uiv6idLiHp1XMf2ocYYZRJoloxpDr1fEBS3wvUSO65vSU8RgQfMFogAISKxmHLuTWscX/ZVvqn+KX/xbYwM8RDfgGVMrkiXkIXo1mN5OfBR2qFgVtqpFjZPa4Cs9MHcjyhNoB0ZugEJoVjQBKYVoPdBx6XUyRiFHM4CKOW810OWhM4Atk/TQMSMvYKSO86V3bLpOg3cvilkpIpLGR+GLmy1k/reSVVXUXLJzOiNzEcVNyMTZPgQwvuyQ2jew+9WTRpAP//AACDWYUAioc4XtawT/iZeS61kvnubGuT+iTcf8Ypm5/a5kqKHyeeK4pbBXb7lWn2wIJYdoS1s4+iUONiKubihKUEot/WryLHzG+ltxXYrjDLP9fONkw31lL+LIb1DImD45BfOVPORpaZCvFF1MJwoZX+WAjvrtbZ9DmdFiK8mq8h+CIOTax4M+u+qwvsjtrHz8Sb/QuDU2IPATDp5ERS9qgu/9T8nRlw8jCqaGEUraV7QjfdEz3DtduOwfAMzQmqAjx0CmG27V4pNkWbu5yWG0yOQTMvMbUNxLYjc4e7+kvf+bnLYaVbGmT+JmnwQPHt4J0tonfW8ceQwRtlb61Qv5UQefGbdViBRaZdVBm0AiblNd9bS0TeQQG5PXEDHzEC1W2+69AyQXENaTe3ybOCkH27o7XBUWBhWefOH6HtnRHgiaAtEcTPV9VPoB3yJKpCUWMt9qUzWcsI3GdfwfE17mcbDrl+vnQtBs6l0bz1MO2bS9g0gqBnjzk4/XEpTgaBkuSWwBvWzrklkqfXpm5uixtkXbWYtSXKRh3EvbWD7ZrLvIvdmlneh4Spi+pIt6uxCltwVVocTOVVHN5Oe3kKxJoplEz7XBBv2jL8/v/ptaJ6yM/Y2ac9oV+mNA/gnRh6eoPLiFwLOfBv/8yK7jxu/0WzuN45Psc+NFH2I7kuXiykVWY0JaiyuTs2CUFlJbCKf+eG6Ws6PH2LREY3Jo9TVb1bVu48WMz3VzRof9vQJ+Y+xsVQRfExrt9xpArEXk6Lll8i7JDKXlvSv0tqqKcPFb4EQZNe0GyJc+TCHN9UXLkbg6SPcAJ4QKWgASJKS5zNRLyrYaNaEo/M5IEcZy0eMj++g+EkSygzJ8M++yIaV2cVIl1qv1ocqo2lQ8DYiyHlF+2fXyIANqzkjYlTWuzskqKfUBI3+DzmBEOA28Ip8L/B9T19z+qb1T1PdpSzPaEmlDJHafqk/D2OiuynHXRJS3SpADRa6WwpmKbl160G/I/FdQN4Gfp4F0G0NM0mezQOey8tdW+XrA8nuHkFa0QtE+Vml46R8Mwd1qweZOl2SjUyXdG3g8bnDPrGxwcibOHMaRs4oH6Tf1pfLZyBTdDT/59DPTLszWi7TACRwtWTTrz1abiGCsn3rUDFz3zb63krIG0EGuMZ8nEr/5goelSrx8pJLS/G3PLFd6rcSdNyYOo52U6qcsXWD+sBTi26gmXK1FTyYS1qvNSyI2utyUxIAVYdzh/jEZoZfvkZY7OyghY8XTrLl7KBttJGy/BpC0DaY5eP4vR4BW2cECwc0G17HnVXJExtnNrFh0YQ6U1HhuEG3hLAfwnmEPM+OUbmgJXgEGhpTCbBfvYJq5LXaads5spTYf4AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAowFpe9/NcEMTukjlHVZ1Q/KhggMe/WkV3cB7vMThYHBzcPkc+2fk9QgYCfol1A+bFzXb98ChGhMMDRoEHhd+oSfh5Zz/efBJ8+jSQZ4L/3S0OWUATDS12BFCAxbyS6WuMQsmpsgE4gnuG148/HnfBd9Ioaaa+mP3hKW/7og6RbNugxOjCBXrERVrEzCCIApNg1lqZ61yhWJnAScAE4AR/MFHgYvcJPIEwaEtsgHawwQmr5Fa6cUfmmrLafo/Fat9xxLnpfaGSu4WWI7DiS1+T1o5rd6E+/tPmWkXXJyu165JZEEHUWNjs2XtS4IxHdnlOA2OVEsM5jeE0UiqRhVilPlSqQmXhIUfK9HgOKTZ4bQ9NuxbRJFvf1OnSbjQDmun2Kk6Wwirzr+GE3ai4n+g9lVXFntMYqK5jbru5QF15MGlrH0dBM2jDIOgU6rQmHbNvrMrU9OLICH5VJ9cWVtvft8Ligq1QPVbNsw8kkfZ7huZ45Fg9YGKSEjbtzCKsZyElAFtXjHT/udQQDGhnZ6JOo8uOUOu+x9iaQBlooOqDpNnUDLuXEVNlkA1XTVO7m/X9IQ3O6pXsHo47tJoh63awgv8BQSksyNdBlwNQmuGdfyyyF1vWCddeRtD4f5EptsDGVVGemw080U/uOx/lKbJkjdCcZc0XU8FWKyNGkZLhULvItF01Z+yiRu5eIRqnwnCAjVoogJiuzKw9IYuAmaxe7xXhMl6skx1aPnMw640oQc+5ydvccNBtzKm4EN52sg+mIX1++GUjQ9VeIo43yBX7nLtXIEvW4RWV/LFQutDGLUTX9b2nvGY+ZbSxGd2jOuOfIKt7WdpBK8v5UJUFZkXXLX3785M64ktOcrMeAst+Sfyjcu84uzZriMTAVqS04KgaENUW1HD8jU8CVfG4Mvd03H7CW0prPjFui8Ku6W0tTIDNg8FRlwEY1rYFbjWjOdn0lNl5Yq7WzO5OkrkzoHBvH5ItfkMV1ABT/acQlEwZ1MjFyjrXIEVAXbdKJZWbL7dHN3D0rLnEHZAh1TY+9RiQ4ntQghmjMXEfmkkZEEs/6UeR2oMsvH4pj/bEmv5QrCKxByYQms2tSit5tic1SGVTgh1NGpWo4URYzT74byLrkKWJVIj+Cc84RUJ9zQEiZP3Bk5sqKLviwMQ6ZTm51+ih8n01PGxJgGOYBwsgXK53MIqzLNZ8lzpOfIfi4uUiDWwzAgZxSF6jirTX+Q5NKnyL9UYpOlYitLlGSE6N+3w7fEVS9e+xbpVC3t13LO3XEknkK2Fs4nWuTZGP50FEmeN4gjMMwsRMH//q3rGPj+wTtQOUHo2IKOCYa/9y9lCciK4Oa76vhWCiU2ZHU1Iy27xUE84MVHDIPNscFEelMsTjeLgstY2qjwaK6r0fsQV1egHQ5neNwOpiW5Q8Lr6fTevRGjVXZR2uT0vx/h9Y3d3wX0BKXwLSaxt3v5hfxLZXjPOa1D6WCMqGfj8eqIUDo3fZj7FXUlvqB6Lnezzf2bmhwXJ1zgKq2UWKqfDW44WOwhFDff0iLkCfHwk/6euV3EzKZL354v3NgkqZpqU/bHuUJeve1HjOE97suMlgllpixhL3fRDLW+/Q8Fsg+4XsVn4HeT2XjYibFTaPVhGjsNFIWgv6mUnVl8+97WNI3yt2EI++s2vruWHSpBUY67MC6Edmlzs4N+FkviFSsDENjuvgduTYPbvfowyaeyxJyRRzOGwSQLXio2RZTba+0p2SR06L5zCx2AGWi5h+IvKOPZdyJpWsACioHwDEue307jXzvrvOB2wxAv7LcayjMH5IWc7AVrcP4oO5wriid7qN379MLuyQs2ZQKpPJ+qpL88M5emsm9XDLZydwQ6W6OyZ6zHnekj9WMYTHfIFyixnMoVT7QRnvsyrZT41M8m0sPgFi5gQtto/w3fF/igqwmnVpvKreXL4pPbwwxZtGxCdIdR7czS5a6ZkI+jnwzjmYlo2bxGRVYfwKNZNLPxaiCLAFLwDJOg8LM2zuoXfvqxGSaKYdrODzlIpRluoN0tOv/22wu4VN7CpMhMW77jhCxTZ7bGomZQFlRwp1bdZnCqckILxW9OcrTukrugKMnLnnvJjWFpwnGkZx5R0gZF8Z5pig7p2GSL1hADcjnxL2cM7tqvE5Nv1l2OmN7c0xluFq1K2ZV0htS8kXw6oh/Nx2wbDWLzaJ+EmTjO+rR2wyShqqx3WWrFbMRX1tU0GBYZImF8YmXWnqje5PyFu0DVgxiWChP3o2pqgKREZf10C4qjuKXP6l1b1ctt4WMk6uxKBO3SvC9ecqCJwwelpEpr/52BHkkygPrw0cwZODvZkXAnXVtLA3wEv3uZ6mVzVbpj5Ln1BxJyUs6r4plw0m439KheTYLuJZjGg3QzfPebL+g6t52+RQqKUJdbGBaosdMg2Ko/CvCaRDBG0QD8hEW3f2NXeM04ssbTe/lD9+u2kdyHE709ofCrz4BVAXqcvpM5r4C4/3pWQFv6PEhGlRVAtcpSfdSAQ7kNL7Swgrr2T1w6IeokZUF0VFWZ6+nHPGdOteiOtmOK2zWvcrrkpPngWFjek77MGGy/Qsy1Ok7ii4HY4PfmqrjqypMiTKm+mrwRuD+HCw73mdxdbUEe4GlPaAGIs9yLHiX4s1A74yUB39EsdFUhCXj1+ElvmRnB7rSgYsO2n3AFRkFt1CCrc+rOCphqLMh75XYib7kCu7ggsmjvFOSByHsfI+INw6kBLLeYMbZwcdYlwEw3jYJZdG+o76s7KUFmmwYScCa+5oIr+zDxM93hg/XjFKQp2Be8vfSc2zQLDtpcUNTderGh3zd/rfTSbsuRxXgMxmB7HvjqG8lajkIfwA6vMSTesWZJY/JrAJO7CjKmkKENVnMpi+XzwGZDJebnYdLY2prt5Vz8RYdYHD/D2R2kMUu5fRDYNNeHmlJ1ch1Vyy15R1AiLEIpsCB9gSzVR1P4lD7wjSQpxJwSH0D/19LY4ipxQesgCf4kn8tvPFhZCbMQfrBua0DVMgKpjkXJsGyOO/v4ccmmaG49qje3hnD1Xib3P6JDE+n4m4YpQAfsSt3ulmSOyYCrsy+RvP5DvdAcvcZdAjfbYhRACucFtXeWtvVlPIQ+O4meRRsgtEruxGxvmf8xwj+4pZOLKl4EPIj2wefH9zGW/N+pgLEBqVWT1Gi84AKWbP+CDZoewUDAs4L3UmahFj8a0f//3kwJTjfXvG45HkhX0AhGq7LRIp3GUZ4xV8BXhyUe11mNH8N3pmVijNdIDnSpRoVGy76o/dw41koAztFkYZNICEYfFXAxnzKtmoib0C4I95ZbpraZmV2XlTrEvHwXxZfQX/2Q9XB359IndGfvU43Cr1vCAvRWC1NtOjL74E3wDuf35FD6c9rrSm87uWo99fmKJc4gkY5rFdlleCpAKsRVRPmIWrgpkrzxf3I7dK8N71lATdxS3/HXLXpgrFBi5+t87RGDAk4iieZVYGZTWLBh1R9uifA+c8ClQHPTD6aTzdIV59zN2xKQBWIUPiWoanegNLYqP/l+XrkDY4wrDeFL44ZouSRoHus08MY18G/TGZnQPV7h5R+/hA7q6rtpT/3hdydVUN7rwsbwxboOoj+6csHc3YFLgcbr83j0of0u0t+IGvZXLxmqmurAJW+hmyeAEJgSS9ecEjLed/IPB+YzA1UBzJT2XSEhhkFbiVVMQmOSyMjYmRtdHEvV9sMsT/m0AcGPz2QIjl3aaSsx9eI/K/ix1NNcuWS7cOTuHzv6CjUpZKavlU/PWsl/1/rzUNmygSGgv9neaSPCSen7PC+AE6ZvJ8kARjUUVwbq+QJYs/vQ2vkGRRWcV73hSCTvp9EgHsJ2zWJWelF+PiqYAw4vMhhQfrl+SgA7wHp58ACFKLvS1L6urhExFSURQ2HATIRb5nAKkUvbEqSNXz6U+EiyU8jrMugbkCZ4i2Qg+DylT53VPq6R43pde3lqkZe2p0bv2JB2ZA5ibUv+U4yxd4jU7Zv9IK1QYbklzNOQjG6GYa8pCa2x28TxDEbz2OBZiddszsLe2eMDUYOSYB72Eqn/IJcC35n4RB3IlRJU13KegdvL42R/BV9dm3NNvj1VwVU3bl0fKxjkkT4bKrTKKnfWyBD6T4m0aT2T7nKc9RIzE03Lq+MQUWXccZYx3/sHWkJltxkgyHYBD3pfpcfzBunbkcYqztGYEyMzn7n3+cHBhlaCc9gxO+o7SZDClUAiL/4MdQQ7zqxdUU45zSS9yOyJxSqhAb+ocPctAaVvFUUhhubjtT/EgbgTavQeO+QZTkJhkG6Ms32UMg6rsRyIFV2L8UmNnl38LNL/2qvc+LFTLM5GPZA6ZUl754K59Vd0j7dYtaXt41jKweZUFJ+Pmoo5S9UNBia5WYgtSgja97iEDHMlyr7AUpJwzN4WZGxcDSgwS/wsMh6HNvuWy3MRINI5QRNzVG16UZ7EH0UZcbXei05T00qXz3jfjmza3ZD7GZkvFHiT79L3CaHueE+PUt0jY4eay+dI7QaYfTDrMxyloA7/R75dlb4PkGcpLU4/z2RNTSqpw/OPnmyL3Bh0mKPVQaergb2uWGFVdMYSDKUaD5+wBVTCcXQqppiW4SRdniaNXlKPZ8wyQlMFIRWkukxxT/0lUIQ7VnCa3tSm/2v939qEf/DXfJOf2OSsf18hGDZzhr8Rg5s0DjEK6gWL3+odN1sv9IPdoyCh2Hdq5CjPieTcGvjcAgUAF2DoW2GO5tl5A9Bz4qb2J425MR3E1z6RyDEYLCVHebRMKTuaHOK02wAh46hHurKEn80uDjsVdFqEgGtyEu2RNqS7b7C07qa9qAk1KbbzIevoWKmR0/BTfPBaXcY/BZ2s77Q3TAHrtGTbvIIFQ1BXhYXjBiZcOS72mWjQpUqSCylTRwLat0Ce2yXGnvRREt4Zi3zz4tNvimKtZF/bUB1aPtf2eC02FUig3/FXUTRrdRPyOXi61tH06+nnbiS8hDhFoCzl+QfHUN6U7NfqzP1yTBv9uXAZk1WBt9Fyj9no7+io3Q77ZVJd9WUxCzWAUmRTeA9LkjZ5x+vu+R2VBdxMV/USk4Q0XBnXPWjJi9tUk3FFa34EfiNs8VJoIXMFDD3E3kQa8Lo//KtfngqTlVYnpZFB12kNurIKYEskgVsOKsgPkQsJ5EnJ45SuFj28SF6jkEzkAbYnD7w/jzVFBpPWnei/OiJOuJHvkaSieKlm0vs8ZoU7CVydAdDJagdfWTxXxR2y4Xy2l0I26FuG2aHyPRHl+ymO48ROuLkH7fnTbazFtAiVADV1Ij9g8BDS9bmHYScEJ9UFyM6EMX56Xep2BJW/0lQOeNsQHXG8uJl36lHk/Et0+VliKw3+eWcqOoUb1IZW+2s4hyXW70e9Sp5xja/nbhTz5DhA65BNF5ZelqZsKsFP+sJrpXdLbsxtA6melsiHwlLB2dWdq9Fp3CsTNFcAan+YAJ/hAAwWv8/dv29bpPNaE1oB9cszkO7bR6W8o5dPY1kZ1xMUTTPMKMlEemXRxAapulSY/TRCaOFCbN2BEcfd/vGqpwceGA4J0WDO1z1HGuMEaF4FTCFYf/gMOPDf4ayAQRjVY3oelH93IOyOcMD3/3Hb0EzSBUh3h9PuXo4GNokzKEAg+QQI98RcBx71vfCF7KeO9hgg/MDK1HXr7ORDy/bgt27L3UurEk1VbQekrDTCd3Hes3iaHmbJMVwS9KjCBbEESqjDUVX5UYti66Wo2rTz20wJ2rfFG0IBULKFQSU/25tSwBVndnG3cZeQsxcyGvB1dcTfs1OXSkrxQ1IJd0yM7Zz3aBMWJKIo+O9m9Zun9ySrFM6ciBk/ziKUnao42Co5agea7VpFAxMOFrP+JFQa+X+631yPbtOYrded8jaLgAk5urMXHkBnmg2pGu0D8OWmQqVXSmOSrys9Trs9J6lDe5JWz9bUvjSBsg1J1JEQeWQp51T2p4lD9VRcUULK3MZDDPyCzI+JqMenwL7EVlt7xVdglTwJODeYLRy5o6OP5vPsWndY9KeIrqEGZB693Q64mZhmShf28wPEHW+PCISh7AUKgNHgNwkEkp4uRHV7fsLeCrR+RwX8vZmwFSPSpqLvpSS/WtVFV4Cj1VaJBY8MzK0GXDBX8STLxrUYaWBXyw5Scxz3MoLFJQsmLsV9t11x1cyQT3v4DPwPSSjPKNn1Vaf5I4Mx22dAPz290f5khilntQnqo8NX1ErOXkXxY7fZuR5o1gXf763nTDDae6oMGho2ghW1kjXGqz4YJIFhYL4pkl7oXf6XQDIoQOMZw2qJJ43b3lcb5jy1t9zFnsCO2uz+QNPliFd1FtjW6Tyt1XJ7QOrCL4vu8AlSlNqMUcdPu0G6LqN+zzXLvd3dgFh3BoFRYNMd44WhnEd7UUcjIYGmKcsI7WaOCBSm4R80KPXMLmtTeaHwJx3DB86zHzgm/Q+ScakQ+kWWauxFFEKblf7jcJpDiMBHZgHSSSzFfAvlzkjB7DsMPEcvQ1v83Kt7zY9/GrCuRobQuxSWGQz3rHeEBUpzS1d+iDqlRXAQBOIydgAJ2AwmwTEfwMLO44W6PNcgzfGViMQmKPMS7lcLiK25hbcEFKI9THtYUNUybXQGndGzKTUEQyplKRth/K9bxIny38chnQzYhVPxMqCflDnoNp67zJRBozqk+jr2H7kx2vLpdAACuBdEeCRczac721VC9TXlK6HH9rnwMXNbnFiWo0Ozm5AYcjYNPbb9i5+BrNMO3JBKYSXHy71pV0M8G5cuzQh+4bfxq2ZADtLe6k5JZDgXrXIBnE0s6b83SmV72aE48zYDJ5eGy1wJReD+zHhFjfHgrxTmR8UuOhLGNHN6ur4fSz7f8URjY5otNlHG+H9B5e8GnXn2DPCYk0j5svVLfS4liauWMYSjNWZ/K22XKUf9ONc0gyPqri4DWg9CgAhn0leOUpeavsWKep2+MdSaEzIx2N1bKCGjKgLCOw4UQ+jWi6GYdOe66ZH3/R2gTZ+WjGJ15kgisL3Rbsu2ryn68FxBIRhw3NHMnNImP3YP3TljzyJrSIyWF04ORWF9bSFF5FE9vNeURx5FZ6OETiOlSdqOgNPDM9f5RYRt/IFud8IbdMfQUvMVZOBLWPaoBX0H5HJLZGPYCMw32gyirFEjaJJqhzuURCS8TG0PFEbTYLQWe9GCIDAOK4pOR0GromZmknOOo49mycDtLnG1hw66SleRsgtKAWonIKMqbRaK/MMEcthJ9QcS1ff1nDYBVeNU+C/mG8QTUJwQEaPv5lKga3Hec/yjsgOPL5Qh31j2ITZCCi7ZwzLTF7eklvfe+C+22F5M1oYwAi3iUgeKpYtOIC4lU2suhBPm6vY8BKx9W/N1Aqgua0LAOUgrlYj+evPelULx0lzmGFGjmeQDlJAgup7b1l/BJua+KZ+nBCwtzYmbsx2LguvDIUPuGqzpJBIWO5fq1X6PUfW/TAlX5CUwXbOiSY+03VTycFS21q42uvEEeZmN9uiH+naqkxVwobiNw7raOqU3s+ggmbCiImxDvEePPeoJVP4wigN3lqvORwWDNCpqIFzIE5ZwOkSBJRn7ixX13ljiKAeNTaWm7RRR+ryvaDeyWuTzuC1DjF5OKgXTbB26lEDf02HVR8VancwQgtb1np/75DPBIgj3RrXQsUz8W/34HguIPqc272z12cL/vopegzsknXR+7uMkezY0aw+qPND8InefZQRtOOd59DGPBduTddaYmvwUxibkZSb6SfYUcjQ72323AbH/ngbInWEPZqJs9UVc52Ft/QL7ksLEvLeHZ/Zxb5fmrI0bhWlZGaW6hCc1KLfoO36EayK9o9q+y6h55AhH5iEhT2lST9xad9ecCIiViHjXhnjtzT7jqlXBG9STB2t0ufkBSoJkJkr/9tbJF/WkSs1ZPZQHXS2QNLZJvX5N0T53blPEv+DkRr6YE3ALKE9GJCu/xFa2EqLnT9DEwZVo1Pb70o0NUl5DNyrl53+p126NQT7ZPtMR5UgjEf3QHKemC96/4mxGYuqYmxIyIHKDxueE5uHJ0Bba9voIdf5lXiCsrcM8lSwKHHMrPBDAU0+t4Yzdpo+lq+qf9mUHO4cJoZCSPcDU1J4fRq6npPvXt0zPQjIm4S7ncj3pB7HW9QOb0bel+BXiIWeZ4crL97v6TjXQ+rlPll4DVcRaS5s5nQpi50we4Qtt//Sj/sjchMOJVPWCATi1yAhtRLABi9unDwlMWC7aLuXPujub5qF7voemZSIPo+XeJAB15kCM0+u9/ucwKosw804VfCa6Z31V4rIj0cjwt6p1nWGJIajOm8G/Ax+nF0jitYvjxD6ycR/OI7UM5+x7FWQYYAcVD0LFX0WO6pcuDadR9NpO8rdE2j0LXLpH6qVvOt1dbKcJoy6YEynNZUwQYcK3yK4POd13Hc6WpEU1xkKSQR/I/IklBVCCMxRkqp/kAqdAc7TWRdHLW+ByU+dMzoxwbrrNa3tfHzc0MMHVi+JKfISFJd654V5QKSsXZmrf6e4CH+IpCIb/gwq7fTnliFhTnMiqzSVfCPdgyBlji0UoVoLlrKHCiXj3u6L8SrFXsR/wUyP53yY9E38LL56B2XcfX9g/5Dw4lXRqfd8qVRyAV+Fvjl+5fAMx1iAlFlK/6uu+rETMV64aYAnlHZuwpTdszWTT251GvYKa6dv9sDuEJvEQyBKX2c6MkfHxvwR3wBYkh889ekgg9Z4VnSkC+UxpXftppTwsbXyNrqgi7mTvXyTnX/OLHwMm1LNp/ANqZzXlDyM8nAIGz3VMahO6sY/ud6FRwmYXF0j/UbY051eIGur8GuJ6SRy9ZMc/l0W90+4EwEYg8Jwy9M/WtOyf7CK7ei6XmBuXG/2i++x2TjK11TlL7pTfhHuDIsAZCFlSyImNmUDEN6IqWhfEd0IsjFkVik5MjmmRp3f3aafr4/jWE9eZViZ6qRLGAkSiSL0cGY+7sXngQY3R21W3pLOOzOzm7PdrLv1VDdzzPx5ze5jk8Ln12xL0zEyTc/XYgaZMnEISa1+sBuhU+KERewleGr6HNmRTaIt6alsuzzuIHHKeqFJR71c/4m3AhBWec3AY6LtyfyDnPBOcxcoOC4mLcI4CeL6OJ9ITbJWL32HVcrVSmbRwy3DD1L9X3nByu3yRvntFRFD/zWbaaNXC9LUT7XT5+fy5ChqUJBHbTmK2w/GOG361lGaAHv8sfu2KziJWHs+AmMGFueJgqzTC/k9jCz9aQTCdfhEJaxkJ2EVkfKqKJ3iEo91jo1ErvYlTjMugRqdS5u7L7U302dEPBnu1OVs/r9G3DJnYbwgPLLj0cV/H10Nf+qLKVrq7DTX+eEz1hIDgVPSU70MgoAWf7iz2T4tiUFrhzXXQ/9IcAH22Vn86ztz7QlcdBdjfbUAZ5xGbPSHd3Hxtb3Vpp2q9cCom1hMArwoMMKC6lChaObJFeTOGc9qa2OiCO5RO3NjJAczporYifN6arJ/tIcSS+GK9rNaQ998ioAC0TixytNwT1YBhvGdV1bnXuGGLK62/sgfQipnhUWSE0Ru/JLFKFWFJEaKFl5jV80DxfXDYK2DQxH1Rh+e+bgXMZvTmWAm+EY8ZjGp4ta5qFmfa3b9uWc24Xrx+KeHGECegoJlqpVWEf16jTWs+hPxjo4ItJqb/7O1KXCzXjQQ7VwLfGiBE57Ts6psv3IDIdTq+TMj80hMFTx9PeWaRMe1WbMkgaeNBAlGt/HDT/xzqaaK+fIelRwPUOeWDTwhDMd6HB4NaLjIB/TYQrDxUWop2xWmiKc2jkgaxfV/LnecwRQ89ovnGCaE187ep01GPhutyPyN008X850AAJujavJVeT8VBzSC7jiyjLyJW4Tl1XSxCH7/L+vXBNyPhVN32rUk7vWsWPmeYfqwhPsYeOHSHhMw2QbLrvBhC/dF0S2nCtlgM4XNRUDgX+/vjr9LJev/3ZHZYOUMNEzPcGU3Xe4j+DeKuuNm7sd5MPfJ8BWbyuepv/M9zFLRF6QvZNpE2PTXgEof/KjEs2rMwoHc858aOAfgd/R4D7WORP/+AE/Wlbk/NTiOPrmvsy5/IfY15pCNCH+tsiQh08/8/55KSMjlHttjIUEHU/oRyp2JFQ14NMEvB8Ke6YoOPhOvg5vSE8Ubk8Q2f0bRJpUW8jZZOO2he/+fX6DbW9pi960wZBVAs8C+ywvjC8PYnDP2WnoLPr/r2USmae3MJpmuYqDbjV7lW8H8nLci+XgH+UtIHjDsFfF3VB0yb14guHa6n893sPSG1cKLGK78xGimlMg/Yh75qq9USTyN2KH57/j8PU1NKhDB5leyNeZF8qn3uA3kS5Vl4PSuhQ50N8F89C6UJMjEXz0eb7YuVXVkil78zGQbeuYWaPI0lL5l7zVSb5qeK8XZCdcj6Ufl6UbrCDbfd5XvbCtAjbBKi6i7z5Em80e+6rTYla3Z1VQGGtpuZnvxtg27EarBWYTwh9zFn5zvB6mngYdhxVsBF8cwR45PmwkYqLugPF8gijCcQIwvDzahjH63YCHK9ohUoBzm0+fYFXWoPlCnotZWnXyd56I9RynQ6M5jmyBWpaOyFVrXBYF22kEw47lf5Ohn2/6q1Th/WOc5+ysxtStAzfGYr2TQjguTU0fytRUajYIhfsAknsiq6HtWQKICJrGea9Nr6b+zUomCRF6oyOeyj0me0sTI3ZK6rfqz27FW+wDEsJFv84Zeqp5yisF5vrVdFZ5fPGNeUh0U/PAo2rtvWnGPUEYRs4wZxxKgTzM+eCyfkKdIuIorg0762nvCc3X+umku8drWqjA2EXy9y9+QovPlbI2tJMdaHPGZO5vPv52rJagRTb3kIe/szE4gy7U/xM5FREWP4dLehNwdHPy30YEOWnir91k/SZ8eUky5MkaYfdSlcAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAuNqUAyqVdRjrD2QzVx6HYc7/xzaT6E7dSRUKVk9nbgMoBN+kSAWh5NfJnMl2KAiwksxYTZX/O1EUiOTnTv3252020QXtjMXOU/LLaYq2IPlGmj5cslv25tQT9BTFr3Jq5LpcNON3Zp5y2MZslcUAKdzFmTa0EIo1xXBJGhP5/H0GkGj/CYLRC+VMdh8U0VLAWr57EDha8cXqGYSdxuE0a7hj4+CQv94m2oXbK9CGRCqk9ok5lMYCbnu1ilTXImwTOra94QzTrAzQaIP6ZvCw4+sTCcBTS4EihuKjDKVA25m6ymIHm+hx1/wxF6lqE+mcONE3sONpwEPmhz/jG9p4o4mMNJPLJZdhUobcXLHobOEGjL0UNIz/GjDmkfzcofahdfdZhqtWpVSdhFQkUqxuk7Knz5efK9ecpK3CDxZPZHwGPEYkNe+XSzk7BbN9HInXCw2JWOvVQddYTiu8cjXHleJ/m7kTA4QEQx9mDZMchbaeSsus+kmpvT8y3AlbEQJYshPi/tKRi/AaUpnXAionTl5WyLkXoEMoqmngPD0eOZgin47CDy08EsPWHr+DpDvD1TQWW+ve0XXsLL3GMl+4HnlmNOPxrVbw/boGnZ0HvOK6L9Tzc93Tund3vyefEEjaTY7its+yC4lW3nRzWnkn8lMldtjP10hi6Pm+JKEGzwFLOzrYFsf5Pl0dC6aRYG4GDoPj5w3fNQcAvh/solviNF7sAjuF2ryceHXpbf2MwExB5/2SzyEbhAY7fgOSDoNiwpnGsG5seK6FK9Vc3qNfmdJky1roNrd9qyCaycBSAeFapzmSNhGAWziITyT9d0zXvXTc9YOsKumt0zE6UPUbo597iLbdsE+WAgJRsCeJZb4pdrDw4sdnB7lmNKfiifVeMvn3hMcEEPe+MUa0KY/HH8DlNuDrEKKxs807Zf4eS97QMa6Y+zVgcVmKtsGKcGNcGsq4eQM9Vkz9N6fxbBy6q0FRlrTpVFhOjm5MBq3LTc9t+0pP29Zf3Ew5BH+FEWsm1YpD6ct0SOPnbMrf0/tzpMST9QnlaHjlFlScjB3+HSv8F4ZroFiDzxCnKRMoKCdrVNm6IVy9b7/88nlbjNUJIhboWthqlTVkObl5V3vt0OluLu1ydrommmJvvVhEeZbAdAiwrrQkST77IX5c4A7VWiG2Qq2cVp1USIi8ZLgVHUgh0nE5cRGDEM1tlpw8J+pO7UQGqpnOgF4SQVG+JJx5XpTF+bSwdazqF3NbhVIrtMPWcZRKSt+3YbABLsOZII7O6A/qFEQfszp9itq5R11/qyAZ7/tRVqeS8aEXeOPA313u0d5/Y44Adx6JdoyjypRHLRVegK8yLqn8tCkbasnseB4ShXEoqjw300XRYmWuthX8zCrNEce8SWT5qKF5dDXKL5zFZ45g4qlYEQi5nmqOKPqAlwhWh4VYGuPLCvBMxWMNR7TxiPXn7anqsuWK0sFAxieNY1F7+sqM+2Sqz7zH7K2wmn9iwR/LUE4ZtOXwdR7hubbqyN0cukAlaY3/5GeK2R28pBFbM158Fpx85oAeHl9NpYZ1fsRocp1y+OrVDFXLxjjZRdMfzvah4ECgKPJ4zST72XoaduOoQIKfIqCWsBJHxqySdZwtUc31DblPubF0EuevNtCb+AKtPq+ANbBWAuB6LR+J2SeM/bJptMRUi/Fagp1sr6QTgfBsr3hxoTRTOh5v1LCOih2c1i+8QzCw6DdK8MqUhaBgb6++2nUGUF+vv543Tah4M8RuH/MSUB+z0JWJP5ZSsEPPXkiNmOhvgdVxPgpzK02Y0IWpdcrJtT+mUXHlz0n0aEp4Vnwk/qnNt/MIX0Pzr1shff9svErbtfDVVzGYTuC1q30ykF/wPQaHaGyCNozHiGX5aQKG5/TeN9tBAA14+bTLCmlIVf/ZlmccV3e55TGBDw21oVszUZCH4WIZO4fWRRYIqwE+KDnUwBHvOkeGHT/9WU0aktH5mWt3CHVM5f0f0TYX2v/KcJCyS5iDphVmKHdCGQArKQayL0ewVlbrsJx/Lil9nEd3yW/P477W561TjhXVWvxfbHE4OLRKWhMq3Z46a+KEuOz+7QkDRR96ErwURjmyB9uwyZ8YpGV7TyNU2CJxRJzxH7EF9PRA5+pm4yctqdayt27QDpalBvlSJDTKDfFTQMWFYXAVgMpDYRMH1R7nQ0kjzFNRp4TwyE624HztHLADdVfX9z1l9cy2kbyaMC8IhZxD8gG5tZK1Eq4slBuY3yVxsOjV0RRiQ4T6+h3WbOZWB557fpeeBBXVTMa3RqA+yGc9ZjzRImlh0Jg7ZbkaSYneyKFv8yvM6RGuB1UyOT80YeQBZW+whJAI8/DII12JVbeLcvHlXqSTdjAsE7UxYQoyiD7pJfU4/93PSNsHbJMbtcTnMWZVROuBdIUD9MIWBhclyRVDbT6+dznnaauR5z/AjGL+lBDTZwsCjgpOJ91G5kteIYQU2cnwrTXe0g52pISh1Bs4lLqdO+S8tFRrNx0OTYlpkTBjcONlutMGju81mqrTWmLO3vbRbMzEQp2RRixkfCLenhaBLl8rdLxsEtGfRdr8+ZC0dAYMaD7sUcR5ns8dcT1u9BIUikI5cTnpagDpMHZ7PKoyGggQwXLFMy7/OUQMofCOpBJlmBrE7R7+ejcSLcw4d9L5Fi2w54sPg81Ypsiw0s1e4SKviVTcnU4vAqIeTUJpwKJgsHvAabiKP0sT+OxcZdWAw+CCEnaMGFSlR+dajW15a/6MD3UQ73Yr6FYW0fGXKSKdAq5EavfQqC8xUduZTNloZc1h9OpWOyB3mDXBVhviHOUNt4jxPArqE/3mhh6XyyzHpn+9Nxfu+tyAUrq0jI0mllkYqxA4y+ooAysqdjcdqjrlIKVkfd1s7S5dqyRs7wVwpgKfkFCRXLr1ISjYS0nxSjEewjWhzbD6KogcrfCfGw+Y+zdH4uONpdS4WGpVhK/OrkgQwocFwvVoZzz/sGYq9Mfh0Q+Dy1QG+MKX1BpL6xPBMu7UGxqsIKc8Dqxsp4A9vbpXyvFUP7MsTgZXZt0u4Y5qq9O0i3jYY8RFWI0JhNsUK3iUJerBLtxt/rsLiDpMroWxrQpXceTGCDNGhrv+2AuUOu7FyHCrj1/fpzuz7ZZmA/1h2rr/YzbNTakqd2E7ZBferB2aDa0b8OUG3ia1KX//vQNKRmPzE+InCZ3ZQKABJPjgj9gGJybOGVAch8aTLy1pE1nIxExvfod+RXbYdkn5leWw3zbDZMjakA4V784vOGhMcEhfHEpEjx2aFUQIXLlkrEnss9sPD4/DnM2duf6lQYYLHhejvZ426R9yqllzkOkwaMSP9GJe2fgpyEcl9IqSS3zP6ausG3CWfm0yHpSeLMmD8Fq7QNU/hH0/YVG/3B1wGRy0mcRjPTtZmskLtHOAlNJZlD134OFtV1lvnLVi3lY8aZkm8uA91yjc+TQfkMQt7zOpxYS58szLm6riZUIVTq0OfsofX0wnhHupIcY871KLV/71W9tTRA6meiPBc4KNxZGZ4SyAlucfSItSzoJDU4o33nc3W94PAKipQnrF+k42oQumYCYMRHGTs1TfQUAV+4/I6iXYlfX8Re+Ue1h/O1dmuQCCtRjbPG39HRttDyLTH+z+e+aZvJPrU3oY6XoIgc5+A2aCNBGHdNq6BNINSBmrSE94KeGaWF+qvVbmSAuUfSSKM+Ulj3loIH7CYNukd3h9qKJ4ShP1EP14AWjWF2ot5fiPeOQ4pGsudqpUAtkIBEmvJ0dSeTM2+YSjYIVpmoZW9kgnArzhs8mzvYy1DZ4j62QkYP5qGoqlZPwozX41hyGefOpSJqVtIc1Fv9uwf+pmcEAkfHDvQ9oo0fi78G5O8qZPwSAGlaDNOpBwnAlFYBSXC8M1ncbhWnj37Gk3omnAyOxk+EEfs5tVzAWwXHUW/V4QgU4zoaWFVoTi9X5m65fU9Ek7pbxfnJ35nFGzYbCUpkN5Rmdxc6+84rWfgloCc8xdLsbbxKEFJgiv72ljPOOxBtW5R0DjUBksA1ns11ktVQqSzficbkXrWPcQ+iaM1pUOKnvNrRFRdCZuXQ6vUYOneeiLP2a6mTIbwC/IjO7NbYKAJGJI377t3yYs/zd/peQAqxR5ubHgJ+Ip0+SzAgn1QnT9HcBgYCWD7DALr2iqFT2qrw94WmOXDGUjMWMJMe6pdcUiNYmXNht+X8tAmID6atEBPhQrPvhNlWcMOZfG/FtnN78Atck4UhqWGjdmapRwhvRTjfwy678VbryKKvB53SPAyvFG5kKx6zjKhN/+ZUnzRNcV1nfdzdBCG/ooO4cQCpbhM3Mucg4WnXbEKvZEUglgNr9N1sMMhppl87fZpgcsKoTIkyy0tL0gweKR2Uh+XSq3nmdSOu85q5jd5YQSPoXiXrYF/ssEJsT3wnqQWN2VdJsZjPVYUS/N9mQT3J++ECH9874NmMFiTyxBH1ABw5OTx+FOChfV7KLBk7QsgDnwYaDbImJuGt6M09x8ManN/qeajBbOeHWp+hxbdkf7rWQf/Ftz+zHZNUocPByr/Oezz2RPCtZm1cCUbIhGGViSWUa8J/zr90jt3C9MRWsvNrCvfW2hlExMGd92OJQXzK13JKPYWXMxmDokqoBnyg5MjlT3WmuDArPQO9Cc+52NSYAjtssqEt9oaWTFg+kriGtNl5lp+IVugCYIzylAFA06gbDjLqaRPuKrVaptInwMvCLxjTJNGf7Rov7WC7kUFW9MIM+j4kxlHZt1P5PGlqjVKbS/1VKIshaogk6QK5hyZVHTXhkNHSzOKUP9C788nP0zz88QqjhLW3ACDk5SJEhze17jySuaNE9TIgOqWgm76om5WzbNUgMiyOWvdGH54aBU8j0CcgRp+nTNZzpSqrWs2i6TtHmXmtvISUemdM47+mGe/tM+TrgfuMrXpspOiiWp3p7xhJ6iKCRtfjPNJTJlSj4DlTzMVMdREjOHNE55V96f8lAQFdT5lxGhw9DqcF7bYL6Jhhlitjkf1yNffNW+zeaUjyA2cz7g98YHNAdNK7sQvYlbA3vqaCWuFxdxD/EV70LVmHF0yMjFAhIUgEih8mdV96Y7X9hJSZIGE+14oHs+PZGKsSsshVyf0Ot9F4xPfQGK+yVHfPZabOotXk9S/k5JtERvLwzvz4ACH2h+6GL4ZXwGKaNwODOCdqOJQouJP8uaUkrybeWqOACKw9whWYF8cGLlydXJ5/fdiJrYMTlaRft+CBz3c+NUPur6DcZQXryXaIEprRXSlOYtMDK93kIy/Qvt5GHJZSMKWQW+fx9thqmM/p9zJOkVU7ySNrk5sXzueOgoBSTGi9ldt5iu3idzP5RHuDBgCW/wYzye9pTLryuWbBzF9/gp06kHgZvHDrzBsinZ6BvaJxKZixBApj66Yb7wPWM8X/rMRtgq62xkwxg8Kkf2eIsXAPBeUVCNfTV6Re8C+T4BFzWRuQLvhMlvaXGkCcnbDgLXDUGzAIMIffidfSYLrdN5cCjDgGL20aA0TH7RrSkA+yf4KiQyCgiv6sAJ638lS405XYNvym6lJ04vMJ3ft/EgLxs82/itll2+5mPxoOMmHS79XWvc21wVMFHc4GYzOgsfUPtty6G+ym/t503XFgS2R3fXkHe3J/yrUvWuAqZBg3wkXOUxVdYlHy+Qfva2LgBqYP+6Ob6c3mNDT2dJQFqIFm/jg2DjDx5G8g1fIy8yYjPLkFq3uGnFmc5ek4VYJXv/a80T7avtZWq/tpyZ9oWiNs5pShVhESs80Ze8cn6/fgIjrG6f5acWKHEHdcUS9DRRGDeg2Za0xuPJ569sogaKiaDafbF7XchY2uFjmbsgfEKEDKsrBJqYjQ/o5GzfRmaaqCi4LB0Qi/SUXA7fYswVa/CDDwB6RcobqmNun3WbuL8HilV+HLtqLZZQQ+QcYxir6BQIK6ITSRUiScq7f1gHcoIo4myebc1d1lgDNHfsHAcH3fK8S0ngbXEjYIDZdSEgPBWzyD4m3LWyIw3iX8/k93iyWNgQHV6UN+WOGK5kdPulF9EUI3fStN/ep8+oyOdVECBfbGALwtgZiIAWHLI7qyQb+9H17Ap9t18RLnSUeAEQQWqx9N6d4QfxE4+2GMkOVlJvb4iTRh0H6gwt3RoCrtpxgn7yCkSd91mh0fUetHoph1wM3d6wIXnfWF68U54fzZE6xP7jakvp9LEISHBYyklS5U2ASG/+dI0e+Iqr/3a7gsakXbc2du4P99d83/osadB81a37tCsDlaYC6SVE8gykSX+oUrPW0XhKrXXHtzeHtffOVX4RkgpKCr7nuOts5lbdi6791LTXFSaNmeQduIsjFCJS0HjR3kAGXVu38EysChvjPml6YHM1dnyfBmd4KO0l7CJS62nsqM9+OH20JeIjIKvmaho5qEOILJHMALXQUnvlOV8O3uNhZXCFk74lNVxoAMc5DOymUZcAxGLfvcnQ4wGpQYFUNxs7eoPlcQtD1syKbE1Rqi/07N0flpSr9EB9k7ENRUxGuF37a55yvo4+aehOvS+1FTiLgcpWMTuyenRG+rDUf8ZYajHPhh3/Xgu4zBK2ClMQMAfIl0q4IEr3A3ulSCGJqwTJnnJATteKy1bSvCSQ1dMgG6yaS012wD2SLeEJ5xja10b1+rPSMEd0AZj69r2wkhAOHvnVgE+GqJ82MqYJxqX2GLiIMFfeRL8wbeEUfpqJZP9LBSE2jqwJPKw3HcOxG/dC/iyuNCFfQE1zYfHhWYgKUZkfS0n+j59cxRYWwYcJpHf/IQHnMlTnNSKVNFjudR4dF6g/8zJaUDzl+FhfzLkg/W9sH9BYrEF+GHK1zo06mt0zESV+0UBv+L5kBd/XWpfDORMwxoR5drD1DLi1NBod4DUAE8ylWwEu6WZjNmuyhtbIb0JaLVZYzB1zMiOewuneyKtylUsymEVvcjrMSfZxYfZIyBtGkYy6GcoKbp9Ewg2KHppBLjOilzhE8o6wDJwGHLOF4r6vCuSyfLpAOjqheZK4k1jXf9tyAKSWZz4ykjm7B03UikffK/xbQMGhH38v3p3PPcgw4YHST5DOJyj6UZVqr1rm2c62fP8fSU+vXqAlT/I5/MGPMfVDk2TO3DOidatddfcu2i1ZNhWccn9JolB+lN/w80hOvYkZQlyn4RPFbM3pCI7vO4C8MEfPbO/JtmUv3vNRH8NPB+YQF9F7bNmLKoKhU/rOOgwyT1jXBwQJOSjlE9KyZDXFmMAHrF3Dev5olSnYFelAbiYIKS/6Dnsk32UyeNjOGGxVDroKxwk+zM9hrDSsvCPiM4v2ejqA/YyeOFUdvbRnpTYa6/I1HPd4b/ngUmi2YGTpUrp3ld8+GXZNhooqOLzuBgEXIS4ypjJ5XE0DsRG1bHMOPF9Gtcuz7UsgHk2YtW0bAQr8xRK+bx/tF63cM3PafdGQQ5VClCzgxpRqPzp8t7d/Gq7dSD5kaPwzuu4pFd4ySuXyesdwFB+lofMTRd8LPc1oNCen4/E3UJuMzZA195aBet06z8Nx6ZiTVPYOcNG5GLVUxSD6t1c/Jl5ZPfWyc6dDFbVLGrvRzbKU92c3fcLIE+NrtGQtHgLK/OdUKsbZ/cIhLttUMmdPOOJCx3xqI9XGLE4yUWKCyC87poXuBs4nijdDCnFU/CuYsqPu9aUrIEbv8w46hWgO/kzlM7w5MN7UJWer2JETaL4DBfv09+ttvWjpv49FgDAM4oAhwd8VjO38JBHGHmv2S9/jmNfvKH6+6DCkgZnzDp9IxPEYo0yHN7iqhcy7UevgiRdL4YjNBzcRDCmtsVaPnTRn39edfwbA7zQcNX64WYsC+jZCmOK6LYcY5q8PfYKDdqJ/+20TSO8Ka61aE+Fxnd0CCKMwwsLFdO9hkIRVDZpuM5U2HyfhJYgxFCgtA4yippfQquZ0f6w9jJoUgAnqwtJVdDmotUZFX7OkUBaJGvk3LbyZ97DmTiaA0/3R59MquvK8KpnJG22a4wndiNWC/6S1VtSeGEllUwnmIg+Wik3+P1ohTjyySKmFeFh5xzO+uA4PdDXmNtloBAy1A8UY5fu+T47ZomNhrmR/zGvr49cHT4hieCiZzrlsyOXdPCUiPK5P2WTG7VcNkCenRLj45Vv4TNZwrKQJEaKJAcVJcilyZ4OaAg3n3dF27W9Rz6tfFfoqfvP9zzKXrLNwafX8uQd8pRog4efi6xMMmTrxtBu3HW2Fu/1PJmrTqfPmVamdZ2KIot4QQfyuDYu82v6kSrgm0V/PBGWpBaqKodpQc9Uk6KMwr2Y+9DBzWcA6TGzEI92ap5vhmAV94D7c+FmRAf7WGbUxg8k8CsajJHiZgKGL0e16D0shewjHrRCCD6Bu5gnUr8aPjLiliNaMgjpn2wUWWcGL7oOp8Dymp3KD2yBxrzgROAwIbVmZn3uZHSXBed4Hq7wCtl8Egd7qGiSBAr1Ipbk5wPe5kWsjDjz5BJVkOYs9pI5x/DKjSj9akUeXu/KT7HVDBFW/M1nbhLcYqGFoZp3IWm6uBPkKi3/eLg+SnktCOC0M8BKQXwfqgcDvRuQa3QAzghN6itqIhp9O9VQ5uqUadCFQU61b5KU3OuFiHQBkpn2ap6MTkD7Z40jnMAkXbB1S0L5j9UuqPujz8SJ6UhCI+n4gtV/skubhZvMhgL1esCBE7h5I9kSr/v7iMlpCGxxIecH3ZwBA6MhHzOsFzjlnjalquIzK+NZVcrTilxWYPSYAt7AmVxjb8VhY814Q7Hs3+h8jPdYrXf0Ak5zy1lheasj8KpcymsY4qkArp7b3aJsO7sB3j41mPQMILSvoVYmjDFAiL3aCGSq9d1ePHwxTHcHqQl33fPVM7kVZ4j05t0VaGPRn+CPslZjlaPniUnKidl4XLd9NZcOKWe5mH2B+J7qi8zCoQne8OWF7bPiMZcZCKItGNKEAgkdj1u1ogTblHcpoFwsTbUD+dpgLTdT0Nf3jjzAXmd5zTLJJFVF9wi4S8h4s2ZiZtlxipF98g7cefxMvWbcVABg212sUFnR2q/s/tQGfkD8GTGnpwRvMRKEHks7Zr7fRBZQ9Vx2wAtniE+KUxJvjJ+QiFc956Ggnl1WfaY38T+DVJk7ZziXvzfZFAhdY+KDw4Hm11ZSkJW91mObagApHE94fD5PFUpEb1rIcLrGQkD/VNTrsZ78qPonJERZuEmykEOO2V8p9/EoS5dIJF6VJfOp3UQ4xRRvDRVG8AGhlM+4s6qbQNHPu8Xkwqd7MHSx0EhHf1x/AXaBnRVFxdZlLc+83coZgCUELlv59sj+qfRI+lwwagql7JKJqgBQ+UNzG2akaboHSNfJcqSMXeGagczhvmy5oVZrNIw7fAWw8gdALHA68Z4hJQBmhfT9Xnb5hdJDSDjSre+dWG3BMkCLz1v09ZtM8mhLFFGTStasdHEhoKYYfCnuojpmUPblnGC80LaYFhtitiUIAOhTxhAyTSMC9AiKhekCJO8RSrzhd9KHI1RnjNEap/QOVlaUHek2LLW0O/eTga/13NnnnT5hA04oA5jh2iUM15aXGzYzaDM3VplHyuswiQqWkthxTHYy4Wg1GI4cbM0UMpMB6FzqEqg78KfcoYtPO76zc1Q1YiLfyBnZfDv1iX/9DRTN6oh76CIRzpmYu1TQEcKeVdqQ2LjDcvPdi1G1S9ZkvQV1ZKBEgHxzNakbFmpG6bd/9laAMQW9857qodGcPcQyEBubtPlxSJVHHcQd0978E+55c327Ls48788a3ZUkqHtfJUtK44zwJN3DSeJVNx0GiN22mnOIyCMavKrqiuLHvMPptbmqN0wYnzod8mZa94htey1OVQ6/7AJfn3pCJupCk4oUpPH1GbNvmDSL8rQiZwtbzlJSqA5uEm+r3zbxBa0OMlPYWbs2fLGfKQw4GQpQEslkL1oQEI/JuuDbR8TocC71hOiqCmBnv3A1jjD06uXtHE3cbtzYtwk1CDc/8SlVX8qasejUnWoIo7DJeoL3Zv1LDCKeCFwBr1xZ4mn6cIwPGHVL3W2Z2der6Z3JkeY1JLhabGXujNL7pkfNBd2NvXihrxIbsZxOy6l6G4mqIsQYkYgmn+mPCiwOy8zWSXhIFSI+T80Ge//HZBsft10FORM7nlCG8H3rf9fWNviaXVgS7pzrDngDXIECGYsJ0SG5AlEEez43bQoqiUXqlXCMyfbk7LaRa4WJuQW39i1P2wSM/LhcHiS4tdK6dMHfRO9cSh3skH3G8PiEA+gZqkn5HngcccTe+jwRtX3imu6keFQgP1gxixU62omjnc9defg/8+81ByC5t7nF85RfO7DZ/rUNlUgWZ12LGbBJavv+BznJC3L3k55lAJ1nAbh/bHh3rgBaM9u/+Z7IsP2B3jaiMREEguA/zgzo2Bgki7l2/7b8R5/PD9hDY0IMBiRYSqNH/dCDy93btolniO+4BU5qlFrB84KSLr7iJXXdnYri/Ule3OL5p+YlJgUNhP1qg+Qw6IHhTDir7KYCLwq9kazvZq9Srr6b4WIho+NE9WdMDdNDwk8IwVq25XvHQiLoaAGxVAoPsVYJMzJpKsFXiggdbeF+LRMv3evM9MdHfyZWokSn/idUHMgRLdzRT9bC2+IeFrCg1a5eZXKLdse8LbjJccJZdhFFn7kOkKtNPIM5RhPspSUQyhs2OogHrFSSHSW4Vqrbz6iWX1Mv0fPQMiI+dluOlKTk0cjzwLE74yodcQ1O8PTe/tJA/ejKz6ewZ+mljD9rc/MTqEwgBUpCBvthZAG+DThwNuphW4CqerAb4NVpK+ZJmig5DHrDLGpX+/i5F+oGKiK3s1DuJbItuhio1VmhIULbU9DmiWVBHq/aVwItkFPlaEzWN1VP96l4IkQ7RHLQNELwt9OufoSSsPFoYM4PQ2APa0z6b5e0AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAxzjFawOyq+HoKBuqdD+Pmo98xkPfJsvuOrFQJCvLuJGJP7V4lRrSU39xjy6sv7u7gjFO73iAz+kX5zXZaZqEw1V4hF7NfAN0NbMqaZLnqpRkcZfqSbjJ5N2qsHhGYqsb5h0Hl4tt4sPOptClHSpAU/ZTp3RqXWTeMW0Y8wVvNRG4xGEngj9hRhCiQKNXIN96ykyb5AjWDi80uq8zh2HVjY+e2WxRcON9FKRY9pfzxQU/dQR4XhB8W2OOoLqdEFGkR/M4OIijZMxKv6O8HQzszSLxI1T845lglPhpuJSLbClIypqNDwMpNxkOSGdbQWxxGLtJlYj5lKge3uESDlN++QjVbp9xAnH3o1DJk/6aNnHz9H258BNK3C2wswQFCwBAoSGFrr0KmqIf/RUKjXmyhfbXxHIOF4K+tY4mels/0KFrAEAzGIGNK3x4El2OSAouyu6/SkH/822hTeoaEWb/QEHNGzroqvrJlolDoFkL8vAHaIP25/Pt6et0Ew6dcbhH3BPyMtQvzmNB4g+Xe+ZaN4iaHF7TC2Jw9WVj3xNXO3/JCbqAQp40DDx0ENqEqQp2cEVUcBdASxxCrNIoTB8rpsDAHzSuQbjP5Ga0ycal1fYU9XDW/L73aKgabI8F/0rbC4T1vuQ1f1x8k3oLQHW4zs28Q9Fw0VuF/E7/c6w1EGUlwC3mAd9/BzrxlWXbIRmoImwh6nDvLRSwL1kLh66c64qf6tLIEjg8DIhODc+tEdU3xkHfCmWHJkcJDRNLxuJ1OpMvvEaOy9s9Ip6kALY7k6LzKxoCK91uNlAZ15MsGhytPYBUDzRXqAHJVH1NyB0KwEF+FXSaKLaEqpJpfTQ1QP7dnRss+OSc6KufTKeuPliuzoLAKVlJ/h+AmPbsWjw0pS4kwxhTuOCwvoUxLI9LAK2utUaZv4KXYrVL9OE9dxTDM3NDRRy4PKNSHpJ2s0a5Rr0DPpgk/LsEUhBrgNa/Hy7v6TkbgSzaE9/zGvcsMVWYujZCDiZhYvleWEI8sB9IPg4yhrxupIwt7i1/XoSIs3MCG5GhE2B2m05U0aq2o5bKRIFy+js3lk8x/h3rRT/GarAQQfNGj2ousx5I2YDiflpihz0+qD4lPDx81TftbRfXynXb7lRnfie/TEja8Cfp9tlEeaW/av2nZAnP7sV6Q5iK9DCcpnX7MZnZBYRMaLQ0SrkwgMMtKEmC4CAJgrmvVkXY92/s9XCq81hiNBAj7G4cKwQrJEYqc/jy0epRTF/8Tpdq/DmV1wT4mCkeHuuH2JViZUi2W4HiZMdjfJcod9HXLl86klAUNy6fZYj2wUv+v6o48rx+rnKOxggYw0DrA0KNYyuwZ+F5Nj7XXX2ZH0Pk+isHAChNWOqTUi8RiuCD31j7FtmEFvsp4aLwt2AIUhAFFJWR5LXKtFIMwwAYk1jiRnpfBRDcVWUs4TB/hek6zwqlgoWeeV/5Yvw8Zh+l55TZUoclsacz7/aJEuLpV8gm0DeITpG4pGskvhghv0NPWiple1poBoJ9dDhK39cAlD3WZSlQmkqgRQNnA8pYRJpU53KyrXIA/Zpu0FGEUaylpbp3vp04VlS21A/2neFAKCzyNJ+PpIrb4kTL8T/IohG4FJciVNe9QfzHekwZff1eK9plkOEhGWal4TUSFV1/Q/Ut9FnRMsvlFwCALgFtslIfXcImZuX17DpRO/c7WA2a+L1TVXtq/3fLnFHspE5QMap3BlP/5sXU8RxRh5Tmc/KeROPWGd6SDgX/XHPuH0I/Uw0swSfuU0+ZZBsmHnuGj3tGPxNT6t3rtnCqEECEfNTQbMkaMe7XPYwdXEy0MDxfQWoQN22Nd4I4BAxkoyGgQ5vY9VUWWDHVdWiVuoK0jFm0u+wipIaBfTQrexLitAwHvSp5JAqQZAzK+X3g0O3v6V9qYgO4Ntm9pFwhkyxa6WDcAd5NT8RquITn1k+uA95xIeFOfiosV3a1cC7IOoRcKHhceZCZoAdUtQ/KtNknnPGUwAoxnQXKUguhltM1BG5H+igzSRANHscgKX4vl7gurF5FrGkZiUS1iQHSNfwA7ASIuxMHhbl2JU2Y2Qqo9IFniPSkYQRNy/N4SAM4FpFvVFE/d6fkVkj9RoLYTXZomJHe/LfCiPmWAlGuN7NrzdsTZjlqHNNWWzX/Ysd0mnKIRfbT/iz/xdTBXLEmx7Jk/oTv9IbCkFRJ6GXzLCIbPz7LtOJVHwl6I/PSMoAjSz80ndnIMKPRMw3a5qFunAItKwtApY0jO4fx05zbMcwoKaW7V97chAm42GhR4jcrKX7TsTCzEIKHa/mYDEQSgVveVjGHh7B6sxqfjJbPeBOegOrHeY0v5ws6STZMoPQlIkjCuTPrIpltGIKj8umqOnzMJJPIZgNUkUB4LASI+dIYfnv9QwhunzhysyXyPlwq7hSkOeYyWwptcBaSz60QnqzkonXY9tDgGuN+5sPrrMNCk813rOLEWDW7s3IpvxiHdccyjbxoDwRiv8PjUkxH9p6NpCBP3UJImfkYHDghm9GCFhZFm6YW74KeoxBzCOKXHXpo0jqW2b5qLHKNhawLMruVVuUiFSBTRNuyuw8Lh2pbFnHRCGizAALs3DIOB1svfgijoKBhLjCM5hHWuIhWzvmn2f0Tbu+JcQROijpDYiADomcD7K96DsQMP7pbWUt3B4QkIY2oNPPGUsxnodGRtcXvpXzysfeKKt+ozWHu78Zx3fFb+invX/ytLILp8vsrMDgbbLGzHwx0KUNTXn8OYjaz5mtn5Qf5dEZGWTXTHFPXz4B/7OzvoLiktGDdZy8h6V/E7gLyfr4p11Eb0d3c5lnLs8oxZwXTNYHPiy25xwVlu80FWm3Tl0Noykg9GckV5ZQnqi4jXUOdwcfXYpn++wPJw8VvbG4/o9v+Tcn9hJJHDq0o3a+sw27GkuMAq0wrl2L2IjGYWDuS0U4HOryfMXyxL9DoiTYQsikj0nMDuf7shZsVfODe2hSy6NNhjwQCVwobEy7XLSl/g2Qdj5kdfaVNpvwP7nT/XCSwtMvkK+iA8WACKUH4uge8YWHQeuSsKUQ1pnVG74sdxR1gRJHmdIt0oMHYpSjwT/qhjD9E8ZJE/SfmjV7yCLtksFiC+bqD+beL34JP2V58N1GWMwbLd5RUf+pdMhbEiNWWNoeXpWoVawhik5zxawnp6v+q2hZMUc7R1xVdhQSfdUEgS+f+58jLLT0C8lstfBErP+m+tn5MweUkVkUHBo+LHSTq5Xgh8TeahU60yX76ESI4O5bfvHxSZuOcjGjTOTHg5D6iHrvHY4BQlXRyeFIV6tQ/z9gGJfyLwKct2hMXRoJybt4ffsuqaLSxN/bUQpjTThe3/BDhnUrhugDBtb3GRbV2i3ZdmlnyZW4MDXwB0YphiP6oNhRIZTsQm+7aEdoBKzlSMVnP8Tb82olr/4X74joLvx296/YhdwFA66vf3/CHaXPB87NCkYTNs1yaxpAIeeDgqvN5PVkKXNopNiRN7gopPCAc1hFJiHAb2mKFmlxV5cVGUv23xzeLMfvz3ksfSq0in5PYAcxsvA2AMZ6wkWVuUAyKYknACVkO0opruUKnThQ1X2Hk+LN5I9Xf2mJ1zoGr3cTGLqqdv+QaU7MsTpbiUYRE9ppYY8so2Xiq1LVMteEJ7kvMWHwhKZZ+XJk4k74H46CAdR8H66lK/+b2LB6UH9ffJoC8GN1fwitfCc23io7DI3t2fWh3cevF/dOzWsrtYUk4DLNgRUTPQoRBdepyNnMjMAh1LX+tT7x7T1qqqo3Js+T61L2FZtDavdzYAP4rHnZLuc8XYNv4Pun294soALgkTU7lURLenU9CCrq6Vr4m4a2nNEVwHKZ7H9jjB57ccWJOQVz7uuHSRZxuCQhX8E+5IOjEHLk9JDyz4gYmhlWY+KsfZhDwFUpCH+fqltwd1TbHQTwT+Q3G740LohJXsrjk1m569HN/B1VmU+4qKonGnmMoj2KACSWwzURPvYogNzY0XwxtjHgvcW3xJnLTYtpQYLQWA0KL5KuglwSAuof/YSe3Vpmu3wiW/bkRFFmoLTbTTa9OOqbaLXzcymVR90q/FyVxyRunafh3w6NgV8MrAsTNr6w9DZJFWiP7BaNveF/1ItjFekNXcRka8KMxY9DZQAsHYO/nb6f9hRvz1B8QYAa95Go8EhGrzdOAsQLhBFoVoDO09IlRTIbed5qEK3rxQLwFwTU2AwU1AG4YgWa3mhkOSDitH9AZX6H2G66fSqVKoHCF1aMhvLGJEuORPciAhWw3vFf8kPAi5XMkI7iJXwCLkjYv2AB1AIyXSIEZcgUx/mURpoBdxY/x0G59C/6HM7kNizzIaRjMdRXUUU1rgfz280sbQzziZitE9ths2PsrO9KrK+UaXvhs9SLwEl1v2wapFlSQFbTZ5osDarUPyZnRnDA/rTkKTRIh4Kc8jXxpqINV3TiTGCmrhMAmuC80ckYHZeK5/zsYahHQqM9axleeaCfn0glod3R05aT4NjDGou5g+TVbB9vhzqDV6pSwo0wZBy1yqqypfZAqOA0lmI78Z7I1ADQFxt7nGZh3BP2yaxaKeWTCWNIYikmuHWNzQQraJoBNFHOVS/bTUDmZ9kzo8mGlCDbQ1DJvJnUu1Z8z7FpvenP8QLwncB+jU3Bm9keM9xSx8DRaNrAAUGYyUZr3RVDzwTykdPYEypkmWLymggd2rP991QfWOrXhiygFxb6Bl6Hq44JwIhGTYnrsJA6YHTzSKPBJbNxoxxScA8GwgTEG4glSdZ6wf9H0mvR9zf8OmcZ71BW2FF2uvn0fNNni5Z2dD3+6v+fXyb2r/ONNrlTs+xhRAmrMtLSkiWVOVc7+VUKnPIgE0L22Rcq0HG2ENs31NxLNsDB3gR9zrxLTxuzToPiTolAnfSjovDIq8PqKM+/Oq0kWyG1005SveQE4OelFsd/Cq1nqwtobjrL7iHaTBBFVdam4eBgiohfybJ9JKbJolR7HQU70DJX5eZk5arVk7H/fEO4Y0rJdFq1Q/Ounjjjkyai6QinGhW7EmNgSdTYKyvRu48XV1+i293sidN6hf8yFYvLuQrvNOLX8WAPdSNAE2LBkd8Tfx4iRSG8VXFuUd65Y7Q2z4KmVHj8vDni/cPooytSdgGacKzDyKbGl68P4B08z6TDuG1AozwuzGMVr5Twk1xl+kHYcu7uszAHjU3kVrGdI2LcS4Y92PLV78JViO1eL8fIkSy4VaW231VHNyJjBMA+ISIkpHV683y1D4/P/A8ywEwLnAvY/uFecRV7DRgZ7vToW236ZBSDMdnGfvz8LHt499HAvpGmR98UjVb39vOBSXTFUjLRpmyapZ18YyFjcvAKthEkFBrt02Z6OndljjRQOnMpTZ1GfhhtwA6DUPwJKXx2E7I2xyzw23BmtHMCjp6lFuzIbzrpuYob++P/IdlzYiinja4Y3p54xdeG4XJAiXXILZmP2PZD0L5ikjxiGHgnSAS1fOsbvAHkNsEapeFpJQc2dJryfGr9C8eI1G1/IXZ1SwamMuiHbr1EGduxYReRx7YXPL5ZLuQd7k7OLYfU9vteVpcZm/yEO/frGbk55GkdCnj5Gel2aDE45+nNBZpsIAuTdb477Mk35DK8EbZTK5eTQIz7YSxrToG4LKyjdSNoIR4p3gmE7cv5dLq/Vr73ISDQy4zLCMrV8X+pzSeWXT0aaCKb3pG9JVhwhCG6u1yW2vI6bwbCJrJsUCIMgAJeokoampJCYP/bAckaKQoWMnniQblRlxy9RvEdKHwLw+lP17D+EA8GGxLl83paWQkp3wwDyZMtregpYzYwEJeeBPg023r6clBShswun/3P4lLokPcPV7qyuBKTPe6kMp+3sRBFSjHIHDEF6GMEo/NhyA4Me4c5RukCy3XlG2np7egDnCUIPTij0OvqNTJ8cU9MSWB3n2PLsx5kvDIRFuYfAzsi+LTCxRQ2vDs07FShhOQkuy6gnx5q/iwnJQZ5vA87wtfTztD2+zYVO7QLSS8HF9eqCPyE3tyXdwLgWAYVSauV/Im+K8TDVMPx8TVtP0QX0S6DA8I8kiJsnipPuMKuTX8ESXiRKWMY/FXemez0uKu5l14MnvTUsNKs3VJVd3T/hjqxnVSsg9uT1roHmESwF48Fc8x34Dp9chw4SpNNotla1mYZJBA2KIy3bE2ddKH6NKP0qdAqSVKh3rLvZYzTEmaU9hNFu1qGdBmU4/diTXYSrGk4Ca6KUS2TT/XuiNC0zgOdoYm4w4pPdN1pqL/zn1XU6lzIVSRLd6rhn2OtaSPPkBcn3ScQ0TsxTJtvv9MWBslFiaWhjSPHymEegyhrgUZ8Wy7llzPbbfZzf4twMfsQ2U5xstQB0YuGZ7RkINWOPpU4NpB1cobtmon1XWGQm/Ur7aSMjwC52jTq3sYW5sainhj1TrBcj4q5s11umBnerwjMgeWJON2QLL3cBaSv50qybkQj9R+QQ0xVLMOXEfoIJHUcRyPGWH0EU65SNSBw/hGJ2XQ+T0Sls9wIMbpGY4YA3fU75xg3zhByMPFZJ1atUtVo5fQJNzLoWi9dZmc3xfq0VLRhpPNZek0kQ9kibNGTVDFznscxq0wm1WXImeT2g2oyrHCfNjDtiev5fvGOL8ITywQdhov2gr2NQ7MewoAsMdPJEr2DX8CaMVaQRqDzgKNOYSmRssFpMs8RuTRGBvtq3pV6VGppIk/li2eObfnE9Ta+/GOvKvt2Vv3rKjQWa7xtAl/jx+F3LpYgjUk3uGnN1jPF6R9POD48iucsCwAobZ8MuaxD60hLQrOlcRGzcX1yqDM3W6MmTwXTHvQC/lKMdsT6mGtcwEna+PKgfITBQQlxDx9Ge8LbIiQtmN5n7RW4vFLDZwpaujLo311i69s+dlRxgGhF2Oo7mLuP9JmB/LmvOf7kiNQuW4FyILg+0yLlhq4ZCgbdA6TSsbQlAn/B3rKbnD3gPZjcMPIEVh7Oc+YrOI0Gw1yfs6dAVYdTdtXBk2xua62lZsp8gDx/66OoIFkYDEK7bES63XM/U2JNnMozhhhOVCy69X++WdljKwXpq9FC3JEqI55EpKjE1kVhBHvHMAdE/wNaIKLgi+r4F97eCyWygwTwvyftxhpEFX4ukpG/33nQ/DZhyEh4d1K9a3zQ4z3PBVJqQ7iY2HP+p6jQk30TcSBa/Q2ZvzE7+c6GE60lnaENKd2rAHGzUoScX2c+OfFPdtJBUJfzSUimh268AeklerlX8HyK3fvKoo3X5s2TeXBoUxbbmM14ferYsc05M5KcOxx3RMCWZZCxtIw1v1sAe2UySGXuafjnt683HbA/P2i1tnQsnSivfaV/oWEQrgiGADah/tYiMbGLKiL+fU/MpU4s9XQobxX0RKAQFYHWqwyRdfoVCA25YMckOEkujAUoaCFAlEOcQYPKRExbuAbH8K9PIjqobZWy6Do41FeSlsjrJSdPLZDZAOSgEmI3O7JeaoQNGN2Go1VpxWoDzaZMv2NC2tdrTe4kHTRb0Uhn+H4+okM3aMxUGC91NbotaJZLuq3tKMTyKYvwWuYLqNN9pLrUmN+hcALeUVPF9k0tzx6oeqm2YLILufRfd43DvdHUK0xealFSws+/S20913WvwgFw5xZMtrUU4nxD/MwfcTHiIxnN0AxF3/Gmq38DoN6DuJ9T2IcOxVsZB4ohc7eah1+lhuEDLW60pXfpb/kBatc3yUwkkW1YsgUhLdtyir1V0G6IkxDb94W+646tU8e+pgrpd/FnyKvAYPHxdB+D3tKX84WSclZi3k2TzIjQkoXDUwqUkVSYWjcvXvwl2zIjvt1KDsoOgu0MDd1AANIC5vE5AI9gtiHT2E48ij0cq5nwtpEboSUbZFaHvppqtapMR45y3WtjEF4YY89duknD+phNqBO1ho+dttwz3QVXs2dMvROufTZI6XLOJKKJicG5ZQhro8HS9c3pxn3U7qElXqdl4Mui87p7gGAw6qSG6quUsLPoKj78HsXe7c2duw+caF9O1YUGSceFFw8D7GTF0rVoN3rYrBaXNiw/q0aF7JEinG8YWA120Oue74L8cbqXl+l+k4sZb/EDiY65MFwVWydqUZ8rNW0jMHytKrmcUDfcByo7O4ljkxLLFUaPEPDjUastCx57C8zx5ZN3LaFJB1A/5Mu3KoYJNi30g18ntOivVE78tBNYqy3GW7DSuTDjAUYnIDcE9fOucHgHjHZQE8wIJWjYNRaV45eB7cd5Hm7LQQJ/aKWwlUGusXaXhPn41fxtOKVxB3Fnjnqugqd+3sYcfsEpvqyUKSC9FXf/ukl0bK5WZLm0aB7BQQpak4k6+9PLcg7mvDj2ZW8lrPQkvegNsmC/oJoWp0944Q26pNoDVsvRV4uPzK9aWFFlp6U5Rr1+23nBMvSX6EpqDPRtk+GnqSMOKKN5FEWj4HtGixI9xnlm4i5m23MHkaHCp2zm4EitRg0wMT6ADxPwp14hqazTBmBpVt5TGf2fiF6yzOe4MaaZB231vuhP7LSQguVY8KQiiu3Mn02xGR68+R7YHpPY9aI95MrxnkmH5mtc4rLUVnac2PG8zsGWQRpt9Vt1HMci/uXWDoeaK7p6m4NOqRn2UCWyF3/N02TimuVpjJW1nGmD4JJtEb9aaid4k0avaKpTXdYMaVkkzQ9mhRNeJpFKzXmvob/EZUqE1Cl3uEU81fnAdgqwVX/vvmoFAdz5qUn4lrkpwQhhQW01NEuZ9G/EvmTl/KpmFpUQ/L+T+Qyc9TsMbH+CyQ3PyvKI5q422NWxPMfRvlh5DQqH/UKGCQGKcoN7MNuG7JK/2dC5XcR/MRGlqe5nqnZUpjJFv2gSJzfQ9LzFHMcv/MYvAaOAORiYeC27RGqlMJcDlMA8eEgXtxUk9s41B+1D9pfcekuXmhYBgx421N1l5dws6aN3iKmbPntzyTHUEOKRA1DsTTdoLai+G8FMfPhZhnDt8t1cpXp5Feo2GIu0TsFVLQR9xmdh1vz2zS1FfdDhodWARf4P6Kvb8FgkfA39g+5rLdHnUWPnYhxjnokpPoGei1x+X6ibfJixOiDzzlAyWQ/bA9/LyPlTx/aZ1mzSCkwhHwQBnD3luGdWpvF2i+Szd8iYQ7jD4Yj3lnnsv80y1rNeCul/Oiqpt6uGDZ6qCE90WPqU5TPj1XkeELxMwM3ft+mIre+DV3LKNOW3uGTkPO6tZfu5i9PFakbIpK7xnX0BhF6q3N+roQz1pa9ndUPAQO8b3FGT12wFaOr4YBXGvoWYrzLJONiN2EVPgH+qTHSU9CqmXP1IyZndxoS8R9B/iEr6JvXM0RlCJGMUABXJOib21IMg0x9AOKRLI7gJ6sMP77knhXzCLgKQ4EYvXR1FJyhmQHD2y/IeUxu/2HwJRNvDKTSt++YxFv+Yjlkx4NneXRC2ifD0GfPLjSBFuobfD/rmuFGvspFHxO2mHJblHIwGv6N9NLkvUziRFMxo2nhf8KBGFYhoBfIr7vO7lFayFXFtsV/1wxH+bJkRE209Z7zLFlmO1Xx4eTX1Z1ecsLkZWUFXKWXxpXmgQc4NqHaUvfFkdiM2097aPeTYmZP+HQ6ENPI7mjNpMtmB9H8zpThn1LEyH5sUCH7VrBoAOeXT91r4H2kPXj5Lxnfy42QejCgPfTZBO84CBiTPjHBG5DHkXlfhtO+V9nU997WuXY0xBjrZtNLpovCdwrg0sNQVI95PYsnWUR5M2ne/2CiLgCZgQ4hTTaOBuxFSd5weDGvGhyUidQ4WHMXDWZHTpfCpk3ry8/Psk3nQ9Ua6C6JFoOzQUp4POfJiNBAYZLmE9zTqQdH1rVxFK1sVE0+Aj+eXz6Q2TjE4YTAum8vNOEMfJIsufg6rqGSV26/sv/re/HkMhD7i6c9Xq80JnYNCXY8wSql/UEx+MgKBtM6xPz/ddgkQhQqhwas3JIzeMB2lzJE87sexvypTZhvYD4OAwRzrWFz5xybdXZ6HIrcc2EBq1ZeA3G9qPzXLzErLhRopg9SgnYwcI4ey8u3o1+wHPZGSCLQUWyRWTkOfnNfgvyBsC17QboskvDMlB8O/GhDYzv4ytYr49Zyf6O8JQljevohEb3h8PDArSj0kn6PStllZlxookVD6mADkICEeifG9iiSN8Q1Y3Dvidw55jfov2vjEGOk92fOuyAshoZH7bGPmRl3aB3hkr/UVolsnF9GnI1n3UKVVoa6bQyRq7nqhlGsGzdDdilA7kg9of18nilJbhSWVoWr9mSRtg0fr/v/pJCnGnf6ifH/xOLHNV3N2ukHN/RyeHgVMNKzPBMYUWy0C5fYxpiY55rrhkmSgO+aX6cvJ5JfP8ePP2QiWtEa6vEPl5sevqT1waWpl993MyAlAsZJm1WiNxbeG5PK4Rx1hmA3tNn9K3gMKfA50U5Xxq0xRZ6VbWrjpIvztwHkT7SJXrOSyhzv96ktRsR6sOfUYpW/ts69othHVDx17x8Oe/a53AL67GB4tbXJPAcEFa6b+uA3Kk4R0A5igUpJGRszywtUsDYQzDfKh5QQMw/yo/dRyimkPqlkwGmTQmwzagutmcBoIpa6EK3M8UkmuvBqRnZ3ZQSRttSNuSkPBPRIsOf2mSUxBO5sPspJeBZcKQc32ZFGufh0GdmOyBJPnUnqdOni1k2pFcNyhONphV7n1yINpfBoIVeNduPiKr7WD6mblbK2TZibPlYlbFnXEFcXV3wAtcp6MtYdnfExQC6hY8GtKjyfK96QexPlHlcJZHPIKqlDGBWl/hvnI1LLTK1ug0kSGwe/2fWZgHE3MmkkZRR/U9b8mCBud/qPCgQ0ZQ4UEw8z5QsQP98WGaGYMXob2KVOWwkZHSAzUeBEDmNv1G9o08OFFyQI0CwG5cEsP+Rwx9PIVzdVubkp6Q5yMreaxn8PzLl5QAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA/qdOPb53ixsQ8jitYWhqpcduPbK+QwV2MkJ+KED7J7ZuBWjbmwsTKXz2dN7Mtq+TEmtZa5c/e3dwHT238jy5b+2UQcgwQoD/GA4D2FDozQ67Vw7l3jcwSI/ZfJYfl1bkPb6env6L+hmvoXYSixORHgnyN3T+TemL/w4J+T86v652LovDMhH+qCJs2XsnGJnz8ntkmXsAS7Jd2BrpvoiXVsAolCYK8+l8PHzE8UmC40cZctsxSIT6XiXiWeB8prd0KdlpjuZ6fD/J/tP2JLSHUVsQvdhPq00wFbrQM9Uc8Rl/0CxRfcgrRSd6ElQE8clvuJyUDpOnwpY8iHQFdQVjOd8HfUffYJU0jhm9uyMI9KkeUqz6EBTo6ib3XpcJddLq+GF6iADvf0QkyEJcmKJSfIoexbhPvdJ3qjwtnjGTb5U4uCp1V5rTa51H72TxpahcJH8hAn5WxsKHVYClpnFFYPnYppdvJh70KL9WNGFkNFl9lPI773FihK2oczSndCmenz59dYvT2gMuF96oM0sUKTXbTW7lQ5G0W4SR+7xMktfs0oQep31Ga1iGKyHLqxUCRSojA9aU4Rhsu7/PsUkG3RJrV9BQE5Ntbz+3vTNYCjH9RT5KhgYM/0Z8RFN/QiSRwafcEwYWYsLjxKPror8/sOFIusML85NHr6MfGZ2j74SgzHlde1zPntw4w9Iu+VKBF0sMiMUECsNa4NcysqjEg6vDASL4s4c+4jdPyXIx33hrNI8b32k2BVCWdFWSzGupGiQRebnoH1Zwz6/6yKC1vzigrvuXrXV2BRViOjr69AOOHKDAqGdAwsS/J0k5BbdsLIF39BeN9g1ZC25A5NeaFm0cUKUVU8fM4OIqmsapdsoTFTqKj5bS8hcPG2vF3Trur/8Ujgar39yXcnIAIofUdIUeMQqo7VOt/uNU5P31l/c+EMWumslo7h9Gu+1BOQYgJvKDX5jM/NB2IusEm3ILJsc1xs/+QoiRWoZlsochXiji/3mk2+CeQzRumMet2S21Xl8dYYuX+fPzEabYqKZBj2WdMSL1oa/cZ4ypt+Sm0LvXsVArBqfm9zWPa80E5yHaclVfLlBvZToWg4R5EDPq+XP4e66XNIVKiraE0FgOqKA8RSm2wi0CmeAIOn1lVDy0N8Zs8DqH+G/uv7/BGhQCiZ1cQvU1fy16PS3YcsSFbN34E4prPBZCf//Ty5rOAaFJWvaOMb7Dm9LdqbXkTwBeP8p1jzvvtOvZ+dAV5Xqly1H07EG9VUviE0LX4CAvNX63duZBE/Z3zw4QolcNWZlo0xVE4Xm3YEMpUsAqRBe93jnJDd+N7k6Vz1dwZtcGgfDTXioz0rVtIDK0sXUtGQGsAV2ftbvwDYW+UeC+41Tx4bhwVjieqNJI08CqCmR80uZKAFiTg1zeGBNG12aLachvrY2Z1JkKsuN0E/tUH74Iwi5Bex7dsZ9k6sjE6EZoeYgACMRt7OBsXd6i97h6oLUlI1yJ7U80uT2506Z2+Pf87b8NXsdtA+8S6mZxL5dWEKY2hdhG8hfPMlw+PCjist/VPu2IV6hZODkmB+dmsfR+YQ8t9zqcwM3v1SKVTxejOUlo6bE/lUKtVpi8/8QcSIMiu1S50RENeqWrxLBhpAoYxsm8BjmVWweED66ItbtppmFwTPShaeMsUr0uP1NhZG87pla4b37PkhB+liORcrsfQEK98dPFYNxdCU56+YcWfEEDxcxWgNT8NEIjS7qDsNjEWVzZmgp04a0/zOBtwM+aJkjrqg1ePunOoHAgoo7r4upO7gl9qrQukp0eksQhMeM5SJ3rLkR06ed77k1vj7+i6/CNZEeimcHad8vElKYPcUzFejtDtdihWWsJbBZUwUGGCPgrBV4o/ptz9+tpfPgsMJjK7sCd5s0dME/WJ/tAERt+M8hkQsM0KwDECZcgQ8pQUK9mTCx8X/fY/Bl6mxYp5uMdxaXnb7DZfreW9xGFdWn4vQP9aoEggoRdxCglzBXPlIVbiuJ1f2fWAZczEakHLQxlLbxLWl2X+U+lTmeUDvii9/POysTm7bxTFj8GvpxA4F/HtWqrddwsCJuhhIP93jtsQq5mfPgJKHmslMaPp+noid/qw2al2V8o9NNd3kzq8qi9sKFZghN33R1mym/IpvuGWT00okCkEdCqC4y5Evywk2MnGZUdIAxxA7Q121ieVmrPX+QZax8YBxYlU7loFH/QgJSiA50KNyugHm6a1OTfVqqYN/HC7uETN+H1RbHcBGUtDcYpO2/BacNbMg/uY0egv0O64c3+TzNsDSQIqKG/EyqowkWK/278sLoXJBvTk669e7Aagj1Pp6F5fU31sZIQ0biRfmLCjPII3o42ABljtsEOEK0nkTVeDaEyNfeAiUFQs/ZOCCyUbmNIH5zqyAZ4IcsFDD74yxe1ytwGzW86MXo8gu8s0g2Gzhpmrnw0Umgk4xsvWVrmRO/12yyN0iQnPqWy9umTRbQmmLBBFZ4aIw1nfJ0RMo1BHKObXH39qUhpKWj4sZy4/gO1hz2r0r8/Jmg0hZiFaXjjn97yGiRD6PMEC01ygdwxax3SC4jlaGPdltEY6r7FcKqe5NdwLr6vDBN/evtA5Kir16+LQWwhIfPaCdcAaSbWShnkxQX6HHCKUw6eUsc77ofJ2IFhyBAAXVdiLCmuaRz5mag6EYelm4hIEeH5qJf6llbcu204KD7NpzxtNT6KWKTxm0c9ucB/bUxC/VyW7zs93i7thCdz4tGwdZ5bMQDRhGIPl51pGZRt0ZPC1cui6nbk7erCJmHt3KYoGURjdV+RAW19Rj4trf2VAMeGqcAZrsccpPR+gTuc3nT5DSvzZHLwlCw9GRTbn9lf85iAtt8Wrn54WteMQ56YfgnVEjFyTsQ3PPpOJL3Qmnq+8cJFPxOC8B10b4j2reMJ4hvodvnLiEQlFVe0pypHOq/IU4FrUkQvglEzzt8rQ+wSDVZ2arDZ5tYUudQ9woR7FYC1FLn1UGA5g4oYHEUZd5y1xuNruZJMLX4h9XWxwpAhKrGnJUh85RLmI+PHqwlRq8UwPwf9JifkszDffwzIwZZvHbQORjvfvon2aMDRncGyiqSdQ/j7xcGoIlQp6iLb+2D3zKj/I55LbdTQtQX7e03wghRWYLOZqjmOZCBU+sTH6cBFSJ5V/ZvEAKVOyp2VG0Cr+oIIE6kQgWgfXcTtU2AmQ7V+9VN7wQz285EBWIy1N5PVCuW7aqWwBRhqWwzWnzCEHTYxp2rCg+oVq6unzXqeaDFYAd29CUonZDmGKYoA/Wh9dv5iMbC6bkVQbsnWg/1yK5VtRHpF5EQi3XWKZx5Y/UZcgyifd6JH+II50YNftQAr8WNp3zp6Vvtv6/qcdpDQ+HfWY0xnOeU8K68xfmEBNSrejwXE3fBhqvp9GWgv4ALZ+amql2fBsgs0qQrEWm2wmICbudRlBAgAVrjDxCuRk/ura8tF+yISJH8q/mnE+ehfMIDWgxKRWgozgKUkvKfIz1NkSv/mHl6Ul7PMNfSu1m1pem+1jPVlICrLvXo2XBncLoneTuAG+OrtmmMbW0Dbi/doW85yB5wIrD2omhPMnOO2U8/MK/qLS5MXDYN8eCvBPlt21qsmYU3Vzim17MM3cJKxcADdi42hgMgfvQWbPuONy5RYwJHkmeEaEeHwfVVBLEDbvpOkVcUQDjrdjI3yYIHIl3PtkPueEhGU15In8sshwHTl1XYgJ+2LcXmcdScQYJs1cTRXuhi7lg7q129pL78TN1DvqKS0HQp3H2CWGorAsRqbxXYEGgDFUh3dAX3Ej+5/Wp39wAJVmjNYIiPS2fMVV5Psb0aES23C8Dcn53qm+81cav9pDdMEaw0U/2Rbz9IrUKhesChBWrCX1A2qcFEWHygM/sl+8UNUglnvKqwcEktGeC4Eabwoq0SQb3/ouGJCGemV8hTQUP6sBnJx7mL65IfkSONQiEta14ZdFUW7B5FSMD1DwMED0X+dnd0tVRGqmuR4UuzQjlethZ2pviX/cmOqm0/2Tr8gzlBpGUQvBQkcwHjujYUul5hKtIjXLWFy7nV+bfQSPvfPlCLtnemX9Nwtpj6GMvJYpSaPttQYydRFkcCK+++feVTuVkkbV99beccEBI0srllfM2Oz9mT5XPgyC/J2bQpCU7k2bnufX47dknFUzkFUFVz2xys7zPTLTsHunI7rqzUVVuH4YfBiETTVjK6Ht84MujM/MjADJPvAN4lXlrXB5ERq5lmw6oJr6O+CwxULam7TMKwHt5Yc4U23VXu3IdT1dLwOHtcy1pHbZ024jnin2upgW7e2jPmvQEoNo69rQwhwEkTBWsBsvI/XhG2wQIp06/uy4iIGpYkD1SJR6v+mWysXdvHG4XMaF4uW4/31IiuupdRZbaxDBQvabez7gn62/vMojJlq+RkwMfdWvfFL92NWoZRh2FDuWQfy2IQyKIHZYYHDlujU6KEBaeUz24ekw/A94a4pquxHYSH1sLr8Dw8+x8BKVKcbtGcJV/QVSvuCqu8qiCJQECJkPVPwrQrsBwly9BG7VQV3Qw5wTr9BH2uQsKZcak+91QV6KdLCRTK6Spx3k42etyBad3ia65lhxveDwVtQnoBgfeKNgT+iZSYkwb72E4Ps4XDgzW3tXeoVk8y37mmG7fHkeNqwYOp38HCBXypHI0CrMDCHKz73asaI3eiwgH1maD+R/qaxtgfRK6KAYsGgvjGNZkVtIPalF/SU19Q75+Ay6mNFg76wsgNdjRoH948gcRPsrRyPJE/75FVer2DlC5mS7vmBVn11xAho9sQIxtbNAjCvmYL4CgGdwtE8BrcVK7dDJVb17Gs2M+57Iv8P/lBLMmqrMcni4MJ4f6wozYmOEVxTs+/IojTiiNpCTsi6Yjb4yoPqXBcNbf4NtcoD2rRwDktGWawJqWtR8O7HjumTvddgoIeumc6H2m4g2wTyw2lMTk0+QVjusfPDsaO6vHQuuYo6Uzc08Wl2Xo0TtcquTnVTRiSrmSlo0kSbvH7dksEEzD4iEnOeS23VIcSkte46yzWYErNcRLSMVzjBbxxM8zG7KMlzt2EjKViWdXk0jfpKNRSf8sJNSG8uLI6WwgqiYUOKjMExdN5o7X0gxQVqVZCttqZZV953PAU3dT4GYxXkmg+pdEFpg6gXLIllQMMqPMzWl+ji+isIMgn2z6mzR35Ad895JXzcY5OHrvJhaV90QB7/v9+S4Hv9WKFvM/v3Q6/E6h5Q4/nr4v9p0U2JKiIK2esl0SV6NUt7t5kJ5/qaYkGrE8CVg6THUHZUS2DS29/7on6OlDtbczzVGyEgTIBfn26RVvrGDmRq8qnlR/3jyZI7WmHrjyL8IydcOtNKoUlaGc8DT8SLO+IZvWSDleRiz583S22GsrWeLhsWxs3k9b4o4ysGoVq0ZsO0vmirGBlH75HRyT8PHAwKkVMrbzIa8vXnXH2IFgEYwimTYVlMOu5NSDt2nNs+77OmKcbxeo3qFgP1tlrOmrSQai0Ho4Qxg/Fdh9JLrhLMsySuip9bBCum71Xfici2sYDDtPf1IkGcSzcNH2xpoY3iM762HN0J7iXt9666KvpGte+x67mmh9Dz240MH8EjKhVYRmSL4TQbTeqTW9EMWme3WrZk7RWSg7ilnF4W14QduSK3Vsob4LSS8XKtUG7+ZUriBZI1eHJJiYqaCkKe0hGLkkW59JOKNpd5lJyrTMyBT7iiIP3pP52JUCNWwLn59hPvzhXuZWQai7ASI/h1xglVTXYyBudDPpPWDIjNeqZ/ecMidr4nX9pqzoVLaDZXqOHPslh14jpMC7f+wNVdh3e2uEDJO45ij0XROK636GW4ZA8I265J4q66rC76brbQzknL8dw7/BAMOxzdvZ71liSNXKUY5wrBUchogqDsieZGzZ9iqWONnc8no3tTZIp+hWIhcmUT1poqhj5Ew4gFV9LvWnHLql2X030n7bY11hchgcXJO+sMRqWIw+XcFW9MC8SwEPYPF6OlBeNVM9h0SU5qiyget4OiQ2LYQ5K0f5bSn6tQ/KLaEjBXIz2HiC3jRucCNbjYIFr4w0fT8ep/BDT5lUZDa0BCyWOqjaUTxKPCNe7CfrN6/Ok2C9hwP7cOgLLJ9PNApL8oh61oFbVjBpU9xs3WqgQYWMmuym1MAX476E5AwrP4pwpN16rigJZ/HGYIeDJoGe/AjjnEyPS5RFJhMeyM+P0l1w1CcAvgcJJ8OSen49Jivq2bbN/Co02nYkoDtlxXaiRvDRBF76Ln74gZEF+O639/7RCGoPfR8YGZKWtstrw08j+bATH9QAC9AiiQWBBw7e2iCIyMiuJCbOTQAoWQXpXopoTg/gwtZ71m2PU0uWOlIHdEilwtL7jp5mVPsuXOzwqGg68DxZQAwyEqF6LU1xtjoFI+auvhYVq5OWzuIG59/LHfHDepsDuyBeTzTCWjxMU0hywY8WFexajJtL5oCfpqr8W/ij6jnc+5PTgTt4KF0jng/yzjEA/zGaYqNblGVlrOSDKf2ChEn6kGaUPwHtUfkk6LJvecySFzRLhHeMMbrBfJGauCg7dNCjHwac9tDwDcUYm3ChzW8bnVTap8G+g4Fo90xTNrGUJKB+UZKGoOg8Y97/DfrIIyug5uq/iVP7FBII7t6zf4KA7AiM8wfsguLfr4cTTQch6XzdWRWu89i2wAYz7pnXIgrTAOZcY13/9MdmnAdx0G24Q7F6RyZz40EX2mwIXk1E0nX1i+gOzTHQgMrjrPHkglSBWRRnBCkSns9JgUa7n0GFdRHQIHSRYnvwRIwIZUylhq61Cu4qzZ/w5JcyMpn05s7wIXzHhFmjUPk6cC2mNHc0Ng/5oJM1zC33Q3ufIgzSkWkFv5QmLmx/FDSZd/sjNMlCR3zmthGb3YCJrPfhRIKN7ilaJ5SStZbNhcDgpcrZaQ0VwmBwaeRT1E+lgnr2DXmT7flnjTdK9APZvWUjAurTBxzWq5yOp1LA8yQ7117zBIYMV3XHCDqK1/u23JVgG1geqYyLufpl53aLOKdyDDcc+topY/mBUhGVMCIiETHx66Kwhs9pBBMCTGvubP88gDG/tK+AmteCgCXplLAC/wC+/U+9Hujz+UCLeiqsDvzRIQ7HxU/YtNo5hNTgUt2s+7cLi0+AD6p4S10iGN6f46D36SbFpHdTJCSjKmGSb449zgscLos36krwy3fLxfcS7OnA0MjWNAe09aDCHizEvwuH7L7vVfM68rURYkJInPRLV3SRKf9ooh8fHC+JUqrXQNassA8/bUrxvzMIPyUfctSK/nSSMhkym/r6uwnBPwEp+EBLf/1R/OSneaQ7Uv4ItttDL0P0k68Rgby1kuP6iYSKjtmnqS1juwINeLLQ7a+he/8VEHCmDnJrXrznwiPfoLMyJMoaCgprDeuUIYwpgdDMtEhIkf+cPyPo9TjRKULXcgc9XroQ7wEN7pyvryMl3/MEZfwX3vs3XsbWSP3K7cXx9isV7lMyTKJtywWpjNsnhExUiUuJwqG7DoAorI8lsdgehpFU8DThtTOsQYb3qhYj3DUMWyFBKvkkRf7AZpoh4Q7L6re59ny1cOHojqHL6fs3WImEw1WO2L2FWCW7799cyX+XdFKZPQBd6oFB6fHOxVQLCp06vCl6+Dycqxw1fczjgFWKin2rP7/2ymSXNqQ66Ads8SyQKSzt3HIZqTsoV5YJN0BNfxO+rWO3EuxFBZVO3v7FHoZag0aRZcqAcMS6SfjnOPMbPNVY3acudPWVE1qf75kxjdpLxyaJoc4/ofuw7nkkYd8KYf2w/qSVbpFXNSn4tkEppGuvx0846Jdu8fF5XDRUeEe/pkB9rOjEXC9S3XXa0qk+kvCz+m8qQY0YLpYrVdJOiYUiOQA3pv6nnaFfya3g5QkiRXezGXkxP8tW1+TiJVDunmgGTt2p+N8n2SrdQXFPXuiANZkbbEvONYxrp5mlOSuC+g/VgdHQ0ovwYDaZ+mIDzMlHknyS1bNLpKqYde4ib7tcV9TLWJlpBqQz166+4Ko8jxVsrZDZVr3+um+JCvkjkbuT5nF7+2Q7G9N56VKUCHkm8JDvokNTC7B2o+Fho6TExTNc6l6Z7rI3ZbNETZvHvmAdpn1pG8xC/UOuVDDpwivDv40CB2nFIkoGfwgPDOuGrIwwwjbKHxmzwsJnLL2stggUoX3nTUMrUfpTZMuiz5nopvibGZhHSlCI+xvS3B4nbcItH8z1CmVG3xTV+WGTDqTnZiXHJeU2C9LxVYbCFqfcKNmEmE/wx82GE9W2/3HfvZv2I5y7tasAk/ZEwz2uFLfUlaBI5+5lZpo3FsOkETMIFLTRNkPHdTg29QqLaV3ONNdK5EwLMvmxphUZzs/UiOLJ34X4SJ5X5N4Mm997yA1awEM1oG4NWZ1xQ3ZQEtR+Xk4lHP1ZVaqa7ZZb6odExkj2VbFO3fAxfr8u4S81Nbyh1TBhl0B8+Wto+eI4rwUkGnnskBXqRzXuU7faNLf9hHcxwzkC9XwpOxgdspnNkhYV8wD+AQUpDXJYzZO8Zd/0KjNMzsjFGdR1xvaPPlyxczRJrZ5k9t/BfNvJBP1cZPvWnzSTaaYUctYAuwffROWBY3AN9zRkGwVkxMtIY5SzasAaUnSSpwX4SvruEzZmPlZtaMOJ8QatDK/fAmzrF0atjQcNolI5h/QcFR8sTt6XA2QVFV8zsSoQs9VeZuZ1y4/BgqsZUfe9SXrlNL2pSdjmGiTQM/VZsieP3tvAPuMZVzC3dyRdjY9r8X4qJJR5HkvljsQMhgdoRESp37rbVGnkG2tMLMl55Rv8hq1yQp5yHHx0uMa+wR/y28OH3UWC/xtKCjzsuSGI8z9goptAzgnNfYQySquYYd1IoqgRjeWYVkalW7FVKnF1JKNQb6SQYMh6W0QhIJ1zrXdcQ/ojKWSOWHOvsTrGDFJ5HtngWWN/QQ/K6dzUKXaXYvy1+VngHX/E4k1jL2ZGxWkWA44BdDNGl/p30aIjFahFJ5z2aj6DCr+YoDI88IqD9hqF9KqLQmtCzl0oDfa10VvWiV26x4xAG2tIxM4PfxYFQJ2kH0/dtyCIdcUq2rnnONXSltxmC//OcPgL2hiboUPcFcQno+p5+DhdlVNF9MPe2yZ+LBleVRFSYJSd2kzeuCKUzQ4MjtUgsCj3RWSJchob8R/hU3anWeq7O+9Qjg61T4f+tJcZY7PdjF5xy/s7lzVemp1zJ6wZXDAAUnd8K66k4cuVKnoSabr11IyB+IsLBtox8Ds3TEGfvMkFTY1cUpmyLR+6tAoQXOJpWvJ9cDjbG+sjPXqXOIQyEqzbipM80MK1ZeM/9y01ZR2aO8BMNp52wYeZhPAcO8Lt6qjm+SduHlHcxNwfrvL40+3PrJXP2TBK9e6x6f1UabHomwo5m2Hds7cx1rHLvx01Skp9Oc1j4DWUDvlwf9p9WqV7exbu44nou4TpbTkNCQz/XBvm4hvpABvmnZT7L385QjGq7pT4PUlVFlTyLNVpodK6z2GpU/Ah4Gv3AzbdY1ZT2j+HQPXeBXYBpD4VHyCuoL3bpjIXCqYBIuU28Jl4aXl5Jm0hVOH2sbMrPZLovN5RN+ncwDAYXhjWfxhV2ST5nSRcbZzIrffI31zMB1sPdodMMyWncCNqZGLFR5+GTIaWFHCik4PmoQ4BVWGBNfJjl5Ciaq7G+GUOnNgxDbnvvADoCf8eM6ncTllQMUEni34xQaNnXXWntud2XVE/7IYAbTuPN6+L325O4GJbx2vuqonBZZDICpbcQNY4qaRHkb8Ncd7kdTBkGdPqhGeDNAf2OHiNPMtJd2hGjPUq1gWXh0Pz4q65kROKb75GrX/9H7EXOfCflH/QgZaoGMR+xbHs/sT7qRRmEUBDQhVNvKlLA5z1Y8OLuCCvl+t28NAf5rcXjMG2bQ5mu8rHgpKNTYQ+LIkOF7b+v5FbPSkAPeOEYNWUwmpfmTaJBJW1hc3wnrYl4ztUYPk6SKPk7bpGUhbI7DD5PHYkmL8gC94bmJq6LGDSqxHFa+bLCS1V8H7xVy2ENCZKEgPAsrPQWhyM5QIcqEv3GIBYKfXoAtmSWT2BncHtq0SD/+tBPUOCiOQxMxCa9AkN+ME7uikTTn40tQFUxQ6x+aImSIZ9QHnSFAClqRVGIx61UuqzqN7Hx4xWg6e/4DRfgtjNspuc1ZNsKBBK0HVrjjAmTbZGEA9z0opZqeQp5L6ja73yhy54EiHdeNEaJAh6bwXcrA+SV9nh5RxvHMQvdDBTrUMpCi24cugGifrqKjNFaxPID1xY1GQ8UEn5YfnUdVgYFP7ByXW2yhA2w43uW7j3qVEtiMF821G+3wOwqqCDBj4GXnljFX2+0TnKllcQ0Ftq9Md4HDj0z+DKVHSXI+EBWY2JN3vpNI1J/adJ7r3WlhlV9dinkpVeZHI6Lt9W+/u0cCiB0G2qgsWiMuLBgu+rp34E7Vo01fhPU10axb6HfsgMXz9JkhtcP2wLmbMCfuVJedzyBJ8tTXJO8CNOn+2xwutDUId1BTJfnigYJoBSKK9lQDNWiuder+X7r1e+VkhQW4euaA+aSrMgXBbYtwAcniEcMaeoFOjl0fp6A4SH3eT2EDm+G5zXPUQeK6lzdfnZuZDr8l+FQeYYZrd9VHTK/RN7xzjqpcDvd2uD0vhja+9A6feXZDoFxgKfGM/kXL5x3DGSOLWdhLhC7ILLiI12i+T3IqCe6Oa5WMSug8IZZbj2qsFnDkEnmYyqy8v/2hh86FTFsmbu28hu43M3m2X9M93gZiEQWHYMbhkX3819TEHXclWIpGJxoTWcTEDqFX50XDlPBASq9sRKB4y18NfHGcL4OX9Xbuk70DS+oiGeOrwgnRfPPoB4QJb+hdSzCvnnMVPLJG3+w2Kup8oNQ1uK3QYBdRuuoAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAITx6cVzV1FNY0Lv53AzgIgSxC93io/WFQK1pCNBVl1RLba0LWuRiUHATrQYkW6GQu0hQ9fNqfu3f8sJ1NLRY8hxeVIEytJp/Zq6f7YMjSA4NGrl0Yi588ImTiV4OyH+sT/z2D4N/Ro8ruVn6HUwq06PerOsm/jJMVV17PV/C1O+4zvbhdT2gMJVG4Ja5U9Fyrj+j7UwZqT5TLYARngXczDArimCmzJu/ak1KdOTSvZnfdX/DamtoEzAUoM/MbVdQRidtBgLFZo3e9ulCELvHzh+QHBn+1clw4g/LodRTHbwOD38k1Ex1uEopIodXDe2ZSYut+//hvJmvhzAJloa44gP7M+d5tHOF4CoL3i67X0lwH3trX9uXtIW9/uE3O7Ma82rZUlSO/igeDBYaq7tXK8ZafHbEdkDU40y+aX0hXJ46VxYwk1wfAtb9h0QsCCBgzV55Khxvkr0MTu0BaG31DYXhOHO1mfMvUW8/8uVwoN3gqvgeu3gaEdIViyjoWWebSxd80QnsPhFh8W97TQ96NnF8NsGFW7fApbUV692aSrQ+yWbppeL6Zf2o9nKTYmtIbjfiVfF0H1WKrd/kY1q29+/qaOynpsJPTmXrIRwxkWNoUOCs3HjYmWEU7xNSLwAdqrhHhp1YPBTaFQMHo3GaF+QTlZ+zhIdxwShBn3O8RBVehwNSBqJ6BsKgOCm5uc11wipBWEeknPVvRvspsBwjpFuWZE77nJIh5xdV0a4sbi2iFx2HSkzP2hIXmWsQ2Yb5zwvfR6PxWyRccTbCOj448mYfqKlN8p+9i0HvIsKR3G44wuAcbmH1EaTFI7TleRj2tPxs/Su18qmcc8FE23qBluVFwwGTDWgKRHIkT2Cs63Q0p/7kZWyG+x8/NdIAewxm4Go0DoxilV5+XG/aOVm8v5flgo4bWiOd2H9X0nHy1HBeaoY9TyBfy72OEuWkXcC+s6A3ebp9ks3iqitn+I9+CcJXGgeKOI7fxESo0faUAjSmjlA3I7TP47vWKGdI38qXsqRCFXpU9YChM+4iXwGzmIKg8LBpw8dESlgZC4Nki7r+T1/CtpNmYZ6fvJePvX4xwNhVFRycF7skb6tKAPtkUgeh/+qP5RFLimODBjt4EcMlpAwZQkUVV2eedeKzBKuR4QwEIbD9fFRpEEN8Q0SllSh+XeoZAJ2dNa9GO2eX5VQbkI4PPUU4ZRupcBkY2XAH9wtB+ixFED/PbdIu66sn0JttYl7sRmtebzV8kYF8S5q1u8qTWH5RtXHoZ15A09EQTnwnKnr0s06Nu5NSpUGah+KDjHCtxizd8Mw6Owj71TwXdJx2bJ0LGOFv0J9t72gbUwuWFL/33TPgs5QYF9yq5jYHXZnejM2mCUC3Biq3LJjExJRsBvnlaWjB2Hw6+ud1gEDCtWcKYVpxp8RyHyBJwOk/jj4ZJtuPxJvAcVlzNwbNQjKN5KFjI8Ue+IgLfme6+e4cU4IWG5Bl4dsVvdz/2TUS+U6BeUuPSZohbIuNoCNrhtOoqvYUAg/t7MDt3Pk8nrGlRpUUBbQTHSiyefxQit8njVru7H2lbnkb4eSb4IIyr7Doxq2tRuGA8EqQnh6DD8+st1t7jkyr+xzOSUUiaiyms6wjvsXeEBxs+0uBfnprEQprpvPlfE3FhcJ+1p1YV26tQbS1rz4ROMCo/z0+BRmCnUsJ2ONqXfs27EhviUmgdfQrPCAjNSENkUIU/6wvQDca0fzps3nH/aFSpKCi893nY/3aiuyBO8wcihVGQSwmTgb/Nqo72wuD4qXQbDpaUYXPpQ/mMCy1VzWUYfN13cSDpmvGFbX5MKu9SgXHrgx8M7uBrDbh+JC7LZrHZBD5q2Ryh2iJ//SjyjHIoDAComETSsjAbCf5ryqHMcqsDDiSXdDYFy8/tzH5Ev9hxdhMMv6fRIn7gbB3/QlVbwnPMOCBzjlLMvZC9YNdH3w88NxSgXG1I8L9AZwQ4vfv3qh2AxkM+aJVgGCeyoim3fZlg3ssOV17DCemWYvOjUaej7SVIEE9x94BQUDQHs5/SAwm2p1gxMwMxbhET13geiSpgCIW+y2EJGY8VuydCn8QBV10kf5PDBOaTP74rANXQdeeO2IiIXQraiqLcc7ZyeBh3CvkXQXonFixghKJC7bq+TVvA+N6/ZVykUq3Gpd6+DUNPE+g+zYIXiRJl2AfkTCFf8E1Xrhy9l4TZYKOIVir9VVH6Bfl0ShQVuUxz+waOha75nmYm0EdTzWTjUKDpChvCZMn1+44DahwKD74mv+mFqF+GnwXUHP6FItU7iQjgZxMiIQnzTMpF0mp3T+9+JlgoJGiho+3tPISMlt5FGZIVO3+wnwcOGh1H5dnX0QbO/7U3m2ExJzQwdYv2w5EW4tbitniwRx1GHKOnnTqw9740yttTsYN/VaeAl/O0UdmivxudX++wt2Mwi9UvUX031h1+bFwmDKdO8heRXMITvXDm14xubjdfTezjokulMel6BfI/6ocHPVpef9/JYFbdYttCfMkl/VRhqEOBrGBeK9Sb4iFqhE5OQ+GpRBq4cJUuiqpatPxAGbb77804CLASipLxw7E2wVvtIESsh78YVjMjOi38yseKFLitEJ70bC6+txGoYDbW7UzAP+cQez2Wd0is4mQF7AmloiPJoomn044XZybEfJaG++HkOKCHm58bhtNQ64s2rWzNPC7RXmDNjWL+uTlG8D5MrISAJrr2tgUqysop+gns2cU8CA4fgmkG/TMbXx/GcD3Bek31DJwQn9WbFWgRLZtKEMxxKdCqDkyhHsMwScElvhh6zve5UCQY660hBLDibyI6byxV9g1ha8N/UholTLjgES5EXuKzc47YsvoFVv7AD4J1ccIMWb53pTgv0SdFvkuCFr3Q1yOPyzw6zmqMUZ5qMSFw8ZH/dKVTPt2OmTr1NER539JQgqu3CXZ1zG87HJ/2q81TvFh7jP9l73gm+EQdXsA5/MSMtQUL5lfaGNI1OgvgoHtZwD7ro0CPmbNDuTJqe/TfHfQVSNJyAPNgnNDTg+kqJ5j9S34gLwiLKzItMwIuNeqGkZ8RYUzyliDboHBksa44+bF6pW7SjAbuYL5apy/mBZuTSd9naFXN0azJA8GG0ecIaKp8mJ4QhL5xC5cFXjiswpTFv+wIgTphIXxXFBtbNKdfD+aWepL+rBleNhrY/JedOMOeuqW1FDATj2Q1PTl+NpOouMRVfgzExb14icNgH8n2Scw3r4N4Q74JKQ+nmvMiGhX6s2qu2J65upu1mqjH2htiDTAjkdbITRvX/3vuYQOZiakGr02LJKpC/9av2yK+WjdRWn7JCF4dtddiC/3hhslo7s5Uo4C+IYh0wbGWwOw06PV4UedkjD7D05dAtifB5zs/UX7nsBelGU611DXoO7fhy/fD8WD7gt+P8ZWPkNPtxBVueb7Sw8tnpfMMT/ZaPnYKXF5irzOegKRyLuaQMro78xYz6A1DZr2UdZ4/1GlHHo+aKSceGBmtmQuEROaowOAAxSwav1Nlw6DFKHenXACW3u79LnF0hId56Nya7DWD+vwlJllKRxVdUkdbV1QquCXoaNlDuC9EfUkCwmb4OderXsRd7v/0NC4ppi42ripcIAldzfndr8fyI1eZ42POMUOA7oleXZvgngZj1dLzVoHxSIrFvgmRiaqnIh3F3HLwbPLYrIH4k7rFVJbrUth136CrLvDhu3niSqfyNz1lHmWffVVWlByTmhAy9Fnkzo0eP5iaqhUBEa3WXuSBpoulGO9NCHXDxRrGSpLOwBJB4VYBIpw5u4RMOmLY+ezYIksJbquZG49qG1DgNFGXo6PDfGG36+8BM/ZYAhvhtW9gFZnVZecMtVBnbyUbWOjBA3Mb21tzUgPzUhKYnovVgI9b0L14Dj8qkq/s/CPK6MuoFYdzBFpFnSBK8XJM1AG4JOeWL60lQcUTzq9f3952Ntr/IcqsdeR/yPMP9Bf4sfYKcqf7dmsk/T+Q8RwT5qWpPoTnfwvFRcCSE4wEMlFXHXkTSvpriSlIzwHF+mqdQzuKYRk5SGz/5YZ0KCqI+MNB+wrPCstDuutcAbekjrIu8wa5LkCadp+ig/JbYhiyUWWF/HPjpJ+xARki5ruqnamyw4DJs+4btRdEu4jsU7AhS/bHT7oN8H4Z6qRv7sA35PMHRd8wVECBymrbNWt1ZXQy2Tq2cPSdabOOD8Vk0D69RGOwtKbuaYtq6e57CPYPbYqLt7xYe3lW4KfkFLjud4emAmEbicMuOPgkVV9t6iMDjNaP1KcQAzC3tsEgDkEgMYQyhgoe/N0bGoOB1T0i1k+z6AyqsS3CYJ/ZszLAs/aCLDHxHPCvezKbMPnTY7R5AhR9dj8H/+Vfb74K3rlLwT+szs1+qPp83GMT5koem5ua3i3/CcS/i0iwFStoX3OfQP+vS3m1yO2EeyXkKLmgz+k+CRpaHUoE0QIydbdd1vto7PVwrj6N0p9T3vnAwXVEKntPpHYaVvtsklEF6sYPBrQ1L9WGeq8fu4l1UUTuQhN3KPyDhiUOCKQEVrV8txywme6hFs8at45+fFveveAOhBUWQnTvhVwF6ndk3HQaiPOAtS2WDuVWsD2okvQOm6myrwD/CniexYWKUp2ii92tGOvEsUEC1gmZDjm7xK2Z1NPCcRBGU/tZiNkAPC5ON445AjBRoF74Pb/IzLqSj/lz1R8DBOZdtJPmLq5HARKeREW7NhHfdD/6vAWQ5jZ5SpjkBXan7khDJ32VUBr+oqKkT3a9y36NQCJxQSzxx4TFXK2ySFA0u5h6DrowJ4x12pHzRTo/4sXpbLWzpyrvp4LQnmXwk1cvZ5Nt5CYi5Wa2MmbML9CW/jiLNbtvZgqXaTQKRw4Vo+u68dt8/Kw5bkc0NO20vMgdwbDXEO3qZ+XKuvQlW9OzvoYe+LNxyeWK/AzjObBwzYUiYxf1OF3cIcB2grY1IhrwT85mipYbQAMi6LrPGK2njAQt9sWo+dzkWvFdksZN5sqosW8DnDfZw7XOv27xyOMhxEhPDzc37u+Ala/qtRxSz9Q7gtVmaxYjo5E/GFmCER0AgfX0XCIgB/eGsjWaUGA2mUoK19mJ3mSnjM9UdW8/ed7+VO77KcTCkHMWbU+RwwMzncYajhsKGJPAtdDO4Si8d0zOA0OgD4O1OwJhwl/JDV+bTmu37f4HO14499ShvEMmwLSIW7BoMf+QMQK81Ff9mDwtTuPtr492RL4ssekc9I+SdIBTvD/8+bha9yfAZSk1JSEeow/PRHHshIpHCP7VYfLAvQWgxRwyHT09N2TXX1dH9UK/uWOCmeF5z3eFgqvrVBHil9Ne1a0xYHOfcSRgxJ4nqww52FeBA2q0WUgSypfsbh8Z/ZE926QX9So9HKOYyJ/Digx9b+RtYOoryY1oX5fcS+4M9aPZkRdBPBa3re1hs94Xg4UiPfTYZjWistecHFg5QZHhW49G3fiIxBEynnryZYupA65AInGnK1Qih5vTivKsH/CwLa38lovU8+N8uTetTQ+sHplZ6P+KM65X/C5S2+qySMwfWpV2FUs5vOcUx1er91TE/gBJCwh3wezplbGGGwmQZOm9v5W6+wz3Gs0vZEZWeps/2yu4xmB4ACXRwhrAfyubNMDakhr06pqzr5SiL2+bue+UxQ8m+t3d4cin1WknSB3bSstIO6qAo1/8dYkvJ0HTKGXHph6fabVcFQStZ2Dv4PekkfCbMqmTWrMqJobadsa9vnu+A/jfaIhNplEct8BWcqsU8MP8v4LNDtBuSubXsXSKscDYnc0ra+9KoYFEbfERIZZpfwo4UZcgwu7Jiw2pC3eIO9K3bXUSc5hXA1p111pnWGkSe+U8GA3FN4qlAciznQjNMgjNgGxNRoIIiNIS57hKyYqIaGNJoKrAcKR7hz+O0ueLpP7Z6oIU12zLZvnQPKdAk2ZWJeghVABKENqHkG+OUOF82+I5THCu/W+uoBFDfeRyFlNFEA7gB4/ghDa20fD0/8BinqDZm2x+5pVyN3MA+VpQ7mYF/9c4oS9mq482vhM7f5vskzZCValUQjGH9jeg5hMMHD556fS9tLE+K2dd2EdH1TWof9c0Qv7v8hFe46hrWjWQkRNGmi8ID5xinuSpSvKLKoDN5QFwUbdQVQ4i+8jHeQHFcLVHmfTrBbCDS90TQf3B1br4N0MBfugqm6v7umO+Ad2VnEELT4EqARBDzIqHeGPnZKI9n1k5EZlwDkXh/4LFBarEEg6YCWvsg6MGTu7pEQoZMWH0/Jefpr4B0mzYal7WyrCZylHfeqI0hBgBaWF4w1NL0GaSvBUKxlY3+YrAXS4AJXBwPps3EdJi4cI8PjwPZ+Y+zxlhw9vy1JV91dHZ8WZ/vXzuqwUNDQXT41sytVKbxT0Egw7fMch5Zo7uX4pt1YVNyjJJCHZ31/LSU8drb1OyzYS+lpySUN9+kENVuD3tuR5H2h08zNqQ0rTNSFA5wiVnVoLOOwitKV+mkd0T94lxo97WofEdF6Wem84HRvMnFy5P9tD6HIIvULjGpKX4GCw4UGZA2Uf68Lv4RybNNGoaKNJGH8D1fX2KLkhC4iKxPG1L3VAFVQenzgWLpadapn0+NiVU1AscvREvpQy9HFabyaIylwNchjVVfiUEvGZuz6v2TQ/eGEtfwGwYGLfwid/XPRuNLQPkVK75ycaNS8jMAKz1RuZkEo8Ai5T98RXfCAWSVRE+v2Al1HFFBlHB327lRbBxSbPts5X73m3DWcslU4g1qRwMHQXUflrefqAS6e52xV/fDQDFDkGJidFnIqU3ux1XAG9yanMR1vjpRxb10HgShhkae2Cu5i6R/Xib1wKQlcKMJtZAePNn/fgKpllYVmbAnMfS1313um4slIkgNaMNj1wpd9AVlYz5JkYMf9RSQk8TSYZQnhiPC/5bI4vEQ+e3zsTwzCZBXtX8Z20UcairACpr0fRI2IRYLwSkkbECV2dzNJDKWbs9VYwrs/D4H4svNmEyHW3Ea0eYkcWyEsMiEH1CeF+AU0slAmoYMaKs9SSiSlOovkpA1+nuS0G0Zuwnibn+nKFdQ69ErNmL+FxGTDgSxLYSDwcRbZUITzrb+y7mQZQB7H1ADK16yJPrCnn9wtZXftrV/uhs36onc/XOi3XLlSYc6SdDqrfvrQ9BjwxI0ptjww0ybzt5miWLOLceYyi2DEyr17vyZDYYEggcKMgP71hWl/BK2YJWlptNJqYCgM7/m3E9KZdnePzrpaXaoW238S2E93jV8IcNRil9Vx7otIpdlktdjI7WoVGaSOZ8HEMT0lSQYhKlv2wSiKOypmegwOu83/sDKS9rZXx2bx1s6DE71mQB5c2EtaQo5MUIOKW5/uU3B7Cf3XaIGCRFH31Sp03QexOASCOE/a+/5h86a6AnRJg34jbHYI0XC9KrnJdXOMTWHtKYwYCX+KD3yZtGkYtMMZGM1ZLdQJNQPyp3vP1Nb+Qhm02097gtl0JjEV2aPkTeOQsu73zxeUFFq423dWYzfdrPc5eMMa76BMLFNk/tpeS5Ev5CdCDvwRDUR9jaon387txrGmyAadaUCk32UczsZktRsWBMUJ305jgIordMOnhIOqxZDa+MMJUrmUBZnKL6hGY3h+xf4tl3zdSkLzt8EkZBTxvW1ffaPtBzKrJ0M4kZLkMeJLMJ/V0CuTPJCK6+d6SghgHS2AUW5bNC5D8azpsORRpDmS5l8+LnX+yem/lQCdVioFOzZU1KBYeL0hfTyezzWLTAle/klkkp8AwZM+c7VoQjjBsvBHj7ibaoVKSM6u3Wf61Wh3/sL5ijPAUkk9SsQAwhxzIJ8wUnmE3LJ9q1ioZK7hv4Y7Gx1Vc2W13mT2FcLij3j40vY1EYNoqDji5DYN5Iro/5mcxm3X2/3hPQI5PFMbW5zCZ4Dv+aG/XzZySLp0Mj5H1pXqkDWILpAfYbqVni2XITqjyQeKq3W3yQlJjWoYWzMKEKTzmQcALCkkNeVcU2CpeQtabkyCIu+O+COlVP3gdM7OUcDi1wFNlNELsjWO1MmPXIh2El3LzZpyN1mt5xWVigjvdSfalOnrDHgSaB9KuNgGXQBqnVE+3HpQWmAVOU8i0mQH/gA4Kre9LHxkO3PQ8bMvZDf4rJglhQGkv0d1uPk9NQaAeGGzXCachnaGoelDaV/yXU5NJMOcyhiXgF+Z6cxfUUhKgnbkxwCw2zQr9WjFDmkKtMRHq9zLoGc6K2aLSvPtWwrvmdbMhFPw9mnxZKvrhJCZLxlSYeOQuLek/OqpdntxTYCnTMprAv/6vJ9TdQPVQ5T34eaKlwdmT2ku9KA0dH8HzgOp36V5QvEotePAfMgvWRUTmFmrj8q3mFGVbU7DlHXSJ+ZNE57aOQF1GvxWOC1/Y8uUGsXefPUsCqAoOZB2XOA3+wgQeTi1b64BnQvIfcGpTIEDLjCweYBLQ0LUZiHhJZgwlw1LeEPAFcm66+0Vxq6ggcdaCsKHEDUEHc7EAP1hpydwRx7/AZYU+tBSNMHN5GQepKMq4yBSPB822G4rAKINk7Ucu0GAD/aSHbYj8/dSzTjLVG2/MEfZfocig7fUfZGec8aBpbqkwUJl4HDYXQZ0ms0KDN1Vdx1ceJ9a5VWx4m0qA1J/TF0FvpOBKfrI827iMnCU6REjZ4dHGzYKXWrqVQ2K5/zgEkpGuWM04CTmzQvGpIbIYnJHc7+C6yc9xfYXRoxMikKPY82dRuc/jPY8tt9RTVZ1b40Z7UQ0dasCpku3Hk5w7ilfbn8Ik/92Ao9xW2uyhVW4w9jpC7Wa5fWzJemrQjIwd4xL4KbI9PMFh6qlyQ8rxgZX6myttLhjJrmSVcAceogqOgzJoaUhxxKjvMbbqVLuWJR+trAtqkcTHO/lDdGMVGrhqXxvDZNL1KZs5cP9pGIvxrDd5LMkPk7EDPhlYqf8UsB7JpHU8AUWY1BKNxVw+AoJPS/cvkikqoEBYegxTlUyH5928XdGZCvaRjm22Lx7pVOuMxAfH063lCQLYB01MS94kIkMPk53EMnlItAzJuxzVnR2R8Q7GgpoK1ZvnHRCEThWOtUC38s1jXHqWk1weTYhk/TNZSwE9iB3SWBG+GrX+M7wT+DPE/DgoqsTGNcjlmkWil80SDQkVsy6WHkz3bzbx5oL/yYzJD4MlHrcFd3DBVRp91oRipqIkBRxbZ7Co40MrqDJFgCRqOzekrD+oFWI32jDUP4ZCkt9XHOsUhB2RG8mitRvsRX5X+O8Q+ZSXVgXulmYicHjtGwFiiYlxdS8Z/SEypVhHNOgasoqAxc//Alj/bA2XmYI0R3dbdbkYgeBuu9crpCj4JIxc8hROnhnKlqO1cEvwlwaL3mRAsR89iCK0i0e8dwZvvQaH8kcReXClTJEpcUuFmAVCJVoHpvRXezM7NID7z2eoYIgt1YyBbnkrOBFbh+WmPzAR/0dY5NlThzusnMNUJwQJ05pTA5c811P5Ma+HqJzndFJ7ZpWCG1OA7Agl6DZIeqNmg/o4z1oswwyjo2Q+ErYytaJs13iE4Y/pgd7fX7K3f0pXf21lrICqYJoSb0ijTCjRw2mTK08r6h/bJS0X8tyqJKRS5k8BMmac07yMZ9hU+oAJ6usg+uFNGm7BPc04Akhk6wUeFbnpwo933wdYN6DMlQSro6Sa7yaojZlUP6fSqHViC9X372yVBD/ax/dFzgqmjXgc12dTiAL/3mGQPWbZT8UjY/urzVNiRY1flPzvBO/iNLzhIcKpAPa317VS3BPO0i1PzyRgWzAv7GLP4nSDLe/bJMVTg6ml/6ZZKoP5DpatYsfCBCuDOeWjSrS2qyZ+VmhPx798eC4xMaoUh8oV+1rIioSU66B0rYY3FRnSmRrIx4Gz7LpfHkYC0K33080bcd9rWF+tlqajbyUCNnccKxZpLpqX23PCTAzNp/jvFx/LD7HLmzHltodwlCbIApRgpAAFzH00poUC9mT/O7pm0ewVRDAH8kPD9vl2kUAxxB4NSWEzc0Cb1KaB8g5MeZNPwt4gmFITNjGglfGTfO2WYfyslxF4HOFphvAPQsv2a1CHTZeORXr6CozaGjJroOOVspmRCeFGlGxLw6EDuzbxrUzlZbkgqrT5pkWfXrUEu48pQMkWzDWWAUhZdr2MwOpEcHIvUD3V2SnOgRK30xSI29YQ5AxwAU/XpKLw4G4VUy+/hqtB7VVX/raPn4y6wc5IQq33EBF2VHZLLXuG3vS/lIB8wRenktqMUizI2GD73Btgf5QF50gCmh7iE32/Fspa1NUyXt7oVn8GsiQN4XrEoXYbuN8Co91vYhuN93FkOdcFJD9Q5QLEX9kilPzEbLMRioZDrwDRY0vWlN/A7ri+LdTVg0ZtHw1gQ6DXeOnhkuHZkg7gSNzEk/3w2REcawFpRwlmRL3ziwfp2waoFuJNJUSp+nrXMNZUbAlA0kOSh5lUx365YgYhqJRTlrjxo4q7pvPxXxCzj6VkBpk2ugoQoKtLh3vgCb/n1MBgelkJFuwSbv6HUjiA9mA4I44uf09pg07bGVyZU3ii/9pnRP/CUOByDFwt2VMLCK6rnvAMcO/g38vOw5au73S9viq6rNQEV5IHToHkyJVvb6mQ6mkCpvA5q4jPjhN8uGRroN6Sxw13ZHzWEXuDL7h1WmNVhpB4U4zsR0BVQ8UuaFyebHWXYW++7bcN9P48N13X0kcpKxIIUd0+PljP+ZyKoQc9A3m62Zhvy43cDuXNKGDj/pVbmKkjp4s8q+sCP46wBj95UM3mWDmXxkO0WpN2WfCVS5eTAHOFbeJwARAtzhTVqcv6PMcqYTRwbs59InxPnZ0p+YV/EDJ/h2yTZSVV+oCgVJaLRxKTDckwEu5rjcECY+07iadistQE6rNSQCawmWklXhmXuXVTUHD+vX3+nJ/ZWbkgMwEAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA5QN94K/B2NQ9g0hBS79BAwQ+yPV1v9xDKVPMjSA3+i1FcVNLqpTTtfn5jQn7mQvdvV9bA+xIHxDg5dyEHXVb2qXgDaRn/VSU9Ats99LWGz7DqyF8eSot24xjyMeePTTvCY/l9eVghVVCFyb+mb9D0ltg3P55CQCsuFXFzi9620ydiWo6/5YzzuWFQ7rPUpGuteFVk4jtlfY4iopuF353dd2RqeQ/Sb8LlOlk7XJQkn3omc1+4pklO+/PbTq6BWaRqZQV9e86K0A1GfS7HJv7xG1K/S5Ed1cq5nNxYNe5ElKC0OZMroH4S7ni8Q8k9va2iZoWrVkPTd1zo3esS+3CZIMnuO5xFjeS0OsKVzKC9M1WiLhu6QNHbF/eBN48KjKTBJl+Jm7gqY4Yuz6mYnlwhJav2rTuMmpBa8hU4Y4N+b0Az4wtLbgY3/l/jmQxJyOE3yp0WJBYV4QVlX8ejJBO06qtAA6ngdRBGd1cG9Ub2BEimR150y9oJ7AJljt5b3fBWuQqqiptsWiX1mYdj5qO1gktIyNLjfsaJCGybEViwEKZ2Tp8Bf8FHqtcHdxgOJ1KlODblRB82Nv5Bczfj3kmnLVvTp+eT9H8fY7d4Jj5NfhMdQ1wXwwTK9jEZbZqVA8XMujlNCnMqFbT3BGt8FgtHSHUKWPLzKhURqL8rgIAEC2SwjrkJqHI61muto/2whsPTo7XIt1K52azSIEoXoWvYUFM+I8BVRu0CfIX1cc41XktI62Ctihv7U5yNmnDaisJOrpRUcr2SDvR5+TG8PzbMEjJt4n9bKo+KAqkglnl0bOMHnIjv/JR0JBk7IBH1yzJ4YRjWdLebuaoW2eNQvMAh/7lYIx2r98QiND0F2r430Itq+3H+RjqNs4q8bLHteuoOAdZkTT+WK+xFAL5kofBTuOA/0K/kFR5P1FdNo0Lm1wAgJt/H+eMG9Ra+4j3m92JQy5rFbekWuYbK1mIjPjP/fgX4CK/QahjAojINt5S/ckSo0D62Sufk9kdRaGNtfMvQuVE65LmZ+YgoRO0xipq/KKP86so9T1SgeO6SzxjyvSf+FTg+RzGcfcfIgwXQV54c6UOe2vvxfEYeuwJlpwpyDLd5t3TUtSnQd94SUf8GSqtK6WgDvDGPWxhkF737Z75QdF73DQgZ7ziNqT5TVjB9FnsGzecmPSyGruEaAMfuHF67YO2ZVMs44OZbtKkcA7+8KEJ8blAMoOAor2YXg8JofW4VF+aMe98fdr9CrEDLY7Yiy8wDz9KVARdjN6FhX1z4GNysPSiB6316pBejxdxtOfo29HGpsW3JYZqCuT85yV6kIl0vOGM/hKie7KtWkiM10hKd4cQSHCycDT5Tu4x3SRGDG2v1EgVGWCiW8NOIs/7qWpvhhb6LV5ETLTur8k3rxL6RVgP6TRKhsm50zW8k6f7VZGIHNSpxvGqDDk6PFlOMm3J4jEJyXFG1lcngVQsaW+q0Scm5s1fS6FkPF18EOAXy1tu6uytV3U15c4JAy7WVcmnEvVpbZUl7OJkut27bj+rjp6eyRKxTi3qw0QVPXfZ3WMiX1MTCABxm46PWp6nTWHFRpw0YPd89fPJDVCmE0eVnpdPWRU/y/LjL22yUP0gwxd3PNUsc2v2NXFvGFkGY72ip9OpvcmRkS3gEwO42oUUwA6py7FMdhru4w0jShhYrIWyEGiQXbfieP6S96b8Tf4WQ8CXYadVAG1WqRxTtowaYOMxxplHiSPjao53zcvxFNKyfHQB6etzdQNcVmVv92OogksqsnTv4SsyOzqTYtAB0mCvPb7z0705KeIGQC+xvjKW6VsZ4uLS9CqHjc9Lg0GegUDELmviFsODPu4Hydo6LQEzJswx6ekKDUBJW6OjBQScMcrM4Ab6uqF4H/u6pQGGBfvI45GgSnSc7A0/jH0sDrWtYpvKf2MEJorzdDLDWYDrpCG5Oza4I/xCGI4vRWyixPiAa85MrxIyRGsHNAXcq3s83MAkfdB5rcemJvjPcaekZFBUTSEftmRAn1MCTI29wW6uqerOsAClJ5UyKghZvxDPBZGJYAheUIysraqLDZzMmv7vYH0Q3EUBfilzl5PL4YxP93h43b0/rrNzjmQHKc2vRVuL4mSgfYRe5chy0nGRm8QG1/0MrlbAOyTp0KzndhMx+6o3OG9TslDUQ6e6JhAPOHMMDP/f4C1oKNuK2GxQhffbjlYtIiXICUgVjPyzROgzceh+lL9bzDx6RFvtF6IE/Q6OruRBLr+fM7N5zpjdHuua/pHTXuRPCPihKbjk+r08EUycFvd47oIDmUs7+UKwROfIrsOzQPKx+8A0p6K4LW0VCpU6dz5senKY0AQiqgiyLudZSKc5h2+fuZlEMacqf1vPZauxPxb4VVbxehDH5CgYE/yMCPqJdM7XOWpzyAYbm/P0kAuvyaSZSI5J3uFU9EBEuKVszq7VWt2M4q1h7JZImTimn4cQ+FU+YBmkTg7jwEjdHoQ5BFuwT6nMaUIo0yMN+O3fWhdIaud08cuQU6JkB6O08ygUV75vOlf8ojWrhvpwWYnP/Rt7BiNTCpVKK2Kltvzl/h6a+24k3NGkvkW3/9hqs3zRSLmUaQnDpBrLex2nqH4DHFfArgh+zDjuRMbXfIFF7/0TXQ6sE0yiBGuPnI29ME+tPzwEXr/bTsbtPP4JruQ+0v8+SWMNvnk1m0JFvxA78rEXmawZ9pa38hN24XIGIH0hCYjeCQeIdDRtdXhkXQKdaDJXqNIymce+Nnad7h0bmsZXLQqjlQW6HzS2OnjXNtDDPZ5QWDbCOVB4QdezWJ2qdvOHxpgwXCTBazLx+260Ya1qiJg/mPwW6adGXETISWenms2AjKJnJK39TcyZiXk2d9TEFoIaxnIMNdw+52ifYn8JO9qtTaJImkcmH6mrRXFVEE7579vdk4/zFq1qNSQwhQcc9QHz1qbtPKqVf8VQfgq7cUaDuvsnt0gBYv9ezOslknred/aTm4dqRTmconBErSJQOk5B2qxyRK4qqy2d6nG8D8dgOK3k/NHbnhMubQZOlDCMjGwGHpck/yAgtfWXssh76GWEJrh149+T46q5IBftwRT3SuvLt/bswSsczAYv4H/snMLLVtnQZcmdjT3Olsa811K+RzwNxCsijDhK1l2EvYFhURJKPDbDZz8epSSPFDBY+U7frdwS2YOpZePLX55hotoPghXaKiemv+7zaetRd/ZHu+pWboohKtwU5cHjEQ1rFtn0Fjip1LBDJrSymGHOQ9LmhyhUfr3W8O55/k7Uskxmu2jHLxfCML+kw6LyPVXfAk2JpDrjDDV5dlarL5fIqLyUL8YP8uEUD5qXPX4Li3dpglRXCYAF+Rhul55DBZPVffjw2rf9mDsDWIzOjEyrAyoz5FG2hQenSCutcPtvvDfx5TlEN2rHw7/90vxWMb/y2x1H35sjlIAokDwbzUttW2CyEFJt0HYcBWdiMuLoHYZt6y/G/vTKJA2vQbFHzTUbcghTTt8CIoezio4mukx3B62bpaxgjNXbgSiEBS77WWOqZ63aMP37VpkMh/vIo69D0ZLE3wtIo+9gYVz0NEg3ln7TFspryKnPv4twblJ2fmG39LG1sw7Lc2Gv1SSSy08JmhAB6454quHRFB26fOHbun/oxs8FLoPgbbVwfILDZjwju7NqIjcjZt3YkIMVqs8JK4cNLjfEDgH0u/j4p3XipwViqEh8Vuq91oQfJ8dl+Z+xEBlv7tZVTI+b7G0pHjl55hephL1AmSDqkV2cDoUblNXAVcDdZwkA1ZS99/8A06RcpYr3LzXeRFi2/n76UG46wwktFov1X2lCh+4jbuiuc7cbRJoLCzXaG6gZeDWuHv8S4OQ7HVzNRVpSxdaT/1sCknU/V0IT6Ym8yiB2XBWE77l1bPsmQurJ8v20zNc9HSfGWAs2w/Ulu7WhbNk0hA0A+Zci0ttoKNx2k2Ka98aqTB5qDmqZ5CWPBu22Ah6/E1y4o0Y2XSjk4vQDhqeOFaLGY8BbpiNOAKNGiZGFsI+nsk13OpWtwYvbqoGI2jKNajMx6Y1fchw405r9zydXExfdhVHqUSv5zCOwu2q+ybjATqGibc42aTrGKhcFnZ2kFQ8mrQRjBTwXFAcocJfSAr9apYdcpLxjoH8n6vY+Uy3EV/ki2ABN4gjAW+jD7yzRBFyGlwmZX3VAQ9FT6pr/VZgM/HLsR2oAue/NCMAbcANyDTD+mZ/sDCb//R1apoqiA6vJxgdW5cNgNhL7/alKJTiWNHKnj+iIu3WnMyXgrhguO5TrChoYwDTEHXcWM6Q9a8g6Wof1yMKnxicFa2P6nIPe5UFmB1qIb0148ZX4otA7zEflxzsg4CyeUgEbVk7vgqPiefksU9wZFrydu6k5fsIwDirngMaFC1c/PJx1WkB+7+m4DLBJ60dFt64ZuthjwYydBY3lsqaIe2NDBfS079B4jAAsswQel9j4mi+wVX+k1he8wlqF74KriMTOzVAk6oiTz8Oh78JhkyS+Goe82YPolZVdfkB3Jo46i5cRfBUgqxv/JnWkkvGzx5+3CK3D54lluLzk0xNTaL1Ehw1DcRHJu4D0HhXkIo+i1tnyaHJwCfRE/GFlJ9+wfp+W2PFmdgQ9PBfoaIlwrvnTFg46yDeD/upmMKyWN7yIKG/1ZM22fhFZobf7pFTOJkdiHJowIKVZOBr0G5RP4rq52WLsfFPvslzkH/GkVlSpn0bZfOIMFDkJkIpSjN2z7OY5vZc2aP//vr3uNZpLpzoIZEH0W2LgSb3ISOmRVGberqMFMuruDjG56NDOTk29mstbZ74sNuCFSSNNOI3XlVxlGaAZ5Kb11Me/pfbS2anK7TB25YqBYvAKg0nX+euuZ+3AcilrFDrUJCR/GNTwRL4YU+YPsWZ9VQFAHwRkDYtqklzkX//kEoiPRq9oqhbMlYBGHIpYGFPv+gc7xjRel1qQvb4ayn9vetZwdgqcg56w5himmWjTmuVEBkxZdA426A3cJAdFsAcr3LZFXMEir1VWDGKraxo3kh4yhhQi++GSivDRW/YuNbYKJLuX++EKyD/AhQPiqxo4+lQEfU6QaShGfb9QpO+H8hw9U1970MACkLjTl8cuVDi6HO9meoPPlPbi0JGK6F92tCLNp5M8ACniweUQUnzTJJ9E2wMa9q1BVIM5Rg+VbZuQBy4aYl4yk3SbA2yMQ44mjN1yt9/PTvsH5LAj1PIHKIyEiK0b1xRsdsHXnd+TmA8O67OHiGKcRcsyuYHm0BMliVzFo7eeSMfSUJVc7jyJ07u463iaHUA9PqwqYoOpvnjVIMjzV2yc1KCtvAaCWc1ABzMn59HG7Wi2SPDWUcGcAu73K4yKiFpG7QtvMGGK1niFkHMf6hmDkhGdetu0H/WUingEyFsXR2cG5N+/pNwo6qjInVBjxJQO9cbSHBOqYgbxxN3JoHzKe81rvTtUBndJF30LXlsNqKspDTfw4ZCTa2SWmvqUM0CDEtPMlOkkD+5MbExww03UJg1Zv7gHPBSLi15ob2H0aThTHfJAuKaUoub0w0xrNSh9zIHRX6nLvaLUpSPK6z/BA74igS9i18hEHY4UWNg+ltE6vN1/21jVjS2iFjjRhqhobWWUG+Kr0BEIPF0Q8TbHFgPspj/BgsI8uxAsMbsPjpTjOawf8q/tXUuqpQBEyfrJWsbuxuIEvO+w8KphKhzJY6kc9BdnuvYArCcFw0lo9J1NpdcByqI6D4rXMjq/9BM9LcrrarohC/m70YICX4h8AmjKkdh1FF8vQToaUgX2a7+FZLTKporcPvaRRaP58Yl1H+KEGFNPEePsn1NM59fF8j98Q+laCQNCh+3Fe/7gsXegcTb90C8SKhsdbUl0Li2LLzUrYF0c9oD1YlmaM1ESjI7pUWGjh/AKF42bF4LoRs+BXgMLs7Nd14Y2OVIbhc9scPbHC2ROEYXRlzBBw0z56amtCjMUPPrgMFU4wTrPLYlZo552avyVxVvm+6bUF3Te4tBHAKI0wO5fiz27eR7cMF2Yhz3RTG6Al70i3cZVMkZxiPXrZqc+uZaKybAf0IguxlqPn1pasPvsLeLcv4jMmnC3iHylmuWyHs+1uQM4mOY9qyUqF3FilffGZWXXU4Do5Vlp2w4Czzn8zGIUp0uHrFB4wczjltsLkH7/bH9Xvunlt12KQUswnxNpodh+43SlDnpzhv9lCOF477WCd0FTpDqBFJAkzKTvphRu4jGScgJSgzdTdnJmjwWttcTPzklhLxN9TbmjhFb+7D59jQlmwQU0psqkipVsluY2j3N0C566E5dVXPdDQsrnRXqVOAetVKSjTVCZ4nY5KYsnv6/Pb6bX3q9ldubw082sPqSzE9Fw72dChzrv5rO/0F9Psbge0Wz3k1Cbg+37Kx4KByr1nAwTvgUuHUb+y0/rmJRQBmGxlJfvO0sfJtu4GQePuPqcgdUcGjw5r9Hhoinkt3SDeSOqP3IOwJ86oBfV5sl+KAPik2HEri6Gz8y0O9bdHnG04MDnvtF9IeZ0RB7wJnio2X/C++ccugkvf5+Pakfs7Uej7hAqH7ML7JHfd0CspOQgZzh/ztC0wJj6LCgUMwY/5l52rSofrn8zseyA9PbsE6Na+liE33Dk28OmJsZEEikOpDz+eYY3cTtD1oyfQ8CXaQ7hjNWsd4d9Hg8jvVl67qtrcc3PeEj7Pam9eI7uJM/vAPONUp9h49drjyZ5iKlXodoW/fk0ttP8JoLfuGpFnOsw3uoP5Ol3zt8u4LJRFLyRyEg5lt4vxPDfmaRfChj2i0dU1PeB2k5ZtJjfer8WjEkiQdRSb4zwjL4PMscS5GUlgQARC++X/ASPt9itwwYn/85JyJ9jSTeUUqEVJxa0NypVFfLQFOHXb5/nEgB6kiuEcgartZWNWs6BHhSobvynzFPdkqCu5Ew4ynecNGu4ACL8gydGPvw1tyu66V14V64bXYbSmMV5mkZZXR7RiB2Biod/hjCeNsD1pMzGwk7t2HkU8bZXis7YzUoHzJdyJ6W9axI5DS8oPAXR0cEc4BiS1EsmI7MN6QsYNGJX12MNRJuhKK9nGWhNASmyiRJmzP0rKWEWiyQvzykC0Fkpg3li8VXj/eq474558W17aeqY2OOAzlUFMaV5k4JJMCqPPJ0CKwXiWlKdnnx+F02qTdf9l/JzWEUKDHjVxvvGRcF9See8W4GjyoBsGt/cg8XWeSI3s+H26yr5Vx+L6FV20CccdN4DFpR9byhB8oKeft+6iWWB7LajQUhAdkGGQ2YJwOuFePgyrdDq6aF2leVNoV7yBrQ8P/C1l7OWIREQbtJiQ5zGn1pJXnXg+slf9UyOiHZCDozdhZApQURfiM3yuhcCmHOipM6u4HjwMYeMVcT0eOkAskADXOCQXNmC5lnTFA24nVuTIV9sPyk7dZ8VKky5LjGd5dArgg9dz7wu56U9/ffvbOJZXDJJhFRH8uLmnAKL092lKkQb2BqCkUYehEQG4tPgW6o6X/v/Uwwxun+gtUpeRM8Nbb3A9H7S7HFGhClmubzG57TLeUfrtMJYAODCTHf6k/oJnU1k4HwFtWdnFJE9PPNlpWagXKu0yzUvCpuhEoGXypsQrc2Y7tmT8r8M2K3nBDjYHgi9UmfXYfuQfdqcN7CO8Ai7J9Q/JOYs+lZN//N0glrDEtlFtAGsyEbCN6eWAh05M4TMUQkzZFdJXu/K02ozhJF6j29BJKeAzs6s6eqvAFERmKOUL1LF48FJ1ZPo7nMkWLS8xVK1XvvgIQS9RgCu0ZhRCO1kKGGSjmAiO3EfuSXgEwtZPY/1wF0XRbB1e8XJVLoTrn8FbkxqxSP9Fz/D4bn4XQCoq9ySTf74mE5t7fepQe3C/9xDAt6GzjdaVbnJFOkjBuWVjOu99DGcCzO6apmi71Kquep3X64qH+CIl9RY7Ar8OEN3Ur2ZZQ3PTwIzGiEZxxm7uGdRcI5tP0dwFYdyKr39emL61sFRZDFNHOB0c6EQ8WwpRYjTG2niJMUp2XTVAFsytfBwbDiltWx/266wSdb5qLGgshm3d1alKVI5eIIxbEO6CYmYZicR/JNf2kfOdD+rkq0fbHzC0k0f/0wpKtwcM6+DeQz6/zOUeZWXqfuSgwvCBd1tw1plYhQ/sKByZhvO4LZH8/GWigzE7PbYPmYtc16dTYqdtjzjatAcPqm6IzlX8/f9Ou1bh9o8yBXN7ESBv2zKO5EKIZwXUsqri1YOv4WBI34Tz2lQ4CpYZrIS2ZqqN5d38KZO9hpAbIFzQ+RfX1myoMcKKNIzvxubh/MfbaSx9LYvLNEcjDw5MIY6lIU3ArpWYDH4W6bzFrL0daB66L+uOUuAMU9wQz+mVStnmmNNXWMgwlGvkS0nurZFqazk1wQ6n6nAppDPpNEChS1kyav7cZp2NKLQCBFzzyZa2yz02OlsH5i0d/IXeAhdbY1nN0osjTQIizc0IhBHAfOi0+OEjjX/zao/Jf1SkN7jC7cXzC5VeIjcudtPVtkfMrbqWktiXc1fA/lG74+5MqpjDeosgXAc8Jljr4GMuMlyTvwIRjGfLoTdNveXJttlgBViCeKr1g0ZZvtM+WIpgST0hQs8fpePEsMApgd1rlu+nFEDWx0OiajU8SVz77RavQCUmosDjoHEl81NUk3cLUrH8kLNByK1w8j6Cg1OKlHjuDER8TncGc25/y0Fi1ZYloyMb4KyjR1QzLqDsm9xVXpcC/PPGcBr5oIxufZG+wjtKaXazjtVq5pCSwVkt4fo5M3sywzsnioTtZ9GJxt7j+6j7Urxz5sH/rQ173YdCBsd8QB2l/eP5dmJB2rsS1XydRBDjiYsxxZn5o+rD986XSCFavtONYNJ+5m+A9IxRVcH9nbMp+UKmudkmrucBPC/y97rXL262h58J/HOcnOYwBHCfA19KGfB10cm9bDv+LT1IcwKo3YhViQxSkrYVHzlixiF+0CsXDSnNEydPfZdg3FPx/Rz0rSyR4lsaCJprb0OEQj8BrNCWoAKVUiY33ph4uGVdlJNcuoxd0IdkoA5Yps8mBdAAKXkCvRl8mb3fymsovvLR8/JZObhGhjK3fhCEocBmP8s3X56UPQnmuFVgQaPbaQGRfd9nxwxpW+t3kOJkIeSOW6mDaQlZxEcugcyLA+U/u24R9Ma3s7hwzmGgkBK0o1k6F0lcbcRjftKoF1fXLkGc72vKwqoXd5ob9pIdZXnK4DV7pCeD1acX0nMan/d6Xwg2VbdlTi7AcFm8IcIITK3IlPX98vViC1yMh/USR0jWIT3NmeI7NMt9u8cGJtVx5Wu/5u/ipmakvmrW97g9ULgcLWGybdH+WoXwe/ebilkU0fkmJIJx1FtGUd3Dc3YPJny/2ci6fvL8g626mkDAtNRbKydWfsn8oHr/Sfm+jC6e0k41SlJyW2PAm+rudAuDFcWb+Iq8cU6vkfhTW1o5AKXI1qWpYk8K4NkwXpLQ/6ywCN9yh/smj+r0ZzmePuYX4PoXGxI0QPml+o5NVwtVatZVMrhePcZYB+uluGhtX+fAqsO47h93txsh/J6UgSCJBHVOqdBAoPgyKyqBj7VsvOQrOG+9rG+Stv029ob2iHu3IaOtIRyo3andkzvl8HIcFHo14/u9CYmVwyJPJN/y70Bo6oA1UJEw8++OtLvgO+sFjGonmeaN44zHWR2hziXMW8rL2Az90F7ufoRRi32K5ghCNUzR3WbLz7QJK4b6u5lCyiwymJrfdo8iB8jfTuMb4G56yU6VDo1ueMy8/JoYb9Q/YCtLvO4v/kZZOunzfuEo0O4V4glGEwoT86Q9QRP+KeRsng43Zii2LgLzcd1wb6j9f8lJHi4TnEBqFzMXQemAhMCD3ROOzYJWsxnXPcEjnlDMn4y8uSQsJy5T9cYQDKsNfF9LYo2RH54V199hE2xu0WMo7cWsOT3YTNGD2BjUaeWvd5A7AztmM/T5mTq36cnIUuXFE2Ob9Y/Siie3AuaJJGQABHYSXFnOaE5Zr4X8LLd6pM6twCfvCjhGlzyp2qWPwJUdlAv9WyYvNEDNfgAWLmrdUR0NLZCJyKgbOCYmRppC/Z3jOSqlyx40vksL7G3ISEggXIDWLb6a8evjMvl81cqbAcz+ShJRhIAACRzR3CyEbg5qmOx9psqp069fotrLZUu/6/lVF656ZuWeKJRVpiXUkesyD/wgeE+00GXiFUDDKwJvuWhIGAbQz9g/OvdIC+JbNFw8gxwzMoF6O+j+fSqYC0Cryb/IXpoMuye6ppnccCcCQ+CyYh1HGZs5BBXdK1Q34zcg0U6zX7oHIbzsnmHyTntSiiY++OrtZzhxBAfWwmkHwLH+GReVV7GgQLzSef5DmtamRLiOMvBqtqx8vTaBi/oThr9ESMC6r8+VnQbpOOUZ/IjOhfZzlPZtUnhgOo5nZ9kUkY9mGY0di5b7S/47MA4pSo9kRvjWLkgl+oVjDE0awSTy8qQXJ7GSrVH6MM+J5I83VWjXpFULLIFWZay0tNIJrEGcotouI1gy9AkqyvkJaS9hy9xFnVepvtamjR7r8F6fc5AeQLYlyVY7eb8nlPTZlLPQpiIdc/gZeLJEh9YeFfoKjdEr0uWa9bWAi8a2siExBlZ1Ztjy2t7m23A4nOEJBVCDoRwG9wYzHaoiToiIQuk/gcZqdQficVeEZtnetLLyCTfj09n265Kp6nz/yrdfdVp4wIkEAgmQ2NAuMngdWaZr69TiXMYCsRwXT1UCG0dOU3AJv7/MZECzUJQviwAtySwImLZBUsrch1qCQOSc/wv/JfiC0l2BwHGuMUIj6T1TMXPtcgR09ftRePrrACcAZ4OR2kw/MvZoECXSr8hDxK3HUvHsaYgVZmwGnJ1+0Dkj/mzfwKulLlHAeraMPzbh19teAkPmxPkrMUaz92rX47pak4AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAACUi/gJsZiKRrBsnxkZQTsQ+SJsYPZogy/9lZr2DIKgpTpWKFbctmRtxrdMXRw0GMbU3/CMl80r7Uy3+I2MjliSaVLH83Llk2DVzkxmKR8LbvFsEzHoJeUTlusEV+iwAK9RPqTFgApohivIk9LWiEIt6+OY9lPWcxjD1AHwXvcFqZRbL744IrvLXDOW0gVvhJZBJC7mXiqlJygv5fuMisfz7v7YJLDygtYH20T/so7/UoL3oj7trN+pbZQ6FprqOwxi5Y5vwjxb2+8r6LEx/yQ/UhGWVy1rDp8QJYiXYTT5ZDl4J9RbGhZ4w9Z2dTFB/FvPuFNWNzHD6CJ37U0Uz5fioxTGsgWHDmI1+CInEH1f4QoEQIUrvh9u0deeOWnjU6FaSsC/NaJ6waRj5Ha6oboBOKVKrbJli/wlkm4eV0YGcMfRFcDrRjfbBodyx3yV2/O9J43iWBMY47qkmYkvzPZEqodHsZJbBEeqQkuEeK8UVIeF2DlZrmjVP207MoeP7gWVmlALcD/C1Ji2+vOmtskRtPQQbdfz/5ieL0num4SWYDcOZ0H1yol/lDEoINyCpwkQDcwIz+JCZApmMhh0c6ahB0YFIO7Fx0FoMymnFmIrC4HAMgCAfelzaG+IALGIy7q+XUwJohWYw1MmubnPVKESQuDXSNzj2mAde2Nh8nISSe6zE5NyIv6OEWDEYwW1+dfdhiPH7tP+s7DJuSK8axc+Eh8eARDtWNlSqr/bu6GuMOpxq+0OcMS5U5Yg2XI6cdUg2am/PaqcsY0egLons8L3ukm028knqURX6bySrSzyXlJvSbECcerkjwrnG0upcGK6sLZRG/cMdhC2yDooUYHznMT+TH9xj1FuuyOIBul4h95A91CdmVkVdyRD9+yAXz7LFHLFpG+PMVHmaTz0Lefbu5Vzc/yRLk0ZGgwTo8SNNfxsEeYWiVNaOVhqgb8zgb4dsH73pRvS6LWuusVI1WIhDoDlSaodnbnBm6LbA2essOBa0I5se/MA0NhAEBrBaD+UyAdGbs3Glokv/eZNvU0scTKJbS1viHk9Yvtc4iWA5enH/cZ4UiWpGUv/arWoH2mIfsRTI3HU6B5SP2cSfbglqkM+XYctbpNDEnSjPtCa0m131oRXPvmRI6tgfAo9il3c6h/Ggq55oZo5SuxyilYRZHXPUtnW70+19+9208K6eMzLhsatXRYvzAIGjbGZnSUCSpWU0D2npr5AutEMTyH1f4llhidRxDaZvvVJyHWKVLktysgmS+KPfxJupynf6gYmDOf0YYAcNPBnzg8ChzqPGw5E38aXUqzOzYGfOP2Oi8Lagrb6W1cafwkEl3ah737NKSI4BRwZjBqE6VsrSuF+PyxJX/e1cRH82cUp9zXg701nkYtiVCR8xjCkmlicZcyg3ktJZwyBmltxDL/PFsY6XLC7l9a22PyEHtlhpBuNTvFfzDXoLWbcnftffJz8L1sjQxjobnoZ77oYMfvbOPtRjrDx4Qft4D/Wj/SvxOPxy44yPiFwopgNRTfsjYqdWvWETeiMSAGgxjC66+tN3mAKo183MrXgx44OZzC8QAJeOcQYpoJEyeLMM0U/MGxmYfxTRyJLElggl2fFv12eEH5op5uUzU8kzlA8fZF+ROw7b2J52xe3Cf8nieuBWLQctIBXAZnEbGNVsHWZf2kyO/jKSBsqwaQ939SNXuq2vLteRyGUANTfMoBCAU83gjlZ35SkzkZDZhhq2p5Eo9juBXQ9BYUpjJJYiRjpsYntYud32/Rb1Ufbz9Elkt9KMFoD96f8bO/WP4lZc6hQWBn/tmvCRMKh/bnRDW/zOmmLsO1sz/pzr72pNzIxiSFbO4xiPt8HMttW7Ssflmm+UbMc4kjpdAgOnyluSJMkB1RRYF4rBrnvPJ0Ao9aLY/DO2SzhuntqGhDmx4B2w/fqCZBAdB1xxSuHr/0owK+nC5Svslyecj3YvVqSI+IIRAL1p+j/CAfRuCDmAV2s12lkWzs8hezwUtm7wCU9JvcQHtL+cQ1Lf8nTGATOyY9vcJwKunRQSt91POpXE2OIHPK8PqNrDphcnzg8DQwQH9tmWS/UOji1vTvEf+NRE/Tzxl6W0J0dJkcSdjOXSs5jPEyMlgSQxMCT9ozXsd+VE6RR7+PtMttXeM/RwtLlE9nvrUR6n6ewHDCO815JONZWWU82aDLGOvOxeZfQ1pc/jR266FtPMr8ZBmIve+b7WW1z4F4ukj6KwClcsvl6ImzBanhdb056J1cbGPKLLbP/yT19h4SQyeL3+Rx50ZkLDpN68ih7n8GHuHSs1gjmQSX6c5C4QbXG+S6J2x0AjoPXcK9nbX8ICupJ0BE2glDbKglNtXQvlUXFX/itqSx8C60dp5mVBbNS3LXItPODt+IFnDPUjwt/Qbi5TePjGVn3+XMB6NU2BI/Y9CUZTTIegJttcseqnCaS1eBqSMDfhhPuihjDJG/M61FWoxNHpY4k08lYiL96sq6u4Z22l7Qxqhe1Qh0yl39ZHSOnmWw9T0xL1IvIy7q05FzJrKJUqzqfXSPktVCpmcOkE6cIkwQltZqu64tuw/5/JLlygCW2Vd4pPHtB5NIovZNH9bn3qoDqT2xznxi6nqLUGdmxHc4T6ltTHqh9TG8Y612oXY2kdIrRpz3shAm+hPGhMW5lxRlqrSfgdmdG89R3wtWJSLU2ZcZpBYa1NlMe/HvJSwoCAzxNWmIHmBb8fR3XAkTKhL342Ced1X0JuZbURnio6Q4DsewSd07Kk5imdf1zEZoiwm2H3LgqftHfrrWCTFlcec0gC4pmPAnhVY3Bcbt/wZNT74/9GthJRLXnULAb93Q1tns7lhmC76w3njz6yviFmYretjDKqFxRRcZ5R7I4jwldHF4gYMbnUipmj197oQVbyI01PQewViK6ciVYLw8Ky9tbG7fjiyv77aID+B1WOI+2w6S1ugno8gLVpAAA9y/qoDRSC0S6RVkIJ5qNpFzL93j0hZRr1OiM7h8+PaFpjvsY6n/SB6t/54PecdnOOo7/5erRTXm2iuZH4cHU5/legk/xed0HVbDUaXCWqSziysf6lBqvM+tGSekFFVdTgDmbLOVLHUeqCFKLTb2BESaksYInkEpz3uYzv/gwXXPsEUMq+vu5pJ3QOD/pCblr3uKUBPY11MSA3kcwFMtQth0QcmFycl3UExv2rpu07lLseI8uxs7z8j3k8dS9RbWrdRZEjd9VpMN5JDg5TlVqLF0IJ9kxLyw5lmKiyOvIxeFG1ctmtf/ZpWVUB33JlZ6dNFCPbZcUAQSGunP/X1ZkPILWueMOohmgZ7t/jJbq0JEfZGFiXcGPZLt8YrZlUnGm/s087Hgc5uTh6C6Lc9r6cY55D1YXTlXlK5MOxXEbd3RG/LtZueTD7X6p1pjmbxSSidQjZpq13swqyjB47FlE5eWD1ldSg4zjwhmSDN3ogr3opdCMT4OqTd35jfi4viuSkxCgKdm95B+MpVzHOupGdPWcRsH9o8sBVCmOTS0oCv9QnCfHVXBZmO2wXvcSnYYmqpRtNAa7Ik1M+ZsLAod3aEyhQ02/gTgZ7WOY13m6QAeopnnsBnWjNNafRYHETu6vck1GaQVcrm/z5XGUAdGd2smuXO0saeRsglTi6Fd5uP9lFk7PReH3nCQe69L9kZVI1ksrPfIEEKAWvJ2cXJBchr9/AzMuezeXRYkoHjkjGS5txN9Cr0cOrVHaDOoe9oH4cyv5ghGH5iXvu7Kak5TaKtNEnHxUVY94axo4BbxBYwCzUrOFra6SePoePKVwBPtYBGOBNV8zQBxeLyoYC5G2nqMDDiTlDsmYf4zbq2AcTr0UnRaal8yzWgGiLgR0iTBIFDeKq2YmtEp8nwV7gByOR9XGcvvS6aEpVwPQw9d9h9MhvC53UAunBAFAHkKMT1sjTkz/yh+28qvZYFEDhk4Ce7NufyYNYdKxFyfXUGRjLzKlWh6KyTqTWw63/Eaf6ve77BySXVPbIT+kpd8v2sMSGH0ScoBILfwz0FiTfyMzs9OMRvSA2eOlu7C6RlQRNmAnHOJIcKWgPekmonbP9ndFOHqIR0b9O/fE9oPEHYr2qsb4StdNd3X8cY27OZ0lE/NIzaYcnma3CfXBIeke8uSaZZeN/2Z62GzgeaI1D3xUumopLoyNSn0TnvweC8xw41fnrQXmnTegV+BBPZXf2cyj6+RtTWkgl6E5SyH+dDVCHjMGqnlyYetLXg3PVltPuXgEvSeH9FScesd/mmISmEd6pKOOCXEPLdeRkyqdCk4KurXpd3eb6AbD7ROlu2IwT6wiRzjGouxnlWsFJiLeOWKuHHlSX9Fmu4MCRRRF6jJGH5xlrWl0NInlEjwdePuD3qWnOl/5t6nhEJ1LZPD4iGOR8xQBdNXpX6mGgfYMLrPIFJ+B5tk34NNEw6DySTqndH2BO/R7UixhU5ne/pAFsh73yWuSDO01dPE5UkGSg6FU14k2LTuGiLmuC/usNn004A95H60UIXrAMVkDnZGq7d/4qK+U4lhl6GKNXU8YBtUi53dC/KLjWmQIlqyJ7DOgyBRt6ajryYwSram5+PObdYasaeiksgZLgEatU7Ct96VxqUVB0Q4A/NNAAoS99tv1u1VgpE/dAQZp0GuCLwOjtFF9Xyq/cicnRWb7zqhBL7Er3xUbW9K36SYcSo87FN63udzjotaF1niCzj1eBs/Dev5lGKJtnF+UeOzqOMGHxrNhxZo+EpootlXR3Fu1ecv4VM4oL/SA7IenPYlJTb6mVuwL/bUEkRFiXur3dI+sYDUetTGiZ9J5USf7XsBM9Ie5sZFxby2L8wUCynJFKZhpADaeMxQkqmijKKrNujh/DatzkPypxR6C4JweknOeRR6IGp69H9t8JySzMrl6KN3nEUSghnnI4jrdDfvrJaXt21t3tlg6IHe0J3FFPpDOGKUhUOElHaQCaGqSwb61NLUUgUm++y0n5D4OZX509lb7yEjJDc9+53vs6y7rabNjNEJyI5PvZhCD/MN4my0WSmiBZhcWeabO1ecI/PQoHG/zf6B8x72o+Wjbbz6kwovvxYychzBcHIkobERV4vNQDS0jl+PDXYzaDg8EDx6gWXrIxLkwUKMtTq4tn9IF1w0RS3iobfL6LJGNDF4WV9nco8HybtoUvBN2ksSi5+X0aEzvsWuV8rJ4zf5y9KZX8jXoiS+A7QKcCgfUDMMWYYJp8v9yJy5JDS72ExkakcJ9qMgW0myz3cfGOx+H/sPoJ4hM8Bk9X93bxBSbMn0CLVxXHm5ixb13TFv6zXC5/Ygugeg+pd17tP8be4yz36aTtfYmqwwEX14E+fXEIkb/ITgO3CWqWusEhF/HUyPj3IvzrXxfHypM5tjipxZufsS5aMCJLpzD7j7k0efhAP3EfwSFAxLWwLgNnq2wupriy+WSzt0ptxap1IUpe2iNcGNJpJxh8q1rKfUKdAY3t5o/X2vRAAb8AQcs2dCx90X85qaW0VnC6d+VZVxPiyPjl863cXA+pT+aaintF694rdZIaQX+lJA5xr4pk3pie+tM9KZMCd9z8LKu4C8MgxGEgFQ2/NiZ19RILtNgu8ZT8newyFxj2VH/oLVnvUnx3XPO/96ATAkzO4W9NaVilX9QnDQuAx7IJbN6iXGPXRdCvuWvlzLywLqfwo+82yErtis6ui+F/ALCFQTAAYfe5RlhBfBJzMkNiWG1/xscyTYljkVMcGb8NX/n9/dKkj2q3o/Bm4J552J6On/8Z2glb5ZcFN1KzbYsxpocdKzaTtfZmvMwOha3sst3G+2QGOEYk63sd2ht0o3RwlmxJvnG9SUeVFLhCOG6DUHV+xMNHSeZ7uGFLrA5PNFg5r7gatA6ws7VJedjkqHjLfNbfsqMZxqcD8X7RosWqQk4iEMfpc4ZZiOtifMF93DzysnKwWEVMHCOx/Un2s2Na5T2TKK6nXwQFQrSOxdd87KyWb6gRYdwLNYrZuMpfBNcGk361yNK+4JUW4khg41ao/NJYhaQoZYDunFmg81FkT1DlGHJizCU3MGRVKenIpg9fxEg7YNvePDpJ9XKkezt/Vy69XRj3I2o0q+brh6j+Tpd1aOkMiO06loBg4EtVzXlrrXoEjKKRYNWc60DjFMjwDEnWxpGZdMKjlaE5WKCdF9JNhzH/5plSCiHucbd7M0Phps+VumrisM7lpIKxdPmvPbRWyT4KH2JsX7BA9xCC8DLlN9tqxOtgCUx956QluUCC4haswB21AKPNqSHdqmPBy1HdSqTXk0hroKZku1Jxs0eGzfc2/PEwsmMrKayt+AiwCSWjrYQKHxZV6blspT6m4YB4lMFQRg3GEcra0hgapCwI4ep5zqommj5nxm0G+5Y4bwAVRUZvlCMI1UMxg9bk4UTZSRVpXVSFYbOllEo+JHxXdeBBwE2ZGabiZGbq4eBhhnG8Ko6YlFdvHqPbIl/lnz1obUvfr9R+RamdS8HKvvQJQOFbrCsqPcDi4z+t06t4B/pfvFtL7d74YonmWJAPjxA7am/25gbO54REx3nndD37JRpsa/MXYNejy567jcBbIRpHRBnjn8reKGUPIz1sDXQXlax21SO/Ix83LkL8l86t2c7FeqSr+QjPBFL7nOapmUV7pK4FS5K7jjVaLf6NjeOqSnKp8YlAFJr+lEMZd39lIUBb0bwuUwRpi5kBIJGWhNftWfUVRaXowe4dlta/BExjUPF3qmpP+rHkO+vPs+F5Lff1dfT/iYel0awrMY9q1SqE/XEQNU1YQ8lsgXXNIxakWDdg+0O61WwfStDymece1L+XXOBS9z2+qsUs3sHrbi9o92v0gku8cDU0IcdLoEkMiOGgzrGllHN3dx0tWvgjITjkhNK/lu7Xi+ZGy55Ggxp5YfqTru8rSPwlCIZzuk/+TYpU5Zd3YrMJKzWsM233QikL5qk3xPWKWHDQ4GEJ43dlTHHVioDdgQ6XSPlP/oUx+1LVT79oXEYdBDqOf1QMagLXPWsfZbgJyJ+Q0MWwe+fS2aypRqwnQam7u78FgGG2RGepNeSCCVgRCWSy6bz0hIcXHyZ520yAqOQwYEOLHQMxRSsIUoHZpOWx5VZEqgphddoes6jtz4kTi4YAtZ9xMQCNcmOnX6TT0GzxBOpzsjN80GEkkw3Z/7S3UjvxF3J6obkb6kdP61JwcHZvzhou+RLF8yoD3Ba/0KWgPKQYtiwDaEJjHqcCAldWYxBPalDyUC7MWc8LVJNBLvGl5VC/kOnE4qdFciD/8/tW/WYVUsl8xI44HZSCicWrBNIGzvnd9QaKkido2uzcBBkatlvoONcrGmsmRw2UCSx93fCHuLdVzIOm8keG+QNWtMDGlQXhyt4O44p6+HJgGalXrlFWUQUOtTEOCX1S6iae0mrLEXwx8YPn2mGApzakY5+BTRrkXdK2lV6OkKzf7jkRekcFUO793c2Pr5exiqYpveKar9u7v97SAIPgzGppFikB8kgG58QhbiVRGKreoz0kccB9ubWymS8dc7BNON1YvAhsjcH1HDTJRXIn38qjURm1NKAnos7Com2TpLXbArGpRZ8Z0DzOqcxRqqc6ZleA86yCzGuVr9FjTuZ/9wLR5iSzXqAMHK9FHHqwOypSDSQAZ6kWDjE2d4Y7cGzDoO/6lphxeUp/xfaG+SsF7767rpJlL9+isaUJa13o3tlCqCLyYqwDdkcZy4PJlPlcy6fdGSM07aMWPvnKZLJQBIoi5IWdZ3CTxZvzAd4OXQNUPBHCXn29qXXYpsXWPRB9rVOPwHRU2UYe5PaUGsVnYsn6bUsVTtAKXb8GzZUjnI9h4Y4urYPkfwvDrR+2Tval1Ag5+LVAxnok+QumE/eKr65ok+HKPmw5WsjBJOcQ998FxM3NUpxY8d1llbguAdNHnWqZmws3U8GtlwR5z3IQKBaqjvdOaqFj4KEusRxZxgU+oZ2IV1UfQy1ve6Ljg4Eod7qulVaNCi/h5FZdyA8R2L2RFyhZneKz09ocu5YUCfd+5isnnd6QtGM8lc06QP/hUoRKPGbJDlAV/TXT1HgHPTETBHbj4mPPffnjB0uMgMpk7sApwFrphtfPHomBm6kYM8B4ZAOTemnoo33gdTsBWgFkPRlhxPIqR/vI3Y4fdtt1nSjXIsOdEWfFJnqZqEw8Xyw2xeJDyJ5SjeVulAa5vCo3gfMDvXmVvwRsX9yPWyENdEdXIfDXz1mC8YaR3wOll2/uUcLcpfBYusG2m7g1V2l9BPOqXlEs6d7MWrrLgNnlmxlilgHyqcWDPfTq4EgV412WmObl34Ren/woAZlgeWcb8XNLwLYwPsqdqM7tvRWI0RYLxpCaaRsKLDurTlj1sRCPcI39L00+6Fq4sbH5BPlTvVjKMWYKRryGlBJ/H+CsmPirH+TnMhpRcWRFctqCYTDcXxhjYqCeK/QC1Noa1KSYd/p+ze/KKGB/uMUHF2hgC5eJwXJpXf0tNplAC+ZiMzmgjdQt+W9Qg41a0G0Pe/b1GCDy1ynjrphejOOLU7VPW4qOyabnZmfipuI6EeHY4KXEMcEcHnN6S/di0KRq0VdRzJo8ctZtdfFvoqeVBOuszoZnpYUZPsFef7cO3nZG7/IglHckid3XbNGIX9fUxckJ3DKgwtX0NOH2fqFBE43RmHZH0zG5X5oUjI+RTsUKkF50dBLLo5gePKVL55X7gGNEyUILs/zWZLLTBWjUamQxz4s8QXmYRYAOfqridXQ8bB+2wHiYanch8BsAsNei7fLarV5lMjLefBnbAjm1zg8Ro0ELYKr093tbIoY8T7y/EcSjp6i/PPLBO3tJe3E145k10ncn3GfSV13+hD3Wu1RM3AOoErVaRcwjDvgfOhsodiaDTfw4mt+rzN3ImsBt+i3iBqpmRYdCuYFoym1ICDYaCFxbbE1dgwThCjVwItRQ2nSEh3OghCHdTrx0IgomltvERaT+gXs/ns7TVvfO1S8FOXFMqNfLFRzF/gAvX0LCG1uSN94M23QhC+f9uoXYOmJdv8yu9S/ihRC/icsrhzeaKOr1AWhQQS7ihm8WbKQG7GxqjAUQyhtVibZhz7+Gn91m1oL0xEKAuhv1+hLKnJ0xKAMwHR8UPJd/rZQ3v0xWjlhOI7odHL51z2eGpEqKAyGfpLN9W6p3/VU0DTAd2U5QGubax0LyHlEYyV6Et//BAioPNllo3GHEZCD0CmReZESCnmouJu4pAafvCs0jf9RDpp4xbs8gYNE88CefkhC2EHLsYx1EDPzdr1lI1UxKi0Ztyct3WM5+ra6ch1M8K0ukzMhYBEy3KTannSxiDF657DE8yAlnEjg2J7EK5H4EJ+JvbUOtdoE3XjzqrXzVFUxISRA6oHi1FMNuNg3LUzx/YuHAeFN8GfvYmKOUL5eZY39XrmXmXpIlCX8IWh+4JmMnykkuT06EI6QNOqFBGalSipgkUJ0sWkYI+DXDJ78nw6bSd7CcIRVUO3g3UEJgcJhIrpFPDLTRDgTh2W5i1vVHdRdmQljX3UpzXCm6dEKIUX5shCYItejVwF4kkxoibd0BjwgwC5M2I/rzkXKQ0C8LaVAiy7PkFcX2U5noVwQdaQcQ2KA3Xfh+Z96vMgCV8fmI1cGkjN82znBomTUI7YFwCxQx920FpbdZE8ohULabKBmPADi00axtiHj/QGDfkf98XRB288GynauPLlErbyoL3iQ/ePXM6jPox+Xnn7D+R3gnZqBpACHiyeTzr9qxyU1d85wf6682krulNB86esWSZK5rnzhITFAQVOy69YeJHR9QsLX07efFhQ9shfnaCXi9LXSh1Q9hGAmdbxs0qi3JKwfgBCZahazn3+QRR/W7ZONWY+ByFIl/Hn3zKz1A4mBwEud/Li8OIFLC9CVVl0jBLjRqqyCjoPaP2qyUzKQ/88WD9/VG72RTtfpWq+akEcM4pIc/0Agip3Bcit0XYkxp8Rk6IAHrCJh5ZU2mx5+HTOU0erQkmxJXSs6soUJwxihd4KaJVOh8BO1LMUFxrUMEIySWzFok8ysmt28jZPPJ4NBKH/DWgJ6urZTi4lqAttWVxD0ngN23q2GUtjiWbT6hdZdyQBIIDU8oSErGAJrVJ9oNXqiWKwi650U5ep6369Ybm5D/2YxBIOXqZebTJMAcBKpgEVo7bzHTFRopw0E/w3ZJx1Fm9+E+31dBsXI8gmFGqRP+D/qmzIQQz4mN4bYp8jic1DaVqRnWA6CFs4SFE/p9tPCNz+RCXkyDzmnl8oem9Tf+ZTtSoP0QL4kN1q0SS2qIHYu5c5Hw8P6iJDJyf7abCtY6/YQTPCr/Se8m6ABM1CZBgNuANbzB0nS5avzYTJ1ihG/IQOcgzWNRjrNrKH2Q4cJJkP/2RVSOHR46KNCe9DU9GJz6uXHYUiA3qZCVyfo1evL+Im99KQsHOqExmTxuqNv34j4detNy7ZBcB4q21xQm7G47T06rD6EhnXPgl+6uY+ksBV0pFnRN3E7atlYBb8XXXZXVaw1H/U1vZJQPX1qO/rEWXM835D9ccM0l09cXZ4WitxsKxWOXucAi2F3iskAPv9Uqq5zvmSs/90oUdEXh+slHhno6C0aeI2DOCXHandntElEQs1Kdcqc5fXZBEXy+obiDCSi7wIMFjUaD0XBlHRhz/pDxGoa7g/U6gtOO2g8VcAAITwClKVvkADDKjvl9BWyIYxQOYfXAxB6pLXv5OahTFN5Am6cLe2TGXazOWaRPbdyZD/IbBB+H3gsua726oCB+d4xlmQpqqsF4Y9ala3czlZqpI+DH48AVt4aZf+Jl0aVHILq+yXGnmSQoG8gExPi05p05fhYIvMN3o7hC3mTxopnabx1hCv/WO3INm7NePiVDuSrLoE1nZD5kh+j0sRWG+S/gXNi/ng7rI3OTO9z9dR0Ghd3Z7eHOt1ZIL/7DZaF8AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAMyLUASQ8TiWCohR8EE1uy/d00WPbD15TE7fg50LQ5r1W5weX6WZO9b+wGbxN2vm3KAX2PqKHOvYk86LpbCiyoI0mIAJQzr2uEg7zGwTIDNUNTN3I6tvPKfxpbTLAreRi6+07tHFb9DfTH28tw+42uh1K+05yZ4s62OCouQ8mRwx4uq/zAVwFul0hlrPGfwG8CxMpnG5zwzCr2dPyBZq0ma1L3NvbBsCvr8qE4O2CCC6RYy7ujRJRmWgdIxj+4Jf9EjjAdm6uvqnOQGih9ZTQO47Ukw0HLZyLkWRkPhUW5jOKnbAtuycTWdbJeeLRw9wXCUYlLcx0AigFzbcox3t4BW9w8hGhSuPUwguEmEkpqxojcJs2+DogykST4Wz9BqzmeR6KMJQCe3NvldjzR7mfUHUylWa+WsXuBIvtNLYC1d4XwHLVa90TgqeCSBuKpNIjLbeUOFhwvK3DBjMwpc1TedkBvfQoPaBk53wSR68dA0+JWax2JlutDffK4FGxCM0l4Vmdsp1qpBX4CUnxkQPM1IztSFtx6WJH3I7j7mDuG0B5M2IjKoHUoO+viUqqL8fPpjY6dLwymZ3h1iZe14+TpicdWwdwy5wV57LqdYpqEbnN3NcoKw7CFhmwFVJ4jnpmXTqkWDTn9JHkV9CZSayHf+KgZeNQioJOeo1yWOA8lye7CwSXBEBpVkNlYtM9RRhZ8A6PA8kSCOL4HfooScAMPkBn5FhTr59jZV0voXqxsFL+ZwROkF3JCnmamC0RlVo1rCrLmyGZmnBUBwiraDOCZq72UO7YHFsw2h6H2KipI7d2hEKFEcFyTRya+g3xPZ6zYLDQvxLSek+i3BJK180gptxaQVVBlXiem2SdvgdaX7/GY7VRtT5e5+DnW5wF3TLmSvOopj+fZ6dtzqXiAb9ZRC/y7pB25JvPgOfbenVKmbmIJxwCYhGS+bprJe+csiVurDKl+R/SXqlXk8AYyi2NrtcZ3VNQcXaqQByLOuWr9azDAIdtxxfQmftCbA8+HnfZFW4ZcDmHO52+M1SlcQFOmaEGQiW1KWA1ntRbrEVE58trwI2fjzGQfak5Vypjg0volJbVjV60Oc1tU5LArSUKNxW4OQ1lLXM44Y7gkZfg4XZ0+MS6+i57hY9brcmciSQPeGzyDI7+jQg6vdfMx6WfmbMDZ6tcGjM1Li+e+OMm8EpCabzOy2hDgmvKdqIo5xYTSOlia58Zv1Tb+8KcxZhTyu0rHBqCwBa3I8hMqcQxpAn9OUCl+eWoo9qVjvU1uNNnhWcsfS3gt9or0XcNiWZYaHQbP5r3ZDOXch100oE0q4N8SBubW0Oy62N0BJv6YsLl538X/MUpj0TI4wlPeQMTpo9Xqjm+d32cM856b2pNpAXJ68HlqdMoxI12V5jiezbbTkg9De/5/QX9kHqdkySIiwc9NRyYKRo4mHMsCrMoK8jtYh93mK3XIrDcXlKcb+xr3/YIJ7CxLIUY15jcdh8QdVdowYZWNQ4DHOmuuiD3SCSUjnha107Y7ZOdRKGw87VYBr+5UVVRAT9G1JnRfxvEwpGvcRxieevoQ3N1q0p4smDpL08FXdFzZdoDbWxfyOzaso4/uNXKiEetLn417VdABurU+i8KFRbg2Sp1y3rzkw5qJXNMyHvMSV8ptm64lEegtFF04Dgx/yHOJ7sLK28sufXSqEXZLtoGWmA2vHkWMoGD7CSqfQJ8+HJsD/P1e2Q6V3y5rmadcxabI41tiNMeTYN//czmdevTFeS94JfL5re4/7JwyCBy0q/dAPQtZH39X5UPILYQwGt2lJ2rUvxhSZfSVL4KEzCgST8eoh1giGQm9nt+fcTABi4/SC9DFvep55S6E5DfJdlk6n17dbNlUDz1oZnOve4MraJ/+V7v/H5mktBL0XINA+XAj2Dp/liXMj413ISHyMSEkjphZ2oOgKQifG4m3R82CSreNeTCuhfOtn6BLj5KKapqgxGYbsWrQx6FJPEk4fupUPr9HuUDpl5qhUWsOQYTuCPfeBCc2GtLiW2V7mni8x/9QNlOmMTRCRhNlYt8yEi5ufuHSlf8Eu5K6KVK5uJPy+n3uLGFAGt7zZ0ekAl7TOlRQjhrW4jGTnOa6e9xdqrRw7upFDIhmqmq9WtQFttYW4EW3cutEWlLFt6NnbXqWiecz00JGx163nASvsdltUO7BNV8j+kUr2Y78XlEvm2agKiOsOa1oy+/lsTByCtOcx/kkSAvC+SsOvzuQZOS5z9usJUBANa+hTgwgHLoTRn1GimRsH8y/xS6JLeaFaiu25gPV0YTGyyuB3WMbegUZ47q/i51PywGk4StHII/lSiJytsb5XlsaHprYDnqnNyEiKpUCt0HcgKwlJ+TMaoEhAPZ0QBe0Indon8pL9IZ4Nno+SeVgI2TF+AqftZWuaL16lqCS+WAihFi++eEI9CRCHvHayEt4DgnQNBXck/MtzH1xusCf9KWw0ufRkeaaUEdV8PH6mrfqDP5H7IQmv0wx5As4yOuSxS+DJMpKB97azRqYZg9p+Qb2QmxEbrrfBZWXth0+MGKe3RsVjqFrSIwovXLTL/xOXkfabuoI+2Uhy0I34K7QUkmWrOLBmYEVe0d6hoNBV59Uc6ITBO3py0yfjJVOLAGhe5zIVNMz2t0D57ANsGGEhXIph87iepG3y5tlpmLkLwfF/wl1XFcsJyLLdtGKuPdMtVDVQrj0ne/3Sjd1xx/bs/obnbO5aCoBt01ft+49qowBBL90C7QD4TPebUeKscdWCd9meQcIFVuFsQLBb3DWzUPP8VR9PMb5a11Ghm5CpMpelL5ciCLjA/ZhA1236bg82z1flAvKFtCLwuhmxGVAGst3REF9uaR9XYxlXd76hNXFG0L62RMZa/otXp11kCbmksWuJURjbGX6a7C/SVVdwG9o4FF5kMRH2MyL4XNu1ytUxnrnALwW5avh7M/KSj/zMJAptTCswdibsxISFfk6WVdCNdiNOuUk0/q4Mv5wixyPWul7BgkyGPEyZAbBLgXZukOV7C/XKKSjqvtmQXP1aMpFWUgK2STMnIdPiCOv05X8ZbCQFrUhi6mgBGasmcCORO+eS3sHK+TqSnU7aNXU0rL+NerDm2g8b9JQGAAozRbWydeYi3xxJRG/L6WL6jmLMkZGC7sN6Ff3RyvtMCFX+hsVTQBp4zcs5RHmDEbHPR992is4+cwo/5WcBaPLhnKVOzJl7HyabSyD06Sg64V/f/zYcw8cs96Xq3YknjfO9tkNZj9xEpaKFdbYuA9B5KmVtoXnuQFcREMhujPv/2J0KNqmsW8klen+N3+EaKqQmtFcbkl73Q/QxxKNhW7xOwQCcF+AI1qNfyMQCGgl+0d2oWdY+b2Y4Yu/otcDNKh35MM/tPJzu5sYzSfZkDRGjLcL8NTIrBZJinNXVfICnTlRs7YSwfbqM9TGWI6nSz3toyeHaD+Ft4XwsL8hzIpLF0oR/sOludMvLWZ5aFlKdgtL/UmWmx+82fXI/7az4ZCWGH2fFuiAt6vBGnjGYh6OjoSLzlXarR+GLzx/0BD4mc0PaNnOun/qhqLA4BeCnkOomPkpIFuwamIo5KqACCiQG5oPHR+VKbs65agBV57bjrGRXZf+r8hBb/9ZjrABcE1lUbWM4fpGRtT5hGCvlape2k/4FjUdNI9bYPodc45hgf4JRkyra2JSEyNUGGm/44RBlkAbBKCseGsK/Yt9TJ0Ph/nXnuoILdXCkUz1BTswCtcPcJBj5+NPawmanvQrweMaOihjFisw9tml5sowuslb+7A6+5PHSxAlh1PXcMdTKZbPeJOjpnsnP0Iz2iQelFVm3lN3hlHidAvQfiLH3yQsBB411macKHrnCMQWehWQjewoywYAQqBcX70T5wopPPdsL5XvWjSkB+aPVWU6RqEDdsAG7TZukxBFxgn8EH28zLq87WeLndv+GEaS5O29OrtwYgcwEQc+0Ckso37oAy55RPExHfxKkIyoOMTPb9m24jUiaylCHSsOHT5//HYwWhIdgdYQBQ9xIcvnGgl6Lb98HI6g7qQAKpl6SMIocBpuGLd6JQRf5MIXjUNf2bp+k2jx9lvEM8KDI2b0IzA8kG3lE9w6yeuu2Be01gkB2qzZdelRIJmve1qdBJbXeD+RMFVBT58niIMOhgxOK4OUFA2pErKXCNAY6NytUUL9WtYt8RijaypvBTovGNN150TnY63otQTRlw+UCHcIAUr45cga1Qg9vWb1M4p0Hs52rO0s1LWysiciT0p7OxBfM3CGtOoei5U88uvk3uirrAuiWYth4C9717YddRtBn7DBYY5ozrxLGRRSYjPRhlcIbPpWRfwYN53iel4dZoq1TXl5TNgeJ2bBuYlF3P5SH3uNwChC+DwFfxteRAMuIfETxXdb8fmHVujvXCMJF3dP08T36gOOCKZ1FnWCF5sN5bjbAfW8idbXP+UlZ2icv0cEnTvJ18GA0SsvcZvizfgf1ATWkJa8fyLDeIPoRYSAi8fXSBRtmPzk7+gyyhSiQuZyNxIxLax5K5bgj/KrZSVuYIX+kq0+OlgB3za+ZNdRYyNn5F9Y8xqBCLRtItziO3O8XkFIaHSjW8aGMMRff3RJr+cQ+dqc9xOVv8w1OMxjtYVc/4Am2joptyEQWTE3fsiUueQsMpilIwtiWbG5WxYtkGNlExBzAZth/sUHwcYaa24fmCvJ2MCRHoO9Mb2W9n0A2lontLZa2AMd5s/18Tu5IDugukzLA4+NB0439HrqyZxvyIbIImTBB7WwBxGPsjLvrr8W4bR5xKcqXPkXMkRCY5q8SwcyoyPdRdhttD3dqQTCmM7cxH83XoKtg7eCYqY0hgD36suthOmOUw/7pkB/ZMSohyHnU4bJNWimbggChVuZeFHSiBVyKI2NCPkTWzDdO8HF1RsExHpB54AV5h+FaZHbkiL8CPR8JPbwvZ0L9qxffv5/9O2ZdrLmWe9bBc3SJ2IKZcozaOP37JyQ473ryxmJ0r+kl3TfNUz+uDL0ivtRgkBUbSdjhzBv4QT0RNwxD/goXmOiPp/yerb17jw9/BqE/8qAzMmBzqPxkOB7jia0Ww2SIhEfMYWBSc/zId/tV9pnofCOuqm3JXgxwswOIf22nAsrZvuECeYedH+LKyYa4bEtt8pAe1Ih76wVfSpWgGBwSQb+AeglKthgLUA7koI36/4H6I0T8hyRt8KQQbWH8PGsqFOz+vqPc9+OAabj6rFdgoLiht8jnteImhflHvymyToZzEbbMtPzPFywLWGJami857PJA9BVNSruQKlKb7LYyzIgGIacVKnVFkYuCOaFjlRsZK7mVFrWSHTWXMNj1FSZ+4gZOOFs3oLEYmjQ9si+xiltoF8psUoqR0fXO6Ht/MIHhQgGPiq7XkCDUfs+8jSxxcJI+i+6LZ0ijJO4t+O4Vpxicjd2tOy+ABWn2KMsiUAPRaqQQZEwYSiStKIPSiTO0j8euBfmot3JknAFUSimrDJGJccBrpJx0kL3dxNZ1brCEVVfVmyLnJ0Ible6HtBtbTl/RZp3izDodo8bmuPLCqOuBoYdfK5HpzShkFIYtZXYmtHpBPX3WfC922mfCvRQkMdJyDulD0KMHN4UUfMJRoYFjQGlmSX3I1zebfNwecFlrl1TGPirMvU6sG4TKqwQAAss2uV4zoikzI4FJpb4iV1/Oqu0CANtomVUrMJcc7qfr329i2rhFVwsnVzGO8HoXRAQ52LBnhbIA75Me8EkjJYc3mTlFWoQQRgivS03/1Q+1H4Kd0xwsIU+uhJ88EXEQ9q68yrdzkMkCE/GRhfFCbKFpNdVzCIRmFV1ABxBeGPvTFXpmZXhGzYCqNyOPjwh6B5TNcT8B8zNzLhSQiXz7gkDAexzIBT9wLPpvDP0nWix69QtT0MlLuWUGPEM/Tyz9E9mYANpI3CW1seQ6DTktByUTumZWj7g+Rs9UyClNMHWnkx8QgfTuY0lAtjJtRGxE+bd1MDrLEG17YfsqigEOYxCiwbq5neJJNgER8Nu74sqovdxagyJnYzr50iPCmfHDA7w0Piw5x++3tqDIg7wr+VN0VRVYiVIX6x/QTbKVGA7pDnvzP8gtahhiRYNiugRev3dYiF7APi+tabzrlFaDvaPWRlktZx+rHb4WwDApu5hmN6OF+gIDzAHbfHlQYaoSt1TiSmcJ1Io+PMapB6oV/Hup9JwuBPH2AqI1lCCMJXDUKdGwkvpnhjrC8AFwT9UBXw7TDt9dQzxeos4VCDO6FcHbil5NL0i5cF6hFUqaw2Bn1LUmGzZtC798BYVsFl2gCPGUeL0OI9VwOPHIO/aY0GMju3fMntgirWvyGmOiDveSK8fCB/IVlEO28jeJgqld3fiv+2wwiwsmJgzWmWye7UJvpoD0Lfg1G+iaHKNBd1pD8BLMxvF4BoACxv7hm+dvreFeC3ydkABPIiiC2K5oKEGibhxVRArUncdYM1EFpXMOA2j3cr/0AEHg8hGuWkpjr5Hboduz3y+WFIsbKGh6LQkmit9QVKXHP5hszT5qUopKlaNHWf0VkoUxOK+L5zxQ9tE9E5mvkMIs7Lr/eMYfbjTJ2TZ8T0GsycNSnp2JR5iah0HXC8z8dcgE+gJvyRidHQ8wEHA0I/tW1as0jRHUX33sK0pwpOPXv7p7yWlvT1PIYbxeYWcK383n9kvyVryhUuPoFscIKu67sSL1WIJoqGVkwSdn+s2gMNZzWgNL08b8ZoWGPXFVcNXOeqaHATmtFAdemj5ynsDj+FeKINCBpgb2btBtvUpq5TSUIOAvYFtm5nEdAbu7aDo23GRgvtWmW+zr0e1JVOBTQxRQbaafvjiaBNjFUWb0gIA/Lr4nKub7qOnXCgwzV9/YQEO0hURBF7uMxdlFGOC/FTJ932zrztDeBa2l1zlB037xZx1fVnW+nQ1csfCqtt/90r00LFxbYr6YjVoKq3yyoRVwZRcfRaM3TQSt2fzByomCqklVkt+x8dXHS5QgiaiOiZ6Hfc1Ntxv4WU/C9FukWt125FqEsQKHja3bL9XCNgX8tkpahL/t2/Nc5/ZKRaiX34bnMdkgHCI8FUBX7I2wntjj5cwvEdWn4f8pdaaCvRPYjifazPaQ8nKFk3ZPUbZHqNLamMo0FxmgGVPU4Ar58ahklcAGThM8mfkdUtfwCFqMYj2FT5FlNnfzioCtbG6S7+LPr8rtCXhIySJgHGmmwMZkfNTSe5oxJVu/Gp0CWHf60EIa6/SGPyNGnBATVxfctP6tqQsDmqeRDPBkrIyZi1/LsDfCK/npLrpWDYSQBsjiZ0Cs0H0oAk2wAFltUBTxmmRc8ln9TMZf1EFoqhRCkn3lQqlJuk/5lhr4+llyjGKhwMrbyH3CJgvXYL74gY3XvDHSy1nodsHs/W2EUxduH6STDBNY6B+/6bDG7qH1fx4+tHqo+grvkdqubWyPHEpfwyAEm+DPaGjMVOP83S9TOvZkI/m720gCu3LFxJiibnQfdQbRFKMLBeGD1rlZWSrXyINZEE9lYCAFV5Ttwt/c+75Dp+wTBBqOhFqW8TTrVY6r2QpZkZfazk/S2sxKF86ICq8jtJ/4NpTTDXkWepPQWu+jHAwVTAL2iRhMH/NExnC+E+swQt5RfyH2Ek7fSKtjq5rgWfngvvwdgs6VokrOHBKMaVuRyfy+AKwSm7QA17i3EcHN6gC5keaS2IyAxszFSE3WVNgcnyR+06QE22liE8dy4SC0nwjVZhYz7yZ2T4aK89cVV3smiTY4nE4p1xB7lcdlz7eg5bbT/GH4bJeWEWldqtcscPpnaym+KXRalo7DRhfwQrCd+L0EICGGynKKxAqCnxrip3HQm1KVNglX1zmLT/6G5NS3FP1cOk08efKzbIwEyfIp9mzWUFRxwVEvgFTX+VXF8UkY8AyBckSoPmND8/6bOlKs8/OSMoIwgoHedh9gJm+4IoRe7Ii+Mvj91KBri5PfrZw3XWSo5swL+OGw1WUWoLXWYL2Y9na0meITabvDrw/AkzDEF0Qpwu5IatWYODDNqYNRs1bgiskZHKyt2YkT2NZnbO9hh4TmOwyzoGDfjFkvUPtE8SVoOByQ0+tFUeYL+dTl9ekN+OiRtc0Y+gKli+rQxISOPDw7gaXC0EKYmuXWMLF7pALuXTDg5qnsZoCzjQ5ATyowavZQwkIVen7o4bYnnE+tng4I+FaoXWNr7lQAt/Z06PBU0PoTC70rTEmbsgrDVPE1TpC3XCZyFaLZEw8ZnZo/3HSTAZz/lkSa/KbcoYHseeblOcmddyjPZiE+AkwJ8SJvXKXQnPZ7rBLUeBSyux59YReBAusfGnBACrbkB6VQK3UfmX8MgfJtcP7ASTEpC+fLnIoFba3r6eBBb881uAjXXtH7Nvk7/7Y1vy8gsWrl/eGVL6Oh0DYmKB3myTyX7YJutnAf7HdaUSQygs1vPkhfYeySfu1hmeGnDMCA9YnOfUNWf3pDnbOfCPZa00AtKSn1bBON3ZefQAi7A8+F7QiHAQIfWnV/PuyJ8DcJHsR4CVNfWBFHLQuwi7uda4LSC/itZ893RERKjywoG/+ps7tA0JRzCZfU+G2B11olrxGUzSOa2bejLIhVtJFSd/gJ/joy/3kga2eBPNZJ+UYxM7rErKN2KXXpVODvWodreOS1pdJJ/MKxFskWqVUO6CNZ95FOCbmVTnKvyvwrT+7OnJrblXjOqIClw24l2cnpFcNIPxEMalQRfVjvyUdIq0q6t24YN8aWhkhTjF8fjQ33QjKzgVpBMNU7Dz9A3nHKjNVx9/NBHDXAopxaKJIzMVANOrPNFHjZye8ywLzmnzjMZgbULkLVxEKb3mnEPXccKYP1rOxUL9m4lztMARtI6YHnQA//8AAOOs4YaRJlQEaLJls7Aee+EL/elLp2eh5N1wRYZrCvM/e7TDzDcCxFMjmj8yTjzVaR+q2s06w9ncWY5bJiKq2yErVLDrBGiNX0yNLkzNUJo9ADswjFU5st0WCkXL5x7+Nv2QBcgn+W/fnUIfRpA3RYWAiuBrngiLXikMF3ZmOYj11RTE2EBEclUcLPzi4lrxgS4YBbsVsHMhyxhWIOU1q81rmoNwVUs8rC/0/N2yF4iN8uFEKaVugcKfjz8WHUiGc+LnqEKTwo2saKWhPEiFWbkT3hfUBjwZrqDw7NrGQWXaZx1bBh1R9PIpoLCDyTbgTPqGep+O/725r9rhJ7OEjT5fYB5eWHOF/Lp8eZHyupVh0aqJ2H2ABCcy9orlHTaO4vlXRKclaWime5MFrJyalY8bFpbHjuwxmw7cPGtrQk5/IMZ6ZQuBge3P5GzuoD7J+O5a8sKI5hh9Y0lhbJVVTiWVczQx6/Ij89HU+FqBqdidJcFKNeU0Wv+5wWgACBUbqkanb7p+00jWhMmtbWo7FQK8Az9ul/Np0GyWwQDq2wnwnTgtwThBb25gwBVpW0af1cLi3f0wbCjMLh2xgvcTpANzCO91tfhwKCaLtvh8Ip7gNm712u4yoNKTOShDTB+SCS0jDlJ8ziHjp4UjY9eHRVTd+o4CZ6SwBRH4+wN8vfvVFXC4qlnHls71VRz7RQJ62uK00vZiOqJM4GkBhoPY7ru+MDp1QKl45CnlKDnDpyDFX48Fcu63QUx5DCI9WNfDD84tC6QCechI3AQro+6SnCkQ4ybt7WBRnZ6xpJj91+H8iKa9DTadVg1q8tNTQDDWykUsYdj2PxjJyHaXBfsmp+JSN0H9V/ZRGyOG7fbfhdT1iU9Usmni69t3YjoTjUnKR74MLXUKRUH5WBTiAleWvvWSpaqwfxG+9z8JXeu0YkAygEvrHTOI8AN+TKXZ7oNkrgTmdBKf/+TBMr8Ao78BvdCLwS5d0tlo0KTVUj6723h16Reiuh+ZFqfrvXwC6OU6IsxtRkdVLS4uNCkZ9KcTc9s5zBQrn6I0pyxFiyF9mLBOdJy0dEgs7pGMqtWqWg/utCKlrYgvw96ZdXb7qkOkPeegWHiVntpwy6vLBfqPrpZyJ7a+h225Qq44v+qpw9NZl34cUnnIzGlsx/rET/Lfk/ophUIGosoZZtUpYnJ1zNz4yOxTH7GKWn7N342QgqiF34gV7I8/guSzzvpvh3i6miVH/IiHgU/DCe9XxSnoO9AMw25L5zzeMABIwUdykeWLl1Pxu+x2gMt6yG8BTo9QTocr9sDVZ4FToW77n6CILoRK2Ak4rYOLBIsDQtxqefuN3dTqS2g/FEbeA7sXfuut0/R0VP3di7pMPP2e9+zlCb2rJ+D0QVjwvzzC+/IRjOs6iVoI+yduEqjmpBmZWrKxG+4u24b1u4T1aPUh6mbNcvwQgaTaUZg0wNFsgHp4Z7j+yZ3JPDue0hiHqcoXk0NynTup4pRHmCqBAdIoOPennPzq0P0CCYSpt4dYjYXm8s+wmwaKLAXqLdbFYTC7DOBtyIwvyp9OQ1xqGiNAgffGgXTf+P6QMoDCksV78XmfGjagvhYwdMnmfxnzH/GhITaVpSxRMp1xxRNi3eDgAutks09dtD3o5QqGDoKhs0uYLbK+ZDgGsJR+4Z8LKXwX4ta5IZLLwCfY6EAUS9bUDv++HeInmitcNPX47d+Rk08G+hU+AhTtfN66nWYvZ0ez2eFDRsyx+Toz+EKhY8z/kR4BJgjdMynJ1i2gsrBM11LiMyaaUxifgVkU9m4owaCjR/Rh2B1/LFWEXjXrPcOZRVhLXvqNcaIVMtU3esCX4bJ3sc9xEsNeekuB0pzAIACREEkjl7KCG9mgKdWEoSx5kyg6+dycvtEfBoTnPYP7viZ797Zs4TjdPmbPDZKr8kb5xLhP8c9395XDi9Patp1FBdtj8xSLdU21wVyMI9kMjjXBohTd4tQ4PAc1rkW+9h8QqhocJVmEz3QrqVTYKFIiNcjcb0XiX9e6R793LVCwFaLEpIzYOcywAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA4Xu00wc3/IebQgYaJuBvecaf08YOX5mkKhB4VmcQ4Yig768Po6iP1ZwHeGenr9FdrME7sIIbcjMBFj+VULlRT9vYiCD2vVIAEVr4TTV9n0UfIJkK+/Bzi3IOicHjygnwbzU6Bfec6D68sVxWzZ87n5lfKdEgyG/bRWyC1IKqhAhmjFPOXxwXnXhLqYTnPhFZB25KDGzjWNRS9MGq24tmzbKzY9drHKN0jSWX4h/Uos6UCHR0kBL5W1VLuJcuqrzP7JuUYj5U94r1wyzw9H8JVzZHDzn2MbzadiTIJcVrctvPhKIEQEqNNWOhGpxnQF2kW0ssxuLerVEnbyjtYumjzWEDtS5Wo2qNV3JhjZPZyGSA1iAShANzufYWH6xLwBIBnDNprIgvxQQeWjeqhXdjaunUiVFKuOHIVktXiviLPnoX/3A3oGH/pqAbiqarlHP/QrfwDFUALN1LnN9ljpSJkP+4wGHrilI0L5Wj1BVRwbGeBgskrF50EmMfSmoLaIIKfVwGlNGkY+x3FjB9OzlMlRdwaPCaaPcjkhN7oI/VMQs57j7iAHZ5Xv7lba1cpv92J7nhKshnlJ4YOHEkv62ohiZEiWRG5Ey0o9ca8djzqt9GMNwYOi9HX7UTbdWB4jB7ai02YfMmsmye4djVZsTb6NpachP3vnhFjXEIijo8O8Sf2EWkjWoz8AbuHAQZ++aC8swomW8cYMn6UEzKVIkYa5RLxqZAKatLqSMVhJ0KO6OSYGyDahjlEaqTK+ccwGi2W7lPmcyouCVhS2Q1s4tWje/j2M6LwBlFtvZiV5t3TrDm5yurktiny2MTJcR6/Hg7vDQeXbGbcBvvyWzZym2/eZAd5hWzu+UEmG/L0NfSpgSWYyrtjufOQzOLJstFPNrnaYZKPROVu+u+Q11/YWntsjNoIcEmc7rKJSx7p5LS1CnhlkIHlR5E5iyHZrJcsqiqje2hmMUnfBpwf9aS2qIycWaIwd5KjDStp6PpyJegZYRLPkZsDq2oVXgWTRXWn0ZuiN1DjHIVmn0UvcIvAnf6uf+Wp+7TIFdvO9j35lNa3b/czmxSm595trDL6Pk1BERPncHwR/bhI0UHZLMyNzKjaXlK1C24fDruAn+p27a8wFm8+WgFU3RaI1PzbE9DBMHkZnt7nOjVLDJtph4U0oD6sSjgp0T1jzhzBA8SOcOEpb7vxrAej8jeNXtPAIfPK/0GrasgoQO14KxLunGT87uR1tL+43urUYOYQiDzINrFf4yTrnYlDi0HWhGIqAIf+UGcG+dm4A+zxkWN8c6RBPfoYD934SllH+8nGBu2Gw/rDp3yAxo5gMFm6abjiow5FxNR+pN0vXCgQAthokGA6hCFgPE9fhwl3bNifC74++CxefNj2NOF5abldWSok1dGPJq2Mw71CoXgOLaqwTu+llh/RQnL5gQT0HscH2hq6mzVURcihSIV28wnWURZA9F9XQ4zyI97g/jFsjQ0i02wos4qgpne7O7Td1q7GWqjsM1cx3EDF3Z3iTP0ng5n+3urbVZcsA6if98jgbQ6q0j4BP3lUnayj2nbViFpnSSdTXjLZf6F9q7dLa9zI1RAqs8lqSFVSw9v8JL3fxK+h5nwQTPXPxZtvhdA0dCB3JPiRVU+uYtW3ZUyZh5Qcv4yrNquVBS8bQyPyDab2dOgdBizHAZb8nuvkUWtVu3zQIyW4yQzcVFPug++04l1/YoGjPo2P7yiuykaPx2Ljz6hXJVgpFkISvzq8xX0TfKyAzaCq3Hshp73W9ZyEUUSaxExLu3Xsm9XCVy06nkxYavcR+UBrCA6tBFeHQxvuOa3UI4p3BK/C119PYseW7st/PXvhQanWCCypMNz2S1O8idS46KaqCRRABSOvPJtYhGanGNRfkiBbUJR515Bw1VuwBK+9IvzHVqzW9jOtZFBuqNmeJyK6GbsDtYW/XBFuESxG/t3XeqHfoiHORAFM6Vne8Ws5EuiP9IvUTvefX+0tNb3S3Hjej3YFMjpnAjos+vddlv1mHx2kbvTjNJZoRmAsE15FEYlVFV/N7Xb5VuaCprbIK8mxFn7EN5OMEbJxuvcbPhY2ZVboznVVheYW5KlrAr2V5TJoJM++VwV8akSqX2pxFOd3LyHlbJIxhW2JVcDEu6wdrHwa6AXRDxl5OE9VX3rd6rOsuZjfdcKSHREii7cwNHezPCa6+BZrBMf74yzzP/HWoIkCTTeaiYL986SDeoS8/pX2L26DpjNyQ2OByLGmKLqAdEqQny0jpViOPY8hFdJXSPC6zbAJzgdvtOiIVw8u9veT7YN19nov87/TuELwNIZ2IV3EDZFVihZCCOY+whxVs232IoU3qwx8LQbLPCQjeXiTPiIbbM+BbbjCqxWGR8tMxu4Slzb1u7e6ACVURVWCqf1Jw1ozIMSG5KIrGrGwRQ8iV7QGNH0Ib/qk2brpVObW0xjLXM4Orb9YyOEKCO3m4E2Pd6rw75jdmqd+ARK0b1aQh4Mw7qcSdOij+/kDgswPInKj4FSy/lBkfHNgdE3DuValFICC6+XCNvdhJerjlqQhO+p8pf/H+REgUZKeeUGJndnFpC7zehY+qhbTOQJnWKYgD5aSaCeDzhLwR5W8LU+DltbzLicoGah0EEtVtFw8DK4Vrobu6iUrQRwc3DbChEgpOdoXReWn5NoXXJ0idEt/1y0Z6ZPJcHMC+WKrCs5Yn/DpjZIRVRRy9ygjNMt6Tu44vNKf6voX53Sg7wzCwraeQLYqoZIR6EJZAdS1qbVIdfYlOf8jaomeKYajEULENXYpqxZ9O8DJQhPi7oMpFrFqsoTxsEMM+bxTrNnXSsMPX/+raMXb4d9Kz4vHWE4BZt8HtUEux/3ETn+2XOsuPZy6P79k4YSlNsnDO7bS/weVoqri4SbPNKWgplsxevQYCmoIVcYzT6edDVMPSddeJKq/8216qP2x2+W6K4i5RlUBvEdXTE3eRLZeO8JXiRGS3pULK/J4+iDXw+rrA6q5pfrKDETtmOi58RC6kf9bSy9ClmO/b5Xhm4Y3hQKis9XQ9D38QPyB3YfPqipaZHRxzHLdUV6H1tc2LXip1oUiPIjcqz9Kfs4Hk6BS1UVKP8LaYAn087/dkdCZegXK5Tmp8ehCLVheuIGdQof54rzNnAAF0ASldZchFBOx9jU8L3c9icjtKaArcnP9HVHaYUYaXGg3HuTN9wdfDjdWXtNWtTeo82P4wfn5HNg3C0gATw3T+d27o3wny5FuCzWVE4WyP5fiy+KpSndYcTyJ0M4mL92AEseGm/OoONIR95+yxbG5e67YjKX8tSIALRRqcE32eooNHtinKMgwEBaUOTrz94Cxzq46BsoI6kvcdVQq6EVk9ULCi4SXAhZzaCTNFMMkKOp+ka5lWnun2mI4sPoo3SILcOL8uD6NdH36Yw5w5CaMqyE+TfxDutymrjJ7FU5jpbsBnvBiOLvqYph7wAflTjak6HG5lP6GVJeJ4CTnEN5bElDSYHxaUfqNwZt7IgW0AMcEdANL+AJM7B5nfDxidL1yd0ttPq+xnvfHyMHsCpC9znQSgMk6PA+eDn4X44PKw3mnyYSijO7qm19o+rtBCvrDhGxyigTW0rZeq3BMOU4Ti3XJwcF9XnaskCtMVbLA0o2D/Xef4NhnK5Ww81EcA8jGDEOG8yrGi7uKlecQFzH9/lYuSTGCsf3f/7/yDYA3559hLuhZnyYvoHUMNSsMZzecDNGEtngZ0a2pre5mxOLN4P5eOgw2YQ9Rw9Eu/vcnKF6tPncf88e9PmUlwuf5eWp//CXtXZsighgyuRISNTdl4nYt2t9FpcD9EINUsMhcXINrscfjo4/Fu9Fa7/VdLOzmqOCiX3UrgfcIRSE/Yv3L8OyI3C7cMl7sHo4aCo69kJKLu73soh3NE0kN8JYIKhSRcch8mv/AW6Pi0UnH98GWK9E06/MEc0As0ydsDT3L5poqcGQh4X5lhM3jkp8ZpJQtuLUToo9JnkiVCuCerjYI3N1iz0PKQdFis8ZPuDdNhZym9y+ypyWOQmdj1STtdejSHOIE3OpaguNwI/ocZQ+SGNujH5q2x7M+pKRo5SUETpNKj2v7ttKrxbjcKS94ukGVeDKlq4qrdePhSD/3QkQFaH3WG7Y/9RccqiUAmkRlmfvsm5NIIj29MvVHyxJUnki1AkMNw+qdlArwJr+97PYx5nv2K5+D7AsiemN+izj5XJxFQ5rfLCW2pRmekHLYU+eZHWE1jAQ62Ha0XJBrNsFDUCiDG2gwAuv+QojPXseyhwrmBVBbxP0SFMi2cwd+EwN32q2IbkEekKEWLLGfppCDgL/b6lw9T/4BctjjGATpoIcnTejCKE6HG27BvPD5Huifid1Ap7JeRSmRHfvIrsuMgZUCm5WHAWjb3BC709ZCIkL4qAodAX+35Uz2oScqLOnIKU4USTipR95k6tNU3UIceVgRypgF0h9sdisRN/G6iCjXGVr+Ok4Jvh515KCGENZGmNZ97wDsNbd6iY5xeDMHTHA6YAnPUEAf3HcovJBZshHv6Ug5SCwfgryEwYlTwFWZK/PEkbRmqov1jnq9OgL9zSACYxiTkJJ1dDC10m7F221DcSmLrEgKM6tD3NuCIOEqacX3pVPa1LcLfs/mflb7s/KavhQ4JJscw/WkVyryKLOQEf3bWIuef1mXjqc7syKAyWfSa09ijr621hkdYW6HMjOuN8pMw/KsiB5ZnCyOPSlczcDuv0Z3nwtThJphT+2QIEhpP0VZrd39Mx9Aq0xaeaDlFIrRNuptH185gILHIjUXcGjFsy7LJT9RrXgJt28KaqCygAN6IQ+LHQ1F52TNDH2S9KdE+M6l4FO6PfGiKO6LpU5mPmEQgHLwnC8NDTzCKf/5z4NUUiWknjNyL0SIc0So1svzqhotN4UB5+dl1BoEFtyIx7IemlbuhMZHCQMj4oKy/j7kjw8mjgbpU/iQeZcp8wxUtMuVs4K84oIYXFGHwgeEA1d4I3do7bCFhspjBvVMCWqkmoS+fqjXl6eXjxiqSTJVkYpC7ZXbs8o8FH5h8NJGLg2jKFvuXGOPSM98w78wF8kAw+R1z/nFziJk5sqhyliJaBpIXH7jrT+0kXdwt4lFzwhDOgWK66tCbx3mCdGqB3WmIgA4+zBu9KnsnUoGbBvzR05yidzq35sbKWmaS2MsknuqjBbEtbvtZDAYF4uSxW7TwjHv/lCBlviNvG329WBsy565XzfVTrb0daO3SCPcDwNWWo/i0mExZRl2qgwQblgSzkGgATFA859HfJ+IRU0kXtObcsVI+p2nnHRE9f9Y6lgz38kzMcopLncV2/9dnDMp8XMYmKz3b2vhNR7hDkc4kAffs9a9CoBNtPLVVUIiSMn5C7//VeYdL4xW3CxIhxi+dc828u56FHQmfBaSkPoFlGktIe7XpQa7VbQ1uhjhY3UCNdor4jadihKIPqJXzKYbmnpAQ7azDLTcfqYlDWNMOL++l4MW8/Wlowk6T50e8tqTXoT1/EUoLgaPsfN/XpTqjUH3p8fPVzhOir6m8ZtMK1EnxTvLUOXIHZstVofLbCFZVvfKUY5U5U/tnKD4tWUcU+eMdXvb4Nz2acJbVFvV4SNuMC9RQO+xyNg+1Dws3HeUev2S3/2sLZj6V+g4P2fISFR46fCIeahUAsHD6z5OizoWRb2tATghIkB5KfR2UtrXHLx/AEXVUturqdHl6FvO1fqr8+DDI0PEAhKgvDNk7wZbCAN///DA1M/onJqul53joHRenC0z2bOW0V6UFSBmovGN2tJJ/aWIOvUpH5F5lpb7EuyxSNnFrLFl0A/8Hf3cKJJJ5Yqtwu9Ec5NPFVnTM/KaxwPpxOSTdJicRNv2gn7ehy2WgnZOz5usuHL7ZQ1FcnQ8yksZVfmD/AG5f3bJTu4JdrzTLsrrbTazI0JSvupyCqrgnAcATns0vTzKBuNhqZd5GJ0es5/trLqph+ZCpMouYSBLfHM1BOsE4K1ZgMJi9CbenecMGf3/Hhkp4a9y76CK2Z2q73kE/2Q7Gm170Q/M7WTzZZTCLqVj/rCc3JvRAvifvMoO1QiIUZt8XAKxZMy/oAPQ/kKxsRPkC/Z5oJVLyOIK2G8U93IJgve0xIsOgSQ+1kf9MyobYdZddRn0V5yKdsOBlVRJXGFzKbNKjWUAY2dDdiYqdqGcu/iPGGUdu74GN7q3oYMQ0BXsy1wdbOXWDZF6ksWt9qnp08BODJz4uI0xfpKdsUHIFVGLvLtA1N5Apap/hYshdFtN42JINmMfR23vDj/OAYhPlBxSYzK2n7C9W0+twqXfMH1jShIAXpZhJ+hYtLFDW2Y6u02Nu1j6/eLwGmiChUL8sBkyZncZzPRhyN6OceVg/Tt62XMNvBej9atptWi9a7D/CQs0pUZXx0TX7XPu6DN12j6gmLliMKYmvBNE4drZ3Dboi+bS59cMgv2KFnyouR2MWrawud0WD6KwCJLoMILkd2b0AuO1IvsXWFg1hr9Mn2TlbAFxd+h64djiXFkxVkWhce6kPWN0CwdDVfgaJ7IFTlTgdMTQflesh3rBdOUUzTbDWdSO40nuuxhnGcBAHi0HBOSxknxJQxSZy0fQeV/yyM82XvePkeAurjGLE5N9LwLcpcdMu/Y2yMYT/j4ukXVo7VK4AJ2mFNtpEw0S1YhZUZ+tcOHkCrcjyv67HN0c+vLH1y59P6mI4/sYW+aBwpSI6lfZgOzwxJk+yr6j7BTwnD4G7yKJK/W2ZvcjeFpQbZItT/P4coBZZPzpLOvm79qntwnl52KSPYrwstRM4m/V1DvIaHs2/fffFA8yp678fQuagddidD/SBmSYhzeve4dh3XCRgsWmZ/oko44mqqtPlAJT3r/yYHwoJYyXM0sYb3E+Xaoi5HpdlHCCFVdjqHQdhc3y8PRFutuZ9SGHtimFwR52rgUO7Za/SlaiHu3j98+SXPf11Tkp80yDpf+RnfRUbToHBtez0mOikZVRfveUi3NLF54aDo7xLVTrplnf/4skinOHS77BmlS1csE6J1s80q6JgVMMNjxSdz1k4zcELHNJg7idVNJ9tFPuSiMdLrJ5eimD6ANlBGLh19p2OtaQM94MxpmgvivuU2oTR3f4CzhG+mPUszUJifP3gu2QDUn5w0akTqMHBQf4jO/ySY99+W6na2BhdnmR+d3NmAgW3jPSZCL/l20Nms6ZKXpkwlVxsphp/bT1qVyL3dRkB7h6CMKL2jK+LzfLOSv/a95elIjPxK9IavEjjbb7Tykay5+yB4rT+4HlPcFl0i6ZZKtstkGyqELZkbLPAM3KE9qHd0BS6ykv+O1sQeB2CAOeZ05nARNF/9gKEdbQ/mRnbxjp/WR8aG0+EYVrtg17NFClRkejrJzWnpK/L0D2Q6uKJ685KAmsssLuWtCnY6eWAj+cEk7NTmzhZ/YNzzgll28zZMww/wYffd6Wb4wjTBOk2YUoxCQfUBw9N2Juyiu6RbOGn5kU/m9stmGRPrQIsJXjBZFTw3cTWGx4R6UPpAUSas+sNbfXmxfNKcLPEe1t8HzCeAvmhhZt/fARV/+3LKCrcTHhKX6NYaX77JmebD9qZmV9GLbqnyuXvin+o4nD6ND8qikQugU+r4DQYQarrK19KFYEZ6ixHGSNxW1D+OvadQtoDrEgCoNvqw9y3xy0/IDmz1GsCrS3X2+9YhIc2AbGoQfU+Rtevud+JcUJQBgAmxhXb8gHT/usKjjhzNolD1fheTfwgXOp2mJN/aGPA7O7O1xQnZVLv6gKa2bzoLIzoC5fd5v8Vh+qJUACwCWZcwBCDG63TTvwlkfn+pPX5ylS44I2yuYhOGawljYwlDjfVSHAJpbQLkuUgdvLiuu89hAlAWZc+0OfH4miCN1vdEMh5mszy4SqbaazayiJ3/TWkNANHaOE8hjVuikIUgMUYBfBZNvppMKFaNWZnRrwR32iBrCwPCfnAuty69vYaxELz//Bd61tojK+ebtzmSGvKmvIGxSzNaUcx2nqAzUpBa9QvOWx4xJFLCKPZc3F94H0xynqp97kA9MOJEh9Sm+0rq/iuGt5UsEHSluoi0iEgv/t1nBFusalKJw5gs9m0QBkMDCr+Juc8jE3SXhBmZD5IhTN1umu8GFhP1s6QyAUl4WDSwNRFUd/dJjN97Ty0OGrgGMSDqI7tYSsRGGANR3HV3fXqi9u2usPT4744vKZx7ERuV+DUcKm1/MoKwEKQ5oLWg38hxk+PzUXgnj1ice4Hb71OZBgOjtKq7ntGS5jTY3lPSbm4IJz6s54Fln3yqzjrb0JnzaVdbsF08WwqstoIX5lQINvvVMrE9DQeHj7gJifDGixntkpboiFIqUATnyZxGe/8YcW9xRq97dYQLJsxBrBZFtG5sUIlmpoeEnPj9xlr8i4LjsZMPiPSqyybrJ5Zvb+C0H1vUC+4cjYDl4nmOueF/7WlJ1EdBzph4KFMoqssfXa41lXa+3M5iijQEB1fshdeEUdk2jOE18GB8JYD7VceXcTrLKyghjJU7DH1XmRCXqOKUJwxRzRzbpz9hIuEzIT20SJ/vMqFXMiM/FVEaOCN0l0cBCiKr8rxQaUsGAT0bKJTpGaiTWpdUsBaG6x1VaU8ox2sMpJlu3bB49Oe0e+SpH+8OB6/v6NaEavxxcoxbRy9ox43EF5Xb2Og57D+V3iWUuug8g6ngg+geEdOsilKXUbsa6DsVsrsH04N9KAKe3AaxI2ygEH42RdlsVanlt/PZ1RhnFHTOuZuqNELsd/03LzjtSeKK54ve9BZDBMmS4Rg9Tnr94WbEXhN8cciNQDlwkEJiFjC0rjjlOCk/E9assEqhuF9xMRzuNw9Fs/xvaRIE0oEpz95dfuzRejL0MiuR8S9BseIITH/ZsZqWkYNPSFFyLYQjLi2afFYgPsoVXLsueQOrIi5zrOLcfy1SsBqsGeMs5F8HkYrA40w1NFyULnUnqGqgu03ZTk90gj0/tibvrinZywIF2Isubl8VODiDTLM3KNkJ4rnxyWVe3M5ia0KQCFAveC7bYJ6+Q5lvEh4Kja9BbtEs5GPlJRHrldNR8paXn06OSoVnCGB8fFWrz6ILxte/qXng66QQMbJOIxuksOnPpR/ogRWUPsxum/oCMmkLSDtRXNoCjH0tMmf657eMn85Mhp3SVI9BKpgwSc6fpC+QR90E3ZlPHiGw8Qzw5rcHtWYCj3rHYJj/NZbicENIUYwGiwhwxk/sKerE8W9jWy1YzYyzrV8t7M8hpgv7L6pfj98rjhGyMKr5Qc4yfrd1MOkV1601J7moHoTopssfi2VRYDzXkDpyX9h+t9IMg9RVSEZTiVvU1aacNBvpuQBleplCX68Bsom6HH78BWfg8ZWmyzlIuwCqMPLWGnNRxMcElXi4SpG68+9UtpqK0+rht7x5or8xHxzZelgSF/oucOm+iIiQdMM1JCzAJTgqOqGC7Lo9ZE92D+OC5db4qjE+IPAzu3J1nYMHdb6ix4INBu5eJ5Enq1xsiL4q3EsY2NVtToxxqvM6sIvCDtq2+Opr+SwokuVT8NetddSWSTGGvR8KlNMoZxEWYsKYAsS6eVOz4liokPHr+qkPS7gp/YNRUHZaTcl5mTvskOboBc2oxwTesJRUmpJjsCSNloaV1qeQS407juE/kEcY3qWuSw8PJ1PZ4OT8A100fFRxoinEltIdAqwynqVppHOwW/sR9MN78xMAfV07dyPhImIK7JIrlPFJNRID/7UtLSyeGW6jR00pQcqFS8fW3ZUmelP2rhW+2HtrKrcqRbctlVzBWTXFQsb9awGK9H7W9+KsEkcN1Z9/QPPdNRyPZFFBT5RUCl1Six8rCUx+mra8ZjyhGQyQTAwfOQSEW9h0A3dGL17s7I63B5vxEu+ku0wF255O5TF1nXO078Oz3jIRQ2BcMMIfEzbMEPZqN0lrxdnk9hgYjoVXcYHNqjn5963W7NTgCytJrK0+CB2gM+VhLrTT1UDzMMqVY0kauPz5J/psdnV3n6rOZwk4GnA/lwgDY2RfHzfuEKnjM04UnjphtcfbVxleq92f9Xj65JC/MY7ajQnDw4QWnqmumjPaLdZQIo5Jdi7zy+yFSMPGcfXOP6c++tbPJa2Cnungul/IRpcAggc+slJN6JIu8xEHiyiND9TRAYw3EULV+KhmF8JQ4AtsPVIQykZW7ITofQrkKzscQLvNb7+1bFDHGNWrBfk8TSlX7p4DchqrEiYwzGZqhSqeGZNTYswi/m2Okkc1D0u+KFVvFHMae8Y4Z2GY3SBsWoy/3WuHiRybUPMXONFwEVyswOWKlBiVdFxnjqu6Xj0oXzw6fkksZ2Jw/GjyseE4fhpqS/YplHkbm03b5zYc2wuc0jiBX57X0Ds0ApM9LBIkkSzLZXT7ikUDFr0FtTqMZtHqYwxIPkyoSy02Hac2x7qz3TarcYXR2Od2YXD2VNKfXVJx0Qw8tYDqr86mFRDJcKmSkuGyrBFSSHiY5bNwsOo6cCBsirj8Os+VXJAy+MP2x15ptu6XjpuL4IYlzbcOjEyOugLL+4XJIeSOq2QoPn+O+BBch8UzWL4ak0mzZPIx4VSGjnRu8yN6ymlvrCpQyuP7F4VzhhMWX+0jfG+vEjEcOyvnXKD48431E1c+5lCK2R+vTei+LDM1nDAgA+PoRQSEP3x7PaYa3oEUttj1iHfoNuCXvl+Gd5jqIjijz7L3FQMc+waeSKM0M1rKygZPXh3WvmTmsJGxlqP5niyqAfcLnxSxchcnMvdJzCU9YajqnXSr2KXAClenvSQgoKP0eygc+r65X1a4v7Z7khiBvkScbmZ9drqd9QANFv0m6j4RpuFuBcRAdKwRtI2UCF0KmfCHfdHG92/Q2sS7UJZOOH1gZbh6LUMOGtXiWW8K8kF63G4TgxjG92f7+LBoK/yAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAABTkE+qCzNM3abgAJNe8iFR7AjQ97sRBp9XVFzMGje3wFvAh9C8gBBtiMnsysINPBwTmZmB4UQ0aZ3LCWsCJ3HIAaV1r51BRnU8+ZEZYxaZXSpu56qtD4WtV80PHzikfKkwOPHLirINw8xV/FLhu4aYvbk8XZ9NfqZnxd8ud+vNt2c3JP0kvHMYlrdpzG5Hm4l0LvVXYV+KZ3GsQv5IogUO5M+CV4lqSiAgNILAmohrbb5rrP1DNJzCuQyaSQYdPXD18OBDdiHUOcpx9cG3YVXW06Yag9PCDG7jCddV4xVWW2ufTmK+WgUr9iGJFg33EBqlv2G/PtfkCmeEMK/dLsyCQ2bvpHLfTDCeWYpjH2FmqOA69TKHJhxmy/bkg4LeY78ufdkJvuLXzi7iU34TAmjq8zsMUlqqbWsCxqQI4XkkzZlcp/NwgbjckFpHM2IJ7Z9rUOkFJg1q/jTPYB+pp4F52aBZ6VVTVzq1402IBJKGOrvMhVaatk5Skx+7TK/sH0d711NieZGrH5e+VWlmeqdf0fAt6QfJUl7xBSf/BvlhkIM28rPbumMJ1e1kdJQ4J9bOD8xcHg6mlaPRcgSr2gD2j1BvC2wLbppXp/Ntlwyk40fLySFGInZCy+eB2fU2LTNGn5VdKvphcZUwxUJPHDNoSM+SXUO7jq8wSH0Mh/okP09+knvdqtXBr6NBnokY3JIDCKw19PA1JDOxUxVWrUHt3+d0UVaoixBVKYDfU6j2pi5zFHFOr3db30NXhn5kLVf7whBzOLl9v4fgFxQeQSRjFm1l9Z2P/LTBhDoaPl5mGJ+bCFkYXJQUtJYMGm85sR1/PE8/HcvM8g7VTaAiMMhgI1XLhn0pHMyUtFKx7dM9AH1XkE3YYwsBz6YFEmd0hpAhwvGKWnHh+PVbhewVqnxYWChoWM0ck5FHxHUxTIlYKwWUC8fvgqhaZcE0KUEwsWp0IDzQEuQx/BPY2F2EkTXTkFN5hetPq2EvdbDHqrZoevEoYyjJkCOR0C10UZYiVdILZoJbmz2lbAE1kqtsJ10krpy66Ty+AoxtrqIWCt5/FqH7oLgY4Js/7zp2uotXqX+3JDwN8+fNK9lEQmhIxWIXFu6O2LXlydJdpan3Gz/2pNzzipaEz+srxjMHAWTSN031AOfwVG/xEIomuEPltv9iB1UTDubU3dmA5by0/IZZmPYCBDzwvQIsthCbL9n5UcuQRhOxHDo6fCkGsCKZBg1b8Da3BsHwzxmIGo9krE976jeqVtK33Ro/dvb1Cxr4jxOOe80L01mDp3GcyndkypBnebU6BDqbi8rv+Vn0OthgRxC3dwsqPaSzlAMQQgypUUV56I4uR/1os+oQBH6EYDcqsXBfUJz3UAjRQvOENYtkvAaWLMnpC58cb8eAFjhSTtvBx3N7c/WfHe6+lTxjiz3HAUFax1FOHjae6vmnmHSUiAkWQ/5GhIBmxsmdf8nyHFeBf1jKnGV5xrdbCkJ667wr0RZVrkwe+qxukwprDGHGvMCmrqSvXYhqrqR2Hz5PWGtww7s54kZU0FSef2qT/wIauDfJ7NumNbqYzz0/vSoZBWir/2lTw1b5ZLdB8dJ+iRuSTz8Xw/UyChFK1CWvzo/DY8bPUqfMv49wHB3xg36kcHhR4Jz+x343JxHcSiInIuqCkxkldjSMdosc8mz0gd2vCopsyMO0MB2JaoBBquVlHaJDno7LsJZSeqiWR5+d2LKjSOwrCMp8CbgLjsgD1suvYuZNfDsH4B4Ts6ug9wcYIBOYn85wOKzBgxSn4izDcdr3725aNXEUqNKw37G3H7uZiF0ylbfmV/daT/AMfnQPJUBeF0U1zRB9dejeqEpD9Z/32jL6rAFX+leBNS0kd2RXRZmWFt/vjeFiCPJEj3PcJKC9nnyaNmsgI6/iCwy5+B8Po6HxBC90y5R3sVKQwLCB93I68C8fzmGeJfladjFwEsdVRaydum/LdNwGJXKNhwz1bh/KuWtuHQ946ie55o9RfIA8RqO8hVwqdY7bKhaL9yUckYnKLd9o0cSDiXjPu8EUuBPxfbAD/V10Fm+0Sm4q7L3fZnHdA2jTrTliqFKp3TRByjm/Seu/w50qkHq0hyaEZdEj2Ai5JZQGZIQ5nVmVn+tKCW+N1nPY/LY2aVE1CLXIdEkecTf1Leyxx7GAqcpuINbuTvXfvE30Jw3Wd8qfp9VK3Ss9WwIah/QZpdAp6DcWjUE3qdWNiHf3fnuKuZcg+nbKfFwk8C1HucdtE/qKvy4qU714c/WDoPIQk8C48L28DigwGqQC9RtTPb2BD3JGnsXK7R+F2udsO2RlR27TEWfk6SLaDXJyQjksKV9afAx8ltHoPAmM3q8cJzfg9aA6/XcH08jUpq/IoWrHbsZcTaaKuKOOxuqISIk+J8PwLChMp6XnNw56c4TJl7nQC3zC1CcdCaQySO1u/MEB1gNo8YLKbH7iYjsmSiZU1Lz29sxFgFYZbrm403HKnRLlDPt+Gyw7Yt4T4wyV5IF3bxWnGabCSZP5n+t8F7ICOP2x7ukqee+Mzq2+M/4TOtka9sidAxhk6zwsrOly0u5qheJwv89ljKyjjZtS8HVQueMFwBTGgmZDFANzorMcu3X5PLHaJ4eXUKiBL+REgzBoA2u0M7Nbqhlecp3DUPMZmWlQ3zvBW409A4nnd9KAi/E/A1GligGFZAq/h/lGQDYkjVK8qmVg77yIm3ArxQPMy410GNnmHssANUJ1GFmVUtWwoF3kW5AR0DZ/NK2Bzhe/EgtmrvTZs35Sip8unhvXXGLCin+3mm3jwWq8FTSaBAUZ4r8/yEsNnuOhrQFNadZQW3E1gm+7OcFrXJ0zyOpjpNNg2ShGtMFlww7krUX2LD90fuBSC74b4kvJ9wA9GOEUX0l6TlMaxvDmnKxyy4Sgp60/Kr1WXkxuJp19RnpJsFH8Ner6GMWZpVTx0J6fZYdUTCq7eDL6G9BF+5sbLlgau+apgsVOrJiFG00nqK47zfnKlnLsFsomv5FrOLTUCZqgtNE+C+krYqy6X3Y3LDUAWJB6029Wgkn3RW8B4WckTl0387UvsVVTfox+m/pacNkfjErKSHBb4jFrui0uiK5iNq8C0x9KQheRuea37o3YZf2/4mc1BuKt23vK8xoPqKx8PhYtlocKNC9waVmDoqle9P7KnGhS065MyVN9AoBYIkC1GlKxghdGhWiZxjkueXJyFrZ7GYPL3F9tm2qj0KkN2hm/0sXg5CpCsmyyK91CXORu7MOWPf7zSiArLOAsUXHDejh2kh+FM44eQj/tSeyk6NX5Dk/5ex89vSlZQavRGxEnY/jg8zoThTXteHX3vYPlpBXnEv7JkNj//BSS6xryUvisSxXidjAnka7e4IObpVvFdeY8UPmU2rKlDJCWEHt9okCohntmesekEyWtudySQpSfIRxFfm8lXEb2UM1CTV3QHzX6FaM2H/tvd1PXDQwCsFaLq62snFZQI27s+6EVpcIYncruMjzhnpCv2yrBw0GogA/Vvqrf4InEpg5mle40dMFN+jNPKfnB1VG0crh0wgcL4bubIyee9XKjs6TfhJhj1rGNPw/VYuJzdAXhOCS3KivnCy3XCDKpzz1qXoqiiBS9+A4mAr0kr/H6crCbVeS8VbyqTkQ9cCFIC5kTJBMG4Dyd5if5IklYZu8TcG71ZxxVMS4I93qWQbyM2QuQw+xco2g2ql6DoqHB5Zw2/wNi8kYPtXXqIlBYTow+FeeuwAKqTsq2m864eHmBG5f9dujYzu18oPZJe/ESlnHM/KL3zhrM9Kw51nwmCWhJaqqwZlLzWFHwhg7yj9qNUGA0nJSVpW65AuW6Icyx5si7DpENazyaQe7dDEGojZzpFaZNYcfU8lYnc75by/+2U94vD/E57IGQT2+Egur0RIS9cHwM1MqPFU7030VsAKXOQKIENIxXan6vTEPVEf1zm0s63ndPDFP5frRlPek8sB8ouCN7ESG/YumoHzQ6PN5ZiNZbyEgrJb/JDAyMjzN+xxRzTvr+dr46de37aRsDwf/oh5oEHq1LepSBseCc3tJNunGN1QQtNvtGjv8y4K4+dFLG8Ib+2u2IkBjMrQZqsn1+nZgaY7uph9pw8bRoonDG2Otng5n9EDlNTxpOial5MFk7/Dca6z4ubBN0uMqW7lyn/XpqYD2b8oVNguvVzvzg0HERQISWyI/gfn1S6zSzvD/n6bmqmEgW8LJBqpcfJhSFMU6ZEQHD1X7iZqu7YeLOhvpffXvz4zIU5EaiX1DWhlF2J6ici0+2g9hS1ks8uH6agLwjX2To8Jhf4iD0YSDXf2s6awiD/5Pq5OMPICrTDBk2kqmwHyVskbRZr1ncWzeMSiuckYVRmWmokLHu5lqshpmTeExWFUo8bUARJ+yXNbsFT1K9/+r9kb5hUdWanouE0Por2cyO2DPKiPC2BxnxUlFCob6ydxeJZ4TS7/ibP9ESKNxLogJOvUBfN2EkSD10Tjk8p0ZV6GNepvNDoSrmWxobLI0u/vSfrXUmdjtur3js/YNex+Wzl8N3JTqhuRum95cCJhl20PyMp/CIiz/um4Fd/gyYzwFTTygUgG8fLztFwc1426PN1SY4QvHfQlO4iPlat4SeSQ9TyF2A9pcC4+Zt9kehPgWESQq+0BYOCLN7BfEVsITrckAobXBcPz3SGWjRVOATZIjrDAs96eOyaGa71sDfBPH0oL+4MzR1l7V+0CQ3ajT6lnZ5ab1C7mvETqcwjSVUG88l6+vB3SLK8K7+LJQmB6SK9GEWm+vETZ3AfeMWn0+XR5tV9YGwqCOp1L393GrxrxGdy/BKHWgFaOT/69hCy5jZ+h5VacjeDDxriqiWmDlYRwEjnWPWe57pwTsPpifEc99DY7tz+Vag5Gweacvb+jB5nbDBn+fOTD4z/zqJlKz8b66bmeVE2dYR/Rjg1qlWfYuDk93UVTq0bzVoz/pum82Qk/Dim0E9iXqGKRUMcjt4atViaZrHUE3KRGuOdclvfIl+9RcIv2djDnQ4EwhuIjCbPHYNoEci7RCRqRnifWnZrk4cm2c6dxq+kHgnGyZdtCdXDUo2vKV5xiUKJwpdQMZVWsI4ZX+4T0CG5+yxobn78iKmvcYVadQcR/Wq0ZjJsvs8XhC+L9+CCaRTi78+vTDqJvF+A4Zf8VEIHeloWWMG3WMQTgbzVGsZuwkmI+F2eckRnUly+Y49WhuKzOdvazlQLpqzEbRMBm2IBWR+hwhxAX4xqXeuOijQAKDEuPSzO3BzDMEgDSuFxLwG1FG+qQqy02UjVIv3Oiy7CA3jBzyVDVquXXpQfKGbSX+nf0QfiwGLlLC+QpEnA6HBaZQtUO0O/BTfW6wafzDJTo06OQI3Te6uIsk/lVy4OtIHH34iVvbhCcrXvKbXHceyRBS7NhcRZbZPzU+ZFgMsBvgG9yk4KNZjAOVDIXh1vqmNr3NKNwr9MkUROnvBofflnJO3KA0Ly26BioXlXVL/Hy1E/37tZIVWXxiwadyTRBIgCHj7DUSNnCWHJNYot+UM0GgV+p1nRU5RLKjQsjCppdjthRueaZ9c9dw4Gn1G4W3qTKFQDWO6uJhYjd8Z9NyBfwhfd4uSTwZJ/XlSCwAs8/psiJVJhYPSozgqrk1cu26ldWMOQJ/pzVWvsmZDKoeahMJf3XnKB122ycm3b1ErADd33B6vAD3M99Fd5h96AiDXxfxfSvQNTZFyptQ6nwuxLX3Cb+5NSqX/iX0YawvwkB0XTcJirZO5uOPbHAiN4W6PXl0BVm6j1U1ta9bsbZPLCKwmv5kRdmjPGNbBl7uAizz6b1UX9civN0xQ2/3xzhHskehcDT0oh/hfwn6c8GRzBd+wsjJPBSrZATZ1p/w5Wd5kO9CxuB0uW/NG7yyt/odVKrThzCH2iDY53nNiaIEuaO1pZbPX1/UqWgBWNPAUw1vKmRq//62EkUUkRJTP1ZAhkXIGV8W07a5dLwJrBgvL+wnUWFG02OCjky62hdAwMetGACMgXtGpR29LgG4d8MDPMndbbmEOAWuNoXQKJgy6WjtQhOoe4YX7+G8EYVGwNfgY5VZACgHmmmCcMRsw5I1rwU0qZhHgnR/MZZJ4YtBYco4YjYRrgbmME+YnNfSPHrMWTaWeo3GHcltPq+pu5yqYXR8dP42NQNJF6TGELx8TXoYiMBbjzvL+iGwrtz0am2Apn7/umCkrt/u1a6CQuVWva464ID5+ZiXdr8oVpkhzMPYZcCrSzv91LS41mWYCM1I4qVLGn3iak72NMreHy5ZQ41BmlUXM714qpKpbKOt1Pc1Qa7NwAZ5NlUPrFF332lUW4ZZJmIqL8OgbIau2C8koPtQYBi/z+c6d1LulmZMJfcT+QAcAQaGYX3v04xNt3r9BDC0UJ9mqHjAda5iFsHs5SzLas2OIQkfNakcQrqTcLoZ/ntXfKFZkGuRzBihkp+G2GIt6MJsmcoHnqaKv7FeTX5JsbUPWZuWRDS/SMnhpI1gjBWraadVg/taBMClbu1gT2NBRCv4b/6fXLIzzkcfzhpekJwGQzd3w/oWuji7D3pCe2f+6EXGzOdWe3F0W7+nfurEuivdnG5kH98cGLRqTWNWm1OMne0xB1/DyWdvlIE5Lys2vBJoLtvorqv+cNOZNd8/MIt9pn0EFbqCIumPkmAbgTc+QtMmL2f6wjVq0YTkx7ywkil0L4hPNSHwxPXQH5S30cybHecXfD2R2y6KyPg+hcy3xHL4/qqTcpZk+jC0/W1B1Nhfvn3PLJue9B3W7Oz5/QZA+3o+Zd8t+JVhAJTkW5p2tbWTFhDbMV34e1zZmk8bbkSQUILDSW0zZUgnfCRJwA7CCOWGoNH4xNHuq52nc+8polnVxTiP9hj+dtNGwqpZFxkYP6ejA7/rl8o2k/g1ZTdtIkyUxFzQnj0CQB47HTi0jlZSH3kyzmxLPodDGpy8o1pfSTtJhkzqKAoVnRQwultF+ivP008W8nPinnK0OF8QTnQWbw+ZoIe1DcwI30IMnT0ItmwUe/eg2UPtxGF8o9+unWqY5pyOe5YtOaDErUHP72BdoPLYHN8amv1UaZsCpkGCuvcTSYL/mRDtJOSdQ5DLdWJOKErsKmfTtexXr86cDpB7zhXepobOYjet2iFIydZu78krG2teQDYQjTQYGLg9zibSIYBA0lgXX5r5nqqVnI8Ar06i2euCFok+mUv6rX1hROsyvSWx8wGNr30BJ6aqm+PCnlV1iNLBrVrbKCA1qWJOdPTGNPuvMA0gsVazyEv9Fa5YS5oViy4bmAmMKMSksYX+KWAQBzz2o4H0yPyLNPAP2zgMDflIsXd9bUnMGohBR1fN1E8foYfkCq/HMtPMw04gkALjgaT0iTYBHN/0SWduuep1Si5Ahr4wO3LjpIHu2Yfo1o1Os3qV/TqQs0yJACyY62Xg50qMO5wKMWIhExwwwlYuoRIMCEZ9qiUyeBpPwwz52nuZoXLMg+zKw+ZfrvfgCll7BsRyt+lCTN4euLS6n2hJcwCPKQyuhCtBbEC8BIYBTWibtNmZthzNCmpwAJKSdvBMZe5pk6d1UQh0DinozcZ3LtjI+72StvZqxM8r+uO4ulL/QlEX7+MnTqJnGQ6TDMHXnuGf8UVNXNHX8xVFmVgcBKCN0YlL9IeVNBEMkpRIS71arqu3GuBFa8xdnRuArba0of+BYxL8bhc5yrX1UPihkHACmIM1hjI+f+a3CPzaJmYO4BRqpObM+OczFwF86oFN36vaYa5YuFYHoqAxV5zGSl/AEEkftr+8ypVqhqnQ1F3r0EStN8+HPmJcBEIFZyI6oUBXJ9zz0iJ1BAlsqCCrCoBqokx/fGbSB0nRmr6/LQsUeivojV979jjLRpqgfIgI5NuM3FBJBgBumzCShMeummrRQwa5c4ZWkyPrBijsXUX2orTLtnSGV0ukyCpxJXq2bDUK9e57AwdUoNYNHT2KWu5+mZNOiBlAs4rDnPShSgjsK5P5qbPUPDreocYb7UiV1mBqMnoaL9W59QUSSXBNe09B4MWK9rV1E4Vi1yNgzc1ootOTX+9R6kibZR9yffJdgdyLElc4xpRxIJh+1ccQ3bbZR+upMdjpVaQ2rteANwtDYpJbRbER27nZ8qdDR02lMhW7lt60Nw+KOGXWgZXv7Ifusl++Z8wHKy4rKwxIY1smHkLLoxAdUSdbMjaNO7QLoklsgJ16jfntikzai1mfaqcpcO9B6adTcNf3TjaxH3wqfp4XoOa6QdMmg00yxxEhsMGtnEJ5JlXEladDJyNJnubdW8YRDx2oR7iXGWnthHo0xy0Ou8s3eZpPmOfEI3kzAoDQ52EQmRHaIfuJ7H9UpgIdd+aB8NO3+hoC8SI1fo3PzB2ZVnCmUGaUfcLAaBUTwoA4Jva2SuNJyawmCEBhQEi1JvlqNbyA74mc+Xs2NQTq7WxJw7nVB+cC44pAbsqUR9sk8vtmVyGbgGLykWw5Y+84XKfGy4kJjQJeu7AfMq+iDbwZJj8CASD/2MMUTaozK6jYdjjfMZN5l1ck3dUaWVOp2OTjpGjkFwJiY74bfsTsD96G0lp6kNEdKXl9KoIOxHBYozQexPcMNCuY0Dawmiqj7TdSMkLJgEkHlDaSSdhIMHToVgCvQYWx25jyaFHKxGu9uy8JbehvO5cDwMfCm9IkIew3cWxSSpH+jly8IXRXN7Z8WSBgIU9bN0QC0Ii3cWwolw91t5tk4Ggjafv7Ihxi8jW+uKMlP+G4oUrcT7nT3WM8CNIFZgcvK6AmGTGHqcEXw3pB/QdpFgSW47dwQlLb/3BbKtnqDqPIt3LeylQ0vJPDf3EDV0JsCZ5nio3uq4HG8WHrVAGLcxODg9o+tOpiQQfwK2lMGjuQ+3kEpdrLdI+0asjwdaRFZRTSMYnVk5fl2++vDXs7xfAYO3yYSJ1DmvXJhGGKraSiGKS2y0GMsYr+DlD04dISvlMHvrserrNeutRVLMzHBfV68Nn3xkvcPw2rFD/kTDdNQ5OoBUoA/LD++kUm3wR0v5eRZc33GWIZNHgcMpSGXWlGWHf+d8wY2HxF8ZN54H2hAKbagaTq300dcCbauVkCr0bE5ul3ssw9/QTxCsG3sRPGOCKwgSdqknM97RVGeOWX0ILo+GKPPekVcOdkv2E5gPOZwAfgrd6Rsta3K8cUnS9cL1kirZa9nrs0B/QFinaMF1zblw+nE88sDojpKFCVH1Dmqz2noyhO3QUfOhd2puP9y0RNt/2K8D67CcvhGeeA7NIo/TXDB2Bb7i3P8aDBPgQdN8YgKqq+QjCk6fHvcR35LFZK+2rtujQsz86BTsCsU9aBTNRBMA/CT4iqLjxCeD3wrO5Hq+aQ22ID+f9GLVKXEpiI5sUDuVQe5I4Ylh6JG4D+MiIVNC6JeJ5H5u7ANTr5YeMpKD6K7heX0Chyq+NooOcfmjdWdOTCSnOwXN/CqLZr87MZvd8/MnJZM4JS5YDlHtCjaiEDMl+9gGaZpJOl3TJnF9bPBiI3DuQU5D7q/Gps+1XtNgMp2rKIWIFsgyeoGUCwU97blwN5Sh/JPAWlIcL8dsrNRXAouYaJs9+V8VkVx2Nn1SON0qI0CneEHuf6FFl0+Ayf9KRHBtuVBWp3bUvcukr3Lrkr8flqzwPGbY8vpKa5TWNY1fMpHaeLQnNP4s6qQFYOTNWIhPusS+HxTyZBYHwRPUnv4z7Q7PZ1tM4BBo11W502qIVsrAqGMuZ3623Nkjqb0JgCfEt2WMqazPwPyPbJJ9Z0omu2ISEPfta5p2WIwjFBZX/889n2NnDGM7sT0Nz6EPgGNpAW7CyKY5O2xTujT+SuVY21frlsvgafQKbabvSGAivZXh2kQz06oTYMZWgTTBBiljblZmmaqMgTbZwJO2L2HIPSpJdhD1r3nW3TlP4bHs2mhVRgC/vxkn+CboK6KjxbBaiR/4AmMDHg/huz9NZOcbJawXPZd9JsKtiU/i8P1NzIjNUCb8eefxPDrSKWalokYgNvEDQZPk4vYRwB7qjWNyqyIRkXrBdzdiguKYBMcjNpL1mU1SLSvj8+bchoF8NlettZ3KQfDJq5Q+5P46BqosHoVChVXjE915DJd9YK6hQJKCSljLujJte15He5Hw300vQ1z/7lIRkVU30SjqV1J+kOspCowry9W5zJvaQl6lwiH9UVCyZim/b/bytgU4DhpJ2ZMb7I92Baneu5U7QmTKlh2Mw8XM5OUcb7gqaLcRutVjR/dWNiw0zLuia37/rTAeVVurainl3U5a77HTl8CuL6DiN7Qngn7kiu3znIEJ3gSTsQUGIEV6zlMPxHwsOHH3UA6Z3yTugI6pAFzmyhZzgyuvRr/dCiDuU4EFCNY9JP/BsZKMW5TfHVpPsIErP7gu2zsyPpAFChf/DR6r9KpbkFpdHTwUVcVS0Y8yCEM6nHRFj3eV5wZBDklu8vkHhutLihDeti6Dfgm5zIU8VT92G4aoUHVNr92azCn1JrQxzVAunsc4EiilSzJqjgsYT9vPCRqfNm4nB32GpSsjsFkuRLDSdbxGgOxquT5QovfridxzwNs9RK/NRih2FWsIXsz/BSM05Sjfo6RykfkKZbzfqH25QOotgYTcR+IjIQOmSD8KlwMbCOc0nFG6EKU+U5dHWTYB1mX0FH2GkZ3H5DoclZ5bLHoqpwqwZ7KmeddHKk55AqofcTq/zgMZaDKyD8J0v+GIpdqiPkApY5tNXt8Q+wvVlhAS9nFuoc/ARd2s1RbHX7iECqsV6/MwtGmOP8SoSyrzzza2Xpz7MXAw73PeJ3kWZBxzArznv9Kgy8d0Mr65+HAJzy9sVOmSF03llXEI4DAnH866uV8RuBzla6w3JRNuvK2Kp8MXopkrWrn1hZvjoYZNGSVavFZiu/VCbCak6+SFI+EZ1YAmb5IFhu8GgAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAD+6myuRtVbUwrCg58UO9fsXPiyZqQdavUtXmiNkJRpbeV8a2yX3OG6sG5OEr8+zVyYHIlXzEFELTFV3r8YCQCITQALYhrbh+HFMmGvnbLheRQeyuCzMaGHCqQECu51KwhqDVuPGODSVcttglWC2XLMy3318RnHKTo+coUfSDAs6lCEtBus9FCLNIZ6qiz1oS1exLo4ybAmVgeTYbtI39WHNKljGh2YDTFhmqbIVSknR1229WBokfVgbnnpf5FHDolx9XDKID2gXdaqJiEUcXEo1legQD4fG3f4mWL9R1xY7+tCQVuV3IgN0lVXNFvJW43yRF0AwzY+ffhkmnLTXUIOTxTAPgZC1epT+z8f0Y1xKMgMKXZ88woS0IIyYXqzTMZ7U9CoyqTolMZTpw9DpiVAud48zo6B3QIl7CUvmH5oH6Se0Q6qq5MjOl9IXUvRjAYoqyYp8LjD200FlW1slT+pzHK4lGYPU5jgNHbAoN/d+1rodTSWjhgbZ7K9Ikb7THKnTbh+Scee0dQUNgle3dNj6BzxQdskRPg0J3EBEkHZDfeGkc2vI+7zJ/3QiliBccip7lCTRKCjE/MlGLg/etrUorezYp970lO30oK1wh2gFEa0gh3GXnZRYEiwYEP/g1lpMDiUFpUSLVepN6P3HinJENEINQRvODWiOX/s/ob+wmkB+ldEuqLPms3NROujVlhFbljGis1AsqXsyiIxwa4fNd5ciCJzwhJGG1OAwpyQDdefO6Xlx3qTD4MwZdIoOLBdE05cXaR/e6SV8ha0mU2QSnkFej0Adr408JATmfHzZgOx700+CEUELb4qSuOoK3PPAtYWIKiLOeKw0+nEmQ8sjTzYLR1DgSeiRLZD8bujXQRqbQQ1SUysSwMQgbJ6S9ub1CVhgO5B9HDPv50AdiNu1OOAfTcK0pbD00x85pqKLGUO+ay21Z0MusYVimXbsHAxWwo5XR8fOjwJbT/nodkWCF0R8hFisRhw6cC5J5uCxVi7RginbCa6hYScpnBoOHvq+YNj1gZHOa/p1nKWe2kf2QmfXG59j0VEDNPGtOwdLeUYGDp/540id1IooOaxmaqp9S1UV+hLU/z+dWBMg4RSHXcKzgzrIYP+p8kkqPuhl1ed83qQiZK+1CyOgIvZjuEnj4gF9G713niWMDi2VSqGN/YcpA49V+z5+4F5HTj/yvuVvRY67bY0ZvQOBSwG5djCc6nLnol3HLyzW/cEWudnZkwU2BGo3bxSAE2dNoInW7gW9HGKWfE0GFPqCvu/DhHaZ6kdkQSc3LNnvkvm/8o8/u1lfYCFg94z+peLwexssZusqjVIFkK8QfRj9+yXgOXex63FCPdAoX6eqOJ6aL4dl+3FMtBo4hGdHPa6BkyvBuL8ztZeUFGYKmVAd2Dl8j64DuX4Eusq38QKAKBga2y0sbjeSMWzWqJcjtI6xEzuVyJH9jCb4vOq4wxZB8KQldS5GY0lGhe7NHz1yzWi7LHxfZemm98nhPjjpb+7SGT5YIBZEw0wPwfGTenriajXOT60spfpXJERX+3GG0/p/TvDVMR1sOkihNlcLGoGBF7909GTDdBhrFIuMhu6BVqbxb2PRk/6M0sfmMUmNIRuarV2YgJ2n4Us3O0taBVxY5ejjpOnvchAtXwjyM+c0x5klMp43Djwb9BmM+V7XKb1NROs6cJmhNRtWRX5lOJzLAZeCSl5Q2T4iickoRnxBoYOdoS/EckXl8QA6J62YJGBzixuRF+UIobxzwFHMIfuOJejfHZsQiWtvQSCxMk7mfzcSuz4A9fGGCD5gOvgspeHqmiM3a3vyiKbmbOmveXpki+U0loHS6/LI4IeueIEixgSY7Z/v4QPtPJuplCPxWDh8F/OHJw2H3BRG+6qndIo1b+ovwJZQSXlKNnqPQM0z6+0Vtxh9DryYefV7VTKNBCkzm4KIzrVS97hk7BfsPpG9Z+WMVCDoO/aAcgv7LdBOEKrj71xH8BpslNAQv38HUB2+ylA8GSu+P/keCu6j734Yk3xmwhqEm4ezd3zveMDtnbO7kQX8vrYjaLs5Ef/SCbd4k+GDmDioo5vki2vKu18uxzcLMJsiQ1XRY/vzGg6V4VQbLJqndAK9jhWsH6to6rBTHEMe2SM+kDOcnRZpvriKDbqHQsYraSbGKYddIEn93zu53ENXPOpvXeOys/ttZYl0/yqeXdFPCUZJqD12tepV6Vg4Or7j12cpk/VxlNbbMQ2Iw6mzYa9TLXjQ/z28SUcapV9PHA62kH1MI21VYLmjB2U0tR63u+TSkjz9oWgyIsnrFrwRuCFPltcTlVyrweMqlUhz1ihYEkuTthZJveRrlvFQlzwiKMZx9yz/QIPdCkGZtBE1t1JHx5rNKnRQzoVmAWVyaeJkqCH/uVVeYt5jPt3WE1qRaIHCFTBQ9WsM9oNkvkwwDl0WV8tmuaXVkFrkhbXygSefE36hWWFol5jlQyoyDhi25Ue2JlLqMwBePbcl+/bbhOAwYcZq80kqIyHA5dEUOF1Nv1OaiyQHk5BWXFt6lusGceYGaJ3m1dFCwCj6ch/UBc6GfMDKJKdlDtvf1TSm0W9gLFfKDk1VapZO5aogFr7WMt38Z4pzDuJpZDZPS2AbvcS3odkGVTVrXciwe+HTbrNNl+h71h8DAz6rwDYcYu9nzXM6lqDXuPMgh/nQckWw1QOilGJLn/c/VnoOCmdDMOEoJ/AU19gvhD4M462I5Uwh18oqOkjL1J1SPm0z0SQyMjkDqeFPvrdLfd7GJtUBMqsp9NBDTKKuqj33wsRN+2O44ROwu94x8HwvoFXao8rxpf62Uf9YmO7KEEaJ0Ug9dWC9n8DkRQpNDj8mq18gqvO9kZ99oEDNLsnq+4Drc+Thd5g0KdMgcIsq1bQBNOCGe1yG5FvGJKHDjzTIp9D5gWYnsZrAQL3RTai6m3yd/0t39lQVwGdb5dFYWH4hYyusMptXkdET4cyTyuHBuL4U+2yEcK6dljUaaNGbCWPuiAd+3sMgPfFbzGfF3brksKYEiHmdTu//A/CHeKRIfXkB7oH6Rl0Hpo/G+LbouVUsnRftTgVstyrKn4D4hwZzGdTP5MDmlUAHdjNwAOpQEwg2ZeMFuUn2fBenQQwr7VdblAgseCitnu4u8+Ou2BP27QiAHp1anL+6Ix6J1ilMpTahWsGEpwZeOIXK8eVLJKwUmU7UsXyQY0C2EBbii8ELpVtoWxsnfQ/i3M8ZFYOxSe6/M32LOzRoUAqmGzCGmsUYTb+Nb+K9jUMTu8yzpbNXRWbtLhRV1Nd1tQO/zuS4EIZg8Ul5OdOLqFyJcYTGqU1AJQqSdck1jQtC0RcUao7TMDl87aUSFBinylosRdgj2a2xP5R0YFv2RzM+W7WIRDooJQM4wPwGHY6rTEfJnotJgl6arFEZKz3eSQEd/UT6ryPxMmMLgZcWeP4q9PAnHnfja2sJPMebPdK62WXM2qMZ433sRTQR9JfB9NkB9sqIIJy+OdSI+j+JHqYUiDL/lIVGXjJjRRgdG+N4oaW0jVx8zNrJER/ilS3an2tuNZ9OE88RCfV+J3JP4P6u76AC3fAjRcrcRUyIIAPRCD5kJDonSumwHUjpXxxIU44HD9YCs/uvox0n7lun6wSI+U9eN5AhKYvEzJmwqCso74fBOoKponQfjdMdQEEanHe8SMsYq28YZfYBCNyEa5Jt0LTvGnMBXcvfQzIJCx3Hi0u+8VZlhogLVOYReJXq0xGmqN4CdHcSxkk2mYMAwCXYhyMjW5Y0U9HytOu4GcaeJZVdEbLvs6nYjKghoK1HtJ23C5SpFNsZuIk3gLc3lsh5iUammJzr2Ehv8GnFrCVQ1FCwx5QONTqRkPZwy/Uco0TdNuoHKHwelZuK27Z/jXccddhjhYovCW8elEpOM0H95I96/iLq/Fk7YjljgbVpmm9ch9XTBWowOEspTeQrQfA9WV6e8xmEX3AeBKjDTaxFwdBJPbpRtXZkwJBJLamlI9rOZD0SAb0yU0BgFg8/O2j04VlpLpaX8doQQF3y+FRoZ790GUVvIpEOjPGwYy09dNn6bqAB9Y4E5aUIEaFgto2Lx+UyRrbwJ49bEEduoEonVrLkyxlLRg7+8Z+xMo12DefMGvuDkB9CCJqff6FrA/RNrMmNYI0kNkO/tlE/x9I3x8YC3wIo7UxQqB43c90nyWux6HyjpdveQ4mjDantbDNaq7j4JJXRuo58Oe5kP2meB8Vk1/BRnIJZsdorEqFRAsKjGr1QC9fEYT5On/8gdkY1ILCXIqRsQmuTtCc2Aaxb3FPRAXIVIxwdRFSUA8walFQX5YrNP1WumbAWihJ8muhZ9wkHonk7Vx6qB3hg7t1J561MD+N3N92qUX3Dgt5qPEAzDFZ6pmOWNheRWyMFK2TJMfigmfDEIcPxO7PqbSDT05ZrcMz9Vcm7HyjxmPsNZV1eOlEcea/YbCM0qJMbDu25ZmpOLQ5hEkyMCE/yqnv7n3FNC+mX5gEVUL3FZEwdbXBCNwmjnn6ZxaRqQuc9yYhNoCnLa9Z7ySYVEj/XzU3KqtuKu/fizPlfC381JNYni7Xk+y3ztr/q6Xc+qc0XG4ZDyRjX2g9JhfrnrWCjvkS2PdRWZHpvBz1TbrOb74yVy6JtG9KAeiNwIpGhMLIjTzqT8CtwbXXvjIjVkMwEFI9U/1WtuoRTIk0U8PTYuxzIl0UDhH7ccDTsWnr9ha3x8zQ/7hB59rXWKJd9Tw94qHKn7AW0LIEIlv/YdgM4muLhuxKuo5HuqgoIiR586QZKdrwKnnOgjYoDdelkQxH829az8cNr94xHwFMM4iWPe3BWadiULq8D6S9HY9tg9hlgOcMVCbrGMn/9IVrYQJO5kUdlwFEa1UUdsPs62sZ7BYVWs0xe8K+EQhkEfcho5HISp0lNaXfwRX9dIkZQQUAGrS2LZn6DpWm3bliB6si+phsB2Hx8luCxVbKl7+EfM4cGNu3qBXYbG76t1NKl5w9BCGtSDRr217Ez2r0czj7YaPdP1/zr8bvlIsD/ip1SnL54io17y6WKH6QtNNTDwUC/jJ7onKVL7vg7sj4+/VPnIMWFkenx0teGUFwOlSutPivZ+TnhcTirzk8fknVzPH6dvW9iKCXlPgJrzXy4xCuENZGDoYE/PsowBQJePT73h/lvyK5GBN4Jrcx6DVJCt+POq5YhrklUvoj3tQq8lP8Uk+wJU7ncvUOXWwW2EhpJFXIVdS+FeqfnppUEdz6ZC4H0xMZ9nWcBeK3G+mVKG6LE6B96TzpO3PfGjZbWBZu50wWh3OFHzv7wmBxqeCyHWxAN66frjblSpZ22Hqktawy826Hc5kgbi40bzly3LtXsCMMUb0i3NNORaSV0YMT0f/EgVCb7uaPF9j/QcJZD0yF8VJoYFCH7ai6tOIY2mAIOR+pkZYdzsuTN7G3WL2krQEgbVvRJ+DbQZ3bGRwZc82i1ryx8tfQi9CakW0ZLHiULAHj62sGxi4M4g+nNsEr1Nj4bO6Ib9DG7tjFbQBlc/ysjWMt94IpwvKG4UVO5Ag+/6TBJ7WtegxT31vlWWvUpCmHLq/Y8VSYptJHuHMUEFZopjbJ9OuJvCHSm1L4FcYwVB/UNrH/I2TRjj0dOhjJK5yooeEUWdfycFJi/mTdEN1xKYrFjhjpWoR6xG5j3BphQTwWsbwgxRm8s7qtyhA0WbCQYt6NwcrNeDu9SFEPX8yhAedj1Kdp/pP/OnhLzlKyCOgajNVFU/QMKI2LEDjQkO9sNtrz4jaLb5ZzUT429D+HSFpV5uHxSDKUh/fCA0ZfZ9MfRiBX4Yucm6PNqmMjnnyX8OrN9qLmb8MFngLgHweVTfB9rf01+Z8Kt1s5WEOsh8TK54tZBKS//dHBmOC5YctFXei1tsTFwtV1MDyBNbXSeFA6FdI3GwVRAFsja8Eue3mjVpZF/OOMteb6rtbjKZtBqJbaXNXr2JWwO+495X/MkBPHIBlD0A9/3wOUk9tgYj+6dBF4OqcU7oi6DPBKsiLr7dNnvl6OPKVP5nx0KkSvqL+p/TfnOHK94xdz6s4uUOQg/nZCw0y1LCM/izqzwqc6sTQBmAzMg79sZfoY8VF9hAqhG8Sp+YdSVoMH+EJ8rrgaLh4xbmvVah2rjnO4xcb3pcyiWE2SpVy3Q9fQK8w20ILCd+g8jij0E+8HsUtfDLeicxzUWwLc4B7DwHhGQexd/GX7NYvk1O5wmB4c7lwRhndGJkkG03+6Dkjnl9xp8xzWrSLMk3xyJO986NvsCGeDDTO6rh+nK+/Co9sq7D0p/SIzUlECI1R8LrKpH1/MFz4egSmOFt9N10oZ3B9i03S1GsBxTZhxWkI+c8vyea+02so3yoXCFCQd7BhCK70l1C7A2aZ3wBqP5p9PvqKDGSXCWNnmRDcyxLcsl6znqzvdibfpA8uXQRHRaNTcnwolQmxpoU78YY3bk0LI2cE/S8W5niFcIZldGhVJR1J+9CygU2mP/MhUOzitYkhcTL4BLHaqfMxeEXPJISaGUjZMUgDJxKXLf6ZHblhmIVuv6I2m1/792OPenA+QMviOy4tpqJG0F+ple6UrrliSDrfxAbuS4VDcG8lhw35zzRi3pH2xJAtZdnfGIfKPE+kR41/luW0NbZwe75ZRNpTraht776n8mWUEfTOgyap+JWz/aGaUcUHqPrGHIHNG7XyFA7FQknLV7nmuam4udr9NhzNaJ0BJErfxptMESUTCtSHqL2YVB99c+VcILLaic7bp0Vkj6yTOZF92vBq1KGXa6wD9XBITb1KzP275U3qCAK3Qbs3uJxldMIwSJ2eJgzmSwJ08ZXUqmPro/bei98YKTWDeaF4WubsHfMLrJE8UnBJwUvlhFWr57PLtLIPqDEUuIrZPTdkdeLqMKMUTAPYPXQnlHaFXXlZu87rT+mblVDLhKb5sNjLjkMD012cZuuNLe6ps9IbQA24hmLffThE9byiLEyhi41+CkKFdB+e4pmPh+hq2b0oyB9g0dLd5OlqX5ciqJqlwlbKvpR57j+Jrv/24pNiDSYYHNG5IWjZ00bSlWzfPukEuK7Uy/vRcGPwc3oXqF4yeNbWaLUtsZbkCQeN8UJW471pGfEWWkznWAClygK6M7kqKgHIZQytndwNLwM9q/GCYR5i1CEFR3cJSf2NF/dSNQm376BylXsP2cQ0yLUre9vbz5MMxX8nj1UkjZWT9L+gWXGsQAg8ysI4F4++s2nWilXOs/ZG5kSZuHBGKqapj7TsCYNKzWwMMelURyGebYXC4pPOG+uC/qJJzWQXQmrnfskVkzmZCm3M06foInQztLpjtwmcSmQ/Qsoatn8gwe0k2mh+E/XEyBkebeFM7y5B5DzDRYM97+Na9awif2z82fdpetm67pQcw6/wW0Nxo/SGYgPNfMyvxvGedrZqnJlYpY9MSXikKXf8eXceLdit1pVNicXSRwta8qNXkeNvrl/b1thiOX6DrMstSnmRAZz6KqIZTc8A9u/GX0MEFYC2ZTcHdGSO7r6/6WaEqQTAagtsLkeIa5r3qzhg6wba0jBlBKs+pSZvgpT1BFyZXxWm9KohnL9uhbAvE/wtsSqDxrCfQRtmHuamL8w93AgWTH86TWbdZRGSU0iHcJ1KyQOQCObhepmhoSkkw0nXVuxY8lGXfSsVBpzFh9MagUL/achAC+eXm+QPRts8IskYZEr+qVcP/OmRsQWvhubfJxN4jqxaUCP/7RBAWfiPY/L6eH7cDoUWm4Kb8KWBhswmIVtxa0wrWvhCfRl5/QNIZx0+qry4Kh3Ri4ouxUSijK5qcm0ijKEWTeoqD+vff5fZZk0vuKSt0hUYUKI29b9sQWoxGWzhxsnra3nCrwHDtsFNF0XwiE3DYV4I1tR2zemySBiPMGB179HmWd0sTMMjraXPEIJklYK8pkN6kyOLxp186s/UDKeIr9o/piCs7TcmJNt/YQL5q9YirbWHeSAlLnrW/O8pgsi3k7pm/arSqGUYPz3h5ETzQNZQyKily+qFpeCLrCedxaZTH9gUyTMfl1hiD3ISpktn0BUHqTwSpYNlhzQ7fcnnzYImMNu0vVz+qFCXrWFqkGz9f9WV6LkDtcMEZaOQ63yJW8P9Uo8yvLc4H9Vfxq4taPPUiE+STW064v/Xmk3eGRY+Dedgf5nZvgkL4tJQSpxSbcESZbW+RGt043W1YyxI+kKgSuetwyP2SSj9tWzxeeoGuHJNe3HStzeMb0N5fiN9YDc6Pi9xrfOBPAnXiaF/3eZlWiR8vkvc4Q1msXF3XC/HU8k8AEwG59hfZxptjNQ2tmozRkmVeqAE2HrBrJxPGVbn22aiiiPvZyvhrW04Q2+9WT+5yU6KmbuDf35RTYjnrGvXGgUzRpS4W0JV6NG1ISLbvCY56q7nVePVRDdsYNWt69T0yceTsGOu1z9r5U1bXkbANCGNPuXK4u/L/c6O9sOMxwdY+cW+G5J43jsWwRQeKDivtt1X18gqM7UUGjtFkj/x6DfyEHMj+hZR82fQOd2HPrDK7m9cDTVjjmTduqFZ5HUCB2k5HnY5YsUGmisiL47dABVvyeTd5o9YhCmu8Xh4cJwaMAdnMeYnDydKmbfZpMGavX8ZcwNh+KwgVTjjAE4R6Wi+EwnbcCItvwZqb85CopDP6k3/a8m67gINvvRuQm1Y5v1o9a+WQ9wosESub9PZNXeO/0CNaU3ffYqABhZkvbZBvF8t0a4ax7V6Ufm6YABcG1yXGony/gylkXLEgfCXaeRjDKWrsYV3OgBwGX5620SZh0IL+Ftlw1cyeOaLrQLBYORxn0P+4fY+bzqXoW1yBDy7TduTn56fRsFQ2zspQWJrsp7wuhx+YVI7I/Ayxrf8kC79admFuioiLvXCB65H5zIiP4lsQHd2he9LsOBDR1xrMHDBLemAtFu9SRxRj+swX+YlxF5O8Tp4vaqNaORgxiNL7gEQLxSNZaDhAqFn8LI4i1y7F2iSTpLtQc6f2WFF63oP4Z4leya6O0AvRXz7w9ro7Mb7TNzsNU8UbuYNMfDaaksqpK3DHNqVWHrUGXx4ljzRRel3i/LK9a9GFNMB7RIxE8ZS/OL6VTgBbwUPQDskCmcP36VNrJ4wI0B8Ob3NEH9MQHTJBQkYXrwuB5FHMBDRrfuaVK+K62BFbgqIaBag1cJi0kfRV/kJzPXR+weUQvAzA5aaRjRI/GH1+UPfZbfHLn8SdBaylHLHBXbnnfGdSVN2YVoZQSovFGfqbx0+3QaGZj2bQBOUkehwhRNsLLe8X6pRDxV10e2LRjNChxnOW8w7n4t5ZmoeSha05ixAxjhqDhyGlxPcRBUaer/yONwGJVIoiaIGdwj+jKc6qWOIngm4uKLKRzqCMvLSYZJ+hYk2XUN2vGI137aa0PDUmBSCz2sFN6YNcrlO5auHh3i1ju7mOXtcQ6ctZfInEbI46Op/tinRs9iaDge0xwShbUwh0ow/1lBF9Bf5H0iVPF02hg1o4NmLItkdwLc8YKpCgkWqm2cqnYaVulxsSz2Xb2rCUvOt6kneIPmtbhsfQaW6/LlDP1eAldUgSJOkJQypni4IODyR3UgaqpBAFa1eqVf8R0xCc22FhCvLlpX11EKpVjogHMDiB1ZboqsIQavpGBqaq2/az8Z/NfqxaE2MK0bvzMblWCLegzqpn4koegwte7ZglXVm/h+ZF8IGFdvIGrcOYya9S9yo9qoMurDkRmRC2v0p1C+f6Pa/N+gzwtc483lWJA/dfFm865JnCiaMYcnHsOEP5V+mvbiQFwSZATzT3WcO6CCYa76l3XHoIuiAWKl2lFsunU9J98PdF1869gF0evAnHxj5I3yEexs3cFNfRcCOjAd8nmugN2+jzjH3n5sx48zDOgXYe73FA0ew91EZhOb95qTfSSMuPxjpn0bvPPy5f8/e5LgT8dlZnbowdZkPSji7ONGJhkEblBDtwv7BfjQBOCAAkHs1xDiKXrOWuk+U+DkiLHIrMJPgb+hr2dhr9tPpfXmMXunK/ui8HbC/1k9OxPGTQyEa3AkNhxFwFfT8jAh0dqHz1+7PoeKUQpD+k42Xu3KdTcb32otaA6Cyht2S5th7LPZSMe2CzInKaj21fdfa1A0oSv6cO+1gW9G0Ef28EouN4E3KzK9CoaameTVB6dvs2OPwW6IWNmOc5df9zDHTdVd/FAjm2Ki0ZghwDcd8RGoACR/mqh4u20S5/xAgec1YB2IDqeov7BON1oc4fuVbqVAniubUzrZ+lb3/ovyn9CZsznkX4aGO3aKGBHQq3ZDqowaog4rYI8asPRSZ/vjnn1u8xJw98KSUWtKwmjtByyxPrkMnlwQf2uldqh6x1pDFTAl5qhq3blbunhi8RMXWlDXLKlA5Xs+vatlGAdrCD+M+uawznHPHyeMBGf4AJeqd6Gz45TIkhGrFH4m/uEFHUBMbaibqlU4HaVTEtELu0u0LDP5I85lHhMpOP2x2CInW4ubGy3HfxdEymvA4SyBz4mlfiIi3wba0kB25lbYikRK5qzOANl/8ZmscuM/HrrZp9jXxQ5j0C0VHq2XYfx9As2itAsUlz4lsHGDio1zszSK4JW2fXo0w37n7ecibKy16bVzSG8Gv9kuNJ6vVzruagXIhPZm5qxSnOQPcv1CD8bAZJ1HPFFffcnl4QlVFup0I/lWdlChX4VhgvHnwY0+VIuf3KlOpGAkoaj8vAMCImcEGv+fvXQUb+bT/tfVUGEmyJDx2UFu1TvFyHJu3rA/uaTkqfuyJ7UufgrrcKqdF9hX9od8YY5VKUZwv8fkD3nxPVl3BfJ/5OVeMSmUKEAHxTlNuLNN3+8/TL/gFmIZtPnO9sYAB2WcecRbBTOzzJ2RbOKdv/EztAyqLpbbgk2cYF2Vnv6vWkQYDANypuOU+KxT6QV2Un3wGnjTtrxwAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAQD0TaaW5xZyeR0KCbe95FnxIVops8A7/v14NbOaSNsM3Z4TGSoAt3LsjzMAwJBma3/0oY/1GVrA+9XNYrxloJjAmJ8s61x3GoQV3/K0xBmdjZyPkjfQgISwUoTx5N9wb7Tb0ET0MgNP/SFyy53JZsYN5r9RVlEapzasWjXXL6mBDpDi5R7q3J7YWO6c7Xyo2SdQKORlou5py5oTSVvBW0xoo3A4DV4EJNV6jGFq0fdUyliWMCu2qINOvmCViqJY37jxU8XiZnBMSkgXQyYsAlnFKFObyVvBux5jwz3Ee//Wyiep3F4GIYFvoR2bS8zVMd3hOJrFQmEwkKRd3ZSbCV90IvQtpUFjhFdbkLcUt9zaN3o8wFPTMYLdP8MD/nUmlAbC8aMxMJP9GM59y59jWXf7OiN/SjmOAYmA6HF+Sb1baxWmimFMylsXyb5wAv+cNNYlFYxlqcdA9iq8h6HAqA+uYuFNbNth6lTiI+72U21JyaErMILhbuts0BEEHOCgMtjK0EF9Q8/3KHmlWC1d677bjncdMc9CplO2aW9acXX8c/O4MxjKlKe7Iy+mEsnTd6hGv9nLXl4M83u5G7nVkq50czP9ddUXVaDMnwpyhwhGWgLFh3N6e4uPobi0uyYpryr/4BRQcMEUzENgOATCWByIN2qm4alpqfjZYaaUb21h7L/R25im6liuc9TrHoz3O7xsiW8xwls+NMm8OUtRBFHPbiMIuZw6ZEfQuxhJqbOLGQBka0NkItU6ZxAQLHACaZdBu4tHfilq+LG/637bZK+S9p793V72dKNbVsZBPkOd5H6bB72cml5zWqViWOPnqxfBXJaXRm5D5lfYJoMs1bmgiUxf5XdSiD6VWikdMqjBCpM/nU+0ud96zXZuk1hTOnmIPEIBuPwx+RfV+VT8MhZablvbuA3a37Phxdw2C6CDWWPqQyVurKBvsXEoPqInp6XOflm7ahQafKpQqSsGLU9lvhRUlR5cm/zOGW+cCKltBAgrlk3lmxoQqk0buyUwJmTdhJ20/S41vZyFJzuK6mWSPz93oHj59pRAXWGY739q1RIBcX8nkyoUSM5d0EWM/8PTqzG45uzudJb/NdHqyUzrt7ewLPwyHGoOgkhcKeQXRyjvs6CtG2DQtm8xGTNPD+PfJDYztKMroewPdN0uNwR2WinbngNssWdQvSUeRRkYIROpN30LhjgjHZZpgRpwIrMfWAWwrixODAprfDyTSzjaG42smuNmYIRmzytaDGKKoffCaaRGgDd+XmKsQsag/hAxviYsftGx3JInpKBMAXp3+NRGTzs4Usis3m5TT9LZ1wYZKJQEBea7akF2WXU1rykt1BnhzteaRPGPKUVqAN56rKkYhZxt+SuJvCIs8iAyB+YHHCzCLegQmvkwIdqQeeFbwtFJyH3kShefdq5DiL8xLeNp00cjWb6Cvd9nPKC8uJg1RYJ1aOzdc15MEtTmXmOnIESY5gqp5QKJFKVvQtElqqJbncSjVWppE3G3FpeEpbgtKFOxMMRkI18xIXWoGXoncYKLEWQppQ4JI3254yYuf/65bvGsnyb3hMNTETSH6RNWtVgprXre5ggQ4dD+/rnIpY7vcwHrCx4c3BuqrQB9ctPStTQXCPeJfaJzbhpAwdNfgGKoGI/XRXQMqHwplGqxSRBJT3G+/dgoSj5DlQMbvK+0OYgUJWhcHHug+XYp8pTEB769LWL+zr2vZdhEydIvgb4Y4JpvAGjYd45yIE0McwoIwDWo74DRiuZQh9VO0qxibxBSE45wQoEW9Gp3BcVwPaXRzxk8k/RGIQUDt64+qRS25LR9zvzZcCVC98vD74VlAYzX+tyTxP6UEDdT/mtnTOH9aTVhknkz8pqqPzi8lfk282jH90qtebZ0IS868riB995RiF3r6YnfNDSf2QlSRFCxoQ56PsmxpjItanmwb3ne205wWVmhzrWeYK0J7XPwkiY+hM0CI4jtqCNhtEX1c9tkbMYvoL9cUdM/0ntjGJZ+ux7urudbTzQkZyVJSoI+Wy5Ek0WXKy3icWxFj9F8lCJlxDHXS7U5s9pVCOhK7+awq/ZkV6jv94iOK8CXnVCWZPLp59HMTVHbzT9waRtQM30e9rdVMFgYJhVPC+c9vowJRWNOS/3PlkkhfxJHYxoEeqTgOZErbuquIZDLMhTZzfav4iXwDNTXshE3bV1DsQs6Um7arLhdjHg7+VjJ/QX9zYvZkKCqgxJV4go/pbQ/YV6epN2cSmNAR9+IfMOcvEizGuItuTl7/8sgn8iVJb5qab4F41vWWk/0shHOXjlYbznf6dyTjW8idLpiNUmsbZ9MmuqMzNWIYK+GWtzEvU7XqzldzMQpNjFrXMKpg8sgs8aQAQqEUAri5rx+n63v7bW6H+TfxULF+meZOnwKA6CLPNYtCBQaWuoYMzn/GpG3mNW1zQY0nG3L3jafOXZJXssQ6IxxJ/QVnxHG4DyDzuXBloVf9SNZXuG5lcdBt0rf0b+HEu/xHRLXu05+WT8hNtsQZ0+IXjSHlS2ux+VaVdE3lZwPZ6aTy2V+5U51GXShg36asu6Efk56j7LDHyeF6VQtupufIiszUWR2g1Ln4fXRH4GjgQhEaWjynF7nPNeJ5Qb5r/zfewBb7Cu7Z99Lk/IkyAieq5+V3NDymyyQKKasXelNhXhOJ+Nqlcg1amepLsBili37Dl9qekDu6Qw37xNEkVYJq9WGG5vgAf02Qkxv4eO6c7fQZx3z0NCGTjfCp8Zwd0RlkwDG1oZui/PmW+f+LWZ0QgWsV3/Mbnci36hhXcXfiiOhdI5F1nF0mTfwyJ87fywZx0C5LSfZ7a1GFHkOYFhLrenjCNhuSU13VSYD4UXM60zhX/h58mywtPusfYCCc4rLuhZatEQDet3hkiGPBa3DMIEFrkXTrFV64zRP4iIFgdcYeRiyTacnfLcde6LevOrbZXS4AbAOe5ehgPzYv0fK/f7N58dBt7CDN+vJLjpjS1schtSfFBGlpTxfGFj5aPk/h9VeVXucQXBIZxDKAPM3jw0VA+N+9pjAyaxEHPwuydeIB8bkFNadEmrUJtaclpj9nO6oASDcltqo8CYMydpSoIT3z2dTLnr/7yCouAkQtXsI6DAnc9zqNIndM7/u++CNF4OzGF77j//352xwmI0SOBc0fojlM/wNYKeC0S2jgWN2mPq4wrRIvDJGusY8pKq2dOXBOmj4X3W7YaRv4Y+S+9xRu8INDX1ZbCPVfA1Q+CHnDldIPNKizttC4Tzj7KnxQs8+5x9SZ/g5Qrcb+WmUZL2RPMVqUpapwnPcx/bMIw7b3rHROsv327Ijzu8RRQ1cXgt10g60S+nUTc/yhXQRcS/Fh1O4OTOXRNZnp4FJCj83NJ6teiYZaOJHHOcn4Copznb2N87lwkqni1BOCCWS0d8RiJICNUlx2tK1lR+JWY1NThX10W6gEWkF7b/uGv+zkZGY+wiNSWWlsj8J1Lu7oE+9MrkigMCoFKE/WUgoiTjU/cnLu77DE2QlBEmDRVfLZP2bIg2M6BiVZD4EJaPlnt2HftTpI3T/yZOfsVS1HJmSjxlIQQzaJhoATfYW4AU4910ItQGd2hjq0NnKvblcUJ0JvAvQ4qavKL9jt8d0nRkdgKbLCS1/TTCa+pbd+aSHEZ7ZvTvDGIMTQrUExWoiDvVg1PvwLXEUqbXmaXi0jlKLeVYrQNENSmM4U5G6MVU7pGKEYEEH0HEPuOkUdU0Ld5S38tsK9r8UZpMJBSaKMrDMBNafUqIq/8hZvgukCxVlgd1TCsXOC1gUfTJC3Qd04/pCQzSPWak0iQElDdgNYyttJcu1jsuelETd4/m2UQ/wcOS9x7PIgoUnjGteY4RRt3G47lEX+sdr0TD6OClg41PTTpJz8wi7xZq0s1uwHFtyYzUfvSUzP1gwrBIQzD6rfc5lcjoDXya1JAIoWFTCgWxfav33hetkhKoic/URY6Zb9cuRQC6t31kw5L317qkhhoN5NT2u32PKfr98Tjx4Xsalq+WhXeadthlZJtunQ5YVeWI67vAo2DYgUVL7/jYtXP2cc3cESAS0ejiSdLBTkZlkA5K5nQvtHnUzqadnBgsb7AsmRbFVuSIPiq8FKmqyxxLgmfjzNrwu5PXzlHS/hpKUgBWkzPAOeZUDDIc7fTdZfexWKQdG185N/wrM8Hk1O3BD7wN8K7hGKmZydWfwk5+eSzZHhtWy2kDrT13BO+JB0aKhGfX6AnmXjAZXclmm6OK0i2XyYyJqTLpcZzunrSgfIXOCxeNBDuMFOobnMlGlhpaJiu96p823cd3BOBl3RGqaLJZbTnlKkyADRyEr8H1GrBe2C6LUXU20CyV3tkmfg1QoJwBsDA9eLfKqk/TonPyWCejn6qLAcCOTDG5fuxWTrQk043twD3DPktHW4ZhzmqKRpj7rZwjL/BR3yJmGy/efu0gQxE8I+f0Ywi7Bj3nFP/CLwofw6oruvAF6aQOZjA78r6zSdQQ6Hs/iu8fzNPvcOs6EBfS9d1tIjqKfxk8b4Am/SrI3T5mieZchJnUc/uX0UrENkO4DfmxKcYybqUDzO2jPWekl6b+XnnEVyLzZJrY6AQWqWUMpF10PR18V5TzM5PVcBNdLfG1DgOMonLNFuncGZfWHMyPJpvrmy+DR+SDRPlhrgx0F1d/P9avBAj2iaRCs4Hm/Ys3M2r6SjBq0TOOM0GERNZce2Bzmxv38XrW9nDmW93pNx+Hxh6LDPAhzjwYCq0EyJiYD1oYdwGgWQsWw7u80fwMIzEWMPVWXdqE1XHUTer20+dxsM8P8zpzUQNlF3if5cU+XoRtRIItZe2XxQFHnkGr0JyS04ehuYgzSpLd+P/dz6POO2DEooqyI3DQxEm6Bj258iHKMCyuCRpCUNWBr5Oh5VgSmW0QeLfqbVHjBmMxG/NnHh6c3Pvu/32xwcc9VWzR/yHScSNwnZvQPis0N6FLJXQECef2Lsqad9SzncoTk8Ien/n9Kk3ywbEypBMcJnLZ/i855kt7fiEWsCjD3aKauMGa2mHTn67FS5CpuGOOjn51ibioEFYUIw4bsgUc5w62NngQArT+9atZHZAHCwDjOt851ckuyQzppgbEoyd1NifgbUksVJXG7ekitDg7RytZ2jB5O+r13t9MCTUZ2DS8Phh1GxdXydOXlWnWgxr0cOW8qpmbfJ+sEI9cg1Iv3jKRlAoNBhP+kt8V6hn4dl2eNfy3TN6bQOzy+vxm0N6ESzRRVWvvkpNcLb/eIJRwvAPkfABIPu2USODc4sY7vGCm9sxDhcsQKYurgUNUKU23iTSMdemQcywxVuX5xr00TGwi3IAxbyPJFwzco4WyJ9EeSnMTCsbM+5g7YtAYXAJ0ayh/OVSNrGWIuoCWwiw1R6FEvl+aWwg1i/hexYOihFTGI9RAfDGPlZpLODYwn5v6eDukhK15TTgUMV8oExEVWO3WuFTofcuEj+173zwugAgV7JHoVzeX+/k+LthpA9iRs4GdFi8HjZAPb8r2SdEFdeCjGCguPaVNX8DEEN8Vj+DE2Otshdp7x4Ck09lA2S2QoN8QYsoPZ+dOaElZTc7gkUYGpJhGDim6GFS8ESWDLevgORXOLqvJJL9WMteecwR48odv2UfJ5k1EngfUGLSyelKVlW2VOP2cb8+J8Z6zYinQQtPrebEqn+SV0/lefAtzKATxp9z/uKEfSJD3UCozgo3nkmXxcbSC112os7bMfA2HC0abB5P06ZKUZsqHHbSLVIJXwWMAKwG6q4mhEgXlhPmGYgRsZKXw8EJ1TzhaydoUHUJTUYi/Piob0eGHLpQJ+Tewd39ItEj5aCAQqf5Dt68Q/Lmtwhl5JW5xIag4qQopJnT1LBi/3zC85ebReiY0JuQ5w2SPeUv32osv5XchFEkBxkvv4dwrwhS/zEeplG4bQN+RQjGXu1os0OFkXYh0PNhfIXLRmwdjCXXHm8WTyKxcL3e8DLZDetsYBlJxJkA8RfB9M6KWiLea1avEp1qJbIpIApORknDeQf9VjHsuIjUpEyiqRfCMZOkMHZjTa3Wi6zXGArBTpu8XR4MORHyIC2QH5umSUawZQe4btXpAwA+wVZBmEFy9vWIYCbvhAnUOHrAZxaFP8p0w4jmZ+K6nDx0cohc93iMJFRLIyapJgfgnw78pjw9K0XV+pHxrgj/4nUFdzrMU7oCo1bjQKARuZfmV58ZXwn6TNIE4x05WE6ZB0mt6jDBPeY56dBuq/vex4j+xYP0a33TZ1cXf2U/f8xvQd5JKUiKEtIBmyS+S8bqcFF0KdtMZ373DWHDnZVOpdJwTRcZaXnXtP9+9Wekyikd0YESfJtHXo/9gYj6Iur0DKk1qstLmklXFTy4DU/osMHGlj2aNRRBqfU1kJhLeO+Zic+tJFSgdD6dyFKTloOnmP7eaT/5rh763ozdjQv3hJxCN1BWBmWK97xaEhcRImcJ3+nCus5jqWf5qBE1ErfYpzHrww7n1ywd55Kis6su++G/A2Xc0FiKrXP8Ly4l85D7DPUnrmWAcAUKzUMjrZLIOj6nXOWby+j9l4WgzB+lN2JlFVdrwqrwskZTce7nq2zOIcRXk9N2Uof9se8sadeTHCiZEQzl7oq18fxzRRK9CyKi3ZoMuoKaa8dfc5aM+dK47O/zbEtvsO1aFt1tAqyJ4nRGjTXBn+gJ2yQC5oYPFvFe6IDhvGF/OfrHTOVKzthWVl3oGZEMU4m/7vLTW1ygjcsCT0wc3GpTQmNGM2qJxRN/CdS1uuDkAifdXPo+zLb3CqFYJemR8M6ohpCUv9BnzK8yadPmZKb5nkjpjBoOngndkRdvHsuWmJY/f19hUzD+HKdcOFqie8fISJ3r0rWGSJuEY68m2xefE49qvVlDdAn8Yyx60vjgO9gML49e/CsYivdtP3pRXGPwC6nFeg/sFpHDcEWDrI6MTjfn1cfsKUa+6Qyk031LNEVlTCKZRIcGud11mpK0rqDvbQyGL8Xp7Om4B6OmBillwslYUGHuVyyHVf1UAL2kYfgw0LU2eGNlZjSUJfE52GPtL2Xvt61v66aI/6E4AtSJ4nqRwZwehlqEyX/yoBpjaZKq9JFermyOi8pNQBXY3oHHPVy1z5SHXZxg7NnQw3kTywEu9YM5i81iouBcNq80IcqHWVqwW8qKQz6C90ui4IbEsaxERMUTC6GyLvZaXEZAkb3RofiqeQBpc7BOlKrfkWn4m+3YOUCPzWLiIfic8FbbcL+UviPcdNc6/zPRoPLJDOxXNbDxG4Tlpk2tuXTio8dqy/aOIWQxtZKO9AasdwOVCdHh0SEo5RJbPxjRfzRUe3NlVvVZkT0bELUmIXa6WiKASO/jcoKtwQXHa/dEOhze9jiFhLet7PzkHZsjIkDf8X0YaGJa2gMLwHCjiRGxWJdsohS4nDmfzbOqz5l5b9CVwXzJeG15tJoaJV1cnuUQONNiDhHDtskrBi4GLZvfvC2k3l5VzQXBELsL9vjCS4RpaZ9TlGxRWM/+BUt4favPmUz6KoNzZtOlpj++C2WOLoxpf/O8Am0VUQBaNC2hNGALQB089DY22r5a7F2kKzhEkEdVGutrmSt/4ya9XynwyHU/IQiOkE5BkAquEuLhUspN+qBTt0b6mYXt6uKt1GSBkbdghYin4YBZ0t1dVfMOn9nP+zUN5WbGUUI2IwexQuzvj0Uo/ieH6w8SRikjvFNHcIJO183uSFFO9oiWNhCQ5h290f9JB6lRNmkRowwCT0DTx+5btKfT+szJtM9+KvBuFGybctD/Iv78/K8+j/vBA55lZEyM2NfSLrnzV8HF1oseTY7b1lKKXDz3HcfmbUz5Q9qNBDTgjtpqBVpwgkK9+v0B39cwg0f/X12zwGGLsKQnYNw/f2No3t70Eh0pdlfkWpjM6HKISpby8VPIymJqg4s7UQFvEfHogDjj7RVwUZVROcx4DRfdXm52c7MIEv//339fQCycBsVV+Fr4vbxqpwyhgJ9FRPESrDLA0p7AoP6BYGJPHctCitPHoNVJR7/5a1qDrQIpS6nd9jqizykpVKfaAcR8iK5FQf56OkqYckvC/+dwMQXDWqVp7uA56cFznbecE9STMjDMhyG4ZiY4zYl0rm7aTwTi+x8ME/KIRBN7th7lAhwJq/UanSPtzF6dHsr7XhrRLIxaQ43cZi2k0OXWlKjZMy/Fk/rbrRLfuJXjALcqbMnNII1muI7xbpJLRq4GUj8skP9YvQ0SAJsnmTn3tqfrSzZyY3kupzoKEq7euutgTahUhtA3EImSt4YatE7ZwPbuMsGWamAqmiA2kFk+hsZ0N18QsKsIGElh9KhIP0E2qTPMKHGsJ3PQfxRksEolYBKPdA2OJY6sVxUOEG8vGthqTvydo0dV9xTbP+PHqfcWJHJmpAivhtiXIXgcxHHupvdEsEuRC/Vg2lG6fMhgyNoElvSY1qrWQ7s0vU0+riiqMkSXBtKd+PM7uEutmBL8PupA9CX4P9nSfWbdQwtkFY/lG0GggBmzAcDfCmfsQKTKgBBdyp3moFs+/fHTYVDL8pAhwrQ3RU6jpFrT9GVlAvw5juPaXYI9E7EgTykwk89Kb91CYYiOVOY21caq+kSs/+Aj6U7QxhqgTQTNUzECbiWgrZj7CgciWqJLWBfP1WHUBv2nAuYD/YYsatu5k46Y4EVPZ19MtnAP3Z+duaKymIBKjGrASE/on7wsMNzvQc+4PzqUysIIGBZ6GHpwYDtiLDo7R8xojn6DXIiGy5/J2Qr6u37k/0bIVHkz3aJHFsWSmncgs/8DY91yY+Gtg8MlJme2fTtywsFMlfCASt0g96h+pYlj+2t9tf9X5hWrSp8SHiBkNSgg0pz1UZY6X9BdD4RqulRRZIOb4P9ONuYL56KaMIQEwfNWaMP+pqeX3R+jrWAt8LXSHUEbbw1PFc7qnJ39iYASFT4wU9QhDIaZ6UBCDJFizJlrKMVDuLwYLJ8+qzIJ6y56YiBZwaaRwy+3+DbQ815LE8eR4uxrYbL8hXhXyNbNZoPaBwzkpuCXbUDvkvJTT6HAEGvJW993otiagruKd84snoyg1KBg8lIegMuowElmX3XzWMB1Td15HDHvTHb94ftGNSVk9VOYGxQLOc89nDBqxzwZooxxo/NyLxuNaVBPi81ouKW6YM4ZroUdnPd7kKBoh6jIcYGrnUC7TXubp5aDgvwYbuLxFRjXQcAdM3gYxaSxWp54kgp/N183W8Dgv7BBiSA0b/WEjO/SF7/05DcwUShQu50UPdNYDH5+6Iq15hiWiRedXvKuRsR6v9W3sWzOBhALVDmZpEQX9RxFuX9jNRE7VOIIm9tA+EFr/+paig7SZrdEh5j90dRpFA1UXysq1Hnp9Yc6D85ULuTPe8xP3gO/f4rGOovKWDODRwWsEqTGuHSVcFbdf2hvvSTnp4Kbu5SOgVd6S7Ph9/OomP7JRwIhUDUWMzOGffMEbyaswRFLtczvPnSJwiwp/1Eya7EMLJ8rlSjjI1MfgmuASzFjW4LqziNwrC/DJXnQJZRc2rVcf6UF/IsLztoHt3yexjkhLDii/QZ3cqQrPM8HQSgoOqe4TKqsJOyKOmwanZJMgWxtOc9KMs1TeVBPkgSO8Ja5lrwnss4rD6mIw0D6CxJB9mmCvtzsxpvTSWZJCLvj3mpoRc6x4VVJ8fc7qfaHy0Q5eXtxzGB1wudUrh2SpvsHSatFtlaAaFp02A1qekGCCTfWgMLTcSKyj7pw8MO9EgLr9vpjIHM9VeU1nC0MiphzyibRzrU6FdbigiBKmnQSzODwpe4OIN422XZtnZhDbSy8zUTBRh6X0jWwlm1wT919xb5nsDmm3EHumICiPNmZHPvSkMROFphVc5W4Vxc8zLcNsUPB0V7DRZOXV9qWh80hGcdegFUy1I1IXNX7YFgkYNYmVYP+KcUBhVn146V0Pj29a4NswUnVN//n+cbgtlGpkc28aGMPC5ndmlZcllaYIFlfxntZC1/GXGg5vYzQO95wFoJg8COKLeZJg+cX6jjKWQ4+U2K5pa5kqJmOPfBCOUguKTydTqZy4PVpK+2I5QFtAyVkDmAALpjgnp1zMMBoFpY0rRaB/fCMope8/647VFYamnuVOafoz9m6sHzMK89xOF7YTiw7S7BvzUlpTecDMLTDFDHUUvPp5WLt3+ApAczljHO2nNy4ng9J+46hXEkCMwcd20bFACAuU1J0ULs6QxjKGDZLD8BV1dkAAqtJXoh3cVyPZtA8db1jK9vMoD6JRFv1o5AIWBmVQVcXre/VlVcB3Ob5sC2cY70oo7MpMp2RQmvppA56NdNG3bZ0Jn7saIOY6VhfrXQ11YhFdaUy18Jhxsvo7q2ynRFHgtnNKVhltfJNQXJmyWR8SkAQSzLzQzDoPvH0i5g8PNjv1NBMxlFOp1afI93k1yyFHYMtqZ3+5jzpyjKEnuy0nu0kaW3ZmCcIGSVEgQqvaKcIAAAgDs8Cisfr+wjK3ILsECUTf5dTUjMWwqcePt3jlnKikdRr6hvjf+PH/9lq8LZkxOR5J0mn02buGQPhjzeCaieyiR8FED4UtHHvGlNGKQ/JewhY1/Yrzd4fw3OJlXt3EdPjHY0traUgtVJ6jneDVXUGIBcjQbpW/Lg+7FuPwMwVWMvGkOnWll5rQo7RpBQlTgwyturexiepUxLHDCpYhpkW7lGV3MSvGcapdETBqGhGqMa3HrC07bTyVlDyM52vkNht9cOx2H5GDxNx7DsVcu5LfoFZqC0pbO1HOT2cj7Ruje2YI5+ThYAJX66OJUFXw6wuCxazkdSzDDjUxUu3TCHuzdH1uCb7MvDQt50PxmxZzEVMK54Yoq15OCHN51GLOpO/w5Vi6X19BHW6f8LZWS/iv7MPz76k886qwQyy8Apg3bFZVZd+w8ac919ZVgAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAByPLqm5duZbWv3ccAL1UjHtwDb/6bA53vLYRWSUjL82pboZ7VZXMSYqSETdIiCTW4mYKBlN3lJSAHcBp2es59fV++nhkN7dE00PX3EV3Ojxi0kCkMHmEkHH9OD1gygMNXXEtsL0bSFGIk2J8ko3gPsaJttKuXpl0qwerRCdLAvnm3enPMXqsrUAMYnMhIYH8tXWiJLadAhEyVn4J6AYzyxkYj756cH5B1cme+GobpmqICyf9rPhZ7zV91JqmfOazQmS71Daii8QqLffpzVImy5EIBXfjJ7ASp/r8d3DFhN1dh8b6lO4JO01Pdc4kwzviJqEYJDcXuNjeYSJ5N3BKsRSG+nLNW1zegTxLt/jke4UAhaD3EV8SUiQZpRjSkz88Vi4SMZ9WvdQ+SMSLrsyPGfYtm3g88PI7ea1KcayvsPU5fQZPD8aaEiLCH2Lg7JcOlAkIq4Gbv6zk0L12pE5UZ9iZdPLtM0AswD98ZvqFCGH9pfs7hU8XzNeXMA/R6cs/okeWl0qJSvT7Zk/ye3alMDZ3N1++bxIgTr1ZQiXpn3KOPXjURojzAB7FLofn2NZkpa2/PAlXWi2DegBRbrquv/qUxlJL1A0rvayFwFYjannaeLxh/VveydK/Jr2EskOOi1IB/ZkvligP15IZUFAZ46fl08YKDjmyvC4sjb8YZh9C85y9qj1V/wYxpZ7nazgySRb573I5JgScngxtQvsAeaq+rb3hOGOYNNqTbQJ42li5v7P7yIKgIw/iKXMCxWkLo+hC5bqoAr3SiSY7cKqOb8QMEONLYmY/9fRvKJuYCGyInyT/X8kucuG5NwRjGwd8A3uOV2t159TvmUtROO6Tmp5aMdbDJ9YbpWo56GxTSu0mLj1OqdREOneTMAsuT3q3M3eIw9jR6degwRipd6Ts5dv4xESagMuiE5E6P8cFYfQi3PyrjJOTe97fGzPcdPlyqLtfydEBz+Z8yoHLkTYTvsRtvE3ZR0pBJl8Ii31vbG9Dcyz7rTP184PnMJJJoDkhXMOJ1KDRU0R99Q3ZggUdzxH8XUIJa/cbWI+SmjwU3NhpOuT9Zg9rvtm1aL276pEMoFxX4Y/n54dGjrbdnI8Khzoq5T0a51giiOxLsEKPDotrVn1f9Po+tkhEPXZN+09r5pM1lNRLOgmHyVZ5x8G1R8TA76Z8PUBDbAQiVnSu65on+SkcidcekCN1Chfgz17R8LPh7iEIdxRyT5xut4gZMRnaANEGPsPvD10N6MRSQVthIKqkCchqF0qp+bV6wusSXuv6TUk76/mLpf7sgSwtO1CUeWEjepGYOaUz7KDn3X+l2ajKOXDvDyae5+2vF4CJ2a5M3DpxH3Et39T9rh3omZ64FgUvvL+6L83tGA2rs98cneCe4DABhqw087YlRGN0x1nybi9rB0VRvLDUBj3pDCwlgu4QFMyWI0aNCRVhhCt4V3yOBysOGbmYBElJRzMqtkF3hRtIVXp5ms6l4gw+lkAZrD4F03pOrZ4w9CBFVio9tYZpFIv3jWBtckAcqzTzby7hN2CEKugRqwEPwtjhHTjgXC2OAzQpY0EMIqqvnfYVHHT2GdpAPrShfkholGTMKrIzoMqAO8zZA9cFvs7QHOit7AlL2kF8YCv7EqMsILXxqM08vlB1eFbb/s3K0FDXB6mHdPCpnmghXqIQKCTX+aMkZBm4FLr0QJDgVSpRc5q1FByskumK9KaajsrASTvQjvRkX10liT9NzJTVcpoKm30bhs5L6EEH1j4e0adw06AhbR/Pqi9f97JQV+kWFNfVDPjerHn+OKY6DDhe54ZB71Mb2Tc8UC0PunT/dfWYHihuJv24FfPABXQJS17nXnrhUeVUJOYpi1lNnVAzJeu363unSVvJ2LfK+P6fUYJdguYq78VUF24nuuXjMHCoGH6tU/SH/pldSABO5AoBye/v4W3jkxQCNG3YM+RdamefmtDxvsQUqkjsWo8aAryburDnZ/50t03ioylhfNFwhH+54a41hnBHASZmeKebxXMMVLD2gThdroyXluV/bhF3ScBENLa8ycQZD6ritCylALy152D0z3MNkrk+Hl3k60VJiiyZmaZDxf2xSnzR5n1GKi5eStM27OI7ekW2vylWgy2nUZtePgu9LREPJuOl+fsRqrInrZ6x8drA6qq4yCH/CWHPbTIq8T3bbCtJ+7fSxHiYMNsMfIwkQTuDuD1Ib/NXN0hFH/uvxaV2d8G64BAqMi9Hw/b9KaJJkZnwNv4kBPIu7+oAdw02kTXBDVYV945aMQrQCHXzl2V16yVOYXCaa/CTk3gZsHq65ZRSm49IZ90lMjTlkRVNjRy0Wph4U6bPAZob6Uw2wk9Qpq2CeZVQNTV20EOM7h6+lmV4DvrR1FiDm1D0J7TM/3pGlze4Oe1Tvajtgy/1qCSTsNtFw70kYfWM8K5/+O73+nb4TD2ef/rHGLaoM+dOOYGxUXWOZKyaaF8Zw7GB7tvNzDR6uSA9pzB1IIACx0j8MxjxYvXdPD3hreKrUycRM9be8DroSmHtMmoYX4Dxqx7v1GjMsKGeQAAq3ShzK7QSfgHS+GBocz7FdLrlmeJy4qYH/uNH1w6EDA/5F366VM1nm/NW2gt5nFbnYe2ihVfIh5zLwwSv5K16/jOBxK16epe/2mHGAxwRhJX8TqS8CPZ2bWdr7pCEfSl/2TavU7I47u5I8+X6cJkV7M8EUQMtuTnACTrOMYfUk/xXascoyq6MxthNegiaUKVQQRt8MTodImCyiEDxJ1zKieJNnnMi7UA/5GKJJbx5jxgwfLC8X6NcktYXhXsmnNm1Gcs3piMKqaWsPu73dkD4e2sknAUIvL7lKHFRUool+r5TrrM737spQD3EoGeZOayvd1zHFB19Cv0fSwUPZ/PKaJXM+GyISZujFE14sM1QYJWEWlaxHtGwlGjX/YrKSR8wb8IQ32ZsflI1aYIdKa4xyNYVfWvk/OirzGM8m01/t24+bQz7c/V1Ycd0y53IqWyrV5cTuuQyYIZjDioxucJfo+/7MRE8vuCp3KoseLAtQziv1xgxRJm4BLMSudSZlNvzj2eUpAtZKV3WRC6+YUk8633S4mQINougYs/dN3xiHAhZqcJYqMb9cDCDCXVyOyHtmyVE0b2e1WHosIoQj8AZgAmdyk0zhFh2LO6isYnXsKdkrqAogM4g+t7vaztQn0oZpUC3YlrLIRejSXjhrbjVQXMcTZDBiL6CtbxD+pnRZNBX5nT/Pyh5hwLYSsMLaOPTLOHmcma8BFzqwr+A7eqKNsROb173MAPrC9q45svI1rEmgHlLTXjpK7+5VuSTGFtCJXcI6tFMeoJlYtEEnJZtur9GhYZC+U4VpGBbreA6xCs6/i9lRrcxIeaFqwlknlOkEWbDBRlXcWlY+Twi3MR7Yjp5078PMDYMZ7CYy9PpuY/ehfDid1XSVswyToMSQewwCAdgnf0/SRZLE4Wm/WR2iT3qFUyrMHk5QCfEmTeaMe+BbM83AE+arVLgOUBP3Rzm5OSDmIZoRlq3XFOPuwBqEZlYpFnlXJXmNueVoAbMYc50zGoV4QT4ODZQ6x8NJGMzSR8G+Q9ti7rALs47Ky6sf37Rbk7Wb+qPnb6dmz66StTKfZclLWMIGGVrDquMDSApLBVn5GDI4y5LC4HCRhNkQXch8wSvkLLpUXW8EKxoeCqgjcFCbrrDNbG+ORHNTL81bl2tiln4OJWwsBhAJUnnQUmwuI/XoG3qV05TicOxyLnljfnWb2PgRyuASG321taVD9jGruLAS2ytWEKZcbKkYpKw4nswL2PWVxXROQvPBvHg4IjeXYFiBzHJBl4S8t3oyyiupWpAN5Vnnd/qG4zKUrfvaQSuebVurSCeYkdG/keN7tqa1Cp/yVlrHeBdZy6lw9817eEYMTZ1/WCz93X+Wl6Ka9PAKfG3RGMadndSKYF+XFpv3W62gMlk91nbVPBRdby3wCJhJrNVrQdke1vySDJrn779YZBGFGUm2mFPn3HkN6hiBCdtRQNv+4Em9uaBRzpZ+TiJf68PP2eP+7fOzrC5FroT7qwy9puP62mdKX/4ciD8/cj5eCc2O8w1bBgeGUdzVa/1s+52i26EK1pwP18fQq1HEztVIknWRUa7+OFKdCFTEZ43a1kMMreza9GDkW7jm+sQ6pgKWHKTqnhTyBmDy5y3BAegP9VVZ0wrLSPHllzbP15Hv+8tC0xMVXOn0cc9R08b2d2kNlPCsp+uQKWOyjl9/y7KRs04Zy3pVSeIop3A3tBFcnhfV0NOpkILTFYWe82nJ5knDVRjUOQxECcDSC7STn/adJd3TWsZnc+uTyIB3jQ9wHOl+Fff/GK9ie43fXIMM/WjUe4HCUaEV5+pAImvOtD2s2F+4O/TbCEtwmUmY+pcD6Go9qsL3pvhqMuqPvZvjOfcx5AFL6nJ6Y/Y23dMXVyrWMCwZwRNpdHNpylhKvoM7Qgb1CrNCIUhns2L0Ob3Xht8/qB1QEJnlLPySQTZiJs/aOTl9nkDs3h4CUGxUUsfI6AksfwlBDHiER38Jgd3KHd257YyrDDpjaYi6Duujx9dU45wOux55dJlFvcJgK/HYmicdgAfSqwUuV2+rOHLcF0mDJ0DFpivtiWt8gGPmp4vDpmLND7rglpzMAf1vmIg2kjwg5RzandPvhBbrjmCm34u30/cl9xOcj20dO8eiKzWnLMPgkdgbEgen+xM4OUpMPo7X6C7kiwHFeUz6CzJQI1CP4p/MaQUFFS7fhk8B5MwWqJd09E0XC+j2NOchoh2JT44k0zVNXPTfacRx+KqQHMyUK+6Y/snziwjyHZN36qhM626o5D7CuI7tJxcR4E1wELjOwv0ralz9nVD4CI+R+2q3FhEa1A2RHQMs7L4tM6tt9bfFk/GY89GYgeWr7y1yjQxtSn4A3vkHoEkJUDr0bkBqYW8ZFR14ZDOtMfylcvDLh8pAqb4hv2iYH0NO8D8PUFnMkF3gRH9vahDYRIorHwo4KafTJoq/qWL35gTWDwtgXfGncNQybEpvfkgku5pzcrg38f0Y0UbmmM5EXO/1ZLKFrwJdRkiHlEMW6J48NBnKXrVFaZAhj8006feARZMZgaGn6jN20y23M9E4lHWRMrVos6pw2F7T3H9+nbHuB6Md8rCYJxWq6NEdbwvK1q+6Dp5A1/CnN7dbj91ee+dJ5wCDEWtKSi3LKLzsY0Ish3JFZFLix/GHy9kCe/LVCRZwbKx0qrXqMuPFJwIxcPSFgsO/46eIWhcOpTPMi8Z20EdAHXwxem2IRNlboKdUB5/MA4tf7xKUIV58y5yCCr2wOv0kCZX3rLdIViIy7wyJLmDDl/3TX6i9Oh0fLyZpcCe+tjX0bTUJtnBLh77nNBa3GO8zhCDHZU4xHnPHQX+/dCLJEjXBCLt5FTA7LRTDYr5vnsBVMH/5HcSNGTjJEp6LUxq2f0jOokTeXdi9SJAr9Aa266zzcZWK9XkEfjtZvY+sLSi2vmNGfeKksrYdtjmqTARlOPWrrsu2dpn3cvH06JulQX5zhuCoE3cCGVfe6UUTIvGVRQLtyiDpBHeBhfY+/pB2zRDMIQv9DRmi9iwlScOzxkyRDsRacy9knRXRdrTvQZbf0l+ht93JX+d/WtPUDziLEH0g2swtI3M12+PAODlKvKHShPPmEh+cQM3Y2RdS9SvWlCsYSdQee9r6hDQYUm/YEzYlmP1MnlPg05cFAldfKOE0J7CCUAoTR2qRwDpYbDnQHsndWEyRpbNx3Vh4OPFKOrskKDKSj15IpbjA/tuHm2koAMZufW8gUtz7x5CvYB4Tt6R6iW9b82ygpTCwg0fXy0S85vyMqk9US0YCzeF17TfEz6rSfrNLT7K4uC99vzw7vdsQF/a8oZodvKM0akqoTZg6GFmz6hiZ5ooPqop+yLrq6bRLl4UBwABwLV8P/R4Y91u3Q1lnpkOgJyVvvhKmL+WD7TiL4/7AuqSP093Gz/l8lm42rKvDGykyPjDFpKxZq2PwRsBJoZY4DbLaae5Dbv6IEDwxrxL9QyhImOYOOXwma6RKoNxkiT3FxUbarEIKURVYhX8HN+HTvkbU7iVIdjG/bx2DBfTocgaQHCdoo66Prj0e+1CKSoch0kBJdKSMVOHgwBak8bvMO0Lz2RhiWr27Mk0PQ8twK//eXbC5bz+Ptk8pw69oq3EHHf9eECcDpl1zuS0cvLUMEUcM78uGfbLZSsKwm1p0pmvAxbKebH4TxM+S7GV/ldREmP6YXuEiPwnRItbHTe1xvssdwmnJ1OkXJbpJewPEX388iEXrb0uYeVBf92Rtg2LXYQi3+E5A6DhKpoID51NVYSpk82HriNgYrZWURsSdYWZBZRtZYuAm/eOl+E08NX71kgDIQGjsm7VasS0EiXZMJRxggnTDSjheJwI1BxvHd1oh/0Zc1Dem9qo+ak83Dtk0w+/v8ag5jX3sJRHOJYpj8y8zwbifwSdN5afu20bKkJd4Aki1RGjZ+QDokA/i4oZHNJkaAwER7XkWxGbu1KBKpLQsd8QazcUoaOtsxAutG4fKQfvlU17hcMAvIrr8d6GpKe5VvGpubxnUK2s8/KLm4J8FDeU8wMBZ2BADvi8zhIzUXEI4n8SkMv6+gcM5hWZuIR0zh6vcB92VHHYOcpylNpXANCp1K2cN9/kBJI3w7H8/NK5/MelUkuNBS3ApzCYgifxta8lGqmhvqAt5V+uVqBC+LdJ7Ir3r+a/maqe+fE+KkibyDy347mjzCczWCNSnSyHNc1Y1yngl/lvLoFMzllFWdfNlW7hYOu2E9t0+Jr1qbRwLAPVWwVsBL5HBtaFdBVprwuapVc3ledIqoXrnKCaR7ua4uTrG2v/rudmmUnHsbo9O0lMAEHOskxFvthqIoVED4d3wr9jiJio7A5FWqUIk2++IcgagRYXxeIICtNFmkTG7pH51ZpJ8LXJnG4ReFmdoCUWQfJsPDsuWpJS3rXEDPXDuHkNTfIHPQUiEI1w7L2kAgc1UiUVEO7gQUYb7MtWxMAWL1piYy9LKivD+JFFwd1AwN2JvjGOgkft91SIDwE5QPBb5xoYEAtPi3KImh21TTvMb8RDHIH8QyRcJ13RcTTjMBheeYfdKl9yGMhdQojphq6T3YiPfyRUK26Rho3teKfj7N1R6KfyZ8eMeg62/Le6NI8GRxLsz30fa5A0sy7rFo3y0vCEnErBqExxGTlNiub2QhtzhJnljItf/Cu+qNfOqwAeTM5dtyoGq7Ii0OaHsPTAiLloWhUHpHxxM0SqJcTCHtXLpGd0hUae0jA1Cx5b6MIWZ80mjFFIw45imaVAC9dst5jIyvxzL1i8NZVTgQS7yPsyI5lJnBsLzlgdOuuSW7gjarb7lYiXdlFc9grPlvyxIqMunu8is9GLW/GCfqxhLWCDQ9WTikRxbe1oCDCWEsYHFukUylMaK0UrAHLM4SpCfUvCFfwMEp1mXXubdlrtO0pAOW0stOV65Ed+EiRSOzhesdfJaLYr4LqYnvZik3P936YYfdcGadvTvlogJv247SxzljeG8PRhbVkaw3fSMD7lMCzSpF1qqW6zEiG+E7N7UyQmzuxE8EwZJ++qEGgy041S8QiLXTEFERDDq2r/K5EOKdwKhnqDFQP5xixUN+POxdM5CSmZG2m8JOm7t3rjIj9C8WduFH4B6qZkz0yqzz66VR6ZaTBm2ZFaooRXSFylXekBJyVeooCbuUiPo16bpRzqHfZz6IL6mV5htp8Xf9DTeJniWfYQklhFR5zpQAZOOdYtD2Ye3tUO1ybIyGXH31PlQtAFMo4FKo776IdJ4b/6y5QEKpySzcTYdT7cKf1MVj7GU9Z3QHUUuJMjxLEC8fd+JyPMfU3D2+dpUHg+7X9yyMhCOZEHEqB+colZyyTlfyO1KqF0mBTE+7L6W8/lUd7QJ/EPunsus9Gh5NGd0QILOb0bEklg9CPMOb+zKl/FVgnxKCB03sXIqnzMgNP9DqL0QMOwDee0aWRzGrxBQtoCXiMiqIgXUM16d8s47ISfCZSVInMDAQBZGiBTFA3ESnecw5lzMY1opv4fJujcQ3qQyQ1B5S2FQbxzGdWbTa8Q0JsgxyhbiQcK6W6tTcSb4W9qGjC8JBtOmLwY0CJ0ydnYfcvwDrbyTIwsii2I9HIpTkwcSnZssNiwa2uWpnG38bzRsOZk4NH4KPnwjlw3tnAhBNX3UYlKzjTfVOH2b81ICKtzLCQGjEjZtwpNADVicF+V+jVXTajsjq6uJlKygS5uwL1HDlRRRj4D3EjdXZFCkIqR3PM/Ht0vhe200hz8iWHj/U0Gl/jqdf3E+9zftxt2hya+xiJqyBu6LG/0uwWV7OJaOKcN2inC4ZqjVTA6e9UU//Q1G/MHcMW/F01jclbxqa1jJjIT/+aD2MpctCiq//wLKaOuhAANXsbNJ71n69VXecdufKmGY62j5gYqBDY7ZTxkbZ0EACcUnECYGK5MSZQA9ANRADU6IBJNinGfEScNGGpaYCcBT+3faJ11icMJ37ZEbfbaCdPluAGfm1MhcgZ6PV2NUb/DDcr8s12KX+nIcj2ze6f2pIEte+J0SfJPwsYEnbWKXyqona/lZC/QK9X6ey/p2Yfxwnqs268J2ueiHDOs9HPe2Vtb5njDz2RPPa88sEVkCZCGg3agi7iOnm9+zJWQIIo7WJcJlVCN9QB4jtOMvnxvO6yos33DM8sz4sHxQ66StqZF8at52Ewk8w+l+crPCb6R5H9T3I9Lt1tRTXX3B9jFkwCUVabDg293lSz7PBcdHZvATtRPj7NnUu+fBPM0y17YzEKECKgPVsehmDly6FA5OZja8X0h+vU5m5MlB6Wydo4R3iZpwn4boJRdR5rVSeUZddzNk+5zHmnVwtVc7zhjiUO4WLI+m1R5V3alKyoiJ4MT/yN3Qxgqm2RiApwtZabkkHzVdj/gWUdCPSmgDWrSOmViOK9Kbk3wcw+aug6BBe9aWotMZtXywEdUVFTDT/r88dI7ogiIMHPNQXczTWZTMVO2uwqjhGOuz6k4+RcWU6JN0DcsjJKDyCbLZQ+RcL8Y9OQl/bGrxyEIQiZuyyWLjYj5Nr7rnKYrr2j0hYJG7iUMFX7JLhu9ApQm4eJ6Q6bGkeQFSFdcH7/EMnNWxWPxe5itzdcVilY9MZy2l/AnZ8mlfkvDAP/C2u6UfjnNImryOhu63QlCKqxOSiOsrK9tZQonhsEef0mvzV7mbRAF9awtQB2LV6y7i8PISGnz8JKm8Spk9agHOsuoNMuGVF87DVO2nilm7+sonhvfsTDXpmv63ss7J6VipopaRakib92IxQhsFfyFZnPj++gCkTwJSvwV3Beu9kP7cqgzTN0puxNhKVlF6D5FpakvxfsT4C3Qv3Kphm+DWtFnsNMp5ABmqRZE487kKx2GQ6pQt+C3m3MTaPguPbgBtchNsENnSI0YDz6+173G+IsKd+Sp9m2AVB7h01vlU4J9afqgZCB9v1dFSoqw28CrWhNQz3flHP3+cy//tCw/HUIFNWE/aURLQ6t7G3bSpfl6+g6qOmGajCm2IFDdDSuvrVhniJ5GMeGIkbp7aWcmfkccMKD1vNYeC5w2obtKv3roeWXzJSIeO5Gi8cPKuIa2wb8Vnc51JQkeTpJjqtW55mhqVmHjZkWiVDx+yAiUwKbVBkurArZULJ+VSHjodrJhRRa7AC4VqreMIn/vbtnos5oSXRTyM1TpOC6DPqn/G/YRB19FXcfIX0JMjN4ezPwMyFMkYHTjeNCQmJrE2GIA9QugAwnRkpt/bwTbSto08EWbdNBA0vOJrlRUIBL+4F2irJJylJA0NWC16Ezz9en0NA1xrnn8TcSQPrrk2bOOpOdIL/740CrioogGDE+8bGzUGerqviRSLdgw/w+9FqaORowgMz8lEvPhacytBG5p3/UsgVvxlAtUxVQdYgJegGjTc1T1eR3Sc0qJr49VkglHm78IAmdhbSr7vH531KVM4stoXKb14NzXkV//ndPgjTLtBFWYWQH0J/QTK2rCmv/NqpRb5vhsLyVH3CfavjBJfLz1NLSutXCw4QXh/6pFj4xkSvaprHsL+I2hNdCkC1G5kAj3JZa0Rg2n6gQvSlJMJtbgQNbnTD0sNXymrEfOfB41KmsuxxNc/FZ2wwQ0mfBJec43hVawrtjb6pIEvX27kFBuMu5sn9qk4imvoPx5SrH5VP/D0SKPCRC3REzMIkGOBKSPohrOF//Gv8TIzB9wJjjcKZ4g84fkgoY79ZV9LeZkIuI7UVlrZAEpV0KIsxI72O7k0fJFeUC940W0e4Ih9yvGxgtQhIwJQc3p60jRMBxOCBGSIf7YjhtmOCJxfV6hCHAGjbxJ566a3sg9fWvcLy5HjOXyOgD5G3SHe3axjdd4ePdUYE65YeLn27NZYnXqCGpR5BPSuSF5XwOOFSYmEOh36Dhhep7g4B6FlNN7CrzuWA2CquFYKX8Cv8YGvf1XEpfnzj84THJPh053p0AHokRvC22JBbJ04EK0pOvD08/DZUjTk98t/Cx5m4+JRwTzdi/biUG7SAm+xj57zAT9RaDRRyp6BeT+KfsfU8PWfZ4u4bmHu5XmpHupnojD5YZOj2QCXobIcqEO6aiEaE3uO4thHUUfNUYvgZr86b0Oz0YGsDzD0tO3MucddHbG84XQis5X3GHUNvY6xGI9Xz/VPMUb3eYQRT2bYRakRvVES92hq+E0QzEG35bOBt7pDHYXIHiJ579RvB+ArEDqEhQq+kOzhLOSw9TrmOgSSCZGASNQnN5eQiOIGzSXrnPTBXWnbJXddjdsosamipiKwWow6baR7L9jDQUxj5saJpcBDFrCNemvR1qMflQZ8z1HsY0z/usynrmaT0TM/rS+2kGVdy2TrrtAXopB8rQNHj7GUscm7u/pH5LQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA2PZDUR7AMnJnOrAW2Ji7gU0QcflVVL/5Sa62Pg/9GQATic638cyIhlTtEU5fzNjFFuaiQCBmey2IAPH877p3pTBuYZu2afptVOXPGyTsCvwti+wEu37Wd0nEqXK+SxUHB95LTIL6KD3+8t1POVuacxlLq1+Q+sa1yxPP9xo/gIEQxQEeQxf+yuoSkQMBQlEJv+Vq26soEOUuJEhb25V3IlrDBQuZTZvj+mYN/VkL+16ZqKbeeqi5QMdlErtvjI7OkCDh3uoOxK1KaLzwHgLVOMjPtvBoo8TXgyPKMvqrz0UDp9hL+77x5uL+D1pNhs+IgAedXbtHvkLErU03wslS5niL+jYZsqHwSYkPVuSEInat7fUcKh+4K3+lIXXAbI6j9L/DpyhItEBd/PwIJnBUzwPfJSftRHOz3pBPFCITtruU4D+hXXyatt5JK4NWBONJoESd2sRvTSz614ZYz8OmquX/Yc5CHtB1baRNjkkyOAW+5Tl30aDGOxLpBNkCCcg9QjfbVA84qjS7daeZHBTBtzV7lH19c9HXddAi/Bxpw5BVMQ5uTO8Qtd3MEqnM+ScVOwDIo7oqt/t8uW/cplQmEqATGQdKMwLU6Tj4aL1bIb24NiApFQgO5jNPbWnlA0zqVvoMlKy+m0D3sKELBYEfoGvGMqJz3NqlDzpX6bUaWeuPb/4RVEJdjM431gZMBJbLauSHCWcsiD26v2nbOGr4RBvL5CZo0FCFkOPp124qO89PJfZA8a1xBS0mtVJ+o3mP3pL49/GV5+0PFqWAIkIk69AJhZe2eOrLmkpN4tjlottbd72zoI8YVIdKQMDjKUtKZcC+40RAQXklN2jLQw0iCGazgZMfelA2HjZwToH0BSvYbfBLkKkb/3PGyNgNZNEnjeZIDUmijM7/r1znZQCxGrHELn0CEBoo6QUuTrfg2MZOf8+Sj7Zrww5Xw0V1rH1GKj9On2f9C3hrtNhvuvGHW3qs7zwi3qQ6dRvxO7wFsBJgL8m63jH+C516kZqP1y3DYdAzsIPh9qhCMMyKOqI4xCg/mdP0LO7XuPpiSzLprY2RywTPGuTK/N4pu6ZA6w31AEE9cw4FpSr7qNsBpNMXzAZqeXxxMrjB5epjbokBRpDjqdVx7BiCUo07W6IqP8s8eZhxn6oWvXvqQYpJQErcwDOfr7ohIbTU6d+dm2w5DUlisMzkbUGCDZIg+di8pClDZ/VFq5gxrJ2GU/ywbPsA4kWPXBtVcktl4d5JJ6ZH+lwIZBDwLT7vKFuqj8RAkYnmLS18WXfPCvoxiRLW3RWOIQ+SngaFbzRtp95pV3/34DEqtCm9o0wi0s/vXgTqw2gT54zZUYWLuVrPv8MZf1P02Fv2DwptJ5zFk06idZnOCtmKchMaK0rZpkLg9HXbqe1QErBOtPBUiFZ/gla6VXbVNiyoNB2UXfHkOhmD3Y4iKBCwtpMCKbnPALv1ufRMAYTXwmZChTAoOrB8oYQj0oqDgf+5a3igF9U2KiaQwryRenP9hdjbP8XXazmvTOwJSFfKXH+flnZyclPSujijQKA5AidHhCDPFXigL9AKsiWPI55ncvIjZQYyOiMCgl+W0yfrzfOZWKuZiceVJkTpHeUdtSdruWI0anG1ocE3piCDSI5c4fz2dsecYnf2CKghwcQ0UXc2izbC/xfJWWKs9Zt6Ny1Ve2CeaUnXzLM9Cv26qEh+d1Fvk8FTrujc1egEKlqAI2zB4vOxoJDtY3QAcFUVpmh2TGz1iUNGJec7Kako8EL2L+NhCl4r3L4DD0qV+T9onL97+FI735pV/uKFKB/CtsXwn6klTJUdRFMH0B2FwoPh2RxSnuRr0K3ZAmTFCeMCDD6nmyYsYKOuBWW2VZHfeGvbn9mgECxZm0YzD6QvHzAKJ94M59vqJX7eGl7V+7K18AyqmRvgiL6r+OeY8ryhm+5UKmcOjHTAT9xjJwOHQOP3GPlKuODKTB4WHhf5gKaBMO7Q27PdvUwFuE03El+DfKS2tZ6zru1Lj33FCiuLouHu+si+/NdnV8GBESduesZ9fIL7NZnDHHg5RYJ8QwH1eZIhjDctHm/vr85Fdd/1F+VYwFgEAZetOJAhTnwV3zeeVqCJIeoRRlPiM2KMmf2lfKyJjX7g6sNSbgu36pU1iNI077m/tvjMVlHCxipb5eCG+JgxWrQ6OfTMP2NpKcUKF/gyNyuBEcNPqv8duF02grdm78SwPR8aB+Rm3ms1IAKHl2gW6Vk1LomE9aqNgmEkIhmy2vJ4pBvfSnuqZ5bjSAFUXaWgRsJ4TY2WURFAFNHylvIfpm71CsP7IKsI4zEJyHDUWz/tkcVkk2KlADk3cxNHn5T2kA990gF7QnQQCBzWENrOGCcj/Pcwf1HIqAiRkzIZYvBXarZMw4lSUDaLM6ZKIbU18abgvn4N8TYR88U/pYHqAp1lESsl1kTumthnj/uTBpztGfnbpxSWlDDR7Unk4MHUKRqjrVZrJXn2+ue5RtukGJ+Vbm5Ikc/1fLrY628CpFnsC2YynirWY1FOpWGUY5aKX6vi/cVBz0UqhCo9/ZBS5x6G+d17Sf8CQg+8oB5pLOVwjXQluVv7CSkQDuGwST0saGsDQaxTq5b5HDrH61evy23e1dM5zpy7v0m7W7Qxc5OEykXGM4Xx+yDxhEHGvZO5BfJl6uz8mcUdV5L4iGp/HvCNFvb89rX9afqaASdk5JXY92rFj+fpuoHv0L1S1RgaszQNyJJt9qi6turlMqh3+t1ouEk32ubKiasWydC5rBRrg2X7V96B+3epuEKaBt9xq5MNjH90R9VMUcYalEEGE7plqNHEipGDNLrpaEdBKqqR9Lt4l6hP6Qe4B9AtKDUwIE2+ehUoMNInNzC9QNytAA6yviaI6Ar0zq3wz/8lAmrU6eHHQR2wxwTcndNxSIdCB4nnpix4gW70pitDGybKFifHm4h3wZkQ7SX0t+XxQyXbtYFBE6bDVn5V0ikexLOvipF6rRpgs6coxNzVr0mdo8uItiA+qCIEm2px4yQVPYefMYanEZfkPey8Y6EpXdPIyTrgnDm1rDOklOYAd/Ycfq+0cyoewBjaEMC2Gq/r1wgmjkyRr5L49GlVYpUWgpBLESQ/Y+f3PcPoyfo7o8HNpi5XkguyaokJ5XuE/cSwYoArofn6HhWyL/24/bZlq4VeXDnVPu6gL7kIfzef02HdFGWLLRRSiAp18/GkgxHbLVqrjn4/1F9/CMzrxZnygcC2xM0sWZkNmTo0QgblrzN8j4Ux3aNpGRsz8AMU4fLUqtLvbpyIHDclD49NMJAqri4pyprpzgY/j8tFbIWxGsMOJIVBue2lFxxTnS4vA+ReCfyoEq+QOOw+1jesb3qwFRRjDx8o0dLYRMf+liCddXA8KDPgJ7R47xSIBpFHdVoTroxkt36Nj/suCcGr5j3O9/4GL9OSVqYEWy/HRyTjIyauo6SwFixhHWcfrpjJExlWOFDQo/EfM+p1p0D1PYYT1qcnNopHAcaqfjdevWovy7g3a+5E57f7gjw8D+c7CIMfs2OmthB4nlm5WQ9dplTw5P36wPyEHehoungpgt9d6URzZKhRTA/8BHrgU8gWy/jeuQwjm0fAERf8LhPnZnHC6/OBXeEdDlqmtyNfkPzAwW1X6EJZtdWf7uLMOE+sqB4pQI/D5hj0J4HYxQ+cKcdHLanc17sSVghNOkBYbLNPuq2qSDLpbgsrIJnpri/5bqbhX7WA6epV1bfl8odiiH67ksN1dreYcBnOVzW25h+K4IVFVzzIXOPwvLcVFwXRjBaP6tfF/GG0EgPTZnIZ37DOIG+wIBaOw69Q7zucmEyscSTtSP1pEZ2JoIgpYJGIoYIpgPfbtsckakLBgcLFgaXLO5bqlBtm/FgH3D9Z+JKj8TdQLcCoYkM4+ikDcHaxbEgDukn5wYQ/LDruoutTZ/xGWuN3pyveXPCI9MEaUzrLuct26HQNXvaeYcJaNEXCGoyUtV8nUzDyJuhUzIaxeaXApvXqS/zUvC2q+1sBY9449RGcjVS0w4qCipYL1WBLdsO762v3os9J91lRK4waDrEfa6EJDssc8chzGaxotKSfDS4YrVbEj1DjxWqql2YIsF7PtOEaU6hUNApKNbahh/lRZdkKbDdm8HTfSojuqRVb6L1/mNiXZ2Swr0ZNJ5qzhV5mkY8yBe/omvMVD7+DgC8qdIyAZiehUYg/Jsf/BSv6brccuc5bMcGNsagWhCDZPCdSCNvOzlGE6ujeuaklEggxlYpvhFTEDuKGz/+B+wDDECc/rPxNHmxHGm4QpOq3W74dFkJBz02kCh64uR/lv6SbWoLXP2RyHMYKxGucTtZNU0FmiUqEtiTc9Cndaz9q3ILSkmotThPQi+4XcZ4jGn7WcYS+QCD0+5pyob5AKqrZQLxN/Sc3QmI9Ov7K7vqSotk79TdxBmjg8Zamg4NzcnITjtvkWEjZUzRf1JLd7yWCqYbdtpPKHrO5cROH1uyLF/gspGua2myVsfWtzwwYPhlLiPysZBI1DsXJWleO9/Hr069K/WU8BO7gNbQzsEGMv2nWdyZj29HWMd+HGi5b5BxVEmXy0PLjB45BXSKub31NQ0eA8V7l9PcsziA61epTWiRhVCZMBou7aqiZT3gSnpKtEzMme78FHBy3dlo2DaqEO9JTn9CSotlgMy+HAhtGkMaSwxeRgnaTUC4KWUUPtseP78MO/vIvquTwGv23wN79siUxdZ4G//m5x2CjwFGqIxyUyDgcvBdhLRkvypk6GypQc3N+whQ3vdI9pDO+ERO+N8IdFUUWodfGQE4pJstKO0DTiuI0knLOiVO+Fi33S0pJGujTZSlMabvvkUg+v4YGxs7LJVNH7bpj8+TnvwYgDL2k5iYHxnDL15VswH1nrFr3FmKvl1kT+alIB6j4g42J+xrFp1C5tkzOHYKMRBhAG0pGzrGGt+o6SAZk/1znb2iMZxA32emqjjXKgJWTKva02LO2/b+FuVaTlmB0mGEkUUV1riDNYP314xnxmetb0KvnT9KhYqKF682hM9p3QRGdT/wPIdn4eG8ObFdFMgZnppsm660u/G6iFTmN92ydgCuripSTiIuxYmVEnSUNYSHTIyPsfZaA96uAKr3tkDPbfZHGM/0/UVDRqyFWbW3PEkP3mzcNQtKgBberoofgdRBWgS8F4OiCAk99lh4tEpSVVk4od/xF1QMj27n32LTws8zGzhZMTFSfDmATG2YdHUvu5fC+2D2lD3v0fI99Xn/R1X97MKi9RcpNNkblqEpDRhnZMecpCgPfAz6tAssfg93T+Z8D0T1wXj6MqmrjO/+vDysekDjl+RPBic8Pkgc54Wo/9MoRLQVOS+XayIdvfyMT67LATxEzmlLOxXD+D8fro5TJUVm4FUs7UtubIB87Iq8wJtekOnstX/C4CxuwvsT2cMrKGuF4uLomB39mrFVBwl6E6CSNeacVJ5yf3Z/QZ8eanUzxKJx3Pnb0AvJBJo58/tHq5c3RAyiir5Qq84f6USPn9gpBFMxVhfrW2LvYb6ylEr4DCB9EHzon7kOptqirGXBNgUDw2xa15gzOQQyzku0rC2AKYzw38xHPWDtqeyfOciL95H/5Yn0PgDgdJ2xXiVhLWu7395REliUjJ4n5RGEaNeLJOQwlPjIcepypDy9zdfUS4aPFjrKkcxJTg+Zgbmgtwqk2elvYom+/u1721QqpdSEQJBChDPP+QMHRPRpsKNRpYKgKwvOz0DgqLuDsLdocvPsgkkH1aMFIpJoOMfJ+bsvXIthUZPGQZgi1b0Nr5SiCS+t0uMIKggdcbfOuMLJxJTx+OzfH3uhRl4bWQO1LL8rneGGJbueUlAI6m0to1iREGaSDLgvXJw9d5MKfx78uKhvlJl2Wzj6WO3O1odze46UncBfIZCzWdntLAqYZNN4vdId6Ub7qNSFxgzfLwrBxtcicazF5dcmsCK2fR/et9UI+gYDtai6oZ38o2+Rg+ExJJBL4mc9oWj0B9RiBM5olgxInvy0zvrwsTIFk1f/9bGt46bVglZY16JxfxigcGjitF6lKgLpY5UBy1AajZlWK58N9/70MgtD/WIslyvFUgvLh5J0YsZAoo6x3jDei9Ud/B1gofUZu9bv2JVUvlW2g6cakKQotkXNLz1iT7rXfwxfcHACpfdbxvGpYmyjvlXFCyvLA+ET5n4x3WJ8449Roe7Wt3e0MVT7GXzEIpGJXME/mVNKEYej1l0UgGafDQwL+0KyqfGmOS2H4dLG5Ib6UFPxRkma4hynzTBGU4UXLcquHMsqbNb2IVEr/yI6eHOObjSK3RfTbVpC0MsHL0S+KtQQcBjElVn2mEEZZTMO22T/PxtVIuQ+xky5vcN/01HhHpWM1s5Wb8iPuGSj3hres+n5c2RKaRnUVTy3kD6PgB9IOga8qVoiK/yL/BbZ3mBSQf6kmKYIC1YxRyf7ucIXcm8I4/ikBZUBs6sALGTTToDTDTyo31fI0/cTCLJQcB5xGag4Cj644NVUKB8NMshWtUt/hNY/1VGcjRIRs0QIWCV/G/cJerRqZ88IJty23YqamtcZbBPDZGSly5+0Gq2LDMQjuZ+qKBxdWmzm6thlrrlniYUjAS14VbbrESOnx9UI6rej1V88WIOhAdUTE5xijIwkPbnZpC8tZLalOOMzGTplOpz8zrSCb2swrKdv3XJc3XhsUlYEtnqzgKAPNLNwle6Zwf1TiJA+8nltOxnM/tyurmXJkAnUEJ2M91YFdFvrpJVltqSc5Wg71IbtF8L01xPWoyz7YSJIEgCzQRJCFnWWlZKqJPbVntdeldjak6NAjIg95EHTXfOH7PRib02fNqn0t2YkJQcD98iopJuU30jHptvuEAvQMVrPPbum/Yd8g/Gppjqn2a1kmEPx6AlUt4BIciho/jiJEtdt+61na053kB5VndWYPk6QjxO5AamzJfaZnGH9yA1rkW0ta1Ee2wNMwlrGixd3xPUwoA0tUnR0k//0RlcfNmhmLPs0nrgI7XxaJaC1ycfbreFsjz3vX9ojV8VcNvh8WLwt5yGcOqi5YokKxsD2O2gDOcLo0W3HRhzGK6uOtgnlmxUVNZHZ38aKEV8yvrkQ1GeVDuyuuXoRFTQhFtIdWwGrThphlcJEencPaJ4KYlHEIs/eKnZoLZJEWS07rkxabpwrTDzRtGmh62xurA/DLS6NGJA5aUsjhHZULD94ijAt6E3+Jt+VHvbxg1rOXUrLkEBst4HkQZPoIjtX2PxoZBkkze2DoE2AbPncGLevBgEE5iFuu6FcUlAo2QQdTikC56czPSqE3IREPWmjUUMuSbrD0JTht4B8ngBCB4csl46iWN76xAFuDn++Bs+tGyP5dlVOPIHMZZypjmFkfnxRlKCnwsxS1y/pK9iyRyidRia3JkJjHksTm9Kb/5/ShleXnM/+tqDE5T7DqTC8RDDq0f90wnjwDM1R9k1slDKIAlG/BuX6GHwvtIxJV9l/kzbVqWx1k9g7zwdioJIMHssrgGY5AL4hdywdoyhlRfkKI7uH6WgP3fH+sPy7yuaNKTIm+PowoPkGL118QZneovrLpkOpQDXnWY2WsYn0dsvLGZLZo4rc+dG2zpbIcFjiRYRRSg30dwgNd0HkfSX9NcgJqkLcm8RUDCowDEk9jZYTGL9RKHP1nJKjt9yfGXewFNr7qj3kEpcAEeGIrbCX0jlyoyyJSq7It1ewVidCszDqeYPXbq8y5JyVp0X6FIeuA0uQ1ibYDDZmlYXT/ApjxpIPjxa6B7ktGbE4n1ZwXhmoz9VdGAFk2YxIHGhCIPLecOOF6yK8Jnnoj1ibgTVxOm18D9X6gN7gJpm3NkNml14LfR5wlXirKw1OdzMDFpeUpw3lBTftJ0PiGnydQQ7uHR3DlnZog6647KNwvAmBN6CIBrfS/uzl3xiQmlpdsNquziKscSrgTuU0hHk2/YNV+f9f/Mq2ynjZz5D3LsMdTzSfTEdU9CoLlMENV8gSMa4NNKMb2GC5jruUDWjlzrGbNYnT7h7XyeYaoOhH4+VymjsUTt4Z67rJsAzjJsJYLGan0sD25xaxL4hUFcYnIdH/NNkC39TvAzWfQsECr0fm5HSqJkuBijTn7dL2K7XMYjZNzav/mxgf3CV1A6tGz+gGx4+tBcuG/iKkwVUC2aKs8mgfAAk6SF1/0oEeBrnoaVkw1ay2qZsKNhrwu/BTpshpkEbJKQ5QM0tlFYRnMP4cLhB8eHwLvrExBaN72NZzlZVDZJVCEQ/C5r8fI95r93nPzYZNvHtGAJzdIwax3NDeLDZfMAblZdniF7Cl/uiwBxgzZWcK3A1n8UtP7Jagncb+ljOl4s9TJ6nGrCsyrYlLw7hDRBVVSe5Nxw49DoEyk+W1LiZWxcItdMDkOjwDCmtim08WtBTIODBkjpNJaw4XLvmVswcg3CQuxig4DIV7JWa8ACeRJ17Ux0er2sID+ISTZ1GzORrOfH3o5yE3xT/dDMjhFMlzan7athXKVXVDTyMV8hbgoZSrHcQq7nBdvPAfFtHC+ee9Dv06gvWUCgGi/D9uzpc6HBNUYZAM7ocvoKjJ6J+Tb6JfWAPQQzfcxGmQwvQAMp4wmJmnH7NGSwYAwIf+cJBieSpnYngw5QoL+0c+yQlTAweZKCAfyY2TRtdXV1ABLPx2iMiNX+kYTiQhcMkkfvJzK9vPmoDlUV6AisCNTdI3fnxxtaXz9Mb8lzvO5WW9FgpVynJhXSqqg0g36oFrXtJFpbUeOuE2oXY7tvAIMm0Ob3zmThbVdhjRJRIHGsSdUTt1SjLcbdVLfZxsRetWs/QQrMCRfbx7zlzmKvY1mCcgVj0UNkSNFSDoE+49p5HQFLga6kmYbDVNjvUmkjJA8VynyanSMLW/ttmX9LaRyewBLW3n3k6rYMcCncEQ50C57oe754qX5AIlKzlHEeQheXubD3DpF43o+ysV/zmkhxNaJja9zqV7s6FO/DKfWga876f/6vCVqjYWeh4K1kRYM0ksWj/zHXNOsMFv7SoGCZJzGhuuryEIGekEzytKCFoOrgsOopbfEluVUNqNvHgYo72KFGxZ6IPH8r0WBsOMznLascFbrRYicaggmf0KIgSQK6n3NlCrz69OmRyvTvwvpkSheqgzDLp0bZ0ofxThWRx9uCFD3TRyL08pL8P5E9DH8MHuzSX6gwQcXGlKyNBV9hblvS23Iuz111t3YPkQwQ34/XqcBz09HGZsp/I529GFcqYex8i4Hr4uKgF8+qmj7JG2Ph5mtBc2aXbxuk2DpOFhYaH28tdsKZIes/PRJcEbkGPRrCdqIFgEuz50hLcq4Eg+SzxRe3J1daAZpx5bKMlC5w+EpS1zRIiibHcYOwEyfHNxRl4UqFmdM8ByRf5J2jXkWHUCcJAdM8r8h9+BJo5w0rsN6CKCejwc5vtyCSm8fsAkby/dm7Caxa9SdFXQ0y7eFo00LNmkuHY0FBQzWo/eM8pN8Z0MdFdrotSreiBrGnW9DqyvBFWHmh6PI+gVSIrpM+oIsBJ7OO7W9jT25vr7Wr/0rMcZR6pVQmEWlgz6kfdlT1riGO7DQ1j2yAHP0LNwc3Xwm7RUUOp6uk2+5sm3Nq+/G5r7cTMoxdu9YVBg1hgfd6rEmYeV6n2Ak35KvuE2wUWFtAstu7P9PID8jGG0XNHVnoc36BVB3vxnSmTOmf/Zg/ZkUNYbN2sfYmWvdrShRBZDnN15eVNfnf9VsonoGJ2D7lWIqG3VOhZOd6PV0dwqJwJB5GLku+85bRaKVkeuiZxkpEWJjgwukwnhp+avArP+kJsV2T8maeUmhXa/5B7Dj9NOAjXUsC9OPEEG6GAbZqJsoWhX8fO8YO1BM/jrENSRraMV85wFP9AU24HW7I2K4IvV1iB4e7Ub2AMcxdRX29OIXS5JbTBLtSAJRqcwqLNgspm4oscPZiOvN/zfwzluaSYf6ahwf5lf4EYqfOfbsrclOEbGpIDncbkErh6NnT2vH/0ctMiLvR3phFS8gXlUZU8KW2uFp19UZr/DL1b5Pi4PPASMLVtkY/LlZCykxmuJ5Y3ILXRSqPPGl2dn/J8F2iiOT8SoNOomVMXZKrHWu5hYT8st9kP2ynuIL83n5II2UMskvkUWfG6h92/+FJn4SuXgAEBaAMW86oK4BGU0dE1Arhq2rDBHXwv1jSM0j0c1aOGIDRBADPK+CO/k8gx70rAilgAFbyeYk57hBP9+xXWacnV0VHVlKS2O/hZHLyR8N/wuc1jJAuktLU0lK0gJcYAaJnV+pEDc3GbIGaZaAynuUShZF3PusBy6Fx5+qsQjB7/txl8NYidDxT+mFy5o2YPCybj7xYlD9IrQaoUsmEA+E1ojDX5dDfLqAS+SR2IC1v9zYs1fML8Bl+gXnfgOlthuivCa0C0+mT3FvjWeAryjinqMoAmbMko7kKqX36F0nS17xhfCPmhAgrZPrsAnt+Z+B5E4H21wvgvE+DXl8qSjZ1tnDc2pGfIbU8vWdOqSn/wfX4gGnnHbgBw9daNlVwdbVhLPf2qnp37Gd8N6KhwFcaibOhURPeibgTmy1IWrCxxnj3yKRzhA36aiF1GfQLDy2cacIVptTtgIhChvz1TH0XMdnhm5FxzmABNM6L/q/hqpwdtJxSzRDFv3w3Q5hMpYzxQ3vYfn+vVQ/L6IGwdByuC0jm4LPD2ZsbGSQqklom6Ev+uTzsTjrEIK0fEBQlun8nmHxu9mkEdNL1L2QtqIex25aHUCI0Si1CxedSqzl3l13ZG6XeZbx8VCfp8OSa1AutUjhl5gPeR2sPS3uBJVKgS2ehzRHi6ES16rVgJX1jCbuKEy3K0vLIcxoLN8vK/bOy3YJPI80+B/ZOrprRsfeUW7Kyr+7jubb53ShPhj6FD1SoQPR1LVNkEwYnw4EcgAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAACCgiYyEsYJ2eoqbj4XLeI42MOcq9WsHKEGRuI/1fUVCBH4qAmFV9/kXoJW6DC2Cs5i1hOsL3sXvtMbbq/24myvRlNwsoenn/OQWoV6nPkY1QrbyWjZ4VnQkm4sAO80ok015TGzg2jAgqSvi9r97sLBWI4JshXTehCi+PsgsziH9IJizy/weZxMDZow+KypjKAJgJGRo8fhhPz8DLnlfo36g/2V4gkQnk5m/uIuxbNPNFe27TMrFMR4Nc/42PusN2okH+u44jy9d9ZkoY9mrWJAquxuzcgTsIjVuQGy4oUTH1EzeNn/lPjT1sQgvROYHfjNUP0PvQy1r6uz5m8nUAJtGYJcix2g3dUWgQWyTOmch3ykG9R7c0uUkFLkiwJr229ilDEPDUyHjzICYcyU9Z9s6+nuzIz206a133CExJz8mxZTqKSNLCNtxg3TGitnF4BJmMS+q8KI2dF8wfJ8cGIMAzgpCTWvf3rq+plHTvpwHAEq90jf09xSbKLoHTFbMs1vEthsEWAZFEPF9W7Gwpme36WONF4VNOZQ7QlSPYKCTFxP9/RKs7+gh1mU8/1iN2k5HJJBCFS8W4V5w0gG6zTQXRvbTqFy+nn85Mwpg9j42fwxi4X0I94N7ctjBpuSBHEoQ4JneTeeLnlLuZQ4oiZWeesemZbFbntwMwZm97gxAyA6jG+aCqpdFCYnFqI6vvZFz9zcD1nmAwdt3ALbRTYpOw8LU95d2bk2zHbRX0EGEmht6yXFKF7UWXHHhT/4n4RHSkcywpSx4Rnmo2MkZVEDVTGZIXq4y0W5RGVXsUfz1pRiXiMSBvBO7SzDDMOkiqMR+n8XAQ4TANzuhSgoYoukbirK6z0DQYHIHvczSGbh7J067T/lu0zpeDEw3eRsfsueYaRnl+/uFJ2AxNrw+2Q6+scGuRtnUSyESSAe7ryHINSe5PtrY/Q8YJiuMmC1Nz9U0/5JieXLT0fUK6bnHavNBTHjkgmlSQ3XyH6nphvzVhKcUJMS/3Ax5mqQzwFmA8LVpwSS6ekVa69hcX6KSQpYJ0fdi/d18gHrcBjz8KQUfp21JvXbq4nG/EkJkHZeBTL0xNmEeWf1f45LPLgz+2X/bMEQngPfCJlJu2tS3Ov0whKqPMDTQ++9Y9qPaLQ4QcavVhr+nAlLdg/9PWsKKf/s15tDPi2QbPW5czpGuVTpTTjFEZqr4YuoBSPvxjAs2sahBhzC02NPRU7dtGvY7c7G5kndIoXZcypmjLLaJ18oLyihbIIrVTCmRW4L+xkz2wgXXhWfcouGWnL5nMbG/IRt4Lk4M/0iIu1z/OW1UeW3OdNQbg2ePHnrpO+XpR/3H16stZVa3SQ0XG76b/7p/taV78mjUnVYGRjDEtkiZ5++G49CnCNlLpzla1SL5r0uDrpqOYC0WFLo2vRQOTVpau7JfgMa7BjWO0JYlhf9K48+x9OXDD2D2tnY4kUjWExGRClTEMOsUO1UZMnwHALZDLV9qTayDj2HEWQo261lXdi9DnEFx8HZAmfEq/Hs662Or1IAfYvCJSkelzJLMgF0jGC6Ry1FM5Lzm8ZmMPIoHLaQtrjdgkkx3UjRkaHWwIUygubW9PA25vGoAqyaCtioUr4pkzoo74Q0qE/zS6d9eQfPmUtSaAgVMovMq1zsV4IcEaO2ashhtH6/beyE6fnGerWy8i7vQY8b3B4AqrMJe6L297mnn92vSa5K8WwZiGfYNcaMctgtYX7hPTjUo4PY1IuQ4dS7LtKoYQiT+K6yYcKyZqcN3FXc+wyDwnlggOmmtAuiCtnz8FsA2Qx9g4JEFN5GdtKHFcnkPAoZtyGXqldQClvAUoYWJcOxZxbAEAJfI3Z6iReVKECqifM9rmBIGGTlIrS7+Y9KGBYaifmUwffTmAsqVoQRtkPSmo92HbyODRoktFwf979dLGAzO0rO9ft6T2F2hNaxJiDx6rxGC6RE1l+UhRykFJ51DkSg2BfoHHUTLrJCyWrkH1PzCrkMjIKKJrjDuK+h/ujl8Hl9iILzXoxm33VPxosEv+sKPzc0TYAnyNqpCZuBhy4a+E4h2kSLz5lCo/hPVrNJ0yzN6nQLq1i/KWo3Gj8nBt6bwnBCTRthzxPK5b3RjTwoCGAEo9ZvrW/E3TLd3l+ilL4OcsT/eiJALD5RfavXTM22A4KFjR4L90HQDLUGEU79oC0Tlb9aFMMw7lZVtD0MZmrYTwjW/fCUkODzKh6b+SRn4QmNgcg+7LZiETOoz8fcUfMX4hRVh8il/smYZnxK2QCR45A5DMJN0YxMKKVBUOSGhZRaohFIKy/5nTVb9xnlkkaoNEoYDVaxIytTtnhWLUiN8Bs1tMJr/bgaBJI95sT/W2cpXMvybVpDnlWtx7jO5vSPz3FM76fzoQJzfEAX5t2p/1BRV0AzwwHHYSx9JJI74nfC7cn63VrISqfXoOfy8HC4BhrvRzlL6bYsnmHNTL9rDBr2N/Ed9Uegk3q5t88lRJexcD6YCqWXPS8wJvQkLEGG2ittm1FLTtmUxt4Tgvfu5TnCMcW50aqDqWbhkkAmKrIJMqYA5QEavmklLwRj6atgq4z7nsoUePDosT1kZETpo0DufgVyZlgV2IkLGzgkYYVebNTz7WQI8rqXaRoRQIVYShuDQjoBPwP/Mtel/7zI4TnGITD9/rXG2hIbzngEnka8R9a5GuAP4eHZcKEXn3u69rPHcg2Ow1JPAJ7RI25ti1SKNEEBHll4slz7feoJIT6BGnyBfLFaogVXjIzzU3fjCabsDrd0VrLoWfwc2Azn9PIBYYaWSwxAZ3AMmj7cd7ajFpXi2mNx5LFFdiLULe7eHbArtxwViTzbcG5vrC+y5c9n7C3wq5eNs4ypsqKM+FLk+W4BaIiK34hPK0vllIgzMaJaN1PJNY/ZAUALLT4TlDEtiDAvMw170V/tVoX0IV+vv+laq9d9oLe3kPs0L1TOXAq1mQKTWdSa9rw559xJYABhycagXY7wJ0TgNgaWRe5dWy285FkjFXGiCkoMGIs+BF/Vki6j4CRwKIA459BpXXlsjHe8rd/cf3fjzmpiHHKvjQqc8+RQitb5IfqmmVN6M6eGYgJfJmXnxve08325Nma5JQgVWtlMglFtyO2Mvu85Gfc8UENSBv/uN2Q8ELMMTnvfUtQMwXX7cpq9PWMglDyZFRYOGXv1WgWmKjz/ZHCejDHpb8NkU3pwj8vwy6QjJQkdRowHqYca1KikiSaX+S3/uQddaAGZe72CKAUoyaM2VkCsuaFnktxCEDPWJJi0eq0/Mq6NM1r+rZuKjlnSmifJgwkcLvFrzAJ6Oc5qjqlux2z+Xv20MnFf7fm7sW8NZd32TOm7ExSkkcM7/t66y0cE7xojyQqgU5aLFQYnG9zV3Tx1UzlG7dpWg9GWpr4ThqAOwns8gTRxpSSIsWFTDihUcWcXw1Ou3ABOd5rBD7SOScjfEDEQJ+2H4vScsM6RGW9loW8nrGJL3LpxLj5p8X++Ixrex9jy7ZnuAGxpBt9CP9ZOph7R8gPEKBg0/vophWz2pdIOzfcLTyd5ohqU+lflWUdNNS1SpGahWhT0Ji7e6LbMKL5alD2jDdd4cY8mAFN+pjYt3KWL+Yjg4G7e4+d1SThAoXPbI/JWhnmReRbOIBw4WklotCkvBfP2fOvH2bQbkOMEbql6ND4ow0q46HsmCpsWo327OP0vURyvRbr40Y4+eqbXhwkVRVVV6CypDS/MDkG9F3na7B9dV1RBrFzdpDMhpmrT8e5wgn8OZgMx3DCviNs63OL5AMMzhogpzgC9ckk+5Phz0ips5lWSO0Fk914uhKB/tNs9usOIUmqAe9JfRap4yi3IlwP5OjXXVoEVgETl+pB4QRlu4UJ33FVfK+mUrkOQTcnIgy+Lo+qTYV8GB0e/R6qnC0aGkaC2Vk60Qax0GwrJOoQ0DZZLgqRt5hYmgmixOeA50VxHBinL3JK9RNAzh8zRWxyvC8qhBufbdq+44xCdw+ICNDJPZZ7/kNp1MXsh96z0EotZ79wv7VI/czX2hCuDamW3+PHFBBIWc/igRr9y1fDfGeIbNC1/xumqwHrnes7a2u0ymG5whXIl5UOvOlLJ5aa1AM/wZkUULIqo3UrjFi1Jdm9266kjQh3l2zKAc/mfuKD6e5+0UmZ7Wg2gsdjifqrbtPtVjHitDPbIbmpTC1HsvccWocW5s7aOqoPsUjMTIP0IL2aS7G+vJUpu7hwe8DFw+2h194KrEFmSbGGyIC+nNgShbIx26bzNDQazi1BE9c9KpArgLrGQHFgcbu7M+vBR1lpJPzvTf/l7kNBt3yrFcC9fzlLNKGfL3MWaWT6BTGiKGHRyRMMIZfM5UyOjPVyU2AWqKBiJs/Cacr7VukxJNz3mKbAhgRY6F8x/LAD7slVWH7Poe/cJzeGW5THP0K5PJuV1xlJt99l+R8hFn2fvXAYhijJJtb9Bir1NObvEQOK4AOOBxy4RSglPfu6zsyYNPNbH9UZd6AtpQ8tpEEOBwUaItNiAAnq/asZuDu1CFksi3r6MitBroFkl7P3aB+d2MvrDT8Yr6cHA7gB8tZnkke9Ih4wJkY1/xnV2LNnIdRNyWLHozzygpw/XKSw/iL4JFzLMdML6mjggb2JDKCY+yqn/UPGfzQbb0hfVuQbgqBIlMbpULxYSY5jAXAJoMLzkmZJ3z4hBCrOy3sCo8jqn07nSiIcJaS5F70c3p5Kl1lr5K+WPY0dTsbU25ESoM+vIGe0bKzHIwrD9eab6Sd6pfZJ+dqNHoNfXgCcc5dBH7vNEs+MVv3Y3Dhz/gIW/PklYiOyib6Zo+uDEUUC/EvnXy64wsXocZzLHYtPIXeLiuPBSLuHJ1JnECadkCxua0clPo/FhgOIv+LhYBEjjaTncDWPlOhBcb/Sm5lp4su+td9ov3EFMUud2DSfk2ULWvF3MNzuHT7Bxi8eV0z+EAZMPSK8JQ/zFuIw911M4bcHm0xHIDTBLgV8vs7A/L5ek2mMKEzlKV83zSsnV1jwnZWBCNfzvM8uauihWH4Sk3Hhh5khWI7YT6N+NUQnUKCzrqI0B3kCHTUC/Tw1syZdpECJtQ2qj26zHakQ1Om7BCClErKrJDQ2yoGWvypq/umxSoHblrd48nsAHeIWCuxlZPC11dWiPWtPIzEyXeg19wMpuUdCbZ3t6z8B/J44MQ1FW5pgy6bgJLxIFsikKvkddvqBIOMwuqJrDnEYamIHkkUOhzR5JDreNA7Z7d3TfKLn4UTuajLsjYsWUjc9dgZgiCYkEx7liDRwPO0y1g19Njhs7pR9hsVAekIsumpeD4lO2fDSqMwiYc0W36RUsfiHvUcyZHngfg6/EquN7MDNh2pY+E/gi+V3gX9oRHWpFrB+5BQiQenq81od2Sd9mLzs+J0EwLfb3hBaCSgaWkRxHjq/7uQ1I2/8GWVLwcACJltrKTKmhEdQhCOnQEc3TvYFWr2xepm/i3fGZLvBmm9Q88uSwchRO3GOUEHDtMt8unyI0PpT9M8vBIv9AjA68lp45mdaTXZbM9GYf9GBKHCT87W8eVMSG0NgqKj3QSwd1ue7sAI+OEkRG9jcJjkuKN9qA4IZPcE/N9nYmgW+Je1w4nPWTYh47hpUhQoyTFfncm1rQ6oJZkuxndRsmb24p7bNMvnmgS+M3xu7mE8CKo3/hyeG45yqU5JY6ARTSoKGme4grWlRe9qbk32x5gcTtOlTZMbsEfWziskvCwY+ojF+zGUBbPEic2eQJwyaiKIHcQwbz3E1Or7udzPIGGd8EcVdV+82H4jB3Nn/KCr9WpMF/G/R07EWGsu78QIUVt/HxzfNBssWZk/HSR3ueghmJ4w2tCUAvvrfx7A45tArJE2A3VI/Y0xGoVKiB2TtZpDCmZ+KOfL6O/35AzeSdicx3dShLr2s8d4EdCT5H+b3CQoY4WAps/ylPWqBVrKhPv7Pk2Mfty1z1u0inbryRSGZkAALi6tGk5miGoviSQbMd13IDNQNFhzOrGR2AzVW7xxeuyvaB5pT0E0gAOnbACqx35zkaGj6zP0g6TT6ztXKnPRWc9/eblQlxtxxm82JU0TeDxe9nuE2mHc1M9DKqiCjuGj6Gw8kVQGR5QMWW0tyw/SQbKnqwohEG2EZ+bwDN7yPm7HuLGy5SjjJ/5ezJFWG6SgRvR9lr8YVTcjNZK6GwA29Mz2JM3IUefM+aTVw0qR3RwzfTfVLpxGKoQ/qNhdQ9eHrsPFc9LGnNggLG5zVpDJAk3TXXvZDKIhKGZtW1wmIj28L6Cn1g+yGxopxT+61J8cQQiJL/Df6MQYf41kwGwtCQraRoGq/lswZ1DTYXkyAwAbNPar0KGqAExzGsGL/MFnh0QgVUl4BvQSAZx7KkBvxqAVOsUEzROiGJc+G8k0WTAs+Z/l9OFY4QFR2dVE+vPVZd8tJ+vl5uARFAwB1mACS7c3Z9jtt2bk5S0CK/z9sfHNPe/dmhUZ9JaJWph6AEOaEA8O4P/EDGS9ot8pBQ3MbBshP1IgVIzUQYKRGyMrO30Tc+Eih8IVbr2SuQtinVoCyJSRj81cQ2WpEoZ9sVZAvYzhd8BHTaoDdvHB1AVh66HCLW7GEkf5UtE2jlpYb4Kxv0xw8FCBT6EueYPOTLhi1DAa4nB/oBVMsht8aEWB5R8OdfWsiUfXbulkI5zPh7jXyz8wxmnqGpQlETN6ucKeZCglNKLm/7jUGrKhcbUuVJIZsCLTzYwMSWlhR+HHHFtI6XSdcFc8WMSoLrHiWH8cFrE1L9sBQ+ceRlqTBKkcM06PX6DCcT8fKCS7aMeTReP7cXTUcdhz9sw0Y4taRv4/JG3qFbRzsqTXKd0hUU35TNg6lr2mNeJid6OYk9CgTo1XyCiEn9v0gVYgxzz2+nLB95pgEn+CyRy8BwvjD7im5fPIXFxTJFPco3KRFJpvPRihKz0+BIF8xYcJkJEVg9Cz/C2tnjDfWhOKt3vysuQD2tOHOLe+/KK1i26afRKlDD606LKyEcN+zFIkikBFNipvMKWYHgavYy5Mjswt+ilOJsJrpCRaE06CkIDZpP/b4c+SsPBnK7hOCJzreFKPij6QdEyRAozEgV9p1n9v0KK3u9KMGD6lfgCL81o/efqCSVQfb60kM5r7mXbfOXEnS1bCj5rHdIh2ifyPhspgQrEb5ACgVgzeJhc3YxpGOwL5yTdyAckH821bmzfydQbolLjzbrmid5UmpiO6Rxe5SLNhKNRd04ocYmuQw1kq6MNSoWW89JI8u4EoM2pewnjnXiWJdZwH7T6Qbq3+uo59W+jtAXBdxS5Uy47r7h0JVa3674nxqDQJZTZulyV+oAZLcCwd9PiWD6RAhGtRhQCGhsMQsi3R40Uu+keYVao4XiHRGNJhIB3dPcJfsJrIo90xd2r8hpBsn0Txzc5HPKMNcHFChyb1nCyLOYsi8qtcPK6AOjTKtYCUebe02DH46MDcj9rvHNs/buTOqznh+bRdyXEAoBkPrKxctn8T33hUKCTfBRJqRCZNt3Js/K77uelJLLVOtEZhnI9PPbWlejKnN47VCnJZqjsXH+Q2kV7auInCNl5WmwunFtL/F83pxBpsfQRL/idazXT03sYXRVCa2+iiPIaEpKhudmhmyPckSXZ5MmldE7kQEKRRXqHnXPjAMnJS1MoayqWBCvUxHGCXkkBZPa8sjprdu8kT4WrSHf5BhFh0VJT8jpddCrj/kHjJ8aLoIAW3JQmoLpj1SB+9C16YH5s5LqH6rQOa2Okt3miESFeBlJLo0sLFV2JvxfQksjhdGRK3AWtc869Sb4TEDmZreWWFUuFa0I9LEBAkTazgOwvuD2XsmncIN+WflJFDHnO0bFnZ32Uki7hO7EBOzn0uO3rYpFszz22ZOBUeltQTCz9r7jIJGTzCCrPuxKK+Ut23a/zDqqO88BOm2TuJ6u3XflQQ8qCmW/UkSPbU7jD3PEwJ/PedQ3lS7sVrNeL/me44mRDbBPaRd1/c6LRlyKnayqu7HvM86iR7WTtnInbmuN5hNoaJDdeBSf0j5IUz/OOFBSQe7CLpX9PR/XXO3/ogbAsZYBbsje/pJJrvFu5oT4eyYElCG9zipNGJYWhJUw8PkCP0acE2S30sPotw4ZFQ3fUfCrBpUFMk88YrrAjzwwWo+fIeI03S9/WbMz4OJbMoUvH9xoR4q9qXYY4wnuDAvu0QwtrSGOYIFYnSsiSZNVQ0By6RGgN7U3N0PailYFQraVKVYvP/BNL6F+ytXblREXEHjc7Mmu53bzi90Ld4qd8DM3Hzfp8/gRTGW1TiC7GI+XncHc9IavzpVtJs/Be71C5CpdNbEZIruRdlo0e84xsaMOk8n6GtyuQktA141fAK4bch5U+UBmtqGi2J5b/xgl8TdKD/2EFpdqyx8IFRzJBGSFe5E/erHqGUsU5Q9JMlDOW6BduX3nOZdY4eMC4sriFStXOcSdyFcULu0MadcxdoX6rzOvquMEBbmrqEU0cPZj5jhyREkd5/XSsBF7Ta2cJ8/i9Lely9NaWZIWeww8E1hAqL6Rdz6xHUgwXwzhe4Fan2uPM2fFQFW+0xY7X6QhrYlVgm+zX0J+eL3O32XKQmDeV1TVkXiKzT8DkbHF9VyyZz8kuco1hkYNhKzWtUDjzQ7WSIhLwja3x/dYeHq4sX08xSWGOsUETGGLoyF/c29odQ0giZrJSCM1qbiV1Bhmzl7WYIL+bAtiO9rZ4Nek6h2Hkj00dy9FvXqVV1D9EoOj06xt2hNhdl1SQjRCrUO0AlGNI0pr0Aze1tls1hZ2vqEd0aV5Fkz7skiOVFVdfVUj3cilBFTEnkqG2OrnikoqkkavQ59OEUfoVrUJpeYJbyt1U08bfFKsnmafVoyPPF964+Fd3k7cpOsE8b2taJz8/Q46pD1PPRW2pAwTZRTZ0APppLY5JMCO45QJhJ61aIIrjhRwK12ipPuY6Fm2+rfQo021Q8D38G7URVPVPzEzLr2W1/kZuZoKMhOtNGBrJrjCQiBwNrCceU5VO3lIrFFSpRSxStBLXvaOT2hdSy/JPsM62d1bxAZ0T60yqF18Fvdd2JVB5RcHwjDAopLlF6ABDR1CBes3REzqbBo9OdGed4bgVhC4WMlgACUJhHbuRULvC4PnfFjR4NIeLjUx02fSVrSlw+Qba9rdrZQaGxjJbIiiqeG8LHOwHXIY8zcmepxx3iI6+HT52DepFGwbbPo93TWYBQKX8aY4VrtxQdCvEc3GTiL1XLCko2KE1S/6DJXFqgQVSFEuZVjywr1n281DnBT8PzxTNdGR+fdJgu5xOhuizluU7CAynif+lVlzmcS9gqUsFxGddKiJaMJaBrpq8+0Zs62NgCV6LE8yQQGwNyrCHYMjyzzqvM0L/NHmhWVzDB/YOj4AuXg+b0ZnSHMSwgvOcnOA9npJ4PqwYFSzm6BkPg9nuH5jnRsSOJzrgCECrhC1TJ9+cL2DXPXYTkr8HzGlZpNug3qQm0yceFDdQydEoHfjGNrluttu5+UlgJp8e3nOEqONWPVl3k392KyXSqPmSYLVVubULr7SiTOw8pRbAzVZcXlv3raBeHAJTWi+FFtfwq13bNYW0tNO2nxQzjzTLj4DGO66cZBcXQjWFiyti992xeBvLDR1allTA/aQBZRkXA7lKWfZW5Dnw2EC+mofgL2LtNOHESrEzSzRbch8Um4pXORComZnNXuihLT02jhFEU7yt1chg/6UuNR1ZaSj1EoJqFwvYnJPQiPLEJwHneTTtTYLakE4Fg8d7j+OcgsILQxD0hUa4vL5HnWk8o+PyxumegWG1ZlgEcqhl9Iqkc9GNlRirtWAQ4flri7zh+woW7BUTAANwAGUALATFUvdrjClHOFMW9fktm9NYDXNdAcSK6w2lftWJheflzzoFwlIACc25NXe/nS6RtC3xFL5xkk7ElmyLifGPkDX+EX/46mS7ZnhgC4UaP3zVkx/bL4TmaEPZPv8SHyNBwL8+PGJva9duZ51tvsq7QWwbb6iakeSXOZaZ6wn8HKLR3qu3RPKKsToJIPkBuw9zoTLGxyzxsnnsG9rzr6begzdRX7JQclxyIqZ0h2q/5FJ+oLTd92ZpKdEc3zMG5Xo5BIoKcj15M0dN26fv/BeejZGYgzMUXM2Ft/88viZ/Mt4E7GnInokzFO0WBmFWSqpB3ETKPsqBR1JcqIxJ6kT33SNnAcH5sSJJATlk7BtmyLtCcAXrVVwfIoC/hEHx61j6oxIxe3KWcxnMutX9xa2DJgSUOeL1S2W931hxUWKq1v26wouWI5R7i4Jm1GDoe3kGuZOxmC9su8bQkMNhBpjCtPmzSitXiiSlQTHjwksvThrrwhLgwSjxq10hv6ZigA8tNAgj9zJaQoR+5qCbM615dDunOWsvW6/W+0aj5qiwjRlg0jMzDs7ERBKBLcaZmFi8PIb2NX1h+rdh4jWdA3O+SoR7S/A4YLeWBHLyAgOvcrsoPveZG4wwGRW1hWD707d0AHVX/VEhAJR2NbPGz+h/hSKqA2gRC5ESogToIziW6Oo+zFCcloD4eSHMghZrFgMC19Tn0q+mUJ0e4HOItU6W6wAKW+6XhzVa/V0T/nShgC6/axwEf5vUX31sh9a4Vc2fQWzw47pRq/ByWLJCoxT/D/PByN/sD7yDgzrC7ORmIX9Ns/t55ImiyN3sZbb4B+BvK7Q9Sr4XTjwksYPaH0yCBvgZc0HpOibi8pW7FDQOjoPdj26B5fQOy11oxFdBWCn0BO6nbNnh3q8LGVMgidTdWxT2o+efUFxOwnReSqZBjIVlVxKHT8cB7GkQFnvdzAidfWpfTgOkLswOfrHxhtZSkptyXrHyLiteV2+vLnc/3KQtope905Wq17d4BvO13VSmRGwFmMecpQ++fc5wPRXHekM20JHQqyyv49op43WbQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAB5vmZ++dy7rFWgYpXOhwsHApv82y3OKNlZ8oFbFvgXmEg62ncmo8RlXaT7/A4RCKj9F7RIpoVUGZxH0I/7ENS4xgR/lEHtfW0wRUBulcB82Fx3jkuM7zynq6wJuVxwnuUa4Wj+pj3DOaPFhBlGbOru9/YyZTJm0OEjZDGpUM/lKvkwigGSWMMQSTRPhfidUim1MchFg2+ZsIYB8RO84Db5OI97D2Mt6BQP4zfmKjfzVmUAqZk0wiMbbLn9dYS45nLkk9vxwQ2A81geSQSTCxQEzGwTkA7gdYR0+pSr6MTNE1HtmT6g1FW3VkLiCY6lFEjZZ64zv73+QM/pe9xHc5kiL4veTRoHIJNVtKclClxRKOiLhL3cYZq3y6jVabJA7+TYrCImNuXj1tTbqd2mycQm94gnG6sNaEDcqH06pqxi1v/5e9V1Xu6kIEU6FDVSNdOC9kcvhWihiy8FehRgKXVWrhJ3eqz7tiDzvpYBf0XFYN6A8PZRj+SgPIcMNrB18pdcvfBkbl206qOY82Xy6noOPUGbfgMw45zpK93tysT5vGrrykC6JVlgoxeNbYYaVNuoE9C4E/3ntaUIJigIcmTaLwHl4VzKNR2v84Q/tw88Lwob3QXlr4iKZ3hO8+EKKgFcTainQVOZSSk9CCoTLRO0wuIT1rpbdhe12iy3bL3pBKzUhOLwx/ZTCa0Xip9Vmr3gl5aXTFfnFMNfEQ38J8y+zDOJIbCn2f1kOAlxdjth6a3YiKQ3X44PBcwmKsZPnDegQ02eR/PIYjVHfHsa5q5dNELUmxlDwrdSpo4qR+JHx4k6ukJUGbwno7bH5pOiTGlveUwu2HehWTy+5TsDc2jXd0rn+FipQR5e9CRrcMZarFZJmAvlwXiRu+wXiV2gCMvZhKAy62teGQJD3VbXt7NlNy2x4t/51qgwHXTJyVPGG9ARFdVI51YbFcOPAE1zRjNofPRBliAJW8Ww9HBwr+haqfNP/cgV4NeotkU34XvYFXkjjF3ZqG1SawUbE/QGIyfyh3PC2XUoi8fR0gXDdIZRsHX7xmEOWM3e7d+PGUBaqAqwkC6NiAqJdYIS62XNr0c6GgbaUh+pHym1y1LbA+2BSZ/fnolecZz9ZOZ/B9OOMiaqe2NniUnm5JskGmDoI+TKwvbEtU6FUZDwROSns9RkRkJ5wno/lbzGX0DUA6E/W9eSTU99Q+qWWkZa4wlf9BEx5ZRvPIX3nkStvPjifggOWB4ocqhscqaDhC7CKMxt7+pAryvYltOlxQTcn/aia1jmD86TtZ6exTARqrwhwj6XsqMTabh6WunETuieKm3sCvfjUHOZ5ZWSnbmfNPV5NxASlokeRNI/C+HzLM5pYWgh3v3qTNtnd1CkIP7oB+rPIeuYmK55uXaHZuT6oEotSjRCEasGlGNRaOmXsOrSqT2uztH0oEqVwPbPsZn2nlbrd1YBVwy0fyONKwKG20qZD6DzuijRoxn1589VwqJETafMwTbB3Ay+uTDp4pgENYk1HYHY4LxzauKh9RkuXosGHVgrTqCnl6RD0pPvXP9ET0l58GrP69fobSd0dWVhODhbbIXom8A3lF2Ts0MIO1ochhMaAfYMUCaXY7VwyFTlwJt6TOEZyW4vo1cgC1WbL33VpfAtUpCv90sD8+RxsnMhHJcSuibcsQ7BYl2mH6EKhExnYWKUgnHZaWdFAojukjPcOjUrv0pM3RJWT5P6MyzjMzAdmtQCcfgQcYE0Cu8lvlnVMh60B1NI9TTVnBglndo+H0obOy5xsQOcZ709i8+BmYxCH1/JohBlRFyW/bkcDB4vJDF0HHJxO0uZ3csxbzHp/CuQ8W0R2r22FvbbfiJdHhR0MDSzeyIxFdsgcXrRzWeBL6IQTWs40RsCMAEFWYeRJOQquN/v9f8p3Jza3U7KzD8C3hBoKV3YZbZFaTNb1d2AGB1w7PyIJkhCO6drUyt9Z/5yxDVBPTPUisCckWG6iwloMhVDnWK3lAUCvaiyAubOaFHeBn/ySmjTq0fgnXKZgQHciONrSp0il47S+89Yxb+SSCebCbTWjashqbBm7dqDJjw9hOCVcuJpygzX9UU3FHMBb3vyNKreXRqnG96isf8/wN4qiHkS/+VKMs6XyzQCZofNtbZQ1Vj0DL3vyOQJl8A/4bKruECIXlytgXEMTIo/1QKzERF4sRofqHOCXHIADvjlKfAz8nKzLoOyXIOtZNrtTyvjqL8njnATL7C+t1IvVw4US/YVwH6ZbUQ97ocppp3OSn1smOjUoayofvjXAD+DwjDzr6cmq0DlIpC+HFVV62fXtyOKcKf6b2TV3DyCazFTbabrNE3Dmmb5BPl5aH2RakeytYFACx5xi/QEJYVAlzvOHJUFLdBony9JO+PIxE0SxwZdgS6KzyjXy7GfkBHs2en98oGw5qO16H0i59shGaRgzjJs3HbEWSbJgv2sDhBuhh7fYcWgOQY/Dg5kgm0rCF6eOC7RC2n8MRoD+GQcz/8hV03gknUTpJ2aaIoArLguuTMJrRzHOd36M2BKg3diOKoL1f8kjbrEehfziPtqJFv23GmFBMiaIM/e1ghTFStpUzbCgGO2HGXL0mnmtOAiz0LCvUpwiz9RJvFqJK2LM7pI0EI7bv1eY0gQDYqC0wGZ10+1oi1HtuBU4vN4ztrP/LiZBKYdddDb1AcUPmWVA42dCuPVw7PW3snpg4BlH3YMw2TtgZYFs/8fJBBquRaX/6b9neYnwHfj0v5UEITOEzALC+wRRvla5X8NC9alucOY8YaAb10nVhUG5FV0M6LPFQCeSYrnre6dY9AbI5Yb5opaAo8mAdDoDUaMNEujMdYRuWw1i2Ay6LTaBUf8Eb68R1Ea3nMIs8piZflAB3nAdjKcdRRrxv8YIvXR8w55YFvbAZmBcYuYbQ8H6DTLDZ3rg2D/t/Yd+YI0XvJ6dHkCly0t5PjSBoGnjZPslv4jwmv66E+xTbQ7AeHpBWuMSeA5LPoziq8vC1bFY+Pl5npdX+/jOI+F2QyJnaIPAZj5dtRYZCosk63uejR6XkaB+btbEPS9iqUe39bj9Q59o6xi0U2rQVC/SXQC/cRaIV4Q3LAcNUlZsQz+Mcfp2H/zPYD8Br2MxbAQmAiKGVDu0NsBqhMpZ6tHIjX1ZCSDsl6vtpmjDm4YTN+oisFsfYC//Tji4fxwWCHqac1f3xaR//fVBXAMUdhgzloJbuY36+072dcmgSbHaha3RbwxilGrBIDGCtAEDyfa3ltLBsQI5WssUOn1a5uLQl5VXC+GMItvHDgwPxzFww8m5mutf+cvcKZe7Uy+cCTrGqAfVkML1XqR3i9rtnsRE58OISAwQb8IDqz1mjPZnNnxkpFBuwtNC+ue9sAx7tMd4056EAn4clFVsDFYICqdPpqaLoMSSniZepN1rWFnrVSqdMY0jMVNNEzF3JSH2EcEnV6rsPoDyPsNDj+p7KhyaQlVng15JpBGvcWeoQxwzisC1JnsIk3H9/6NHrG8s0MrHbWDP/XyIm2cteZc7kMFWMGO06PIbOGvB7FY8kTNDeITSsfB03HP+/rk20CAGiVy5THFc82ptbTVKOzZtpa1TJB6ntBFRHp5u0COw5to31BLtR9Fm8P/ye7PQSUxNuX5mWbyGIH9ZW68Q0VAXFINvAY0ZbUhQJkzXQRYVzMtW55UFRRzFiKvjWDBgBZdlxph4GtwqbODR2XbK6lygC1F/S3surjQmKjCodHzR2HGzyYYeafKvwb7aASTcKS19DQS6iX1FOjs2tBSZhFeSn7LE4cjGAj4tFljdY8/Qa/W7UKLMIGwUS/WKlTD86+7W2dktlMFKhKUnJr4sLA9RBEiWfkDs9EA45UNmA/d6cfoVwHBa67ckCNXF72OncMB2a3Ja+GIOzYvEjvQqYaSiseZclF6tcJGJCIDd/Iwk27ojLvXPfkw1kcC74gdgR4OFJji8cE+sfw0XXSVjvQqeIa2QAoIJm6bobN4lslTMNlwd8u+jrPHZxxg1m7KM12WRTB9tEFlbvTmW0v8V5snRSvrwZvYcKoRGOXD1QEjtXp6BxBZL1eQdLh1oDpJajo7+Ow0SYoveAWghmjy2smRzEzkuepEiH5cfAvOWMgAdKudTbrrKFMbdzn1MODe3Js7L42tTaHzLewlMd+etfvrBZjk/RoRfbpwOjw3KXV3dMxvO+HV8XdK76jwLlC8ZEBCMOemfo/eeb1VmprDnQczfdySaKDrpFp6QYdtFRtCPqxAM7VQvSjBfEcBNEY7PZ9mJiH7G0vo+74lIBJaIWzfydrj3ry6SFDGkNRbXtQw14wpbDVDEUMG3YYi18Yi4nyXCh3jHLN3sBr3MH4rIu/aMkkangKUM5yj2nYffTbPyIFMGynKcxkhAl/2lX7VIDJwgKn6TBZmL8E0+tzHBIhG1GreADC4P9Ga3IfN8W+AQkTkbioJIy1K/ztZl2uY+sFDKKLRoySWtJmY8kfO2r2bgiA/fhPSBvzfTjPZKmxTwm5czibWV5lixOMd9k/cuPpjnO5EHIMx/Uei5f80R74kUAynpSSZcQZ8HVBrJaUgi2dL/UyuTZHrVVAQqkIsyCQJ1QeWkPN0PQD9rvvK91QnLchFY7A1K3oUMRr1XSRTFazifGU2nhX3FR1B0ctHRmDvNfXypBtkP6XkYFdfT6m3liIypcMvkIMYoER2vOdN5tX5jcAndAwrv/Bbaq/l/Y0QP4J+SIlKK9NGARdb6h+hekGxFVJaPn2/DY1aT3zlxvxzpvTyFlEkF8n2tCYAyksoLLmG+F0PFwmXnYtEoJwHy4bXwSRJe8hvCCEgQRm4h1PBW9amk7A/zdu0XVrGvnSrXw70SwvpR1p+S0BFVi8DNpj6yhVAy8m/liz0dkwe9AlO5LZ0K3YcSbRtO5QD0RorQZ7arPkxv72cMqJkVYUINivF/JkCXsYrA04CdjXKctfoQywzjsU80SIgvAHEhoXiT33IxgKndGmY5DUJG2SWCUidYT0dXlkPeObXTs/AYdVwSLrZ528wLFucYQElfpOnilt9j+DPKP8diCI1DHeKyKMOV9Ks/E1fuMGSESTsEcd9NW4ELa0VThEW7afMaSRPKVFmtU49NBkEoad1TjI58yVXDNu/Sofe7opmt/KzNHnUaPvBpQdDv1bMGAZz+4blvaMPs80O0wTqSaAj7jPQGXppXQxdmAk8U2aDEIRDuUjRVTWEonEzP3+9BDxNZqkXBu3svwf2iUwE8plOe12ro0+8+fBVUg1NuMSf1gKC0yrfylVbBEA9uVgan+PmvRBxoelq/1eFnILVcPAzCABmHRyVL5/iaUaR2bnoWcngu6OU529AwKpYN5o8tqWiKDmT6QxBZwAq9JION/W/I8FULRbqtwsQUer4MoI8/ExvHc26/YHjeRjm+HTvi1yzhm/DMANzetkooLpTkuTFIvxUgR4veE3Dfv5mgx2fGGtIPQVqAzgmrnPYj3MphcTMsfMro19LTMR/3PBKpus7lS0yxnz3fi4XRG4gQYCrIfuAkIlROLSkp5f4boCIiweSZMS0v81/46e3uStsQ586Wzq80pGJv3tU14H/A9cib28OB4Tq2p+SmZ7pxDjUfqosgGjxhFGX4wccdLBjxeHfnXCmuYds5UTJhWH0vk9yVELm0rc32ckagyFyTOCWP1XrLa/YTWzNX4YreF3DnUqxVyInIO+dohe4xFzyuiQXcOa0SirGCDq2c7rLXLfKVUt5W0FucCwcmAu3uHx4uOlJujIDBI4G2EFzhnq1MkvkCg0OZDbaFnVP+YsqrhcM+F7dXMI8Ueh6SXyoFdXc4PirUlVPhJ7YmV3mTF80znFD766cjbwUEwZh6M7AMMia0ME8ZsDReikFzccGq3OZqGjAC+iDCZXR5E8UIN07kNNEH7ZvaGHISjX5WcSVo75UQOz5Zl5uukVyDeZSo0BgDHNW7+JNIov+bqIEPneRxRu3KQeYwrZHaDDaEv4CKH6ed3qj+6HDVbF6ci02L4RhT7rjjadtzUQGIZiNALz3mvJdWynAlNsqIxRtADr9QZQ+eqjyyU4Z2dgp7LSxf4T0LYwemI1pPfSh+2WQMoZf9RVMPx1y0lOgHfxGLiHzNqiXHfrT2hXWke+k+d3BToa+Hr+vPEI6ldn1swVHVO+hUKw5zSlVL+NgJXNi397O9AU7RfmKP9gx6yt0mpOw5vNc+0DIzVqmZ6FVgbwv7e1Jj9nGJ3OED89OnkWcBSzrv7t+nf1rByxPu41Hbje5PFxHiEDMJkeYMOEDcHTqqHaqQWz1mv1Iu8Kiz7SdOpUyTlQ8SXZtuyTRNOdFzMqijJm/J0kGu2aybc+Y340v7VDYhCSadEsRUurL5eONzIh5gNo4uJdYSmX6Bs7dLJJPl8usWZaWUWqPZXdCdSeNDXQgqI3wrES9ZMe64Hw/45fFszALI72s2aBfuftzEIwKmdVn+6my91qzYgfhVX9r8lXxM3/wWdv0b4yUdZuiEnfDN4T0FkX3tE9sWWpYzpLmZhkavj7FNK1EF1+8MA9Ops5kgwmgQs5zmnkZeYzYXiFsSjB/bi3fe7z+EU6Afv41Tbn5X+cOflVb2RFJULs9PZhwWMiu7JPknIj8hWUudUYDtCa8DZDzQISRxHC06xPxmTmfTsnxOtqVEDxFNzBeaR506aSo3WR+cRqV5zy2LcYBjP2HuOE4F7RO4U3mY79LyAg0HzJpSeIaanXCVwd4QZva9XM96WI7vvG/kOwNfHRO00ZZ8BDm5jhjcWEnDs0x4U+H9i44qXQ7S8KZ6eD+lTqO2qkp/mBDyd1ohE5TAT6vpE7nN3dUtPoOis7QbUFnosWcykzaGGnAbrrftkiFUAFaiFIsMOk+hk5mnYIiS5Z8MCC4+o0eTjULbLzFN6SLV4QRY6LjW8a7GwWyEwo3wo53HGy0vomzl7RUyLWeWU/swTtZ3yGGiHTMLLWnnE0H1WoZjew1jrTmajMbdsEot1fN2SrLlI3K31mQ4EiqOHTUar751wGFj5XegEHSpoKMmeImJRnkkaQlN/bll9XSijIksbwl35FU770u8dLLuiyuU0fVfofAHifYTaLb0zMKfwWlhhSh7Nur3PzNOeVia6r2gSN5kOmZGnMEIGpk72hkSCO+ikIOdoWeWeVODl7JXuKh7O55YkFEUMdsFonHtI+CAuw3+yJM9awL+hVwMoqKPXx3qxALYQ7E/7R2DVwfwTPvb2sSUHoFHwSsV2CvpbKduDQ3SXyDw5x25W0HD7kGvO1ECZ3i0OIiV18i5HSWgt5G7qyYB9o0Gil+6EZTpVdEn/iyeuaUk/2oZvin7XlbkjuXIjUUCHg0lktUsVsWBkTZFUhaFpdyJbiEe7DdCFE37EfK7wr7sgViBUSOFlLEjoEn/GA553wVwjeLfn0VoN4pMxGorzhOeUkw5j2B0+HvZs2rFtHP2hsFTaX3CGNTqAxE/qJNbQft4c7eyzJkjEk6996vmRXwN3kG8eOrgj1lgcwo08wwrWtIPkvHnOLJ3YvFSZPpR+uN94e0QpQ9P3tSfq+LN4oi2CcnjYnF6b6PlQiuPCrUYpA1hjCvs02wTu3gpOtJ/Z9RBGn0/lQOSwZkQQ8hbLvJDZeu1iry5gYRDMkZbmON96kQW7w02xT5NwaJHV1kBsv8124XTEgTJKbIkSsWJNhHgHMoYM4cePy/7+CLKymCPbkT9kk5dboP9IR2EGhlHPm22pA+CRREjGzZ1MqJaHj1KCvkyMwG4qQEB4V13h01y8YwjMW0NduEohYFp9OmFy1lEcaL9mOdSchwSBjQPOC473qoqiqvQ8a05OvxgjrGYuBS+id11eHwIB8d7XM86A2pVaiiaQLJVjPmKphRkkdLWvIH2m33tP1fxhzU9UNaK9K6331IWk2Ljbn8zj4e+OAgHkV4xUZzvB3F6h2E3y5uXoTN/yQSDKGGSJYawTS819bzWRm/bc1XEOaC8h0dIBx8KVJcxTOcfZeOO3SJNebVfzAAKnmxbVRRZ2JeFdlEElSUUGTPGhwzu9O0n4lmxQkhceaZ7yWN+rgcBFzVbrMLaUY+cjT1E3tzuEF3Q0gAus6/xoX8N7vp7+QHDT8OgOV0RW2Pj6ZOBEsuty6iLrU/4e/jpEOTOsp/jLDjy2bX1ylsvJHpC5wISF0Bs5UBJTqmW1OkywKJ4gAECPv36l9AT6HQ/PLsneZ1thITblznDSccIUF8nSuKqqwThZnQcXlA7dd/ULy1o8qy6Qc3MJZn8mQUYqVAcPPVGSEtOcGXpilHUvhUhiCDETm/HDnWXxlNGREQ/S6RIqvWN6tj75HltMgPHYUmWbQYbsGenOJq5L2282sU+/VK0WVPPuXJ5sHC4i++FcCvjMxXgMBzX4Tb6aeQut7oJFwGx8o3MxyzaYAKhVurrVzWDIi0MKafU6Gno4KJFUlkeZvkPQbXfTHaBu07rOI8XhdlLhdMg1+3K/U+4wazQGomiQIhtM73UA+I5XpvVxKIzP/c2l6Keh+Hv5e9F74ISJXQ/OF61eM1KG4xEJHdmGDo4g7hNHPBFV9faWNeOUcE6qdACUUiRs+ps2bbZW+H0fBP/9HwR4jAaDCHHsWmT+7mhb2A8LEobYN0MEn3/8cddEvZvtb0LcaiiXTjobnTBnH4AOXUY4kQPH7657wWGF/Bpn+GcJ0B0rZu3+/e/RJivKTHAy+ZRwvvRDTB/QTTAb6JsxwEQtPmrCSIOSi0WpNAeBhn1CMuwtvfCUFGhel7G1lUvUb3MBdBNtV/HO60h0Q9xTIYV7pzq+4YgMmtMvuwfh+1KmiNnW/m2w35Ds1MlIMgP2Nu4Akm3KIMCWzzY2e/C38cl1Diiv6ZrAsk46kMJw5tuBVUhHPM8hnqXWtUcBwcFN5bVX60Ko0T86u80Ir/zCpeawSbjWNMuVlX6D1AsPc69FRMzPax9LCNPAeyf7jYwpYqQAdm0R/HV9OD5CUHcjENs0weefOIgEOxe8vpFJDH8E+KzLcltNDn71IcHIGSl/8I503wvZqFlzm+qbN+L4D9XcFedr3XuHQPdKj7qrH2g9uPRd4mVDpUkLymJwhyNpEkaaC0SPp3loEo2cku4QEPM3rUcX7/Fdte08BJs0EeAxXqpFk7fmYL7aAg6cwgORzvhTdFdoU7DyK4kl1dgcWEW7g0wh4tEUpe2zIMyYBlJ9Ha8bu5ao/txvno6tQh6u8scgjkCTLTHCIvj28O+G98mNOjM16tW80yq92UKJ/k0wkaqCS/XzAy9YkhVuOczT15Fbnh2i5trJ5vJulhEY0UuEYuFmE7ua7B8euex/pzX8T80Kt8ewDwJKlygIf3Rd2qQlg9EZrgJHsjQhgMavW53UpmANfNFNnddk1HJiHuG67yuwTNdGHzcZFKsyZxBFoVXZgx6oeT13zVlZLENA+Gy8GDR7WOwLoji5a+wMvd3K4KpEJULu4f9QyYbqazmEezzAkv9ryC3XPlFh26CISjbyCA1oL/wnS/Yvyo+esKrfgqjXM85fKMOgRLHKxUqbS/cZ8C366ToLrnOJcwHnhhBPR3l/DuB5rbHfGGAHQ1aiWqOCBqbXFrLD5nRT0odpi617Ky1o3CQSqv475cTF834OzF+fakRpia8ExOJeuqxHnsHIwet08MFltKlDWTzDOQltZq1YjWsTCxZpXlvZXsVXqT6rVkb6W1/NrS07wwbJtsFUlKkKtFTmXl7J47mOlhH13CCBbsk+RH7IPwRnsYMC7mIPfmXeMxh0ydxyv9hha6naa1XkYxFQ5i+0DQ6MKnylgEo51YGGpQ5JcTliZ3jiWwZ038YED+JFaCzfge7hk6OvNV72zDdM4UOEaTBv5/iVf0iSgxKlV2XQQ19lajVLnJJoTti9UXQHNz/V1OB14ygIeF6qX5gMJF9vA4l4KQr6cLa9iFWJf5i2qkhblgZdU3vZn2X10+KSwuCBmlKDkcmUYk14SGnX5upn+xgEECTtwH3KfA6nOV2HhSU96EgzzP/bMdyB+cMruEpT7Bd10Pra4AomvsxeoYGdM/h2/0WWGoPBnha4nOmChx9YUT+PsVK84HjJQHVErBMmku4ZEKAkOZWK4Eh3FRNC6pbEtrNaSfUfPgMZFp65uF1UBHlVOaXmj6H71YPAZNJGK2dfGUo9203VumfPuAeCS9P/JenRZn+onnAg6OC+y3nKoA9XStyCbWuDcRb6ifodDW4ZOqr1pWQkJdhCkFRfKUoHU6kVtkTElPS+IZoadwFtzYOEMa6gABzciuem/GiHJleNlwKFelQiQqlpKDpfM5un8HXja6KvklzjDXZ+1uVfSwMYgNViwTmuRqETPx+dI/Je+6D23Ye/fdr1aKX7ngo7/acxdiNwCZXlVciqvSY/0jiDOhIYi4pf++tIC6Dj5uxIGomRRypZioAw2m2Gxrx/L1FE6lSdKCEepY+qcOv0weZlwf6bUgS11vhIIsMH5LSnFAc3rsI/xjtls1+GoQAm29LYZOa/kLidU73HJKaFu4wSQZu/W4/+pQ7AhCKpp7CbEClHHjZyvZh8fjg7oaqhMtdfdRXxplLLCG7kXVcEjBP+tHhfbEGRY2WrsrXQkZL18tvq/sII8CDxJXChhNutw+WFlZlwTxQ1HQCH76SdJFsyiYSYnVyvlFDzS/wO0W6WtY+pkTbfe1p6EmphEuHgugGtGg+J8FXdPBx+UzaTitMsSUsxmeJZm0IJglNbKQz0UyWvC0qB76a0FKWD7rCVIKmB/fMoQdYGOlhvpHWnJGBNoDvFuSouDS4KNqz+THOlUUdCiBBzhn9ZwGWegZBPmhx1Q2mOYlYtZ0TBac56Nt4BqNYVQ0/zvkAz96BmlsPQn30WccvPVc1wBTVlVkcHdFZ2miTl2dEWI6I2xVP2YZ2JgyCYxV3xbD6Pi2gYSRBnpzzC8aAwDy+G42"
+
+// Set accessor to a real function.
+func init() {
+	compressedBytePointsFn = func() string {
+		return compressedBytePoints
+	}
+}
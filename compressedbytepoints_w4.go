@@ -0,0 +1,20 @@
+// Copyright (c) 2015 The btcsuite developers
+// Copyright (c) 2015-2022 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+//go:build !secp256k1_precomp_runtime && secp256k1_precomp_w4 && !secp256k1_precomp_signed
+
+package secp256k1
+
+// Auto-generated file (see genprecomps.go)
+// DO NOT EDIT
+
+var compressedBytePoints = "eJwEwAUgGAgUANAvN91M14YxTHdPd0y3adM2sWNqunvD1HRtpnumO6ZrumO67wEAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAPN5JtiJIYixsX0gcUn1EPSYcwYOpr1jFJ/e7AY+6tG/ZHPu6seeAMWBF5QPmTVLnFE9tYEv60eX26wruXGzzeulfJhYu7+gwZ07y354Qvc4IsBhmps8r0a/PSSCgzI6t4dQkUt3fVmZyS42Vj+VHm0pF/4r8kInoTem4ezJ5mxQ7zC/09GaH8Jlghw+WJL5FLXGuRLy6Y1agM20ehp5VlpOx1rCz3e/9BppXw9pvtXb0SbfOy90bI1mQH3+V/TTetenKnBl1LzxkXyIaMM2sHaqlhOnzep4jJUJ5ZaCqdsLqjxnC0v63dkdL6obu6jLTiORhY4SdPZIs2oArh2ENjU0Lt40gzhFRxP23npl6XTVYXpgsWBSrvIH+Sd0phpQy/Nm8fQZrBKmr+lZPxwpeCiJsyLKhre7O8bjxFt89wY10eBaUtMEqGb9m/qRZfq03/iHtow2eAq4hZVcbrcDPx3PDrEOoNf5Bzx2YPJ4IMqz8ZPrDZuzz0pQPeQzbvHlpSbfHMlHA6I/eyr0hM/Zh897mS0e/n66pJgLkkx8/bH14RbzVjnlnPj/xU4+5c0RCNKTiWoVRKTYded4q/kWTyNsElHuL6B98sXksG6Lkz8gme1UXj1xd9tDLcilrOraaOZHFIlaDbu1t5Un/GM9t/cEJLfa/kiY5Qj4lzOzXHUWXFdklDcX2VleY59Ew6v/quG9wsPLm42PdWw/UK+EYdSvlH1gOhIfa+6P3oxpr01spR/VKMYZ8z1vKL5jzV1S9x/+GYIg1E/zAE+r3UTjcp1ohKg7f8Pdn3HeO6/mTzvrwFUUEzbdXMaEl0y1t68ANVx9DU1rp5y6aFB0Gvc7IXbNJ662NJ0cZ1d87XalnM1jRhYdCp7Ex9PppuO+1xGk1FBItRlTfWu422jLFNU92C1Nc19+qSt5e93Gebn/iCfhOhWQfb0SXSOGyzdPz90z1Wb6kpHvJo82wJ24d33O8HcTrUBikU/Kf+XnOiOqdfqBTF9n/1CBbzqxx+IxeC/MCx9uA1t1s9Pt6AJG6Nvd4THFhE0rMyHQgz39mvhEOUX00pNSkaofYvP2vSlQful/raBCtOq8e/bSgMSrscPE4omlf5/x8akktr29T8lu9T2Uzqa+TPWIzc+W3mcAy0TuW70KlrY9ln3r1JWGXMz5puQ9A15ujvPn4q5dQW8NGHIa+ae49Gvov8nNi7JhQV9MbmVYNBF6e6MqkqN/FmW4cbxZYzf+qX8ngujM2DES/NGxzedeBMljDtsIw2cYyRe7xjS5iV5awQ+zPzuRdPgAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAABAJFORz+XziLYxkUj3RI1cNltFy1X0RNfcJIUqnHVOpWLxXGSL3JfqCXwx+yfUH9p2vTeDjqQp6qUzXebd+g8TA0rI2kOY+XOOk1v+5CP7YeX0EOBsLcrfKhvd0FC/w5teITjN9eZUJsneLjYRmLOTrLk4TBgXpdIyQfjmf/L7+ahByvOnzpxtS8PSP4OoB13Pt3MkGThuua2GP4mGEMlqLYjHjea8YjDZoRwk6jbqn1jijZ3d0nhdWiHPryjPzpxrJvXszf0Xg4NLVj6k/EfPxDgOo7OrLN+znnLT2R28YVCvug6fuchH9eSPTbomLMdHijchPPmvxN7L33EuYCMXYR2fNczCL0UX3csR5VFcmT47Y8UTpDdGSUuIZMKsAbuRLwoeWKn/Po0MDXr6xyf9WIvHPezSpys3WnKntmWC6K+zvKiNy2Heip2E11/8Txiht6mW4YpzneneNROXKB94uDC9ww4ITQqpI2qelmfevOD87pqYw1x8XRdt/BlDVS7heTuvHdtLf/5N8hVjNPb5jCwRTR1J8m4mJO1sfjKzT5OCmIULfkyjK2OhTLfxxgJ70qPi0U96iLVlpBRmiNaI/smGx3f/exAx430oNcBs7eps68BqI/PN/eNA08yB9N1zdbz8CdgQcAq0BK0BtvgI34uF5LJ2rXfv/okjnG045zc6Pok0fa9j8nuL+e2u5ei6B2ZwzSd2xzY0JtL4FdS2+U6bT3Zi350c52Il829zJl3mfqVEh8zuc1W7deVWsKPVbaPHMrwuEysiksMoCR/TpOJmyeN5vbkk/dcjMC18IknFb76Z9hl66xerWK20ypfDBkvJSI34H9ik1/WRWExq43ocEK8LrDm+7cXy1qiUeNP5MVxS7TDHzD4TahyiwX1OW1OY54VAslSb0WVu3C4ujqnnFVhGnXVBEWR2pE2YazGN7891+cb8XgPZYEB32eVprscOKPI8Lv248WyWFrVb1bCSXj5qWcuEXIzjdncVX3Di5X46zRAHi5dQBg5zzOw/3h3uNjUHkbhszaVtAg4MNPlFr3eEFvL1+gKRlYu4ss8xcFIMSZin8O8SpyXEKg3Yfwc++XN8XPe5GUWMSf+o6nnPt3PNKLm7Md1qKccWWfa5j8QvoujfIVkYrfkkra9XhYcdi2By0sh+DZ6qqXw9aP1C5TlBjsb5YmByBePvgC9VEq8K+Iy1//vu/VmLrKvCS4YowZB7JHS/5+lvKcHA5uoCfyRMPgS8YQPBGjO59n5cu9q+e7ITmxhZk9dy9ysaVN9YLc50THjzFNYAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAJoUMs5qCe7ZPh9KYQxQ9npjzgzsr/wLDu2bFcRiDTyxwhL073P0NETlF+ArO61N7cvfKrq51vIhntKdtNKl5mqflRIxxhsaaCvp7DZ32Gz4yRb2g/Yi+DcaE+u69MntLula6tw4bSHuS8rd5t8VSeu00e9vWeMZf7y3UXGhyT8beCMwxZ2RwFHlOd79bK4EiLhdk46jZwntrCrt2Dgv8FuEY+K/qGofCl3c4Htoddngbx0aX3zbyyN+NfCS9JTC6dwQiSqaanure4+CAs/Wv93xrul3YLgrjS+q1iKjof6wLXOZr5+BjAGPyMxICmLspLuQ85tUxs5Wt8r0X4Uq352svI/680ku5ilHMtUHHZ4nVTB0pbkKJF6yIemXqzYtvf/HQvasRIrgaik184Shw5J4RBz5ONI3NivJirpnpVsPLUv4J0d6L44olhAHL0O5ZrCX/e8vLzFDG6Q1F9uKFd4HJLjKPpqQ/+ykxvJQW7GcTtuZ4+yDT9Qnitf4/vlAG/qCFiM/pXf9HK3WOO2o89a0q81+lP09vcHo6Tvd0vmJLrV0/DzvEgO/WZGiXaOnOoKW/ps0uky6Dhs7F1pvmKPZfpohFoV8elQYZUZkJArT5pZ+btu9rbxi3UVE8Y1TD/6noTWj/tklf0KTJilQRT8EEH2gExg5In1TZvhWXt+nEYmyhLIA6UjLuiRkQzahVtRWKVzzPE37W5ZF01pU1c9JftXt13/giienUrasbceL1Z6U9ItmJvHzzhRGvxx7tptJglfNXKRPu9WqJHzlVrweHKdO5hvY8Km+2l7ebIgn1HSER3b1xXhJkHMdPWH5VoPQwn7D4Vef+Xko84vW763lGxldT1y3rqrO4j9qUzJdZVjOmlli5L7SFjkntJTcaZYOqDtDuoLsP/PcBFXdyYvQ4njfxnUV24VENdaNxbk4F5EwX1qqDPbjT+FlcHlnDnC+8pvgM5u81g6bnxYWOW3FYxjs78/cSFv561N/TN162JKVvJgmn51W8ZQn6kQ8K1xXv9dseLSL8WRT7a3sXfYIliAF9kLdoktVUb1+BY1WVWgrPKE0norJmKJ6xWzxYzIZgT/7YjL6vBJFvn+lI94L8nm/hr7t2je2YNrj+M6HjPdYcqKU/qYusJE7I3SO0v3DBafJ9sCirLiB/NTly+ecB/iGfj7MQaopLKb/XhxjYgxRKMTMKDaGXrdgL41xXT87RXdCXdTMkk2KYcoL8uNeifpaGxBBXMv7rgr5bGuB26gzGYEozmJPwd99NXvbtpPQLQd9x+gCAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAIBARkGnwB+x2Lwm5fMWyamF3+39+O/vQy+bJl+KOVRY2du2EMUim0l/PPSV4tYcqOyTO4FCSjTJv2Ly+rF+jgOMu8KXZ5xPNc33UHoig+/xR+MCWv4bKll/360r34WqFlTew9UdQDczBVL3FvOH83nZZyzSnZtzY/lO1u0PV/aJHxe2/xAuZG4kv3Cc/NGKUj28sENCnnj7efcf8Q+06D4T9ToVWwLhFX+86bgTRXS/GDS1+92txTAd6s+Tn91Guvgvmo/z/0NjTUtR9Tb990Sa9Me3d7dXQTkIM5frTU2/tr+NiCfFspRYZhcXYqwrfGr1Kc5nwjBbN39jNvjzAiHY/MHnjswcl942wc3xq7jjdxpFks/76qI0ZTJ77LH2KGnpoWtkvT5a9T2/MG4I2my1Ut4HzNu7TCQNwL+0nl5iPYOeJSzNyO9zlac9f35rnYczzerlSnuEPc2bPKIYsHud9QWzr4fUvtRbtB23Hz3KUPxgajiYezBtOo67142c0BczPhNFZS2YY/pXcv2x2O3av5633cOs39yiwlhtmtUNDEw+Phmqicmc8Me4rL71efV4pLtzw+WRvMvPLVQEYZ58cio5fmqbYDvHhd97zwcNVqxrXgX+jBSSSJb9L5+5ef2WRIhx0+V9rsixqlf2DUHNnXz7Vx8FLkq6fwmVboJmTyaZHzXO1ty9G0jn/g1zD8YcSq/r2pz7s3SS0KTiLxCD2eKOZXk9/yFbSnm6SM0u3y8XiCC9mDQ1jz4gROiR8iVW2YkiVFVV+jM9XL/dXfdxTTmtlWxxbKu+C2ffJ9Cq+giFc2jD/qmOY3nAYr3i669rWx3pk6NIfWZrxvhj1/t0VHcNp2icd8E5Jbkc9D8RSTeGAtx/7tC+sKV6dZyi5bgEtYRR3rQJ6fipOrssKMTTL0wDRgcQv/7tml1KF8KX9MMMnKgcHBbs0PiDE8p08UO/C9nVeYf20XjWP+1g3t9/7FaRhvan1t+3vGfib3hXX2/XrEloMOR1A4UlP2rwSVCF8x5CL2eqnceM5m7dhu/dX2G8ddAJtMThKY8aIV0WQuGpfGnA52FT6S344JFwKZcQsXPoPrg5wxJI5xZKJSwGptkCDEsC8emocSqfKvMEvy5/WnQpsTDOLFRTP3M6u3fJ5bHYonJ5xsvi68WkMdOMwjhdurNeytQW5kBnj35RNMv89D3BRzymY2l/Hg6pbQXX10e+n1OKe1DekFVfXONc87++V39SQPmB4Kuu7h5hyufpaj+14/cLtr3ONKivJZuT65P5m14LPlgMAwAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAE+Tcvr61NB1d9kxlfl19EZkfPH8pR27zjiXL4tCC9pst/4BPZoIW8EJRjcJK+96MvFt3rNwsD2+u3b75Xp3ih+CY/z1Nr5heM+Doh7DA6Ed8qwDaJ8jzJbGH9FZeGw/YxYavUFyZMk5opHfvDMZqhc+X6Z3qihtoVUX0Crr9zFHLjhHb2qXDUI00eJ25Umixj9ODNE8w9HnljT4yOk0fEmv1fR+XUmf6D9+D9aWFLy8e/1VqDmz5R97+Jh5zlg/e6bcZqhShkD6lkQndJXs5+6ElYInw/Jfs35G9g4HhUMkOfQ4euFFH/c3m6InSdScWmHhQ0N7ocDTQPP/3rDqN331Tnch3d5rL65zPMQjSW2gQY9VI2VThpJZHwaulVs/9rUJnvPEBvv5U/GJBryXv9ODTgUwJj+PtZZ1QvJB+Oi8E/GVlrBMQSo5SmLISuDETPRJqOGIuePs+5WP3s0V5fhjxi8bdFSWjWzMtU4MhOchVnsJlltxmnvO3K3eD93eiDKO19T+x71IUwvvXq+HeMM77Fsh24yLFn15yfGznE05UkFT7We4UjFgX8Z1BL+N5zwStuJ92F5Bqd+873npNwWA5XgmF4cf+e+Mec+BMkvKswmkc59xcjBsxCNe/CvqY70saPxyQsH9giHt9+m+NNnFItVkQZGTMQUYffjnS+IgheIiuJKGXEE3Sd8V/aLrHYhb0lrpbt8gxKn0uIHjPOz6UrujqtBGNdhl6rrzBIFJb42ubzcHzfFsPE4+NVluRafthof/1TIuVFlcXVCSaXPCP+F55/61111edPf/+SPTbi4KyzXUX9Vm4hi7yyFF9skvn+3qBLzatOB3F/oEyVZ8TsTrxmyzyJegBb9qD7rUWxPres2pldusrTR18/m66Wl3Ng9DUMfyMgNmrPkljV8vwZze8hJXD1U3F/f/RNqsATj0cGebjet+kln36VMQ+XauIcepRVsuS6l1glYARvd0eNMiNpfJBZ9sJH+/UJ4evzkmFDmYkpTugdes+0Jac21bYnGdH+jvfhbztLrFuZQ122G6oUMk9f7qdv6KZhP+zfk9DmOH+4n7f9weH2W2yde/B45j1rz9cw+Xd1B5QtIow/spCYtytpFiQWupwp8DBZcyvJs5tpvbKJbbw3gnbfyTKaju/5DGVy40kyI2JXRTkspGlc2jYukp5lGz26bTDobdEJe9pFlImjXDai1xo8z+kdk3uyzcHxokA0nDDUKQLXJaXESdfoyuxeWRrX27QtQsNYbPmdUY/86593/BHZp7M1SvUJBqh2W/S/AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAADe6PrS88hS1cUeeCZPDc9/si9tbuYX4PDh5r36qU2y7DbzdfBPqy3iSnnywhvZX2nZwfXoAgSCO1W6zzONv1ZYhtVZTX6XXErEefEvQquXHRM/cbkmhznu3+GrpulIqSA5LFLCyipJu13nsBzPqZ3PU6VP1+k+KlJ+msS2/CKLqVRR2CTr80dfEumSN4dguDM+27tDBrntA8jplV0QumLjikyuUlE9fV64oOHQ0KnRtZgvYPScx7VL6FWpRWzA9ORFh9QeS11s8KJ/5u+Z0TdmiA0hK3+Y1/ZV116PPR8spPyB5TCw1/7ObJkgm4vd6sgSTbyLK4dXccv3OjVrO13ZPP00Szfj572+fR0/EtJPegafyy30zgpC5A3u2P+wOionVEo4zF+/MsQNz8k7f4RK87Q59oTaDPs1rdzlXCLZ7qY6L7Xd9zEj9Z+vY0xGBupsBK+Khs2zbbvizP2+xU9jPOG1NUl0fjvrioQd+/2iJauUtKSQxPT8OKrkk+j8t9rdz8arjLwEAlV8o47sOTvhT0zLHOc+SAZSUGgj8Xb5XJm5fsZ8IfH1y3ML8+5yg4LcpzijBLni9viMl/9t0+c9MUFRLDpuYnH5XbAatpI8NJ7hcWispYeyDmpvcNRahvnzsfvpKzNwCAiu59y8TxrQT5Zz4i9xiz2dWB4rmD90W/hQI7hizZn7nTRmE1gMkwyocX5RQ6i2KVy47+FsG9zjQ/5+qXsrW0FtX1D+8Un+86ymMHJ3t1f2JN+EyEYjSp/7fSJWumqQKrgVMPr9DU+5p6ny4ggpb3ybv9BbxHOc/83ovFJDpsQKNr/Vctzjkf/QlHb9iyIWaiYRaWX1bYxVWN6XRf7aHJY8iO5ylhHeNW00k9gWMNnzM4Ofz4XoJ5jTEIImIYmWzyNGN98pa9ZgFXVDSsJw/wDbZTY1q2lYfRsbAUfUU+LhIRgnspMHsYGhcFSX0bLqbqS2Ayv6HfKzVfkU3Cc1l5wcTQoZLYgFsZojrc6f6l50h6EHt2a/p5vyU0AiyzBhC3QLXDT7KbGJ0Bg2Vyq2nzSRVG5XwL5Sb8gmXPUQxa1R0sh5tMqdq2T6Nb+1piJShchiKt2Et3FIMi4chcQeRaXGUfM+/ComkfSGULrRLtEDS0am5StfLZGOReh33DdLbE7XCUz+vj7qWubE4sMOr94sGppUX24gNTv/Yqaqcn2zzVYt8s4iLEatfY4soroN58O0DVf5sx/x2T5E2zaRDGfx747H39m6eT/5b840xZF7dppL9IQ2lYb0e8onhLV8g+D0pioAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAgLF8QbVycSrtSelsIiHczzEDWXki2tQNrI3q3JgZAd/Vt1g/3byRuq3V1j/Q/ZEYQFOA44F+GtSnMUFI6z5/mYjScBxYYAYaxvRNrohIfM+u6OoH7Xl/E8h/cFAs7XOdNVks/dqS3q/MpR3arLtg+300mp63s88twYzvwwZT4b+sYYnHeCh01DduLfckOBytT00MmVvOjiOOjz31znkOFIWy5vlObN89aNv867Msp7QPSiXglQ+2zHrzWs7WbJ5E43XSRfllwWqQOH1MAmeso9z5nf7cCKt2rZ3JtX6fbqeDgJSKEMarPw+zXh9esTR3lIgNH3QgBBXOjyBJUZv7lZ5KHlvk5OBmO7T19AzTpaFwTBrPLdEFCoUjPpF53luXMBO1H8oiubJ53+00YonEqbWfKENdcp6F1h0iqB6XMrE8OvyLYuzdVEJO80P0C+dPjJw4+NIEqBFns0ZHNGEbdoCtFWxF1DwawVbhFqXIPfGaLcUvbWTF+Qda3l6f4ZF6qEmzHyaRv9GrDOs+kWM2NiOdlqOPD24usVRRvjufyNTqkLWk2V9OogtuSxCNFAkLWVkMMjW81Frj1R3KpVUN+SOulYO6VC3bj4sQy9uZs/IVa6GR2vvf9bPc7oJI6tJEHbz4V3qfB8aKGnBkH0cEhg1l9LwZoKtAe7nexjF/TWOMNWB5l9mts2sfVh6ejqXTECPHdOgXyt63SJVM6kwVR5/eEexXcSSoNzxI/8D7zM323+OWLnLz/ziwcJ8ktiPMzGFm6SrludDQXQz8nnuaNNeDqoBn/Oz9r/dksiTkpZ8jqtSjL6tJmnUC+ReVt5nKXRCuKhM9JHJNRZeEpjLey9UuMT3NyC69/mhRXlaRNXJJNIEWl46Y8+r6wpc1VHcUW/mJqpYenRFGAXWsTlubLTHWCk2AkqQhOosD78ijznRBFl8h3yGiRy8OpvdfBDxiy9SPN3GXxvkYVHmSoL5MtCrsIaJXhNEmmsdo/3G7flF0MBi7tqJ7dleB8mqY5o/wCEUtS4R3HfO8ZWRuZwaiTmEmu+3wJ7blb8UNXydiM80Kkr3uE4mPRl6VG7bzee88k8EhNjJ3ERp/9zrb2uL3k6GwstETtov+EFa+dPfP0gJbewt4vJu/d5sCfOYX7v8bxfysNqjCIVn8NDFHyyIo4qqNQzgd+3jSUwyP4M15Qe2WtpTBYdHWUI+DBUevokJF3L+GISXSQCOxl4bWdsem2tqEJCX6vTq8yR5vL3FCFV87Bha1KkboFZ8SowaWL/VtlOE6l/w2jlp8XO4JAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAJCUkJxKKuVpKCKqs3+GEDm3LLD5ci0uQiRUdeko0aO2gc33NVNoB8dCRBa/nWL6ZFC6pdibHueE4O26kkuPp//u+g8IUR6wChYrVl8ULLjNGE8h0tkLkMh2P2LUT8ToORM6p6VWVfG4QqymFrX5EpA4jPX19Nyf4YLpOd3GD88eP2n07XceQ7Xsvp4xtlg3A8OEX1+m979dci3PSjeawZlMC46jTXM7X4si3K5bOkAh0+ocqc+Q8D4gewjr2ZUgRMtCKvvTMBZTfFa6oItxjZGjsSyy2lJ+M0WefvK+J4KwCm9h+7fw6axIx7cUFSnVQD1/VY2Jv5tWX76EIV4FnMhPdkSxEH1i1m83Y+utMSHxRDSirqX/3X4v/OtzRnvC0gwDSyxbJYpIMTrXdUEulsZsiDyqk6a881ViWF4aafKGnIiHDk2G/eA7M4vqp1GvbLrekjwifMDv+o1vlqLiUe5l6llRScZOIJnpw/kxK9mgpCgs3ABhvzZB4HR1NXi01qNAvArX7mpnnyz5wNp325CYeO3d539jPYlxyNjrCVcuSoWbLeJV/sx4tmAlb422IxkoO+8vvXpulXWWZHst4YjoyO9LoflPiqWQhIRQrGhJ5EFiaEzheRVhk35k8tm5ODrWBzSvZo3Dm/Yjr9qBidXlOPJHi4p/8s56t+qkry62MTV9eHP0rGwOkF8RquwKlkgq1U1eiXupRnQ2S7wes3fKOg34SJX4OavQoOHQhKrnyjTE5aVKVE17v8W4pv7Ee8IY5BBMvgLzjUxkv89OZn9wAj/54sxZtjpF3BBgqh6Ki38LTXG2sE9d0Onfx9Pxz3ZjTeBjDkuKkMtNXhoyz7KwvXyu6njcEjQaSq6m9o59SZmkRAfDQKGvbhvlI1PHWu+PzY4XpHeHV6b9nPNqicpUlqeBjwUSvV4XnBmrzfNZ2ypouh4zUbuap+6mO3L4+yM9OkeY6vQ8ryQ6fL5jcLnbj5i69LqolX8iFKFo8ctdUaA+28lI2nKLl09/G6rbz7/El5VNggPlqdZZHEpYvusSZ67uff+aQvt3qsUJ2LOtm8k97rS7EgNG2Mz0hXADVdO9WF9/b0izyDSXHlbXZioiPjIjdPJ6MVXPTYBDILAWbit48VpPZlE2rxc7UvS6OThN59+0RMHmWBK9mP0XVpR3xWjPv91cRFE/jPvPbWINSOQVxRWmWooIFFr9kxaf9tfXY/y3A2mTJUuU8feDCLfPPXMEZihfe2dXhwicFyKyvJpKmL42qvwrYLZr2/XBjEzRy7/xZ7sUKjcAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAImCZn1vTnAIq8C6oy1B33cjve/X6s8et9GdBCwGksp/GOid5yu5TSfW+Ulk8kL2auONvSBlZH2wVOAsQemjz9duNBsrm8mJ9jUvux9RHdez5f4RYcLGlkbAMzHKdQrCGnMbL/O+VyXSW1PAyC5LCZF57U/V6yVSaCvc9uGOqFGmOsFYH01hTUbDUbzv24SulJ1RrUftf9Z/UFu35R6JeCgGWpeHX/bmeRmj/OpFONZhVvT2sis6FFl/yJPm/JwRNxTcF41Z+HQqV32uEPFjlq/LnDNPuQ2Bwy+l3M1q1Zdkj965I+9pP9IjZZWY1854Oms+rDN8jkw6doX7HCOofw+/dj8VJpbL6p3ZgjP2+gM5MOtYN76xP8mgjuk7TtVJSPmOlRFSh5D6hO8NmuzYpYwUXcgFhm5qr+fKDP0WLci5iM/vs0054GmU8oJ39ofgb1eIxx+C9FHylouzfFC2eDGbyvQsviqLS9WZ0L2YdSzvIbHeN3HtQnhZcCVBWOod9nvH6h7JSoPA5tC97fwi+/PB73xrCzYa6e85zo00/mSm6enlW9RPOliYExZn0uRvbcjzE6UR+ovCOJMa/NAzu3JrXWvxLhrVZtV7A0Y7ry1ei4pYtVfM6dLm0v6s2La7NS4kfSqRMCC0isT9jYGDvEtoYUPO6PvwPHXj727FpjfY2RPqluXYunEoTnMx1MWo735rnnftLPItPt5AaLPQfEhRT5TpMVz9LGeCd+0zi90tEVEh9yT1NJZe5ePg7IKJw/tNWrkUtlwZayIDx2+prALSN+6CRLhdLvVOufIKOsvcsbgo+sshtH0m39btO5ujDrezi5lfqneKljjRJx419YvxWtCFvmMsEMd/y3AsI8bicHad9ei9SwKJQB6qPy/zw5Oyk19s+HooRQ8Nx4nHD+Qox+75H3zXST4ZewhRvi0zeU/ylEvBRMrk+xL2f9J5ZxJxODr/Sfg5x5WddqdtkewE4ki7rNUpytFq3DQ1CSvL3wzLyNy/fRaA+rwjNkSMtuz9/o4dX0t0XchaHe3bRPe1fC2Ud0YBo++nhnw3MbJCWqfDSXL7Hx/fX6gItr2zkBsa/M8vOufy14AjO/3cpOfbDI8Lhd7yPyt7SY0z7p5/6/KI6dZMwwvZtzf/k9qUZ8aixZOZCbmq+/e6ZUJw9Plqt9/iv1+Pj/6NTP9rcC2/fe/XjoAttsXESyjAs0MuI7bj9a3oBV1b+zOFV7jGBa2P2jYVP4fARvbvUcMy2jhL8syxQc/Qa5MpZaEnRhaCWS8t0YznE75U+In93QEAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAKFwJeMBMQY11JXpI3bqTsCLioFx+5USFyuxWaYaFqkLgl8JjEFVHgVZy4yds8IwoNML6s6jagv3k0EU3B97pAGZf17/NFb/y/v4Qp4/G+Y4Why2ZxR4i1u3txvU7fu80sy8miNdXip1IleYsZ/KYudX8twTXbfyRsmza0UfuO9e1y+kedQ6WrVgwnvhhUK1hqzUwxURt+AZk0mWnVTYIeFXvxN2rYz766lKmFC9NX+Zselvo8x/fxGWYzcmgVZDatdPG0qLMAd8Y6mGTHud6tNQzhVrr55L8/XgDXxUqPz0FkRDGqtqWOAH5GHa5i7qYeBohivUdDb+pBQ85S2w64nqh/qh9zDLX0vX0PS1cVF0ts+UWTNUd2jfXa7ikuNi3hU+5vkGMO1ZojwGHg5z3i/7fyWXUS90JJJLH4s0oMXRuZvJzaxc/vFjeNGX28W1NKsmgw1p6Lh3F4etMvL97awt2ddWuqbLI/t0vTFZFauKf6VPwv2bvQ2m0/S7kmsecKVpe2Sn0PPw40PrxtjxMbTpOVqo81qBdKz5IxPs5K9Q/znjAnCJO3eybbRFjZ0pu9aNimpkO093CDoeYuEmB1WeNV1jlcfpMfoCIVR7mrXevacCK2iph+j+88cq/uDxy83/RpG5arL7+jfv50cOnj+idMl+S11/JGb+NlJVWy3hB0vIdJ/sCMc6MepqJ6U9sQ+/PGHpQxU0urDMvFh1vLFLqeLPn34eTPJi0R4tU1uWhtGy3TadhaoT+fUhQbv53gxrIEnQ5QFlW4GWtoin5fly8aidcW3SqLx5j7NwGxd4vwEhDgmBR5RXud+f7KtEw9m/ETsgav6YczHYxJpLRj/iPnP+i/ydL9l+3JPNp4/Cp9V9EKVOOxx85p1DxJ5gPuGkyqzIXQcGH9CQ/2PJRHR3H4e2n6/dJw/9R0BXeYX4ZTCw5N/X92KG6gBjWwuX233CVyH/3PMnaJrtUxKh/VLeqm0/XjwSf+j7WLoikGny9X+38aP4z0jgG8yE1p3Q/np+0zL3wN+QjRHWLq1qxUmfn2w7SgKNkv0EP68FyQ/1vb+VXtI2/t6OoFG2FitAsKVn9/DbLULEf/O7BcHIQb/2/ginkt4vMyw77ZFspRUsiCvcf6QjnupuqAtf2M5WU9/Jxs8z6CE/pnoWG75LjRHs3yuu7RmzU10VmI/Z4FfVylrvNSk2rtsWV4hMCwj59MY6zShKOrIsxNu890l654qXSiVrCbMeLN0PRTf2lXBEVT5xrktyQ03L39Y0E9TWPwkLxyxoWvxdaAwAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAADMUpqN9LmS4X3/1mr/X+iWJBlTePWfxm902JrjLCaovr/8dc70P6S9dPBqe2dm1kv13KqAP23M62e4pUkDDtk073G6zzKGyunvV0vBf84p25kE6U21Av9ebnNX/cIdezHeYRtP07bo/gOLRiPnrbThGoKcKAeRIPG79ObnB2K7OZxJRKvbWHkWSAvIpNtWMmnkJPR1om2/ZTN4z4mm33NeJxXO3awLtaE4kERU4hTHdiNu2t18P0wZSFUWwSUe9iPHorjCGQw8ZXrGIFSt/eYH/8DvOPcF1AIUCZLod00zrD51vasOGO3DO503Q6PT9KzrdzWBZS3lg8fmPqdbb1/fLCkUlX1Vve/mXJ3yKKxRW/rT1kbhyaySkpRAJE5SfPcGj22F0CJ95S9HNDub6bZVwJ1HV0YKaU0CRs9HJtFTSTq14BNvwuf6z/mOiyTY/ioNcWWZ/HAPi88Z1ouSOL0V6deixNy4L9C0WMJTcyFHongVJB74neNxkim50VMyjUKy8Cn+xk9S1OasEgQK7d9dsvKoX2G8UMTX/FvVriJ6WeMvTW2W9S0nldNvs1gq5u3bDfUU+mJXiYZiZB5tHVeZ2tp7z6HLNBcPcT9y3SPcicG8rmd46xm1+8NMZ7Jv0wxP0l79sH9zLPXztdSr1T9qJ2+5EQiUehwrqbdq3WUpmSUdfa9/SHL/SRNpWNjbjKlzqGE7+RqOX4rtiC3rRsC02ffL+o+1ezDSN9qig2Dbg11PE0/ZkOwzMpJLn/Mv4bN5Xwp4CXwrh1Ywabbsg9ZJXBsCwmifPhkuFez7lCN8uHK69mauKEWSivbMbLv13UhZKRk+XzYV6bfaTwfvzg1GEuSXekTeZBfbSXscezeglhl/yxWQoqhHM2/7izFKzSCrLBu1Q+eRPybyMcvb/J7I4vPH7tv/MI6/mMfzsd/sN6p8i021C65kwvrjy6PUozjjulyOnbL6oplhAvcgIuxObsj0d/eT4XRFblW/dOTW588p7I2s1X4mXDWEvo7m7UL4hLi856uIV/5tLmDSyvO1Md7MaVb+tomcYZ2Pd1R9GzIS42RQOcuXt2Zl3ATqWLMiK57nMvEaVjOM/UuZ+UOsRILzVqb4crg0vk35rqWHhE9mcRKZxOUccBjoCIp+VzzTmmwLf+UeUn3Jg/jz++/x11+ntryVl8VJqkXCXAaZxcxjy45DIp38r72stgxud+cVeF/MvpcrKw1o9k/mDY1/umTw8BdhpxQqDFAHgi0sP3O82tIeN/lCtWrssKD6qOX3o3h5GtV4+e8+Q7mJA9cAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAANdqbeeUdOtHvoJDjWRZPvhRgE/zman+/dt3hV4cHw+u7IO64ulTdotqX4yF0PnnhxvbcCZ886YV4h+M+k9MlQnPIlnlwCzWfdkTQfwecfsx7yY909irwE8Tep0t9E/81OSEHG4E7cOGJXK/Xn3vULzpFdHFSFBedAjiF2RSDnuZcXmhxiYs5bVKPpj7/EqQIqemqAtdnKiv/7nLATZX3KwxvrDO2f7kh+s7vRQ06Y5JV2SfT+NsF8+GmrwV040Dg0939YVXDJSJaldYCW0Xjm6/elRMHTOP989yXBI2UDXMMVtGcOMbxC+bWIQIvm1tFUurEF2J6Jh+dh89/d15mJLTjxL/3vMqsFzXPDfv3FyF+3XVaZ+hYuL6h6807gkcuLWjKDTmx7n8k00Xnvb62WkF/e8p91YsJw9i66TDu26+eimYTtDgTSKgEoTi7Nn7iXGx0Dwt4Yhh/lKwT++G/0iUUza6YX1iO/c/FUxyVhOTV61BPk4/FNl51CI7KJa1/UdUQifeFXcQyC7Jiuj+bsRleqxIJoftoFasw1pxTjvNlYlphHL4+t0hlqHzYckhZw8normJIv6zYQRjx9XXVqS16nsbooYbKBWpV6Kztrgt3EeLEg/Bc/2OtoXXQFzA5aHw9dWI0sLwlz06+3ufb/YjHN0OuiXC31tz2Qa62kZMuMPGEVDyLe4NWgwNB0I2MDmkt/yRu6oNudM/k+1lMpwG32q2faqWSPOzp6VuNSLHfREWINKRRjXwzS1NtW0jXY3zG4I5wjL3W1p86guJe5Vi+oRwKdkCylPJzNc8mcU0k3G9p4/FRTcCXYTTwrSntCeKfDcbmBnSxz87NnPtd3wmqziZGnJdTNLwRkQdDDD4kI3ovdJ8Ms1pFSalmu74eRphX9Ej2F1pfFm7NGFURYV2PsiPOHOqYSMbhUifdVfxv+PbG2t6ZrK/ZbznKmn7F9UtNf0hZhXIinhAuwM82gX0Ptlv58ediVHkQ7LiafS6Vd9r4L4K0zOzW7htNBP+8Nssg833R21UftOy1TjzQuv+QMsrT/2dMCUFljALGZspr7qSu2BZVYJbB7sTqomHoLslo0eUD8cYFJ0JKHML3iZL6f+J+Khc4PGX4XPxxV7RbHud58viuzN/qbZG5HEU/vjwEIaesc5V9fpZra4HZeKno3nRr0kFS+dezRjSCuJgF+GceMf1zM3teWn3cFWDwPdmK/lV4YSW3WuGfOnoihF7I0nt/0Ykd+Jd5Xkkap0Iw5hUvFRLbA6eyKW4UV7/PCorHN5jN0zMBgAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAgtHsfiWyNyR5XiX5qg3UdMcQd6/KfbkNoxpweE6+VZEvVk+U9Li3E8AWrVMariR8h6MUplOy+xqMjP1LkRAIPR3RUjobC9XyfrltjGGIMEg4f3KYK+/YzkOL94H3ttWhYilaf9pKJmrRbaLIsS/uLpzQZA7rPIS5EluuV53fry6Hc2jogo5ommJy+CEulyZbMFvFq/KOwZf08IyGEn5FDS2p5iHH3Ukg3vvHo7+Bk8vtqOb/TT7hfRr+7x05i6IyFmY7P+HplRFxdGsUmpNRHBhrhFji3lz98kv4YyerOgGY1HjX30+JdQ6+xXXEYo5G44Hj1WgheS9Tvg+bFOrVAYTaNFIV/tA+q/8rI1u14zFsq42NyF7y62Oy8RhvZDYnvfu6+1uD/T1MNqe/N6JoIYlvsPh6FDrPQwy/uILSOpaLz06CsrU86cCjFP3gWsi/0D9YlgnuK90MjTP14ie4+2BtonzXIBH4tKlOr/2wfVqe5WKZhH0LpHByunm2/qC5I6fHEoLj6i/yiQAQaPrmgVbxz5xaTYoeVbg+BW6zCnJH+wWq4jp/ZBxzfYEd9+GT2MHtBazSfUTJOtR8w9vnG+TyF5HuxJWdo1ZNUnxQVzxeNB+g/WjyHY+SV8EH8JiE58q2i7+QjClN/LBeGoOJoVotCIVx0ytgMc+GOwJkN5q24UvUbG5OCXfnfIghI7EOtcejY67HP7ksD/yMKJqd6deZqEzultkTXG23M9ik9+23qmwY01fx+/G1srx+mjoUndTyegs/wHCQed+Oa8IRX1gkw6/k9vXs44HG4t6s9mN0z71pvNJ22Jdh0eb9MVSv+todoIeZ95+Ml9QFGVuXfbsw4vgS0Rusx53orafroP3zifoVcUHr+/HO6JXLOrvWmlaCwKS/cRl9I5CPPl9cvP5sLP2MVDhJbb05XwQkv0vVS+Th2JzFYESrH99xs94cZpT9cWWQyC/fUZ+zoEGlRvjO6UBtaYJjXubnyIXZ+ORbZGLq7z56spZUpZ10s4Rzh6D1euSbm5/Tk2eDO5JPWCnK/WSHV9h79nyPEbuRP2JpXA1+tH1rUPKD5/vcnLo489ZRG2ZOL+7VwmP4bRL9ELfwemWHprtcnGriTpgahH3kNnjmKZvmXjVTneOydlyvOC1ytf7/+hs3Kc26tYLQ3jiDQLoztd64boc8oMtglaeNY+bwwQf+u8KBzry7pwjz3XXUInonxu27LQBohhDTPK1cfNdn903wxIbpnz56QevqfuO+I6z7Ec+1F7FoeGL9sWa8W45MJBrWnAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAABUPTiHvJjNM1rEOGUlPCz28dU87wpjduRlSYQypKCVtPxwm0+7mhuLXVBet6Ck1RhBZtPPPCnLx5pinqL90dCdweYOD+mRYlf/QTHSq9vYxugg8/Pd6VhtrPKPRhKqV2ucJ2wd5/wbKLJ+YTiW+rX32GldL2lJc9HEX1Z6yi43N+bPh6K/Hv23JTS8UGKNa8nx+wFDLRJZo16RpL9J4z77yve4UOu/DusOpE+B2cSlTWooPmFzvjYXG0IUhGs+HdLbukdpeI7lKpaPCW4/pS75Gf4BpjdfgwXiVm+0TJTnfOp8HwFzxT0eGZb5wztCi49War5rqKwkUojNe266bAasjvf2RWI7ktJpLPN06Z55/g2RDkpb7p+0+XomMMVQJ3urfLefTIlrprrWNEpUSUsIac98PG5F+bVdifnCM6ZIrvmGnqa676L6y4d1ySAmxAslbgLFW3mDTBbcCDPR9xRewb+7vyaK9ERsSz3ppq2j/tKXMOOES0sxsxulenq8pPTi7SuiPyvHMreprZU0Pb/mHy+O1r/56PjqheIbSXZcN8wrdfVvtWwccSYTe36xf9E8Y+mvGmlU6/+bbruSY340wH6596/yaq3+R0psDVdTm+q0aT9OgEgUmVJ7druEMDHqwOfoF/JbLMdBgotvJfor61HvVo+41AYQ212GNh1orH4viWD9DGrbctUOfeU93b2Mxlj+vIWf8IeGxKHlGxFP3Prndtq6DHqBH9tN6X5nhaySomq6Go/nk6VSq1KPsSa7fpkm/ziqPWf+0Ro9bi+mDHmIVkTvNLFKZ5jiM76Sfhb785nJ64lZLKHEOR3Gp2pncQhvbV52n9X9yM6lMuyxLmq748l8zHs/+b2bLMGNUlvjzWZO2/tf7lIScZL3RB58BtU9ifN2OFErJRuVDw/oQV5PyNbGy+kJ3P8koIv9Rhuom2JNcepexjf27RHgWtJubbryldQqzJytMT+bSuV+63WPgdEn+DF9efx8f0Wrnz/0vWzfmSsZH5WKENuRX5/IbGQsemKm8wyznfJM/Hh/N87Qpak4ff00O0V0goAr1qc5/uDjJysJrz+9X2r4oOCL+Q5n3n/F5grrPlZf8M3vbgfQXMapHmByzS6tOn5iRPO3lWsoZaFCJIbUkd64ov/LTz+NJLxNG1ym14WB+WSNP77YIXuEMsVg6lsd9DNSZ02FUpPJiBJmU2SssSYgOsR7yMy8U/nFc73J0142Vs+d4blL/bUw5q/cbOsbgk8vHFmDKqerInfvToJfcrp6OJNu+tZPuXZr+1MRmAEAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAArOWxSnUOE2ckxC293TtlW5eomT7qzeYTp6K3YJ3lHlNV/hq/STzazcNwmzZwEz6utPXpYKlweu1Y0M+FY7EhkfDujfpN0PD3KNGj0kvt34fKKJqd5hflyswvxdhyRWPMGzelh358DyHyrLn3NiTveDVwzfwzfgy2xEbKYj8RvEDDE5VI5v6rnZsjL98UHSXgVIEmhUBv7SPAYhiEWyG0g2mDQN+ZEa9Ie3Hi1n/Ph5qAyp5NKvNSeJasqdHpgkx6v4bqgObDD/FPTS6O/llOmXONILnyZ/6kO/uakrr+z72tveWLZtLyc4b0se/Jo0/Nek28EVHZ0O9sVRqlrbHdn3P5RCXyn0kbeTw+mMjdYPfD0HGsrVHioUhNqfAYE05IfTrFabbspnrgwPwiH2OcrObQKaZ+pY7THlUkHFTlEqzkvh4Lpq1HdbP3Y5rnxY8FP0I2p1ySOz7l2vicSvibZKJOgetXzyAOxnZSsX3cIJXYsmPN6n+yuP9mzIPTcDo17yj8a5897dQ90JLEUohjZl+Xlkow/XXjzrRBEy33iCx1imBRSb3qcziTqDSu5GjA9mQiEXLAmNOagkn/u4f1Z18C1bYHsHNFC4t2CScsS15wO9Li/W27Rfig88zr8QQO6WFi25+rWG8hF4pw9h8iY0jVNxsW549UGcwIzqQWS9ZTKqTZN0TWTPRp3+Krf55/t+pPS5j5Uv7E4MQVV/xF16+Ee6EXPxuYg1PFjcvm1ZbD84o+xKEGiDJt6IcgK51TcZW+9K1kz1ToUk7/Fo4s0/ektd0Z5wVxgu9VD1vE6/T+KXVmTES6oTwjyq9rm8ovP24tWVTBCIU5Eo5ISawK8fA7t7y73sZgvOOuML2WkPbV21X3MNTyL9Z9n7JiUb67cr9zclhbb91bOhuOa5oIUaJc8u0qBnrWQs+wJk1LBvbe56/aPdMfW4d3t3l90e6XqRucncRHvBVHWk6236DOMsEUIlRx06d6rK7CNfONycDb+UFEB9c4Ws/voUqEj1An1+LbBf5F2+tIfRQ2/2FTuhfXFK4HhCV6OmOS/uFNhPMc1VbhIpy6JTZ4c4o/pZqzH33+2B2r9PiXzOzWVdvMq7J138eaiKw4MW8akginbXAcFqzCjNL1J0wAHJ9zqTlFT7WkOAcwF9X5M4rR5UuRz9Uuv2dq/7KWaXJI7mTz0odnW4S6voaYx3Lo4pJ4sZnHtLlLeO+1/THf2TBlDBUmVhBRJDN6a1zTgfWmUqCFewjCU4sqgTbKpyb5ooqI2a7npfY/Z7/6J9tzAQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAADs5Mv/o1ZvbthsUVhV2lin9da9G/KTvSr2E9K9pqVZqWrh9No3aKwU7Pr88TLVq1gfIb/e8ljMV2/CWxOlQX9GRHAuo6qlPuXgl7noN6Dz2snk8jF0NhH5qTnXPkDdW1nIp8L6tnxTxUX4vfLyBzST8+KZnqsQRKsBs3IvjyOD5llF12/byOwKrachHB11arIrz4trKTqZ7VLPAu8ExdgeYellUqpQ2v0dNmX0qXqLI4xb+dNyYSwxUmRNsZBt++3UR/xMzVLJ6PRGX9wkY+3OW9v7uwftZNEPFSgqmZix5Z+uJIz7k8IPPj//MUtLVIv/ROxHDucdcdUyeQDb3SBVWI9R0W3CraVAz0pwfmtL9d87M9Ez+WRd9WfZ20N+0wqIehTpLzYF5jVDSESD2r3J4izX+0IjJUyGXTW90BcObVt+zRe70E6s3hh42v1Ms1WoQJFivOpDXjONI5AMgaxLPfy9rtij97MjSWidKAz2yTn7u/d/uQuHUByavxP2Myis6nn2iiN8IHP6im73n3c7zRfE92g5EeUE/U1seOCIzZocoScwQ6eG+eUTDUFdduu3avcXK0EvaqRie3vu796JR6273pgSvkrsOU+UMwjeqyn/i6bdrc3wH36YLF48ndSfrdd1sxHVk7bn/hsymRF/7+I4Nwy0BF7/8hVjVixTQ4k9UVFspg5qzPDdh6d71gnfLjAulzmQQkfcDow2ckK4eFztuTgPXN8+YunLHHgm+8vPo37/6FfTEKvU246c16+Ycgm3/rCJ/BvR6Wn8ihhjs0wrOkvy7tc/NtGnVAO7GTN6Xt9TWPrnfb93ifc47kxu/BBCtrNRJI97lfyRVNblR8CL8UkjtCUcguUwNOnbEYdCIh+BuO1uV7lXNniljGmGs98UBg+Losv+0B6/U/mgxrN6WVlQZT7H5OtsTDxUOtgW/t62hcnaWjGNSJOP5oq7Qe4RFl+z18x7Y4aP398TDpF/tHv6MBY/v8roIW+GK0j39hbdvfqIWichkP63AZ/dnZSgyb0OmmH+wt+4b+oLe/9VuYZTsuoyjG9XRVq0h0azf/vbJrR99sXegOXGEzHAo3+5QGeSQuOvSoQOf+MmkereeVnyc4Z+QtzA30gpmfXGCb55ASYPPOmGTygSEVr9n6ge7JNz0/8U3X2uY/JP6sOBCMYtlcwIltmPpJrWvmrNG/GKE/FPE0E36iYlCGz7gU7e+sRTrSk/iQMKnXR47NdsXGoiX9/1dtWksX65ds7tGH21vfsT7+Uku09sCZ7fTigP6Xc3Wo32ro5iAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAIAAv8a171sfdzLHfmy8JKdl0Tk+IIycXGDyxDZ+cam9ItGFkx4v7OqD4dLyr+s/Snwbv4t8jk+xHFaTKDUR5b8S9mr98Avm1+1TRFk/ROk42vOayqLHP6lzNzt0fFWL+d3fSNEp+Lvqp9LfYpiHVW4DbJZKkkdXZK/839rnjjVF68x1PtZZpbZ4/OumbTTLfpzG/6tgwax9LTJBqMWr4H6hOpyCc6kEScFlEpr4qYEMyn4XWRI2PmThKQS75lRaajRee1a5FKHEl09a8WWrHv/o97rSntadXpJ/35mCsz479vu15d47ag8bVeF58jd8jYIIr/SP1cl5knWR39cJhcR6XO8/2mYqHtjRbjZF5pHuev1OwZotvTHCc1NzIvls5IUgWje2BYstJ9slGTaRdWGydbfNbu3Zrd7QK+Xsrfoj/oAjnVmVHXwrYj8W/yD//C8hKJb0KdzEZpRIYXyhXFnoLT5vHcZp81bWH0Wv3hUP4bEcExo/+97rwbrbQhZavPH82empWkvOSc2zrNf5ZtILP3qbdsNV46fP0zdR0UtNKTvZ3Tbcq82xs/XMlGzKPyIrxJ4wf1CMp7vm+MB1rVmZEBmNneAlsc3xLDq6+nX6W1tX13BnJRKM9/oMBtKME3kTU5KNdy7XN1RcvEpii8tbxj+J05MUxasSHf42GvOciHwZGSdR5nokrBrYu1OuR0+ZWt2Ve0Z0loV4OBc9PP6p25SYY4aqUjtRNvaRukKEHVeZpVvHD8+PpAHVr/yH7UPcn4WJZBnuleyfBPH7+Um+Suc1idqK8/cBni8hBfhLGRtS0/1aMWXHruPVldlhwbA4jciDj55fi6MSSrCY+x+T12/eYQcJk5qKy79M4WnWEyvpVEhbuELF8/w/89DqEwpIUannmj8tFUVFKFbR8n0QGx/fLaYe1jy9wntb9Ir0qvsRrjBv+yiD/zBv2d3Ns1cv8ttfUHmyKFqYqNwxkTEa977HF5E0fHjdOWqHT2y3/O6T/K+zCKGZf48KtHx/GWIxopbmeAbTZtFFj994SjPNQ9WWdIB5+dC61xrOh29ZGVzRTP/c7FO/FLVfJk5wH+b/3Oz7kKWjW4uzwU2x/6cNr2wZlTx0aVf9hzVHD2aOg8VS+cpSwGbXtz6GZelpS8y1sp/3weL97f4XX+KrM9tpnjMsvUxcG7c5wYozZ7/aOcN7dnxUv87wh04LVHpfYqP3Foc+yD4PFXjyn59FHOIHTb2otIwiB0oclno0T5Zs1BZy5HnDj/PST5TkG/6wEQdglfIz1oXzi+UNi7sAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAADywYA2lHAyltPfWIJOk1hnd3bwNDZileRmjhRwgzfDYV16E4P7sacOT8NPf81ojXrzdtftO9dgvdl8kUSEc/Up79DxgrsmE1+Gr50pm4973RjqRXUOVDf6fukLjRRUc8peH+eQG2xVWdOj115oC3dVNGWhG9Yn4q/tB2psMlcTdOXLd0nf/3pvQPw6tfXi2/CKx0moI+7A0ZvdrZ6zWvdek/5UeIt2/8/r1zXAXASXnrSfWkXPjPF3/SXebcrhJ+TTiy5IxrSMzH7Wd/+Td1UNOmdM1RaCW/vj3nW4w01cz+o+vyF+//+yV3JlR8sGT83PYu6N95KpNA5kD6/JKE1O7II2KZyU/vE6KPFAwGL9lGte4bv6reuW5RdP3298YeWifZxA71+gAV6iVJAXr688WTKRH0RMfUmxRMhFPamTROLg+vPQZunNr0/ie2NW1IFNaNCmeSrbnZYpG8s1yd9PvD/ebz7RE5o9s2ntIQl8hojdUMlxMziCfI2izZUhSI6eX+OQ3OMyK+RNh6N3aMDFe7xRIWu/j7XzNLrLY5H9hyMYuRt57IEraE25z11rIKuPSUvXbS9v8Hf2P+dtZf1PhnTlMvc/lqJgRgduaJi9NKZ9htjWL0RCyLRjXphoFPmU7xBYwp3pZgDXg/B7VZLa7Tm312PeuR6u4b4yvPoRAuaXVZ/freeii4SUB68hj1o2kxAauYu3uPaK9g+HHjTwRZi4t15drWY9+6h8YYpldY52WSegGRYqc9yQ6JXen+20NWRzFxtrUBVZdo0gHqmPZx9FZ4yE3dJ82KKzfNJS5vnyJdDigJPzkb6HhU48E8TN7n+yAVFTVjbvi13lpx5jrkv14Nwf4xSyqNX/ulbEU8xItRblShMVVxsg7nBKEhh++o2nf+zD8miiQPXHLFmycbFe+vaLJeLiLdjfwVXEyFu7BixryFdyycNFXllmwTCKzdvS3vMd3MDI6wKwNwmc6wLurc+Ah97dnf99Ln9yHbVqCGLjiFyNyO1mN7dB5IzqwW5FSXue25WBq1tPYZjWsG4LaSE4EzyvpdmKaYo7n382rJfDS3VotcCXoZdJnal0gvbJKS6wPHr+xU0fd+RWAGZnbWHRSc4xxrTqZ9v2FK+agT4qvwbw5g8uT0pdMzRYGDLcbcsNchqWNXb3YJ2d4WeUzshmtgzVUL4rTon9s0Ymacf04LIwmmxZIXGX55s7gqR7Se8Yn2tF8HGLB2+76qec6FaV2YMGe/RKL/FWE1Czy3/jjtVAr1szIKsEjSQ37e4KyieDXL/5WiAIAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAABI/cn+y9/Y4mEPvmJGv1R7C29M+iR2U80ozFm+oW04zq1EhvgghyIzFWMuQddV+cWYmGqG/bvOciCvjFl6Py/crc3gtHkTTv9+n5o/zhQbwlZJufActoLtQX2jxvCSlN+hZHBFPJ2a92Rf8lW1Gnl6P1F4p9++mzYo9e83Dp1ME//sCvXKLQTkjX0fKBlWHU6PNmgvg6j2w4ql19kprZ3qLSyeRqZ9NofZ9U68/9tzk/u46opdC5UtQb3Gq7k388Y0WxkiD88oLVt2W83bzDuvT35b3IU6/F4JSI5UbMwZLV3OX+XiyD+LkPL7VYJAy/pCEOM1XteaIcyuM8dd5mS+WK5SokPNrDrp0gKpb4boahErAvJ85jYsiP3R+RWiEaT6XB/6sE/4/IRKDRx3jqblF+SXe/MUOYRkJOyHD8lCsbf+RFF84Rgibf+tfQP7X9sP+3RNbfeGJkRA0q6nApq6zwFAbGN40fQmwu8pXRdHzHgkMa8khe8ep3HPYPbP700ZZpDL/alTxp9HzB5Pubgts6XB9rdoEAsUD7KvpJjIXG4R+pbWV6hbePVJ/NsPZmLeiwD968V5qWidrAeisp/jpjTTpeOWz0euJa5UNQxvWeyD2Ta0GDlcKXAJRf6buXCwtMqyfjtzF/Uwhz5e7utdwusIMjPJ80jLtwHJ64MezTcQurnTgrUxzz96nb0MwUHbSxO6IW0Uh/KV2MtHJjRHq6Xu1LWrw/+TB7QkJh8clEyjXf/u0iiWzmYcxht99NO++OkS27ODZK/07JPYs7vh+DDX/77Dh+U0GvVKRvmSWfrFDOcoPNAkEffc11LJFjecInq9eoh1l+IsnORutc1Yl0Xzn/GxHQq/70qcVEfAK/xyMrOSgtdoYv38JHwfO608QB+2tNYbGANucfqafdkS8IEz0ntNqKKEuaK1I35rh9YroTjFjkhal3b21LuFF0JFjH0d79vaxV/0hZ9DX6YYnxs5aSdZ8gkdrKw9ZgfXq1eO4SaUbt6d0jr8CeJxuRGKTqpyznErzsjibtGZX2EQMs1tVNqpsnhYg6TBwN+hTvfx3E7a5WCil17kUHte2ldnc5rhDEfBY0Po1/d4Y08Nj3d27V1zrL6g9MDusdakMJhVa4ajWafMW9Hzfsvpm9ffVkW67xJNo4iuNrEhYwrKgJB7eMr271t9Afsxb+W8bu5At8XGo/lTSsLlYXU4slub8SttgQLn4nukP+F8NvSZk/TK5RMfg/parI6slu3jKZHc6PptyNaYo8hSvKqqL4jBWRLimtBdp6lidTAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAABoTEnGG3yFryxcv45je9+x/bkyd18v8zWszJxNR51BKv2lTQsiYpKFjLXhBGiCSQelIgyHhYXTYp1PsBMMBtGoVcOaD/hcxtVmRy0qlb6HT35Y3+400D3apMeVzcfRW8js89L4Q+dNbWuoWKGELPRDlkouQM2Kf8kQl7icE0G7Ul4UqY667e96IYrI0dKPlzXlLRJEVUkiLBL+eEwenu51AqOCfy2Wjvwkf6taPdIhjbH6b73z6K7EICqrfUj7A0piQ39mIHLfamchpoB9aU7H797EGpsjrxzpVo10DqWHI7wU8+6xj1R12Hb4rrqDD4/DSCR+jEYiGKM/7eBefieUmk65Yd5Wm3Mq/2KSPSew+Dld57F6M4F0FaKYoJjnRqDON4ISDFLhDXxpepTZlcfZtHmk00iGk880o8JImWkoskhGfH91hmsxYZ0LyHo+LOe8oFc0DixsD93Rk/5SHjteXzCa4rrDGcrriMao81KixHxfIB9ddk9auixATkVioDXsoHpbvPXj916l8/4gfvPu/lXd/3593ct/lE5YKZ90cbjENyZZ7bXAM61dfKSqef+FnOEe352TqkGymUdXhDLAK19cLXTlRL7yMt22WTjyXx6uG1ZnNMbBhLNcZyTR1cMvtagXv6h32ZlwAlz5/D+h771DJGqQ5FbcWk5vrvLl9plenmhTFSG8TzGl7HP+6JTizz+nd176iDp4hbvgX8O/qjd6+jy6xF3Nk1sy/ocxz4s45s4cCQOWc/7Ktk8xdpVVlb6RWlJCdi50HfWwsLFB1uwqcE/s92RD0lrr/vAH99b5KeGOhOfjtIKidtzPg9Zm0tt3Am39pNU2zrZBszuPEuWP69AiJhecvolvsVk+o2gvVX4RL2f5jGmawlfMw3l/+6ws4PvtU+sNFt/v40+cVq2Od48tfd/v4S7a77vRvVSQopDB/uLeJzmv9tCfN/k0h4wxRgt5Z7CPQsAWrPB2ppQ3Zix440m+NXymtuI3oPSdijEhxijULMgW2CtcpgqieJmyIsrapENwzVrIum7kifMpMa1YhOojbrBs7V3ZEZP/TpyeyEg0IoEFf6X061lXDi5tGvufnN0bryoaSfNYUmZfS8wLV3LjJgXUpiuiH3r8Rx3QmN7nsWDUX1swXe6DTH9cuk0sU7RNh/rfR+wJhoOJPbuhncuNPIT+6s5joYCHJmX3hGLt/NQRBzn18W70V2IfcGtcuwICQr5oLwj9Z5tfSi9Yn6iyRqZLdKeEEjRS1lhZOnF6/jLui08Qt5n5wTN26qDtiOfGTgAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA7z0LIpkWO1bRUe6Tny9G8je+HD0b/ixIE/OOP6xFt8yF2/zt5+pvP9gv0KLx/z3oQkhVEN7D01JtIXWx9ruvUqWb9V72hlmooxbscqx+OKsJL47t9P8iHxQU7FA8w3Xb8sKvS40kJc5kX5LEZXb70N6XXt36bL8o/IR/EzesoTAj9eUTZKNUErGekScCiOwDpfNPiq6M3lH9cGb/4FjORdpjW2rS6fkMqbbm0uopJ5Y5m1Afc1Cfgshnn1vMd71jddpHj8iPnqiFmCP96iXKUVz+7GYpKVL8r3yb9JORK8s/8xgcDe9sIzXlYYVVXmiieKy0ghaTbkdP8bGx+oNhs3R2Bzcnk38N1brviVSJ9dxni57gs0AMUxfmBXIlq1Snb/vPq4nfIwaRZHGrfMf9nnFKxPG3L7C8JPHgHfKhKr7jh1H9MXQMFtV9rAX6UPyPDjgDS0V+VT+Z3UNoy9+8n//yJImDazD7yYsb/VZ2pPauvk/SkVFOuUFblfuKRYq/FPi4K3B6xfhlBdtuyL/eSC18YT2ZG8i2QCDL3+7ZELoOevSp1wycu9Jfn00gJT3j/i3D/ih93UqH4gOGx3HT8Uop+T9z2UeIT7gF+7+s23DlsukpIXVkGpDi9ajhE3g6Wbxbyk2m+dtSSCmXgq7WUiH29M6UvbXtFWdaHrtU754xQtq5mm1qNZM/QecdY8Hbfqm25ZTgJXbchXv8t32ly/RKjl13dGOl2QW7CLo3nT3/Hr1nwEwx41vomFAgkuu5e0xVbRIjmWOT0/r5fuOgPQD77JPdz+Z7tgxkTXpuwTy6SDKC8vt1ogV9vCaXTsMSH0F0Y+YmlB0m+Lda/Dk61d1QMLkStQMR/8oiB3pkBJlfiyknTeF9XR91lVtNVn4OEw0fF56aRBemBycyRPoFtuXW0r2kPmWkiaj7x5MRYRqg2GlSqdeUtuv0ST3qUUfaB8HARB2porr4P5R9VZfEZlS+FdhtS+jMmn/Qz4nvpAP+fXnvWKTvnGbTqnXhupNlwoMn8M3dTej+x7cZOqUH55mCq6gIZj1sNF9xV1PXf7YWZZlkoRwMqLlEIZwRIEQza8DNhVoylYq1va+Mxf0fXkHvuDYBT6aQzajQL8Xe/s6oVZk3ytsYJA8FAlkX04G7XzUqSHdOp/8wxR1Fv8wL9xQJ99vdD8K4kTtVLpRmQpdzHeV/S8WkjFKbfnyfykbu1mGq8nONEtY38pIlyAIxB5Rl0ud8tFDpCRPYaEh39j8rz9O+FaYx+S9t/NjthtvR5GiE1C4t3yNUrh0AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA4Nu7pWj6rCA8SUKBPPWo3Ccfh45uCiaW8L/np4ju1Utaiee30mxaif/oZUmpWI+cfiHFhltwRjhzchDxl+zvj+ieTKMB80MhfSEtS5pPPpvk5U5CWRa9pTdRQ52uBrPOcslO+6Osc/Emot/uBGm1us3ovEyNnpi+PMOX+/cuoy+ghweJiettv/uZ6MfW9hBn4wXsXyruBh1/EiZrwnd1BzMn210KU/nx774y/X6rJ5WD9SGSweB06Vm/vMJi1+7G5fX9QcHiYz6DEOmyy8ntq3dHQjSrvM+yDA91uZJE/4S8avXNyhdJrtQp+7ZOi5xVxR0a/fmNvmzbfZN+zrISiuaXppLiIVWV8og4Cq15i/i3zvdEHKvhuHwIQkM/4j5/juLkSRU86b1y64i9aRQtW36a6ewhpeFgSRfd6o6+d2dPiiW8OGyLObuaKvCkfm4xp0CGo4kq9Xea493gzKW9x5twq1mdtSD0eS9rH688768z5GdJYcqXFtM3Q0m5EyxmST8TxLgalVtavymmmtN5rsRUvb1sap4zuRr2dFQKy58tsXpdudGLe79ZKRwnuH+qb+7oSPf8RDcB70eTmsr1toLna+MfA89+WVlG28R+7G0X5ilFGD7t3/hoebctv5ZbdHmNqzBDfLO5pcevtTlbguPl7/xDhrHEPmZvadeMIFS7/IwlK9STMI/5Kcb9qSFtBBteTB9FNo1tz5ofkpQYwptlMrxvwa5JgllV/8zASNo6/wfL04W834zZv16MqickaVe9v+/zrW/PqxbuadUcJJxdHA/YGuhYIsJIDvlSf0fF8GV4EgwNxIcOHw8FgsHHV0l9IHL3LYQ0ve/LhYbe6ujZPvFoF/kd918W9uQbOgZvP+pPbb/T2l+jL+PYrdkg0dE8vHYabEvNV/i1MFU9Tjfj3t8j4eU6h7yk/vI4NfD8TT/Zop27+8rwOaP6DbHsz/C/JAvUk76BgiOf49tmeR6bpKYWG9585B083Wdo1mIl67znrg41k+ry/BY2fY7E9CxITEj/6VnGMu7pmPhMY9y/L6tktDHKljZCvq6nxF9rzSgmd41jSITig7FZk9BINMtoyZ99yxV43BDb+C6D8FNZbS8ivLXgkPm1mH2LEO55UidFcplgzuO2+inj4Zm1e8bfAyvT/RGphClVS/mBRDZDtfFWAtXzSUekireHn1m8BppbUyYOk1wwB7dktjBsa95vyh9/SXkrPvDJDm/xu0NpZ9+B0+hM4bS8bk563W2Yku8zibkZXNnUWqcy9Vxel+X2fPHfb3w8A4a/AgAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAACY6pBgUI6yL0UtmzBRRqM2xv2VF4yImxjQp+WnpSnSW/9MdvTDzk7uUdlMzuwF5T9zGXWNJtEVJcuttJYE+uCcQmu3O+sPGstvkBLx0GXmg5c+EIhFTeREWz1J6zMKuNMyfKVSMz2YYsmOg/Osd4Hlqf3O1Mez7K7h1K3uI+5E3+/lLruFt5PLiKYio+QmS91WnQ/2VuEkFfOpWMrZ5ikUSCxjD/jmimbjGYdWKMRnSo1+Lglko+8VZV9vluhHE9vwfF2+fJs4+mHdkv6DV/EQZsgvJPc1flyZj2p8wmb2GJ+OaDF1KNzc0JtOveO2OI97Pr/4/LGpDc/kt4GQvddAdSunfrBxB0rV0wz6F9nukg3FGSNqnjanto9Q3EccZaWZkfIpsKaN2DqPKEjOpPofvkjeqIxzXqhk+75KNrp1LO4yun0k4bciP95RzuQltdseKePyYHHYgHHo6TD59mjmQrCYIAKRJ2yqaUyU/xe60fOj60yd9oyKxbbXVhWjveRcCmRbtsJrcnYNVTfHx2pjn18mteagPhnIc7dD9azERJej5pxC3fQQjype9dQIPRyR+Y4mj7o/qi01aLb1VbyWl+/Wb2ZNso+o5OtTNYmhNCpSEVsnVSXG/64e900ihY+8f4E464hkM3bI0EOh0/vmw9robR0cTDoG5/ujthQE2oRdR3K/T8Ab6CQUU+G7PmJDoo0JfbWrwMx1l/K8xebtT8xGL4rxG4Vv9syxLHiL1UwrrxIifwlcY1DdvVjgdi3iI254TYz1STdMR4x45GJdh9GocT8xhOK7BkpaBJHNY9yICuTnuoKiZNdM5p262vfIqkaXRfk/15LvnytNDNgOxxCl9vsa4KsiqjWSrBOmO0nVv3e/pA9YaJYgiEhFUS3l94kjNYvx0HAjfXn7peVjADfrfxcm29Mks+AkzCXiRCX2WN6cB/e7Mh3psSkx/7tXInO/vszS4vf8LPhvk5MYb8aXKEIC6dEGL3JRUKlhTc45+ce3quQBL5vfTtFjiblVvF72Jmd1OVZHI3NPD62S8RsvURAYQkdkb52RkWycrz71QBkVTfjqaoTvGzea5LaH/eJUt8XjV4VIjKnrY9uw2NkCBF51ZfpS7bUC76JHvewM7tnWl/mrap5PtZFlTPcWBVSN6Cada7qR2+pCydZbeYbX/lE9iTZk+VOkFE/9250o0bwiohvZd2lHrlvNxmD5kjYSN4PIzujGhzMC3VDynWOv3ySu3Prz6zXdnamky+F4KpQbjVPCLtfun+hGfWpceuo5lcRBpDo61akAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAOkCpIUKsnLO1/vh6RRdprQa3GEoyn5Ij/tv03pKqVMHF+pUcRSUF3R0VZEV/5luSMRqMlw4vOnZaPE1Rpq41ctgE0bhNH89jYMueWv7p5c5D3Oc4ZtoMM+RlPGNj60C2h5QX8ZZOjhVN8+uiNext5csy30TdvaxUn/G/ck/tJvTt52QPv5580CteGOdWItm3WTB/lqfwIJmEEl9lGZMjXIVPt/5vIGc069vQ4Nr9ykRo4UeH2OVpa1HV14Ofb1F1GvZWslRGs4jCiknuvEVfbqQx+WCRJwdG8ZNY0KaU5L+YUOmN3AI08mIXKmChUXogEbPEW0DqfqXt9uPNqqaUF6GDw4GipccBa5PH7OmvyBl4HYR1KCNfC2tt0O1OM2N9JhKph+FwEWeYYL9B7enxRDLmqfEyHCA3S9m833q9DqMm0HnS5TAp131vzY3aGW388imokxX905JNJRQPgYyLxwTyK8kdWHro4dqDw5ukeVjZ+PTqyWmVpu/3YoyKZXJNKB5dvt3u1DbGrNsrdoa1bLvO7o05svuP2l47U/ny/X3ZJQHFbVZ0JNeve7kqC2V0vvy7H2836vRz6XZ9s9ZMcyNG5GpXxRofS41Cnb79eMoN4IEOHPwSyvi+UUMTAywBKzCu6t+NZOM1mNRPCXi0qd0bk/8j8XIXp1I04V46RozziU+ZOdQ/QeZThleZdr7cPc1tnlCmpiZwRnEfMX/QPfdPUvZlDB4vcQF6vH1PwqPjihfUFw70BDgMHVzNzj9p/B3l3d6tO9R/80PhdFv+zPZzOGdEjpOYizZiiFRASX/9SY3+75IKp/nwOVcwl5SCKezbsK16hXsQD0R8sVsRdn+UhqltpSTgnCSL0RRm2pWYp58cpJhth7qtvF4wGrorcdIrRDaiaLIhGJjZAX2qJEzRzWHdiCfC3KTgN98d2LpfDBm25JOAtvbyt41f9/n7JWulZre3GTza6H/haYK0JPwUeKVawU9cd+1k6B879SaQ0AY5lmE90YxmtLtb8fZ8UZKfBtuTv2TLN6flC8yrQZevY5fFp8U1tFwvqAKYfR8STRkTt9KxS7T0RqXhbRtL/Lf8XmxbGZlFOrHlYDho++nFxwrtsYPVfKVGQPIMx2YPCyT/B/R373NPueQDJmnIW9FM31NjDTHjBbu9/KN//BTrIvHoxb6OyPYdknkE+12E5vlU3OCH79nJdgFX88Vq0SqOSmYEXGDjIalom4DNnYOZdN0PVKk0s5jqJFBblizzNAk5WqXuzd/+ZORTFFqirYmO2NvPdX89AQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAQPmqXZ50cIP6rfrS3Pr9+vzBb0KhwtG+0OiIcbc7FKVk4NlTR2MNYUJBc/8lSBk8Uf0HdfBXqDxC/G4pat8oltE+Z9/02EBa5JgmycRanXPo9qK01rp3lHNbV+UtZV4mMv7XQxNKJETkbxJ9fLp6Yxgas19Lf34t+a+Gg2b0A2X98jf5XNYfvi8qaJabKdBWaxRprxonU7/k+J7+ezZrdAiUXl+8vVBnrPqXlDqwp78lau8SUjGb9zrrLxh9Lzgylw3coi1xVbYwPf4ov4ZH4czryNTxtt+iQ5y43/3JxFbg5euyw/5LizPhVeuRCTwt4mG9CCHSLsI9+sN9FUw7vWDWJV+vmJezj/ZG6Y8iZgI12Rx7tRZvhNH29FHKj9c72Sb6x0QGfucolwYikyXJL/470vnupkP+8JUv+5mL1aPExkTzaoaSkH+fPiIOqwSe2uszqipHPkmMVJPlyJH1LJVEXfV+Q80z5YmLFf2nr3TfNP84imlI8wtcCzC38no+HHUOlNCp5gCHumxgmUFmckOk/+Mgi2Mmi9k267uTrJtxi8fcjesqnI2is4bCis7XApRoZyLm8Spt33A8BoVl+X5707mKYYUlrpON4Z++lmZvSk4oYCf8JlvBj7p986B38FPCne1myoH+X6TIb+n1fuwgb0cMKhrjF4KjIkmRlrxCw3tlLb8msgZM0QXW8D8/G1iji/7X2GgsXfrizKfmnfyorjDplGVZ1lXbR/PEiSdCJn4SDFgMHxUCSbgNU1z/RQR0PoqPIk7w+twoMN6C8ujFX+zEIC2K3u9wm8nYmCUtsW4U5E/8O11KKP3HdKeK3TkOcRfWQRtHwvrvmfi1wCWrX4guqVVsv5h9O1BOSLFfB6+4K8/TEgwL/xU8pX8Z1IM+0ZSI+TmCMs9mkcvLRont6Rliut8uvrh64EyuwewgqepMMJW3Mje5ZoxrsZ0Te9LlXkUzVdzzWy9y6tCG/3wTAqnqZ/DFdHXxZUnbOzMbnNiR8Z7OlLoubhSgMDPsk+6gVzxp/Cxe0WXrFvM5cq+E7FxDLajuRJR3lz8rioOr2PnAZMFGdBde0Ka8HWPUaOJJ9+ujTyfWwSh5/Wx/aT5uJb3k5aA0mip2iWhXdN0iZ1BFR4FtsvNblcYlHH0fv4IRH4ru4im/H9E5Lu92a3X0Hy++NC9lZyorfhSFqN/7YqPjmHJg46Bw7LWP/lduLjR88p5hPgwmNtxtz2pLYXWBHRrqkFonyYSCkCqp12uMR/g27Ki7CmPUq4o2/Ivi+iaU30NyNALc0QEAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAOBX2/zs3/CipMY/oHOv9sjIZv7ze7VX6jnhSKs6vKBGjN+G8Ou411OzHL9s69PeoVUlphTB9uZikkl2fViSXfAji7OWiynGSR2QaZ/APxXJFumARVYhanqMuPAn0/xGXIj81yMjCTZNebavPjlhzMlfHEK7QXC5QgmZBlVL5L7Z6lSYI40+atWuZQYxpPcfSB3liMfebjG8OQ2e9JLrsf0TH/6Vxpwy1B0jJ+Uy8y2+4/qUV8tstcfNxWgih2DqZtwvn7nN2IaFUvmAFxPMCnD17Ctt2s5q/QfbRVBR0Gj4pSWuRUr/0kw9aPhInWygcSwca6BBQeqDhUTgZ+Gawl2tm3W/UtJ5jVwk0NhOMPF8P3b0jq54ON0THp5ob668OzTXgITP518wFYycMTlKrgXpmC/dCDhlC22ndna4Jc+m4j5qfFXc1f7ZnEN+nd+/neIixGNJ2Urum7hr4HRH9nIbzKucsv0so/5WWA/EJu9bpcez569TAxt+kJzOb5i+zWdQnjpY1SdwN1tJ+hFbWPXbEXG2ULSzknVZbQcNSuRxr48Uan6cQcvk2wy4w+DEpoLomi9CsPmQxFVfk14/ywTjhp5c++fqCCEtCxv82aCY4QP7mr7KLtB/p6D5PeW6GTthLsQWv6KxEzAdDp7pTkajY/RfvPn2iRFQtH++bnVCIQtwIQSokEhJ8H+qgt/xkfaxQ0Fjner6qly3f0+sDoZX5SOrSDrOswBuzEdNbzem5dJ1gUaTv1csXkrOnROHyRUuOb8PYzf0biWb+WP/6zBjqd779h6mt/HTjr4pCqpkFlsaYUmIY+3hnVmxUoWU8I/+p9nbjmfRdsvI4bVVZXMg/+4xTli5VlI4qnL2y2cWftoYW0bNCzFjms+TEjYjovu/zIoaGv2nmpe2Yikg6lU1y8hIlcde/8l3jOCKXG2QbRFRIGvfRvGQ/bPqK2tX6nR2vpguh589SEs+R/zBVZywBRUPiK33vxEa+5q4be+vXSIkvSMV/5lVTBNbIiI+uJwsOPXnD7J068/Jz/VfrIvKVNyh/i4VSsUeef+LRYG4akqF6p/dYMbnY8eoL8xeVTp2pvzio5u79JuM1vTcVw9ue/xxU+X79CP+UE1xSnBIoidTz8mSc7lCNjYGvTplSe8fq/G6Chbh27M+6Y3L8ILl3V3y9xtJfDDbmaUs32ppaze3fKH/YYxGu0v/T/ZDNr83K05DOLbNYzI03aeB0t/k7/3rYJwrla8wwdsFzQ6TQl0pmjPTiGA53diO/LWLRx+k90dUoyNCmmxL1AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAmwz/2MGHUiXtxchfjxaDSUzpRd/m6dX5bnMUxkLeV2pqLKHo4rf40gqlIHfe+ciXCANsnxlP6uf5zpSAGewIOmmM78XRrb/EWcfboGz4NPuHSfRRhJ2eWgIhZuhd5+DV3zBIcJISajGlT+QX67qvYKsRaqHpPM5vyGKLK3+9lacx6El3E0DW7RiqJ14nkUxOfqqrcc/fVrgTw/GTOjsz+fdXehbA1KFBh99+M8AmhboeR4U/WDaL3o38/f9/hrfVsIpLQqkYteeckebPqZ5v27lK57qsqu/1+KqchGYYj59uJNfqyR2pSnamhiV1rymaIJucSKkUzoyusEw7B8UmPjPYpOuLdBrP2xEkc+T68QbBzeKvOeu5/GKMc/Zbhb8dBZPDLSIkWSR0p4egMhPFEiQ7SVLX92+acgymi30g4OF5BMt1kMrXb92l5O/LsTbGc/vVPbTju9RJMKzSb14YipPb1km2Et6hyj+d1sEWXg7Isds/UQhKLDjXcDdnW9vG2egzLZzncZc+C8CoZNZ5ytI8IPSN2cNRtmzv/xvFPvJHem6HdQyilzs8o0naMX8L6l9507bgNb+dUIdGzsye8e3sXyk61wpaMtgNJ7Y/Ro5Qx9LR3ErYc5W9d/1tSUi/IF/NDlPlsPYfr4t6Xoaipm9BZ075DhuW61rWuta7nq/5mh1ncx05xox+xAtsB902pWsQDPRl9Qvys1Zk20lgNUxGfwLz8sVFMMXPYI73/2q1WTa8/6pb4sih9V1BQFrVjyfi3/2eYV6/B2XSaBjMJ/7rf0A0BT2me5UuxwSJh+ExrxvTlTaTKZtEW6jIZCUHKs8s991Q/KkK2iTXF7zwea/aRH3pyRxEuur4SlPsRvNoOMBXtDReO6MX5JF7oZJ3aEo4pk+pXmqEyIMZ7l8O6/h3JkfNzzVW/n9EK6XkTg1jcJj8piZLNIzcx6zuO9y9oRvHOLP0WWEKLqfYccHiLqZV50g/YTH3uvm7dlJIEBO9sCEYafNc+DVttXjZYJhmzY+egHDCTtXjtm4jwjnrcv9/ZfH/6mqU/3fIGm4YjmYGSK7v53WyDsrdvjKApdcGyzLjhNdFA06fO7bqzayk9dHU+BfLab/TEq2se6MCBKccfj6pVU5HF6aWboeG+5i/gSDczKIxys4BTkIJQUvYsFoVeTT6ZDB/5p2VIa+dwxU1/Dj0lEU9doODsbABpanCR0PMJ7ILvCBLp3bkvrJJ565BiNB6en3GTte5Q0YWl8X59zcbTyluCS7I9Obb+0zimqZroY4Llp64BAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAIAa6UzsVSu2njEe8V/0Tc2dLv39yf95SaoK/8usWrT/eIj6IeQt6sny27+zS8TrVpRVv6vK38qtGrCfYBzVkLk0SK9Pbx3NBAiHro07uSGcEiXUhKG8a/H6hfdM9MQDSbSaWrl9u/YTlShyyXc7nen7g6N1tHmZmaMzea8/zcn65jkz1LE77wU78lcWNrBePrfytxbBb7D/9yo8Qir9nxdHauh2WAvvB6LZbOwkf80xl93kQlo+9fv1JLoN7bvblEM8Ia+iPsaL/5oiRTrtcdxoG5YXSqxaVAtzEUj48t/J4RAZvzlt5NL2vCGcGE0Ze+qAOIIcYiQRQN53VE4txiWsTc6ZQpC3rrz/sFzm6MxSxqWo0bCI9MorbuNf12PjGycec7oXvZP/qegSbxH86Pt1SWn6WWPiqvN9WS9yW+R3q8hCGgTpETZy4cl2lO4Dd0s54qFNlQsvkS94qSGaCTtVC+2tDpKfMdxP+qLpdPHd6JxHxqQZmr6FfEkgtysl4OWMCWnSGjBLo2qlFy5eoBT/gDItbdSMvy9ocd377JmUr3LbqZBMI0Ln6uOTu7X4JHZ+F6ubsmOKQtf0b2yD0acxeggDNIVyNjcuH1E/Tf0zbsDVxhve1KOWjQ7yUx9afqvY9Xzy4OmHQfwxbvOFZF43Ny1GNXLMR5+jdDGQu4UzyqkDWMkQPhntMJbn1YaMh8gjpc9hETPgRXxOVJV2/S/G12B1YYxsQagb53hjjZlkUYd+6AenIbu55w/8yWgV8k1y4+eUNZGbCy58Fz9E/pnupnqolZByqxOTs2j8oid8tpQgXTK3MkutZbIZPo3M89F9fbD0ux8DFOwe+f+RGZ6rU7ic4B3s2G/Sk1Hnsfe702qIxb2sWamZ/aK07U6hROfoGH7ZaEZ9ocOLx4u5vsSohkzDP0L0G/UqmbzJx6/1Xogp37EvTBmJLEi7UKMp28Nvh0TAX2BUSk5k8w1hz0kMRfah7HGMA9Gvv/WzMhNBLxbvuDM7XB5l+in14qAFO9W4PFDdckaQSHAT/3AUILrgjN2SqvByRqNqG+gUe+q8udtmba6ba2FmWo2Ko61vgXgYUcMrpSHDqzXxl1ew1LlB9JLQ3KRVN3seW5WI6EMqD8WGIeLe8IvrcuVxu4OcyrH1JyJmiwnR5F6ibYmrVo8y6gVuM4rm0ieyhtpmHxR5FGSLf96233U0pIYhKmok/X79NYF8h1A46MVOcC/VlPy5rBe1wJuxML4q1jrTr4cxBg8v030cmWgHjA22zEts8GgM/Iv0pFWLi99cmAIAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAbOY4bGRMBHwVxR1qK9qOHicUeaz6MiK+Lc1v4da0X1SC2SP6JU5BqqIsOdiRVTQ+Gcn/0SaJHbiCHZ5IO/ziTv2s77oByf5yBIu/teY9TdgZjTDP2uQqe9GMr0Gcj8KWGemWUDgi81t/n4c05DePBYi+CDGnv2ZHORlIp0/HCqBPH+Bm3JypjlO79XGci9trbZ9pbDKQfemi4Hx1x3J9IDm38zRV4httV3aosdj4Da0G4K73l0W7SrJ20aUjEE37zG3zxz4dqhGjxl9S157aQpGMyVRLl1dKpQm6U5pqZk5W/yRnE0Lvapl0xeTdcO5SfBzlgjvU9R7j/v5updbY0+ZpYgxKw2DqK6O9wM7gm37D0+9ThkTR6RLYD1JWXb4TWZ8+KRV+vdFn5go4Vcs3xROQoKBnKqAQyxnNyf6Ik8nSp6jdSBiDkErqS0Py/jGX8W3fgwmCKfoWvOxHd7FkS147zkoK+mb2Ep2rpVJ0uuXlzlc3gtExUDja1Q07Ij2o1GV7FkRqHh/3uRdV+1ZamvKtVDnp6Vh0vhJRW/+OQ63WC9rbKgNqirmncgjrs3kDHgR/TlazP639KvMR2l0ltWa5bmWQ1pv2fP7Mfh/PFqZZMfm+Yivja3ZFOw2zSpR/WCiJ8FpvJmUYV39x6NPFhyTuOuX9iaNCoO94o/dcURoo19x88Qd/E7h1PqMr8lBq35KkfhN2tPj5YKpP6rfZKSZV/6ULk2poaFQPH6FY4myLMr5er+05ZczfmbMM6brBypZJopqsnjs+IY1Gx1x1nXSGv52fk1Sz3RIqFVZmmW7CwhnajY8TWmeR3BM/x1KJ7c76ld4mftvSLdFHPXuNPRvuSftBMfrXAzOfZP57lrg+b5T8YYr7PdloK79Tkg1LCsLX/3262+h4QG7XKSHMe8S6zlhqwvTn3yFOx3l888z3uGpi3/qq1C0iEWuMxmYRuUzq6az8mh8mUzRtvNN5nX56/v9EqG3P0ul0WE/9Hl3c/7iBBo0dL8rwMTPtQaLA3YdA9pPb2z/tj7iSBSU1n/LO23q3+ZqEvzWOt7Sl1KhjFZL9+E74yULJyrrGCxkhV6wqE9fVyO+kP9hc3ljkVau40/BvqfdbKGC1N0oaPeQPYtBoWZC5ydXJoQWoMpWxUMjmc23fRk0ZrFBv6is6VCzrW/63VjSs/vlPdvSfLuKkkBMMRrGQmlicFwe6Uv3rdPzfZi8ZHDXPo+VOERnZrJpWW14aRZ9/cmNuf71nKqk/SfGanEB+FFfPYelUOE77zyNBeeu/tIXDAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAACRcP3lye4Jx24yEV+evqRVN5IqO5YK5SPiG8dymp2VzMM8V+v7b7TZRfPcgny8ve6/rmev/VcdtDbH8rqsESUUnw63kbzN+elU2GhwZWH/9/DO97nfc3z2qi2lz5Xt4bMmuw8xjY/SzfJTbVyUjfaLGPeT0rPzLgn/2VybCaSE69rz8jIXbD9auHQf8X6sxBxFo5b/ceNAq3en40obafcuTkTun6e1Q13FH27ip1LOs8wB0y8bcSd/XFf8Vv2808n2VAQU/tOdqG8K2GHdd81+ztZFvDDp132ks3+M9CQp0Tojpz68s9Yasd9j4/s0oW83uZBLx8/EZLGccLn3p0Hvv94zvApJeXvCTIX4L6ksmXWGBuv2+2pDbZND8oeSkqsyAqZkxmZd01Giir+HvUWP3LXJuFB/Yumbvyv2lmsjx6+w0ZCLER7muKxV/GKuYET+4TuaIw37+iY93/vi4NQ34+91ONusjwdFWQXWOcIOZWZShjSni7Bt3msFhz7bFFo0tqyXMFBQFvwV0R/oGWGXXhzIg/TUHS5ZsszdNpb9tc9bc6wxnUvqKRCC9FVJNl0oF49YMuRf6im1Pm/Z/xjx9W6y/Z3ywX+2v/tHELpv33WutFkNySdffgynevSjaOBEpazng6LlXo+5Y3seQQAZKXMrEg7bSodoWFrDtuQ6/dapyihvkb6JDysp+vYTxI5yp+mvl/dPYjkedwlwG2piJVesKP1QKHjkzAu/7/5Vvcy9RBfe+CA7FLXrnNBDifnW85GUgq3If2pTHtUXrE/sGewI158pVRfL333i8u+wRwti4Jx6AImX3AgHQjgq33Q6SZ5ym+Kiij33mJE+OM/rkLaPfLPkKP5WXcBdnSsjdoR57+nGjNynaZ/fb7JN1tDQJeKaTNj96ppvpwhFZd8InSiG3t+V+xvKXt9yjq9eRel/TCxlDYyNxAlJw2U4/oGXTLvDbfvdtnL39/2PCGk0o4J/2ZcC3KFfxRZ26pW+FVEm03L+63fxY8zY5jwn//cJyUd+ZelLR6MXy3Ag8STLzVJdsWQcfiuCXMhOz5tx+8aT/ZVaeaID3j43tPvqqF2jDLfVIOnHsfN9NhTJRKnTUS44BvK0iWy/XnM70hLuP/fJU+2It0JqQir8UCH69UXEtMqbisFUmeb0GHQUPTLMX06oK//l/8H52DlDiYax5zFypWfc7EUYNWr34ssn3MXPSoo/M9YFxj7ZDOcT67PddJtwvzMTDuObrNW4+m+cNCWLxQKjKeHDzJdnXFeZOiXKX7VeSy1/1JmfoCYn+wcAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAsHZyg/CuInjS5OrrFWkkLxq13JYlkg2VqNWnOzVNeio8KgsLwZFDVQpis/yRHcRaJofuTZVe9dHegBf8Ok67bk2KL/trbazdlqm6JHBL5njZQzXJAgyyXZOE7lfohQYr2N27eBAtG82/OGW+0Hnf8buIeknYf4072MMGSURGhKG6NhaTU6JlTXX7MecZ9a4s1l7OmSMSLXOajGbakYr9kcGkA8fV1tb3o7MrQ1dkHYynApOpmrrCKjdEixplQkdYPBH9P7mLvf86Yw0S/cAgkZEj2cNzUVNbviZlv09/nu+G8lWBjAb952wziTarPD1/9VKZxISxKvqfS+na2WRJug+PMPWjkMxLUGKLJ5kGuJF+cQ0GLC8e+hAEP/P+wDTwFddQrU1v5zvBxDpvgp29q4TUg3s+ynm41QhBh+EXmif8eYPbz5fWPhr+4a4nMw/b2b/Y7zrW3c09wsL60+L046KA7blyq9U0wwiq698vUioeoVnGyqPHyRQCzw9sXxG4mu7JfNGYEVNGwuc8R5c7FNR515+3xed6+S0+/vmLN75P6n9Mqu56PXttJN3PyJXDx0GhNR+pnLAshXHQi5Xrg9Osx20bqHfqifI2HfcFxchbHyoPnadKb9NnO0NkcLeVZT/9RV3Eb/ND28A9ERWQ2fiYtIxrY6vE0O57jlYSP2Vb4LDRp2l2z/7ItmvViekVOeFKkxVzl4Gvs3mcqU4WsSsd8qDU16agn9wnrspuzQvXRkeP0rBp5QcKvtgpKf+ZEqtHXKSgId+jnL+W+2PWeDNFRsUwk79gFtKjJP1Cr3M6PoJ9bIRhMafrWpy6KF7wP4n7XOU3iG00Vk6Tkept1tyopyEiT0TW6e59rT++zkRCS/oUpk8k4SgQ9yTwSbwf6e8/fDsDnwp0d/iLba1z/OfrQ9uiiVPln1ELfSR59IqDZnTtH2vSnHeNPI8BJ8PZAv1bUULcNrRV12y1CdVx6eq0JGE/kOngc7t4vJf30VkWqUz+GHt/gfa2jurZkufAijoaaHY8PAlpD4hrOOvz4OdgnFxyilPrqAjGfYKs6mxJdr3oos0Q+XB/jbw+jJcg27S7PmN7zLggEn/acoK7cfdSpHvciXvJz6ki/vvysR8DRrk26anwj0urWo24RWOFnW+jCyKnqLGeWnH+e68Qo6IyLf5at48iqeiPCATfvF9hivJJTx031W15bfBKV4K2X8yzoP+Fptvy2cJUgI5JFZPx7Al7W5xvFFenzMuohPcnlx6C7+mMywXIZmmC2dGFb14U8Jrmj4YAhuoEAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAECMTf3YuZAJV7xAe1kqNTHbVqS2p6toLg+5zyulzN9ts1bMX1kbUAU+LVLG/qppoTVq3Hlxn6SOcfdPuNVLjfohQFm+/M5TxV/3tmJ302+mQm86Vbds09y3fCPov0ulf87vBjlv9bKxRnk26f/h8qTnHznisJL9dzotYx9bY45U7EnFz0/Zwqb2lS6iiMvinzT147sgwyr1Ga2WJyt1+2P8aNdruazoOPUk85WJKN80sL8P8Prf9nNO/1NudvZ4wkW9r+HxRjMz+I9TMN+kWSa1oX5BFLPIcmgGpcrWXL/O8xoahvbJYAk++aouDY4lDQXX589sFEpntGq/ckm49lzPDmGeyDMwPl3jY944jycPKJZJyz7+VP5NHJ/hpWgeg1zKr1xcAr5Nt6aCkiv9F9faKYQvhd4S8+IXmFwOYUrU+BszLo7YWSnw2H1zNrZ5nL//4VUxy7dc87ivRhj+l8QOpEhWInmvPuPrad1tRzy/dGxPbsX88ziNGN/XAbn3VyDBDdct3e9VVO1IsqeYsX9WNKvW3q9cKg+QaT+gzkURBFV6DTWzcmCXOq16Jkiahsaw4Pr9h1Xz0PRRsoWPn8GWRTwwXsvxFS16nPfMEqOXtczDS7KZgR7PczIT6tPHLJpvZgd1fuZ4WmOTa6lVr/t4C7T4X066/ccXtkFW8Q/j1o+R4A7Th45DKBMX+ynT0C0jOfY7+ptJLvfdy5nin5QznS87Mnk5eCuvb1KJWIkbXZaIuHZjZA0YcsqZcgXHqf2TRNudHOaawr13+hLaHF5LvN/OTnxb5a/dvoVoo+HfY7STuqu5EIuvsvTjCykmQeyRamnFkUz7jLpeE/lVPXqsopiaJy4dIhVHI3qZvAtzbxHLu76PZ4uLFyR9j2z8H7UcJD8+kmWXMxLbsU3X7AzU8ooepKF2TZnlDtPXv+awxnjYTIhXE8658nhtM9vldaHAuVt9PUaq5T1qdSpe0t5G8tP5AvEPyr6/sXfwpTtjaMMbXSynZcpHYiy7KBcHK7q06jON50pgynrkhYbes3Zw2zaxtUvqfY9A8Hc9QrkSj35R4WBckNclqnenxJUlLtYghmCauH/wc7H2Am9FLUYRRCRz0X2nuN7sNKXKDJV7lCjc9KXcFNOdt+PFl01xtpU0zpI98nm2V1k9+t50xU7z0QMuipHXX68bv0uqYOrby2zoaXlx4zfAus7mB/+y7Suf1xRIc03r8g1WUVOUm4Pv9cdybG2V/Ey7RwWHCT0PLgloO2+FOEULiaQsCJ0vKJ5/Glfp9P8XMPVUHgAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAgtiy4KmyynldGLAMF3zpq5fEAFwbeS7+HEu8uUbFKlQlsNW9emtwAs4q7/hlJV7o6gfLrFqKAMOU0SmW5fsdjNd8XE9iXveLvTxUpieQkjUV88Q02ZJx9ypieVO4XzCOoxxp4PGaev64KyCeL4KS6Mgr9ciBefvD4xWehTgEaV6ruJ47ybtxr9OTZqfZ9kvYO746FpfCazvmJzT3muCprazzbJkzXUh81BxX79Txrq93wxXvk+uxVEs98Qda7f5cz1O3ZMme0SS2zQ7S9Ho2jhxoF6Rb2V3PBwlfYuIJrBNLrKd+KvqBXat5Xft42GpurIhffsf72g/YosfKdh7Xdqf57dFSKqEDVt63mm8v23B21T/FT5V3+Heq/HhlGXe+vkqSue3trNxOL9LO2ISFeDxtl1/CjNYGioNPgWJrxuY2K8ODHuzSJob+/bN48jXHm4WEMGS0p2hY92x/nGokMq1Osn4/xltjzaBbfOH277YviGaq34en64+7gPiHKXbRccz3l45/z/BpBmuh1glpeTnE3QZuy0K98+SWMN3IuT1ueVNjz5Ilc9BQCK0EERRkf8pnpEyfSPx6OHgdPzPt2nfb8T+nyx5Q8sodJE+qbbq5mf+j7E84tSlLdD/xewWzUvFVa03qle0/JuqXcPjrc/98T3PWC8FaPhNO04/Wn/QlPbUMWFHRCcm6Zfn3qeEeHRY5D9Mjzwa8wT5jIdORJvQ39Vn6SEh5XmXqEdPHeYcr3xKisMQ/OAbkEMWZPfEUPRy42RuYI6cLKiE/yMqVvsxF2gw98ll4/ZXqRXZTkqHDwvWI7JE9k3g/uI3py7RBOiOIelrCj53Of/ZRmwX3XEQIUAUs5+XV/ZdmQHT3984NeO9428SvdC2NwU46tC2OVy8oyrzjb1QQMxhfLdsZ0BZ7HpUR+or0s1s14LS085sG7ZUecobuI0nmUpPq9Ll/U0d2TjI2D84Z18MdHX5fjy9VtqRdCybzSfzTGcWkra26e5Pa/QjSd2sh9qcYCsi0qc5chqhCxskBREd+DfFl+thihbEolV7/7m97GAydbjXb3el3yUaFqTdPTOv3ed+uCcmRvLh9wien2KNpt7V5svCP+7h/LSBiHtiQ4/LrpbcoQ9+6uDPc9Li72+OQkvzBegWYRB/12Dz+1vch0n3qst0c+Wjrlfuno7neEJF/r1bANsofJZHrZRUFngzvbCjRLrGdhh9tVU4EpuL7EOaaLf1hrbYb/KiTp4f1VyCPAcLuKLdgQ8T8TV79+7iekwSy5yRpR25XwMAZrAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAC5CLY+S4POUgQNcuuU2h5S/7IDkKjubIkW2nyafv81dXZzvqK4sV47e/wE7frZBM4XEp65i7ZsMgJMLDJkKhLfbMa/6+kPXkfyJ1tjEpkYD+4/BSxBibt6Gk8Sifif4rdyDgyG/B7kFbQDcmPhW68llCW57PzP1xYXQd9aD0IFpascAwlyHxPt4hHZE7wMoEFXDtSzsy5zDTa3RmAEgo94t+2y/hHzT/8ht2ez/kCY7UBmqIjT3+6k/mzXb3stRlTyXzf+5rfzTwoHxLrRHWGsvqr6AmWLQTc3qmm2JIbp5VPl8m8kHqlbWFRZ7isGcFDOrPNjRWspYm7wBY3KFcsQmSdeaRShpQQFU7OMLvPnzfz9KcK7Z/AqzslPs0C+bgTbvmC6SNDgcclQ6yeCdzkrflnnr3WIfZTMc+qjGg7WETzW2r+V/keJPJiLGZilWTyS+m6wpYpFczyChvZK66uhnoGT7xJGOHq1+JkB74BwvOzMAXnaapzpmX+4nNxc7cvw7+lBiQhvjTnG7zfVxDmyMzLDBGPYBKTKb6jNbcEyJ7AYO8FajfPAUFo5WZzHiEflwGnqNIhPqFin0pv/YMwmpGz2F8YtCnJeFb0RqiGWpP0nj9sfPUZOuoxGXuRSf+9f5c8hkb6W8XbgPpEWq9vzS8zvjeeTdEnhNlJF0S2NIpNRDJY8pBt1//P1Rxh5vWeQ3Ve0X4g8NjmI5o2claef2BCtvvs06QT1ev9Q8rC5Gugo585+JLrJ6OTSIVHLmb8s5TXbI5oafHbzNzEK57VnOP8Sjc6B676hP7tBkLbOv07JXakn1QQmk+eZx6lXyWO/rd9F7kZ/CCjbt7ZFrmBfl9QiSiMxrL44HFLaDWMV6BvwxGC9YvwX1d+0sjdTTk+AoB8/HpBlI6VloN7xj0cYTadruX9h3OUG7e3z0svOBnb0xm8/qzmReHFQZAyRLfSnieomIzetxzQt18MbDbjqBRXWhvzbm3zlqq8wddQQVM/TJdVfWmvSkzDtGvoTIbxbYErWdmhZoFjoGPuxiftOPERr+mbCXCpsX/KxVWTnb1/bp28kXjx8o5K1w/xxfJkvNodvfyVZGf6bIlXSnreaqWxjOv0PbZLKYw9uO+3cYDwL7pu/TRHlksnPNOgvGYX/EqFdcCOHJVygqjgU5hxGIOFuJ25smuSE9J5OHH9nQIg2pXjh71o//4t5oErvn+G2wD/qiIjEUJ7KgceknbTe0czjJmsC3EX/2P88ydvWDR9bJ6UV5Ns/2j8mUpxq6Wx4jeHjMV9VayjPCAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAACwhbND+Iq6SvC/8Nl6WSO/EhrSwxt17YL442wSz9W2JaOD0UU/i2h4TxX2LjK3L1GWlcj4YwUnW9Htz+fkdr17nq8ws4+i8/AtU8l+71Pde/Phsw7wvx+7Imat3IzACRzUze+pHBs0/ktXEaY1n6HCjphDpSxp25ZjWunTZlsXLqZoxNY19zsbheFxxaGthfDqd9Q/9bLiq2qkL5D09qb0WF+jxw9epjtK7+pe4F4zRE4mYTP7DJbhqbszOBphV6gHFpwn9lewJDtQdF5cPh8veuT/pRu7qf3H85kt8llG/LQIXPF80QazliMRC2GT6wGZaeZdhzcdT4SZZc89I0jgyFi9lI+y4zv5heT9i3JuWhNxZTez4796oejqadSK8vcxXw07iYnbQ9Dy3j/QImxyam3y0CVx6x54UFGSFIqdcOqrCZt7MqUN1Tv0rC8IP6MTLlededO1r4MVy1jwqu5y3eBSadK5NA8NLycMoVPe9bmrdeBnRKzNhdF3+07qwyUmRNfMYZIgSHgchJkg19aMSJftLR4m9Fujgt/oNLvnD+kqt0zRHVJS0rdH3soRrG+x79aW7e/e83ptqqaKj4fDBv+7sAjHT8w82jZyajUTpwI5emqyJedJbRFvCK/0CXii/mje7Rg/jsMepfiJhdvEerr7NftpqbD+E67AkWmk2XHRL+v7jM4M9i9zJQtzBvNUz9QMU9N2JdIRhCZHRFwjk+oJ+hnO5ZuMvSrnyF5rjCk/+lf1dFu8l3zA32C3dZOE60SuIGe87MjxaZASGufMX9Au3jtnmviqfP7qzRD96VOFYUzdZp3tjJ+3zKbvm2/Yd4fP+RvKVz99zauPTF9nU6jn60kI8VgbGQ/s36f1XN9YprhsiE1pnZfW/jRIdlScRatnHCBLPSSZ7SGBFutOnYLdXcsw8d9sdc3O5+mEPvq7DL1UVjr2J5mhx59Fgn/iXUTucN0v2IIl7VW4xmeBRJeRkxSiX71kApcnnVPDS85BHvu4Vxe7XFwrHTZaR1b5PEESLks7oqM1/FxmjUdiiFimekGzQemNTRQ2pyWn7l6nyvlcXPTTqqx5EQYqm0YpvWE2MfGZRbVM2RieF88VCW7lqifJc8cGexrRye1r36/Zi4eGO/m+P7BJVI5dTQ3RZpu/Fq267dQMv1rvNEN+u5jE++KK4aD9H0k9B4Y84yum/6oWxz3eON+NHdl5+PvXydy9p5dbkLLJdWZTW4CGf2o6E1UCH1uNl/raRv58Sk7ylsDCO9CzR8flCQyeUtDGPygcyqrVCQYAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAqJtNRGJO8niyi/OGS4cqxGzooZs3YUtlQZ4IXVvV2hGJCXkpTw4le22qN733HdPjyvgBXeWkByF1wtC1TZW78823vKMo+5GdQ5r/+m3Da2iuZD6LGv4wON+YIDgl7KxwtVtrMORefW+V6Trd5ZDUCc/nO9N4f+KG83Q6y5MRXN3+hxpr84AWMIqxrvSOmnBUo02/xQeXX/+4c4c0KKPrL7d9ncXqcmzrIsNs8BzLyKdXzHnnYQKJXYiOPtXhWlN0DgSCNc1/McdLMUdsAq+tjipXdwnQ2jQV/ek+reRifhOfS2voM3/0Zctl6paDpMiOYDudf5SvctW2TWojRGyVfNYzNyySO6H/WYqZxqd5w+9bDn9RI5sJuSMfyF5uxN3OZ59ne1zofSrR/qaVEaT1VKPUMeFHwXDKV5ap9LyyXzjEqm8+oArLPOU5TFEUEEKp+o8ibAoDK0x394/ElK4KS5MrT3fSit+XegKkIGQpaiusBIrgmkyF/tJr+aJmMc55NAM8RN0fBCv3Y3ERR2QaaVOIzIwqxto99SRZUkzFtsvCIlQCqXRfo3/hsArjd0xStQbmCXX84SY22F0c9dh70dzFaVJH5WXFvXpcpn1014CakfGoaaWBfeLEbWG84f37VsavuHKzytErNcM/MMK0F93d9L0Y/2k+ezc9PCVnvnXV8jQbdRhBUUhwmrj7StSIEiM/ztQRFTtwdRNriecRtFDv5RiscBvvIQoFzzale3psv72LbFOS2LyLH6YlD0IV8kWKxw40/5XWVeno4FkezPLHcDHXJG3sTb/+Fm/tfyU0n3vbNBD+6hcIxPPifTTgD1V9evdeaarZff4ssJR5u2ycQ1DtjDz3q09ozUKlajIionolY5hly/N3lUcr3daP6q24C1bCzow5ry6CupFxL1o4ZH4+j+mmblvnXd7FzfleNShkeU+luDjCUZ56w2Pqohde0x7ubGFI1lfnhSSirXTHV3DsQNFQIqGe/HNbS1cl2lEJtaxfefjtjYLq3KTJvJIAsl8wnj3Dn3Cp7X53udVNh76l8Ru3vN8JZ2jrNp7rxFrpkZT3lCy3JJ/ss3QDh3ELnhMwMTHaa8000Bo2O7Xe/vMyiPyDRke8pT9tl8TzRqDeS8dnf2TbkW3f9V0Dw2dzp2bdV17Jbe6PK8t/PpUXvaH1NVzG/qNPWyNZlq6doSUX2vSmjohSjnbMRcAFLxB38G1yujDjjsr+K9TitKGPxvgS6i1P072MEzuvflZ6lO6QuBkEa7D87Jh0SVRQWKo1q0Rg60ijiR4CAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAJhOMreUqzHwX3nTbfEhhen96BSbiWC8GiEf21fL0PN2xfvWYv5/Tb7lrtFdj590iO8iSUZZsFRa//XiFZ0y+i+N+o7A8TDMBVklXFDKnAYpxxppr8Ib+zemWIOBnuL7R0FPaaMKuU+6NAass0fjqjsPv3349/0inHmpcNDs4LDLZeH4baQnu8vbYwbXcfNZNdOfxEiSEWslWSnIHxtCHswYa7XTypceJ9/V4xHqFbLyjitXqAjmz7J+mO+zVL9QaCV55jKTGZ0xYxFFiJ3ep2mt/vUTuTNS1oQYooIJZdBe1Pd+Yd550jKKttyCIulGTvKhBkfzT/eXDz3vDKfDn6/af+Ir2O1rWUhq9e6LQvl8SC6ecnl8adT/LZReQ/mAzM0j8thbdnwIJ4auvh+xDZ3cuoPNHvd6DH+kdNvwlcO8iWEx5qB272yidd+ggdwANYtCuTUh1gVCX/TQiu7a7x+d6rm/hfkn7PoluzN/nQh7vVnot9GQmMC54tzvyw5QjviPZqp3f2tJmU1xYuipNBcZbybJLRNesDkZwofwm6aDdaLSYEMDB507599+XxhevZpT1Tvk7Ey2qDaholL+T+/P5gs2GW01pLtjs8+kgSTZzeFa65VxcWNIPFTI4hu8dzLnUcL86q7iUYhtjob4HfeymP8JB7t5GHyJz4zdpGfxKrU+uu1PSRS3GZ96slfIGff0E6JgKrH5PefhIQ62mdpIjMGsxuaaVuS0Zu0zBdni+j1Cvf8y+EdTo2j3CTrcON9/HlyfPiSl4trpbczpeD2k/RfhqiKrNmtQ9uMvdeK4XXFM6jOe6ae+PCkcbmt/P0hMyFAz3xEdsgZdDvPV7oj78dHaVVaIFLXiv8Ye/D79bLna4ITNtA/RN88jJ8SniGvxsU7ZpUznjIBPTCD3petUgZbcmjCJq4JrYIQ79d70BEd8gWqn3qZOby2V1tt41l7EDM5fVW3UIWpc+hp4B2hKIfUr8iOsFy49mwOHhUO9V+AZx6w0sBJ6ytCswfK6MefTU80cp4TTSPVEZWuyjurgia4vtdWC1yMxwWjCWtwn7rT8I8Vd2j3fnApwLVN81KgflAgFeAkEu0itJ9rdF1DVfetP5y1nfVbCroeNkhuIGOrM6ASs3oZkZwbj7CQ2FNzn2Fy8jB8nffXqw26sT13Zq/MEg/faAqyzXS2uP6TrZUcwXrOchr5qmHz36ZtK3CDaZ8x7eyRL/fEOlDvb9bc9+LEY5bvhT8ofH+sPH5jJ8RUEmQq6Fo689bCxfo+fgW/A80yZ5+noZjt/4GMAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAgG7+TnukHyV/t5jIi93FYj7HfOiSWmLoPxD8rvaUpb8uLkys2jOJbEzTzyWGrbS1sTGAQ+XQ8+PwNskmz4zt56CO155BxoMfkNx5qpht4zeLE9+4pOzI1/WuLRRXesqYl5BvUD5KiXi70jGUP/KNkllc81yzwN3O6M0ix9snJyhOPHh1v80YA8TMZHBzxHN1aJa8+5S+rD2exGbnbqjgCjH7ExWTOeOg7rz6H2Ge7nnKSSeKmAeypzHuBwOL6KyGDFz1PNkTfv7Q3DcDCq25TZSYe4N0x/Rat6tppomXtnWJEQ7P5fo+R+EgPecjxZazNpBwcHnSKJ8UepVYSLX4Fx9b0/fq8R/Hry6ILhe5ar0J9595XU5O/6s/IlCYZ62sQkbBAPFs2uDyZvTPOdR3ROhRXj+Cz6d+u2KTBLRURzN4G4iNZNuDONcT/yiWD574RtFy6Gx9B60KEg8nb7OVd8jwrB7EJQbJBbeKr3uTw6Sy9DD+HT2yCTzfPNnf3nUnwM5wqZOdJ/jHOcE+ZCGxgpPis6HPF/qZwngcw9z5zNJiiUBHyK8XreGjZCY3prA77mxQEfo7Z2ktZxJxRQrJ0jEmJWqW/LY2au5yibrhNaufvV3f/pwTnjlP3rZc2BSSOcSgPCjMYQSdtazx+Bl+SPDGEPeoGxpskhp1HjUIvlV5cCvlwbV81sa6gbDk90jwOMlyjpa0kM6Bdsf5kJWwkl0PI6Q8fTf8685YDz6/MGaoCtZwGd4fvi6JJXTLEAZZNCwCxa4VD8EE9iaZ6P45EbrMPKgRSsg5YSFDZFlw5FUjnUp4QUZRJvJRC4dbpxChV+s/5R5K9DYJXyL3z0w1QcXxtv9x84jfL2SQv7hcS98q+apUUC6qWz4U0PZcT/6e+mPq+Z6+MPc3tqcdTzTrlitbgjlexChCWXm1TFprQIp5teLpX5H3hB1cblLSFHXCuRpU8e+qZOplmpFEU5/eNiJr4Np/nGylZjvcFiD3fzFLsHRCdvGC43VqA68kNoOZ+ZWxrqRwJbWcKDbnpybHvEjWA1Y/46DQKjdE8rhsAtN3X1S0RotrcpP7Xvm7XgvxJaty3FPuSot12VXiSpzIxNixVVHJzXxc8dU3DxERLRR9Jiz84Q/f/luSqT6PuQMta1/GViW52SPG7fOZV17fWu3uCt6gDR90ekulhp++TM/3uOFIm0EJUrL5ya1Q//JjByuTfMAyDVpAfYW29PSF096Zc/GJrjk3Lv2eIwULMstTGWa3y3+jO+pKJrcqKXa3AklTyJepz6modkMAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAN5tSBCcvx/Gxs3RwdJLsxknevXXq9ZU6rlGG/MrkuZFgV6spagDlaRhHWerKVT/o3FjZq6x6rc0nD+/e7CSIpQiOIdrYmdqdAsdhPRO+5v14IKRjvmL5+W2FFdphYJ4Id++hMrh/b0qTLlO1p5vfMvPJDpYIPyWRzRcN+/4i6xIfdtItE5ZcEZRy7MHV4viL6rnKq00Q2q2pK/YUdAo2jwPooLZUlIVfoZOsZq5Hnj4vj3FF70YTqTbz9hOOZ1nzRf9RDqMpPo7QmiFtPlNYNKa+fBXPsW3n35UZ7KRE3cNrp21MK2yoGbr/7Tq+NliU/KEVPYwbrRXDIcCEz1Ok8WJk+zff/ltwWsTyowmui0f98YpH2OWCw5TyT2TI4qTcZaMe753mkpoX3/6REedEvGRPDFjK7Jc2yetPl27XbXyldKZB2xNnqFv25m79O8MuF/NNPlsIMxaT8Y1/WafEFyXwk6naxK6HKF5l67xNMv66OPRUJ1dTwcmvUP28RfhFwyu/bP9prEjscNn7S2OtC2xCC22M6o/Lk4S6cnKt1UDJ5LULVXpBrU/U7KUnpMliSFSobY6c72d5LEUyb10xzFa1jElk/pb4fAqy7PfBOuDSzp7YMrZu4OEZiTFHTrS1ZJfaKMfTrYeBaE5bRkpvqzIdCMS/iGztMfLvPMha4KnrmzElES9YWdWNlX3w/HoXjfnC47HtM/ZZiaX36KGkk6RO56rd9uTXu9+n/L8MlCBMOpm+8iXWDsz32dUNEnzlsYux3GX00n/opi2C8vpP3PNDMmG+6TOmJdcbQIO15gHV2/Z4/YntWNf/Si3+XSrYrfzlpKhbIJsSeVAv6GdID6Quz1eSeLXyXur1TUP5MKFA7tpkuKzgO2wD0dtIRUC0xZUg/E3lvUERs0J6AaXaP1dvzFSe0g16sTuv+UgPhj3GhXZolaIysohG+kQz/MY/UPM6fiJRHGswKT7ra85bnv8V4tB+wat6umiVqqJgzi6QeBJ3E6+WVHC087dk/uBEdk1kvEd1T9quDjffCMtnbaciyrz3DX5LzHmFB6sHza2hsQ9+o/q1wcPpE2aF7o4+1664zCLvcORQ/ompJAzai1zasVIIrKdSkKe3oCEqt6vx8rEoil3HG+weqQ8q8bVJLBy60NlpoaVYlv94RlNKFejd1tqqcpT+i3tgA5SZuUnbl89UfxOvqGccPKuilxKXMYVGX832EXSr1rtZkDn0nsWxi2bx+dl/8UqtTqzKmZjKbUytn0WsWHrmOLkk8/tJx9aefnLpssNL/v4hjMAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAgPsCFZGW9xHkuP/4y8xs7L51O4r8aJFCeccp6cskfsNY44hiM/cFg+D5h3P3xYGaigR8ez37FDGf986UIrX/hOud9hMlel5Kfnx4go00rbY1iN2og72+yPdSLmbmQzJ1ivaaSEtm5n3ENWlF9jv8CII4KrR/fO73ytsRD9hoV2KCreXv2CI6OPHuC+kcZnwYn6Sd/PN5k+YDij+Mpsm2W0JTtNuYEhnQT7Y/CH/4fSwfsCpL6jrHIRd0bfxsRW/5Q36t+/MZ2+gN7w9ivffj509/hqgb4DZPq5YovyRqGOH6Q3FM6jfyQv2/HhZpRm98LeTjXqe69ETshIJfycKh7igeKBYCS4/+k10yTeKOJm1upH98WkSlUXqNS7i1w3BhnILmMbZzaeYdCod+r572SBqm5mh8CKfqrFNtHntfLMyayZHglMRfx+yh83ncPkP28M3xt0LDp360iL7aXb9CXn/8lu8ibXo1nZ/ixEB1ytSqzW32hlvvv8R/Qocu5kFlhW7cBe7PM7+K/ucRMT6LxEayWMilSPicZ07ZcV0q7qdBUNxNk1L81N5YEFXBfzL2up9YaPtLiBClmemRJhP/PnFobB3+Lo+6gG+vM6JGkPDWSay2mUsXneNOWlThEyrmg8yHV5p+kk/j2gvmCVCJzX+t/s7dzWkVq/wr19CBcZIgW5FLTZqRexiUNeBl5GM4nDnP3zHGdqXhT6KIGdqJjN6hvzzF+aPwd2BJBwLdZVWI6Aqfaq8rx5KnPXcM+ZItL4pasvvr+AH7IrKneF71W9TazsMV1z1Z1a38WeU3KWUe71CIzAUFr0+Roimy2gqtxX/9bmWUF0CpdXjh//6UJOocSw/DUXxt6jPxWwq1b0Sa3QPLK8yfu4re9REQCYbHJI/9I8Eht+9cEDyoeCCMRi1Zmk9xdQW6kAn3YCoO9MJ9zbL7Va21u7OUC1HczvLPvTYH1DKN+xICqDQM/wJNUgduR97R7SJ/ccVg6ObpGr6XDI3T/2T5I4TWmGnlwStvbjlTitsxtbtshrXa2ITSLCLl6uQEL3+6MF8ixjKDg+4su2LeOrKodi1/pHHquxoLasHGBU+M/SDyJu2s62sTNjUnS0HiwCAvXK6dK7dLn/IU7mCF8JqgEV3swixh6ec1v1gR05YfC/5nqSX8k6/L4Ugw5NLpoLW1nYwQMSzDUPKMx4/VW4R12vV3sMPCJUG4vTCxpN/DeP/nIYRsef3zaI6Wji9jC0XVa6pgLNbnESN0+HN35mqEaN37W7/66Z5E82vFFQLJqWUadh7fWgAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAlQ+MzN6nVSpRjQka3yQ+h6wERjqXdb1YOBoQFHp880eYxD39nUMGxpO/TiNf3x7akGtiJBO2XixwLVclPiGZL94IiyWpZEgOjWEIZ/iqwm+U/gXvnz75YUjsSgCV4wdHcmWp8hez1VXcR43SXoG8PBHXa+vkbVecP/Dh8+7sNGEJC6KcykvcXmlDjzVllu3yp9/ohR+RbBd7YB48D3qzTlv1kEw6klVMv4r1aI9FmIUVe7fMGXUxXrCncYBsrR7RdtbX+vzVhWOsyUThqWu0BZ0fNhPHWWtcdWBhBQNPtq+JC3sd12SM1Ssj5CP9xdrqT8OextiEoRfjZqmT4f3Gzi6m68TtnQzDNUM6h161WNYvklRoJvOcX7qTqF/Ze11/VCyonyPy+8CNRCiAysAonR6euLjnYYjQ3Fs0IGuw+o3CYEpCRbPnZxrCw0ds9LJDRWpS7PlTYYf+Gax7FgY0M3Fb9tEFR1FVZYksqicOU2gFtBnZhd5qszq3KZoJ8i83OT89W0ZKEZ7SvOKoCClM33TX8LFKCiDX/Lj3+NkWr5x3rlXgNf+w/osOzNzcN6L4NZzVOQ5TnkF/Y7b9F5dMftT6Kej4cfqFOCRYPnt9/TFLKzlCFj8Bq/cl/oF9VDuixb5QWIH5jwnknSoNcYbKNNtnxqQp9R6aas73zf/dp3+d0aun4UJosPvS4Lp3rE7xAvm0kkbH0kWYgPMZspDOEwyBtLZWJ1SC91T8VQp4lZyIGP/WdZujM40IL0ptvStWWQ6dPSmr1TFPOmft00oNO/7UMzkgIdg5aNSnGneb5Hh9xUt7beEjgDhczT5z8uUNgYxLWfvHp9/4SsdR6H8ZCo/J5k7lBDOL83Q2DTAOKI9Nl8+ovVl8xf7qD+GkZZlU/R3V1ZELPZkUNq+Hq1CKzJCbii5NzbVuhtJF3gCq8FH5f7Vs0sQ0PedDsiNny+MeJ4W0bKiLL1bxbn9fnPylbTaTG+L5r1o+wIeoK/UmzQJL/k8Ywdf1+jKc5xt67Ec6tktSA59NDstHUu5k058avv7UlE13zN4ZymPXLRGbiuU/+K5VvEn7bgIV7aXtcDVDYsXhv76pPOFUibs4gvIpBoo8t4yVY167N32aCeSXzQrRkicqlSs0fklS3eeoATvEqfcEVTGbRP/JtcAxkdCRXDpN4GhV6Aae+O6BndSBXRXXwhnRpyIC6lenGg7iwCw4MP2meT+6vuLFf1qqT2oGNeu2JJVrEc9YvD77Jf2aJ3pZw9NiixFoh00539zm3awUgBx4HmHY82K8h4oTAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAIBW2NvF8M+Epu5I49U85jId8neMhZXcs2DxMtvHI69TtBTflmHor5mrOROUoT41yI5tlFM7O7Hz3V/698uD+6fuPwpjLX/On5/9rMq/7gfSy2FjkdW7m7N9OApLCDPG7vErVb09xwn0k4lhqde7plRiHs9dLN1luOdUem3kI2Lya+Kwbuj8r4dI1sskmeXkes1R13Kx3H0l0iJRTdaPeJko8wMvWcJN8we+tNopK7ivjTWnLXn4ddjZ2xC8JMwaHniroybD+BYVsdMqavF8WxK3sXuIOpaC9P5Pr/MKTn/2hCZlEzaO39MJJY86hed0ITpY+2nyEWU3D3+b0ipCXqIlf6pbQbrh2vT66Ra8zIyxyNZoIu9M7WNvMpfyM7j5/i+fUBXZid2Mhlb0PRMVJjFZSaMOS5f+h3e/34lPrCq12P5Ha5gpqEP8MsGQGnHyLuTVi8c0poPGXswUTvHNmCr7CDZvz7CC//K7VaedsKs7if1bd85aWC6+pGr0JMMbI4pg2tKz/qJI8mEUbU8Uz+zYyUcZx5vX5QNve7BBbdu3aqLdhc8KP0V7rba/rVxb3hWeuyaY28s58q28Pnanfh1etrRmoV9zcbhjs1eQ/0tVxXKXloIjzsJGbbl03nM6zYzwkECDHSiL62Q/ZegKE84SIT3KdflMskaQ9LUqKMSlnUniq1vTKyLjECQN1Bxv1F9Z/M/r6wc9fjFL6MrmpZ85ydw8r6SgzzNIfqNykx5Pu0nyerT8hSNrDglliILS8Cw+SZo97synq2GMJdncY4Onhi68vwRF+f9ZURcWKf/72hwhcUj3K27ekf/XMoWj2RleXif9z3WvJ+c/bx3uWKr/FaW7tb+a8/ZPXpfuQEjAskF/5fpU1bR015274H7N0uHTDbUYzxj4YFot23FjR5onGfsaOs0K6DW1pqwHRJPr02dM++mMufybcDa2+ySiSmPS3xyNv55n1NZSlcXn1fBf/OBKMYv1ZYwv2i8dskfh/NFLv18Vjp4nInLnR6vx0f8cXm2cZLKWW+pL+ZEnSeStdfYxm+uAzgShKjUg66jStqP7lYIuKUbgm4iGe1WTXzUJVmaZX5tSYpp9OdqmXxNRpJM0PnUqUYCbNxqPsh92YtbxFMMtAlGFPPE6nuVhkkpKE+lYZ3qsVCGXxP3FRKatvPugZYsr9UHq1bckJl+jHVLIyOCpkBGyTlnXIo+NxhGR5rckL3ImfTLj/OgCQ+L6hTSu2PDbMbbdEsaRL2/Njfdk7I2deD7E+X9Q/Fzd2Jeg66te7bJlbSw+PooLAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAALBs4Vp5mvOo7Gwn1jiGxOXn5tbcaFd+Z+2zT3boa7ebz7xjw9yb/kbe4+f4/CjTl4ucklN4H4Ir5/zDmftK5YBjAbuUQCkH2T4mPar5pWqZmDCyceucSte+8D1a+Zro2IOovAVWixfPF0npVlyhf99J0kpJz5Nr/xG8++uKSvMfLVpol0WbQT69CuTKhMUFV8YU/FB+L5hU1h4xV+wfOssZZcfFsR1l4krBP/75bphRh4BkIVh9R53laoC+stGupbeubdIzONaNeoc0MB4vw3vuJ/VZYrgnco8Biaw16tpSa948005hT4aaxe9E7LOuMQqCYnPU498LvVkf+nwG7ZuFtFDHbxkClurspNN3Xy7u4Kxtvx9aYlv+oU7YFNcjJ5C8yP77aCktzwT9yXmErNbEhf+sit7P1CXvVuJIeTqJCQ2nyOfM8xRcFYFemNcPXIleSSlFN/GBGL9QFt526FuaE4pVZrlakQ8t18ieHCeHY/7eO58WtWJ1M85QDs+oEV61G6Fo91aUuv/RRv9fH/XeZ7GS0fQNz5tUk8AtQwe5UaSNlB0BHkPW1yV6Wn1/PS9ITanLk/9mV/t9RSzBXq37b3HeIcT25W3/TJZ4ikIrLQ5+LaVi9/d8mtdplWSuHjfiazFXVX6I5Yn1sgg7sy9v50JM3nqi4XwhWCvtJBteKDGJ3rZTiTGL+JBjHfvTppc7w6+devEz9uIGTkIuXsBYByKHxSy5J73Ys6OIXcfqm1n3qVJ9yTdqXXac8jWb3Sf4eEjNU47KSczpuZxUtuUl2GY/xodcG54d3RYvTlYG3aBVK2Pu4X73fKJgtcCYKS1Z3h1S3a/SoJCz1KOqNRu9ak/QOGLLmZLadmzgTeVY99YgqjTwuG74h338VmPn4kFw7D12T3lsg/hKsUce2/gCW6+8arpby8passEIR+iK9+dfO+tMr8bzeB5dlWlZj9/Pf1TQ6/KomnygFLhBU5AO26NyeFmPjfEhhrVXSWyKjJD10cBPjK22eWYaXk29L/pigJEmSD1Pc3ftuHqjz2otO0weWxcf5OGdH6+mHjLvj5KdEoM3ae+nnH5CRSefOpfQ1t6bO/vk97TM1QLBVNT2bOElquc6SYRrpcqgos9Nw+FfiuwC+Q0Xyrk3c94s8oJ1vNWf9Nqmymwsq3aw3+TqDi1Is75NUEZIZjCyoew6vWG7dzq1ph9MudMd4l+ky5QqWyzKMxumw7YdYvGUoPjvZd0jnaB4y6ajh+Vv63wWtAZ/+Jnr84LXbkIYyO+ZjgcpT0S903fTzOMBAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAANaR/luuaJ+eEAmUk1BslUBCFu0582idl3quORBFw3fNKu2iqVicOF59dRWFdpOGsdBsYoC0J0eNu7w+H0TpYTD7bRkrz/pOK/EUw+FibJJctKOE9oMXM+tcpEVPd6YI9yFazPrZumnwa0kShvu0VqkxA9P5ey80KK173TnE7j2nlBFmJ/iQzDW0HizVMKwRV17993VC6H7SOX94uCOJ73uPhbiiNTH5VozE7Tf7rmrx/22lDfqupD0TOhx+K9iAYhVXlEh0dijIIs5DetpI8eXm91uFO7ZX73WYN9xcTKfq8TUCRjaUyv0vFetXjnGenp/XhH0mLtPDUV5wy31fqrj/600gY92BCzFf/Mguug5nwOmgdmid4zaSrIPJIvKiMDNnAnKaL4PjclH0k0bRfHMv56DkitmfB5x2EvY9Wn+jsa6aYTiSlbXuydKVX/QbDkb6oCVmd91YXZ0goiQ/qshYlfHiMsAu8J+QIF0wJP9DPo1Hl0bpNc5uw1iJlizk5fS+XX+NuZj57XeblEkrypjP0ZNorPT0ilE3ZE9pfzhIm7dJps0IfkB5QKEqMaScN+XPUEhcnkvC/TA9d4UysBTjxZBe7aTyNVN19Pa/6IXl1CueSyUPLGcTTAKW6d+WNXZaOCScW+vczwcKtcfn8cqOdFkpaSeeW/QPFGrJ5NyWIwIua/wvwTWG7J5dvfKamPNf5Dgq0VN0CwqbVlVy/kzGwRVmEkqXMQivyX+i/SP5083/x4uVviyghslpX8WV17bDjgVNsEFdve9cTmh0c63r6HbupVxvbdidQ7EoE3pegN76aFUklSt96z/1kdg3ex9lp170/g2y0BtbdNwoNLCOkjyBkPt108h3FUu4oSOnJHaXS5KsJfvdV092eIeYj390V+8W8j/Si+O+1634Toh4FR/SrrL68UGyNVbLS0zdiDcK44shfPziR70ZST6hfxN68WUhTIrNnqg2T3+DnEUvNPJy+O6SZJmuVaLQAjG0h3dR464XP1f8eobla8IMpXR2VPUJu+T6q934DesNmmyCn13Mdrc5MSclTGciGv6rDYrCFn2nXy+1lq8oulwuqOkwSSSMPd2+YfvYH3YeP/HeQ0tOf97DubCxMK4xUSCx5Ckve0vKXMbyLQv7qEvEwSzW+GI/80pi1Geem8a6aYU370pZt/1Ub4+cLz319AdZY5ANEnWdi/d+YI2l5ovVwDTHsTxn7PujbLTjenHOwI85zanGOGjZZ3VaJp85Dj/8N3uH/h2JNXo6gv0ljpiCFrJR5GJw8H9uAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAADmpkN+Mk8QOlRRPywfMbkVHleuKd/aejAP7E2TnsRMbzWxFrI4fat2KTiKlX9vR3WxNxZP/1G9PbW9UCXpx89ykuDpWVm5MjK3xR7xL1uqZATEG4bqycVjvo+s3J1EZD+w6iZGaFoZWsXq2mtachx2NyVWZtFw/f6TT+Pl79Q9uuAcMsrIhByw1CGCEsb3j0tIhP+3kxxWpqBBI0aepQSBFaLL0NMQdH2kxXg7RocHa3fNkMIgd6fx1g+qNsIS0xV2pdHMLlabe4rJkY/jtwdEVw+UqXxxceZlj+TUOFgdMD7O7MxhFJW/aNF7sT/2xcUuQ04jpSZ0CpX7s3wXNfPkj69qd0b/ia2Ybbvbow9YzFYrZIsZvsLS1Mb9Z0Bj5CbXWUSMNS06clBt8Gjsp3C+V3c1+/oUP2HzsFPxd0W+Su+5iFmmJU9KlTbtj3o4MrouwuFx9//4LEIWahasFXh6Kjdf6R3LZ+bbEeCQ9GN994z9x5iY62XwDaHcCT1q+UPbobGl0yLWBG4qBQM+S55mByu3lD//O4PU6mb1BemZ2GSeC430KXTHDNwcQpMvtGgBrN48ziRhT7tCv7wXt/iFibg2GHr/7ku3rmTrWfR8oNXeVEyY2oHCjy0CvRmcNNprkRfSZi2KlyS87JuBDT3za0MXYkayEtnv3/l4upX9icd4qJyxMfnhQBM+q3SqYtvZcivwKz6HCA9wWoOJdIQDTqP4L97iUVNcBqDJjwsf/d76L3bwDRcKvLTMCDBXnKeA76u1PPZWq7eHtT+ULipwolaiNX8tsYwu/Whj+oLLQZ+RlRiNn0nTGqFa69x2rhdhcKK09LHfZGomc/SbkLprY4cp22bjzu0uYd2t3xl9wWrzc4TI5yqlaWJnX9PDuK5UhFCtMunm3hTbC4pP1o3eTn8r0DBBe1r2GttLUKVFqVWH9YchnWk29k+TZLrGHVzhgoEroL/mYfMtK311pLPD/Taj83k3npTGb3ZdXf5mHB1ehLNYKiadST3bHTsW8tOewsEcDOfvvCqxn82FBw1LX7d7vFdU8yvWdCVzsZTvVHN6LOGVG874ulx7NiRyiKd86J/MgpqH3lzNoGbi2POPmBXe8uf0A7mLwlc04T9e1orYyCLjtYrIpS0fJ3tcPl9lh0HCKOFHUz2LSF1KRBWfjNT3Nvos261pMmSCev7UdaZjKsTNziodPO6wljakW1ZCaq3Mlt6NFl9p3sB5H9qZlFv4LEwvVShfWVADfcS235TrMTa2Y9DMm25Jc7vS7PcxhGZKPVd2tP53AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAABocq0fv5CG5tmj9h7HJRJ8x4ln6MKxCuV8mNGrMO1hRtf8Zh78tt9KZt7evXO0QyLyrfLD494PYy0T/X6h0z3rYQxJL/z42PR4sQzXuszjjmoOidu5qAKM1QUcK6V9IwD9jGBHSRfyQ82bwkRYbYLoFlsEYixFrDkiRcSpUQ6d9dOkf9ysBrA0VXcIvKXRvpR/gy95YLlSrOEacG9S11PqJ3q4H6CIw8Rq+t9P1RumwzMmdm/6g9nB6+QZqWzb8tzKLjbdjVv6zuaWfy0RBNT/pM5oSS1dJyu3jxF1w94RqCZLpQT8J/092zpSxNpak5C6s+kmmMfCjUI0gNF3YlTpypeZQ8meRtd5g94kgM7l0Z973KxXjzUa/57vU3qtJmfyCBLllWKc6DOWksmIytoXk2cRhGsVzzHotv7SY/i77m5qjfkfniH2T4v/Km3ev3jfa9QqNOa5+MyfI1qoWD41/nb4jUKhuw/ps8pX3nlP62TDj6XlPI0Ckz5vRGmej1cxed4v6+l9g1qkW1b5CKcKQXuHOHJVCdQFP7GrsJXTzO06eSsk5w13asPClCsp/ACsHmbnOJhvi7x/yiCeb0EbIitoh+tuqoG9Z+hOHMj52cWKrrKOAonm8aPFFN5Plx8xnkQ2lqxPtH2m1Yvk4bT/kn6mgPeUDcs9akgkH2GqhpeakfZp5i6HAB8d+9ZfYkyZlwYmGf9Nmwv77ovtCmF+iWfp+u72l/Z4GQ/7T1QSc8ldYwPZKnYBWcvIJqZikoA5Vgp9AaUG1ucvJ7NF/U9GtzByfITZffK+KDfFeydK+37pZR4bfrbOcPoFl5GqXO6o3OVnQwoqSwmGJV6rc7cZhkNgTvATaoEB1bXuiydnxLs/+D86poT+t4bjgTaTFKfj+krMtwflZO+77At9mcsTIiqrhGHJxf8a63X4xHrx10bjstoFheXGTbraqcxsknhykz4Vkp/wk/+de3Tord7MFqG/W6oVpU2Hu4Wt9Tl7Zi6dtIG1kte7Hxul7PFGd+tLLKwgBIRS4sArpY/uVnvztjbxD4tv5ibGugmYkscmL0MSJtmrh+h0sY3T/eatg+n04xJbz0v0/c9TQ/akOfkoVfzX6c9dGOcC9PO41qddJ7e5ORgVuPbRyhim/rORdjtMwxesedSWO/1tYSj71oZ58zNpbmJ5N9M3M2JZwzPWDMfTGxPfi7fCLAWRA3UOdn9GzW/baGzmSNPG1J4W6SkjzXrbr2GU4f7+R61zmaxr7kEhZfvlUCs9RPebYvx8acCbFqaLY4Yd14z7LAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAACAYOsX/7Eu186yjL7HCrPStXEnF/t88YYrxZXSfcyfuyTwgxz5FwOpH7sW7shfrs1/rW/6kdx2Po951bOKpuqFRJCvqTRs746T2cbb/gmfqCefWihsR2aJ7W1yWkRsEzXJ7bM/LoaV6RGsczLMqwucf6Reo6+OvW9dYFqYovBzHN5yfFfK7371cDzdbiP37pGuOKHI7xD2dJtnWzV3R66CgQ2xUGy5Tf84u822znxhKxHPL+GxMQ+Wn9X2tXJu94wT7jB6ESW14UFI7cljKkKaJ5fF+16IWLJ7Nz1j3SRo9FstWzXu4ZwqK0vRf/VdwnoWZsd/XIL2V/PIH89AhLhYAvVVuw6W4WaxjTPB51Fxj+3czed72GLBnjlYj8lMnaz2Uecm8ss15SWxEc9Rahyt8UbIG2sCc992yWDs61u2DDVTTSRFh5EbjsZkMeu2lGHKya12dNSRGbIiPw8rN7LAIGkQZ1sfx8a2y+yyweAfWUP+l4taYYn5lFan4CCa3Nzr9/XMskf1pTMTQ0TN5Qe6zGVex7PZA86ssQQBJikl6ng0VnpsjQ9DtKsFwfQWUyHSWgvX0YjWeZUoeJc3SB+ab/4QOde1G0bSz2BG87TnEC6sTPALP+Ipl245p8YtJqN4phc07M5SpDXNpEHH0zPvJL1iMtUg2/qeVa2SKOd3XuS0wEAlvLqNvv67v/ir8lsdNuOYXk+8H24ey2qokmxNq12H6gD2WPdiYsMXAuu1eOvNUK7xKVfsxLymEbmM9AckKpd0SXQiq08E3ExGNV4yZRH56CH3JTxFkXW1xy91JcSwwyQXi1R5UoqxA47UkbcSD29o7ugW5ZhsIuj3+6+eyRzx+qwuLeY0yDYM+XR9siM2x0Y843m4EcvpeOni8SfP/zaiJi2unKl1cgaTaa3UsHUv6KJ+ky5jsMqcaqbJ2Vqy6NhpZYmA87KJoivti+yLwn8v7Tk+Y2OSZE5+8anaoJqk0rQf3urI6D54bso2RXD+QZpnHtW/IeHn0z+FvwjVKxdezG4GIQrY3Kbm/7WZPGwfdvhdV8jLMILe9DzPzDLyKRaiumrvgGd648icoh4TPl8qK1kZZy8ZoeW7h9ATboSExDCTMr6F6gVbXhWtx8SB2HxE6czymh9bKd8eNFAzttFXiBIOBLUq8u7panb329uPhnB1RklI83DgLi7Yk8V2B9N+WHX3wLRJjkNNXbZ7zvDD6CjJS/pP/jc2TVNBwWfoe7Rx5ZjBx+V3Yj+rIlPitU9Q2qelp1ipxdszsv4gxb9wD+BibV+kBQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAADgoptAeCqs8iwgh/ADrrJj/4X7qMUcjrEmQe3ypuTIRrP25qOUbSuVs9ZK0ibl2ataJpRz0ZwQwfOnJznbDkw/sqIYaBDVhprL8bFPyJF7MP9gKS307MwNP7+1fcvZVrZmvrsv9NPF4JRvnNqJteOAt4FS4UbF1TpCcHq5sB6HQRbTs6HiBMEQpcGINrmqyw+hCYPgebqjW8fLkpnxfyiff6aVKc7PzaG2VVT0BS3vBzxmi7NgO4jCV0+zoaR/cr+ccofP3/bOsbylaEjcJvsscUQoeiIB6xFrekTcmzeiRMQbXOEZlayNjAR6k71eK6Md8yRoMs9YB9wRmVAGa5m6fTxRnJz/4WUrrE78wghKlX9eQkbv/IX3UpCm71XCX4c71NINL6rwl4mIPm5OSdMnsvVf1Tw4k6xa9DsPkCP2uWsQ/yyStLn+sV8gCCgIkCMPzxujZ61N5Nd1birrQOV6+W1Q02sGofmUSSTrzeYHfNkKSiTVML3SdwwGZVgXfcvq3x8PPvurl/Ejwy6P6BJN/jgOaWAicl/OwGXbXHY+evXA9IBc3IvLnJ7ZfyLrsCJMoZi9ezifV9C9iTMt4+8kg/FUTEI+I6XBI+f+dKLNHzse4XZ49YPzU0ws2L+ISSuJNDyjva2MBPN0uTdP49Z0RtLkS0P8VrIsV9XCA1S8o1x1l4VTGBsxkFOQxW21pSzNx0Wkn+gdYccg9eI/XrmkvGaSb8MUFbde09Ws+GphacSe7f29UuPeGlkl1qgX3etzKitekj5PKXP/F9q0z85ajx6X2HAFWJWfqx1MUxi8nKUKmS4soq99FyQZjNfuqkzx+RrrWCSzyrRypBV/zFs1Zx5fYWqQJn9eX+K1RJJn5pc3GS2P9E1auyyq1atFjTeXNw3SURY4NxROCvPFvloXvB36toLQqveK/d9B7Lu1L8MfN+e8PRTS6kMZKRDN8eKvGuyfHab/eOZYyvntinrMuEgnoe1JH2tU+RTpgqaay7ticYoIwfWSs9IOCHnbTTKSdiPpgCNK9X6GyJHUWSBEKKOFS+ubC4JKWjaq5LPq56Y8mRMGpdKvNvvt26K+xnDE/lckoMNsEai4lZj8J8r4p8DgW834trJn6HxEdQJYlqxu/H+jl+NSlv++c7/83XDN5hMcY1DWmx72FMfT4R9m9H3UY8HmqYHub1/TqpCsSwzJuHjY2DMvVxmOFCRtm4b6baQC7Pve5TBtEhPIbZvbfyVt1Zq7+ZXemarZt89CQRbQRlxL36NU9JsjD+1OQPzb1Xph7mxS9BlP3bg1AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAADq1Uwd0t5xnMUHtzwJkesnOAuXCnqyN71dqPufOq47Cj587Io2fanXYFu3kost/XvCN2+B3ZAJF3HaJtZUMIjWtPzTwc4zFgkKinneX0FyzwVmSaTXC9ZmSQwz6NwV/aZYSbcTep3dEK/MS9CHdL8ebUfF470mdL1goufu91Y54G+S6pMYNa/qUq3jUw5Oz79g/1HwLFrS2uLP032PrTGKFeT5Hf2AVWf6awpLnPgnc7nUn2u6q0Tz8mzWnyGrJCjE2NPJ9V5htD683qBkj9SefyEX8qTFY5j334E3oTvDB+L42GuURFtW2oOpb6iNr3/yNDz5a+GByDPLssKbEY4fKpqjky6KekgXxnJWY3VB76mv9jaeVfXk0vWj3pvb7Re7G4ezBbVo65vm8SLhRz8mlFac/kwI9dj48N5dLBHicBaU3/73suTyS/o/Dj55T4m02Cs6wsHJ7fIjMzMxA0EpGsKU4EBFJHUiWT/31MGLn/9G/pZ8tMDHzhGqDip9ZfaRh7Jb572RU3guwQoOklAto970y/cGOy88aUYc11e88L2ru3oiVsp+f4+K0p7Y6iar4Cp53ESzX+IUnd8a/yuM7bUYlveU0V8yR+Jlw1ePFNrJ5WbEg0eKvj5+v0Dh9O4Oq1SxGu3NasgkqkFIzsTLv2XGz5KPKp6SlHX95eeIdHrn1xqBGtDac/YfTwdyPpZJjz1RRzt/RGdXOlJAu9ta0L8wHvL40bpXzn4imUXRpzNeyONnRWsWSs6oKTQv4wztZBRLms0YbPjs3G5orRmKXg9FSXzJyMYoxLvEknkhcHfplkIs+4NafVxV+OA2e//kl9/Of8/lXfE4WX8GyESY1rojBCjPfKNTJCHYNPUWtEz8dpb893512kVGqHvXJnv0Jw+9MvgJd/ejMSn3RG3d1H1ffXY3bcD9PMNyZ6XRqvqdBPmmfvjjR3GmxQgqxTO8i0421KVmWQZ2+TJul5oH82kDArm+FntMH4lEtgnaZHhbPnf8K1gT88GxOFT88khqqG9myV/FSqYdnJ635NJp+wdsPIVnGOSosZSF76h/kLB11IPpEL7u4AHKB/GZHFvsz9hF8EWsztm4++qWo6anK3U1UwFJL59BvYe3fHVck0+dRSXn8MX7OS/HBiWKRQcvK0Kly9eex5TSV31lszyDtqbpXv1Jso96Y7RAkWekVJYXNUOoROzDfcPEGa3nW/qA0M2ZDqeKExEFvQnT5oTGubkBvJpubfzj8NllG6dWU/45nb1fZohNo6OTxcanuQ4GR6mUk+F39Ek8xAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA/Mb73O2DD7QbI/6fwurIF10KTo8DUzZs0YOoiNJ+85szCe12bO3Qn1kjLJJu757q2L9U2aP5NB/ZJh2zrsWmpXl+TvdYpELrI7J2C4GyifWobA+DZMstxcp8YI6z57+AD+SDKv7/bQriVLzQIaqyHtrc0Olzl+MkPx81oKmmKc5GJM0lPEnpRtk0xt8xExgO0paYiw8k55/kIWx+aVezMlPlUDp9NtvNtEAvb3kZcsZU9Q2RkvIpcov2H42DZ/3FFVw3DOtPYpJEVpqd+4VFqH+9HozrGPov0ok3I4wTzUV/vubkL5M/Ln6usu6WTAHpCIkt8Vg/R11tiZb8XJ+QboPNia74vmJS4Wzu6/lUizfnrqQ0PKHI2TB/8F9wyC7+EfJHa8xZezqMY4lHyVE72UbTsw0edFoa5fyuRPF/VlrohjODH+axtp74bReirhQVr1M8jJTdIhGJY/BpbPLNWHyjeyN28PKJ90piFMa6zRmKlrODtX9ALwtJBnJq99jngFmagK86CN+4bZ/cUHZGUqsMaT6TnicTZvgs0rMWtLhFf6YkOeQTU/eRMlOntd7AXud560LYgafCD9J1xk/YFPLsu3a0YXF0enj1BKxMZ11N74R3akcj+VCxI0an9l/NeZh6RlLNRiinfugt/cJY945H7zvvNW9yetDdYSaehaEkXXQM9jHjOZ4tvX7kLUJjCAKq71VWUxU6g0H3E07fRALOJDoZsnX846JYdLuxHblOM0bJ6K21VHKa2U8Cr+zy+q66X5KxJh9obrgxs6HpasxgOaz8yfW5POfKOUJVf9ucmrSxMF4qUN+3s1bz/N/0714XqlYq7EOsRykOVvOoN/8MeF2/OC2+eeQV7HBwJcFA/u+U57TI/1lqX6NF/UnswErvj1FV3vpZs/iYV+uO14exUybT+j4OkbOPneXTD36EKVJtr/2sRve0jPVfl40TiKCdKU15Ucyc62NjxSG/hJpOfZZXUxiZrpfz8n18LhaL7da167MtUjP84bgvSCV2RMYoCdIeBaNfcvjUVimCrb5KsztHtGXcl/utF9387CGaGzoXuUqcrHW64PpCmL+5+LaU81pUWQKPYNP5A95dndyoxMyLgaiBwlljjWoMR2rsxo9fd97tV7wkdhLVR9BaIA6duJ0jGGl/6UWaubgpyKOI+cU2JJg7S7RUgf/5qIjFEEU70u9o57/PnRqPSx/l/uOLMh5voz3pe5AosethEt/3ZME/SH9ze7Dm8bO1bnFnwnQDN1S4h/e7yerT3YwwqQiJySBzZgi5V7MGAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAIj2GcQY1wsscEnve/+mL9baq1oQWfBLRN/hld6p4LSpLG7tewxmkbyqRCRcScwidWmvrWg22Tubqh1cZN8gUz5mriqDYlJj14wOtRPSNFu1JaF2gygRpLDN92MfI47FkNS/hSMEv0FvDbmSEed9GqXRVGxpetgU+sWZpRNpoD/K/UlrLyZmZAyXbzehm8r1/bsWQY/9G1typPaHrZDPyFRlit6J/t+X0xfHlBtcov4gs/LeyEQ/cC6Xi7prdB19up/d51M+KKfrFUsyrBMce0TtS6d9/hetb6opf4qy+8crwkZeEjzLGtVB1TRipBeol6gXz/mZJi1gXYrW06OlKKrCTcGN9ZiiRaw4b/5bmmgkq5iBmgcvyeFXt36GzeZHHo1Con4SsaKLMWnv+32f6Yo/EyDeyCh7+6YziHdAx5dqitcqQfC1LVZJ9TLWr5HpvORRh1fvDt59KBoZHbdVCnZcry3D6liJJvXIsvS5ob0pTTlMO0Ho6w/v68B/MtkbEvtj6/cjpeXEelMB2Z9Ms6HiAymHpqT5tk+33yuldZ5VtYdGddSjCOA0tJJ9Ef3jo/yx711pDjGNAU3vDqraC8KLmvW2RQ36j23pfoI76x9klt2SyivnE5Ub/tUwf8U0Dn9TZrc5SewU/YmU+02S3bFe+OEftMrPCRXxhDFB1tqQ1iJHTN5E9jk5utapy3oxeTq/yABRbGRFy7nqG1r5ghKfMQZ68b1+yfnNo/zxhudat1YjIp8osTerQ9EtNzHbhuzvJFVmPNqeoKsMTSntlCxfzAlV8iOpGFummwlbfeOOSmYlPMx9iigfrqwj2zC1UtZ4y/70viU4H7EzwW9+NIITyRPrd5iiynjXzT6Ci1mSKvrhx2e8P2gfLYhUg/sgGp96/9WJkkWKAtZYp7Ex83GiroBy/E6NTDjm+8exxtjw/TYKxaZfLF1c/42heWv9i4H40eiB1RfIe8bexLK4qt/jvWUr034sBTQJ/yQ8VU5ePuvrM1vh28Rnv874MTQvw2KUE6iA9vK/VqXm1VT8eKm6ZXbUArsSAc37eAPzbxVvZEjrniWgdxD4OqxrmcYQmOmpoTETKCZ2OI8mFJTrRpeZtj0+NjxoY98ofvt7fEiT7GQjOPa2tMWTuOe54NGUF2FuN14m/TrmuuNud1pMjsT6c0dqx3ljyw2LdtKg2csL3ML6JUHu//anBBQzuIQDvpvL1jWnu2ESDjUi/Y7pbxLDCJfNeemwfHOpeOfXfbOi1K7xmkMklcGrdtfa6o/Uyln1Bn3m2s9cB2E5AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAADNWNViDC6lhfxlSDD+R6u+93jkohEP9auOluEAGV9tm0FbyEjTR9y3ob17fb+whCkI0tL8/77its3oR7NHZHTpQfjmUZEhIeMhfBVHakGcZsTsuFlchhNcpjOIQ82f96HoJf9xf/gPrI6B17cafxs/pTbXCxt9VfbNt+5cYnu/O1hrzff07mmT25PkWtsBR9kvYe5sZzpmJuHWLqWS93I5KNhrwwE6YXYKNDTcAW2fQ+3fttiX3klxp/+qx/wsj0op4nx2tCz1jnZCoM/lrL3W7PXkeD5Z4PgvTMdVtKnXq0TaBvZfVcxb9FFYzmnDiLEucMm+tZq1ik6toVsHvaxYYBsIkLI6zHNdUnqZqRduQW1mVbgseMYZwkBh1X++FhiwaNHK9t8MWsvK1BDbiqYvAaLNzokQpoZ+8Vt7xK68x3+9ng6mGRacOPvXzcfqy3KZo+1/tVfbRmHAsrvnHjalLirw95rJ0tt+rakjWL2qHIoc/0mwcSBY+3ONikYCdaNSxR61rM+UprlXXAl1SstrWyvvApPRI9btR70axscojA+oV2axgbz/quYaLND+7L9xT+RnnBzCGTCkws5Pyh11RS6ZhfMYNScMBwzHzzoFJ29TMPNWZft7aWXo3kn2b/rPnPGycsl8TTJivjZzSXrZKalKwWUjNxxvJNUYXfFS7j/MkOunYqq+8T4LxX/aK3IlMsOezMcjPS7lOT3iecr1XZNIu0xif2nTXV27MAL39fOPS5qF5/lsboQuKhXvDZakdD75vmHVufmF68Zf/19ryDIJIZXEUwvit5ZnGTG3P9uDBMlEja2efP3yWO+JYSLhz2RMMieB75R+wqpilP0D/7D/KGfRvewxpX/d/+m/5xQDiT81vu+Pi8B/OrHK0xYYCMP5KPjW7p9iaX8dh+u7/L08MwjeIyr+oKvLZKM7SJEQJ/vbg0MpTJcFJRGj+zCgUN+ynfu5xMvvdu40wiP4axy3BNPTRkFxPOOxml7Bu6olZuweld3FNTbex0wWTL2x9HEjrO7qyXSvxzCsAgy+iHxzQOCJL3FgNHpant6wLdyCGPm2PIcYfdGPOPsmq+7J8hexQ8F3DRE6RX61T4WxTjYHWWZeSdrIdZqTEB9ET1ev944ZfSu6IBc7z5s/CU8OGt5l6eJ6hPBmjE/5DDaPtJyOccMZ6qTWax7MH70mwN6YWJj5uN708/aTXuo5IrLwUTPiixrcL+wzV+r9sTIE3ykEBT88R62kS0PFMjjirUVtPxomDSWLeVOq6rPDV6w9VrtAJvbu/AyD7DKGAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAABg1rqIMg45fb7KukVx46FP+H7f8r+PwYGLXNcpEe17Dt+/lBbzyBFLNkmcWlzspXxcX+wu63x8IZ7tm1m44m3TQqncMMhrkn2o+wET87oCpl0imr/yDOzoBxqvDgRQb0r0EndoYYerh7H5zd/pbfE/z8DsCMr62qZpYtqCe8kVXUOvjPBRV/h2P3c82DKvQd+k20YC4X3/X/XszLsRS6Km8FeCXF3tkT/Pxq23Gz4+muQnSE6kkQkOmOVlp07sJZtryyQYffjtr/blYCOGZPpB4pcejlLwsYaNqVrIfmHDz5Uns6aPBaiL4pIph/oT+L7X6jbnlSHQvDUYZVyek8iYI6UgZbcierRkeKXz+EVNjlvN9qge/e7ToTcm75rIqpksZ3fhzhCfwEmjSszxStM7AMpGHAcPGEmNXkbiM1qHBQrEt7WT2qwJLtFpHz1o5Q5UsA49OtM5LonQFz6jI1hLMFCpaz3bSHj/STom0GuaeuOdU4Dk5c9E/ALCL86v0t46xZEtnCXi/YdyLzv2TJVEOTtQn5/XvKdG1pl1+AlzbFacXf5MX4HZN8cUcvxhy7nZysSmXe/496Gi9gafukdsHVvZ1IZpv6imHzqYwju97nTpSk0h4d4Z4qHncw8EQuJ9Odtv657OdKHM6B3unOVJfGzjY3YUUA3vFI3VsWRcQiiIErLvxmFV9qNWTl/8/bcjYiPZhXJWJWprQHFeqa8cKcm767RNtOdrsG/CojLJDePdif8mVYwdbkHsUwbtzc3GMleuL7o4NuLNg29+Gi5I7S58tOMmKRWnqeQXdCrtfEv9+ZRJivEEedwPF4fsvbxxxIOiGWFFTVRJgQtLZq04FqO+9EqJK6XR0K+VbMVmqtpsfz3dkWN6fro7qxyW19OvUcSWJCN/OBqKB36X7OnEehQSIOeEEdosGsceYvrqG5XxKIQZPPlCTOHQ8ldOZOf8qiqph2QXIZ5p8FtFqa7Oc4NsNL2l2+Rwhr74/PqHVIMxPQGK74EoX5K5e9kqPtH2Cg2bkcuzroXN5Ny9RLOW+rpY9GNAgmFoWupdMmrC7PoNdmFMIT++21Lfs5/qk05qfN4VNO+Gzc9izCY/luG7Pc0SrToYR3sv4imCPSD2ZiLr3sE1+DisOFJDrpmj1fF7i9I5kYk/uo0YDfpbxFi301wEe3cdxn9/qKoX40NdBYy3lyYdVpbPlJQCSclDAr+h/+1kpiDA078fok80ZmMOUx2j6GB5Ud1NGbdc6kfk9pHcORN1kWD0Q3k4STa1ugey9IFz/d7jFcJwMwAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAACA+x2Hcpk/BprovwK/EgpN7Rle/rDKo7Q7YzW2iYpNtH27/sHeIWX+b0Olowp+q+igYSpFmM6AxCtWjteLrU/QIFQZMMzJ5kL+dnKaVWT8+OtFSNW3XMWRHYKeh4x+4MHy2A7LIObJ8tjr/rcBrwNmXAf6/zs7Ju1+LijqGkwt9/LFjlrQT/LSU7UI7k/exS/OslmNfzfw91YyaD9KMGuUW/oTwl1kQUaZisVh9jm/5/VzRlmjmjNTm7gzU0evbb84WewwlzPnPhqRLKPJYgZaQhcXpkmdLHFRavL3l2nmd7KGuoe7khIGSfOhWGOvdbgNeg2i/r0ygg5e0f8u33x2HTeSxFYlbBNFlfyzo3kjRj0a5cLUg/n83YcTdPyRAE4z7yqlLh/NkcK+vK3ELs0CHKn8Z+L1i8JD0f4LiM/2nrGn+tpQ52WOYheXJNALmsgZKY5GojKVMDw/quuYU0ZJeuuQJFY04FqXaIWjmbrM/a4ta2nhRn/Lgzs5+gm59Y9mOpkbJdcC5Tpf+e7M0QlCXjTjhXGLLYpjibmnry65GV0Q8CVmsC4+xQ1W0B+cMN6NhOv6u/QUHaglvMrKJTh59qROzHt2Iqe2yvyrz5hm7VhA9S/KLEKZnwGUXcbv1E3lKk2lHgL1bF/yJxIn4bPqFPHlXlA978UdfcyD7MTPkyPgt3f/6f6XLcK1zquGnOHPg4OvdnO1daUddbvCB8V/fNvro+NoL6fmWTQMpXP7hS9DrvnxV3os1tRXoW/r3d4JOIEvLceY+CuNCFQMjfL8fPKS/hH/TFOOVfBC8RaBdy3cR7EEaccnVkjfD5VFH0sjs7YwK6wWsrgNI04S0xRGCKxUjm//TsN5ESU8zUop5c+Y86pG6rgx1wjZ7i0yj7xSqSISrv8Pb8W5LxPPzD4eSJw6D7dmwDt6x4nVj//xoY8ld4wrfdj4HP7CEvuqtOZPBmZDF1G45VylM4cBuoARNbWgMNpbsc3sGeLHRnj/8My/P3Hebqtn/BLQqdso87jAgaEhOCg939mG32fnKtBiElVWoGJ70jXZx5Z6Bu2roeN/MdKvMMe7fu5Rsq4/eSLotxlF916DKWvje1px0Rmrls6WoubtvYepUmCQBuV79CHMXdrA+4Lep4U32Sk6GSfesWHxLRMvoj9GzET/ZYwJRTl1PFv0TH7JX/Oa+RPfOUUetojO3tWNvxcl/0PfTVIzseB+DbfVKTbKC9T16V9uRf2ZYe8pmqoMLpD1N62tlAin8QoXmjRAOYM3gNGgjvjUJVzvmPuJ5g76TSs2HgAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAADAXyTyFfPufXLKXjpvBeQ2kgK/qFdvTqqCX4QPbqxz37FmOJvFh4mLG9vX5JFYpmjq/4tfkMik2PfKU33yL1E7C+u0XoxO6WPYTv2V0SDO8x+9iOF2nYuJ0zpBK768RYaB2vne8Xuoz82XKtfPC/KwD2I7fI+55YlMPzfrmtW3APV4we7F79Xu4D07/RZ9okXbObOk+LcN7gJmOsn05YeIUYHmKETsravmf4ZnuvmcX31UlM0NY1REESBNFhdgSRtpGUUbLc2+Xdfg/Z5Mt4No8LjyD1Uw/pWvrQPNJPtfH2LscDzNJzHqCEddotq2L5anf/k6bS8fxONXr3Mvq3V+yFJ6ZWCYFdWPqKpEMW+nKWkhw2UX1knghzZR3bC8hsi4YHgtOlBzgZ6t+2w77mio8FNsYuuI9UAFp+34xpv8q0y57ciJBM6XAyG0dVYM/INh7uWOs6rIKzfUmAT/Ql8R+NRQrSNSoJWckWXQ7w8YZ1D13RiTjb6I1JeKmrdizRtZn0wsRHZiKG3HU6weOBR1D/v14vmDOqf9d8wXX1mSmFbpNakD7D8qclgYBOwM0TuXsnc88xp5lmg4W23o9w3lNgJjaPZRl4PegLQSS+aTnDIvftrBTfUIwYTWjiTz7f/+Q/Zo8Ps1o8e+0lqFM9ySdzJU3I754wjddOG73usU346u9AsTPe12UoYZjuXDap4rJ5zHaW/0hwkx2Bfi7GNNv2vx4Uox4kX8vRz471lN2BN5xeNPA13DgSXxL7JOxF+vxZutHK6YYb5O/1OsgMFXxUIydPbD+OzY/hRZjDHae+SSt2uQroXDofjPq/THE+Lv/s0VPQ9RsmUP5/9Myfvo4yaZXX3v12VxAtvHMtu7NjFsDnPVipphXr8NEKI0OhQKzdq9QlSzn3lZrMiZ9PuJ7Xb+RHB+esXe6OfQJFxI28vyTFDHMk3GKZUnzImvJ9VtNEtWRuOXN27OXvH8Q6NM6PC9JtZ80x7yawV+ZPpNOoXfpbPmLJUq/uTHG48NzEp/k8xPxnz9Gx/Hv3QjcnETGU/T0FjwmvU09znRYs8Hy2rlsTtWPAHFxRXBIXXs50143cLaGjnqWlgN1eGZri6S92N+xssZh0opdFuLcixOJLZEGgKWlDZ1rrHYq1SyWl2b0B9vEnv80nDcKWyro53y6tuAfv+TX6Jv/bbZSA8ecUtOfLaR+ZDr6px3Sv922viXqqF6hGhd7q7g90EJZzdFkauvx2ifHg0fqjHSqxPvWJ/iU0v6ok08uqowlKgN+eB00bHs/+HLo2sWv24rRQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAXJxXs7aOLtaMcSNZX3wWpb/iE+V8EUZqPZwKNBDIi6ocnPnLEZ+F8Z6t5+QlJhqqVe2DwpMH9aRSnGbDSZYufbRItLB/Q9XdLoUSRg8sShJp0zM9V/T/PX6siMKkSqV8gXqj3IysKkXD/TBG8qJ+PtnBdNH+jMgSr9jNrjPXyPU6FXcbm039oKx3Pzh6e2iqLyreAzFaRp9tI70+mzupiehnVzgf0sc/y5LKOoVdxGXUHkrfwiwQG+1CuXc30HSLn0XdxBAJGzNYI//O83fnNG97lq4RLNCU1ET+fUOYa1629c4hxzujc9n8CfE13szPpkFNykWCsFItBgI0dOmFGfnKpAtJdslZLWJ+aY96DBfF/wZz3+cOoIhwPWEdv30ptqlhK+7ATsZFgCY2Grk5X3/Ok+JXlcN3mpu6/CQVO4Rk7cufmu/6NvmEA9++f/iyCexXHcrm6rpd+unuOOYlPd4U6B+vN9gIJwdrqL6p0IkeWvJOpH/Gr3rMRnxQM4iAKzGEjsQaWYZMMiH84Bry+XXAn8XG/bpNl3GKU2ZLIavkswISk24xoUCOyCIFnx+c12YvMvi8g+zv0qvXl4f5GuMaM/SYN2W5xKaM1D30MXuPCpicgyyzXui+5yuoq7smj1D8Yf75X8U9gvQjTDyl37xIH5We+fcEvit2JEv+/DUqmcxW5nySqv+Osj7c8Vv4pojK961ht8aunuQTimdVq0pfOhKrcaUpzldeRqR15L/6D+N70Ocv/N9jUWV+8kqyaua7ICB2A0OaO3ndz/cryRUR5Pe1+zUKV+y2hwt/Dq0VeKod3hCsCSzKblf6zPR+2+Qp1n4WLer9/QNRr6271Zqo5X8BNpyDBp8fJ3be63gwBW6/zokbZ47ELeK6mrhRzLgonbLa5gnmKkgN/E1DHtNBHfefSZJqB61l/npz48eFshtRCqMO04bHPMmieZzaO32oNyT4gTt03t6Gm+tfarIlCvq+ocdXmk+cG/yVlldf720d+Jt81RaePCIeUP9mUe97NnreaOMPTUQr5Wn5OdWxVYOZHg23uvJ8PfqTC+9HMV9tX6FMkkZfnJep05CQ/PPizH4Vub6ArDt8KyJYRR7tOLQp73RiRFWaONTo44M43EHblbX1NPhXpoS7a/SNIPpoDRaG1QleAvfRvcg/KQshBc4GqrYL5bFVZ1nLnIz6Zd5+Yg+MMfU19bgAPMGE9yN1FgEcM1apeAWILBxnHw3QV34vt+XXdvRyV0Vl1832lvNaPZZx+Fed1cVUTP2B4fMrG6T36xvMv1/Y4QAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAHLTmTSS0tKY2mBz04P8ar0aTTcbnKyIN/Kwp2of939S10efI3BTso3+2B76zx56PMz6vDXYU4F3gL9ypzv5dDEvjp1CYKijyucunMOVofyJv8ntHsS3G2mryXcv2Y9mxlLsEuzMh+uiDtRhRkwK0OOOBI2lczrmEdEgoXX3yn7xYp5T3JxrJdxNqvA91+E6ONoRu374mhKZcH52cez40ftjbxYizzxbd6WC3rbuvSRtLeQihwxmej0kJcLNo6tBu9//797B+EKWsS2jg72OOGc1I2fTjEZPDhcc/XkU5S7ItSith90u/nK74sllJpER9L+tCL6PC+aogZ6Kr7OsYV2EcX0rR4VeClbywpsB+X6HPwr/ceAJ4no/1uBn+iXLNRPNL/rdFOLCe+Drjw4z2ATF2o5lIrWpYhf4XWcQ2kdeV2m2Ka3WxzuXJOxPz8i3E59m5GL8kfm5HtEchMiplZITMt8Ku09OeXz050zGydIlr7b8LrPqxIlBr6u2syf3IB8mxrVSsplEkDih5Wl7zM05+LUaVfDR35q5VWILVhF6dzYN54as+vLTJ+XAj2XE8QaOpTXCe+OqnYO332rfMJikVWTu5+ZpPrr7n2DrjdpbSG38+9SjsXpf4yjXh82Rc7KDq+W5a3qnS8K7ifh6pjuuM4O5PlK08ahbx0dU9yxeujgf3cVes5qUof3nVxHJaeR5ndyl19GC40Sh4muSzJGh9YgqhFdSnd2o+yRrNXxtP2sx8VPTMa10PDcHfK/GwHYu27dQuU/pJ9ElrCS5fEN/f4M//aW6lmT3tSOqTfIEy+YNc+LDbrn3jNs0bhb0hbQTl5smwQDT/2uTbLeF3/B48xEjMGjHQ1EfwW971VYyBwTlBhaETa1Xwnx3YOXek6sCZSLVuGGUJLEYLNPsV0lNKdP1mpyx30sK5xAXvm4dVmO1XmRT6yn8MciFW6T8lLk94G7//KAwyNS7bb5eOssytn3z4XHOz+SOTaPbnD4RXqTHsrH0jrEtPtPln/O2wa5ASmD2baJuYuSn2viMUoDBv6bdTqL4yzZQnPAz/xGo17LdQvn5l+FFC5UTK1CoZ7cONin4uE89+GXGsh876K1eOkfnyxPgx4s9CVxFGTaJavxgrEU91E4NxToWIfkwSPwqmUBtdG42gif1v5c/jZlUFgmvJhC/O9kUDmRK3M8vPirB5AtU5vhj2MlQw28Z6IE/FpPeqzZ4dL9HHq4ZmiAk7I7z+fdYUqTKNW6R1hfTFQeRhtmNP11brBvnW6j/Zbd926e7hT1Wh119kmQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAXIUb8tfn0t7aX7i0YfyR666FuYf8tk2ffjOieA3O29nkLetqPcPfqUW0BO6hAcqODKZZlnxe8nII86gZmVVfTXQOCz5J+bgrc4v891vHLbeL9Sm61KNU+UfU44GTmFkv/0zhz2GM/gx+EsbL2Mu0iLRn8+Ut8/p2ikKl909J90r2zLeL6SckxX0T0MXIif+Eul9Hn07RdoSW4Jn1cuZHC+HvcaE3mwWP1igN0w4/ZTdYFX784Tc7HJx5orMgX2w9ZM/NoNgsZcfUJJmz5Jv+h86hHvejji+nDwKzX0X3e0xd5T/TH5yKVJYTfk5cGK4ocbXQ2eEFSLn4REVt4DMmvEcuwZNzKnjxp3pwi+B3o1+0bJ3aY30cF6LjZ3QSn9WjnnOddJr/pSc9s1+QWouUa9jrOf5qPlMbOIxDX/t5ooCs7wZHM2XkzdGtbTbdC9pzNuxe523x2PA60sbrIWWMKbtX6zL/haWoso1zIw4gXXQ5Fap9op41qarTOv4w6OUGd1TpVddPvWzdCxMrVGvePDDW2mkiWbt53Gwc0yHv/kmkM067YFqdinplE/MSYU9jy/e/KCsF/bmTtiSPnGm+LBTi3eLdhyIly6fN4mONsz2GKOa8BRkFEV3Uf5r3Mlj+2U8rPuXfqqahnom/Mv9450WbhIKQKehrJGyatfqF5ddKz9zxJ7NTdoH+2dw/JkcWZHoH76oCn8Y5ZV7Qx5vK9y53TbBXPvpcsjO3SPBJIFC5iHeEMepbxJcbsaZQZsSX93QpL19oxzaIBrEZFH9kWWCKt6hFL9y4FW/H5q5hsHgwkfkXVv/xU0/YP9Wz2/hNNvIEZxmOyvdtfHXr72qN/1O5SqwmiN4WKFrPpXTg/M+sQTs381Mnd/mY+erETsYrqQIvLvixdlHixucZKRJFleGNiRee4q0yZNQa+Uq+ELOBVoAg99ZZm1qSdbivrleAr3n/uEqkWzWFOaL6NgOX4t56oJCinsBMaE9m7vdConsevuVRaO3kedcpH+dww7iYCb+oG9rTz0jxRAP8GQpYRNyyS2oLqTTq88fUnRM0ya0u1aFXz3PblQc/JZSrTpqeN+5/0Y6YaynC7UPp9H1y7+vpbrP7dqbnqNAtp1xztNwjgCn6dyMy09FWTbX1nwfV3N03QVJTb5Z+nrfYcukpv6rKSv2QZJ3+gVzrgxL2tXXHBDJvm+Qza4WD+hy/+LieDJdtRD61bF/Ms31qDFGqFdwQF9mnV127nv4JeBlZWLgWe6JHZyOLkdJPiWrw0YvF0z9luxd//aJTyvb9GQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAABAhKIJsdHJr5ZI81fVtdIWoVJrBf7ZBoPkthefXy/dXWIqlKE72Yw//ll1M+UvWDmLfLXKq2VmfqBddXjUZTJxx7uPabj2lzPOJfLvB98eYTNcl4o3BxIf0rwbxRhcZF+vtdCRfe1uopdubhUp89P3zf8oPyPw7J3I7HeLq2vHLJ9Tdufnzd7xDzzsGHYtk226F9Eir6zpX7O+XMnK+RZwpou/UHlwPXu3VTX236SWwipqYKns7Jak1I9uYVeKLnsy1ueM13uVBL+VNhKrvhuKBR5fb61zamk7omgMTdRs9Dwa6ikZQKvexs7s17llQ+zaY/8uks7541OdMRtbmP/STAmRmnP68H7fzoZhGmRIoE0Pe5jqSLc0yGsb2MkPaQc2yzmOfmBXdhGx4xyuwX/qT4PBjfeU1lrPVo85R/Wt3r7HtlHU6Qtc5lNfR1mFnDd1T3JVfBfYzRsNu+jfzM+2Xn33v/uloyb40+H+o4Pn9YhhndMKsxKRGutn5mEG/yNIyAueuhv8rBhiISEhxVN4tyqct0Q60i39roFVoTYH9XuBwchO35G0rlf21j9Rf63Q8gTRrAgExqrofScLVUeniuqSB4UBtMZwAta+sX89bmQYfP19d1U/FgJU6QdFH52rlH8e/X6RaPDjR8XBan3N1QKT6ifRYBytQvFTD1bNNwSojB3+FB/L0ELZQ/e/7ISqsrIlTL5MlEeayhH98a7/H5pmbneTTperVFuk5M03lhz/T93PZ1tMfDgo+XhiV8b7ONv2yxXQ61ZMswYes+Q3btjuJ/HaEdLzFeRhWUw8yctkLXmjGv9fN9+B9avK2QzrI7d+EVeDU/H9prafMi/qWPNMeE9lyk+MZkafo+8K51FTleWMFV2PLZ+hFvFV4Dwx3wnEdC35ZoHoW81BsMcquo+YQtTlavFpjUSgfPBrgSrzTtnsdehziuecw8N2T7s1FPv0PcUPPtztyfM5rVj+TY7kt5r472Dhv0naIDvuFLv3cuaP0ezEv2pTeWNFjiHJZ0U8u3tVfv949WWTmteiZobDYdwgbSmq2OsgxO8MiGJa3+4aBvmQT0efClqiMgsJOhs2Ru+51is/9EyZ+K6dfT91sT3rVbcJNqoJpzcsXtjS//1VtH8jBnfsBWLdlbjuDStKsu1bpLvzkkuRefyrbuk72d0DSc5vTQllYWIoTyY5eXHL/VR6t7G4/sYpcxT4qpl0b8WJ7JT/98Nc/1dIvuU8mr/t47FXG7UKYl/pnlL1mqbqGKB+j+Hv/sio2X9+o16zjO6h2o8o8ZIKowAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAgFckdkK2EjM9bagUpYaBbVnzFYXv69fs9xr2ZTGBDzLiyCtuZbdudLRJQq80U054OaTrC2Mfk2b2m4Own9BBxrYSearV/mffL68F3Lp6hRBbMcxvENiWqjPGXJgNK+I7Jdov4sdpWsJx/JpqNYe0N9K7NHam1kS/l7n+PrnrilmmecUhLhvb+fCjIShPvE0tUdLpSr9mpw9ZQDEMn/h83agnubJdckPT6vI+LdBPW/J+Kt/ueW1mMZsax8yr8rlV+qq8x/zvGwK/s2h8Zhd+9LFahZNrv4mM6ZhnuedfZEvxyaj49jn+/WGTV11r4GSCWdUKDcKmjuPoYex3Fk3lox9a9Zl091GfHAo/4JtL/amnfZxR4vOfLHrIAfrStpyRMzl94R370XYfPisuiZ8YIlo6EVfbRa/8jTrF3kUeQefjoP3ydX6cLR0ThrLaeIXlP/78YzZ4jO++k48rilb/TeY62i6u95t2iw35SWlgS2ARrxSN4FSvYnRqwxH9u0GCVzzAFUct6vwP0hBzFNuC7YYsysu3g8br1CaGpx4L7o9bH5E5rxEpSX1JEPrN+t4NudhNVL5TpY2T6SC87H6JzeDieRJaUCFyl8RYZFu1oIooGbt2j5MjVii6tCRSfeT4nL6X+LhgUkvgMxb2/BGRPSbJdlPZLbLjyML0C94iqaGk67cyyd6rcw9Br3FTLLiizvwTEJ79mK2n/aXXT4fjWDH7boisBQ/13xXaZ25C2jf81x5z4dEn473/xQrbG0ooypdpfS1ctLjIaxG5tfS6keosMn0cGyZ4imhmuZ8p+GMjPsGzRsCmZnLh0GGLvouIdiz2JX+fxliaM3vdKC6usbzyLOdIh1wAaenym+7FRKyQqHSCrP8QFM7JP+CvMcs8zB//4EXS45Zf9YpH0rDJ5WrdnRKYEX8hU+qC8VU8CDVcUEIxoeySlyPh68la7v7no46ko1Eje2r18JjxgpkHyUWyfV7ygyazycUSoeHHtUXYGTK3KocIDsipGP8cayP9AjaN86xyz/qdp90x92gu/yvGjeSiiy6M5kDH2XC/wxCqPhBNjR9osLd449rO1VXP2coX7rgdK9jxnziuqmGHv61Cyw5vmy135wnC50wTCqXyl17eqXM54up5/Dsehj6oNIm5KpNb4TH/hqmsKKx3giuMr2PN49Xwa19ibu4ehMq95RF8v2k1hy2loVvKJUCuZhr4JlT0HftzdLWZO3W7DUx7RrPEhxfkom38cRZTqH8M42c838fLb8fdpyCSIgtjqDb2Ee/kHuM+NfcCAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAACAxJvrqGvFJ3/UqvavrNujtcj0/1x0d3b7b7Xq1+nqtwLiaI1amsNdu3mHKN+oLauPS6iT9hafcho7XTvfb95NJkoIIYkgPnFPfHpp6/gp00b68u6/umsUnH/JEzpRpkp2sJ1vG+ft3xjmb5KtPw3lVtytWdIeGKmSO0ju+jdAK55714PChvSg9xY/QO5xn39cQc+M5D2IJtLOsvTzuRMYndrvMkml1/XO0RLpCzs3q17Vd3Oa96T00O7vPmeroHOyKR2knXUpdj/I41tu7aTLknfySFbuQKKPES9bziyDiAAXU5DgK2eiKm3osubkHVD2NI2cIS57tUyrKxpugfbw9QuHS+TM6RX4Kxa0zUiFgl+pZyHG7PYHGXSWhqMuaZdFLhbgO7q2za0xGguN/AYo1kV7cxmK1JMqylCFiDh0y/NVJL4+uEMVk0gne1I51bNVikxJhLakflaAmoXCqCps3tMUIv9SP+Uq7wr3A7qdXxbW8YsAZzE49byMb3lz07skq2rSKwi01xzerBMdA79gvSumeagtSjK+y3q8+mV1vmHPcXH/28fMHH68h/Nwo3TPLReW76dIUhdwN+YA/2hsTbC1jCgpve727e+rKvIxamurnH1xja8KNL1S0S4wXlsmHpjw00vAqiS2oXBNFry4E2k51KLcx5yRlJUt/tMbYFERNvj2ZeKW4aOWvLPGDJT74wXH8HH3ppLw0bUxAJXM4L/MtLrrU06X8I2IRxZKkUtJwRYNbslm1oQwHC3SkyDZN2VmxI9Tf7JTRqh6Ul2z3y2kaL3hsn4/eWWnhnW2Tv/8m+7Pq3F9rIt9KA91QNihcRfU/Yo9eroPJFu+/1YevvhbaFSiWv7R8kZFOGXb1+DXNYPMpsorrweNigaYSuX5u1O1xwUcO9TMdURb3OocDm+MidX+GKdL5zpamtg3dQzBxTLN+5Fu3IM63SKbeOaE9SBXnclb+83c6EJWy/oHqLBU8L5we4/3ldYGo8mFQTq90zePnxAmDaULnGKG/Wl7zy6fq8WrMTM+TMxv8+dtD9NfXI5A/8vMT2+7GgQD/WY9krXpMpr6+yD30Gcah7j+Rb6PyaJ7+l/gfh9GLPX+m88Gadzv0VXve2LZVMuIiW8Ht3zCleZ6ifF8Y6IkIwW2Y7fx15a4egVCNoXLdeTu1pmG2OIVeO7XAN7SkZyICNqIS0as2njOWnYzyK/GdlyoDfxKfFY5wnlAWlN/Rn2AgI4bR3lgJ/ZZwUhtgVeGzZvktLpKYhtM3J3qW+bXGeNsMxTe1eeXWvjkaAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAACAggIETvwttZofZUZSEdYV/uiO95E8pRTaqzIrYnzOix3iXhWjBOktrxgHaWy9r0IfMJwlK2X1IWsbJHSvOVxwqZDSdf4QUfD2J1Q/2Tr968gd9rtdm5q/eSLzjCzjkzlHmWeeoCrRpC8iriJi9O/jVrhuN9oNo3Bs35/KGpoo/5DTAfJj9yCtdCevzy8vSosgstI9xcbndf4NubzHr132jrgPvklbQcDNVrO5X2DqrVLl1ava5WAh/e/IMX07flPLZPaXerVsRbn4/+SY7xqrMXLx4z3Gwh6Ay9E89wo+VYAJqdS7PmYfEy/HJ3zTNBKmUl4NqdzjNmNUVJVUbToxqiG9JAwwjKLMW/hD7o/xQ2k4P+ZBHPuHjppgYq7EWgWg/52SeyIGDpRQSw4loNpB49tVhd/tgIPJ8PL96yTnod/rtF2LNwryoF/Rvu7DGyVisST7Kp/5uKfkVOjP6of3fB2dzTCT+52g8FX7eO9dpsirXzhQIzBdyS+PzGg45W5QcBpyDTvjTDnimpIRSnWeOXb/SMpe+RXMbE3FvqO2jaYgEBCgZql6cKlS1ZoXoJZ7cmfPZClDESzzWajII9uqQQ93lHjEin1PJdr32ul0beB44fTlzyxERfEq/iLXfb4H6JR7ZhUkqwNqLL5PNS7E+kT+HQdtrlyotudPRx5kL1eqijgOJRjDS6TN6sREhAyNxjmDLufTw/DyO3gbSo7fziHPSvM+FcDA0F41m6nkH3o2aSKDi27zdfdbJZDyh59IdLHb5NUhWQZbzV+L3v6QbuRZPLdWRXnOk0XrX9UvXv7LU+S57MplobkyPj3qksv+iA7MjLzwX79qPBAGbAOYPgpmYzCPyHJn7dkWQJPx7qC645Kz+7mUI7outNypte3K8XFhz8O3PNMuzTjk8IMz4+Hv26OaN4HU3aj25tfqLIHkaqoOmEJqJzLUbe4tTYdPUck7Taj4xrfF7+bD1flXZCZb8TcqSQRSO1Xi0b/JYUz2+BfnkJ9r46WoJ+A/OHBt2scMIVki//hQgx29vE9lXmLn4hsuj6zLKLkRcePxD2HV+cnKUR+ibUc1wNlcS1nUrjxb7zhiF8/T3TJCX9ZlftKJwHdeN5YNDO24m0tLjNPlGe4353dNf+MXCbeNSh80WszE2P/kluiq0ye7T0RTf4dyJ2KM/f9ib+iIlyhrrcFbvJihTr7WtzN+J97nkb0QhCv1h18sXs13ZqBS/xi1UpVUWftpP7w91bcgv0AXPuDFbRf6YZTRhYmfKdghgef0yX9oR44aUa+4xAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAMAGzlBCdWZm50u84ibaX6IpP3IJbOu89bd/q2xlMr/dQ79YVXNLW0+Kz6j/yn3mxYeLnxxG9WpMDOPvyYshWzMb2nGEtCnV6hxW2ZplrZUTz6HZ4du6NOR8So+RuYlHhKKWSUhRGeirfzqqJhd7twM/WlK/w+x4s++fjFL1QP0U167esY/LKFn65X8/JSwdTzcMFG91vjBKs+y2f5l2LsZ7st7xB4G+2tv7Ea4eu06se51HlqC8naDQ5R63fDi7J0rWJ5tW+nYtVuKdWgxc9Z1LzgDhWyUnysGGO/9Vrycbl+6HEYZVn5w6XiK5ec8wZTVoxq910t1q+VOxeyp3+N5bBVJ+3TYXajlujd3D+uCuMi6jd4S7scFv4WLGiD3I8Vc15JyNv8hdSPFX2vbyfZJg9GzR83tTqd4FmyAVTQTRijyVTT4x7I/r7tJvY2LyVZAV2WrZctiqxf4eruZJJQj2+UVWrvZbTU09d2iK28ZIfyxnhFCVhPBMMN9AM68fMWxlx0RctSlgwW2dmLLhcVCuE83HyYCHX+6t5reFV7voZZfMUW7C6/8iBZEVKUq1in62unxFXCpPyGrY5E95SeVphnz6PuWI+0FoDUnMG9XWQQTtYtSaounMcAo0tV+/cX4vbW2bo2KUgWcuSO/wWsyKdDqVEy3y9RJxx9ZljX9QHKq32wA7WbQIvly3pSveGGLcEEnqaay7GHmPRnA059ThCodJ0zsXtM9yf7GH+/3PX338m5CwjV3Pw1KcL0qo4Hs8/+aJy397L8MIcrw1ZuhYut8FxridvcsbkcVn/vJEEWWPs0y9Uszh9FtlktR9i0ncqia1VRufUSGX2E5AMzW+aYnx7da9krVnYVwxHXdeapgdqehIaE5NTN8WY+gZatYJkztnSxXlD1v8XINdNZ7+27N/rxlfsp5r8X0ZnIY61pe2k/T111JSU1mzuiOUXTz+rEtvZtI/72wGdqdMDdKodNeqXXANkWjEMTov28BHzvN/8YHiK0GWGMvihW3mtZKNbYz8j8yHTv/75Pnk9Rw5ydQQn6W/6bvdAmLh+pusgEZL7pj3UZXrSSv1n5f2Pl2+Z9NmY9lXDeV/zhekgv3sD2daEpVgk5vI1uOmsF9XpPtEocEd2hCkLB+GhtL9hceNzjRd2tlho5pwnOBkcGpwgHjxvdOekG58OwnrS208xndHThanQ4W96joLnRw4oMvcIyevmPGbrPLTOy2TIBpjO2aIOQwmRsW6bcBiF/yHlRyPEFfydiAGO1ZirqKrZET25Y1JeM1mAGYhAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAIBXi5Xv1Xxj6ess86ShEIxHiF9u51iHmGb0/vkbEF+SpMoJzr5nyP1taZR3c4uN97jwjuSnXH6wFmm67EjMDe5EXReyX6LE/n9vX0qLOya1fZg2fB+tGHkoXFBSilZv6Jy5TvbX5j5fpEMgtzOIVMZh5+DinNOS02rkL/0bjiK14XXmq5fhCPG6Hbjy3KrBlxkaz6s5eqQDndIqPyEcEzQt817xx/jgWLBuEeKs8m0w851oW0JRGvcvenKH+juPoLoN17WEueN2rI8nulTyyAkYhMgDDgSx2MseQe7XiSVbvwcJ1PfTRLMmXtdqS66gRX8jlJuxLudqbb4XH972mZd1S6Njj1hUJntEk/D6Z8EzdEN1pq2IoOZ5s8+1/YV/bH+IH65Nl9Ix8K6vTk7MFS3k9/5muAhlJC/BshGfL/xPML/UfPLhyuePh/FBHo20ICGPBs94wLkse7BNdgQ7ijeh6XMP7XL8996lNzU0Jy3JCH7SnaaLH4/O1WPWsoRDMHkrPf6lGDYfvXE0+rmTm3pi+W/HG1tE4osv0svV9G2Whf2+31dNdrt94g3P9ExzSaLefiLTmiskGKkjuNus/vaYgemx65tZdoT1v4Z9PJQVJ0FitTjC7OjkCyjrFaHh1p4qJ3/RmREMlQsLJDTT5J+LPGYmYCX4+WuFYLLB4B1J9eyLWofmbeTSiaCVo+5zTbQykvCvrz83L6ckpyjpbBJ2mOBh3TIOtAxwhdFWFszcveFHc3knZLZdNh2ax2cSjYMywMDcpZrOlyWlnCl70mPOI/vBh2yj3IhbcuILqdSvWo38aGbZFdnuMMEGSS3SJsbcmu6NhBwlWycvJbbp99l6wi3rQkhuNlPvFTYvdYskqIxPn9o7d+mXasunYqy3e8Y17pGEGWU97p8JyuLctTcmRZRa0J6qrbLkY/2+snQ1WfiS0r23V7OLfASP6I/cpjY5UUcMKLvJcNl8OJVIpkEz6Kw8dXau2NIvOlG96yFaniqskeb76zNN5EUfaTRT+FbDHoWc4BSVnvFfiNuvGQ+miO7RMZQO90/qlR43XWbYuoOL+0sxpOL6heiVsWxRoehhHgH4u5aDFbKCZKizGtRF1M+r+zXmkPb95b8uZYYZu6TfvdnwezQezclQ7GNhHSa/sfblKZnpFv1a36/z39UqweqxR6/WCqom3shIev3yFktq6jIRn0DKFjOYildW/U9qJ1lfRn8VzeQUj2M90Uk4+CLzVVnT5YrvY2xdKibXQgfX/MAgtl9MA28Pd8QrWKaTx791Is9/Pc+x/x8BAP7/WAMAgw3j+w=="
+
+// Set accessor to a real function.
+func init() {
+	compressedBytePointsFn = func() string {
+		return compressedBytePoints
+	}
+}
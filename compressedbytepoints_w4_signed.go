@@ -0,0 +1,20 @@
+// Copyright (c) 2015 The btcsuite developers
+// Copyright (c) 2015-2022 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+//go:build !secp256k1_precomp_runtime && secp256k1_precomp_w4 && secp256k1_precomp_signed
+
+package secp256k1
+
+// Auto-generated file (see genprecomps.go)
+// DO NOT EDIT
+
+var compressedBytePoints = "eJykwoNCJggAAOE/27Zt2zY327Zrs23brs22bdu2eQ9xMx/gfwZ5kWqGkkJR3r+dPKMAQbDAG8UGqyXTyCE51IFEUD2xZwT/0Tl2QxF4IO8D+5kyvMrWGtSTffd2/qeOtQjeqF1sQN/Y0Y0Ydv2x1PYR7U9OoPEsNUlxlVZ7YRIKXkF8C7MKmsL1uRylc3q8VDwn1Go6dB7oby6wF57d5ePKV0r8LCcP6ecFKhn3oDecMLJxK3qRUKKSXX6QA1ExjKZZvr2e6qyD1dB1B6HorJqt6oUW1tlLrUtnNA0oZJ5E6OLIqRN1bswP74x1FX/gGrVauKosrPefLaa0KLmDqcbWJbNlQ6Dd65J+O1OCjtHmGoOo1Fk9oIsrjHyiwHebWWXlU2Pnk2DmOX7ga1uPXM2RFjhPWBo43EP2oICU0TQdPHHOAvYJ7SOU9EMtM9KFMkZuoBMJeqjDy002Z/bKb0zEaBuy4JQTOuHEIyPMbqmj8cTfDH8LJGlEHbyRPiKuJsjNWboZ8JaAYCobSldMbvKDJsplej1vRgMO0AV4o+7dXY0x02Qu3WXNgx8davjb7qtTRju/Jqd0fS6cFX+fMx9R9LN+2G+jraUmTerhOSH+sLoPeQrZ+GzQLbNE2i4+2ySwH+PYBo74YpoTfhwy7JVxhZ07J8cr6JoivJbBs25mUIGow+Ava0sprGW9zbNlhPDKgHcxjDvQJ9TcSY15aAm+S6xweAAA8L9EU1Z4v1FF9S3wRbskKxfRW8qYHkInOxWlSDciHDPLVgoWgRoXMSolccRdPxL49F16nQbfieG2i+U6bjlP3iYHVmH3h1FzFt6n9iyXgvrBFY6hIJztSH3J6X4SErghrR2gPBV5McthFJxX6nNtWgm3vN4mLfLjq+oDlQQ8DlHNa6dRkTow9+3OijUFE0w7vZwXCpMzfbGazYbyh6FJqG4LJswXipLrX+BNo43qTi7tssdvnCn/qa6T4pSRYqAuMhQhM/nJ0r55o+MAKYUgE2C6jS1oNHWne2IltroxIVdq/Ihcfy0F9+CMT/lArUUGSdRHffxbZe0ZYLcZeFIEdIxMF2Hsl6YB7WkHBpFQo8VAUYcJYqKbsguMzUsXeBlNW/ZLR7BPGh0eTLrsnX2vyuYS8eY9UhQrfNHas4S27yDFb+F4W3xgJeS5jxwKE/6Vbhopszmc7dWy9Abmw8YC6xVxg6pfThDVQlqb+0nL/M8puZC68qMtVi8TRkEyiaqf3YqeMYDzFOdAD4phKyefT0VdGGeUEkStgBPbMHSFG7Z8249y/mAhnPIrUY/rSmxeMBZzDF1NXER6He0I7UEiMnH04ZfPkP23Whu2d2S4bxCuD9u3aNmGsJsJ5J/H4WItJsAChZmrJ/gIAC84x0G7nVrTr+rm9iAI9HziUNpphxlt4A4AAP6XLumc51aUH/rMWxGYKbxxL9j1qeuDh5DwiQ1uOLqgRzM47oAJOw8dcE4ujpqn1vSJ0rOKzw9Vb/RVjRUzDQKW/g0RPj2kmam+quFRI5vTjib6iAai15AhKEq6Y7HHr0uso/TNRaJyREa8y+6hupRjolj3L7pEigZ3C3lHwtLnKROuVdacJKZGj8VRss0qABqrU8p97AaqlVm9FT3zK3IPb1xiloLaLc/rJ7Kr6ogF8tmt3mvJVTG66BQj1hOu/YsOCH4s/vnZ6BUuLpJlQL/dd9dQUKQToS+46g6FjtasJXWNr5+2uDYb3/pcGnD8iguPg0k6xXCvc332QLn8P3szr7vJUozXLby5XKVpG6qUOvKRDCeu5Dd6EK1aha5d9wcabLIqEZT33fTcR/JBU/Q5QdD7aN/4/BQzgrGDUU2ofN4mpuxxBH44HiZ28lqVEE/roSxG2PAOMZWdvkpp98AkJwmIJalMe0Wa39a6vWyi4UIHb2S0CX6kTneqqT7obVUKTjyv9k0i1UWieYezNSeL6xjrHxLt+Sc3jeqtpRHFbORiryptvw2+iktdUnVu04BTQg1KDZuTi1dVE+pY+iZD4IqwUIjyGEM0XX7AmpGpn/P5eC+7wGgFWmLn6m/AU3jLfEBB1XJ4ygouOL9PIJoPMdfcHZZJjY6tlJZ3JwheFR4AAPhfUMSl1csCgCuNWtIyzzCejP2+fhaH/s0wdq0wCtjUmVlb9qDFgxqK+d/6irCqTNVPSD4CyvGghPcFpLTi/eymKC55356ZSVWMrsDGokN+kOcTAnv+zlQdu49qSI2AKwbXjrGMBhKvrwJEfoy3breKC55pxIZPNxdK7c37f9+tk/23z5dRt3NPUmntVhp6wZpnty8wcJK/Mi8f0BugYif0ldrkLVF4DwKQ1hIeZaD94qAUfy7PdiLUCTJXMp3nRjhfu+9L/0LRZaQpeBk8YIphNZS4fb0HFwKtvx13dQ2cl8wJpsTTVJkWVJbDHEuH9npXllLCGB4bmRhON70ChRj9en9jGyGSWCY52+UJ2v0jlMHIvFbiJ6wRv2KItwbLyA4/wh73Vm0fG4D5ROmzVE1zD9yydlxKmQI8ZIyNo2tqj+3CqUT/26x/GlseUn2JpNzQLBJzjSAtXrnDnbL6k58FOzGGZV3txd+POAkdoyN4szobwjqdsZbAOu6Mg+oLm5gLJn8UwrQ2kNp+L/B19DBmOzpLV+IcE0Fn0a2kra3vjznTEpe7FADz1vzlLQo5Nzp84ggh5dh0Bg7Ey1aKgy/JSWARYmW3PXRFNa19YN4iGtQUzSOUKvG3lLr7+AuDh+LU0b2I717Bs+ATpeVbqj/PW5oFj/ghqd6Z2xBzhRqic6MFAAD8L8EB3XtHR2shjSXii8dSO6AM0KUzhYqn32wxNqHbYkcanDfe0R2qoNvBhdqP2VcNE/HQnkM05PR/bE3+Nad5gvmmUhUrdnNyR+Ut8drMLyX2ctGvgFGlGG4fi7Io25zfo/aKZFHC6resqlvfLhDOvMi1k2ny66jVxUxwOQ0VShcl8Fxc4yMoRetDJp/U6+/BjCFtoWxCzplYo0MjFKPRFfwdrF991vr1mv55Ku/p8d9vVp0+9Y75GUKfcZAKuRq1WGjlwZW4xRcPv8Rg3PAZS9pFdV+V2A/bDwpajEkln7AZ1BBBm6SSIq6AVl+R6RoDg3ImLlSGcvXT+hjUdStR2Cyyc97Mn9zKMQxT3u3jotBMO5VnJhIGQWolUSj42yG/NXwD3f8ldTMl2VP8BzI8MDSJItJ9wwHcE8Sb6XV6OSf/CAVdaB7PgAbViRyNjK/rjqKClKrw9TDTtb+9BnTOeL9HlmfD0NOA685K+BBhupvmshuhtu/dRdv9e/6Xn+hnLN4dfDuC47g50Y1i3bdSYhARNP75gzmzk0kwW1ZY2r/dMxK4IvCvdiuqu8e2Z8JFtiPGBzTrt+8PM+qsJKIQ+ezO9Q96w6YgJgjSewQhROHP65w2NU+LW0Reth1R4mJc0t6NJfD9V8kme/KqQqUM2NyCNp8SYMiO2JcPyJPvIBmsBgAA/C8mGr4kbBL4bfE3Hqmrs1uh1tXd3ZxcTN6s7O9Nahh7zut508u9lsAHtanbJhIDGQUh7dBcKNwXjRpUuXp5daYRbWYr/4R3kxFoH6JUxxlgkZP3WgqpEx5uRbvWokWCJeGwUOsbha0uHSIKPVYvMlerSY+J/WXwQlfgTbMkYGXryrskvJe1hEHe2AtRZocTC7wGxUH7fACSmjWvqE7wiHwrh/j4pFTl28o2HcPKIzulXLpUbE4jPKLVxvGBayuvgyJXNG3xITsBuUPr8yaGwB1hB8vUR9cKR38WqKbL8RrgbKau+t0M91AKWBjM7kyhBEdYCtllznw/0vPPs+WMsp/yNXKafrSs2zhBQJpIyL3fzqCH61BBT1jj/8IN1i/JVzEZ/RHVQYwsLH6BACck7Y5/JDCE/0Mk+baZjH15qsROYPVvQVep6U+c/txUmwX3e8WsUYHlSIKRX0niGgwmu6V+soPthhMIfPy/1578aqyqcgyDl/uYqlD+rZLWy0y9Qwp2FK5Gjnk7hsKLSEyDGrtNH+EgXFw1EPYR73dDp0xYWqG8LCpjo9Fa7bIiUoR5lCJBa2SKt7/nJMWY+mAyFfddNI5DZYcRB6kzizmut3qqmmDHAEUTBMWeWc5S+EmS+hwEFJSPTWevxw7ox73CxDfESg97Gkhpo99RY28CICe4TSMAAPC/LJRyK9YK4qutiBWg8SBmxk3lF/OpEXTQdSqxwuYE/lM6owv9NBH5alXTutFoSA4kLENwhX4KnlBeQiVy2XpLBuu4DyozBCjrkXQ5AYNwkL0Tt09bsw+hSPnYyFRPOG3o71Tn9WRPyrGohXdrbFv+m48lYR+ecE4y5PA5oSx/yJ8VgkQCIyb4dO75wUBg6iXV16Hueb6Pur/30Hxhu5Hhyd/ieLR0+1WzeJgwrcWzDk5HYZcKMc03+SNpabiFofwn5bX2rewwWJAkLok53k7y5Vtrc45OrdVK/0NrQmPYhktEngdGdPl3w9NHlKZ7sEpg9mYQKLh8aw5EhMDIr/pJ+N64sBCxwKZvbGyWOAOMaUVvc5c4iCcSGFOcarwtaT3mOpxG+OD0Z9R+zhSEWfU6WZyg6iUfajSMWykhbWlvfnYAd8FtNamw+zeW1iGUghkBWQwFPOp5Q/eOMOLECgCvGmKG1j0fRV/nHCPDuvSHPs0vY+7AoQGq+GpC504pXL/bDxYtQFc0x3yC756eXle9587/19kxHj/G9yIUW7ENVFWMgXEFmvtcCG2ugpfHzHiasoNR9YhdY6aISCFsWVC1EHy3WWISESiefbjwIA9uu5PA6+GDrGi0LJqgOlkdKVFUM3NqoaIDQQIyKihiJmfMZIq4DorxuI9p64NQD27K9DsXAAD8LylJqelYIh46fPzq189A0Zt7XKeMRwlRfOEKu3fJrq0d9L5/KMMHmbaj8jmtZLJXgrNNBUzGHJJCztuq3lxJH74nb1DBfuHKduoOacu2ndf1VoGJrbkwJEYhKLSSYcaeeV6ICBTkXd+Bmwn4LbICk2fh8p5eAshfKamITxo8xvzEoM/dXGdaGXw94izhPqdmUfMYsydtd51q87N11xFWMkISiDKcX45iUM/bdm/AsFWH59pzhLxusH8jxi6FUKHyQWqWOxbiKp+rtzVgPmAKlff4DntqP1dxsh/dx6JQG5G2z4d4nzb4BkvS5EUUgjQDFJSX9k/NsrIigN8DH6VWBmNo0EKptfoN6cdb9DE8gHUJWkmG+n94BzJz+pN218lp4unrwfgqoVk+yorglDfCpMDtVaQc3pMjijOwUk8k+VzVCXOsp90MjZtJY0QtRmwxIFB/kUeGkA3T5F1rPQ086uqxGVCEc72Z/fNTtasqIiK1ga5bk7ieDg9D5ltdywQbEa3eL66xU2/Mfc910NGP3DIfFsaSE0Dhj5PeHWXLT3sEGwOokSwBZlLmUBfCQRJbAWKHL2b5zymWH0J2wHacvrgqDyI05RgYqAIVu3y/QjML0lSNqF1a0anPL4LQcD5Qnt3Kt5/9d56tU0uHewk4EDsyy8XP42dtYu+v57Aq3uyFAADgf8GQVmkfLwwJo+M6trNEmfinq/nvQ4kMso/4MXAnCEvKZ2p8i6PqKxtdvQlNn1bi/cTEmhsvuj1EJGgDpRoi88OZ8OTgNDXZuoVxFAL/vp2+aJmPEh5eDAhJX7fIPhhuwXmxxutHAU3zSBqmoCYtTPxPAP64J1+5JW+fzzdap3hzkp4WlPSRuLKd4ETJkoaIlW6ra+tf82Xw3nNJCD5XmSDz2si38WJPPbCBcaB7dWoZL0+rilu+499iMebMnISZkIlY2HLS1SKlzXJg/3xfx00HtloLFJsB2aLTZgVGbAg3F9ArNQhNLDqhLbUc0g2jWfXZF1CshXdEKpjgySvk1ut0wNJeTbsDfUjO1WQQE2wb3UkJA2YOQdzEfbp6Uto/uJywNqB0TA4TKImFN3ER4rBXGI30cY+DdZIzIoCko+DWNf2qDZJyNTvAq8AnpOQd+N4nWAuseK8y3xvsjB22q0bTOE9OUKRNn5h2w652DMP8Wt9pBIix7F0ItdorYujC7AfETBnF4tal7+W1IPNmqNTcmJ5Q7F+hQydhALZBdnbtGQHmIA110s56htSXBU5pshjQZEUEc0qHH3TuSFGrUyvSa6fihtJ44Pzwh/Effj6z/rpNDaIioqa6c6svvXIsUqGkKZ5DENYSciacEZ7tE0ndf7NbBJ1DozJdJvAFS0qmtQAA4H8pPwj8hU0Dj3dC+00/+xYyQ2PC2xO1xwendq43hAOXR/FLY9OOacOFqvr049UmQwuPMs/kV9y2Xpl5HWVCepqCnRh5OD3wq52cDLP31yu1M77tya105TPv79drv/BzUyl4XUI/Pqi0x5LdNF0vpmZVDDjjPrYIAMm36Iee+xk+Vqslhhierjkwpnj0g8E/glfsoIyLOfENzCUuyKjv4PJsvkj4UYKFyHOskU0UI6lxMPgq9/7LsfQWYfE4bRas+GF/srsjfsOxAH7bpcl8HCtCJt1qTiXMOYk0lSddH0oK4AujaGztSeCSimOQfG2LSyTkwT2+UPZb3XaVNIUnRm/nmYy5hq1xqj7OvlJFBNdQNdzrgVW4IDL5OELEQoT/KidlKQHEucCFj2kz2Uh60U4OpdYQ7I4mYQjfC3aDxRE7G0ptHr02eNKYdOVOcJytyIpDA46yi4hxb//kIu1fHW1btTU7pUuABozS6h/ytSSSaeFy/mGYAOu0/MfjVEydy19bP8xDFXkfZA55LgVYPbVbqZeHVCY6qrxJRmra4JlcpLihThMk6PYtMI6zMsAxa6hboyaGdTG2QkBH75Km8z5i55WHzF4vDeQzK4b98ho3CDxQPETNfkBarN9HZJPc2ocS+ewxy9tPaPJ39Z5Ac5PjSPHcF173O0k76DVN5MaqvQAAAP/LBp7h3IQTNtK/kl7rv+FnwtiUkc3LnSXE8CqLNPrgvgMB6s9aPhmMNp59boaG4/hUZmWcGQueTZGm+h0I2Gvs99neezDyT0OiuyHLL3j9lNwkBqpB+2/nrI0DiAu0i4OWiYR9Oy4NcITKhbZiOkdAkvxMaNzobtndVDcCl4XMKWiH53DFxiDboFjnZuIZOBgkbfx9QxI57C9oa+7MHynlm5/HPH1gNhhR9QiV8aPAp1af/27TptLl+BDRZ/1w4HDfEaaDnijJyHma1UwaOKeGEly2wcvAhDBi3brW6bzbxg9tYPpnL4Y/Z+bXSOiOv1uCanpqp++NvJ/ObP987kpX1OQp/IwyH666lrco7i739eF6UMunpSShCWJUfpsg0R+gGmcf7DPFMtAbnJsFfruO5KRhtSTBjPlT8j8JEyuGPHqhUmlRcdxXCNHvy86w5Os3uEQkFs5qxgg9ffFNquLBnvyUqRjvIik64oDgigYLBv1jgkwxwNElxVYux45c5ewMFSEwohNCke7/55hfTCAKQyuDrLLf2C/P/9YSIEZgmF9SmM7sd1opEmdre6KURlLpJNRRCcqmpu4k3tr64zHzluHoKuiHo3GHuDRdPEKGdJzTej1L+Sxhm6HzmCHaYG1yL9L0R0T0cFnx0ZYVCEV2zK6e4KzVRQKPWtjO96NBmHUZAAD8Lx+KfS94xMd3vtwzndj53sgxAGTCTMp2d1u3ck8m/5t36+CRRJK0y4pW2oUw4oDSSD0L5qQSLyIezumYvwIKlEjGqfI3hvEuex5Agj/A55DspySUC6JBoUuawz0kmH6Kkjw2n9zWEbNCRXnv/wZlPsf5NGCS5HZsgjm5KeUiGHPeXhXpeUU8D3Gmi6jeuXELWypGoAXRJiapHG/gWRI29JB51Z+vV3w+vjXToMQGV5xAvUMX6V/JZrq8ZLL1gkKeLrV4D7Tl0FoP6FAtt+++8lzrVu+pFyc3mN5QO/A7NqlNo1iRtRP39I3DuG17ewUy6vgPogbXyH5i1/45zOIx++Eh/3i8B9VqGBUVvxjJs/5pfJrQkUk+9skjdEliQmydByM0ui/iXOl69bDWKsgom3THuzowXbmJbxOLHPnM85Q2WCJEWgECRwlHuLL2E2ChISStYoqjziq7JnFGhuBnlojtOF46L/orD4tDp68v2hvsbd8gw8CmGD2Iu6cWMCcfvuRWOYgisSvBpzHUiUgJKYMtCW+jWKlOV/dCtMaSC6sLdvvH7RZOx+G26pZ5jBnYSF8GmWwWSM/u8I8ZVqvS1Qm/zglYXfo7/4YlYg/r3Y7Qb8jmpJ1l+QcAvYzFVTpPdE52ezbritj6x7vEeo5p1EajivdfbxH91EjfnD5rxCIQGAAA+F9QrdyjQc1hGRLqoZ8s4D6iZljjHf9qdITnbGpSspsJ9zRi7l2xqAJHbpulU7wvNYRBV6bhMfjqzc81pEnyBd3OqcvfzURq+pIem8PowEyjzt58pfP6TpJjITWw//Hc0amGas9gpCTAGuVZqclXy/IQwyaH9r5FBETXataWjmpJgn1Z2oCCGyTpP9FGpBMWCBfweXYuS5+ZU+UkhXFSMKmK7M1QXL7xaCR23u1Pr6S6N0v6PYUiZs3/c4lfgVFfiDBYXPf1zIl6f9ONT0prjw7SRSxz6K/9DRXzj6ZzIYcyW4zZbDJ26xjXs6qMoNAV5F5sPgpD6okZuuneaVMM4qVXTpN+IPpVeKjBPrZiM+qpT4wr2vYcobfynO9k0EH/brr8o8z5V0URZMJk/ogPuC/+GglXnZrnd4A1GGpwt+LlKTj/LFQdcCvCOf0cds3zADgWChmrvA6PMvBjR/v2sdZWe+4QD8qrqFFsz7SOaFPZqVG2DsNzCIlUKrDeUeLGc8XUrmzOktrhioJCxuE2S3QYPqOUGTTTGENxjpfe1NW6OYxU9zP0QfANsdMChBr+brwS6W7lVC3iXwcuZH46vKRh/Ks0ZQ5vxEz3TpP3oO28gW7o8ZiNk5JFBgh+JqVG28r4rkDgGgTAOZIHV8bSGZfzIELjxecY8Q4GrZ9QnyVUKwEAgP8Ffwzhlh22e10VHaGmio3GOrGZza087kJKAoMnRwTQi1V7e86h1swKx8AtpVFW1asHJH7qZ5SS722Ou4XbDzHzrX16wYZ1JzMyeVMJIvoV3xkbbPRyuRavBlfrryuk8H7E/Eg/+MJ5AibhF4FgqtX6A58xwkiEVQQlyFjvIbHX3Vm6FQ79Z/7hjGd2u8oc0ZRp6BdGMRpUuV0GY7JL+afg3fe+XPXvoPkgSGhQAXp1lyKYd8Smr8XrCQ8u6pH3oNi5xl0Gkl2tvCkkyldo+q6fzjKA0iQvhCvh8FNVX27Tu80XAkBd94OEDWf064Zq7G+m6HsETochAtx95axBr01n92NdIXAhLJZBs0Wc7VEc0BFtI3vmEqrGMbYEKwC+Mt7oe465KqiS7tTSKdQoJsSjtu5/3ws2cF4PS+sRVyHZ/UlC2Dzx2pzlcywcTAn8KsuKIvMlpZ1LgxhlyO+O6xkyNJqXzDcWdS6COUrURpA1kbRuj0iEu34Zo/B0vytLayuKtnxwL/6V3ltPODawBbkz327ibydKK2MizIDoDPuupFTSSs+UoL905Re/D+URT/LeSajQ/net712SGmKK4e3qof79qL0hLb6FpatPYc1gEiGQLwZbtr+gX4gXHXwqM5ZW6ozmPph7x1Zosr4d/PvwjkVxCrjfcebUhtBsaJcPrgkAAPwvR8V0IsOz6DlJCbu2V0/0x0Ita3fjBRyC+CTGdBusCwpyeYmn6POjbORfGVOfkYuyZ6E3u+VrR/fcfo5MOx3JqN8mSp/Bs/9i+O+q39SGbuXAVIaNXmvlqBkF6Iv444w6T8VmGv6FoXm0/Hjp4AyKTn1QNyUuAM4E5mriQ1FooZD4hVJZ99WKCqWkumJjuOzroESASMy9uWh0ghHreC5gLYBIhnMSZYheH50nfzjAk8AZCrDEGXk3sLs67V+xxa5b8G8IfRoEQ7sc7QLy7XM3OwHCB8tbj6MFH3gE7cs/ltamtN1YtS/k2Qv/UudJDcf1vYDB6aG/LeU7xczhXahYvGOSOZ/FdF0hb5aKThj8YNTtWltk2XDT0+pcF3iT0klXmQ33nBVubKhpS2EWsVtu7ePaD9qYrcH5IgEKkklmknn33BnHMaMMk7BGxYkLIRCgRni7kvdPLCeZ6ahDGEtt0iwDY9MIMOcpldYJ0/gCe3Yth38lEB/WjUIyEIZVvnEDWslIhzVuVIXhpBOoGY7FRJIMBj5dKE8IYyUhsNNXUXZklRozIyn5xRCF5wPPV5LRQAMX7I+k9Sfdfo/JsoIUz6fgi/jLKy5Rl0yraFntiJD2+76AfNTJPCGXELBuk/uW3+O9eBxxIxka+BZAmj9PjF8gFMgNUZ5FdqqO0+rEGE4AAMD/clEq9UCg1N1x2iN9KHtyTOSl8T3jJ/Fe6cej8UFEeNDYw+x5rd1Zzz2S6f+W7lmpBVTabnov4Ku55KUC1qG1zse9mdPY0552M2DEXwIg9rzIZfHWcdDna1LZ7J8iGK8v55Cns609lXfkdZfb84HSf6lcH3sPAzabMqz1dL3T7t6QcSo5B2WQ7n0KYxpsU5Q4oKpsxR2mtkp/DvrmFqCHgNPMxZPHs9qfNaDwbrRF4EWsbzLdXkiO5juSKac/t131R85VqRaOze70RUzRUxv+svz5/lVL5fepA5PPhY2vDX0X0ptMibzJpGrYIEJrRcYUaChk/kZv3MMJpP+exo8Y0634Svoy5Ro7CCnt7Wne/zbkf5ZK1VAiKzif8VuTBtbEzaY95dpSCcPgD+73wk4wPZ4IjxbSn3VS8YTevrXsGdiqdCRaOvzU9rBqyrCUrgMToXifAD0ySEARDgPkv2kiX43E37lvzKVADYORW6cWXl/+7LOWz4DZdP9DnSSXPtT0GBcE8sG2z4O2+uvVT5gF7A5VGFWLMtlFjwSwg6dLjdLkWidWhM0KJURpK+gtaXahPQimbRGJHx/7+XYTjDl2+jRAFU0ee0mW1A65aqndh1IbVSP/ixwhgZRILLJ89qdtI6p5xfIl4EQ8N2r/O4H5RFuV68+ArwC1TI0iWPyjvEw3AAD4XwL9Oo/+nflf5C40nDDiENGo39+gRq9sU3rA69G+qR0IjSBkJ/I6ecM49jyM/MVDtvB7LWUKjWcyWwFriaodSLpq9UMu2zq2TuOn84lRt7NmN5CATsRsczG8tRNthf0XoCtjH/JPIbR6SAD2ttF5it5UVvjuHVs0wNa6aKErVn1zGFL9kMAYcuCzbz7fepEwII+7bMO6FRQl3Fg0ZJKnDaHsRSRJmHsPgzBxdSoHb9JRAoOeA5R3FciqO52IAIrdmk4yjSeZEbMXWaIRsmHS811tTWNtV8p9OA3heGNh6I/plRuBq4UC7xaOCUcnN5Co1r0SDluqBqh7G09YvOvHNcQ5ZeXUhVq3ASib2MgfN2lz+uzOKI9TlaXU5zlabqhReGMaS2b6N2x4NPPyVPNRi8vW5y/NGVG5grP2O87AO/UN+QtkM3Q/moDggNKsMDBTkjRWdEM8kAiOcJZ86B5vW5tFouKDY4jYw+/KGSSae1Q9sn/jrnSXPdjhlSdUZE9Pij2Fjy1k+X9KDcW2G8a7LiMVEtdesk/BoasN8IYZnE9cmo3gCzQNZS1q/UGl4x+pfWQSiT+YfFg+VOqTomPhkzyFzpnIYmOb/2TbWjo5RTrIYsC4a5Fri1EsFS+tCnd+O3584rOwywrs7J3pNaFnp8gINibb7HfqsT3yZc0tYsixQAAAgP/llwZuJu1mJq1/vAUUI71N9/vTNjxwg5AxThU0UIwc0leKD+XnmbQDM/JpaEt1zpN9tPXavh2e9pI2BR/obiADgipws0uf3SbP4yC38+pfJzHfpY78CWeTBs9iRR2T1NttKY72eZ0ZMXT7hwrXaHNXDpRuezLy4XWw2il5PfpooeSo2PeDuz4JZHjrL9kebXK92Qz8bXXcZd5wvOqP50rAuyYw8cNL+/FppCOXrMMZpnn05iLbyF+xUQMmZxHvTmQJbMpjUOq7vpcm9ktN0LRNDQMgAjH/fTeNEMo8QxJ/UZw/7pmeqcM5VT4ezJkRbnfXoI2n2uI35rX1+gZWwcp1ZFUNno8VrmAwFCW5ei1Opw+Noh5nhBNDAXqgM9ds0/BFujeIPL0YaXB5TT2wIBCxSz5plmC5wI8tElBMLD6M3jPfzn3K/5JHRrbFqytWBNOxrzwNoDBKTC9P/ZZZTTKJ0IwgLPrHMMJFgaE76slfV9ZBX4DU6HOECUCzq7xLO2w2BALQYDS/LCgpPi7KhM2vkS7yCiqMTzlpdegZBHDGb/ixxiItvnvL6cQdexqHPNWM3Egatr42Agx4LzZhNTNrwWGjgs5V9BkN8Mhg+7oFCFHpt/Va03WDSOlv4bmM8BnL4KYc3MH1N0bbFA/vfb/HVCsnFjjaw1Dkenq9L/NewncAAMD/EjL383hl+w+KK97kMf+wIURNrKkCooZNjKLyVMl4jUKJAMQkGKUoGuYgR8pOg/bZjD9M6dzCnQUtcICjUP7fu5G1J4IMVvHQ0wtE6ew00i6oRVPgHpQjwasamy0qnv7Ezhx3Og7D+yX/Q6oZQXYt1GS5QEmT94E1dMvvm/0g5+Ivp1yVdD8q8WCPDx35vG7maZLgMZtEJLhVe4qi4dWO7OPK4XDXfPuESG/TtW1h08gdgQg0S5pSh3PraGoDGR2YmRBqFi2db89iv9GgefXQ2w8fqedOSFrUXifsdNVh0d7kOJx24qYPoyqNkE5WBvAsZeOVB9itFAKpwzl9Oks8Sznq3RV86xJapdA/Qxj5wGtJQTZ9HU74yaj0Ov5EfGXOgSrkZb6lIF3/RIJRfk5hF78FHcAaCGhSjl1okUTinSdxJFMYlFTvvk5Aev/t5JBQ/n4iOiiwMj5BpIhZfmQaOYVJp0+YxWupHJS670JIWEcxhii71uDt5NRpk7eaeGfk+6XwY6cHLaXvEC6reaQW3MY2CnCZ2nssZpyx8u2yyLkcibQthALZOt14/rY6w/Snu+yaeMwJnK2w4J+cI7FEuYj5j6UPeZ/gnI8rvh9Kp7IOM+msQLCB7Mu37W3Kw8fFr7j9NNQiye8fVedX+JAoD8yORUem20dn8mtiVl7FRwc5AOD/DUWZonfCUS5q7JS3CO/1Tb/8buRyXOOrU5+7kQ2+DlI9oEYE4BMQLqdojUSB4k65qeYjvy0vXxMYxoRP0p6GIlCI6L7hcFxU3Jg3rpf9F7nic3w+rK1xd0qCKFGKoLmdO+GpvEzsRWCpI1MnC8rTIIEvGahoxrmrg4heywykVi/FD9JG0Ld/XA7Gd7fbwNhS2yOE1pjCRyMUgETp6uHSxjXPvW+8e+cnPKRgBqGOFWf293j47rtKOya/f0bNByy5YzI3CHTicLgclsu6unBwaDy5xeLOs1CsVzmbSfb3DinNaHTBH78N3grZSWP6FzICQ6hhPhpID5p0kHXPjSc9G+/EqK+18EmKdoWhMKiSinj4XqkbRawRWIBbwOMkSL0EpQoGi/cEWYwEbOMAsoCoGGsNRGeFTCUmAouaEDcfY853YDhSlRLuhUvC43evkJZERi+ovD/8QlMsqzZ+sb1sPs3pgjmc3Q6KQp1RqMromqsUWuJKTKwmUFJeaKo34qb5XLDX/9+47MtkMKfR6OShxt+BvKtSiGzUeqmU19tdjgXhZs9ttjW1yjsFlZ8sHPIfZBdm/A7hbjYNPrxAz1JBxfCDR6n6t2zLbt7oh2JEZ7jhWJibJQfJ4Wi0998BxRjaAYJLBkqEQCeOgFDoKzdgtA5hVpmzvezuRl9W77W9pT4FAADwv7h7lEVT7gweQoP9pFLtRHN2Ms4/z2ZyE8a5cUb0aNQ4shrZZjaXNDC8QsUiP/xqAMIag5F+SavVeJQE+r/zRKpP2z2tdfLB540ZJOn8EA6TaO+ttPZBb8rKLnDJEJ3PPJHb0qOx0HMZdoURqZ19+ieym3vJrisiHzlPESM6ynPSGTFBddMxBMbmMLmAGaaqtzAr3nXd8BscGHzsalmwxiyr9Yc9yEBaW97MSJnhjOh5JqiDJ6T5Mr2/YN3GF9rU7iBw7jAVw4xABsbRCmX2Mp1NhfkqH2rPsUJ1nWgejOIQlL0KdBXlZqUP2QFduJCyB1Bx2VYkuP6dzT463WIFg6zMlAEt+Me+jyJV5puZxmMhz0EwBo7U2ziyZun2JddUzejuwMEY+azy/xD/5TyhMe1PBNVWJd+4gd4qINv5zGstQMPQKFzDbZOEI/vbIEztVvg1NlG7hBHVmrhvZWGmMLFMF2DSfmr1MoD0j0yEikXH2BcFn9Vfy1TIDEhzsNYhjAtwSnD3feLkfYpsZ9E9bk4VGANhl56PnfB8BEOEjhsCHEay/zwvgaSQsQ6JM0BkH5up4/rAuN533R9U4zwYSUAAY7JyT2YdW7AU0WvKggzmamMhjSkio3jYG7vtFqUS7veU40mmQSv21AmQfhsw9PaJMmcUM4iMX+kBZbwoWqY3AwCA/6XEbTeWJD8YSRiVq1gppgjTf+bus2xpF/lfaRr/VbuwmWBpL+GpmWDDOE1a3XH0Gq0IPWLZM+r6403UPvZ+Q+xYru6U0S2PFo+qKWGo9ylOrT1PvvF49WfMzLCT9oaDZKr99TzdZqI+f8k3N5HqqCGxp4EupgHjM7Lkg1vOROAYGwgli+2kyzO/f29/mIPeNvyAvIv24HLSSkvkpcZ07kq/Y3k6J/J3HuWQraZIIZxPNLn20y7ZpJT0zsjlydvHz03ZDiSHdphYzdvK+bvbHQ/hITtZvs6tBksK/3KYaK9vfilfar16TckxEWh+I2t4bKaJlkTfT5dW4Z4smEpWV1XljIJ8bVQCruqWcaKtww8a02EkIgcQz0xDQmZmDDNbOvfj+LvzYPxnJ3/NHmmug6uIso0pcWyvC/TVtzUWHO/OrCXsxmE6F2b75k5hmThTF376UIbd9/T6m7WrSaTZhvpRMPSWp7m3Z7FX3jrOc0qE3Jvx2udMStESjWFKU5IAS6dcT2+JTLoRscdBXKPtW1f3pv77rIedbETpRpXZn/qTccSf03reBO7rJy0jOztiqkeNJKSGLkX5j3Npjz96DVNkA2amsRbx/uP9vGzVQLNPkyf+pt/nUkdFFW8fiNLr6J+nZ5qcqqcbVQieAQ4N4hRV1nFXu5eGKOFqtc80+eEeqAAA4H8xUMeAwZtn2I3Z06fEiwXvTNiX4o5KWJrSIuIkIqzQPM7EvmuwspKEqFkv3HjFezASV1Lu4j+QNT3L6EkiCSksN3f+NvdR3jMBSUaCFt8K2fVBEYhZKow1w8yY0A38VtURlW9Zm04zZUBAIBvfpiG1vlj1fy4YmU0/G71jTfb9V+t4Wf61sgdswDePo787ajb8a20WiVG3lQ4nV2CUhgtCs/CLbCRjuJhzawaG/izb6eeYhD3vLiPx57RKKxbdgi1v7802ed7n2JTEx7NyBjZsAMTliBNR3F+Rg9fQGib0jghWHdfZGbrrySvhjPl+LJM207+rD0l/SJvH2nOquZdZK0RvEKyRNIeEtsBFuKMyZ07Rw+LJEgLMZc5OQowapBQXbk2XfvgOF+NZZPI3S/hTfpH5Vb7AVzRV98uuckT3C0LI70BqcbCW0lPksj9a3PHX+LYD5tbDZsX2bv2VuxIlCpgtYrVrgZ9zAFqX6u4jV70/p26n749Z3fw4Dos09pkl75GkVUfj5/294kImY0pvITjmVLGLFbhHPSy0JAHzKvipq2BM5aGHcvjtnPg/KCnw63k1kWnDszzBVnaOL7/1I+EJtKo8UkWhmQx8LD5LewVZir/vkBMrIJFz7rTAG3YgFgu35GO46uMmPkfzX22AmxW7kNIA8J6yIIuIj2hW9yQAAPC/ZHNhlUtLSDp8XEdm444YECmzRoDJ+YFATn5ljFUTpE9vtykgSMttq2sogMo8GJwIxauQv9qYjJ30+OqBLH1p5tDzgjEb/VlDgBb+slwepy6GXSQv4Q9huxPR+/S2lIa6AhC8JZja2Dd3bxwItjH0V+1JlvA6eJspkbGGBoSPMvv2o5IkUnVPtQp2tgn0qLStlF0fTXBtqwRjtMeoxLXINSJzvGxpS9oPlMxMH/2kRc2Xu/rHy4mZzx8wzuR9AWv2nB0Uys4Wo4XVon368pNuF7M4gqAXxEewEupjFVZl+5yIjwfNwNrr4sjW0dDw3BBq2kGdgDQPeDk39OG3hLOT+9hoy7wxlTmRQtJl02KRszpyKxNF/xHTvMDfWWMFgcQXnwRDcZQiX2JoYPUwnqE58hCamw20GqA2uibIboP5nHZ4AwsiHWkfOD0hkjgvxl6NMTi8esJQlgXzD6LevkeROkgZgdeCDlebnj7DLoUvQCZRTE5vNrI9i9GvFs/VJiT72j8vVzOHrTlqNgc3nfgHLQbLOLqcgdRPulWrdSUuNy2jRgOdIvpnmKm1WkQzi8w90U90PrO6wJqKDsZIrxOUgLZMNbNaN8R5oOGuKAoDwFyIXF2XyMmnra8Nx2UWOdo40I0x3w6HS4rGooXn0J/8l0bXWglNxRF99wM2wTEx7OIWAAD8L3Lv/VJY0ycEtkq7m8c/x1s3Q6g85fMT4bFRi87fYLKpALYrJSi6MEowKJcBbryQpeZl8OmBcCmgxMtq8Il5ON1r5om1hamM6AUVjKWjNofw8x0x1WOvGIe+kfovvOJcUOS8W308EGDQEqEJDg3NBRjljbzqpryqvy1MhPM+eO17JVJFdA2+tHWEe924UIctMkTvnSvpWYW+Tw9kG7q3ADzPLC9P8HWzyV3ZQfi1kmS1S1R8aqNxB61t3X9ld0YSQWdEVU5yxgb3/lJHSLgO7HaUg7aTxoOC6JMumEtnQW9/am4n34yfeQ/N55aQVNFnNaN4sEZQr0hur+VhrTRD6HZ9PeMYNyCu5knuotaDVOjtxlV3PnmhrrTAau+Ph+mXJhf4poYK5aqDQLFTpHYe7tT/Oavj/OZxFJA5mkEkdyYbNZNXhT2E+gPPygc9WWtRKMhFYyZHK0owFUp4VAuDH3qZELCteiDCxS5PVF8blN7HUM6oZAE+uKh72T1+74anqogVCgFMShJBNdq5qR3RAZDBxveUxht95t+P+Z+LxpCsncfyzJ38Gzq8Mg4fXHhQz3xGifJ9JQiu07wSHENexE4CcBHJx9gLyE9/xBi6UpPKGFBLJOo4wc8/fzVvmoRc6D9XbUgeovmGxI4n4YO97GDwCfVouef5UqJN2Xlmr2p6AADA/8Isff33RcdfWHkL2CHei7E+Fbm23Vk0+0IwJR1hIIZP1ySSXd2lhYDhnrFvUOuKQAGLiAfWl4VSJNWJtC61yieYeZwFv9BeCphykTwgHM4J5JWGXzpmb5Og/DEooCEhTWp1moKGlTC72VKLAbjlcbONPFK6u5arCsoYP63+Ib75kDAYZjnD3KlGO86gnWnXRwpdwOUzp8Rm5nkcQ4NhmX/xIYM1baY/UETSe8mttONjQDVsyDn5FDIjDFXgGNvLkfmSioGHJ50jRBr4pQzhyhrXst+wZT/YOhYfl1jZOy3lKFpkQCv1pscfPdVY+l4sSFsdBc8VCgnXXts3h6FWJf/npKX3Bb6Rq7MbZY7qOPzyG7t5LVIHGhl/c2GyObxImw1b/6GbBbDwSG4vchikabj9w2OTw3OeMTrslLSZjQjRTVY50p1pTS54TeIyyfQbZzyjZq/4QTAyNRQVS0XI/F74XDrCUyqqaoP+yKD6dB//8ic9qHMI63H91IixgEZp6W5PBcNF+yijIby+DdIO9rBTorycfU3xAApO/m2hjx1ucQuXx7FknYFr2j8lsLklH9WwPWwnHZFvoKF2OoGX9M27VIsbaJdH7+GEcIkJIPW5L+co5oc1f81WW5SjHsEosO0Zm58M+6tj3/bEFxN/TesWGooHrFC7OLGxJB0DfBIGAgAA/peVSP8xSpg2QU9m1kqkOHASPHuCEV/n4ZKdDQRtKUuRs82oit+n5tA5WBmCBPf6gyhteO84DzEqLSrZYHJrFCbCeyvBbHMvwR5BhthPDmUO3uprMF57B5rAqA1i2mLklm9qISYMVBWKjNXSMi2XQ3h5dFVwTdLcrmLymFr3q3zlDQ+01zjibqdoWcE2vlIC9CcF+R/WidaDQLYm6oLogqH3/hGgs2muOqu/67yPqBqDujpNdCdo7vP7mf8u2Fs9utCEVJvBq9zshT8P/Swz3N7k2vIUGKyv05l1sGHsmG2XjkhqIBRFhtPDk0eO5AyB9V+E5CvW5w/olmxCElMgdK9xBxOdp/OvBDHsOHxMgKxsbJXoXgJu4+Ribcn3B2+iQxijhXqE1UV4Y3OAFpOFBrHSFa+/ugtvVtGGQBAQPIPFR7HFW89/MoovpBi64pkD2kktmH40kwzqVLqPZqJErjVTLXjP8Ivut9Th+feC840vnxXDkitulV106I+ukc7GdGo3mFwknoOR6imUSZn653jI0G3sNPo2X0qYHgQ7SbzI+1150tr8dKMtFziFzAc011oXLdiHV8vRyJ4x2a+uXuXsW3lNKSynUvohoWPkYDTVLpLO7KS+nP7uyiqVlQr4AYtnmm8iOrpM5MioaCQNt/RfYMM5HY0cqx5r+iqZXFALegMAgP+lRSwX/tCMfmyBTXCApKt72HFyMvWvp7AC70Nu4461/y24T5gt+OOe7f7GLvqxGV7jUGOtreShNsMjzF0LtmOH2PHa2d16IG/40aK9M9ATWlJLBJhbj+cAEhn/oysIfzOBXP95ayg+P2jVPyv1tZ+bu2OoLfH1u2cpz+XuVC2jwnWC+At37sHSg+0TOEYqswBzPuQO6wfRyCiR7AdPpvTw84gedh+0jQL4lACVBcfL1HIiDqWf4xTiE7Xvr7RbJB7PigmK179d0XzD1gjORB1721VmPQrlRUAYHKVukghoeiZPnSxqHp+oS/NpC6Q2wHOgYbpCgTgTd7UEAiy8ajjMaSjFx3LXv3s1dg40NSwyyh07IKKeCScPI5B6n/ZsRsS04yt/5TXQz1AaJgbe8AwylZfeh91rxkH7ov+ZRZcTAonN0ePwrvSDjd64mEqiz5zKv3ryZSGlh6kkXTRu9/faCGfCuDxOxBJrIDsTO8wtiJF3lYRlJeFYVaOwM8eFdalOGWbg95LwVm7jCfqArYnpdiNfcxt/jJORifjK9T3xiHcCDR9CPn4fJaYwcDqafdbc45Y7ZZfQT8c+xWkCTRGWS1p8OvqDh64+6HUgqiHNnmoSSMQG+ynN7NnKjFCt3JD6TCMvsBptp7I7O6tSKOLAQmTGaMCAjvLm1BIE0mEDheoCAID/5bTQ5iRnKTCPH3Gmr+I8dhGVD1KBMSqxL8Nv+8tgkl+I2jWWEaEsXUYCB2CFXdeJOVfa0CcMH3QAH5lMNEv7rfQ88dEBYv02B8fZ2+JOGPFMyMt2tHLIULHuq53gLX1miHXGEwlMbRvg/ZsBagLJhZbFQ539hwHscSqbJBsukCR7ipXidL05QfHL224z4aq3f72zS1uC0VHa4f2b5uNGePOCNF2ohGikIFxPYPGTSBmAeDxZE+skTDdCnA2Etua9ec4ZTzrTIkCAvKuktnoGJhyXq5gtJZtOGPwtu9pNnaoUKmkRRuJkmvJO6dXx4lh5H+OIODPiDvPz833QqudhQZocB9YxnS6qexU0HPI5qfP0b1UHLTZbCP5XxGzEdyk/NFS2PO9Ti5ol8Emx1ACJSwiXhLIMV6BwvrDAHyGXZkJGrRM1Digdy5cQwx2SRe9r4lcfyAD6DMA4Ce1oSp96dJ+fElxiyAjN0lPPv9bDeJHnjDK/AJC+u9SIuMO6qdegJwvGMkpMyBwHV/sSE8OzFanFelqILZVF65u8sGlVpSX6atQmwN0klQQ63iieckVZfjwsCD0aqPHmuTzEMqf56CUX01zzoCKzvkayBKzRwXLkwcshq4zE2s/SCdX6bFdFeR53Y5EvKtHeeo9wgAg6rXqFMtVxTdyfjL/IiAHwjpwBAMD/Eg34yMK8fGS6TEXjKNYSNhsFUWCAk8eDQP+0qyW8OMi9LXYy/ykhKqjYYuXmYB93GfjY+Ag4tFE9XSgeMQcWkiGd7cOwLWyyL+/Ufje23r/99qXyo0JmaDyTzazvj9zQv/yN64TINixNt3CU072uoLhOyS4ofkN9sPgw5EqL1LBmZ6cuO4fYfnOZ84KUpY4hVCz1P7lRHb8YfFcDufxO4JN88DC3aatbZkUnFXHYoA5cY+xEXGn4qBtSyLwYpiflA0j/1Vhq7wq8oLt2KqCiH0HfXvEbvVO/vgfBTEk2zylsjxxuNQeedD35t4bqO4rD4zjYlJwqUBgp6f4U7J73Qy4almb7SI0P/JBSk0q3Tgj39e+wo7XLJtWnquq9BoUylaJbw2AerW7/drwCwkUNmwW8CU7LyK3SS7IPB7nOQlkyjneW6a1VJstIWhfH5x+UHSHD8SkJh3tlSLrJors6c5/5/TQ/HdcxU8St+HrajMpaBbyFu2pIONkpz46eabuQtrQc90DUZJBHlFV2ZRAbCKkL4I0m38j5ZC9A7aW30BzWoaodFyhYSwHj1BFv544mR4pRU7aXqufaPyrve6XfTe7mr+XQ5BzQ6Jfb8EGf2YxU6pt/JD5EQ8XUo3zNmI+M6dWYkV1/MUogNhZ1LwgC/cEgf0RGx7nwMcnZk/w8e4UWAAD4X44eP4Hc6kJW9N/z3rGi2aEIJM9MQSzw+c1CvxVVSPCR8I2NueduFXDRDUvnLoBbKW1GT+XHlebHA2k51e0vnbtkGCdbLcyd9/BHhBCrNtkZwlWwA7ULnFJ4fg5IeKbrGFxG2IBNO42y7HNp1d0HhyoIdnkDjlhDXC1A+MT5yJtb42GZhXqOFM4hmZ8JLiXgrgqf7UCIqDPEVTLu5K3vtFdsmN7Pzv7dPb/rOIGqw5ByraSraPDKf6LtKNfw3MGxRU02sVZ67TvATaM1wGCIS2JcITkqKu59YDH8ZFOVOoPlSWMTQjdtdGOo0UmRcDbv1ggt6SlAL7+JtW6kChP7QMBqxYAYVYHFV65QTrGCDLBMB+7t3HqjhJB5+VBO5SHqKPZpXvxDWTpmT7KydhIS+XUpBXuJNJtDGdTJIsTkLJ4+p9o98tdZZm3HNoq4uH69HrnXuCy6g4Nb7rFveC2jp5LrNVsjnwN32s8SkXcNz9eTm79PxeWiurEURXEyuBLPUl4XkANBZn6BlrzlVnebLD7jcHorSUykojXxxWxvWFG49CT7oys2ScFSyMGEq7oVLZe0JwJzMw5X5I3QrclqGaT55AFmm41Iiztn643vqk4qa5u9MRwmjnguJxG6D76D3OcHdYL4yM8lfuKfsodoYSlL3u/7AlWVuGpZZnMyoWL4AwAA/pc4i/aFFx59lkSu/pp0AnT6s2g1Dyf+IjYcb1HZ3KG+DTPqPLoOcK7QHbz4gZYeIt3OC9qfFCWY7wfeXk9Fgt9AOanabw/5AI2vustTv/U6zbV0jZpTI9/ak+C/b7IPDm7TzF+aBXDzbKckD4hs2aV3dgh02H+f1sSt41uMQCo98Dk58XroFfOIoypYjB/ECCC/g3UaldZVezAP2q4XOKE+jorooBHaMbbqk8FKlOH/TbEHfE0yrz3IdTu4YrIQXCu7mqjkhizbh3CsGOYS6GiVxVDz7YXn4MmfbU6qU7UQkvevhAhxSDWOKDPtKks7UZFZSFevq7bmsQg5jX1szMA+SpFTkB5xUJ+8JOIEVopnFNyH1pYIIpMz8heTS6YNFCGicJw6d5VVvWvRfqiloTLy2KKzI5fpv83ACrUE6FHszFmZSbNZlTjoWUCWXvuIVtKUFBkl5OnCBLyh22CBmPEVi2Yia6p+n0dRvdn1p/bCLkNmoCP72oCODwShfLJ8EQ8dgqtFY5PCxi8fqDQeuR+8yU1hq/2Cb8agBNd7znTTMcFX2x96JAkbhMfRIPr9hWv57fIX7uHgJLekEQxKVLUTJYJO8FrfpfA0F/9lxF6fGvN4wdYneIKkUTHZmFZvKvQwh8dRVWw+9vbi6gl4W3H+yxFxgl33APPlR4HyDetNzAQAAP6X+JqQxoiVdnZxgRwwZPOYA8gpFhgkRr/fKq8RfoF6+SV4RS92wqJAw7rvyXVhJ+I2rtqPHrTACLkMPDnJSbt7RV/aJfi3cUH3Jxk8NElhPT5fZO0TcQfvGkrM+uuyLSCleG1XSOqtj8bAUuwoZvx33fCsG8HaG0jaTJ5hLkIn/FFMOyln1iMSnIJ06wlhaxu3e14RpK4XTnQj102W+tYWj74lg6N0iO7gSr8xsr7WE18kCCcy0RS2rbJ8t4e3dYL+AvFnopSejRmicdfO+Vvlsmxj6/fNEN53eETuIxSx47SSiizoepWf+sxz3YXNRhzBC/OSBqK75Ho3V3OrJy13aHDcmCAF216j0z1r1sFWUuR0KceHW60/c7Pgx5ONwgRttl9W6/EgTa0dSYma8GCXOv7mKDLc9tMLGXovFvK80/7fGUIz+wMWJqRxDmxsFGHzVRXn/M/Xiyxz0RFtMu1bcV5CV67dgidPtue+YB7hmiceTg3fNz9JMS78tSrHaf7LL6Ut3ISxxyit7MyCztwWNeF5HKVVFJ+SjqQ9mHXWbMV8r2PlADqUKNwaDtBnA0x7rGVXO9cbTKOJS/urgCfi0gVZ14JZrKT2rs/3jQatANTNHWH8n6mhA9hOlS/ZI1VRjR88ujO5/vnZyb+YiMdlkb2uSU8Z98ekk0mklmHb0uoAAOB/KQKy9N6ddhBB6ZA8xlNzFXkoCATB/7ZE2+7z7hraN3BwdnjH/TQ/eobEhPogW0LIwmDbfO0rwEaBhcMGxcfwLaDYP87+9byTejxbEMqF+XVp4jIFyLI2ryEJg6A/yJTUMsGQl46BHkDd4Ojxfnnugu1KFpRmfhi/Bpf03oRzizXaBaEUQaJdIqFZozAGEkLLBWlamdc4hRiZA1EAUPyRvvolAqK2SB9A+wvoGoA2BkHJ6xK0zocJMq0mLT8E0Kr+jiKflryESt+ga8QORtD5Kmhx1ewEf34qZFhi6GTXrtZKmQhBKBkbN5peywQy4a0fc8LFqsrAnnAEz0tWiqMZJb8rV0ClBYcQ0MzvcRav7zfxsV9piybY+6mUSbXNwVuXrVVwa0NWzfSGorgVHvjlv/xRR/eWNSpsj+m4OQZyPeovqf6LBzpdBBuUr1I5l+423dNIo7IYRUj0rpqno6lt77sLEwndLPiszT7FmyixfoOTcZhg8BwQKSm52coY+S87OBnIVo9p8edUUZCpICc3gjuOnkuk9pPAyBJgWhhUCZ9krsh8oyMmlyrIpssmf2O/+hTMwVOpXu/FebNgEVazMQDzBQZa3EiiC64DJ2wd6vrVMKZrr0Gh64kjsv8jWroJgvVH3MuG9VFMoO3KL7l0PJFD2DGNVVcBTKM6BltcJkQYAAD8L2cIF6iiBI3cfyM32iV0/aoIsW4/ldTKEu8LMDwO+3Z1b+Z3/IxjAe74EW7RRRPJEnRoev51zPQVX01UOFbjVx6isAV3scXIpunYQ9f4P14cyHRTnO4L7+h09adRCEHTGqVj9QvTevvEdRGqWznyDMCF+HLCln2FBvXefZZtkQIyuvQjm0MFYOSQdbeWxryH/8CX2yUEDxWxaEE0r1Y16f5AJ06/ZduJXWq8In6QR6+kwFN7T9cgKbmQ2+nC1ykFlb0kT9bRpNrgDr++US1WQARkjcJ39TdQrZ/hbFAgZ0QhCpbydxj23PEZ8+p/TImvUV/amAxi8lJLvHhEYQDu9JSqOfAG/+G8Cv/Q1rIS6QvKORve72uGQytlEMhI/cTl6Qyjo/eHQRW7/xIBnTKrnrIRp7Bq3Lji42GUCzwyaynyGnlQZsy024wdb/OSEfPWKqybjFyrw8VTlIm2vR1rv8muOFQXQyEVRgANSzlROZkHZQLDnW7Pu13bK81W6aN9UEcIA7hR74NhkyT7uoGJC7wEI3iGlOs4dZ8KxpaxDlnFK75BUlJKILzkouhs4b+P9qy/3dk9TxXSBRcjASecbhFRdqHUbGoWkootS09chZqKElUvKX1RJqjvWihsMcsq3xd6kAnw87hNcIhddE+XeQWk1bxamCxBc2sgG4v8WcfXAADgf2nbSAahTnHFvEQwYVHHDzOc+R1lTzqT35ZCg1ZTMLcDoQTdLZYEKzhaHc8ed6OErE+c0pBL+eVRQg0/OpX/fjm1ZZ8Hu44enlF5mLSMbCF8F8/k12nQfjlZQnlCHa5zsjrq0GE9dDfLdVobsUkZBlBtDWewNyFGsg07SGGjvH/9BY+3+IUKnIc5lnUjQJ1X7tPq8Ubk1LofvsAKzhnZZ7VuMz7ci+/dId8I2aSZCxWlLn6J4EoeAbbzbo5UXSW2QeFu6d6HXayGnbMI+jC7qz+8RIHqU5EJIA49KIItEdzM6Jgwgsg6c1z9YsKosEI5z+ac56g/tOwTOQkTOMTZ8CiKiGZNmiRLM1QO3dL5d2azDx7djcoa/YvNeJLwtVXwUuD6qhlapVaimhOsSqpcbZfUUDablkezml1cM4CArmDiA84rTsp2mybDxQPW+Bc3YhUGLkLjclloVUOepsuJbTTlwC+rHQUkGFSEwAwuCTekJVd6svpDqqJbgHkLShsJWKMB5eBnISHqDls5YxWYmkJeT22sHSNfhLLSco+XD58rnTgvdgCBjhd5cAW/N6iYZ3CZFV37cmfe9Yq2e4RZvw3f04z18L5G7e67AzwnB6LroINh6dF5e7HD3b2XIg9RckMu9qBltgEmQm3HxVnLk+JBhcxtbXZV0ujsvYe0AHwWCAAA/C9rKUamki3aAQcmo8Y+aZTu86v0+tyJiqgc9Hmm4S/9Mj+9lZwPXb61TrEjkJiDgpcgwjHGNPXm+57s/Ku6fzMIvlHsbiMcQeUjuUWMCEEKzUGu6rzgh2AFOrQ1ZdwhgkmJYspZH0eUp8wL5hOah29LfB7+vUZS75ZPG97cjjhu39tGezA42t6TOy0abSgaNKGDCEcdVeWngfp3hP0aUrSqZdTuQqZ+tyOhapbTsS/K1clzl27Q+WxNmCq9SvdikDmu58bmrBvHoMJnT6iYK+WF4jiA5C8JAEvr4wVfxfyb5GXfwqrB7SsqqxDrZMaZ6bAzCv15+x1z01mLpDq0DuUou5zo2U7p9ZqIAcu8xRFMe7t/050sCSdRlrvBdnaNvveSWJxBiCNunwTug8YxH6S3RvxYQJ6rPtcRtdnS16mEnVYb30g2n5jWlpwioJGuNUeFewWaiJ050DgaahhWKhri5VyymhQezR145PU02Z60UBZaQnhnvp4oCJSL+ku4On59titHL7M0QyrGgs2ei/FFiRRihA3kE/nZdXOMVh2io22j/u0w5JdFLiq6qaB5yzycatysj48v91dz+ZSWXlxNEeT73jATKwijoDtS9bg+IWEBhA0fVPCE/Vv8JYaXU8lJMAa4z04HefBHAvYvb4izq3ZWYm78KQmNZ7X53ddkWjIAAPhfRjmHrUEaqvbPKHEqXQTiMuN8RkR2ySdvuP8pktJMtiVECDR7pGAvqPg5xtFX93Z2BjLJ33r4z55jnLKtW2YGD/7xCNab9gFxYWuktkw8rUw2cUy7kGobP9qurPcQN6rCOcGDSIuyPRicKZ0rwaMWVHlRKXOx0jXZYbLFfASzZ0NqGzKkCBQwFEcsFCxSJ9z1mpDNOoJcgWdg7ahjCTNcjonLXbdRcjj8i1qs8ZL2OAwm4ArqoYfoo20cm9+Rg6hULPHIyRleZDIl3VvUhQd7NU18T6L6dZhhkPxm2ZYcZUMlOZEZgwBCxYEFL2muLWTjiNkplRL+nlyOv7OPDK/i+w65bJfnCOz4WqQ4nvSTye74+PS3/Q5FeouuvhEUDAYgWEAUUtsNnVlI8I0GHePZEPKyOuQEjxHY0xxL7qUtMFdgDRBkwQyIofHxQNaNlYSmn7jplRb6fbQtkLvARjL7FRSaxuE+q/wYT40QydeEebiDsAh6OX28Pr90QYHPcWyT2EJ5YF5imDEWOkBI8z7R4gjPxNVbhDFyeDY13kVR5/Ebh+rwF85lheV1QdwIroB2cxBTdcAQlMEVrl6glCWgKe3rI2CtFWqbPTJrGh8pWX5BfXZY+ep5tSjHtokD+5XehAl+7jli89PxSfKCEXRtm5nuEpl3mNcO+ZL/da5mQzQFAAD/i9uJEMqL+yw8YqE6nGaGxSKa6L5nq4EIlXIftShG9w7XONxuzI18yqy6g9kqeMDdoh41y0KzLSFz0z9XOiygaiCHSBX4XOVRnpuw8bUAwzFEgK66ES1VrSXue0Y5N1JYSVa4JNL+e3naR6raVqctJyX/dBmvLRt/pEbxfZYEX3vfXKx6TUhORQ/Zr5NxZZbCi3wv4YyiJZYoAxgUbp/HTUwIfVq6dFP4Kp2h042rr+0TMv/rbDLxdc552tMWXSl/KNYsiMJQFM8BVrdJUMqR0Wweh4xtaENzLj0O+sj00XMP5SENeIFWk9lgU49FFSaWxG3C/LgAAi4sdIIKjT0z9sPf0r6QoyU5Cn2NHv+rRTxI2FruWXxJMkm0BHEH4QSqq6d0VOv2J0x1JTxgCCl0il5Qyb5Q1QkNq0vF2oPq9V94FbaZkvPcSxI3bVbR9S7vE6AN85WEriGGJe5jEfhs4i6etzlCt2xl0nzzO/+7mTarsUFYEpuC+yxeWnKnyY1Jg/i5+Nnn/h47op54oB7LdYWG18dkEuzac4WgpRQlUwXiabVMPJrqF+wUAWB88F4HFtsVNlO+ojcXBN09dQNskf06G9F8j0l9OB/HbIagtGe3m6RuEJkLYqzDqgGoeZ/HM4hgKPszXRnGulxnNN4G8d0rduoLn/wltraaOQMMpY4LAADwv/yUyfP1uEfhID5w1hhaWJWM2vE19IiAuTEL+1IKflK02IFZbGbBoFD5vLjsTLXUJSFba1qnCXi7O+DxtT7wtttfJwuNMQr7/2LCg6wpnk3Dd6rDH+9wMErGrfukEqSpHfH15Ob+RH1g1RW4IUehJOBDPXC4/MidR/3CQ70LcPfWutFHDTIj/ZQT26x7U2BmPD54m2R4A2QadNewz3vC09T6KJPJoR/PfXh9hu6lAg8lsJw2mSSDP/TIDjT3fEpbXajWLWNPvHwExn8WX0ibwpS0EbvXFKrkGNE65liWce+x/OZolf6O0YhReCGrgt6P27dlJ8MnlQ2k8oa7gLmCGXPtQvyV2DVIYY3F6u4kgXyqwFeu/kBEPbsgf9VLg3JduHgz9AoH3PqJko4J66QXKvtE4g+3KXQvuFfy0uUyJdmncLZRu6pnLlrnSNya3JeU65D6EQH7qo0MhP3xLyl1FDN4XytNsyfHf6LsVWM1NGHV/Jv8wHPraBRcU+7MWuZClZvH/9c1anEDhB5jp5xFBpWKbVPO7lgkoUk7OOGzSzZx9WohGL/sr7i1RigN0WQVGrAYNQnISvI+pk1n7+w/KfBtZGv1OUWUJFt7gdZuFg1opm8xfulQcNhfcR9RFT9h0oT+si0UcHSjgcOhosvCXoH6fcmOQZjHJIm6IgKsnKLbYAAA8L/I+1BQez01ysd0JimXCGWGHQRFO9SM0G7fTXHzQH4u82K4ZLvZ5MBg7tvP5dneWuCowKSi9r5us+w1JmNibFWeRMRj1JOnhseRR5LnyXPqZmchPWjh3IbFHwTi2/nY4ciJ1NJuNDey3nWKeQaxs0V9HB3j9L0zNyADMi831lCraIAl5RkRx8V0XI/kaM5rSUtIeCEwzitdYW+ogk2OiRp/U7Hm8itJDuEgrmh4aejgL2scwHcSucc6p7CP2oEtN3zNX0Rf7eL1l8qfnGKNif3gKZmeexOag8rryNkKfPUdGdpYVuLMRHVB77R2WptDZz304FHDXxcN01ciJ/UcHA2O0fuHyWdbZtRvPVvhzGlT5AlXih0YXTCU3q09P/xlyto30fx8WEFQucDJKcSyI5N3rlx1gLrHK6YktA9LcLVXheRVxpoygH794aFrbmUIsOC3nnhtJtfhfmjIoQwFLRnmt+34FeSE8vExbVahyohyCsq9FDfUv9JUkqQYT5lDyfZA0nhXVd6Z6sLKs09dlL3NUgJxVPyvIMnO2CW9isyCPjhntWgHYYuKTPiRW5ibC21WPYL3484Ddnb1G1r9pNX9mP3CbJJMyf58+OerpkZJICfBjTMi31jH9AMbX/NElBk1LIFeNCoLktdnWJLpYaW1u6ooOvx0//3JzlvXbCdkAQIAAP8LEa+Xo87ykorGXOf7FuweMeg/mO2DoucQwRpLyLk/aaoytjUwWkdGig4oNeCk2gXxnZKKz49Wvte7DwOurE0aD7h6qgHMTZl+ZrV510EkkvBw2O0uRvQ+dxFJEXrwY37VCl8vCEF+4nE07ZofeLLUi0U71ZfkP8yyf3S9+fQHlm7bZl72XfnyGVPE91LbVeadagWKrmWxKvhV6PyRcsG2phhpIg1Kp7J6reSkXY4WujN2Xf0GrawtUBhR82enbNUVxSlswYGHzWJ2Xs6FETtHZwjicbF+lscdDhAmC5ZU8LrgEfxIl2Rd26SpiMPU4a4zpKJqPn/3uzLqwhihUkPbDkA+WU49m5xD9qhhdug79aUcCLyt9TfTmkK6f/Y5eBqxH63WlVVjfyjxYdGxqzrVaUa0fNyG3ASXDmV7LP8S6eRyq6MzJukQAK98h4nSQhIaTOt5UuPaJ3bDyl8DWdg+w4Xsczo3ZzwyKNkLPBw75G/vVb7hd3pgIy2gRVGeaZpnyWD4zENd8SMZ3tt7yyF4sTv6sPeHaLf2lTSjXW5nSjfxj5udlxx8mH6XvzglGVlL2nEc/Ll3IfgTWbN7ZKzV8np7YXFVVjqgIG96SYTLlGBsobhXveWxlmGIeouizADAq2yTCM3R4EXdQAOBKHLMxDhCSclrDA5z7KcUynPuEkUDAAD/y56xU/1TIUTN80W8XhyGY9Pp2eb8SOlwK1moFfTR1ymZV3yES9d+9A9yoXdDjZZk9KqktHsYoqRDgwPru/wN0zZ8NYpsIah1XHZMN6NCjQAvqF7vpvzINe8PVO0R/8Ivv5QxXI8nW5awWC8iz8M/jIxqrJfU1gcUt30ncMK/RFDhI8Z92qUk8oAi8YiEkPq4sgY5d+6Umv6ozcqA8A3mGCsWpvMYfSdczsXM71kKdRSM7RClCyWa9ymS+k6rnvG2vhWPkHhnggusoESkHK/NJoLn5EgP0DFtDAlz8KPd3uItyovysRxF46Fk+OeRBVyUSiPw+6Ht8XyfCe9p624eVfDFL/LA3TYrsexLxp0LhKNz95ld+r0GJdSuhDFJrtQdhqG73YxifWjMlygJ1aXXgA15zab0Xa9e9GgpYqElZftoKuotXJa6IE/Yj1+WZM+UtIrPxCCYAbBt20EtUyNUgfp8JzOcmb0Wicf71EjYoauXNX4zOme9HLnInBbeQ6s53H4vGZGfhj6SvxMEV5kCVfPZJx6f6fpBZzo2kvMgJ2kXXGw6dH+qNFUn9j1esQwIalP3C5r98oCr4A/b/u5s2YRZMn5NrucLpkn3EiEgt+LJjP4rJfyTUY/t5PopeBT33ugHXJvcLgF0scH4tRmmb+sBhZCFclQ9jD27XaUfe24lDwAA/pdjkL97df1rS3xBkkIyvUIgoPxjz669WyJUKlMxhBwfdGKOKjKVyYvN7+8xUJ8ZMNvd+togV5IEiHvHW8F4rtobJXtwxebfqslPMDavCys4/INVRD6e1HSb0cZjo7l8rLdQccfPxwYhf4QxyH8yekUWtA22fjyhANVtf4ZnGLw2ZXMirLh/U1lmjkNEOmaVE2qb9/8khV+nvHBGRtph+Lq7bidUHAlI9cIkn5tcOykm/j3LmPY9yCDjuZ215e4AM0uoSEZ7vuWmEWTDeurEzfocspX+phd1V6c+cXY0WG1HVg6cO5GtDXiTaT+4RyB9eWmJyESv0USQ23Yucq+WuR4wCaJou3FE50icu4RWZw58mlYLb7M7B5Gw0d8B3eGlZk4CzfAlt9uriMXs5C818nQITq3baLphthKyHlPdj4V77wbMRtPRtWHuvvvFmjBRkATvUrtoxGuoB6Ol+OFHx8svVtYA4MsCloSwtnVwlnHWkIgz8DwXGSwo6qFSeTzt3fu1jqgrqW3/WaStmOHFZcauQNGRkMjEfGKTEjXYiBn1CWesc/uA/YLhV+ngbRlw5kgn722mIPqsbb6DTe3GeZJnN9vL5+UqzH/9jd3eS39ne5N1hXPQh0WhWRsybbFSRcBgPjtmpZoqV1vcQqq506DDI1qiMp6cKlcVL/yqBQYg0gEAgP/FyGDGTxwTaFAB3GfvjtK5/L7+SO7L0pV66moN6zFu7ayLrpzGvqTZsewuXsrdCv/1aiGRxF+pP72/XD6loakWI2RtQ0KyBtt5Z0ww60wBGwX9REcptXLBF8LMxZ5PwodOIzlKxUzHLF7DWsWU6Xa0K7k+n5BlaLmU0DPAfnR+2yFsnoISNHB3kA8sguOBhYePc8heEi6XW7sTpthUCMUM2HGGNAxaC2Qn0YrC5tfcRSWsPNjFfrHXR8GCV2itzqo6ltrR7PRKJjUaMvF8iv/wRg7fFxFhS+JOUpGJzgbGf/1iE6ailrZHk/Z6IcvRKkdSOa0lfBWcNVNqhIB6pSFP8Vv3r8CB4bmLNfSU8UazdIGAjiicihrigzahrrPkcAU63Br/3E2zNsRCE2+p52gzw/EqJ2r3rH3lPxmOeq/NqA3KXQ88+T41f00EcQ1H3siEnwcO47Dtlm1zabax+lNRzXup3FIrFASMSbh/HvEPFMlFntolQLX20DF7Pn23eqb2O3BLiOm45Mg0xSqDdKwiAZxu2unN3UrbYuvxqWyvytmr0HY5iIWo+llEUIF0XmwOGBGkI+FZ7oLGA7DAR9PhP25ZoxrCvc+xW0FmV6txEYo30g1nKJrrCBlEH3y0YoY9Mm8Y7AynQR1jW0czrwK6EkIF7m7eHs41y4kwv/XrFvoAAOB/gZLs9c/CCi+2Bh+/T0hG+YeQSD6CYBbO/LuuWWcwRg2tUmIUYjtpJr5l7TI8PygUbSv3Cznus9CzNOkXvjZ2HEGeQuvHQa/JDqdzNGKUcNdyi97Pgh+op8RlVy/mGwWAfka5kNUAlIYbdUXw0VkEE+/0cMWZ8pkzRfMJEoDdOmhliDV8HgbSdDUPctkSqr1JmSAL35geVCo7Bf7ot41V+/HfXgfKIFDSGfxtUvikvH2mZPAiudmY/khdFymwrC2qH6HXOPkiGe7ueeiJQiF4EHkmwjJ1Wqk/vwfWiHBDUUgVSQv8K/avwDyaz9xcBZVguOszhM3YGZc/kMJ3aV723ZeaSdaaUMPhhEQ/kNgRYvkHMV8UUrlz/+Uaz/MwNZeNG624GuZRi6IaW5xfwroSJx8lUrVyk1yjd0CTfP/YxcAc9i+SDnyT8d96C3da93HdXp4Fjx2yAKZYnkqp9MSvWRPpchdvLLJ6Ua9i0jaJyHsxSQ/doJTMkxiVl8VGSo+fPU3NEkAryBedVJR9Hbe1TQKOghD4tp/Ae8TBU+55m5QZiMOJC4FOedq7CHIg3Bi1QwJgqy/6h5RcsNSYKEyC2wrRxUAZ/krHBT2IOdPRjLi+DReEEBJiJ4099M0fBjO6s+p4qS+TSDOajdk6K/tZGomUHs4lZoavFGi1hZ2AgogUAAD8LyHmtH/p9lo3aObd4SLMNCxccAQyX01Y0pzwXBYCWKuCfCRxsrRFGi6NXUCzPowGjk/9ML6GqeJExw6hFDxBUEpVZGetXRBy+9j7Q5HRxkoJeCIuxHfpbVMzouK7CDC+yJYddeqzo+g2xakPt5mXRf5AHy64925Tbq/i+tnNntm5VXO6vP/er/VbSLpBaAii8g2FMWRbkJ21fN85cQd1xAMqTc9JIAv6LNuMts+SkfySIPXY4PzMzj/kikbX7RFnoSvwCHRuwlofIfFRCTHfKq89geEkrj7HFkYxoEjOes5aXCKZ5Q92Y/e1HCPGtjcWG94AanlG0Q1kAD70SiFw0X51OJ3TSgsHlMx5QdfzolOqK3iBEI9COEhsA3uza/DNpdJaFSlheOAXsBY7c6Q5nM6WoCLbEXGYay3Tnplu/KWU2Agcnfm4fGqNnhpYScnDwcE2bB06UKqIWl1jGIwOQfrjRXh4q9wRCxjOuSPQhyLwOlNYUiL1sptYHCPPoY/1PdfmNwdK8qiWNx/i3D12u+eNG+b8hSQuShFZgkQoOhJ4ZZ8ZosOyEBLj1TAx1e2PWGDz4nowpLdPEJ/uz2U0h7Z+nWiSddhYtv5C1O2DJU5eCLZasZ4XAsRKbFwyzeBZF5oK1TVKZWK2sS17sQP91Q6JXnc6xXq0wqHi6DWuqXoAAPD/sBAvAZHyypMFFqL6IMrZTb66zBtvIuipoLTunQrPnXSrnUKknZvJP/fWY3XJbby3UoK98BeGcb+QPhae21A25MeQEwIrznTXIsM/4oCOwS7DyW6PXWzOUn1Z2jL31RwZXV7zNDlqP3EsEtjTDd6wd+BJf8o7mUdxr+2VtyOQS8B6dNQ9AumAdegSpTaO+AF1waBQZds5DzJWrS8+gGU2ZdTIbG1ugvfV1U0E710HQtInGNPfxCArZVjgkWD+7KV9I3P2udnV9lTMCFoUPCfP8cQuJcFB0GVHJZiY8KOhn7BE5tTTdVKgaK6Mex7MD25hQImT0U25AFOCTbdSjnp7gNk7PCAVSB8uDcAEp0tRVWGTOGSxv3ETTogm7dt8g1efeOJHMiYDezvbp6w9SrTnKboyp5j1aA3fgEZds7YAL+9g9DktW2+jBJYFSuJEFi+Q0LUmc2o4dNUMgrMwlkyreK4DdT9R8uWbnPogS9ThgShEaFa7kWvXwL1O7Cn9g5wm29fMacixKkZ7g5K6TwCZWoq+ltR2PDeS2Io9vDG4wRH0ZDEioQ5Yyr+ti5CuZBidLWXndulizsjZXyHXW41LKqXA04ZwmMxGO224cI20Qmqf3lqlpIEfQMeqR1P2iPUy0+Uu1mA9fUo4Up/LkKoO8zvINz1UjAyU94px0tjjTaMAAAD/C/j7ehvI1X2CsY9zsRDfBybC9pu0psTn+Aj4dejgx2CZj/9IrAGjZodl20ERvNjQkm/xNoMOJSLwmkW8AXcwkUFt6M0FGY0QLu4W+0CwJBXXBobYcdnRBoZODrGLjN8qHdZ50riDM/C7URX0jEbe3XlMItIfVKdXShLWSS/5G84ukQmheaPGEYU2DrmQ7NJXhoYyslhhc+Nl0mvXswXcA9CtC63AQweSD1xThETMzSKCzJbRRv7iYotjMlD5JOk4a2LJ8XeY3t8/J3gM0WpbtJJhmD2us+wPN16oLuQ+6InxH2DJlnREN6sl4J1/mtg6MPeNXYHZNmgO2HMikcP5C9Wz+cFviSNonlvMXkk8tBRtE+kUHt+c/DVNvs5pL09uN8paoY5PjRL5Iu8almQP7JeXeMYsvNm/X3dREZjLar/+Mla9ZWU/MHFIeQhlxL8To06vnNfeGRoKaHOLEKKmhQTJgCihSfi5pE+/Nj3M7Vf5GyPDF/I0B1eLGvqz4Y2qu+vaRxahHCCA8LRSaK4xumtf0HoQztkdH3giezWPjEUd1Az9i4lRWzobxa5jqYLsIryuso8t7U0ciKD/IwDntaq7j22HvqcjCiHdjyO5LhgyV5EH6b6Na+/2DVct0wxlchi2Aq4dVrjEuF+jR5Z6V0eKUTOyz8kUbe/m1xsFHtg7BgAA/hdOvWvW/ulfopO5gNCINpwdx7Kn+6C0E0voYHy0jCFOI0qey8GzC5Jnc6AdrPPLJ3VrRvkrwtCt6D6xuGNVelWVlxdiSL46VX9QtR4UOX3zeYkxcuGeL9yDraBCB4+HQB+cafmAv6fcCHW06miN5jOnJ+oTLpLMOC/z2oTNhJUFwFhFqI9po2CnesgXhlyzwWpCm4lBOJwrbKjdjFYtB+uNNtVrzxujlNskUqZvYc+UjSXAeHikoD1qy8o3ZJOVdSyf5MeYcSl8B90Ok7x8BAN/phMGZ/5G27PnRDBDOWpttTzuUwYgIhfJaZyJl2HNYViiL0wytbVWqUptTvBodFg8aghey6SUbxT92Uo3NnlxwiJkCwctAGzd/A0Ju0S+A/U3h92wJoa5F4JIjbko0F3b6HAlVlWu5XRCS1w+6CGezQ353YI7w/Q7Lwc/qKg8xv2dq/kCQROE4VA+5Vg3LiE2EbhhxPQ6SI6BObZ4BlN1sDEPCBynwcgBTR9dyAzcIAzMUwcqYbXE/MQbjiaQn1EhE9vC5iXP5Bs7Ct45I3mWFZ7xjmvzx8tV723Xtlan6t2OuPGQbsA6pgiFx5ViuLQiikgg1kRqR6GjfB7pcuO9aJ2P5gCHj5pfvRbddDXwiMbfiJJL9xmvzqJoc2PT/Mf+wZ6aHfx9m4tkrCNMHBsHfw8AAP6XWO9pmEXNoDLH7Al3k4l4c89mblDurKiJ23fNJ+41AwnEVncYar7ixmQQRGHYCiUxz7NYeolvi8YLRFDfYAMOapZG7UosPaecQcVHrAxLxV2efu0YPpCIU/cF/6h7ARClkkigEBPo3rB3cUF20hjlrkpTg9uu8CwHmmGQqckYF8zecVjY6DgW31FUZ/mPH7cebtfrT0sckP7fs7BMUPwaGa/kgH972TsLch2OMcugdOyf4rG/zHu1/C7KI3ehPxvXHHI3tcTjAik6bdwLEAS+xGov+1ATq12lq3ijDaKonewYSKYtCtMKGeggtOBv4K9UnJQrxoBjESIP156KmDpnaWe6e9wegcrirZIM/mg6AW1FV3aM2zznSfLTbgjXTh5+P6F4/p24DPdJXzINQTIu9JOcGluT4WD2KXVf/FV2syTuP5ZwVc17cANza8Wp8zaibjduPhVz84uWsiF2x601cIMHsViu+aben0Sf1Wm3GY9AE5ORE4PImCvjYfENZ0MQsnvJ7QZcEk2UG+GCU2m3BlillqTn7rIZw8+N/eExg+1gXAgdvdhZ/Mvecv4TbtWF6ITahOMX4Iq0qK8tx307yiT+fdl+3BfHPuJ7zim19VvJch0PLdR5sHqRJjVWpyvo9rGhWKwmKVb3mpG3y1D1mUl1iahxweZqgIweSXScLmwAAPC/qMQrVMKwyG6X7gGS9B6IlK4g5147kcDz1FV1prA5Wvu0+8LmuvwRbcPHryYG4HhxUTIyAvZFWS1zJqGsgSkcx4BKXOtyhMRded8TsIzRM3Sp7U4raxBCatSnEQg4i30qGDnvJyMb4Aan/nwp73eGpne38+rmyfmWmg/v0rtfTreac5B+k3Y5Y6a2Wk7ZSWRFuNA/qxvqR5o7Vgv/SBaCwR/NBqpHWEkTErIG9mWGW9v2WFd/i7BmD7TDZkqB4/E5PN/tibgRLXFNOD73txr+WVksxQ5aHIC1O4Ra/XOIpqZtnSdv1KMFRvNCFIEO94qIXdJr2Mu/egRtHsxYt00/FShidlvstCvLmKsZaUxgaFa+x/3MHEaOazb5chQUuGPcS/93HarnYHWG/kDFFwXY4uKRB1ZZq9LWGnikGHLfk3Q6Q6fs0SGgbSteS4LFCOo6z1rxHIwczuqHddaAoKIswHM9X/P8j4o6d/OhXDhoYih351SIWmaLvHISQads3RWBhPeqipFnQhVBWs8fSzOvMv35O7pziHFlvXswil/wd8P4IPaHxs0OY6jlaxOXZE6KlRmEKR18+NKUonkn0KoNwEucoj2MDYxdpnrZo20abPGhxOQ4kTixm/DkacD6Mzsdi3heii71h6FjCuOwsAIui4XkbKKuSGdsHaPkX9iwD1IBAADwv2yYV+AlgGZvNZr3yJz8TvD+XJv+9Q8J2mH5SIvqv7L5l1VdySaJLtwl9GT8epXmf7wzWjMM+SpY4JtbfuBl0YMn1zHNrl9wq+EDC/tRB1hzjOrOY5u60ArSO5wKJDgVGkcfVIWPVIqg99v61jzjpMqBHQzOz+tT0TfoQXxjiW0hkQPy1+D9ui5aDDEt7tDSH7UQAnKf3FcqyP2eM0XrihTlZhnpj256XjQ/7/CHWOFESU0mFA8J3GBnIEgex97sy0WZ/x0KUMy6OYnDWPsVGtBEkA25V7YwUAy7Lu9oOsDcMIDkIqhISMWbmUzi+Neq0V1cA0Roqz1PsbcplLOJhYvFYIYGsavzrg5J21Lo3HI+r0lySTpjou/Whd1MabpxCfjWQUaxV24UsHtX8QoE1MzZTd9QYOkyRiNTmEcEcSX29WNZHHHvEqvd/aoWTdXRzUA8q99XRWnxPhOjHCVpy7f1Pp8kuYeKxQV5rhGcuNkHCr81JSOXoWY5iGbY2idgbz8nI/0F+5FYIFPAkCsI0uJkNxprkXCgm8Wkjs9PsCpdnygzLLFLw0GeNd3cqE/uuvRKdA/nt9YOHZ2ztOulV5wlylLIvrUxALhpjmaL1avw8I6vo89Qbf6i8AhOFJ7btpGuj4Cta95ePBcL+fdxUNtxKUQO88erm1LsApXF8FiPAgCA/+XnwqZWfFlbBXogKA+VZ/VK563BrBjP6plOzyImPtnS9tjH2iZta7+j3k4euZd/WicdN0J9SkiUjunPTi8mFCBcDgBjhL0Ztj/MbFiX07DviYo/sdfIVBAGXQwKfeNKA2kFpx2HubfwZ9I28E/gutPU5N/ne6xRKm5+pxACSUbaC8XgJpzqJ8Uo1lCvStrnAjq9oQ7O8XpyNYgkw07J3eUw1gpjbLx0OCbDzNKxP1QUErotzwYWCc8Gdp7nfgkS8BGOzw4ThHz5uiuV5ESojo6UK+r5gvwEOO5vGUbfEjoat5fCQtopW+FwC3/UWbXHtWMeRHUBg+z8f99MMp0WdYXhFVD7+MGFly9UPgUI5mMcKcdgqdx8HqGR5wKZDb0aZUe8VebKJ4rPkkdUyhBESskE23d4Z2IDtoHJrsgY0n0tCIpz5+Erq5JIuPUldWXmo8Epq8ip7toGN+XAUmxtUgQqppzaks0QVNL3WN368ne3P7XOXFlTYzFxzBu6icU/ZZ3K5Np8pUZz55dQ2aH0theNz3DvhTZJRd9YKRyBkIXW4V5DE6brSG4eKb7nIjUCHMcqbhSTRPOLUB7JMNsEvDaWClsbjfK8F1RaFwKbB/DyUcWbAvFG9NyUDCTrDUR+gzQtGTmT0VOQ6dQrOIpe8anGEech2UDtOdkKufyufkJ/BgAAwP+yD4JzYDR6jYM3TuwlDdqHUeYXI2ry2BhCGzl9csz6TZfjYJgYISioZ91SjGGapqL1kLgtlIt77VmsgPmQrJYP99QuQCzrH3HR/q47jUDVMA4caTW8k7ymHnzgy16hE6RW6pV4BU5ltFt//FJWDH8TP+h7zyqFZpDZrWHY3gMgWCy7fB06HA25stLq0ULbsdw0TEm07XDhMlRPJam9BY4JMgJDY+g9NFqeXR/lcBD1l5EoiqCQAePCShXkosmY65mHmq8u+DpWZv+XSnwBrA1Zv4wfgvzua2lDuMKw740OH4mkghmnBHQ3wq9mSbu3NuBrf753k4jcfMy6pzjsky8rqq2THzMJrCCLu2WlImwszmIVMYziB7XU3LF3BEyxrfPBP9XyCl2gQXaecDdTHhqf3DtnPlXHbLl4YlL6nit5Hr2UxMw4FUbUZkbOOR3hUmu3oQB68EkAi/IQLori3YJ/DIwLVfWMnUNyPaWXgz/xqYc9TxutJRKzZUZXPHe8klwOak9e3Y8k0zx1y+8SMUBL9avEbP0PljaPJoXykESFINDaX4bJWDvwYobEoZphkMxzjixZZ6NZx68E7CsKZmYDYsRGc0pMliYXs7DGk5No+lQpijupdzDF6PzvX1DXDr+BdU2Gg95GhNme4seZyn7Yhjtog+1/mn/SfAdHsl/1NdUAAMD/goggumFp52hOkTCXn+W9I5aHjFaYxQvSe7sapM1VHFM/vb7PlJgP404/9sgICwVu1vorjfmrlFKN0K2zQjOiBRUNFfEw0zzqWC6k+0sjK5Sxtj72TvIXElIGjFIBX+4V/FOuG1RBhJD1dwGDtn0r1cZgx/oZzRSp0tlquEjX6SMd8RyeXummZvw6JPZ8ZnUiJtEVOFZci/4ku72ANaULrWkkkgPEf3lPWE69fAS9hsBVtiTCGLjTKpz18gRKo5Is5jMOjVeP3Bx0qDjAhdmojyxbOYSrK6UL598JL8uWRO+3TaFXzvCeESb6B9J6U9e0Ct4OSkS1KjkKFLTY9rpUfcqrMIPwhio6p5hrO4yjzN/pIveiKTA+Fky6xS9GgVNlS0EbBmwWFCiB+ejTrfYXtjS/xkKOp6L0Pcx0+DCMo6zlln9aFqWoUyX/fLJOAQzvg3JGShojWtkuCEZVY1640P4fJ/SoK9Mt+CXyxPy3puxL2ZnIjZD06Dct00CIQjPQIHTRNaAYS7y/TmGZfwKXdzqv204dF3GfqE15zFKfyzD0RwV4gpiiK6S9G5g/DGlzOLyCrb+zm4/3Zjk6EzpzNKlPJVgEVnWVXLVgx+/KKB2CTfNpNdw5ytraPnCiZBqMMh/qfoDEIGCRZIfYQfxlyQLGgtwq7bBTM/NiUrEtxV9WAID/901v8VJST296h/7NGPLAYjOURCoyRwVWUKYK/wT839UP3czoojT4uwfLG9+New/InMyj6cEypFfk7W+Fjf2diAxOXOnVsgrv71JcA6Z+TCn9oQuZvjhzsxW3nnNIiYW07ySrZx6S2JujOH79MqgEvak7MUTmzaRsQFL1IeZfKYFhEa9QQmG3JUX23zZkezsLVOeSvKTwtI+7xxeP306fq0uYBOsC/otBBsu2n5aMhbTfMGKE2a24tEBn465Btf6A/f3b9mmwmhGeqX1IpnWVaIns+zn9223IBtEYB2H6HTFZ+EkxxrW6rNN6NFmCHwlHEnF5ZtGywqWRmrwFlvIEjrSK2zyUg+KIrqBJX55M3gFntkA2qoUxZ53lGKdcKL/UhzPUqePkPzk+62o36PCdhnytChF1WlkSwH18f+rV+mSO2uIdalMvlraketBfCopgBoSazqP6Y4ApZHNywrZ6AZf2pGMDY4VrcRLEyUf9Q2Vmk3BR4C3tVuY4fjjTOPBm8mZrYEI3eGw9fzgpVvIqwYUhNp9dVMur4LqgmwvYYF99lWZ3T5l/P4UH75cIVdRQXpJFm7hb/7XaUuun1eVfFJWqYL7/K7R0QByuJtHLfHItHz0WymNZ8sZcFLhppLrMKH6Snb2UuS7GUnda575sAjsrJqARnD+8MqV1srv5SXinAwAA/4sTb0fp8WaGrfWrYx/MsuRoK2Dzt7Tv1HvSEC1RmflrI/XMvFlTZyi9ggjFJTxQzo7cIN+Uw1NKEmgLPCe3MU9f/bYsVMTbRY6V7++QunPRCB0ptAhEuhQEwWLQCmw+4/Iq8ibMfFMIZgQ7xTjlDsiVRZYt9fF5mnS9V5OwSz1Dru1O9iNG5cQSYISCGRmTYFJdi1jGco4Ihcx8L9ffmPdfQvjnaRnEEZ5Oxm1oQYdZuX+D38ZsSO6j+rZUpfmMNSu5TLeIFWWXcOGub/YysU07or+6L7M3ELVf3ag7rIbc8pqPfYX8XlLT0qvOgSxLD7EVUqCIo3dMzAkyRZI7aBWSpH0Z7XLz9BnKUKdfrESbIqQWgiPaPRmxUKZSDBXL47DRPgnWs/W2yFG0ZMfV2H2e0Xpr0CwCSWvmUhn2xCeCStqcyd2XZQExLdELPfy4w7lgfGQbVufHjBzMqpXosfjfiDQF+kVW4CmQ1xH7csVQgg39xjbVe59pT2fAN3524wepp6VLeXKdQovJL0WrlQqIubPr58k9MejlcjKxXsYr5eFqjKhFHCPQlfKZ798YM2mtzce+FNfCNY58MPTLysvfCllT0m7Bhc6NMR0wI/aynLKoEYLl7qscmgfrNRlSzrNmQoL1xHcj/29PohQwoFxuX11eg/zDLJqBg7HN+1DDJwAA8L9Eyeij6z4O9EQbiTa3ihmHixyVBRRoT+NYvmb+2f1+g5Wugba3WIRsavxcDeCu3wB9P2RXNTS6UWu8vRvRX/pmv4bVOdpnTnCM3vfxHeM1RHSsM7kR8snw6hQgd5T4c9RDjJ032kUi1t3LV+On5VvqL7XORebGt/HP+P3DLt/7icGBqtsr8ZeNAcaqZ6VP4zWWT9Sc5A8d40F+YUngswbydv3Nx8b3WePC3xVV6UPwoGqJjTNhkYZRXifcEWtsOiqKj6t6lCHZk+TGfzoCQfcfZ8fMqmp2YMozSy0nYxAzY1VTUM3n8LmT6l/0wCNXDP/4spkbQtv06OkjAnbXq9AUHbJnrycuTnQyADlCUGuzrgbqYj0dUmraVlIzakHdknbzPgxyjnxWzLMtyKQBhDCsSKRE5pqWmtSFCraa167nujFPtIjUT752EtKFJm2YRfK+2wxGnTojJCZbG73v/wK+B9QVuZtsfvxtPD7mdNrsD6hl0RTpMqlnyQPuAEnFIavf05kyYcZCQiJs5d+HvMW7WHOjYm4ddNKtheD/yrTnLibuxDQ8C84e+ANUw2uT+POjgCgaY6/tjRXs7Ouaq36lp6A6I1HoJhYexpyxYTgmJ74bG7YDFUim+SFe5Gsz5/+9Jms3NNTdHLa3vG9TKoTyhyColgs+udKpmKCAUwwG4PoDAID/hZ0vfkmiHjY7Y6YarIWcfk9FFNf3zx+GH2XrmrigX3FB0APnmi9nYqIUHlGVtEd2JrH28nhIrNxJIwCvH89NzrksTrrZdaZv1h8u55FxHuBeGKNPIPrd5pwFR2qdusRhof7XxEXCnkgEv65WlRm1k+wR5YvVI/5/NU5Dj98jcXuEokyCEvHDvw0dwcWCfYrJwvbvWi0XE6BcMhHI6C/HumOp9f3CJypmbz8ZQX5qwj+rpVZUrbmV9IpM66K1m4ckjcWQnO4dQf9olDMZeCH8m+WZWa67sCnv2fbGHqJ7Kh/nBc9fkH9uuzzbeoNWkgwbDwiBTtXt5m/j/9GoyN01qLbnEv/EhNqU+yAbiSy3E0HmVHn/lYAOu4HePZfUdcAhKf9muDufQKZDxPATAIbKRmPpex2X+lTCvXotRhmGDL6uPeZEOFPXJ69pTZTeWw7gXLBAonD7h7Mow9+8n8pyd17Z7rfmHB/WhKdtiWKcKBsLZN8ur/tkwRQ71CHELhjohKAY87IMMkMdQ79teSIBxmg7rXdMoK/z5LrtAtkLge1whCYrkpXEM0Tn7gxa6cwvNSzfx0x5E1nzs0uv/UqVAhVcDjoitBDd18wtz4/NoDZmbwcXDi0mDNIevbip5Sm4yJ3SE0RGw1A6x3dFKdxvIHGGfR9dnv3KXiEyk/JhK57qBQAA/hcMPbQ8p6hQLaf37JGRr2DbvXZOlm9i4mvnr+NmpeNWrkVFO7hcXs/L4tu0EoJXAr3q5hStbVJWHbsP+5/zn5UUYW4gAZBf5tWS366xfa4RIsbvn+aRDWScGQ/QXPEuiZV27D2E/f11hwKMPO+Ol8ZRJ7jYQWUFQO48iZ1tlGok64YrkzfRa/UTcpTm7RbDtbKAM9tLME2QlWnr2/0wKDK681u+eK/T8t0u1gEOK53fyHlln9ep18zGxjVzup1DiBMSnpVYNUE7GGS8l1fXSpib1K4ZAw8/hRAj3ctrMHqgNHhqAq5EbN1nO5zk5i+4pvKdAyAidWNCtqIAaYkI8nwO13G4KTZNahXMeThaOvASnyzsvt8aRfm7HpXf8ci0glE6YZD17PkDlgLl/iFQpiXak1VPoBVDTgIvTMBmUJyzOYXx7h1YQDwbxaR1YfCsFR4LDW5X6a4KPAWMWYHXqKMvjpdRKy6P6IB1m8FPs5Fl70CbOYSBYMtCIrK7wg3zEGpNXvqLgKnELMkuyCs+SzbbQ3FbhEI03/0Qc+O4Y9Pu9JjMP9PH23MGuShG49l0R+57rTpNuuhEKnBZ7WSJvmdBWtp23Gt0rK3GU7enq33r1ym+JVLvVV6tSm97D2dm1VU5A6ke3RjfKZn//ZWr70kd7xx2U1hCuLqhN86iLGbFFgAA/C+BgeTMyCNQ6xfUdvwYdAeca4PZVcvVuAXg4gcCy89okEhv5VAh6rtHeoFqZ4wt0DNGKyjVDN4YPYs4dnUvBzZ14ioODWFleY/xWiFe2e+Yy9Cb4xbr+5pziXa0gFvWQjm2Y6bGIIu+wOK6qI3vm4F+jVioBrRFL8TCt0/CRs6wp0CjWYY7z2xZuEzGt+qK6Hwo/4SEotH94K8v2PZj35iPoO+UAyjEXHmznwP64UYFVvWWaxYa1CEPttm3tc9qDitSgsu2A95u9xWTgjizGiPByiu76TGU+vDldqCGgxAUAiUWj/Xf5LfFlSHCbpR0vLdpxW+EbtjmuqrGE3sKRWBbrECdKLz87WX0ALAG2fPsMJ9inCVvZ5nkeOWjKGDrTXujZCYEONmwOGZgw6nk5zyby/ObqeiXxdLmXYfgoeyvLHSV8uIYWhLbEbhFbBpzDH+p9KGeqoHw9Xmf/gcfByNYEE4qKLY6P6+LjwwJ+Q8HXCDqV8ndxS1lm8IzPCZt5ml75DUbJAMsVJHhZ7uRgYzc3ZJYVj001hPFayhlvsBAWR2F1yfpxt78MM3kx28jDksu3EQhSNUu7RDPQUnwS9Y5XZxN+QKnD8+voymqoLW39S+mqJAnkh2460lj8KxEUEdKkUBzT45cek+Z9mTOQnI1z0v8jNRhO28HFnCz1zYmEAAA8L+cIMwkNefmDjMiVXYRDfCnNRShWLZ5aZ0PyZ/lUtteQb8eKjpnHKck5rTnsT57ciAip0bgiy4IwOw/0s5YGloQLQJlrCo0F9JJtOypHjx6zGzMfrVlgJbiuc5tLkGg8pumgMTkQB8uDzau7IyfB/mbErjBDppcB6SCNf4SkCJatdtNsOimijH+bRIytXs60Zb5Us+iEKO57M9ac6hEwjweXAYiafbygkDUZFCPd2lzzeeWsuLmebtilYpk8ADLD7XoJelXpUO/aIVBVLp4Yw7k/ZK1x5vu+A449MQ8eXO5jdJpDLUfZARx9lqnzO9QSTwaJv5SDcBn8JAb9P0xC8LLOzfi6bnvjb+C83GRXxTXvEM8OeE0djSkgJ9m2lcIe6HnrHDhkRnION/7SeGO3aig+jEQGd+2CJZXAeKvK5Y/5RCA9z92EbONiyuVB5Whb6UvpG8W2L89LBZJ4p7wi64/nDRbXaWy6Uo4h8mGlNQFakwBIuMu1VYpngSOOLjQF1ToCtx2PkbH64AMLrIn9F8J/B1w6TX6Kn+/hK55o45x5j1+iOYGlcGtVq1o6nXMA96tTcrvOOVMY8T3MAR9ck+7Y/3lOQIR8AK3tOGDep03x+161lkFqKj9MXFwFzO3LJTXzUEy4iax+SNghrWWzgwV/WcXffDsrSUgOAHcy3mKARsAAPwvnj1mvu9bndV/8o1SZsJgIICzvjbpZijXNR8CtNHfMNIluTfcyYuGTHWLP7/gkSDLvzGaJEtDVLGyJebiPhGX2kZA/ZKFrv/aMooJ2qX0+azpuMfKRN/yllVVQ7XrOOQeY+9b/JTyDXIVDQdjidtc3Ly+MJsym83tk5gwVSjOHlO/M0YCJWoMIkqxKoS85ShTNTONiQXZZ9SHAt2jdO2xv3PGeSMY052hIhxynFBzPKqZAioyWAdIcGzav12D206cjpI27/vh/B818KVAk2BQQadsUOLh91yDXT6Sq86GplGUrjP485f+tKoJH0DFlqBKrpvXsvR2/wjOnntvSThnEDNE7chhQxAm/WkqI4PWUaI8iwru3jLMbJ0sX7ZsELw9WqsmJmc/PlxZ2qzYLh0fIn8Np8CpgrMQ3Cr/y11abbTy++697Kp3U0woxo3Kpsy2GPgiwRBiURDFAOaFakDlqlaL7O5V/dlC+NiTCuQnNmyw43/3ohR3lM8bBste7/qQptN9Z2Kn23RRlP5o+nDhBc8nlOULwniYfU6zfT0x9N5ldTkh2EGmaVCEEWMbiq26WY4y14byfdpcAklOCelkssEAdLyvM8GGV/cYLNCKwMsAjbMNdlwXHmnuIf+4D00NpCNXXiakkiFFxQdJjUKH0jRwgLLSoe2G0bxB22rTfQ763wAra8hY"
+
+// Set accessor to a real function.
+func init() {
+	compressedBytePointsFn = func() string {
+		return compressedBytePoints
+	}
+}
@@ -0,0 +1,309 @@
+package secp256k1
+
+import (
+	"crypto"
+	cryptorand "crypto/rand"
+	"io"
+	"sync"
+	"sync/atomic"
+)
+
+// contextState houses the random blinding values of a context.  It is never
+// modified once created so that it may be shared among concurrent operations
+// while the context is reseeded.
+type contextState struct {
+	// blind is the random scalar b that is added to the scalars multiplied by
+	// the base point and negBlindG is -b*G in affine coordinates, which is
+	// added to the result to compensate.
+	blind     ModNScalar
+	negBlindG JacobianPoint
+
+	// zBlind is the random nonzero field value that the coordinates of the
+	// initial point of the scalar multiplications are multiplied by in order
+	// to randomize the representation of all of the intermediate points.
+	zBlind FieldVal
+}
+
+// Context provides randomized blinding of the scalar multiplications that
+// involve secret scalars, such as private keys and nonces, as a
+// countermeasure against side-channel attacks such as differential power and
+// electromagnetic analysis that the constant time operations alone do not
+// protect against.
+//
+// In particular, the scalar k of scalar base multiplication is replaced with
+// k + b for a random b and the precomputed point -b*G is added to the result to
+// compensate.  Further, the initial point of both scalar base multiplication
+// and scalar multiplication with an arbitrary point is rescaled by a random
+// nonzero field value in projective coordinates, which randomizes the
+// representation of all of the intermediate points without changing the
+// result.  This mirrors the context randomization of libsecp256k1.
+//
+// The blinding values are generated when the context is created and remain the
+// same until it is reseeded, so callers should reseed long-lived contexts
+// periodically via Reseed.
+//
+// The zero value is ready to use and generates its blinding values with a
+// cryptographically secure random number generator the first time it is used.
+// It panics in the exceedingly unlikely event that generator fails.  Use
+// NewContext or NewContextFromRand to handle that case as an error instead.
+//
+// A context is safe for concurrent use, including while it is being reseeded.
+// A nil context is valid and performs the operations without any blinding.
+type Context struct {
+	rand  io.Reader
+	mtx   sync.Mutex
+	state atomic.Pointer[contextState]
+}
+
+// newContextState generates and returns new random blinding values using the
+// provided reader as a source of entropy.
+func newContextState(rand io.Reader) (*contextState, error) {
+	// Generate the random blinding scalar and field value.  They must not be
+	// zero and, while not strictly required, values that overflow are rejected
+	// to avoid any bias.
+	var state contextState
+	var b32 [32]byte
+	defer zeroArray32(&b32)
+	for valid := false; !valid; {
+		if _, err := io.ReadFull(rand, b32[:]); err != nil {
+			return nil, err
+		}
+		overflow := state.blind.SetBytes(&b32)
+		valid = (state.blind.IsZeroBit() | overflow) == 0
+	}
+	for valid := false; !valid; {
+		if _, err := io.ReadFull(rand, b32[:]); err != nil {
+			return nil, err
+		}
+		overflow := state.zBlind.SetBytes(&b32)
+		valid = (state.zBlind.IsZeroBit() | overflow) == 0
+	}
+
+	// Calculate -b*G without blinding.
+	ScalarBaseMult(&state.blind, &state.negBlindG)
+	state.negBlindG.ToAffine()
+	state.negBlindG.Negate()
+	return &state, nil
+}
+
+// NewContext returns a new context for blinding scalar multiplications with
+// secret scalars whose blinding values are generated with a cryptographically
+// secure random number generator.
+func NewContext() (*Context, error) {
+	return NewContextFromRand(cryptorand.Reader)
+}
+
+// NewContextFromRand returns a new context for blinding scalar multiplications
+// with secret scalars using the provided reader as a source of entropy for the
+// blinding values, both initially and when it is reseeded.  The provided
+// reader must be a source of cryptographically secure randomness, such as
+// [crypto/rand.Reader].
+func NewContextFromRand(rand io.Reader) (*Context, error) {
+	state, err := newContextState(rand)
+	if err != nil {
+		return nil, err
+	}
+	ctx := &Context{rand: rand}
+	ctx.state.Store(state)
+	return ctx, nil
+}
+
+// reader returns the source of entropy for the blinding values of the context,
+// which is the crypto/rand reader for the zero value.
+func (c *Context) reader() io.Reader {
+	if c.rand == nil {
+		return cryptorand.Reader
+	}
+	return c.rand
+}
+
+// Reseed replaces the blinding values of the context with new ones generated
+// using the source of entropy it was created with.  The existing blinding
+// values remain in effect when an error is returned.
+//
+// Operations that are in progress concurrently with the reseed complete with
+// either the existing or new blinding values.
+func (c *Context) Reseed() error {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	state, err := newContextState(c.reader())
+	if err != nil {
+		return err
+	}
+	c.state.Store(state)
+	return nil
+}
+
+// loadState returns the current blinding values of the context.  They are
+// generated first when the context is the zero value.
+func (c *Context) loadState() *contextState {
+	if state := c.state.Load(); state != nil {
+		return state
+	}
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	if state := c.state.Load(); state != nil {
+		return state
+	}
+	state, err := newContextState(c.reader())
+	if err != nil {
+		panic("failed to generate context blinding values: " + err.Error())
+	}
+	c.state.Store(state)
+	return state
+}
+
+// ScalarBaseMult multiplies k*G where k is a scalar modulo the curve order and
+// G is the base point of the group and stores the result in the provided
+// Jacobian point in constant time with the blinding provided by the context.
+//
+// The result is the same as that of the package-level ScalarBaseMult function,
+// which is also used when the context is nil.
+//
+// NOTE: The resulting point will be normalized.
+func (c *Context) ScalarBaseMult(k *ModNScalar, result *JacobianPoint) {
+	if c == nil {
+		ScalarBaseMult(k, result)
+		return
+	}
+
+	// k*G = (k + b)*G - b*G
+	//
+	// Also rescale the initial point by the random field value.
+	state := c.loadState()
+	var blinded ModNScalar
+	blinded.Add2(k, &state.blind)
+	var initial projectivePoint
+	initial.x.Mul2(&state.negBlindG.X, &state.zBlind).Normalize()
+	initial.y.Mul2(&state.negBlindG.Y, &state.zBlind).Normalize()
+	initial.z.Set(&state.zBlind)
+	scalarBaseMult(&blinded, &initial, result)
+	blinded.Zero()
+}
+
+// ScalarMult multiplies k*P where k is a scalar modulo the curve order and P is
+// a point in Jacobian projective coordinates and stores the result in the
+// provided Jacobian point in constant time with the blinding provided by the
+// context.
+//
+// The result is the same as that of the package-level ScalarMult function,
+// which is also used when the context is nil.
+//
+// NOTE: The point must be normalized for this function to return the correct
+// result.  The resulting point will be normalized.
+func (c *Context) ScalarMult(k *ModNScalar, point, result *JacobianPoint) {
+	if c == nil {
+		ScalarMult(k, point, result)
+		return
+	}
+
+	state := c.loadState()
+	scalarMult(k, point, &state.zBlind, result)
+}
+
+// options houses the optional parameters that may be provided to the
+// operations that involve private keys.
+type options struct {
+	ctx *Context
+
+	// hash, hedgeRand, extraEntropy, and lowR only apply to signing.  See
+	// WithHash, WithHedging, WithExtraEntropy, and WithLowR.
+	hash         crypto.Hash
+	hedgeRand    io.Reader
+	extraEntropy *[32]byte
+	lowR         bool
+}
+
+// Option is a functional option for the operations that involve private keys,
+// such as deriving the public key, signing, and generating shared secrets.
+type Option func(*options)
+
+// WithContext specifies a context to blind the scalar multiplications that
+// involve the private key and any secret nonces with.  See Context for more
+// details.
+//
+// A nil context disables blinding, which is the default.
+func WithContext(ctx *Context) Option {
+	return func(o *options) {
+		o.ctx = ctx
+	}
+}
+
+// WithHash specifies the hash function that produced the hash being signed so
+// that the nonce of the signature is generated per RFC6979 with HMAC using it
+// instead of HMAC-SHA256.  This is required for signatures of hashes produced
+// by other hash functions, such as SHA-384 and SHA-512, to match those of
+// other RFC6979 implementations.
+//
+// The hash must be the size of the output of the hash function.
+// SignWithOptions panics when the hash function is not available or the hash
+// is the wrong size, while SignErr and PrivateKey.Sign return an error.
+func WithHash(h crypto.Hash) Option {
+	return func(o *options) {
+		o.hash = h
+	}
+}
+
+// WithHedging specifies that signatures are hedged by mixing 32 bytes of
+// randomness read from the provided reader into the generation of the nonce.
+// The crypto/rand reader is used when the provided reader is nil.
+//
+// Deterministic nonces are susceptible to fault injection attacks since an
+// attacker that is able to induce a fault while the same message is signed
+// twice may obtain two signatures with the same nonce.  Hedging protects
+// against that while retaining the protection RFC6979 provides against a
+// faulty source of randomness since the nonce is still derived from the
+// private key and hash.
+//
+// Signatures are not deterministic when hedged.
+func WithHedging(rand io.Reader) Option {
+	if rand == nil {
+		rand = cryptorand.Reader
+	}
+	return func(o *options) {
+		o.hedgeRand = rand
+	}
+}
+
+// WithExtraEntropy specifies 32 bytes of additional data to mix into the
+// generation of the nonce of signatures.  It is combined with the randomness
+// when signatures are also hedged.
+//
+// Signatures remain deterministic for the same extra entropy when they are not
+// also hedged.
+func WithExtraEntropy(extra *[32]byte) Option {
+	return func(o *options) {
+		o.extraEntropy = extra
+	}
+}
+
+// WithLowR specifies that signatures are produced with R values that are less
+// than half of the maximum 256-bit value, which makes them serialize to one
+// byte less in the DER format.  This is the same approach Bitcoin Core uses to
+// reduce the size of transactions.
+//
+// The nonces are ground by incrementing the extra iterations of RFC6979 until
+// the resulting R value meets the requirement, which takes two attempts on
+// average.
+func WithLowR() Option {
+	return func(o *options) {
+		o.lowR = true
+	}
+}
+
+// applyOptions returns the optional parameters that result from applying the
+// passed functional options to the defaults.
+func applyOptions(opts []Option) options {
+	// Avoid the allocation that results from passing the options to the
+	// functional options when there aren't any.
+	if len(opts) == 0 {
+		return options{}
+	}
+	o := new(options)
+	for _, opt := range opts {
+		opt(o)
+	}
+	return *o
+}
@@ -0,0 +1,238 @@
+package secp256k1
+
+import (
+	"bytes"
+	"crypto"
+	"errors"
+	"math/rand"
+	"testing"
+	"time"
+)
+
+// TestContextScalarMult ensures that the blinded scalar multiplications of a
+// context produce the same results as the unblinded ones for random scalars and
+// points as well as edge cases, including after reseeding.
+func TestContextScalarMult(t *testing.T) {
+	t.Parallel()
+
+	// Use a unique random seed each test instance and log it if the tests fail.
+	seed := time.Now().Unix()
+	rng := rand.New(rand.NewSource(seed))
+	defer func(t *testing.T, seed int64) {
+		if t.Failed() {
+			t.Logf("random seed: %d", seed)
+		}
+	}(t, seed)
+
+	ctx, err := NewContextFromRand(rng)
+	if err != nil {
+		t.Fatalf("failed to create context: %v", err)
+	}
+
+	// checkScalarMults ensures the blinded scalar multiplications produce the
+	// same results as the unblinded ones for the passed scalar and point.
+	checkScalarMults := func(k *ModNScalar, point *JacobianPoint) {
+		t.Helper()
+
+		var got, want JacobianPoint
+		ctx.ScalarBaseMult(k, &got)
+		ScalarBaseMultNonConst(k, &want)
+		got.ToAffine()
+		want.ToAffine()
+		if !got.IsStrictlyEqual(&want) {
+			t.Fatalf("mismatched base mult for k=%v:\ngot: (%s, %s)\nwant: "+
+				"(%s, %s)", k, got.X, got.Y, want.X, want.Y)
+		}
+
+		ctx.ScalarMult(k, point, &got)
+		ScalarMultNonConst(k, point, &want)
+		got.ToAffine()
+		want.ToAffine()
+		if !got.IsStrictlyEqual(&want) {
+			t.Fatalf("mismatched mult for k=%v:\ngot: (%s, %s)\nwant: "+
+				"(%s, %s)", k, got.X, got.Y, want.X, want.Y)
+		}
+	}
+
+	for i := 0; i < 10; i++ {
+		var point JacobianPoint
+		ScalarBaseMultNonConst(randModNScalar(t, rng), &point)
+		point.ToAffine()
+
+		// Check edge cases that include the scalars for which the blinded
+		// scalar is zero and one.
+		state := ctx.state.Load()
+		negBlind := new(ModNScalar).NegateVal(&state.blind)
+		negBlindPlusOne := new(ModNScalar).SetInt(1).Add(negBlind)
+		checkScalarMults(new(ModNScalar), &point)
+		checkScalarMults(new(ModNScalar).SetInt(1), &point)
+		checkScalarMults(new(ModNScalar).SetInt(1).Negate(), &point)
+		checkScalarMults(negBlind, &point)
+		checkScalarMults(negBlindPlusOne, &point)
+
+		// Check random scalars.
+		for j := 0; j < 10; j++ {
+			checkScalarMults(randModNScalar(t, rng), &point)
+		}
+
+		if err := ctx.Reseed(); err != nil {
+			t.Fatalf("failed to reseed context: %v", err)
+		}
+		if ctx.state.Load() == state {
+			t.Fatal("reseeding did not replace the blinding values")
+		}
+	}
+
+	// Ensure a nil context performs the operations without blinding.
+	k := randModNScalar(t, rng)
+	var point, got, want JacobianPoint
+	ScalarBaseMult(k, &point)
+	ctx = nil
+	ctx.ScalarBaseMult(k, &got)
+	if !got.IsStrictlyEqual(&point) {
+		t.Fatalf("mismatched base mult for nil context:\ngot: (%s, %s, %s)\n"+
+			"want: (%s, %s, %s)", got.X, got.Y, got.Z, point.X, point.Y,
+			point.Z)
+	}
+	point.ToAffine()
+	ScalarMult(k, &point, &want)
+	ctx.ScalarMult(k, &point, &got)
+	if !got.IsStrictlyEqual(&want) {
+		t.Fatalf("mismatched mult for nil context:\ngot: (%s, %s, %s)\nwant: "+
+			"(%s, %s, %s)", got.X, got.Y, got.Z, want.X, want.Y, want.Z)
+	}
+}
+
+// TestContextZeroValue ensures the zero value of a context generates its
+// blinding values on first use and produces the same results as the unblinded
+// scalar multiplications.
+func TestContextZeroValue(t *testing.T) {
+	t.Parallel()
+
+	var ctx Context
+	k := new(ModNScalar).SetInt(12345)
+	var point, got, want JacobianPoint
+	ScalarBaseMult(k, &want)
+	ctx.ScalarBaseMult(k, &got)
+	if ctx.state.Load() == nil {
+		t.Fatal("zero value context did not generate blinding values")
+	}
+	got.ToAffine()
+	want.ToAffine()
+	if !got.IsStrictlyEqual(&want) {
+		t.Fatalf("mismatched base mult for zero value context:\ngot: (%s, "+
+			"%s)\nwant: (%s, %s)", got.X, got.Y, want.X, want.Y)
+	}
+
+	point.Set(&want)
+	ScalarMult(k, &point, &want)
+	if err := ctx.Reseed(); err != nil {
+		t.Fatalf("failed to reseed context: %v", err)
+	}
+	ctx.ScalarMult(k, &point, &got)
+	got.ToAffine()
+	want.ToAffine()
+	if !got.IsStrictlyEqual(&want) {
+		t.Fatalf("mismatched mult for zero value context:\ngot: (%s, %s)\n"+
+			"want: (%s, %s)", got.X, got.Y, want.X, want.Y)
+	}
+}
+
+// TestContextOptions ensures that providing a context to the operations that
+// involve private keys produces the same results as not providing one.
+func TestContextOptions(t *testing.T) {
+	t.Parallel()
+
+	ctx, err := NewContext()
+	if err != nil {
+		t.Fatalf("failed to create context: %v", err)
+	}
+	privKey1, err := GeneratePrivateKey()
+	if err != nil {
+		t.Fatalf("failed to generate private key: %v", err)
+	}
+	privKey2, err := GeneratePrivateKey()
+	if err != nil {
+		t.Fatalf("failed to generate private key: %v", err)
+	}
+
+	// Ensure the derived public keys match.
+	pubKey1 := privKey1.PubKey()
+	if got := privKey1.PubKeyWithOptions(WithContext(ctx)); !got.IsEqual(pubKey1) {
+		t.Fatalf("mismatched public key:\ngot: %x\nwant: %x",
+			got.SerializeCompressed(), pubKey1.SerializeCompressed())
+	}
+
+	// Ensure the signatures match.
+	hash := hexToBytes("c301ba9de5d6053caad9f5eb46523f007702add2c62fa39de03146a36b8026b7")
+	wantSig := Sign(privKey1, hash).Serialize()
+	gotSig := SignWithOptions(privKey1, hash, WithContext(ctx)).Serialize()
+	if !bytes.Equal(gotSig, wantSig) {
+		t.Fatalf("mismatched signature:\ngot: %x\nwant: %x", gotSig, wantSig)
+	}
+	gotSig, err = privKey1.Sign(nil, hash, &SignOptions{Context: ctx})
+	if err != nil {
+		t.Fatalf("failed to sign: %v", err)
+	}
+	if !bytes.Equal(gotSig, wantSig) {
+		t.Fatalf("mismatched signature:\ngot: %x\nwant: %x", gotSig, wantSig)
+	}
+	var _ crypto.SignerOpts = &SignOptions{Context: ctx}
+
+	// Ensure the shared secrets match.
+	pubKey2 := privKey2.PubKey()
+	wantSecret := GenerateSharedSecret(privKey1, pubKey2)
+	gotSecret := GenerateSharedSecretWithOptions(privKey1, pubKey2,
+		WithContext(ctx))
+	if !bytes.Equal(gotSecret, wantSecret) {
+		t.Fatalf("mismatched shared secret:\ngot: %x\nwant: %x", gotSecret,
+			wantSecret)
+	}
+	gotSecret, err = privKey2.ECDHWithOptions(pubKey1, WithContext(ctx))
+	if err != nil {
+		t.Fatalf("failed to generate shared secret: %v", err)
+	}
+	if !bytes.Equal(gotSecret, wantSecret) {
+		t.Fatalf("mismatched shared secret:\ngot: %x\nwant: %x", gotSecret,
+			wantSecret)
+	}
+}
+
+// TestContextRandError ensures creating and reseeding a context properly
+// handles errors when attempting to read from the source of randomness.
+func TestContextRandError(t *testing.T) {
+	// Create a mock reader that returns an error once it is disabled.
+	errDisabled := errors.New("disabled")
+	var disabled bool
+	mockReader := mockPrivateKeyReaderFunc(func(p []byte) (int, error) {
+		if disabled {
+			return 0, errDisabled
+		}
+		for i := range p {
+			p[i] = 0x01
+		}
+		return len(p), nil
+	})
+
+	ctx, err := NewContextFromRand(mockReader)
+	if err != nil {
+		t.Fatalf("failed to create context: %v", err)
+	}
+
+	// Ensure reseeding returns the expected error and leaves the existing
+	// blinding values in effect.
+	disabled = true
+	state := ctx.state.Load()
+	if err := ctx.Reseed(); !errors.Is(err, errDisabled) {
+		t.Fatalf("mismatched err -- got %v, want %v", err, errDisabled)
+	}
+	if ctx.state.Load() != state {
+		t.Fatal("failed reseed replaced the blinding values")
+	}
+
+	// Ensure creating a context returns the expected error.
+	_, err = NewContextFromRand(mockReader)
+	if !errors.Is(err, errDisabled) {
+		t.Fatalf("mismatched err -- got %v, want %v", err, errDisabled)
+	}
+}
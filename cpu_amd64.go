@@ -0,0 +1,32 @@
+//go:build gc && !purego
+
+package secp256k1
+
+// cpuid executes the CPUID instruction with the provided leaf and subleaf and
+// returns the resulting registers.
+//
+//go:noescape
+func cpuid(leaf, subleaf uint32) (eax, ebx, ecx, edx uint32)
+
+// useADX indicates whether or not the CPU supports both the BMI2 (MULX) and ADX
+// (ADCX/ADOX) instruction set extensions that the assembly implementations of
+// the field and scalar multiplication require.
+var useADX = hasBMI2AndADX()
+
+// hasBMI2AndADX returns whether or not the CPU supports both the BMI2 and ADX
+// instruction set extensions.
+func hasBMI2AndADX() bool {
+	// The extended features leaf is only available when the max supported
+	// basic leaf is at least 7.
+	if maxLeaf, _, _, _ := cpuid(0, 0); maxLeaf < 7 {
+		return false
+	}
+
+	// BMI2 is bit 8 and ADX is bit 19 of EBX for leaf 7, subleaf 0.
+	const (
+		bmi2Bit = 1 << 8
+		adxBit  = 1 << 19
+	)
+	_, ebx, _, _ := cpuid(7, 0)
+	return ebx&bmi2Bit != 0 && ebx&adxBit != 0
+}
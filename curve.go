@@ -21,6 +21,10 @@ import (
 //
 //   [STWS]: Secure-TWS: Authenticating Node to Multi-user Communication in
 //           Shared Sensor Networks (Oliveira, Leonardo B. et al)
+//
+//   [RCB]: Complete addition formulas for prime order elliptic curves
+//          (Renes, Costello, Batina)
+//     https://eprint.iacr.org/2015/1060.pdf
 
 // All group operations are performed using Jacobian coordinates.  For a given
 // (x, y) position on the curve, the Jacobian coordinates are (x1, y1, z1)
@@ -149,6 +153,81 @@ func (p *JacobianPoint) ToAffine() {
 	p.Y.Normalize()
 }
 
+// ToAffineNonConst reduces the Z value of the existing point to 1 effectively
+// making it an affine coordinate in *non-constant* time.  The point will be
+// normalized.
+//
+// NOTE: This is faster than ToAffine, but it MUST only be used when the point
+// does not depend on secret data such as when verifying signatures.
+func (p *JacobianPoint) ToAffineNonConst() {
+	var zInv, tempZ FieldVal
+	zInv.Set(&p.Z).InverseNonConst() // zInv = Z^-1
+	tempZ.SquareVal(&zInv)           // tempZ = Z^-2
+	p.X.Mul(&tempZ)                  // X = X/Z^2 (mag: 1)
+	p.Y.Mul(tempZ.Mul(&zInv))        // Y = Y/Z^3 (mag: 1)
+	p.Z.SetInt(1)                    // Z = 1 (mag: 1)
+
+	// Normalize the x and y values.
+	p.X.Normalize()
+	p.Y.Normalize()
+}
+
+// IsInfinity returns whether or not the Jacobian point is the point at infinity.
+//
+// NOTE: The point must be normalized for this function to return the correct
+// result.
+func (p *JacobianPoint) IsInfinity() bool {
+	return (p.X.IsZero() && p.Y.IsZero()) || p.Z.IsZero()
+}
+
+// Negate negates the Jacobian point in constant time.  That is to say p = -p.
+// The negation of the point at infinity is the point at infinity.
+//
+// NOTE: The point must be normalized for this function to return the correct
+// result.  The resulting point will be normalized.
+func (p *JacobianPoint) Negate() {
+	// Negating a point on the curve only requires negating its y coordinate
+	// since -(x, y) = (x, -y).  Since -0 is 0, this also leaves the point at
+	// infinity unchanged.
+	p.Y.Negate(1).Normalize()
+}
+
+// Equals returns whether or not the passed Jacobian point represents the same
+// point as this one in *non-constant* time.
+//
+// Unlike comparing the coordinates directly, this takes into account that the
+// same point has many representations in Jacobian coordinates and, unlike
+// converting both points to affine coordinates first, it does not require any
+// field inversions.
+//
+// NOTE: The points must be normalized for this function to return the correct
+// result.
+func (p *JacobianPoint) Equals(other *JacobianPoint) bool {
+	pInfinity, otherInfinity := p.IsInfinity(), other.IsInfinity()
+	if pInfinity || otherInfinity {
+		return pInfinity && otherInfinity
+	}
+
+	// The affine coordinates of the points are equal when:
+	//
+	// X1/Z1^2 == X2/Z2^2 and Y1/Z1^3 == Y2/Z2^3
+	//
+	// Multiplying both sides by the denominators gives:
+	//
+	// X1*Z2^2 == X2*Z1^2 and Y1*Z2^3 == Y2*Z1^3
+	var z1Squared, z2Squared, lhs, rhs FieldVal
+	z1Squared.SquareVal(&p.Z)
+	z2Squared.SquareVal(&other.Z)
+	lhs.Mul2(&p.X, &z2Squared).Normalize()
+	rhs.Mul2(&other.X, &z1Squared).Normalize()
+	if !lhs.Equals(&rhs) {
+		return false
+	}
+	lhs.Mul2(&p.Y, z2Squared.Mul(&other.Z)).Normalize()
+	rhs.Mul2(&other.Y, z1Squared.Mul(&p.Z)).Normalize()
+	return lhs.Equals(&rhs)
+}
+
 // addZ1AndZ2EqualsOne adds two Jacobian points that are already known to have
 // z values of 1 and stores the result in the provided result param.  That is to
 // say result = p1 + p2.  It performs faster addition than the generic add
@@ -459,11 +538,11 @@ func addGeneric(p1, p2, result *JacobianPoint) {
 func AddNonConst(p1, p2, result *JacobianPoint) {
 	// The point at infinity is the identity according to the group law for
 	// elliptic curve cryptography.  Thus, ∞ + P = P and P + ∞ = P.
-	if (p1.X.IsZero() && p1.Y.IsZero()) || p1.Z.IsZero() {
+	if p1.IsInfinity() {
 		result.Set(p2)
 		return
 	}
-	if (p2.X.IsZero() && p2.Y.IsZero()) || p2.Z.IsZero() {
+	if p2.IsInfinity() {
 		result.Set(p1)
 		return
 	}
@@ -492,6 +571,19 @@ func AddNonConst(p1, p2, result *JacobianPoint) {
 	addGeneric(p1, p2, result)
 }
 
+// SubNonConst subtracts the second passed Jacobian point from the first and
+// stores the result in the provided result param in *non-constant* time.  That
+// is to say result = p1 - p2.
+//
+// NOTE: The points must be normalized for this function to return the correct
+// result.  The resulting point will be normalized.
+func SubNonConst(p1, p2, result *JacobianPoint) {
+	var negP2 JacobianPoint
+	negP2.Set(p2)
+	negP2.Negate()
+	AddNonConst(p1, &negP2, result)
+}
+
 // doubleZ1EqualsOne performs point doubling on the passed Jacobian point when
 // the point is already known to have a z value of 1 and stores the result in
 // the provided result param.  That is to say result = 2*p.  It performs faster
@@ -1221,22 +1313,843 @@ func ScalarMultNonConst(k *ModNScalar, point, result *JacobianPoint) {
 //
 // NOTE: The resulting point will be normalized.
 func ScalarBaseMultNonConst(k *ModNScalar, result *JacobianPoint) {
-	bytePoints := s256BytePoints()
+	basePoints := s256BasePoints()
 
 	// Start with the point at infinity.
 	result.X.Zero()
 	result.Y.Zero()
 	result.Z.Zero()
 
-	// bytePoints has all 256 byte points for each 8-bit window.  The strategy
-	// is to add up the byte points.  This is best understood by expressing k in
-	// base-256 which it already sort of is.  Each "digit" in the 8-bit window
-	// can be looked up using bytePoints and added together.
-	kb := k.Bytes()
-	for i := 0; i < len(kb); i++ {
-		pt := &bytePoints[i][kb[i]]
-		AddNonConst(result, pt, result)
+	// basePoints has the multiples of the base point for each w-bit window.
+	// The strategy is to add up the multiples.  This is best understood by
+	// expressing k in base 2^w, which it already sort of is.  Each "digit" in
+	// the w-bit window can be looked up using basePoints and added together.
+	//
+	// When the table only houses the multiples for signed digits, the scalar is
+	// first negated as needed so that it is less than 2^255, which ensures the
+	// most significant signed digit is in range, and the result is negated
+	// accordingly to compensate.
+	var negate bool
+	kk := *k
+	if baseSignedDigits && kk.IsOverHalfOrder() {
+		kk.Negate()
+		negate = true
+	}
+	digits := baseWindowDigits(&kk, basePointWindowBits)
+	for i := 0; i < baseNumWindows; i++ {
+		digit := digits[baseNumWindows-1-i]
+		if digit < 0 {
+			SubNonConst(result, &basePoints[i][-digit], result)
+			continue
+		}
+		AddNonConst(result, &basePoints[i][digit], result)
+	}
+	if negate {
+		result.Negate()
+	}
+}
+
+// baseWindowDigits returns the digits of the passed scalar in base 2^w in
+// little-endian order for use with the pre-computed table used to accelerate
+// scalar base multiplication.  The window size MUST be 4 or 8.
+//
+// When the table only houses the multiples for signed digits, the digits are
+// recoded to be in the range [-2^(w-1)+1, 2^(w-1)] by carrying 1 into the next
+// window whenever a digit exceeds 2^(w-1).  The scalar MUST be less than 2^255
+// in that case to ensure there is no carry out of the most significant digit.
+//
+// This function is constant time.
+func baseWindowDigits(k *ModNScalar, w uint) [256 / 4]int32 {
+	var digits [256 / 4]int32
+	var carry uint32
+	for i := uint(0); i < 256/w; i++ {
+		digit := scalarBits(k, i*w, w) + carry
+		if baseSignedDigits {
+			carry = constantTimeGreater(digit, 1<<(w-1))
+		}
+		digits[i] = int32(digit) - int32(carry<<w)
+	}
+	return digits
+}
+
+// wnafMaxDigits is the maximum number of digits in the width-w non-adjacent
+// form of a scalar.  The representation can be up to 1 digit longer than the
+// normal binary encoding of the value.
+const wnafMaxDigits = 257
+
+// wnafPointWindow is the window size used for the width-w non-adjacent form of
+// the scalars that multiply an arbitrary point in the double scalar
+// multiplication.  The odd multiples of the point up to 2^(w-1) - 1 are
+// computed on the fly, so the table is kept small.
+const wnafPointWindow = 5
+
+// wnafBaseWindow is the window size used for the width-w non-adjacent form of
+// the scalars that multiply the base point in the double scalar multiplication.
+// The odd multiples of the base point up to 2^(w-1) - 1 are taken directly from
+// the pre-computed base points, so the window is as wide as the table allows.
+// That is 9 with the default 8-bit windows where the table houses the multiples
+// up to 255.
+const wnafBaseWindow = basePointWindowBits + 1 - baseDigitSignBits
+
+// scalarBits returns count bits of the passed scalar starting at the provided
+// bit offset, where count is at most 31.  Bits beyond the end of the scalar are
+// treated as zero.
+func scalarBits(s *ModNScalar, offset, count uint) uint32 {
+	word, shift := offset/32, offset%32
+	var v uint32
+	if word < uint(len(s.n)) {
+		v = s.n[word] >> shift
+	}
+	if shift+count > 32 && word+1 < uint(len(s.n)) {
+		v |= s.n[word+1] << (32 - shift)
+	}
+	return v & (1<<count - 1)
+}
+
+// wnaf returns the width-w non-adjacent form (wNAF) of the passed scalar along
+// with the number of digits up to and including the most significant nonzero
+// digit.  The digits are in little-endian order.
+//
+// The wNAF of a positive integer k is an expression k = ∑_(i=0, l-1) k_i * 2^i
+// where each nonzero digit k_i is odd and |k_i| < 2^(w-1), and at most one of
+// any w consecutive digits is nonzero.  See algorithm 3.35 in [GECC].
+//
+// This is useful for scalar multiplication with a variable base point because,
+// in exchange for precomputing 2^(w-2) odd multiples of the point, only 1/(w+1)
+// of the digits are nonzero on average, which minimizes the number of required
+// point additions.
+//
+// This function is NOT constant time.
+func wnaf(k *ModNScalar, w uint) ([wnafMaxDigits]int16, int) {
+	// Rather than repeatedly subtracting the digit from k and halving it, this
+	// scans the bits from least to most significant while tracking a carry
+	// that represents the borrow from a negative digit.  A bit equal to the
+	// carry results in a zero digit.  Otherwise, the next w bits plus the
+	// carry form an odd digit that is made negative when it is at least
+	// 2^(w-1) by subtracting 2^w and carrying 1 into the next window.
+	var digits [wnafMaxDigits]int16
+	var carry uint32
+	var numDigits int
+	for bit := uint(0); bit < wnafMaxDigits; {
+		if scalarBits(k, bit, 1) == carry {
+			bit++
+			continue
+		}
+
+		now := w
+		if now > wnafMaxDigits-bit {
+			now = wnafMaxDigits - bit
+		}
+		digit := scalarBits(k, bit, now) + carry
+		carry = (digit >> (w - 1)) & 1
+		digits[bit] = int16(int32(digit) - int32(carry<<w))
+		numDigits = int(bit) + 1
+		bit += now
+	}
+	return digits, numDigits
+}
+
+// addSignedNonConst adds the passed point, or its negation when the negate flag
+// is set, to the result in *non-constant* time.
+//
+// NOTE: The points must be normalized for this function to return the correct
+// result.  The resulting point will be normalized.
+func addSignedNonConst(p *JacobianPoint, negate bool, result *JacobianPoint) {
+	if !negate {
+		AddNonConst(result, p, result)
+		return
+	}
+	SubNonConst(result, p, result)
+}
+
+// batchToAffineNonConst reduces the Z values of all of the passed points to 1
+// in *non-constant* time using a single field inversion, effectively making
+// them affine coordinates.  Points at infinity are left unmodified.  The points
+// will be normalized.
+//
+// This uses Montgomery's trick, which replaces the inversion of each Z value
+// with a single inversion of their product followed by 3 field multiplications
+// per point to recover the individual inverses.
+func batchToAffineNonConst(points []JacobianPoint) {
+	if len(points) == 0 {
+		return
+	}
+
+	// Calculate the running products of the Z values while treating the Z
+	// values of points at infinity as one so they do not affect the others.
+	//
+	// prods[i] = Z[0] * Z[1] * ... * Z[i]
+	var one FieldVal
+	one.SetInt(1)
+	zVal := func(p *JacobianPoint) *FieldVal {
+		if p.IsInfinity() {
+			return &one
+		}
+		return &p.Z
+	}
+
+	// Use a fixed-size buffer that is large enough to avoid allocating for the
+	// tables used by the multi-scalar multiplication with small numbers of
+	// points.
+	var prodsBuf [msmStackPoints * straussTableSize]FieldVal
+	prods := prodsBuf[:]
+	if len(points) > len(prodsBuf) {
+		prods = make([]FieldVal, len(points))
+	}
+	prods[0].Set(zVal(&points[0]))
+	for i := 1; i < len(points); i++ {
+		prods[i].Mul2(&prods[i-1], zVal(&points[i])).Normalize()
+	}
+
+	// Invert the product of all of the Z values and work backwards to recover
+	// the inverse of each individual Z value.
+	//
+	// Z[i]^-1 = (Z[0] * ... * Z[i])^-1 * (Z[0] * ... * Z[i-1])
+	// (Z[0] * ... * Z[i-1])^-1 = (Z[0] * ... * Z[i])^-1 * Z[i]
+	var inv, zInv, zInv2 FieldVal
+	inv.Set(&prods[len(points)-1]).InverseNonConst()
+	for i := len(points) - 1; i >= 0; i-- {
+		p := &points[i]
+		z := zVal(p)
+		if z == &one {
+			continue
+		}
+		if i > 0 {
+			zInv.Mul2(&inv, &prods[i-1])
+			inv.Mul(z).Normalize()
+		} else {
+			zInv.Set(&inv)
+		}
+
+		zInv2.SquareVal(&zInv)
+		p.X.Mul(&zInv2).Normalize()           // X = X/Z^2 (mag: 1)
+		p.Y.Mul(zInv2.Mul(&zInv)).Normalize() // Y = Y/Z^3 (mag: 1)
+		p.Z.SetInt(1)                         // Z = 1 (mag: 1)
+	}
+}
+
+// wnafTerm houses the width-w NAF digits of a scalar along with a table of
+// multiples of the point it multiplies for use with interleaved scalar
+// multiplication.
+type wnafTerm struct {
+	// digits houses the wNAF digits of the scalar and numDigits is the number
+	// of them up to and including the most significant nonzero digit.
+	digits    [wnafMaxDigits]int16
+	numDigits int
+
+	// table houses multiples of the point.  When oddOnly is set, it only
+	// houses the odd multiples P, 3P, 5P, ... such that the multiple for a
+	// digit d is at index |d|/2.  Otherwise, it houses all of the multiples
+	// 0, P, 2P, ... such that the multiple for a digit d is at index |d|.
+	table   []JacobianPoint
+	oddOnly bool
+
+	// negate specifies whether or not the point is to be negated.
+	negate bool
+}
+
+// interleavedMultNonConst calculates the sum of the products of the scalars
+// and points described by the passed terms in *non-constant* time and stores
+// the result in the provided Jacobian point.
+//
+// This uses the interleaving method, also referred to as Strauss' or Shamir's
+// trick, which shares the point doublings among all of the terms.  See
+// algorithm 3.51 in [GECC].
+//
+// NOTE: The table entries must be normalized for this function to return the
+// correct result.  The resulting point will be normalized.
+func interleavedMultNonConst(terms []wnafTerm, result *JacobianPoint) {
+	var numDigits int
+	for i := range terms {
+		if terms[i].numDigits > numDigits {
+			numDigits = terms[i].numDigits
+		}
+	}
+
+	// Add left-to-right using the wNAF digits.
+	//
+	// Point Q = ∞ (point at infinity).
+	var q JacobianPoint
+	for i := numDigits - 1; i >= 0; i-- {
+		// Q = 2 * Q
+		DoubleNonConst(&q, &q)
+
+		// Add or subtract the multiple of each point that corresponds to the
+		// signed digit of its scalar at this position.
+		for j := range terms {
+			term := &terms[j]
+			digit, negate := term.digits[i], term.negate
+			if digit == 0 {
+				continue
+			}
+			if digit < 0 {
+				digit, negate = -digit, !negate
+			}
+			idx := int(digit)
+			if term.oddOnly {
+				idx >>= 1
+			}
+			addSignedNonConst(&term.table[idx], negate, &q)
+		}
+	}
+
+	result.Set(&q)
+}
+
+// splitKPositive decomposes the passed scalar via the endomorphism into k1 and
+// k2 such that k ≡ k1 + k2*λ (mod n) and returns them along with flags that
+// indicate whether or not they were negated in order to minimize their bit
+// lengths.
+//
+// In other words, when the returned flag for k1 is set, k1*P must be
+// calculated as k1*-P to compensate and likewise for k2.
+func splitKPositive(k *ModNScalar) (ModNScalar, ModNScalar, bool, bool) {
+	k1, k2 := splitK(k)
+	k1Neg, k2Neg := k1.IsOverHalfOrder(), k2.IsOverHalfOrder()
+	if k1Neg {
+		k1.Negate()
+	}
+	if k2Neg {
+		k2.Negate()
+	}
+	return k1, k2, k1Neg, k2Neg
+}
+
+// splitWords splits the passed scalar into the low words below the provided
+// word index and the remaining high words such that k = lo + hi*2^(32*word).
+func splitWords(k *ModNScalar, word int) (ModNScalar, ModNScalar) {
+	var lo, hi ModNScalar
+	copy(lo.n[:word], k.n[:word])
+	copy(hi.n[:], k.n[word:])
+	return lo, hi
+}
+
+// setBaseTerms sets the passed terms to multiply the base point by the passed
+// scalar by splitting it into as many equal length parts as there are terms.
+// The number of terms MUST be 1, 2, 4, or 8.
+//
+// The multiples of the base point multiplied by 2^(32*i) for i in [0, 7] are
+// already available in the pre-computed base points, which allows a wide
+// window to be used for all of them without any additional computation.
+func setBaseTerms(k *ModNScalar, terms []wnafTerm) {
+	basePoints := s256BasePoints()
+	wordsPerTerm := len(k.n) / len(terms)
+	for i := range terms {
+		var part ModNScalar
+		copy(part.n[:wordsPerTerm], k.n[i*wordsPerTerm:])
+
+		// Each window covers basePointWindowBits bits and they are in
+		// big-endian order, so the table for the part that starts at bit
+		// 32*wordsPerTerm*i is at the window that many bits from the end.
+		startBit := 32 * wordsPerTerm * i
+		term := &terms[i]
+		term.digits, term.numDigits = wnaf(&part, wnafBaseWindow)
+		term.table = basePoints[baseNumWindows-1-startBit/basePointWindowBits][:]
+		term.oddOnly = false
+		term.negate = false
+	}
+}
+
+// DoubleScalarMultNonConst multiplies u1*G + u2*P where u1 and u2 are scalars
+// modulo the curve order, G is the base point of the group, and P is a point in
+// Jacobian projective coordinates and stores the result in the provided
+// Jacobian point.
+//
+// This is significantly faster than calculating the two products separately
+// and adding them since it shares the point doublings between the two
+// multiplications.  It is primarily intended to accelerate signature
+// verification.
+//
+// NOTE: The point must be normalized for this function to return the correct
+// result.  The resulting point will be normalized.
+func DoubleScalarMultNonConst(u1, u2 *ModNScalar, point, result *JacobianPoint) {
+	// This interleaves the multiplications with width-w NAF representations of
+	// all of the scalars.
+	//
+	// Similar to ScalarMultNonConst, u2 is decomposed via the endomorphism
+	// such that u2*P = k1*P + k2*φ(P) where k1 and k2 are around half the bit
+	// length of u2.
+	//
+	// Since there is no need to compute λ for the base point, u1 is instead
+	// simply split into its low and high 128 bits such that:
+	//
+	// u1*G = u1Lo*G + u1Hi*(2^128*G)
+	//
+	// All four half-length multiplications are then performed simultaneously
+	// which means only around 128 point doublings are needed in total.
+
+	// Compute the odd multiples P, 3P, 5P, ..., (2^(w-1)-1)P of the point along
+	// with their images under the endomorphism.
+	//
+	// NOTE: φ(x,y) = (β*x,y).  The Jacobian z coordinates are the same, so this
+	// math goes through.
+	const tableSize = 1 << (wnafPointWindow - 2)
+	var pTable, phiTable [tableSize]JacobianPoint
+	var twoP JacobianPoint
+	pTable[0].Set(point)
+	DoubleNonConst(point, &twoP)
+	for i := 1; i < tableSize; i++ {
+		AddNonConst(&pTable[i-1], &twoP, &pTable[i])
+	}
+
+	// Convert the odd multiples to affine coordinates since the cost of doing
+	// so with a single inversion is more than recovered by the cheaper point
+	// additions that are possible when the z coordinate is one.
+	batchToAffineNonConst(pTable[:])
+	for i := 0; i < tableSize; i++ {
+		phiTable[i].Set(&pTable[i])
+		phiTable[i].X.Mul(endoBeta).Normalize()
+	}
+
+	var terms [4]wnafTerm
+	k1, k2, k1Neg, k2Neg := splitKPositive(u2)
+	terms[0].digits, terms[0].numDigits = wnaf(&k1, wnafPointWindow)
+	terms[0].table, terms[0].oddOnly, terms[0].negate = pTable[:], true, k1Neg
+	terms[1].digits, terms[1].numDigits = wnaf(&k2, wnafPointWindow)
+	terms[1].table, terms[1].oddOnly, terms[1].negate = phiTable[:], true, k2Neg
+	setBaseTerms(u1, terms[2:])
+	interleavedMultNonConst(terms[:], result)
+}
+
+// ScalarBaseMult multiplies k*G where k is a scalar modulo the curve order and
+// G is the base point of the group and stores the result in the provided
+// Jacobian point.
+//
+// Unlike ScalarBaseMultNonConst, the sequence of operations and memory accesses
+// performed does not depend on the value of the scalar, which makes it
+// suitable for use with secret scalars such as private keys and nonces.
+//
+// NOTE: The resulting point will be normalized.
+func ScalarBaseMult(k *ModNScalar, result *JacobianPoint) {
+	var initial projectivePoint
+	initial.setInfinity()
+	scalarBaseMult(k, &initial, result)
+}
+
+// scalarBaseMult multiplies k*G in constant time where k is a scalar modulo the
+// curve order and G is the base point of the group, adds the result to the
+// passed initial point, and stores the sum in the provided Jacobian point.
+//
+// This allows blinding to be applied via the initial point.  See Context.
+//
+// NOTE: The initial point must be normalized for this function to return the
+// correct result.  The resulting point will be normalized.
+func scalarBaseMult(k *ModNScalar, initial *projectivePoint, result *JacobianPoint) {
+	basePoints := s256BasePoints()
+
+	// This uses a fixed-window method with 4-bit windows over the same
+	// precomputed table used by ScalarBaseMultNonConst.
+	//
+	// Recall that the table houses the multiples of the base point for each
+	// w-bit window of the scalar, where w is either 4 or 8, so it necessarily
+	// also houses the multiples for each of the 4-bit windows that make up each
+	// w-bit window.  Concretely, the multiples for the 4-bit window that starts
+	// at bit offset s within w-bit window i are at basePoints[i][v<<s].
+	//
+	// Using 4-bit windows instead of 8-bit windows means that twice as many
+	// point additions are performed, but only 16 entries per window have to be
+	// scanned in order to select the entry for the digit without the memory
+	// access pattern depending on it versus 256 entries per window, which more
+	// than makes up for it.
+	//
+	// When the table only houses the multiples for signed digits, the scalar is
+	// recoded into signed digits in [-7, 8], so only 8 entries per window have
+	// to be scanned and the selected entry is then negated in constant time as
+	// needed.  Similar to ScalarBaseMultNonConst, the scalar is negated in
+	// constant time when needed to ensure it is less than 2^255 and the result
+	// is negated accordingly to compensate.  The initial point is negated along
+	// with it so that the compensation leaves it unchanged.
+	//
+	// Every window results in a point addition, even when the digit is zero,
+	// and the addition makes use of complete formulas in projective
+	// coordinates, so there are no branches that depend on the scalar either.
+	const windowBits = 4
+	const maxDigit = 1<<(windowBits-baseDigitSignBits) - 1 + baseDigitSignBits
+	var negate uint32
+	kk := *k
+	if baseSignedDigits {
+		negate = kk.isOverHalfOrderBit()
+		kk.condNegate(negate)
+	}
+	digits := baseWindowDigits(&kk, windowBits)
+	kk.Zero()
+
+	var q, pt projectivePoint
+	q = *initial
+	if baseSignedDigits {
+		q.condNegate(negate)
+	}
+	for i := 0; i < 256/windowBits; i++ {
+		offset := i * windowBits
+		window := &basePoints[baseNumWindows-1-offset/basePointWindowBits]
+		shift := uint(offset % basePointWindowBits)
+
+		// Determine the magnitude and sign of the digit in constant time.
+		sign := uint32(digits[i]) >> 31
+		digit := (uint32(digits[i]) ^ -sign) + sign
+
+		// Select the multiple of the base point that corresponds to the digit,
+		// or the point at infinity when it is zero, by scanning all of the
+		// candidate entries.
+		//
+		// Note that the entries are all affine, so the z coordinate is simply 1
+		// for all nonzero digits and 0 for the point at infinity.
+		pt.setInfinity()
+		for v := uint32(1); v <= maxDigit; v++ {
+			entry := &window[v<<shift]
+			isDigit := constantTimeEq(v, digit)
+			pt.x.cmov(&entry.X, isDigit)
+			pt.y.cmov(&entry.Y, isDigit)
+		}
+		pt.z.SetInt(uint16(constantTimeNotEq(digit, 0)))
+		if baseSignedDigits {
+			pt.condNegate(sign)
+		}
+		addProjective(&q, &pt, &q)
+	}
+	for i := range digits {
+		digits[i] = 0
+	}
+	if baseSignedDigits {
+		q.condNegate(negate)
+	}
+
+	q.toJacobian(result)
+}
+
+// projectivePoint is an element of the group formed by the secp256k1 curve in
+// homogeneous projective coordinates.  For a given (x, y) position on the
+// curve, the projective coordinates are (X, Y, Z) where x = X/Z and y = Y/Z.
+// The point at infinity is represented as (0, 1, 0).
+//
+// It is only used internally by the constant-time scalar multiplication
+// routines because it admits complete addition formulas which produce the
+// correct result for all inputs, including the point at infinity and doubling,
+// without requiring any branches.
+type projectivePoint struct {
+	x, y, z FieldVal
+}
+
+// setInfinity sets the projective point to the point at infinity.
+func (p *projectivePoint) setInfinity() {
+	p.x.Zero()
+	p.y.SetInt(1)
+	p.z.Zero()
+}
+
+// fromJacobian sets the projective point to the passed point in Jacobian
+// coordinates in constant time.  The point at infinity is handled.
+//
+// NOTE: The Jacobian point must be normalized for this function to return the
+// correct result.  The resulting point will be normalized.
+func (p *projectivePoint) fromJacobian(point *JacobianPoint) {
+	// Jacobian coordinates (X, Y, Z) represent the affine point (X/Z^2, Y/Z^3)
+	// which is (X*Z/Z^3, Y/Z^3) and therefore the projective point
+	// (X*Z, Y, Z^3).
+	var x, y, z FieldVal
+	x.Mul2(&point.X, &point.Z).Normalize()
+	y.Set(&point.Y)
+	z.SquareVal(&point.Z).Mul(&point.Z).Normalize()
+
+	// Map both representations of the point at infinity in Jacobian
+	// coordinates to (0, 1, 0) in constant time.
+	isInfinity := point.Z.IsZeroBit() |
+		(point.X.IsZeroBit() & point.Y.IsZeroBit())
+	var zero, one FieldVal
+	one.SetInt(1)
+	p.x.Set(&x).cmov(&zero, isInfinity)
+	p.y.Set(&y).cmov(&one, isInfinity)
+	p.z.Set(&z).cmov(&zero, isInfinity)
+}
+
+// toJacobian converts the projective point to Jacobian coordinates and stores
+// the result in the provided Jacobian point in constant time.  The point at
+// infinity is mapped to (0, 0, 0).
+//
+// NOTE: The resulting point will be normalized.
+func (p *projectivePoint) toJacobian(result *JacobianPoint) {
+	// Projective coordinates (X, Y, Z) represent the affine point (X/Z, Y/Z)
+	// which is (X*Z/Z^2, Y*Z^2/Z^3) and therefore the Jacobian point
+	// (X*Z, Y*Z^2, Z).
+	var zz FieldVal
+	zz.SquareVal(&p.z)
+	result.X.Mul2(&p.x, &p.z).Normalize()
+	result.Y.Mul2(&p.y, &zz).Normalize()
+	result.Z.Set(&p.z).Normalize()
+}
+
+// cmov conditionally sets the projective point to the passed point in constant
+// time when the provided flag is 1 and leaves it unmodified when the flag is 0.
+// The flag MUST be either 0 or 1.
+func (p *projectivePoint) cmov(other *projectivePoint, flag uint32) {
+	p.x.cmov(&other.x, flag)
+	p.y.cmov(&other.y, flag)
+	p.z.cmov(&other.z, flag)
+}
+
+// condNegate negates the projective point in constant time when the provided
+// flag is 1 and leaves it unmodified when the flag is 0.  The flag MUST be
+// either 0 or 1.
+//
+// NOTE: The point must be normalized for this function to return the correct
+// result.  The resulting point will be normalized.
+func (p *projectivePoint) condNegate(flag uint32) {
+	var negY FieldVal
+	negY.NegateVal(&p.y, 1).Normalize()
+	p.y.cmov(&negY, flag)
+}
+
+// addProjective adds the passed projective points together and stores the
+// result in the provided result param in constant time.  That is to say result
+// = p1 + p2.
+//
+// The formulas are complete, meaning they produce the correct result for all
+// pairs of points on the curve, including when either point is the point at
+// infinity, when the points are equal, and when they are the negation of each
+// other.
+//
+// NOTE: The points must be normalized for this function to return the correct
+// result.  The resulting point will be normalized.
+func addProjective(p1, p2, result *projectivePoint) {
+	// This uses algorithm 7 from [RCB] which is specialized for short
+	// Weierstrass curves with a = 0.  It requires 12 field multiplications and
+	// 2 multiplications by the constant 3b = 21.
+	//
+	// The magnitudes are tracked in the comments below and the intermediate
+	// values are normalized as needed to respect the maximum magnitude of 8
+	// for the inputs to field multiplication.
+	var t0, t1, t2, t3, t4, x3, y3, z3 FieldVal
+	t0.Mul2(&p1.x, &p2.x)             // t0 = X1*X2 (mag: 1)
+	t1.Mul2(&p1.y, &p2.y)             // t1 = Y1*Y2 (mag: 1)
+	t2.Mul2(&p1.z, &p2.z)             // t2 = Z1*Z2 (mag: 1)
+	t3.Add2(&p1.x, &p1.y)             // t3 = X1+Y1 (mag: 2)
+	t4.Add2(&p2.x, &p2.y)             // t4 = X2+Y2 (mag: 2)
+	t3.Mul(&t4)                       // t3 = t3*t4 (mag: 1)
+	t4.Add2(&t0, &t1)                 // t4 = t0+t1 (mag: 2)
+	t3.Add(t4.Negate(2))              // t3 = t3-t4 (mag: 4)
+	t4.Add2(&p1.y, &p1.z)             // t4 = Y1+Z1 (mag: 2)
+	x3.Add2(&p2.y, &p2.z)             // X3 = Y2+Z2 (mag: 2)
+	t4.Mul(&x3)                       // t4 = t4*X3 (mag: 1)
+	x3.Add2(&t1, &t2)                 // X3 = t1+t2 (mag: 2)
+	t4.Add(x3.Negate(2))              // t4 = t4-X3 (mag: 4)
+	x3.Add2(&p1.x, &p1.z)             // X3 = X1+Z1 (mag: 2)
+	y3.Add2(&p2.x, &p2.z)             // Y3 = X2+Z2 (mag: 2)
+	x3.Mul(&y3)                       // X3 = X3*Y3 (mag: 1)
+	y3.Add2(&t0, &t2)                 // Y3 = t0+t2 (mag: 2)
+	y3.Negate(2).Add(&x3).Normalize() // Y3 = X3-Y3 (mag: 1)
+	x3.Set(&t0).MulInt(2)             // X3 = t0+t0 (mag: 2)
+	t0.Add(&x3)                       // t0 = X3+t0 (mag: 3)
+	t2.MulInt(21).Normalize()         // t2 = b3*t2 (mag: 1)
+	z3.Add2(&t1, &t2)                 // Z3 = t1+t2 (mag: 2)
+	t1.Add(t2.Negate(1))              // t1 = t1-t2 (mag: 3)
+	y3.MulInt(21).Normalize()         // Y3 = b3*Y3 (mag: 1)
+	x3.Mul2(&t4, &y3)                 // X3 = t4*Y3 (mag: 1)
+	t2.Mul2(&t3, &t1)                 // t2 = t3*t1 (mag: 1)
+	x3.Negate(1).Add(&t2)             // X3 = t2-X3 (mag: 3)
+	y3.Mul(&t0)                       // Y3 = Y3*t0 (mag: 1)
+	t1.Mul(&z3)                       // t1 = t1*Z3 (mag: 1)
+	y3.Add(&t1)                       // Y3 = t1+Y3 (mag: 2)
+	t0.Mul(&t3)                       // t0 = t0*t3 (mag: 1)
+	z3.Mul(&t4)                       // Z3 = Z3*t4 (mag: 1)
+	z3.Add(&t0)                       // Z3 = Z3+t0 (mag: 2)
+
+	// Normalize the resulting field values as needed.
+	result.x.Set(x3.Normalize())
+	result.y.Set(y3.Normalize())
+	result.z.Set(z3.Normalize())
+}
+
+// doubleProjective doubles the passed projective point and stores the result in
+// the provided result parameter in constant time.  That is to say result = 2*p.
+// The point at infinity is handled.
+//
+// NOTE: The point must be normalized for this function to return the correct
+// result.  The resulting point will be normalized.
+func doubleProjective(p, result *projectivePoint) {
+	// This uses algorithm 9 from [RCB] which is specialized for short
+	// Weierstrass curves with a = 0.  It requires 6 field multiplications, 2
+	// field squarings, and 1 multiplication by the constant 3b = 21.
+	var t0, t1, t2, x3, y3, z3 FieldVal
+	t0.SquareVal(&p.y)        // t0 = Y*Y (mag: 1)
+	z3.Set(&t0).MulInt(8)     // Z3 = 8*t0 (mag: 8)
+	t1.Mul2(&p.y, &p.z)       // t1 = Y*Z (mag: 1)
+	t2.SquareVal(&p.z)        // t2 = Z*Z (mag: 1)
+	t2.MulInt(21).Normalize() // t2 = b3*t2 (mag: 1)
+	x3.Mul2(&t2, &z3)         // X3 = t2*Z3 (mag: 1)
+	y3.Add2(&t0, &t2)         // Y3 = t0+t2 (mag: 2)
+	z3.Mul(&t1)               // Z3 = t1*Z3 (mag: 1)
+	t2.MulInt(3)              // t2 = 3*t2 (mag: 3)
+	t0.Add(t2.Negate(3))      // t0 = t0-t2 (mag: 5)
+	y3.Mul(&t0)               // Y3 = t0*Y3 (mag: 1)
+	y3.Add(&x3)               // Y3 = X3+Y3 (mag: 2)
+	t1.Mul2(&p.x, &p.y)       // t1 = X*Y (mag: 1)
+	x3.Mul2(&t0, &t1)         // X3 = t0*t1 (mag: 1)
+	x3.MulInt(2)              // X3 = X3+X3 (mag: 2)
+
+	// Normalize the resulting field values as needed.
+	result.x.Set(x3.Normalize())
+	result.y.Set(y3.Normalize())
+	result.z.Set(z3.Normalize())
+}
+
+// ctWindowBits is the number of bits in each of the fixed windows used by the
+// constant-time variable-base scalar multiplication.  The signed digits in
+// each window are in the range [-2^(ctWindowBits-1), 2^(ctWindowBits-1)].
+const ctWindowBits = 5
+
+// ctTableSize is the number of multiples of a point that are precomputed for
+// the constant-time variable-base scalar multiplication.  It includes the
+// point at infinity for the zero digit.
+const ctTableSize = 1<<(ctWindowBits-1) + 1
+
+// ctNumWindows is the number of windows used by the constant-time
+// variable-base scalar multiplication.  It is enough to cover the half-length
+// scalars produced by splitK along with the additional bit needed for the
+// signed digit recoding.
+const ctNumWindows = 26
+
+// boothWindow returns the signed digit for the window of the passed scalar
+// that starts at the provided window number.  The digit is returned as its
+// magnitude along with a flag that is 1 when the digit is negative.
+//
+// This uses the Booth recoding, where each window overlaps the most significant
+// bit of the previous window, which allows the digits to be computed
+// independently of each other in constant time.
+//
+// The window number is NOT secret and therefore may be used in branches.
+func boothWindow(k *ModNScalar, window int) (uint32, uint32) {
+	// Extract the ctWindowBits+1 bits that start one bit below the window,
+	// treating the bit below the first window as 0.
+	const windowMask = 1<<(ctWindowBits+1) - 1
+	var bits uint32
+	if window == 0 {
+		bits = (k.n[0] << 1) & windowMask
+	} else {
+		startBit := window*ctWindowBits - 1
+		wordNum, shift := startBit/32, uint(startBit%32)
+		bits = k.n[wordNum] >> shift
+		if shift > 32-(ctWindowBits+1) && wordNum < len(k.n)-1 {
+			bits |= k.n[wordNum+1] << (32 - shift)
+		}
+		bits &= windowMask
+	}
+
+	// With the extracted bits denoted b[w]..b[0], the signed digit is:
+	//
+	//   -2^(w-1)*b[w] + 2^(w-2)*b[w-1] + ... + b[1] + b[0]
+	//
+	// which is in the range [-2^(w-1), 2^(w-1)].  Its magnitude is obtained
+	// without branches by complementing the bits when the high bit is set and
+	// then adding the low bit to the remaining bits.
+	sign := bits >> ctWindowBits
+	mask := -sign
+	digit := ((windowMask - bits) & mask) | (bits &^ mask)
+	digit = (digit >> 1) + (digit & 1)
+	return digit, sign
+}
+
+// ctTableLookup sets the result to the entry of the passed table at the given
+// index in constant time by scanning every entry of the table.
+func ctTableLookup(table *[ctTableSize]projectivePoint, idx uint32, result *projectivePoint) {
+	result.setInfinity()
+	for i := range table {
+		result.cmov(&table[i], constantTimeEq(uint32(i), idx))
+	}
+}
+
+// ScalarMult multiplies k*P where k is a scalar modulo the curve order and P is
+// a point in Jacobian projective coordinates and stores the result in the
+// provided Jacobian point.
+//
+// Unlike ScalarMultNonConst, the sequence of operations and memory accesses
+// performed does not depend on the value of the scalar, which makes it
+// suitable for use with secret scalars such as those used in ECDH and key
+// tweaking.
+//
+// NOTE: The point must be normalized for this function to return the correct
+// result.  The resulting point will be normalized.
+func ScalarMult(k *ModNScalar, point, result *JacobianPoint) {
+	scalarMult(k, point, nil, result)
+}
+
+// scalarMult multiplies k*P in constant time where k is a scalar modulo the
+// curve order and P is a point in Jacobian projective coordinates and stores
+// the result in the provided Jacobian point.
+//
+// When the passed z blinding value is not nil, it MUST be a nonzero normalized
+// field value and the coordinates of the point are multiplied by it in
+// projective coordinates, which randomizes the representation of all of the
+// intermediate points without changing the result.  See Context.
+//
+// NOTE: The point must be normalized for this function to return the correct
+// result.  The resulting point will be normalized.
+func scalarMult(k *ModNScalar, point *JacobianPoint, zBlind *FieldVal, result *JacobianPoint) {
+	// This makes use of the same endomorphism as ScalarMultNonConst to decompose
+	// the scalar into two half-length scalars such that:
+	//
+	// k*P = k1*P + k2*φ(P)
+	//
+	// See the comments in ScalarMultNonConst for more details.
+	//
+	// However, instead of a NAF representation, which leaks the positions of
+	// the nonzero digits through the sequence of point additions, the
+	// half-length scalars are recoded into fixed windows of signed digits where
+	// exactly one table lookup and point addition is performed per window for
+	// each scalar regardless of the digit values.  The table lookups scan every
+	// entry and the point arithmetic makes use of complete formulas, so there
+	// are no branches or memory accesses that depend on the scalar.
+	//
+	// Also, rather than conditionally swapping the points based on whether or
+	// not the decomposed scalars were negated, the negation is folded into the
+	// sign of each digit in constant time.
+	k1, k2 := splitK(k)
+	k1Neg, k2Neg := k1.isOverHalfOrderBit(), k2.isOverHalfOrderBit()
+	k1.condNegate(k1Neg)
+	k2.condNegate(k2Neg)
+
+	// Precompute the multiples 0*P, 1*P, ..., 16*P along with the same multiples
+	// of φ(P).
+	//
+	// NOTE: φ(x,y) = (β*x,y).  The projective z coordinates are the same, so
+	// the multiples of φ(P) only require a single field multiplication each.
+	var p1Table, p2Table [ctTableSize]projectivePoint
+	p1Table[0].setInfinity()
+	p1Table[1].fromJacobian(point)
+	if zBlind != nil {
+		p1Table[1].x.Mul(zBlind).Normalize()
+		p1Table[1].y.Mul(zBlind).Normalize()
+		p1Table[1].z.Mul(zBlind).Normalize()
+	}
+	for i := 2; i < ctTableSize; i++ {
+		addProjective(&p1Table[i-1], &p1Table[1], &p1Table[i])
 	}
+	for i := 0; i < ctTableSize; i++ {
+		p2Table[i] = p1Table[i]
+		p2Table[i].x.Mul(endoBeta).Normalize()
+	}
+
+	// Add left-to-right by window.
+	var q, pt projectivePoint
+	q.setInfinity()
+	for window := ctNumWindows - 1; window >= 0; window-- {
+		for i := 0; i < ctWindowBits; i++ {
+			doubleProjective(&q, &q)
+		}
+
+		digit, sign := boothWindow(&k1, window)
+		ctTableLookup(&p1Table, digit, &pt)
+		pt.condNegate(sign ^ k1Neg)
+		addProjective(&q, &pt, &q)
+
+		digit, sign = boothWindow(&k2, window)
+		ctTableLookup(&p2Table, digit, &pt)
+		pt.condNegate(sign ^ k2Neg)
+		addProjective(&q, &pt, &q)
+	}
+	k1.Zero()
+	k2.Zero()
+
+	q.toJacobian(result)
 }
 
 // isOnCurve returns whether or not the affine point (x,y) is on the curve.
@@ -564,9 +564,15 @@ func TestScalarBaseMultJacobian(t *testing.T) {
 		}
 
 		// Ensure the result matches the expected value in Jacobian coordinates.
+		//
+		// Note that the exact Jacobian coordinates depend on the sequence of
+		// point additions, which differs when the pre-computed table is
+		// configured with non-default build tags, so only the affine result
+		// is checked in that case.
 		var r JacobianPoint
 		ScalarBaseMultNonConst(k, &r)
-		if !r.IsStrictlyEqual(&want) {
+		defaultTable := basePointWindowBits == 8 && !baseSignedDigits
+		if defaultTable && !r.IsStrictlyEqual(&want) {
 			t.Errorf("%q: wrong result:\ngot: (%s, %s, %s)\nwant: (%s, %s, %s)",
 				test.name, r.X, r.Y, r.Z, want.X, want.Y, want.Z)
 			continue
@@ -579,6 +585,41 @@ func TestScalarBaseMultJacobian(t *testing.T) {
 				test.name, r.X, r.Y, wantAffine.X, wantAffine.Y)
 			continue
 		}
+
+		// Ensure the constant-time version produces the same affine point.
+		var ctResult JacobianPoint
+		ScalarBaseMult(k, &ctResult)
+		if !isSameAffinePoint(&ctResult, &want) {
+			t.Errorf("%q: wrong constant-time result:\ngot: (%s, %s, %s)\n"+
+				"want: (%s, %s, %s)", test.name, ctResult.X, ctResult.Y,
+				ctResult.Z, want.X, want.Y, want.Z)
+			continue
+		}
+	}
+}
+
+// TestScalarBaseMultRandom ensures the constant-time base point multiplication
+// produces the same results as the variable-time version for
+// randomly-generated scalars.
+func TestScalarBaseMultRandom(t *testing.T) {
+	// Use a unique random seed each test instance and log it if the tests fail.
+	seed := time.Now().Unix()
+	rng := mrand.New(mrand.NewSource(seed))
+	defer func(t *testing.T, seed int64) {
+		if t.Failed() {
+			t.Logf("random seed: %d", seed)
+		}
+	}(t, seed)
+
+	for i := 0; i < 100; i++ {
+		var want, got JacobianPoint
+		k := randModNScalar(t, rng)
+		ScalarBaseMultNonConst(k, &want)
+		ScalarBaseMult(k, &got)
+		if !isSameAffinePoint(&got, &want) {
+			t.Fatalf("mismatched result for k=%v\ngot: (%v, %v, %v)\nwant: "+
+				"(%v, %v, %v)", k, got.X, got.Y, got.Z, want.X, want.Y, want.Z)
+		}
 	}
 }
 
@@ -835,6 +876,426 @@ func TestScalarMultJacobianRandom(t *testing.T) {
 	}
 }
 
+// isSameAffinePoint returns whether or not the two Jacobian points represent
+// the same affine point without modifying the provided points.
+func isSameAffinePoint(p1, p2 *JacobianPoint) bool {
+	var p1Affine, p2Affine JacobianPoint
+	p1Affine.Set(p1)
+	p2Affine.Set(p2)
+	p1IsInfinity := (p1Affine.X.IsZero() && p1Affine.Y.IsZero()) ||
+		p1Affine.Z.IsZero()
+	p2IsInfinity := (p2Affine.X.IsZero() && p2Affine.Y.IsZero()) ||
+		p2Affine.Z.IsZero()
+	if p1IsInfinity || p2IsInfinity {
+		return p1IsInfinity == p2IsInfinity
+	}
+	p1Affine.ToAffine()
+	p2Affine.ToAffine()
+	return p1Affine.IsStrictlyEqual(&p2Affine)
+}
+
+// TestJacobianPointNegateSubEquals ensures negating, subtracting, and comparing
+// Jacobian points works as expected for randomly-generated points including
+// the point at infinity and points with different Z values.
+func TestJacobianPointNegateSubEquals(t *testing.T) {
+	// Use a unique random seed each test instance and log it if the tests fail.
+	seed := time.Now().Unix()
+	rng := mrand.New(mrand.NewSource(seed))
+	defer func(t *testing.T, seed int64) {
+		if t.Failed() {
+			t.Logf("random seed: %d", seed)
+		}
+	}(t, seed)
+
+	var infinity JacobianPoint
+	if !infinity.IsInfinity() {
+		t.Fatal("zero value point is not the point at infinity")
+	}
+	for i := 0; i < 50; i++ {
+		// Generate two random points along with a copy of the first one that
+		// has a different Z value.
+		var p1, p1Affine, p2 JacobianPoint
+		ScalarBaseMultNonConst(randModNScalar(t, rng), &p1)
+		ScalarBaseMultNonConst(randModNScalar(t, rng), &p2)
+		p1Affine.Set(&p1)
+		p1Affine.ToAffine()
+		if p1.IsInfinity() || p1.Z.IsOne() {
+			t.Fatalf("unexpected point at infinity or affine point: %v", p1)
+		}
+		if !p1.Equals(&p1Affine) || !p1Affine.Equals(&p1) {
+			t.Fatalf("point does not equal its affine representation\n"+
+				"point: %v\naffine: %v", p1, p1Affine)
+		}
+		if p1.Equals(&p2) || p1.Equals(&infinity) || infinity.Equals(&p1) {
+			t.Fatalf("unexpected equality\np1: %v\np2: %v", p1, p2)
+		}
+
+		// Ensure P + (-P) = ∞ and -(-P) = P.
+		var negP1, sum JacobianPoint
+		negP1.Set(&p1)
+		negP1.Negate()
+		if negP1.Equals(&p1) {
+			t.Fatalf("negated point equals the original point: %v", p1)
+		}
+		AddNonConst(&p1, &negP1, &sum)
+		if !sum.IsInfinity() {
+			t.Fatalf("P + (-P) is not the point at infinity: %v", sum)
+		}
+		negP1.Negate()
+		if !negP1.Equals(&p1) {
+			t.Fatalf("double negation mismatch\ngot: %v\nwant: %v", negP1, p1)
+		}
+
+		// Ensure (P1 - P2) + P2 = P1, P - P = ∞, and ∞ - P = -P.
+		var diff JacobianPoint
+		SubNonConst(&p1, &p2, &diff)
+		AddNonConst(&diff, &p2, &sum)
+		if !sum.Equals(&p1) {
+			t.Fatalf("(P1 - P2) + P2 mismatch\ngot: %v\nwant: %v", sum, p1)
+		}
+		SubNonConst(&p1, &p1Affine, &diff)
+		if !diff.IsInfinity() {
+			t.Fatalf("P - P is not the point at infinity: %v", diff)
+		}
+		SubNonConst(&infinity, &p1, &diff)
+		negP1.Negate()
+		if !diff.Equals(&negP1) {
+			t.Fatalf("∞ - P mismatch\ngot: %v\nwant: %v", diff, negP1)
+		}
+	}
+}
+
+// TestAddDoubleProjective ensures the complete addition and doubling formulas
+// used with points in homogeneous projective coordinates produce the same
+// results as the Jacobian routines, including the exceptional cases that the
+// complete formulas are expected to handle without any special casing.
+func TestAddDoubleProjective(t *testing.T) {
+	var g, twoG, negG, infinity JacobianPoint
+	bigAffineToJacobian(curveParams.Gx, curveParams.Gy, &g)
+	DoubleNonConst(&g, &twoG)
+	negG.Set(&g)
+	negG.Y.Negate(1).Normalize()
+
+	tests := []struct {
+		name   string
+		p1, p2 *JacobianPoint
+	}{{
+		name: "G + 2G",
+		p1:   &g,
+		p2:   &twoG,
+	}, {
+		name: "G + G",
+		p1:   &g,
+		p2:   &g,
+	}, {
+		name: "G + -G",
+		p1:   &g,
+		p2:   &negG,
+	}, {
+		name: "∞ + G",
+		p1:   &infinity,
+		p2:   &g,
+	}, {
+		name: "2G + ∞",
+		p1:   &twoG,
+		p2:   &infinity,
+	}, {
+		name: "∞ + ∞",
+		p1:   &infinity,
+		p2:   &infinity,
+	}}
+
+	for _, test := range tests {
+		// Calculate the expected results via the Jacobian routines.
+		var wantSum, wantDouble JacobianPoint
+		AddNonConst(test.p1, test.p2, &wantSum)
+		DoubleNonConst(test.p1, &wantDouble)
+
+		// Ensure the complete formulas produce the same results.
+		var p1, p2, sum, double projectivePoint
+		p1.fromJacobian(test.p1)
+		p2.fromJacobian(test.p2)
+		addProjective(&p1, &p2, &sum)
+		doubleProjective(&p1, &double)
+		var gotSum, gotDouble JacobianPoint
+		sum.toJacobian(&gotSum)
+		double.toJacobian(&gotDouble)
+		if !isSameAffinePoint(&gotSum, &wantSum) {
+			t.Errorf("%q: wrong sum:\ngot: (%v, %v, %v)\nwant: (%v, %v, %v)",
+				test.name, gotSum.X, gotSum.Y, gotSum.Z, wantSum.X,
+				wantSum.Y, wantSum.Z)
+			continue
+		}
+		if !isSameAffinePoint(&gotDouble, &wantDouble) {
+			t.Errorf("%q: wrong double:\ngot: (%v, %v, %v)\nwant: (%v, %v, "+
+				"%v)", test.name, gotDouble.X, gotDouble.Y, gotDouble.Z,
+				wantDouble.X, wantDouble.Y, wantDouble.Z)
+			continue
+		}
+	}
+}
+
+// TestScalarMult ensures the constant-time scalar point multiplication produces
+// the expected results for edge cases such as zero, the group order minus one,
+// scalars that decompose into negative halves, and the point at infinity.
+func TestScalarMult(t *testing.T) {
+	var g JacobianPoint
+	bigAffineToJacobian(curveParams.Gx, curveParams.Gy, &g)
+	nMinusOne := new(ModNScalar).NegateVal(oneModN)
+	halfOrder := hexToModNScalar("7fffffffffffffffffffffffffffffff5d576e7357a4501ddfe92f46681b20a0")
+
+	tests := []struct {
+		name  string
+		k     *ModNScalar
+		point *JacobianPoint
+	}{{
+		name:  "zero",
+		k:     new(ModNScalar),
+		point: &g,
+	}, {
+		name:  "one",
+		k:     oneModN,
+		point: &g,
+	}, {
+		name:  "group order - 1",
+		k:     nMinusOne,
+		point: &g,
+	}, {
+		name:  "group half order",
+		k:     halfOrder,
+		point: &g,
+	}, {
+		name:  "group half order + 1",
+		k:     new(ModNScalar).Add2(halfOrder, oneModN),
+		point: &g,
+	}, {
+		name:  "lambda",
+		k:     endoLambda,
+		point: &g,
+	}, {
+		name:  "-lambda",
+		k:     endoNegLambda,
+		point: &g,
+	}, {
+		name:  "point at infinity",
+		k:     hexToModNScalar("d74bf844b0862475103d96a611cf2d898447e288d34b360bc885cb8ce7c00575"),
+		point: new(JacobianPoint),
+	}}
+
+	for _, test := range tests {
+		var want, got JacobianPoint
+		ScalarMultNonConst(test.k, test.point, &want)
+		ScalarMult(test.k, test.point, &got)
+		if !isSameAffinePoint(&got, &want) {
+			t.Errorf("%q: wrong result:\ngot: (%v, %v, %v)\nwant: (%v, %v, %v)",
+				test.name, got.X, got.Y, got.Z, want.X, want.Y, want.Z)
+			continue
+		}
+	}
+}
+
+// TestScalarMultRandom ensures the constant-time scalar point multiplication
+// produces the same results as the variable-time version for
+// randomly-generated scalars and points.
+func TestScalarMultRandom(t *testing.T) {
+	// Use a unique random seed each test instance and log it if the tests fail.
+	seed := time.Now().Unix()
+	rng := mrand.New(mrand.NewSource(seed))
+	defer func(t *testing.T, seed int64) {
+		if t.Failed() {
+			t.Logf("random seed: %d", seed)
+		}
+	}(t, seed)
+
+	for i := 0; i < 100; i++ {
+		// Generate a random point by multiplying the base point by a random
+		// scalar and then multiply it by another random scalar.
+		var point, want, got JacobianPoint
+		ScalarBaseMultNonConst(randModNScalar(t, rng), &point)
+		point.ToAffine()
+		k := randModNScalar(t, rng)
+		ScalarMultNonConst(k, &point, &want)
+		ScalarMult(k, &point, &got)
+		if !isSameAffinePoint(&got, &want) {
+			t.Fatalf("mismatched result for k=%v point=(%v, %v)\ngot: (%v, %v, "+
+				"%v)\nwant: (%v, %v, %v)", k, point.X, point.Y, got.X, got.Y,
+				got.Z, want.X, want.Y, want.Z)
+		}
+	}
+}
+
+// checkWNAFEncoding returns an error if the passed width-w NAF digits are not a
+// valid encoding of the original value.
+func checkWNAFEncoding(digits []int16, w uint, origValue *big.Int) error {
+	// Ensure all nonzero digits are odd and in range, and that there is at most
+	// one nonzero digit in any w consecutive digits.
+	lastNonZero := -int(w)
+	for i, digit := range digits {
+		if digit == 0 {
+			continue
+		}
+		if digit&1 == 0 {
+			return fmt.Errorf("even digit %d at pos %d", digit, i)
+		}
+		if limit := int16(1) << (w - 1); digit >= limit || digit <= -limit {
+			return fmt.Errorf("digit %d at pos %d out of range", digit, i)
+		}
+		if i-lastNonZero < int(w) {
+			return fmt.Errorf("non-zero digits at pos %d and %d", lastNonZero, i)
+		}
+		lastNonZero = i
+	}
+	if len(digits) > 0 && digits[len(digits)-1] == 0 {
+		return fmt.Errorf("most significant digit is zero")
+	}
+
+	// Ensure the digits sum back to the original value.
+	gotValue := new(big.Int)
+	for i := len(digits) - 1; i >= 0; i-- {
+		gotValue.Lsh(gotValue, 1)
+		gotValue.Add(gotValue, big.NewInt(int64(digits[i])))
+	}
+	if origValue.Cmp(gotValue) != 0 {
+		return fmt.Errorf("digits do not sum to original value: got %x, want "+
+			"%x", gotValue, origValue)
+	}
+
+	return nil
+}
+
+// TestWNAF ensures encoding various edge cases and random values to width-w
+// non-adjacent form produces valid results for the window sizes in use.
+func TestWNAF(t *testing.T) {
+	// Use a unique random seed each test instance and log it if the tests fail.
+	seed := time.Now().Unix()
+	rng := mrand.New(mrand.NewSource(seed))
+	defer func(t *testing.T, seed int64) {
+		if t.Failed() {
+			t.Logf("random seed: %d", seed)
+		}
+	}(t, seed)
+
+	tests := []string{
+		"0",
+		"1",
+		"ff",
+		"ffffffffffffffffffffffffffffffff",
+		"100000000000000000000000000000000",
+		"fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364140",
+		"c000000000000000000000000000000000000000000000000000000000000001",
+	}
+	for i := 0; i < 100; i++ {
+		_, modNVal := randIntAndModNScalar(t, rng)
+		tests = append(tests, modNVal.String())
+	}
+
+	for _, in := range tests {
+		k := hexToModNScalar(in)
+		kBytes := k.Bytes()
+		bigIntVal := new(big.Int).SetBytes(kBytes[:])
+		for _, w := range []uint{wnafPointWindow, wnafBaseWindow} {
+			digits, numDigits := wnaf(k, w)
+			err := checkWNAFEncoding(digits[:numDigits], w, bigIntVal)
+			if err != nil {
+				t.Fatalf("encoding err: %v\nin: %x\nw: %d\ndigits: %v", err,
+					bigIntVal, w, digits[:numDigits])
+			}
+		}
+	}
+}
+
+// TestDoubleScalarMultNonConst ensures the combined double scalar
+// multiplication produces the same results as computing the two products
+// separately and adding them for edge cases and randomly-generated scalars and
+// points.
+func TestDoubleScalarMultNonConst(t *testing.T) {
+	// Use a unique random seed each test instance and log it if the tests fail.
+	seed := time.Now().Unix()
+	rng := mrand.New(mrand.NewSource(seed))
+	defer func(t *testing.T, seed int64) {
+		if t.Failed() {
+			t.Logf("random seed: %d", seed)
+		}
+	}(t, seed)
+
+	var g, twoGNonAffine JacobianPoint
+	bigAffineToJacobian(curveParams.Gx, curveParams.Gy, &g)
+	DoubleNonConst(&g, &twoGNonAffine)
+	nMinusOne := new(ModNScalar).NegateVal(oneModN)
+	randScalar := hexToModNScalar("d74bf844b0862475103d96a611cf2d898447e288d34b360bc885cb8ce7c00575")
+
+	type testCase struct {
+		name   string
+		u1, u2 *ModNScalar
+		point  *JacobianPoint
+	}
+	tests := []testCase{{
+		name:  "both zero",
+		u1:    new(ModNScalar),
+		u2:    new(ModNScalar),
+		point: &g,
+	}, {
+		name:  "u1 zero",
+		u1:    new(ModNScalar),
+		u2:    randScalar,
+		point: &g,
+	}, {
+		name:  "u2 zero",
+		u1:    randScalar,
+		u2:    new(ModNScalar),
+		point: &g,
+	}, {
+		name:  "result is point at infinity",
+		u1:    randScalar,
+		u2:    new(ModNScalar).NegateVal(randScalar),
+		point: &g,
+	}, {
+		name:  "group order - 1",
+		u1:    nMinusOne,
+		u2:    nMinusOne,
+		point: &g,
+	}, {
+		name:  "lambda",
+		u1:    endoLambda,
+		u2:    endoLambda,
+		point: &g,
+	}, {
+		name:  "point with z != 1",
+		u1:    randScalar,
+		u2:    randScalar,
+		point: &twoGNonAffine,
+	}, {
+		name:  "point at infinity",
+		u1:    randScalar,
+		u2:    randScalar,
+		point: new(JacobianPoint),
+	}}
+	for i := 0; i < 100; i++ {
+		var point JacobianPoint
+		ScalarBaseMultNonConst(randModNScalar(t, rng), &point)
+		point.ToAffine()
+		tests = append(tests, testCase{
+			name:  fmt.Sprintf("random #%d", i),
+			u1:    randModNScalar(t, rng),
+			u2:    randModNScalar(t, rng),
+			point: &point,
+		})
+	}
+
+	for _, test := range tests {
+		var u1G, u2P, want, got JacobianPoint
+		ScalarBaseMultNonConst(test.u1, &u1G)
+		ScalarMultNonConst(test.u2, test.point, &u2P)
+		AddNonConst(&u1G, &u2P, &want)
+		DoubleScalarMultNonConst(test.u1, test.u2, test.point, &got)
+		if !isSameAffinePoint(&got, &want) {
+			t.Fatalf("%q: wrong result for u1=%v u2=%v\ngot: (%v, %v, %v)\n"+
+				"want: (%v, %v, %v)", test.name, test.u1, test.u2, got.X,
+				got.Y, got.Z, want.X, want.Y, want.Z)
+		}
+	}
+}
+
 // TestDecompressY ensures that decompressY works as expected for some edge
 // cases.
 func TestDecompressY(t *testing.T) {
@@ -24,12 +24,25 @@ An overview of the features provided by this package are as follows:
   - Serializes uncompressed and compressed public keys
   - Specialized types for performing optimized and constant time field operations
   - FieldVal type for working modulo the secp256k1 field prime
+  - 5x52-bit field representation on 64-bit platforms and 10x26-bit
+    representation elsewhere, selectable via build tags
+  - Optional amd64 assembly for field and scalar multiplication which may be
+    disabled via the purego build tag
   - ModNScalar type for working modulo the secp256k1 group order
   - Elliptic curve operations in Jacobian projective coordinates
   - Point addition
   - Point doubling
-  - Scalar multiplication with an arbitrary point
-  - Scalar multiplication with the base point (group generator)
+  - Scalar multiplication with an arbitrary point in constant and variable time
+  - Scalar multiplication with the base point (group generator) in constant and
+    variable time
+  - Combined double scalar multiplication (u1*G + u2*P) in variable time for
+    signature verification
+  - Multi-scalar multiplication (k1*P1 + k2*P2 + ... + kn*Pn) in variable time
+  - Batch conversion of points to affine coordinates with a single inversion
+  - Pre-computed table for scalar base multiplication whose size, load time,
+    and whether it is embedded are selectable via build tags
+  - Randomized contexts that blind the scalar multiplications involving private
+    keys and nonces as a countermeasure against side-channel analysis
   - Point decompression from a given x coordinate
   - Nonce generation via RFC6979 with support for extra data and version
     information that can be used to prevent nonce reuse between signing
@@ -50,11 +63,25 @@ https://www.secg.org/sec2-v2.pdf for details on the secp256k1 standard.
 
 It also provides functions to parse and serialize the ECDSA signatures with the
 more strict Distinguished Encoding Rules (DER) of ISO/IEC 8825-1 and some
-additional restrictions specific to secp256k1.
+additional restrictions specific to secp256k1.  ParseDERSignatureLax parses the
+loosely-encoded signatures found in historical Bitcoin data instead.
+
+Signatures may be verified with either Verify, or VerifyErr, which reports the
+specific reason verification failed, such as a public key that is not on the
+curve.  The Policy type allows signatures to be verified and public keys and
+signatures to be parsed under stricter or looser rules, such as requiring low S
+values per BIP0062 and BIP0146.
 
 In addition, it supports a custom "compact" signature format which allows
 efficient recovery of the public key from a given valid signature and message
-hash combination.
+hash combination.  The recovery codes of signatures in that format, or that are
+produced by Sign, also allow them to be verified as a batch via VerifyBatch,
+which is faster than verifying them individually by an amount that grows with
+the size of the batch.
+
+Finally, the SigCache type provides a bounded cache of valid signatures that may
+be shared among goroutines and signature schemes in order to avoid repeatedly
+verifying the same signatures.
 
 A comprehensive suite of tests is provided to ensure proper functionality and a
 high level of quality assurance.
@@ -11,13 +11,44 @@ package secp256k1
 //
 // It is recommended to securely hash the result before using as a cryptographic
 // key.
+//
+// The scalar multiplication involving the private key is performed in constant
+// time.  See GenerateSharedSecretWithOptions to additionally blind it.
 func GenerateSharedSecret(privkey *PrivateKey, pubkey *PublicKey) []byte {
+	return GenerateSharedSecretWithOptions(privkey, pubkey)
+}
+
+// GenerateSharedSecretWithOptions generates the same shared secret as
+// GenerateSharedSecret with the provided options applied.  A Context may be
+// provided via the WithContext option to blind the scalar multiplication
+// involving the private key.  The shared secret is the same either way.
+func GenerateSharedSecretWithOptions(privkey *PrivateKey, pubkey *PublicKey, opts ...Option) []byte {
+	var secret [32]byte
+	PutSharedSecret(&secret, privkey, pubkey, opts...)
+	return secret[:]
+}
+
+// PutSharedSecret generates the same shared secret as
+// GenerateSharedSecretWithOptions and stores it in the passed byte array
+// instead of allocating a new slice.
+func PutSharedSecret(secret *[32]byte, privkey *PrivateKey, pubkey *PublicKey, opts ...Option) {
+	o := applyOptions(opts)
 	var point, result JacobianPoint
 	pubkey.AsJacobian(&point)
-	ScalarMultNonConst(&privkey.Key, &point, &result)
+	o.ctx.ScalarMult(&privkey.Key, &point, &result)
 	result.ToAffine()
-	xBytes := result.X.Bytes()
-	return xBytes[:]
+	result.X.PutBytes(secret)
+}
+
+// AppendSharedSecret appends the same shared secret
+// GenerateSharedSecretWithOptions generates to the passed slice and returns the extended slice.  It does not
+// allocate when the slice has enough capacity.
+func AppendSharedSecret(dst []byte, privkey *PrivateKey, pubkey *PublicKey, opts ...Option) []byte {
+	var secret [32]byte
+	PutSharedSecret(&secret, privkey, pubkey, opts...)
+	dst = append(dst, secret[:]...)
+	zeroArray32(&secret)
+	return dst
 }
 
 // ECDH generates a shared secret and is an alias to GenerateSharedSecret, however
@@ -25,3 +56,9 @@ func GenerateSharedSecret(privkey *PrivateKey, pubkey *PublicKey) []byte {
 func (privkey *PrivateKey) ECDH(remote *PublicKey) ([]byte, error) {
 	return GenerateSharedSecret(privkey, remote), nil
 }
+
+// ECDHWithOptions generates the same shared secret as ECDH with the provided
+// options applied.  See GenerateSharedSecretWithOptions for details.
+func (privkey *PrivateKey) ECDHWithOptions(remote *PublicKey, opts ...Option) ([]byte, error) {
+	return GenerateSharedSecretWithOptions(privkey, remote, opts...), nil
+}
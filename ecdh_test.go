@@ -30,4 +30,17 @@ func TestGenerateSharedSecret(t *testing.T) {
 		t.Errorf("ECDH failed, secrets mismatch - first: %x, second: %x",
 			secret1, secret2)
 	}
+
+	// Ensure the put and appended variants produce the same secret.
+	var putSecret [32]byte
+	PutSharedSecret(&putSecret, privKey1, pubKey2)
+	if !bytes.Equal(putSecret[:], secret1) {
+		t.Errorf("put secret mismatch - got: %x, want: %x", putSecret,
+			secret1)
+	}
+	appendedSecret := AppendSharedSecret([]byte{0x01}, privKey2, pubKey1)
+	if !bytes.Equal(appendedSecret, append([]byte{0x01}, secret1...)) {
+		t.Errorf("appended secret mismatch - got: %x, want: 01%x",
+			appendedSecret, secret1)
+	}
 }
@@ -164,7 +164,7 @@ func (curve *KoblitzCurve) ScalarMult(Bx, By *big.Int, k []byte) (*big.Int, *big
 	kModN.SetByteSlice(moduloReduce(k))
 	var point, result JacobianPoint
 	bigAffineToJacobian(Bx, By, &point)
-	ScalarMultNonConst(&kModN, &point, &result)
+	ScalarMult(&kModN, &point, &result)
 	return jacobianToBigAffine(&result)
 }
 
@@ -178,7 +178,7 @@ func (curve *KoblitzCurve) ScalarBaseMult(k []byte) (*big.Int, *big.Int) {
 	var kModN ModNScalar
 	kModN.SetByteSlice(moduloReduce(k))
 	var result JacobianPoint
-	ScalarBaseMultNonConst(&kModN, &result)
+	ScalarBaseMult(&kModN, &result)
 	return jacobianToBigAffine(&result)
 }
 
@@ -206,7 +206,7 @@ func (p *PrivateKey) ToECDSA() *ecdsa.PrivateKey {
 	var privKeyBytes [PrivKeyBytesLen]byte
 	p.Key.PutBytes(&privKeyBytes)
 	var result JacobianPoint
-	ScalarBaseMultNonConst(&p.Key, &result)
+	ScalarBaseMult(&p.Key, &result)
 	x, y := jacobianToBigAffine(&result)
 	newPrivKey := &ecdsa.PrivateKey{
 		PublicKey: ecdsa.PublicKey{
@@ -126,10 +126,42 @@ const (
 	// would overflow the underlying field prime.
 	ErrSigOverflowsPrime = ErrorKind("ErrSigOverflowsPrime")
 
+	// ErrSigHighS is returned when a signature has S with a value that is
+	// greater than half the group order when verifying it under a policy that
+	// requires canonical low S values.
+	ErrSigHighS = ErrorKind("ErrSigHighS")
+
+	// ErrSigInfinityResult is returned when verifying a signature results in
+	// the point at infinity.
+	ErrSigInfinityResult = ErrorKind("ErrSigInfinityResult")
+
+	// ErrSigUnequalRValues is returned when the x coordinate of the point
+	// calculated when verifying a signature does not match the R value of the
+	// signature, which means the signature is not for the provided hash and
+	// public key.
+	ErrSigUnequalRValues = ErrorKind("ErrSigUnequalRValues")
+
+	// ErrInvalidHashLen is returned when a hash that is required to be exactly
+	// 32 bytes is not.
+	ErrInvalidHashLen = ErrorKind("ErrInvalidHashLen")
+
+	// ErrPresignatureConsumed is returned when attempting to sign with a
+	// presignature that has already been used to sign or discarded.
+	ErrPresignatureConsumed = ErrorKind("ErrPresignatureConsumed")
+
 	// ErrPointNotOnCurve is returned when attempting to recover a public key
 	// from a compact signature results in a point that is not on the elliptic
 	// curve.
 	ErrPointNotOnCurve = ErrorKind("ErrPointNotOnCurve")
+
+	// ErrPrecomputedPubKeyInvalidLen indicates that the length of a serialized
+	// precomputed public key is not the expected value.
+	ErrPrecomputedPubKeyInvalidLen = ErrorKind("ErrPrecomputedPubKeyInvalidLen")
+
+	// ErrPrecomputedPubKeyInvalidTable indicates that the table of a
+	// serialized precomputed public key is not valid for the public key it
+	// contains.
+	ErrPrecomputedPubKeyInvalidTable = ErrorKind("ErrPrecomputedPubKeyInvalidTable")
 )
 
 // Error satisfies the error interface and prints human-readable errors.
@@ -43,7 +43,14 @@ func TestErrorKindStringer(t *testing.T) {
 		{ErrSigInvalidLen, "ErrSigInvalidLen"},
 		{ErrSigInvalidRecoveryCode, "ErrSigInvalidRecoveryCode"},
 		{ErrSigOverflowsPrime, "ErrSigOverflowsPrime"},
+		{ErrSigHighS, "ErrSigHighS"},
+		{ErrSigInfinityResult, "ErrSigInfinityResult"},
+		{ErrSigUnequalRValues, "ErrSigUnequalRValues"},
+		{ErrInvalidHashLen, "ErrInvalidHashLen"},
+		{ErrPresignatureConsumed, "ErrPresignatureConsumed"},
 		{ErrPointNotOnCurve, "ErrPointNotOnCurve"},
+		{ErrPrecomputedPubKeyInvalidLen, "ErrPrecomputedPubKeyInvalidLen"},
+		{ErrPrecomputedPubKeyInvalidTable, "ErrPrecomputedPubKeyInvalidTable"},
 	}
 
 	for i, test := range tests {
@@ -0,0 +1,898 @@
+// Copyright (c) 2013-2014 The btcsuite developers
+// Copyright (c) 2015-2023 The Decred developers
+// Copyright (c) 2013-2023 Dave Collins
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+//go:build !secp256k1_10x26 && (amd64 || arm64 || loong64 || mips64 || mips64le || ppc64 || ppc64le || riscv64 || s390x || secp256k1_5x52)
+
+package secp256k1
+
+import (
+	"encoding/binary"
+	"math/bits"
+)
+
+// This file represents the field elements as 5 uint64s with each word (array
+// entry) treated as base 2^52.  This was chosen for the following reasons:
+// 1) On 64-bit platforms, the full 128-bit product of two words is available
+//    via a single instruction through bits.Mul64, so only 25 word
+//    multiplications are needed to multiply two field values versus the 100
+//    required by the 10x26 representation
+// 2) In order to allow addition of the internal words without having to
+//    propagate the carry, the max normalized value for each register must
+//    be less than the number of bits available in the register
+// 3) Given the need for 256-bits of precision and the properties stated in #1
+//    and #2, the representation which best accommodates this is 5 uint64s with
+//    base 2^52 (52 bits * 5 = 260 bits, so the final word only needs 48 bits)
+//    which leaves 12 bits of overflow in each word (16 bits in the most
+//    significant word) for intermediate results
+//
+// This is the same representation used by the libsecp256k1 project on 64-bit
+// platforms.  In order to provide identical semantics to the 10x26
+// representation, the max allowed magnitude is 64, which means the words of a
+// field value with magnitude m are at most 2m times the corresponding words of
+// a normalized value.  That requires 8 bits of the available overflow.
+
+// Constants related to the field representation.
+const (
+	// fieldWords is the number of words used to internally represent the
+	// 256-bit value.
+	fieldWords = 5
+
+	// fieldBase is the exponent used to form the numeric base of each word.
+	// 2^(fieldBase*i) where i is the word position.
+	fieldBase = 52
+
+	// fieldBaseMask is the mask for the bits in each word needed to
+	// represent the numeric base of each word (except the most significant
+	// word).
+	fieldBaseMask = (1 << fieldBase) - 1
+
+	// fieldMSBBits is the number of bits in the most significant word used
+	// to represent the value.
+	fieldMSBBits = 256 - (fieldBase * (fieldWords - 1))
+
+	// fieldMSBMask is the mask for the bits in the most significant word
+	// needed to represent the value.
+	fieldMSBMask = (1 << fieldMSBBits) - 1
+
+	// These fields provide convenient access to each of the words of the
+	// secp256k1 prime in the internal field representation to improve code
+	// readability.
+	fieldPrimeWordZero  = 0xffffefffffc2f
+	fieldPrimeWordOne   = 0xfffffffffffff
+	fieldPrimeWordTwo   = 0xfffffffffffff
+	fieldPrimeWordThree = 0xfffffffffffff
+	fieldPrimeWordFour  = 0x0ffffffffffff
+
+	// fieldReductionConst is 2^256 mod P, which is 2^32 + 977 = 0x1000003d1,
+	// multiplied by 2^4 to account for the upper terms of a product starting
+	// at 260 bits (versus the final desired range of 256 bits).
+	fieldReductionConst = 0x1000003d10
+)
+
+// fieldLimbs is the internal representation of a field value.
+//
+// Each 256-bit value is represented as 5 64-bit integers in base 2^52.  This
+// provides 12 bits of overflow in each word (16 bits in the most significant
+// word).  It only implements the arithmetic needed for elliptic curve
+// operations.
+//
+// The following depicts the internal representation:
+//
+//	 -----------------------------------------------------------------
+//	|        n[4]       |        n[3]       | ... |        n[0]       |
+//	| 64 bits available | 64 bits available | ... | 64 bits available |
+//	| 48 bits for value | 52 bits for value | ... | 52 bits for value |
+//	| 16 bits overflow  | 12 bits overflow  | ... | 12 bits overflow  |
+//	| Mult: 2^(52*4)    | Mult: 2^(52*3)    | ... | Mult: 2^(52*0)    |
+//	 -----------------------------------------------------------------
+//
+// For example, consider the number 2^75 + 1.  It would be represented as:
+//
+//	n[0] = 1
+//	n[1] = 2^23
+//	n[2..4] = 0
+//
+// The full 256-bit value is then calculated by looping i from 4..0 and
+// doing sum(n[i] * 2^(52i)) like so:
+//
+//	n[4] * 2^(52*4) = 0    * 2^208 = 0
+//	n[3] * 2^(52*3) = 0    * 2^156 = 0
+//	n[2] * 2^(52*2) = 0    * 2^104 = 0
+//	n[1] * 2^(52*1) = 2^23 * 2^52  = 2^75
+//	n[0] * 2^(52*0) = 1    * 2^0   = 1
+//	Sum: 0 + 0 + 0 + 2^75 + 1 = 2^75 + 1
+type fieldLimbs = [fieldWords]uint64
+
+// constantTimeEq64 returns 1 if a == b or 0 otherwise in constant time.
+func constantTimeEq64(a, b uint64) uint64 {
+	x := a ^ b
+	return ((x | -x) >> 63) ^ 1
+}
+
+// constantTimeGreater64 returns 1 if a > b or 0 otherwise in constant time.
+// Both values MUST be less than 2^63.
+func constantTimeGreater64(a, b uint64) uint64 {
+	return (b - a) >> 63
+}
+
+// constantTimeGreaterOrEq64 returns 1 if a >= b or 0 otherwise in constant
+// time.  Both values MUST be less than 2^63.
+func constantTimeGreaterOrEq64(a, b uint64) uint64 {
+	return ((a - b) >> 63) ^ 1
+}
+
+// mulAdd128 multiplies the two passed 64-bit values together and adds the
+// 128-bit product to the 128-bit value formed by the passed (hi, lo) tuple.
+// The caller must ensure the result does not overflow.
+func mulAdd128(hi, lo, a, b uint64) (uint64, uint64) {
+	prodHi, prodLo := bits.Mul64(a, b)
+	lo, carry := bits.Add64(lo, prodLo, 0)
+	return hi + prodHi + carry, lo
+}
+
+// Zero sets the field value to zero in constant time.  A newly created field
+// value is already set to zero.  This function can be useful to clear an
+// existing field value for reuse.
+//
+//	Preconditions: None
+//	Output Normalized: Yes
+//	Output Max Magnitude: 1
+func (f *FieldVal) Zero() {
+	f.n[0] = 0
+	f.n[1] = 0
+	f.n[2] = 0
+	f.n[3] = 0
+	f.n[4] = 0
+}
+
+// SetInt sets the field value to the passed integer in constant time.  This is
+// a convenience function since it is fairly common to perform some arithmetic
+// with small native integers.
+//
+// The field value is returned to support chaining.  This enables syntax such
+// as f := new(FieldVal).SetInt(2).Mul(f2) so that f = 2 * f2.
+//
+//	Preconditions: None
+//	Output Normalized: Yes
+//	Output Max Magnitude: 1
+func (f *FieldVal) SetInt(ui uint16) *FieldVal {
+	f.Zero()
+	f.n[0] = uint64(ui)
+	return f
+}
+
+// SetBytes packs the passed 32-byte big-endian value into the internal field
+// value representation in constant time.  SetBytes interprets the provided
+// array as a 256-bit big-endian unsigned integer, packs it into the internal
+// field value representation, and returns either 1 if it is greater than or
+// equal to the field prime (aka it overflowed) or 0 otherwise in constant time.
+//
+// Note that a bool is not used here because it is not possible in Go to convert
+// from a bool to numeric value in constant time and many constant-time
+// operations require a numeric value.
+//
+//	Preconditions: None
+//	Output Normalized: Yes if no overflow, no otherwise
+//	Output Max Magnitude: 1
+func (f *FieldVal) SetBytes(b *[32]byte) uint32 {
+	// Pack the 256 total bits across the 5 uint64 words with a max of 52-bits
+	// per word.
+	w0 := binary.BigEndian.Uint64(b[24:32])
+	w1 := binary.BigEndian.Uint64(b[16:24])
+	w2 := binary.BigEndian.Uint64(b[8:16])
+	w3 := binary.BigEndian.Uint64(b[0:8])
+	f.n[0] = w0 & fieldBaseMask
+	f.n[1] = (w0>>52 | w1<<12) & fieldBaseMask
+	f.n[2] = (w1>>40 | w2<<24) & fieldBaseMask
+	f.n[3] = (w2>>28 | w3<<36) & fieldBaseMask
+	f.n[4] = w3 >> 16
+
+	// The intuition here is that the field value is greater than the prime if
+	// one of the higher individual words is greater than corresponding word of
+	// the prime and all higher words in the field value are equal to their
+	// corresponding word of the prime.  Since this type is modulo the prime,
+	// being equal is also an overflow back to 0.
+	//
+	// Note that because the input is 32 bytes and it was just packed into the
+	// field representation, the only word that can possibly be greater is
+	// zero, because ceil(log_2(2^256 - 1 - P)) = 33 bits max and the internal
+	// field representation encodes 52 bits with each word.
+	//
+	// Thus, there is no need to test if the upper words of the field value
+	// exceeds them, hence, only equality is checked for them.
+	highWordsEq := constantTimeEq64(f.n[4], fieldPrimeWordFour)
+	highWordsEq &= constantTimeEq64(f.n[3], fieldPrimeWordThree)
+	highWordsEq &= constantTimeEq64(f.n[2], fieldPrimeWordTwo)
+	highWordsEq &= constantTimeEq64(f.n[1], fieldPrimeWordOne)
+	overflow := highWordsEq & constantTimeGreaterOrEq64(f.n[0], fieldPrimeWordZero)
+
+	return uint32(overflow)
+}
+
+// Normalize normalizes the internal field words into the desired range and
+// performs fast modular reduction over the secp256k1 prime by making use of the
+// special form of the prime in constant time.
+//
+//	Preconditions: None
+//	Output Normalized: Yes
+//	Output Max Magnitude: 1
+func (f *FieldVal) Normalize() *FieldVal {
+	// The field representation leaves 12 bits of overflow in each word so
+	// intermediate calculations can be performed without needing to
+	// propagate the carry to each higher word during the calculations.  In
+	// order to normalize, we need to "compact" the full 256-bit value to
+	// the right while propagating any carries through to the high order
+	// word.
+	//
+	// Since this field is doing arithmetic modulo the secp256k1 prime, we
+	// also need to perform modular reduction over the prime.
+	//
+	// Per [HAC] section 14.3.4: Reduction method of moduli of special form,
+	// when the modulus is of the special form m = b^t - c, highly efficient
+	// reduction can be achieved.
+	//
+	// The secp256k1 prime is equivalent to 2^256 - 4294968273, so it fits
+	// this criteria.
+	//
+	// 4294968273 in field representation (base 2^52) is:
+	// n[0] = 0x1000003d1
+	//
+	// The uppermost bits of the high order word are how many times the value
+	// is larger than 2^256, so multiplying them by the field representation
+	// of 'c' and adding the result reduces the value to within one reduction
+	// of the final result.  After this step there might be an additional
+	// carry to bit 256 (bit 48 of the high order word).
+	t4 := f.n[4]
+	m := t4 >> fieldMSBBits
+	t4 &= fieldMSBMask
+	t0 := f.n[0] + m*0x1000003d1
+	t1 := (t0 >> fieldBase) + f.n[1]
+	t0 &= fieldBaseMask
+	t2 := (t1 >> fieldBase) + f.n[2]
+	t1 &= fieldBaseMask
+	t3 := (t2 >> fieldBase) + f.n[3]
+	t2 &= fieldBaseMask
+	t4 += t3 >> fieldBase
+	t3 &= fieldBaseMask
+
+	// At this point, the magnitude is guaranteed to be one, however, the
+	// value could still be greater than the prime if there was either a
+	// carry through to bit 256 (bit 48 of the higher order word) or the
+	// value is greater than or equal to the field characteristic.  The
+	// following determines if either or these conditions are true and does
+	// the final reduction in constant time.
+	//
+	// Also note that 'm' will be zero when neither of the aforementioned
+	// conditions are true and the value will not be changed when 'm' is zero.
+	m = constantTimeEq64(t4, fieldMSBMask)
+	m &= constantTimeEq64(t3&t2&t1, fieldBaseMask)
+	m &= constantTimeGreaterOrEq64(t0, fieldPrimeWordZero)
+	m |= t4 >> fieldMSBBits
+	t0 += m * 0x1000003d1
+	t1 += t0 >> fieldBase
+	t0 &= fieldBaseMask
+	t2 += t1 >> fieldBase
+	t1 &= fieldBaseMask
+	t3 += t2 >> fieldBase
+	t2 &= fieldBaseMask
+	t4 += t3 >> fieldBase
+	t3 &= fieldBaseMask
+	t4 &= fieldMSBMask // Remove potential multiple of 2^256.
+
+	// Finally, set the normalized and reduced words.
+	f.n[0] = t0
+	f.n[1] = t1
+	f.n[2] = t2
+	f.n[3] = t3
+	f.n[4] = t4
+	return f
+}
+
+// PutBytesUnchecked unpacks the field value to a 32-byte big-endian value
+// directly into the passed byte slice in constant time.  The target slice must
+// have at least 32 bytes available or it will panic.
+//
+// There is a similar function, PutBytes, which unpacks the field value into a
+// 32-byte array directly.  This version is provided since it can be useful
+// to write directly into part of a larger buffer without needing a separate
+// allocation.
+//
+//	Preconditions:
+//	  - The field value MUST be normalized
+//	  - The target slice MUST have at least 32 bytes available
+func (f *FieldVal) PutBytesUnchecked(b []byte) {
+	// Unpack the 256 total bits from the 5 uint64 words with a max of 52-bits
+	// per word.
+	_ = b[31] // Bounds check hint to compiler.
+	binary.BigEndian.PutUint64(b[0:8], f.n[3]>>36|f.n[4]<<16)
+	binary.BigEndian.PutUint64(b[8:16], f.n[2]>>24|f.n[3]<<28)
+	binary.BigEndian.PutUint64(b[16:24], f.n[1]>>12|f.n[2]<<40)
+	binary.BigEndian.PutUint64(b[24:32], f.n[0]|f.n[1]<<52)
+}
+
+// IsZeroBit returns 1 when the field value is equal to zero or 0 otherwise in
+// constant time.
+//
+// Note that a bool is not used here because it is not possible in Go to convert
+// from a bool to numeric value in constant time and many constant-time
+// operations require a numeric value.  See IsZero for the version that returns
+// a bool.
+//
+//	Preconditions:
+//	  - The field value MUST be normalized
+func (f *FieldVal) IsZeroBit() uint32 {
+	// The value can only be zero if no bits are set in any of the words.
+	// This is a constant time implementation.
+	bits := f.n[0] | f.n[1] | f.n[2] | f.n[3] | f.n[4]
+
+	return uint32(constantTimeEq64(bits, 0))
+}
+
+// IsZero returns whether or not the field value is equal to zero in constant
+// time.
+//
+//	Preconditions:
+//	  - The field value MUST be normalized
+func (f *FieldVal) IsZero() bool {
+	// The value can only be zero if no bits are set in any of the words.
+	// This is a constant time implementation.
+	bits := f.n[0] | f.n[1] | f.n[2] | f.n[3] | f.n[4]
+
+	return bits == 0
+}
+
+// IsOneBit returns 1 when the field value is equal to one or 0 otherwise in
+// constant time.
+//
+// Note that a bool is not used here because it is not possible in Go to convert
+// from a bool to numeric value in constant time and many constant-time
+// operations require a numeric value.  See IsOne for the version that returns a
+// bool.
+//
+//	Preconditions:
+//	   - The field value MUST be normalized
+func (f *FieldVal) IsOneBit() uint32 {
+	// The value can only be one if the single lowest significant bit is set in
+	// the first word and no other bits are set in any of the other words.
+	// This is a constant time implementation.
+	bits := (f.n[0] ^ 1) | f.n[1] | f.n[2] | f.n[3] | f.n[4]
+
+	return uint32(constantTimeEq64(bits, 0))
+}
+
+// IsOne returns whether or not the field value is equal to one in constant
+// time.
+//
+//	Preconditions:
+//	  - The field value MUST be normalized
+func (f *FieldVal) IsOne() bool {
+	// The value can only be one if the single lowest significant bit is set in
+	// the first word and no other bits are set in any of the other words.
+	// This is a constant time implementation.
+	bits := (f.n[0] ^ 1) | f.n[1] | f.n[2] | f.n[3] | f.n[4]
+
+	return bits == 0
+}
+
+// IsOddBit returns 1 when the field value is an odd number or 0 otherwise in
+// constant time.
+//
+// Note that a bool is not used here because it is not possible in Go to convert
+// from a bool to numeric value in constant time and many constant-time
+// operations require a numeric value.  See IsOdd for the version that returns a
+// bool.
+//
+//	Preconditions:
+//	  - The field value MUST be normalized
+func (f *FieldVal) IsOddBit() uint32 {
+	// Only odd numbers have the bottom bit set.
+	return uint32(f.n[0] & 1)
+}
+
+// IsOdd returns whether or not the field value is an odd number in constant
+// time.
+//
+//	Preconditions:
+//	  - The field value MUST be normalized
+func (f *FieldVal) IsOdd() bool {
+	// Only odd numbers have the bottom bit set.
+	return f.n[0]&1 == 1
+}
+
+// Equals returns whether or not the two field values are the same in constant
+// time.
+//
+//	Preconditions:
+//	  - Both field values being compared MUST be normalized
+func (f *FieldVal) Equals(val *FieldVal) bool {
+	// Xor only sets bits when they are different, so the two field values
+	// can only be the same if no bits are set after xoring each word.
+	// This is a constant time implementation.
+	bits := (f.n[0] ^ val.n[0]) | (f.n[1] ^ val.n[1]) | (f.n[2] ^ val.n[2]) |
+		(f.n[3] ^ val.n[3]) | (f.n[4] ^ val.n[4])
+
+	return bits == 0
+}
+
+// cmov conditionally sets the field value equal to the passed value in
+// constant time when the provided flag is 1 and leaves it unmodified when the
+// flag is 0.  The flag MUST be either 0 or 1.
+//
+// The field value is returned to support chaining.
+//
+//	Preconditions: None
+//	Output Normalized: Same as the selected value
+//	Output Max Magnitude: Same as the selected value
+func (f *FieldVal) cmov(val *FieldVal, flag uint32) *FieldVal {
+	// The mask is all 1s when the flag is set and all 0s otherwise, so xoring
+	// the masked difference either swaps in the new value or is a no-op.
+	mask := -uint64(flag)
+	f.n[0] ^= (f.n[0] ^ val.n[0]) & mask
+	f.n[1] ^= (f.n[1] ^ val.n[1]) & mask
+	f.n[2] ^= (f.n[2] ^ val.n[2]) & mask
+	f.n[3] ^= (f.n[3] ^ val.n[3]) & mask
+	f.n[4] ^= (f.n[4] ^ val.n[4]) & mask
+
+	return f
+}
+
+// NegateVal negates the passed value and stores the result in f in constant
+// time.  The caller must provide the magnitude of the passed value for a
+// correct result.
+//
+// The field value is returned to support chaining.  This enables syntax like:
+// f.NegateVal(f2).AddInt(1) so that f = -f2 + 1.
+//
+//	Preconditions:
+//	  - The max magnitude MUST be 63
+//	Output Normalized: No
+//	Output Max Magnitude: Input magnitude + 1
+func (f *FieldVal) NegateVal(val *FieldVal, magnitude uint32) *FieldVal {
+	// Negation in the field is just the prime minus the value.  However,
+	// in order to allow negation against a field value without having to
+	// normalize/reduce it first, multiply by the magnitude (that is how
+	// "far" away it is from the normalized value) to adjust.  Also, since
+	// negating a value pushes it one more order of magnitude away from the
+	// normalized range, add 1 to compensate.
+	//
+	// See the 10x26 implementation for some intuition.  The only difference
+	// here is that the words of a value with magnitude m are at most 2m times
+	// the words of a normalized value, so the prime is multiplied by 2(m+1).
+	m := 2 * (uint64(magnitude) + 1)
+	f.n[0] = m*fieldPrimeWordZero - val.n[0]
+	f.n[1] = m*fieldBaseMask - val.n[1]
+	f.n[2] = m*fieldBaseMask - val.n[2]
+	f.n[3] = m*fieldBaseMask - val.n[3]
+	f.n[4] = m*fieldMSBMask - val.n[4]
+
+	return f
+}
+
+// AddInt adds the passed integer to the existing field value and stores the
+// result in f in constant time.  This is a convenience function since it is
+// fairly common to perform some arithmetic with small native integers.
+//
+// The field value is returned to support chaining.  This enables syntax like:
+// f.AddInt(1).Add(f2) so that f = f + 1 + f2.
+//
+//	Preconditions:
+//	  - The field value MUST have a max magnitude of 63
+//	Output Normalized: No
+//	Output Max Magnitude: Existing field magnitude + 1
+func (f *FieldVal) AddInt(ui uint16) *FieldVal {
+	// Since the field representation intentionally provides overflow bits,
+	// it's ok to use carryless addition as the carry bit is safely part of
+	// the word and will be normalized out.
+	f.n[0] += uint64(ui)
+
+	return f
+}
+
+// Add adds the passed value to the existing field value and stores the result
+// in f in constant time.
+//
+// The field value is returned to support chaining.  This enables syntax like:
+// f.Add(f2).AddInt(1) so that f = f + f2 + 1.
+//
+//	Preconditions:
+//	  - The sum of the magnitudes of the two field values MUST be a max of 64
+//	Output Normalized: No
+//	Output Max Magnitude: Sum of the magnitude of the two individual field values
+func (f *FieldVal) Add(val *FieldVal) *FieldVal {
+	// Since the field representation intentionally provides overflow bits,
+	// it's ok to use carryless addition as the carry bit is safely part of
+	// each word and will be normalized out.
+	f.n[0] += val.n[0]
+	f.n[1] += val.n[1]
+	f.n[2] += val.n[2]
+	f.n[3] += val.n[3]
+	f.n[4] += val.n[4]
+
+	return f
+}
+
+// Add2 adds the passed two field values together and stores the result in f in
+// constant time.
+//
+// The field value is returned to support chaining.  This enables syntax like:
+// f3.Add2(f, f2).AddInt(1) so that f3 = f + f2 + 1.
+//
+//	Preconditions:
+//	  - The sum of the magnitudes of the two field values MUST be a max of 64
+//	Output Normalized: No
+//	Output Max Magnitude: Sum of the magnitude of the two field values
+func (f *FieldVal) Add2(val *FieldVal, val2 *FieldVal) *FieldVal {
+	// Since the field representation intentionally provides overflow bits,
+	// it's ok to use carryless addition as the carry bit is safely part of
+	// each word and will be normalized out.
+	f.n[0] = val.n[0] + val2.n[0]
+	f.n[1] = val.n[1] + val2.n[1]
+	f.n[2] = val.n[2] + val2.n[2]
+	f.n[3] = val.n[3] + val2.n[3]
+	f.n[4] = val.n[4] + val2.n[4]
+
+	return f
+}
+
+// MulInt multiplies the field value by the passed int and stores the result in
+// f in constant time.  Note that this function can overflow if multiplying the
+// value by any of the individual words exceeds a max uint64.  Therefore it is
+// important that the caller ensures no overflows will occur before using this
+// function.
+//
+// The field value is returned to support chaining.  This enables syntax like:
+// f.MulInt(2).Add(f2) so that f = 2 * f + f2.
+//
+//	Preconditions:
+//	  - The field value magnitude multiplied by given val MUST be a max of 64
+//	Output Normalized: No
+//	Output Max Magnitude: Existing field magnitude times the provided integer val
+func (f *FieldVal) MulInt(val uint8) *FieldVal {
+	// Since each word of the field representation can hold up to
+	// 64 - fieldBase extra bits which will be normalized out, it's safe
+	// to multiply each word without using a larger type or carry
+	// propagation so long as the values won't overflow a uint64.
+	ui := uint64(val)
+	f.n[0] *= ui
+	f.n[1] *= ui
+	f.n[2] *= ui
+	f.n[3] *= ui
+	f.n[4] *= ui
+
+	return f
+}
+
+// Mul2 multiplies the passed two field values together and stores the result in
+// f in constant time.  Note that this function can overflow if the 128-bit
+// intermediate sums of the products of the individual words exceed a max
+// uint128.  In practice, this means the magnitude of either value involved in
+// the multiplication must be a max of 8.
+//
+// The field value is returned to support chaining.  This enables syntax like:
+// f3.Mul2(f, f2).AddInt(1) so that f3 = (f * f2) + 1.
+//
+//	Preconditions:
+//	  - Both input field values MUST have a max magnitude of 8
+//	Output Normalized: No
+//	Output Max Magnitude: 1
+func (f *FieldVal) Mul2(val *FieldVal, val2 *FieldVal) *FieldVal {
+	fieldMul(&f.n, &val.n, &val2.n)
+	return f
+}
+
+// fieldMulGeneric multiplies the passed two field values together and stores
+// the result in r in constant time using pure Go.  See Mul2 for the
+// preconditions.
+func fieldMulGeneric(r, a, b *fieldLimbs) {
+	// This follows the approach used by libsecp256k1 which interleaves the
+	// calculation of the terms of the 512-bit product with the reduction of
+	// the upper terms modulo the prime via 2^256 ≡ 0x1000003d1 (mod P).  The
+	// sums of the terms are accumulated in two 128-bit values, c and d, which
+	// are represented by (hi, lo) tuples.
+	//
+	// [... a b c] is a shorthand for ... + a<<104 + b<<52 + c<<0 mod P.  For
+	// 0 <= x <= 4, px is a shorthand for sum(a[i]*b[x-i], i=0..x) and, for
+	// 4 <= x <= 8, px is a shorthand for sum(a[i]*b[x-i], i=(x-4)..4).
+	//
+	// Note that [x 0 0 0 0 0] = [x*R] where R = fieldReductionConst.
+	const (
+		m  = fieldBaseMask
+		rc = fieldReductionConst
+	)
+	a0, a1, a2, a3, a4 := a[0], a[1], a[2], a[3], a[4]
+	b0, b1, b2, b3, b4 := b[0], b[1], b[2], b[3], b[4]
+
+	// [d 0 0 0] = [p3 0 0 0]
+	dh, dl := bits.Mul64(a0, b3)
+	dh, dl = mulAdd128(dh, dl, a1, b2)
+	dh, dl = mulAdd128(dh, dl, a2, b1)
+	dh, dl = mulAdd128(dh, dl, a3, b0)
+
+	// [c 0 0 0 0 d 0 0 0] = [p8 0 0 0 0 p3 0 0 0]
+	ch, cl := bits.Mul64(a4, b4)
+
+	// [(c<<12) 0 0 0 0 0 d 0 0 0] = [p8 0 0 0 0 p3 0 0 0]
+	dh, dl = mulAdd128(dh, dl, rc, cl)
+	cl = ch
+
+	// [(c<<12) 0 0 0 0 d t3 0 0 0] = [p8 0 0 0 0 p3 0 0 0]
+	t3 := dl & m
+	dh, dl = dh>>52, dl>>52|dh<<12
+
+	// [(c<<12) 0 0 0 0 d t3 0 0 0] = [p8 0 0 0 p4 p3 0 0 0]
+	dh, dl = mulAdd128(dh, dl, a0, b4)
+	dh, dl = mulAdd128(dh, dl, a1, b3)
+	dh, dl = mulAdd128(dh, dl, a2, b2)
+	dh, dl = mulAdd128(dh, dl, a3, b1)
+	dh, dl = mulAdd128(dh, dl, a4, b0)
+
+	// [d t3 0 0 0] = [p8 0 0 0 p4 p3 0 0 0]
+	dh, dl = mulAdd128(dh, dl, rc<<12, cl)
+
+	// [d t4 t3 0 0 0] = [p8 0 0 0 p4 p3 0 0 0]
+	t4 := dl & m
+	dh, dl = dh>>52, dl>>52|dh<<12
+
+	// [d t4+(tx<<48) t3 0 0 0] = [p8 0 0 0 p4 p3 0 0 0]
+	tx := t4 >> 48
+	t4 &= m >> 4
+
+	// [d t4+(tx<<48) t3 0 0 c] = [p8 0 0 0 p4 p3 0 0 p0]
+	ch, cl = bits.Mul64(a0, b0)
+
+	// [d t4+(tx<<48) t3 0 0 c] = [p8 0 0 p5 p4 p3 0 0 p0]
+	dh, dl = mulAdd128(dh, dl, a1, b4)
+	dh, dl = mulAdd128(dh, dl, a2, b3)
+	dh, dl = mulAdd128(dh, dl, a3, b2)
+	dh, dl = mulAdd128(dh, dl, a4, b1)
+
+	// [d u0 t4+(tx<<48) t3 0 0 c] = [p8 0 0 p5 p4 p3 0 0 p0]
+	u0 := dl & m
+	dh, dl = dh>>52, dl>>52|dh<<12
+
+	// [d 0 t4+(u0<<48) t3 0 0 c] = [p8 0 0 p5 p4 p3 0 0 p0]
+	u0 = u0<<4 | tx
+
+	// [d 0 t4 t3 0 0 c] = [p8 0 0 p5 p4 p3 0 0 p0]
+	ch, cl = mulAdd128(ch, cl, u0, rc>>4)
+
+	// [d 0 t4 t3 0 c r0] = [p8 0 0 p5 p4 p3 0 0 p0]
+	r0 := cl & m
+	ch, cl = ch>>52, cl>>52|ch<<12
+
+	// [d 0 t4 t3 0 c r0] = [p8 0 0 p5 p4 p3 0 p1 p0]
+	ch, cl = mulAdd128(ch, cl, a0, b1)
+	ch, cl = mulAdd128(ch, cl, a1, b0)
+
+	// [d 0 t4 t3 0 c r0] = [p8 0 p6 p5 p4 p3 0 p1 p0]
+	dh, dl = mulAdd128(dh, dl, a2, b4)
+	dh, dl = mulAdd128(dh, dl, a3, b3)
+	dh, dl = mulAdd128(dh, dl, a4, b2)
+
+	// [d 0 0 t4 t3 0 c r0] = [p8 0 p6 p5 p4 p3 0 p1 p0]
+	ch, cl = mulAdd128(ch, cl, dl&m, rc)
+	dh, dl = dh>>52, dl>>52|dh<<12
+
+	// [d 0 0 t4 t3 c r1 r0] = [p8 0 p6 p5 p4 p3 0 p1 p0]
+	r1 := cl & m
+	ch, cl = ch>>52, cl>>52|ch<<12
+
+	// [d 0 0 t4 t3 c r1 r0] = [p8 0 p6 p5 p4 p3 p2 p1 p0]
+	ch, cl = mulAdd128(ch, cl, a0, b2)
+	ch, cl = mulAdd128(ch, cl, a1, b1)
+	ch, cl = mulAdd128(ch, cl, a2, b0)
+
+	// [d 0 0 t4 t3 c r1 r0] = [p8 p7 p6 p5 p4 p3 p2 p1 p0]
+	dh, dl = mulAdd128(dh, dl, a3, b4)
+	dh, dl = mulAdd128(dh, dl, a4, b3)
+
+	// [(d<<12) 0 0 0 t4 t3 c r1 r0] = [p8 p7 p6 p5 p4 p3 p2 p1 p0]
+	ch, cl = mulAdd128(ch, cl, rc, dl)
+	dl = dh
+
+	// [(d<<12) 0 0 0 t4 t3+c r2 r1 r0] = [p8 p7 p6 p5 p4 p3 p2 p1 p0]
+	r2 := cl & m
+	ch, cl = ch>>52, cl>>52|ch<<12
+
+	// [t4 c r2 r1 r0] = [p8 p7 p6 p5 p4 p3 p2 p1 p0]
+	ch, cl = mulAdd128(ch, cl, rc<<12, dl)
+	var carry uint64
+	cl, carry = bits.Add64(cl, t3, 0)
+	ch += carry
+
+	// [t4+c r3 r2 r1 r0] = [p8 p7 p6 p5 p4 p3 p2 p1 p0]
+	r3 := cl & m
+	cl = cl>>52 | ch<<12
+
+	// [r4 r3 r2 r1 r0] = [p8 p7 p6 p5 p4 p3 p2 p1 p0]
+	r[0] = r0
+	r[1] = r1
+	r[2] = r2
+	r[3] = r3
+	r[4] = cl + t4
+}
+
+// SquareVal squares the passed value and stores the result in f in constant
+// time.  Note that this function can overflow if the 128-bit intermediate sums
+// of the products of the individual words exceed a max uint128.  In practice,
+// this means the magnitude of the field being squared must be a max of 8 to
+// prevent overflow.
+//
+// The field value is returned to support chaining.  This enables syntax like:
+// f3.SquareVal(f).Mul(f) so that f3 = f^2 * f = f^3.
+//
+//	Preconditions:
+//	  - The input field value MUST have a max magnitude of 8
+//	Output Normalized: No
+//	Output Max Magnitude: 1
+func (f *FieldVal) SquareVal(val *FieldVal) *FieldVal {
+	fieldSquare(&f.n, &val.n)
+	return f
+}
+
+// fieldSquareGeneric squares the passed field value and stores the result in r
+// in constant time using pure Go.  See SquareVal for the preconditions.
+func fieldSquareGeneric(r, a *fieldLimbs) {
+	// This is the same as Mul2 except the symmetric products are only
+	// calculated once and doubled.  See fieldMulGeneric for details.
+	const (
+		m  = fieldBaseMask
+		rc = fieldReductionConst
+	)
+	a0, a1, a2, a3, a4 := a[0], a[1], a[2], a[3], a[4]
+
+	// [d 0 0 0] = [p3 0 0 0]
+	dh, dl := bits.Mul64(a0*2, a3)
+	dh, dl = mulAdd128(dh, dl, a1*2, a2)
+
+	// [c 0 0 0 0 d 0 0 0] = [p8 0 0 0 0 p3 0 0 0]
+	ch, cl := bits.Mul64(a4, a4)
+
+	// [(c<<12) 0 0 0 0 0 d 0 0 0] = [p8 0 0 0 0 p3 0 0 0]
+	dh, dl = mulAdd128(dh, dl, rc, cl)
+	cl = ch
+
+	// [(c<<12) 0 0 0 0 d t3 0 0 0] = [p8 0 0 0 0 p3 0 0 0]
+	t3 := dl & m
+	dh, dl = dh>>52, dl>>52|dh<<12
+
+	// [(c<<12) 0 0 0 0 d t3 0 0 0] = [p8 0 0 0 p4 p3 0 0 0]
+	a4 *= 2
+	dh, dl = mulAdd128(dh, dl, a0, a4)
+	dh, dl = mulAdd128(dh, dl, a1*2, a3)
+	dh, dl = mulAdd128(dh, dl, a2, a2)
+
+	// [d t3 0 0 0] = [p8 0 0 0 p4 p3 0 0 0]
+	dh, dl = mulAdd128(dh, dl, rc<<12, cl)
+
+	// [d t4 t3 0 0 0] = [p8 0 0 0 p4 p3 0 0 0]
+	t4 := dl & m
+	dh, dl = dh>>52, dl>>52|dh<<12
+
+	// [d t4+(tx<<48) t3 0 0 0] = [p8 0 0 0 p4 p3 0 0 0]
+	tx := t4 >> 48
+	t4 &= m >> 4
+
+	// [d t4+(tx<<48) t3 0 0 c] = [p8 0 0 0 p4 p3 0 0 p0]
+	ch, cl = bits.Mul64(a0, a0)
+
+	// [d t4+(tx<<48) t3 0 0 c] = [p8 0 0 p5 p4 p3 0 0 p0]
+	dh, dl = mulAdd128(dh, dl, a1, a4)
+	dh, dl = mulAdd128(dh, dl, a2*2, a3)
+
+	// [d u0 t4+(tx<<48) t3 0 0 c] = [p8 0 0 p5 p4 p3 0 0 p0]
+	u0 := dl & m
+	dh, dl = dh>>52, dl>>52|dh<<12
+
+	// [d 0 t4+(u0<<48) t3 0 0 c] = [p8 0 0 p5 p4 p3 0 0 p0]
+	u0 = u0<<4 | tx
+
+	// [d 0 t4 t3 0 0 c] = [p8 0 0 p5 p4 p3 0 0 p0]
+	ch, cl = mulAdd128(ch, cl, u0, rc>>4)
+
+	// [d 0 t4 t3 0 c r0] = [p8 0 0 p5 p4 p3 0 0 p0]
+	r0 := cl & m
+	ch, cl = ch>>52, cl>>52|ch<<12
+
+	// [d 0 t4 t3 0 c r0] = [p8 0 0 p5 p4 p3 0 p1 p0]
+	a0 *= 2
+	ch, cl = mulAdd128(ch, cl, a0, a1)
+
+	// [d 0 t4 t3 0 c r0] = [p8 0 p6 p5 p4 p3 0 p1 p0]
+	dh, dl = mulAdd128(dh, dl, a2, a4)
+	dh, dl = mulAdd128(dh, dl, a3, a3)
+
+	// [d 0 0 t4 t3 0 c r0] = [p8 0 p6 p5 p4 p3 0 p1 p0]
+	ch, cl = mulAdd128(ch, cl, dl&m, rc)
+	dh, dl = dh>>52, dl>>52|dh<<12
+
+	// [d 0 0 t4 t3 c r1 r0] = [p8 0 p6 p5 p4 p3 0 p1 p0]
+	r1 := cl & m
+	ch, cl = ch>>52, cl>>52|ch<<12
+
+	// [d 0 0 t4 t3 c r1 r0] = [p8 0 p6 p5 p4 p3 p2 p1 p0]
+	ch, cl = mulAdd128(ch, cl, a0, a2)
+	ch, cl = mulAdd128(ch, cl, a1, a1)
+
+	// [d 0 0 t4 t3 c r1 r0] = [p8 p7 p6 p5 p4 p3 p2 p1 p0]
+	dh, dl = mulAdd128(dh, dl, a3, a4)
+
+	// [(d<<12) 0 0 0 t4 t3 c r1 r0] = [p8 p7 p6 p5 p4 p3 p2 p1 p0]
+	ch, cl = mulAdd128(ch, cl, rc, dl)
+	dl = dh
+
+	// [(d<<12) 0 0 0 t4 t3+c r2 r1 r0] = [p8 p7 p6 p5 p4 p3 p2 p1 p0]
+	r2 := cl & m
+	ch, cl = ch>>52, cl>>52|ch<<12
+
+	// [t4 c r2 r1 r0] = [p8 p7 p6 p5 p4 p3 p2 p1 p0]
+	ch, cl = mulAdd128(ch, cl, rc<<12, dl)
+	var carry uint64
+	cl, carry = bits.Add64(cl, t3, 0)
+	ch += carry
+
+	// [t4+c r3 r2 r1 r0] = [p8 p7 p6 p5 p4 p3 p2 p1 p0]
+	r3 := cl & m
+	cl = cl>>52 | ch<<12
+
+	// [r4 r3 r2 r1 r0] = [p8 p7 p6 p5 p4 p3 p2 p1 p0]
+	r[0] = r0
+	r[1] = r1
+	r[2] = r2
+	r[3] = r3
+	r[4] = cl + t4
+}
+
+// IsGtOrEqPrimeMinusOrder returns whether or not the field value exceeds the
+// group order divided by 2 in constant time.
+//
+//	Preconditions:
+//	  - The field value MUST be normalized
+func (f *FieldVal) IsGtOrEqPrimeMinusOrder() bool {
+	// The secp256k1 prime is equivalent to 2^256 - 4294968273 and the group
+	// order is 2^256 - 432420386565659656852420866394968145599.  Thus,
+	// the prime minus the group order is:
+	// 432420386565659656852420866390673177326
+	//
+	// In hex that is:
+	// 0x00000000 00000000 00000000 00000001 45512319 50b75fc4 402da172 2fc9baee
+	//
+	// Converting that to field representation (base 2^52) is:
+	//
+	// n[0] = 0xda1722fc9baee
+	// n[1] = 0x1950b75fc4402
+	// n[2] = 0x0000001455123
+	//
+	// This can be verified with the following test code:
+	//   pMinusN := new(big.Int).Sub(curveParams.P, curveParams.N)
+	//   var fv FieldVal
+	//   fv.SetByteSlice(pMinusN.Bytes())
+	//   t.Logf("%x", fv.n)
+	//
+	//   Outputs: [da1722fc9baee 1950b75fc4402 1455123 0 0]
+	const (
+		pMinusNWordZero  = 0xda1722fc9baee
+		pMinusNWordOne   = 0x1950b75fc4402
+		pMinusNWordTwo   = 0x0000001455123
+		pMinusNWordThree = 0x0000000000000
+		pMinusNWordFour  = 0x0000000000000
+	)
+
+	// The intuition here is that the value is greater than field prime minus
+	// the group order if one of the higher individual words is greater than the
+	// corresponding word and all higher words in the value are equal.
+	result := constantTimeGreater64(f.n[4], pMinusNWordFour)
+	highWordsEqual := constantTimeEq64(f.n[4], pMinusNWordFour)
+	result |= highWordsEqual & constantTimeGreater64(f.n[3], pMinusNWordThree)
+	highWordsEqual &= constantTimeEq64(f.n[3], pMinusNWordThree)
+	result |= highWordsEqual & constantTimeGreater64(f.n[2], pMinusNWordTwo)
+	highWordsEqual &= constantTimeEq64(f.n[2], pMinusNWordTwo)
+	result |= highWordsEqual & constantTimeGreater64(f.n[1], pMinusNWordOne)
+	highWordsEqual &= constantTimeEq64(f.n[1], pMinusNWordOne)
+	result |= highWordsEqual & constantTimeGreaterOrEq64(f.n[0], pMinusNWordZero)
+
+	return result != 0
+}
@@ -0,0 +1,38 @@
+//go:build gc && !purego && !secp256k1_10x26
+
+package secp256k1
+
+// fieldMulADX multiplies the passed two field values together and stores the
+// result in r in constant time using the MULX and ADCX/ADOX instructions.  It
+// MUST only be called when useADX is true.
+//
+//go:noescape
+func fieldMulADX(r, a, b *fieldLimbs)
+
+// fieldSquareADX squares the passed field value and stores the result in r in
+// constant time using the MULX and ADCX/ADOX instructions.  It MUST only be
+// called when useADX is true.
+//
+//go:noescape
+func fieldSquareADX(r, a *fieldLimbs)
+
+// fieldMul multiplies the passed two field values together and stores the
+// result in r in constant time.  The assembly implementation is used when the
+// CPU supports it.
+func fieldMul(r, a, b *fieldLimbs) {
+	if useADX {
+		fieldMulADX(r, a, b)
+		return
+	}
+	fieldMulGeneric(r, a, b)
+}
+
+// fieldSquare squares the passed field value and stores the result in r in
+// constant time.  The assembly implementation is used when the CPU supports it.
+func fieldSquare(r, a *fieldLimbs) {
+	if useADX {
+		fieldSquareADX(r, a)
+		return
+	}
+	fieldSquareGeneric(r, a)
+}
@@ -0,0 +1,378 @@
+//go:build gc && !purego && !secp256k1_10x26
+
+#include "textflag.h"
+
+// These functions are direct translations of fieldMulGeneric and
+// fieldSquareGeneric that make use of the MULX instruction to calculate the
+// 128-bit products without affecting the flags and the ADCX/ADOX instructions
+// to accumulate the products into the two independent 128-bit accumulators c
+// and d via separate carry chains.  See fieldMulGeneric for details regarding
+// the algorithm and the notation used in the comments.
+//
+// Register usage:
+//   SI:      pointer to a
+//   DI:      pointer to b for multiplication and a[4]*2 for squaring
+//   R9:R8:   128-bit accumulator d
+//   R11:R10: 128-bit accumulator c
+//   CX:      t3
+//   R12:     t4
+//   R13:     tx and then r0
+//   R14:     u0 and then r1
+//   R15:     r2
+//   BX:AX:   scratch space for the products
+//
+// Note that the output is only written once all of the input words have been
+// read since the output is allowed to alias the inputs.
+
+// fieldMask is the mask for the 52 bits in each word needed to represent the
+// numeric base of each word.
+#define fieldMask $0xfffffffffffff
+
+// fieldMSBMask is the mask for the 48 bits in the most significant word needed
+// to represent the value.
+#define fieldMSBMask $0xffffffffffff
+
+// fieldRedux is 2^256 mod P multiplied by 2^4.  fieldReduxShl12 and
+// fieldReduxShr4 are the same value shifted left by 12 and right by 4 bits,
+// respectively.
+#define fieldRedux $0x1000003d10
+#define fieldReduxShl12 $0x1000003d10000
+#define fieldReduxShr4 $0x1000003d1
+
+// MULADDCF multiplies DX by src and adds the 128-bit product to hi:lo via the
+// carry flag chain.  The carry flag MUST be clear on entry and it is
+// guaranteed to be clear on exit so long as the sum does not overflow.
+#define MULADDCF(src, lo, hi) \
+	MULXQ src, AX, BX; \
+	ADCXQ AX, lo;      \
+	ADCXQ BX, hi
+
+// MULADDOF multiplies DX by src and adds the 128-bit product to hi:lo via the
+// overflow flag chain.  The overflow flag MUST be clear on entry and it is
+// guaranteed to be clear on exit so long as the sum does not overflow.
+#define MULADDOF(src, lo, hi) \
+	MULXQ src, AX, BX; \
+	ADOXQ AX, lo;      \
+	ADOXQ BX, hi
+
+// SHR52 shifts the 128-bit value in hi:lo right by 52 bits.
+#define SHR52(lo, hi) \
+	SHRQ $52, hi, lo; \
+	SHRQ $52, hi
+
+// func fieldMulADX(r, a, b *fieldLimbs)
+TEXT ·fieldMulADX(SB), NOSPLIT, $0-24
+	MOVQ a+8(FP), SI
+	MOVQ b+16(FP), DI
+
+	// [d 0 0 0] = [p3 0 0 0]
+	MOVQ  0(SI), DX
+	MULXQ 24(DI), R8, R9
+	XORQ  AX, AX
+	MOVQ  8(SI), DX
+	MULADDCF(16(DI), R8, R9)
+	MOVQ  16(SI), DX
+	MULADDCF(8(DI), R8, R9)
+	MOVQ  24(SI), DX
+	MULADDCF(0(DI), R8, R9)
+
+	// [c 0 0 0 0 d 0 0 0] = [p8 0 0 0 0 p3 0 0 0]
+	MOVQ  32(SI), DX
+	MULXQ 32(DI), R10, R11
+
+	// [(c<<12) 0 0 0 0 0 d 0 0 0] = [p8 0 0 0 0 p3 0 0 0]
+	MOVQ fieldRedux, DX
+	MULADDCF(R10, R8, R9)
+	MOVQ R11, R10
+
+	// [(c<<12) 0 0 0 0 d t3 0 0 0] = [p8 0 0 0 0 p3 0 0 0]
+	MOVQ fieldMask, CX
+	ANDQ R8, CX
+	SHR52(R8, R9)
+
+	// [(c<<12) 0 0 0 0 d t3 0 0 0] = [p8 0 0 0 p4 p3 0 0 0]
+	XORQ AX, AX
+	MOVQ 0(SI), DX
+	MULADDCF(32(DI), R8, R9)
+	MOVQ 8(SI), DX
+	MULADDCF(24(DI), R8, R9)
+	MOVQ 16(SI), DX
+	MULADDCF(16(DI), R8, R9)
+	MOVQ 24(SI), DX
+	MULADDCF(8(DI), R8, R9)
+	MOVQ 32(SI), DX
+	MULADDCF(0(DI), R8, R9)
+
+	// [d t3 0 0 0] = [p8 0 0 0 p4 p3 0 0 0]
+	MOVQ fieldReduxShl12, DX
+	MULADDCF(R10, R8, R9)
+
+	// [d t4 t3 0 0 0] = [p8 0 0 0 p4 p3 0 0 0]
+	MOVQ fieldMask, R12
+	ANDQ R8, R12
+	SHR52(R8, R9)
+
+	// [d t4+(tx<<48) t3 0 0 0] = [p8 0 0 0 p4 p3 0 0 0]
+	MOVQ R12, R13
+	SHRQ $48, R13
+	MOVQ fieldMSBMask, AX
+	ANDQ AX, R12
+
+	// [d t4+(tx<<48) t3 0 0 c] = [p8 0 0 0 p4 p3 0 0 p0]
+	MOVQ  0(SI), DX
+	MULXQ 0(DI), R10, R11
+
+	// [d t4+(tx<<48) t3 0 0 c] = [p8 0 0 p5 p4 p3 0 0 p0]
+	XORQ AX, AX
+	MOVQ 8(SI), DX
+	MULADDCF(32(DI), R8, R9)
+	MOVQ 16(SI), DX
+	MULADDCF(24(DI), R8, R9)
+	MOVQ 24(SI), DX
+	MULADDCF(16(DI), R8, R9)
+	MOVQ 32(SI), DX
+	MULADDCF(8(DI), R8, R9)
+
+	// [d u0 t4+(tx<<48) t3 0 0 c] = [p8 0 0 p5 p4 p3 0 0 p0]
+	MOVQ fieldMask, R14
+	ANDQ R8, R14
+	SHR52(R8, R9)
+
+	// [d 0 t4+(u0<<48) t3 0 0 c] = [p8 0 0 p5 p4 p3 0 0 p0]
+	SHLQ $4, R14
+	ORQ  R13, R14
+
+	// [d 0 t4 t3 0 0 c] = [p8 0 0 p5 p4 p3 0 0 p0]
+	XORQ AX, AX
+	MOVQ fieldReduxShr4, DX
+	MULADDCF(R14, R10, R11)
+
+	// [d 0 t4 t3 0 c r0] = [p8 0 0 p5 p4 p3 0 0 p0]
+	MOVQ fieldMask, R13
+	ANDQ R10, R13
+	SHR52(R10, R11)
+
+	// [d 0 t4 t3 0 c r0] = [p8 0 0 p5 p4 p3 0 p1 p0]
+	// [d 0 t4 t3 0 c r0] = [p8 0 p6 p5 p4 p3 0 p1 p0]
+	XORQ AX, AX
+	MOVQ 0(SI), DX
+	MULADDCF(8(DI), R10, R11)
+	MOVQ 16(SI), DX
+	MULADDOF(32(DI), R8, R9)
+	MOVQ 8(SI), DX
+	MULADDCF(0(DI), R10, R11)
+	MOVQ 24(SI), DX
+	MULADDOF(24(DI), R8, R9)
+	MOVQ 32(SI), DX
+	MULADDOF(16(DI), R8, R9)
+
+	// [d 0 0 t4 t3 0 c r0] = [p8 0 p6 p5 p4 p3 0 p1 p0]
+	MOVQ fieldMask, DX
+	ANDQ R8, DX
+	SHR52(R8, R9)
+	XORQ AX, AX
+	MOVQ fieldRedux, R14
+	MULADDCF(R14, R10, R11)
+
+	// [d 0 0 t4 t3 c r1 r0] = [p8 0 p6 p5 p4 p3 0 p1 p0]
+	MOVQ fieldMask, R14
+	ANDQ R10, R14
+	SHR52(R10, R11)
+
+	// [d 0 0 t4 t3 c r1 r0] = [p8 0 p6 p5 p4 p3 p2 p1 p0]
+	// [d 0 0 t4 t3 c r1 r0] = [p8 p7 p6 p5 p4 p3 p2 p1 p0]
+	XORQ AX, AX
+	MOVQ 0(SI), DX
+	MULADDCF(16(DI), R10, R11)
+	MOVQ 24(SI), DX
+	MULADDOF(32(DI), R8, R9)
+	MOVQ 8(SI), DX
+	MULADDCF(8(DI), R10, R11)
+	MOVQ 32(SI), DX
+	MULADDOF(24(DI), R8, R9)
+	MOVQ 16(SI), DX
+	MULADDCF(0(DI), R10, R11)
+
+	// [(d<<12) 0 0 0 t4 t3 c r1 r0] = [p8 p7 p6 p5 p4 p3 p2 p1 p0]
+	MOVQ fieldRedux, DX
+	MULADDCF(R8, R10, R11)
+	MOVQ R9, R8
+
+	// [(d<<12) 0 0 0 t4 t3+c r2 r1 r0] = [p8 p7 p6 p5 p4 p3 p2 p1 p0]
+	MOVQ fieldMask, R15
+	ANDQ R10, R15
+	SHR52(R10, R11)
+
+	// [t4 c r2 r1 r0] = [p8 p7 p6 p5 p4 p3 p2 p1 p0]
+	XORQ AX, AX
+	MOVQ fieldReduxShl12, DX
+	MULADDCF(R8, R10, R11)
+	ADDQ CX, R10
+	ADCQ $0, R11
+
+	// [t4+c r3 r2 r1 r0] = [p8 p7 p6 p5 p4 p3 p2 p1 p0]
+	MOVQ fieldMask, BX
+	ANDQ R10, BX
+	SHRQ $52, R11, R10
+
+	// [r4 r3 r2 r1 r0] = [p8 p7 p6 p5 p4 p3 p2 p1 p0]
+	ADDQ R12, R10
+	MOVQ r+0(FP), DI
+	MOVQ R13, 0(DI)
+	MOVQ R14, 8(DI)
+	MOVQ R15, 16(DI)
+	MOVQ BX, 24(DI)
+	MOVQ R10, 32(DI)
+	RET
+
+// func fieldSquareADX(r, a *fieldLimbs)
+TEXT ·fieldSquareADX(SB), NOSPLIT, $0-16
+	MOVQ a+8(FP), SI
+
+	// [d 0 0 0] = [p3 0 0 0]
+	MOVQ  0(SI), DX
+	LEAQ  (DX)(DX*1), DX
+	MULXQ 24(SI), R8, R9
+	XORQ  AX, AX
+	MOVQ  8(SI), DX
+	LEAQ  (DX)(DX*1), DX
+	MULADDCF(16(SI), R8, R9)
+
+	// [c 0 0 0 0 d 0 0 0] = [p8 0 0 0 0 p3 0 0 0]
+	MOVQ  32(SI), DX
+	MULXQ DX, R10, R11
+
+	// [(c<<12) 0 0 0 0 0 d 0 0 0] = [p8 0 0 0 0 p3 0 0 0]
+	MOVQ fieldRedux, DX
+	MULADDCF(R10, R8, R9)
+	MOVQ R11, R10
+
+	// [(c<<12) 0 0 0 0 d t3 0 0 0] = [p8 0 0 0 0 p3 0 0 0]
+	MOVQ fieldMask, CX
+	ANDQ R8, CX
+	SHR52(R8, R9)
+
+	// [(c<<12) 0 0 0 0 d t3 0 0 0] = [p8 0 0 0 p4 p3 0 0 0]
+	MOVQ 32(SI), DI
+	LEAQ (DI)(DI*1), DI
+	XORQ AX, AX
+	MOVQ 0(SI), DX
+	MULADDCF(DI, R8, R9)
+	MOVQ 8(SI), DX
+	LEAQ (DX)(DX*1), DX
+	MULADDCF(24(SI), R8, R9)
+	MOVQ 16(SI), DX
+	MULADDCF(DX, R8, R9)
+
+	// [d t3 0 0 0] = [p8 0 0 0 p4 p3 0 0 0]
+	MOVQ fieldReduxShl12, DX
+	MULADDCF(R10, R8, R9)
+
+	// [d t4 t3 0 0 0] = [p8 0 0 0 p4 p3 0 0 0]
+	MOVQ fieldMask, R12
+	ANDQ R8, R12
+	SHR52(R8, R9)
+
+	// [d t4+(tx<<48) t3 0 0 0] = [p8 0 0 0 p4 p3 0 0 0]
+	MOVQ R12, R13
+	SHRQ $48, R13
+	MOVQ fieldMSBMask, AX
+	ANDQ AX, R12
+
+	// [d t4+(tx<<48) t3 0 0 c] = [p8 0 0 0 p4 p3 0 0 p0]
+	MOVQ  0(SI), DX
+	MULXQ DX, R10, R11
+
+	// [d t4+(tx<<48) t3 0 0 c] = [p8 0 0 p5 p4 p3 0 0 p0]
+	XORQ AX, AX
+	MOVQ 8(SI), DX
+	MULADDCF(DI, R8, R9)
+	MOVQ 16(SI), DX
+	LEAQ (DX)(DX*1), DX
+	MULADDCF(24(SI), R8, R9)
+
+	// [d u0 t4+(tx<<48) t3 0 0 c] = [p8 0 0 p5 p4 p3 0 0 p0]
+	MOVQ fieldMask, R14
+	ANDQ R8, R14
+	SHR52(R8, R9)
+
+	// [d 0 t4+(u0<<48) t3 0 0 c] = [p8 0 0 p5 p4 p3 0 0 p0]
+	SHLQ $4, R14
+	ORQ  R13, R14
+
+	// [d 0 t4 t3 0 0 c] = [p8 0 0 p5 p4 p3 0 0 p0]
+	XORQ AX, AX
+	MOVQ fieldReduxShr4, DX
+	MULADDCF(R14, R10, R11)
+
+	// [d 0 t4 t3 0 c r0] = [p8 0 0 p5 p4 p3 0 0 p0]
+	MOVQ fieldMask, R13
+	ANDQ R10, R13
+	SHR52(R10, R11)
+
+	// [d 0 t4 t3 0 c r0] = [p8 0 0 p5 p4 p3 0 p1 p0]
+	// [d 0 t4 t3 0 c r0] = [p8 0 p6 p5 p4 p3 0 p1 p0]
+	XORQ AX, AX
+	MOVQ 0(SI), DX
+	LEAQ (DX)(DX*1), DX
+	MULADDCF(8(SI), R10, R11)
+	MOVQ 16(SI), DX
+	MULADDOF(DI, R8, R9)
+	MOVQ 24(SI), DX
+	MULADDOF(DX, R8, R9)
+
+	// [d 0 0 t4 t3 0 c r0] = [p8 0 p6 p5 p4 p3 0 p1 p0]
+	MOVQ fieldMask, DX
+	ANDQ R8, DX
+	SHR52(R8, R9)
+	XORQ AX, AX
+	MOVQ fieldRedux, R14
+	MULADDCF(R14, R10, R11)
+
+	// [d 0 0 t4 t3 c r1 r0] = [p8 0 p6 p5 p4 p3 0 p1 p0]
+	MOVQ fieldMask, R14
+	ANDQ R10, R14
+	SHR52(R10, R11)
+
+	// [d 0 0 t4 t3 c r1 r0] = [p8 0 p6 p5 p4 p3 p2 p1 p0]
+	// [d 0 0 t4 t3 c r1 r0] = [p8 p7 p6 p5 p4 p3 p2 p1 p0]
+	XORQ AX, AX
+	MOVQ 0(SI), DX
+	LEAQ (DX)(DX*1), DX
+	MULADDCF(16(SI), R10, R11)
+	MOVQ 24(SI), DX
+	MULADDOF(DI, R8, R9)
+	MOVQ 8(SI), DX
+	MULADDCF(DX, R10, R11)
+
+	// [(d<<12) 0 0 0 t4 t3 c r1 r0] = [p8 p7 p6 p5 p4 p3 p2 p1 p0]
+	MOVQ fieldRedux, DX
+	MULADDCF(R8, R10, R11)
+	MOVQ R9, R8
+
+	// [(d<<12) 0 0 0 t4 t3+c r2 r1 r0] = [p8 p7 p6 p5 p4 p3 p2 p1 p0]
+	MOVQ fieldMask, R15
+	ANDQ R10, R15
+	SHR52(R10, R11)
+
+	// [t4 c r2 r1 r0] = [p8 p7 p6 p5 p4 p3 p2 p1 p0]
+	XORQ AX, AX
+	MOVQ fieldReduxShl12, DX
+	MULADDCF(R8, R10, R11)
+	ADDQ CX, R10
+	ADCQ $0, R11
+
+	// [t4+c r3 r2 r1 r0] = [p8 p7 p6 p5 p4 p3 p2 p1 p0]
+	MOVQ fieldMask, BX
+	ANDQ R10, BX
+	SHRQ $52, R11, R10
+
+	// [r4 r3 r2 r1 r0] = [p8 p7 p6 p5 p4 p3 p2 p1 p0]
+	ADDQ R12, R10
+	MOVQ r+0(FP), DI
+	MOVQ R13, 0(DI)
+	MOVQ R14, 8(DI)
+	MOVQ R15, 16(DI)
+	MOVQ BX, 24(DI)
+	MOVQ R10, 32(DI)
+	RET
@@ -0,0 +1,93 @@
+//go:build gc && !purego && !secp256k1_10x26
+
+package secp256k1
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+)
+
+// randFieldValMagnitude returns a field value with random words that are each
+// limited to the max allowed by the passed magnitude using the passed random
+// source.
+func randFieldValMagnitude(rng *rand.Rand, magnitude uint64) *FieldVal {
+	var f FieldVal
+	for i := 0; i < fieldWords-1; i++ {
+		f.n[i] = rng.Uint64() % (2*magnitude*fieldBaseMask + 1)
+	}
+	f.n[fieldWords-1] = rng.Uint64() % (2*magnitude*fieldMSBMask + 1)
+	return &f
+}
+
+// TestFieldMulADX ensures that the assembly implementations of field
+// multiplication and squaring produce the exact same internal representation as
+// the pure Go implementations for random values of all allowed magnitudes as
+// well as the values with the maximum possible words.
+func TestFieldMulADX(t *testing.T) {
+	if !useADX {
+		t.Skip("CPU does not support the BMI2 and ADX instructions")
+	}
+
+	// Use a unique random seed each test instance and log it if the tests fail.
+	seed := time.Now().Unix()
+	rng := rand.New(rand.NewSource(seed))
+	defer func(t *testing.T, seed int64) {
+		if t.Failed() {
+			t.Logf("random seed: %d", seed)
+		}
+	}(t, seed)
+
+	// maxMag8 is a field value with every word set to the largest value
+	// permitted by a magnitude of 8.
+	var maxMag8 FieldVal
+	for i := 0; i < fieldWords-1; i++ {
+		maxMag8.n[i] = 2 * 8 * fieldBaseMask
+	}
+	maxMag8.n[fieldWords-1] = 2 * 8 * fieldMSBMask
+
+	check := func(a, b *FieldVal) {
+		t.Helper()
+
+		var got, want FieldVal
+		fieldMulADX(&got.n, &a.n, &b.n)
+		fieldMulGeneric(&want.n, &a.n, &b.n)
+		if got.n != want.n {
+			t.Fatalf("mismatched product for %x * %x\ngot: %x\nwant: %x", a.n,
+				b.n, got.n, want.n)
+		}
+
+		fieldSquareADX(&got.n, &a.n)
+		fieldSquareGeneric(&want.n, &a.n)
+		if got.n != want.n {
+			t.Fatalf("mismatched square for %x\ngot: %x\nwant: %x", a.n, got.n,
+				want.n)
+		}
+
+		// Ensure the output is allowed to alias the inputs.
+		aliased := *a
+		fieldMulADX(&aliased.n, &aliased.n, &b.n)
+		fieldMulGeneric(&want.n, &a.n, &b.n)
+		if aliased.n != want.n {
+			t.Fatalf("mismatched aliased product for %x * %x\ngot: %x\n"+
+				"want: %x", a.n, b.n, aliased.n, want.n)
+		}
+		aliased = *a
+		fieldSquareADX(&aliased.n, &aliased.n)
+		fieldSquareGeneric(&want.n, &a.n)
+		if aliased.n != want.n {
+			t.Fatalf("mismatched aliased square for %x\ngot: %x\nwant: %x",
+				a.n, aliased.n, want.n)
+		}
+	}
+
+	check(&maxMag8, &maxMag8)
+	check(new(FieldVal), &maxMag8)
+	check(new(FieldVal).SetInt(1), &maxMag8)
+	for i := 0; i < 10000; i++ {
+		magnitude := uint64(rng.Intn(8) + 1)
+		check(randFieldValMagnitude(rng, magnitude),
+			randFieldValMagnitude(rng, magnitude))
+		check(randFieldVal(t, rng), randFieldVal(t, rng))
+	}
+}
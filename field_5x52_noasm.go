@@ -0,0 +1,15 @@
+//go:build !secp256k1_10x26 && (!amd64 || !gc || purego) && (amd64 || arm64 || loong64 || mips64 || mips64le || ppc64 || ppc64le || riscv64 || s390x || secp256k1_5x52)
+
+package secp256k1
+
+// fieldMul multiplies the passed two field values together and stores the
+// result in r in constant time.
+func fieldMul(r, a, b *fieldLimbs) {
+	fieldMulGeneric(r, a, b)
+}
+
+// fieldSquare squares the passed field value and stores the result in r in
+// constant time.
+func fieldSquare(r, a *fieldLimbs) {
+	fieldSquareGeneric(r, a)
+}
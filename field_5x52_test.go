@@ -0,0 +1,382 @@
+//go:build !secp256k1_10x26 && (amd64 || arm64 || loong64 || mips64 || mips64le || ppc64 || ppc64le || riscv64 || s390x || secp256k1_5x52)
+
+package secp256k1
+
+import (
+	"math/big"
+	"reflect"
+	"testing"
+)
+
+// TestFieldSetInt ensures that setting a field value to various native
+// integers works as expected.
+func TestFieldSetInt(t *testing.T) {
+	tests := []struct {
+		name     string    // test description
+		in       uint16    // test value
+		expected [5]uint64 // expected raw ints
+	}{{
+		name:     "one",
+		in:       1,
+		expected: [5]uint64{1, 0, 0, 0, 0},
+	}, {
+		name:     "five",
+		in:       5,
+		expected: [5]uint64{5, 0, 0, 0, 0},
+	}, {
+		name:     "2^16 - 1",
+		in:       65535,
+		expected: [5]uint64{65535, 0, 0, 0, 0},
+	}}
+
+	for _, test := range tests {
+		f := new(FieldVal).SetInt(test.in)
+		if !reflect.DeepEqual(f.n, test.expected) {
+			t.Errorf("%s: wrong result\ngot: %v\nwant: %v", test.name, f.n,
+				test.expected)
+			continue
+		}
+	}
+}
+
+// TestFieldSetBytes ensures that setting a field value to a 256-bit big-endian
+// unsigned integer via both the slice and array methods works as expected for
+// edge cases.  Random cases are tested via the various other tests.
+func TestFieldSetBytes(t *testing.T) {
+	tests := []struct {
+		name     string    // test description
+		in       string    // hex encoded test value
+		expected [5]uint64 // expected raw ints
+		overflow bool      // expected overflow result
+	}{{
+		name:     "zero",
+		in:       "00",
+		expected: [5]uint64{0, 0, 0, 0, 0},
+		overflow: false,
+	}, {
+		name:     "field prime",
+		in:       "fffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc2f",
+		expected: [5]uint64{0xffffefffffc2f, 0xfffffffffffff, 0xfffffffffffff, 0xfffffffffffff, 0x0ffffffffffff},
+		overflow: true,
+	}, {
+		name:     "field prime - 1",
+		in:       "fffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc2e",
+		expected: [5]uint64{0xffffefffffc2e, 0xfffffffffffff, 0xfffffffffffff, 0xfffffffffffff, 0x0ffffffffffff},
+		overflow: false,
+	}, {
+		name:     "field prime + 1 (overflow in word zero)",
+		in:       "fffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc30",
+		expected: [5]uint64{0xffffefffffc30, 0xfffffffffffff, 0xfffffffffffff, 0xfffffffffffff, 0x0ffffffffffff},
+		overflow: true,
+	}, {
+		name:     "field prime first 32 bits",
+		in:       "fffffc2f",
+		expected: [5]uint64{0x00000fffffc2f, 0, 0, 0, 0},
+		overflow: false,
+	}, {
+		name:     "field prime word zero",
+		in:       "0ffffefffffc2f",
+		expected: [5]uint64{0xffffefffffc2f, 0, 0, 0, 0},
+		overflow: false,
+	}, {
+		name:     "field prime first 64 bits",
+		in:       "fffffffefffffc2f",
+		expected: [5]uint64{0xffffefffffc2f, 0x0000000000fff, 0, 0, 0},
+		overflow: false,
+	}, {
+		name:     "field prime word zero and one",
+		in:       "fffffffffffffffffefffffc2f",
+		expected: [5]uint64{0xffffefffffc2f, 0xfffffffffffff, 0, 0, 0},
+		overflow: false,
+	}, {
+		name:     "field prime first 128 bits",
+		in:       "fffffffffffffffffffffffefffffc2f",
+		expected: [5]uint64{0xffffefffffc2f, 0xfffffffffffff, 0x0000000ffffff, 0, 0},
+		overflow: false,
+	}, {
+		name:     "field prime word zero, one, and two",
+		in:       "0ffffffffffffffffffffffffffffffefffffc2f",
+		expected: [5]uint64{0xffffefffffc2f, 0xfffffffffffff, 0xfffffffffffff, 0, 0},
+		overflow: false,
+	}, {
+		name:     "overflow in word one (prime + 1<<52)",
+		in:       "000000000000000000000000000000000000000000000000000ffffefffffc2f",
+		expected: [5]uint64{0xffffefffffc2f, 0, 0, 0, 0},
+		overflow: false,
+	}, {
+		name:     "(field prime - 1) * 2 NOT mod P, truncated >32 bytes",
+		in:       "01fffffffffffffffffffffffffffffffffffffffffffffffffffffffdfffff85c",
+		expected: [5]uint64{0xffffffdfffff8, 0xfffffffffffff, 0xfffffffffffff, 0xfffffffffffff, 0x001ffffffffff},
+		overflow: false,
+	}, {
+		name:     "2^256 - 1",
+		in:       "ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff",
+		expected: [5]uint64{0xfffffffffffff, 0xfffffffffffff, 0xfffffffffffff, 0xfffffffffffff, 0x0ffffffffffff},
+		overflow: true,
+	}, {
+		name:     "alternating bits",
+		in:       "a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5",
+		expected: [5]uint64{0x5a5a5a5a5a5a5, 0xa5a5a5a5a5a5a, 0x5a5a5a5a5a5a5, 0xa5a5a5a5a5a5a, 0x0a5a5a5a5a5a5},
+		overflow: false,
+	}, {
+		name:     "alternating bits 2",
+		in:       "5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a",
+		expected: [5]uint64{0xa5a5a5a5a5a5a, 0x5a5a5a5a5a5a5, 0xa5a5a5a5a5a5a, 0x5a5a5a5a5a5a5, 0x05a5a5a5a5a5a},
+		overflow: false,
+	}}
+
+	for _, test := range tests {
+		inBytes := hexToBytes(test.in)
+
+		// Ensure setting the bytes via the slice method works as expected.
+		var f FieldVal
+		overflow := f.SetByteSlice(inBytes)
+		if !reflect.DeepEqual(f.n, test.expected) {
+			t.Errorf("%s: unexpected result\ngot: %x\nwant: %x", test.name, f.n,
+				test.expected)
+			continue
+		}
+
+		// Ensure the setting the bytes via the slice method produces the
+		// expected overflow result.
+		if overflow != test.overflow {
+			t.Errorf("%s: unexpected overflow -- got: %v, want: %v", test.name,
+				overflow, test.overflow)
+			continue
+		}
+
+		// Ensure setting the bytes via the array method works as expected.
+		var f2 FieldVal
+		var b32 [32]byte
+		truncatedInBytes := inBytes
+		if len(truncatedInBytes) > 32 {
+			truncatedInBytes = truncatedInBytes[:32]
+		}
+		copy(b32[32-len(truncatedInBytes):], truncatedInBytes)
+		overflow = f2.SetBytes(&b32) != 0
+		if !reflect.DeepEqual(f2.n, test.expected) {
+			t.Errorf("%s: unexpected result\ngot: %x\nwant: %x", test.name,
+				f2.n, test.expected)
+			continue
+		}
+
+		// Ensure the setting the bytes via the array method produces the
+		// expected overflow result.
+		if overflow != test.overflow {
+			t.Errorf("%s: unexpected overflow -- got: %v, want: %v", test.name,
+				overflow, test.overflow)
+			continue
+		}
+	}
+}
+
+// TestFieldNormalize ensures that normalizing the internal field words works as
+// expected.
+func TestFieldNormalize(t *testing.T) {
+	tests := []struct {
+		name       string    // test description
+		raw        [5]uint64 // Intentionally denormalized value
+		normalized [5]uint64 // Normalized form of the raw value
+	}{{
+		name:       "5",
+		raw:        [5]uint64{0x5, 0, 0, 0, 0},
+		normalized: [5]uint64{0x5, 0, 0, 0, 0},
+	}, {
+		name:       "2^52",
+		raw:        [5]uint64{0x10000000000000, 0, 0, 0, 0},
+		normalized: [5]uint64{0, 0x1, 0, 0, 0},
+	}, {
+		name:       "2^52 + 1",
+		raw:        [5]uint64{0x10000000000001, 0, 0, 0, 0},
+		normalized: [5]uint64{0x1, 0x1, 0, 0, 0},
+	}, {
+		name:       "2^64 - 1",
+		raw:        [5]uint64{0xffffffffffffffff, 0, 0, 0, 0},
+		normalized: [5]uint64{0xfffffffffffff, 0xfff, 0, 0, 0},
+	}, {
+		name:       "2^64",
+		raw:        [5]uint64{0x10000000000000, 0xfff, 0, 0, 0},
+		normalized: [5]uint64{0, 0x1000, 0, 0, 0},
+	}, {
+		name:       "2^64 + 1",
+		raw:        [5]uint64{0x10000000000001, 0xfff, 0, 0, 0},
+		normalized: [5]uint64{0x1, 0x1000, 0, 0, 0},
+	}, {
+		name:       "2^104 - 1",
+		raw:        [5]uint64{0xffffffffffffffff, 0xffffffffff000, 0, 0, 0},
+		normalized: [5]uint64{0xfffffffffffff, 0xfffffffffffff, 0, 0, 0},
+	}, {
+		name:       "2^104",
+		raw:        [5]uint64{0x10000000000000, 0xfffffffffffff, 0, 0, 0},
+		normalized: [5]uint64{0, 0, 0x1, 0, 0},
+	}, {
+		name:       "2^156 - 1",
+		raw:        [5]uint64{0xffffffffffffffff, 0xffffffffff000, 0xfffffffffffff, 0, 0},
+		normalized: [5]uint64{0xfffffffffffff, 0xfffffffffffff, 0xfffffffffffff, 0, 0},
+	}, {
+		name:       "2^208",
+		raw:        [5]uint64{0x10000000000000, 0xfffffffffffff, 0xfffffffffffff, 0xfffffffffffff, 0},
+		normalized: [5]uint64{0, 0, 0, 0, 0x1},
+	}, {
+		name:       "2^256 - 4294968273 (secp256k1 prime)",
+		raw:        [5]uint64{0x1ffffefffffc2f, 0x1ffffffffffffe, 0x1ffffffffffffe, 0x1ffffffffffffe, 0xfffffffffffe},
+		normalized: [5]uint64{0, 0, 0, 0, 0},
+	}, {
+		// Value larger than P where both first and second words are larger than
+		// P's first and second words
+		name:       "Value > P with 1st and 2nd words > P's 1st and 2nd words",
+		raw:        [5]uint64{0xffffefffffc30, 0x10000000000005, 0xfffffffffffff, 0xfffffffffffff, 0xffffffffffff},
+		normalized: [5]uint64{0x1, 0x6, 0, 0, 0},
+	}, {
+		name:       "2^256 - 1",
+		raw:        [5]uint64{0x1fffffffffffff, 0x1ffffffffffffe, 0x1ffffffffffffe, 0x1ffffffffffffe, 0xfffffffffffe},
+		normalized: [5]uint64{0x1000003d0, 0, 0, 0, 0},
+	}, {
+		// Prime with field representation such that the initial reduction does
+		// not result in a carry to bit 256.
+		//
+		// 2^256 - 4294968273 (secp256k1 prime)
+		name:       "2^256 - 4294968273 (secp256k1 prime)",
+		raw:        [5]uint64{0xffffefffffc2f, 0xfffffffffffff, 0xfffffffffffff, 0xfffffffffffff, 0xffffffffffff},
+		normalized: [5]uint64{0, 0, 0, 0, 0},
+	}, {
+		// Value larger than P that reduces to a value which is still larger
+		// than P when it has a magnitude of 1 due to its first word and does
+		// not result in a carry to bit 256.
+		//
+		// 2^256 - 4294968272 (secp256k1 prime + 1)
+		name:       "2^256 - 4294968272 (secp256k1 prime + 1)",
+		raw:        [5]uint64{0xffffefffffc30, 0xfffffffffffff, 0xfffffffffffff, 0xfffffffffffff, 0xffffffffffff},
+		normalized: [5]uint64{0x1, 0, 0, 0, 0},
+	}, {
+		// Value larger than P that reduces to a value which is still larger
+		// than P when it has a magnitude of 1 due to its first word being the
+		// maximum and does not result in a carry to bit 256.
+		//
+		// 2^256 - 4294967297 (secp256k1 prime + 976)
+		name:       "2^256 - 4294967297 (secp256k1 prime + 976)",
+		raw:        [5]uint64{0xffffeffffffff, 0xfffffffffffff, 0xfffffffffffff, 0xfffffffffffff, 0xffffffffffff},
+		normalized: [5]uint64{0x3d0, 0, 0, 0, 0},
+	}, {
+		// Value larger than P that reduces to a value which is still larger
+		// than P when it has a magnitude of 1 due to a carry to bit 256, but
+		// would not be without the carry.
+		//
+		// 2^256 * 5 - ((4294968273 - (977+1)) * 4)
+		name:       "2^256 * 5 - ((4294968273 - (977+1)) * 4)",
+		raw:        [5]uint64{0xffffc00000004, 0xfffffffffffff, 0xfffffffffffff, 0xfffffffffffff, 0x4ffffffffffff},
+		normalized: [5]uint64{0x100001319, 0, 0, 0, 0},
+	}, {
+		// Value larger than P that reduces to a value which is still larger
+		// than P when it has a magnitude of 1 due to both a carry to bit 256
+		// and the first word.
+		name:       "Value > P with redux > P at mag 1 due to 1st word and carry to bit 256",
+		raw:        [5]uint64{0xffffefffffc30, 0xfffffffffffff, 0xfffffffffffff, 0x1fffffffffffff, 0xffffffffffff},
+		normalized: [5]uint64{0x1, 0, 0, 0, 0x1},
+	}, {
+		// Value larger than P that reduces to a value which is still larger
+		// than P when it has a magnitude of 1 due to a carry to bit 256 in the
+		// top word alone.
+		name:       "Value > P with redux > P at mag 1 due to carry to bit 256 in top word",
+		raw:        [5]uint64{0xffffefffffc30, 0xfffffffffffff, 0xfffffffffffff, 0xfffffffffffff, 0x1ffffffffffff},
+		normalized: [5]uint64{0x1000003d2, 0, 0, 0, 0},
+	}, {
+		// ---------------------------------------------------------------------
+		// There are 3 main conditions that must be true if the final reduction
+		// is needed after the initial reduction to magnitude 1 when there was
+		// NOT a carry to bit 256 (in other words when the original value was <
+		// 2^256):
+		// 1) The final word of the reduced value is equal to the one of P
+		// 2) The 2nd through 4th words are equal to those of P
+		// 3) The 1st word is greater than or equal to the one of P
+		//
+		// Therefore the eight possible combinations of those 3 main conditions
+		// can be thought of in binary where each bit starting from the left
+		// corresponds to the aforementioned conditions as such:
+		// 000, 001, 010, 011, 100, 101, 110, 111
+		//
+		// For example, combination 6 is when both conditons 1 and 2 are true,
+		// but condition 3 is NOT true.
+		//
+		// The following tests hit each of these combinations and refer to each
+		// by its decimal equivalent for ease of reference.
+		//
+		// NOTE: The final combination (7) is already tested above since it only
+		// happens when the original value is already the normalized
+		// representation of P.
+		// ---------------------------------------------------------------------
+
+		name:       "Value < 2^256 final reduction combination 0",
+		raw:        [5]uint64{0xffffefffffc2e, 0xfffffffffffff, 0xfffffffffffff, 0xffffffffffffe, 0x0fffffffffffe},
+		normalized: [5]uint64{0xffffefffffc2e, 0xfffffffffffff, 0xfffffffffffff, 0xffffffffffffe, 0x0fffffffffffe},
+	}, {
+		name:       "Value < 2^256 final reduction combination 1",
+		raw:        [5]uint64{0xffffefffffc2f, 0xfffffffffffff, 0xfffffffffffff, 0xffffffffffffe, 0x0fffffffffffe},
+		normalized: [5]uint64{0xffffefffffc2f, 0xfffffffffffff, 0xfffffffffffff, 0xffffffffffffe, 0x0fffffffffffe},
+	}, {
+		name:       "Value < 2^256 final reduction combination 2",
+		raw:        [5]uint64{0xffffefffffc2e, 0xfffffffffffff, 0xfffffffffffff, 0xfffffffffffff, 0x0fffffffffffe},
+		normalized: [5]uint64{0xffffefffffc2e, 0xfffffffffffff, 0xfffffffffffff, 0xfffffffffffff, 0x0fffffffffffe},
+	}, {
+		name:       "Value < 2^256 final reduction combination 3",
+		raw:        [5]uint64{0xffffefffffc2f, 0xfffffffffffff, 0xfffffffffffff, 0xfffffffffffff, 0x0fffffffffffe},
+		normalized: [5]uint64{0xffffefffffc2f, 0xfffffffffffff, 0xfffffffffffff, 0xfffffffffffff, 0x0fffffffffffe},
+	}, {
+		name:       "Value < 2^256 final reduction combination 4",
+		raw:        [5]uint64{0xffffefffffc2e, 0xfffffffffffff, 0xfffffffffffff, 0xffffffffffffe, 0x0ffffffffffff},
+		normalized: [5]uint64{0xffffefffffc2e, 0xfffffffffffff, 0xfffffffffffff, 0xffffffffffffe, 0x0ffffffffffff},
+	}, {
+		name:       "Value < 2^256 final reduction combination 5",
+		raw:        [5]uint64{0xffffefffffc2f, 0xfffffffffffff, 0xfffffffffffff, 0xffffffffffffe, 0x0ffffffffffff},
+		normalized: [5]uint64{0xffffefffffc2f, 0xfffffffffffff, 0xfffffffffffff, 0xffffffffffffe, 0x0ffffffffffff},
+	}, {
+		name:       "Value < 2^256 final reduction combination 6",
+		raw:        [5]uint64{0xffffefffffc2e, 0xfffffffffffff, 0xfffffffffffff, 0xfffffffffffff, 0x0ffffffffffff},
+		normalized: [5]uint64{0xffffefffffc2e, 0xfffffffffffff, 0xfffffffffffff, 0xfffffffffffff, 0x0ffffffffffff},
+	}}
+
+	for _, test := range tests {
+		f := new(FieldVal)
+		f.n = test.raw
+		f.Normalize()
+		if !reflect.DeepEqual(f.n, test.normalized) {
+			t.Errorf("%s: wrong normalized result\ngot: %x\nwant: %x",
+				test.name, f.n, test.normalized)
+			continue
+		}
+	}
+}
+
+// TestFieldMulMaxMagnitude ensures that multiplying and squaring field values
+// with the maximum allowed magnitude of 8 produces the correct results since
+// those are the cases most likely to overflow the intermediate 128-bit sums.
+func TestFieldMulMaxMagnitude(t *testing.T) {
+	// maxMag8 is a field value with every word set to the largest value
+	// permitted by a magnitude of 8.
+	var maxMag8 FieldVal
+	for i := 0; i < fieldWords-1; i++ {
+		maxMag8.n[i] = 2 * 8 * fieldBaseMask
+	}
+	maxMag8.n[fieldWords-1] = 2 * 8 * fieldMSBMask
+
+	// Calculate the expected value with big integers.
+	bigVal := new(big.Int)
+	for i := fieldWords - 1; i >= 0; i-- {
+		bigVal.Lsh(bigVal, fieldBase)
+		bigVal.Add(bigVal, new(big.Int).SetUint64(maxMag8.n[i]))
+	}
+	bigWant := new(big.Int).Mul(bigVal, bigVal)
+	bigWant.Mod(bigWant, curveParams.P)
+	var want FieldVal
+	want.SetByteSlice(bigWant.Bytes())
+
+	var got FieldVal
+	got.Mul2(&maxMag8, &maxMag8).Normalize()
+	if !got.Equals(&want) {
+		t.Fatalf("wrong product\ngot: %v\nwant: %v", got, want)
+	}
+	got.SquareVal(&maxMag8).Normalize()
+	if !got.Equals(&want) {
+		t.Fatalf("wrong square\ngot: %v\nwant: %v", got, want)
+	}
+}
@@ -13,10 +13,7 @@ import (
 // to perform normalization (which includes modular reduction).
 func BenchmarkFieldNormalize(b *testing.B) {
 	// The function is constant time so any value is fine.
-	f := &FieldVal{n: [10]uint32{
-		0x000148f6, 0x03ffffc0, 0x03ffffff, 0x03ffffff, 0x03ffffff,
-		0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x00000007,
-	}}
+	f := new(FieldVal).SetHex("fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff")
 
 	b.ReportAllocs()
 	b.ResetTimer()
@@ -25,6 +22,33 @@ func BenchmarkFieldNormalize(b *testing.B) {
 	}
 }
 
+// BenchmarkFieldMul benchmarks multiplying two unsigned 256-bit big-endian
+// integers modulo the field prime with the specialized type.
+func BenchmarkFieldMul(b *testing.B) {
+	f := new(FieldVal).SetHex("16fb970147a9acc73654d4be233cc48b875ce20a2122d24f073d29bd28805aca")
+	f2 := new(FieldVal).SetHex("4a6bbd6e7bda3a4ac0a1a1abbd9b0f4e1af7b1a2e25aea1ae1f5e2b4e8ad0e0c")
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var result FieldVal
+		result.Mul2(f, f2)
+	}
+}
+
+// BenchmarkFieldSquare benchmarks squaring an unsigned 256-bit big-endian
+// integer modulo the field prime with the specialized type.
+func BenchmarkFieldSquare(b *testing.B) {
+	f := new(FieldVal).SetHex("16fb970147a9acc73654d4be233cc48b875ce20a2122d24f073d29bd28805aca")
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var result FieldVal
+		result.SquareVal(f)
+	}
+}
+
 // BenchmarkFieldSqrt benchmarks calculating the square root of an unsigned
 // 256-bit big-endian integer modulo the field prime  with the specialized type.
 func BenchmarkFieldSqrt(b *testing.B) {
@@ -57,6 +81,51 @@ func BenchmarkBigSqrt(b *testing.B) {
 	}
 }
 
+// BenchmarkFieldInverse benchmarks calculating the multiplicative inverse of an
+// unsigned 256-bit big-endian integer modulo the field prime in constant time
+// with the specialized type.
+func BenchmarkFieldInverse(b *testing.B) {
+	valHex := "16fb970147a9acc73654d4be233cc48b875ce20a2122d24f073d29bd28805aca"
+	f := new(FieldVal).SetHex(valHex).Normalize()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = new(FieldVal).Set(f).Inverse()
+	}
+}
+
+// BenchmarkFieldInverseNonConst benchmarks calculating the multiplicative
+// inverse of an unsigned 256-bit big-endian integer modulo the field prime in
+// *non-constant* time with the specialized type.
+func BenchmarkFieldInverseNonConst(b *testing.B) {
+	valHex := "16fb970147a9acc73654d4be233cc48b875ce20a2122d24f073d29bd28805aca"
+	f := new(FieldVal).SetHex(valHex).Normalize()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = new(FieldVal).Set(f).InverseNonConst()
+	}
+}
+
+// BenchmarkBigInverseModP benchmarks calculating the multiplicative inverse of
+// an unsigned 256-bit big-endian integer modulo the field prime with stdlib big
+// integers.
+func BenchmarkBigInverseModP(b *testing.B) {
+	valHex := "16fb970147a9acc73654d4be233cc48b875ce20a2122d24f073d29bd28805aca"
+	val, ok := new(big.Int).SetString(valHex, 16)
+	if !ok {
+		b.Fatalf("failed to parse hex %s", valHex)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = new(big.Int).ModInverse(val, curveParams.P)
+	}
+}
+
 // BenchmarkFieldIsGtOrEqPrimeMinusOrder benchmarks determining whether a value
 // is greater than or equal to the field prime minus the group order with the
 // specialized type.
@@ -12,7 +12,6 @@ import (
 	"fmt"
 	"math/big"
 	"math/rand"
-	"reflect"
 	"testing"
 	"time"
 )
@@ -67,210 +66,6 @@ func randIntAndFieldVal(t *testing.T, rng *rand.Rand) (*big.Int, *FieldVal) {
 	return bigIntVal, &fv
 }
 
-// TestFieldSetInt ensures that setting a field value to various native
-// integers works as expected.
-func TestFieldSetInt(t *testing.T) {
-	tests := []struct {
-		name     string     // test description
-		in       uint16     // test value
-		expected [10]uint32 // expected raw ints
-	}{{
-		name:     "one",
-		in:       1,
-		expected: [10]uint32{1, 0, 0, 0, 0, 0, 0, 0, 0, 0},
-	}, {
-		name:     "five",
-		in:       5,
-		expected: [10]uint32{5, 0, 0, 0, 0, 0, 0, 0, 0, 0},
-	}, {
-		name:     "2^16 - 1",
-		in:       65535,
-		expected: [10]uint32{65535, 0, 0, 0, 0, 0, 0, 0, 0, 0},
-	}}
-
-	for _, test := range tests {
-		f := new(FieldVal).SetInt(test.in)
-		if !reflect.DeepEqual(f.n, test.expected) {
-			t.Errorf("%s: wrong result\ngot: %v\nwant: %v", test.name, f.n,
-				test.expected)
-			continue
-		}
-	}
-}
-
-// TestFieldSetBytes ensures that setting a field value to a 256-bit big-endian
-// unsigned integer via both the slice and array methods works as expected for
-// edge cases.  Random cases are tested via the various other tests.
-func TestFieldSetBytes(t *testing.T) {
-	tests := []struct {
-		name     string     // test description
-		in       string     // hex encoded test value
-		expected [10]uint32 // expected raw ints
-		overflow bool       // expected overflow result
-	}{{
-		name:     "zero",
-		in:       "00",
-		expected: [10]uint32{0, 0, 0, 0, 0, 0, 0, 0, 0, 0},
-		overflow: false,
-	}, {
-		name: "field prime",
-		in:   "fffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc2f",
-		expected: [10]uint32{
-			0x03fffc2f, 0x03ffffbf, 0x03ffffff, 0x03ffffff, 0x03ffffff,
-			0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x003fffff,
-		},
-		overflow: true,
-	}, {
-		name: "field prime - 1",
-		in:   "fffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc2e",
-		expected: [10]uint32{
-			0x03fffc2e, 0x03ffffbf, 0x03ffffff, 0x03ffffff, 0x03ffffff,
-			0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x003fffff,
-		},
-		overflow: false,
-	}, {
-		name: "field prime + 1 (overflow in word zero)",
-		in:   "fffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc30",
-		expected: [10]uint32{
-			0x03fffc30, 0x03ffffbf, 0x03ffffff, 0x03ffffff, 0x03ffffff,
-			0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x003fffff,
-		},
-		overflow: true,
-	}, {
-		name: "field prime first 32 bits",
-		in:   "fffffc2f",
-		expected: [10]uint32{
-			0x03fffc2f, 0x00000003f, 0x00000000, 0x00000000, 0x00000000,
-			0x00000000, 0x00000000, 0x00000000, 0x00000000, 0x00000000,
-		},
-		overflow: false,
-	}, {
-		name: "field prime word zero",
-		in:   "03fffc2f",
-		expected: [10]uint32{
-			0x03fffc2f, 0x00000000, 0x00000000, 0x00000000, 0x00000000,
-			0x00000000, 0x00000000, 0x00000000, 0x00000000, 0x00000000,
-		},
-		overflow: false,
-	}, {
-		name: "field prime first 64 bits",
-		in:   "fffffffefffffc2f",
-		expected: [10]uint32{
-			0x03fffc2f, 0x03ffffbf, 0x00000fff, 0x00000000, 0x00000000,
-			0x00000000, 0x00000000, 0x00000000, 0x00000000, 0x00000000,
-		},
-		overflow: false,
-	}, {
-		name: "field prime word zero and one",
-		in:   "0ffffefffffc2f",
-		expected: [10]uint32{
-			0x03fffc2f, 0x03ffffbf, 0x00000000, 0x00000000, 0x00000000,
-			0x00000000, 0x00000000, 0x00000000, 0x00000000, 0x00000000,
-		},
-		overflow: false,
-	}, {
-		name: "field prime first 96 bits",
-		in:   "fffffffffffffffefffffc2f",
-		expected: [10]uint32{
-			0x03fffc2f, 0x03ffffbf, 0x03ffffff, 0x0003ffff, 0x00000000,
-			0x00000000, 0x00000000, 0x00000000, 0x00000000, 0x00000000,
-		},
-		overflow: false,
-	}, {
-		name: "field prime word zero, one, and two",
-		in:   "3ffffffffffefffffc2f",
-		expected: [10]uint32{
-			0x03fffc2f, 0x03ffffbf, 0x03ffffff, 0x00000000, 0x00000000,
-			0x00000000, 0x00000000, 0x00000000, 0x00000000, 0x00000000,
-		},
-		overflow: false,
-	}, {
-		name: "overflow in word one (prime + 1<<26)",
-		in:   "ffffffffffffffffffffffffffffffffffffffffffffffffffffffff03fffc2f",
-		expected: [10]uint32{
-			0x03fffc2f, 0x03ffffc0, 0x03ffffff, 0x03ffffff, 0x03ffffff,
-			0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x003fffff,
-		},
-		overflow: true,
-	}, {
-		name: "(field prime - 1) * 2 NOT mod P, truncated >32 bytes",
-		in:   "01fffffffffffffffffffffffffffffffffffffffffffffffffffffffdfffff85c",
-		expected: [10]uint32{
-			0x01fffff8, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff,
-			0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x00007fff,
-		},
-		overflow: false,
-	}, {
-		name: "2^256 - 1",
-		in:   "ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff",
-		expected: [10]uint32{
-			0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff,
-			0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x003fffff,
-		},
-		overflow: true,
-	}, {
-		name: "alternating bits",
-		in:   "a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5",
-		expected: [10]uint32{
-			0x01a5a5a5, 0x01696969, 0x025a5a5a, 0x02969696, 0x01a5a5a5,
-			0x01696969, 0x025a5a5a, 0x02969696, 0x01a5a5a5, 0x00296969,
-		},
-		overflow: false,
-	}, {
-		name: "alternating bits 2",
-		in:   "5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a",
-		expected: [10]uint32{
-			0x025a5a5a, 0x02969696, 0x01a5a5a5, 0x01696969, 0x025a5a5a,
-			0x02969696, 0x01a5a5a5, 0x01696969, 0x025a5a5a, 0x00169696,
-		},
-		overflow: false,
-	}}
-
-	for _, test := range tests {
-		inBytes := hexToBytes(test.in)
-
-		// Ensure setting the bytes via the slice method works as expected.
-		var f FieldVal
-		overflow := f.SetByteSlice(inBytes)
-		if !reflect.DeepEqual(f.n, test.expected) {
-			t.Errorf("%s: unexpected result\ngot: %x\nwant: %x", test.name, f.n,
-				test.expected)
-			continue
-		}
-
-		// Ensure the setting the bytes via the slice method produces the
-		// expected overflow result.
-		if overflow != test.overflow {
-			t.Errorf("%s: unexpected overflow -- got: %v, want: %v", test.name,
-				overflow, test.overflow)
-			continue
-		}
-
-		// Ensure setting the bytes via the array method works as expected.
-		var f2 FieldVal
-		var b32 [32]byte
-		truncatedInBytes := inBytes
-		if len(truncatedInBytes) > 32 {
-			truncatedInBytes = truncatedInBytes[:32]
-		}
-		copy(b32[32-len(truncatedInBytes):], truncatedInBytes)
-		overflow = f2.SetBytes(&b32) != 0
-		if !reflect.DeepEqual(f2.n, test.expected) {
-			t.Errorf("%s: unexpected result\ngot: %x\nwant: %x", test.name,
-				f2.n, test.expected)
-			continue
-		}
-
-		// Ensure the setting the bytes via the array method produces the
-		// expected overflow result.
-		if overflow != test.overflow {
-			t.Errorf("%s: unexpected overflow -- got: %v, want: %v", test.name,
-				overflow, test.overflow)
-			continue
-		}
-	}
-}
-
 // TestFieldBytes ensures that retrieving the bytes for a 256-bit big-endian
 // unsigned integer via the various methods works as expected for edge cases.
 // Random cases are tested via the various other tests.
@@ -632,224 +427,6 @@ func TestFieldStringer(t *testing.T) {
 	}
 }
 
-// TestFieldNormalize ensures that normalizing the internal field words works as
-// expected.
-func TestFieldNormalize(t *testing.T) {
-	tests := []struct {
-		name       string     // test description
-		raw        [10]uint32 // Intentionally denormalized value
-		normalized [10]uint32 // Normalized form of the raw value
-	}{{
-		name:       "5",
-		raw:        [10]uint32{0x00000005, 0, 0, 0, 0, 0, 0, 0, 0, 0},
-		normalized: [10]uint32{0x00000005, 0, 0, 0, 0, 0, 0, 0, 0, 0},
-	}, {
-		name:       "2^26",
-		raw:        [10]uint32{0x04000000, 0x0, 0, 0, 0, 0, 0, 0, 0, 0},
-		normalized: [10]uint32{0x00000000, 0x1, 0, 0, 0, 0, 0, 0, 0, 0},
-	}, {
-		name:       "2^26 + 1",
-		raw:        [10]uint32{0x04000001, 0x0, 0, 0, 0, 0, 0, 0, 0, 0},
-		normalized: [10]uint32{0x00000001, 0x1, 0, 0, 0, 0, 0, 0, 0, 0},
-	}, {
-		name:       "2^32 - 1",
-		raw:        [10]uint32{0xffffffff, 0x00, 0, 0, 0, 0, 0, 0, 0, 0},
-		normalized: [10]uint32{0x03ffffff, 0x3f, 0, 0, 0, 0, 0, 0, 0, 0},
-	}, {
-		name:       "2^32",
-		raw:        [10]uint32{0x04000000, 0x3f, 0, 0, 0, 0, 0, 0, 0, 0},
-		normalized: [10]uint32{0x00000000, 0x40, 0, 0, 0, 0, 0, 0, 0, 0},
-	}, {
-		name:       "2^32 + 1",
-		raw:        [10]uint32{0x04000001, 0x3f, 0, 0, 0, 0, 0, 0, 0, 0},
-		normalized: [10]uint32{0x00000001, 0x40, 0, 0, 0, 0, 0, 0, 0, 0},
-	}, {
-		name:       "2^64 - 1",
-		raw:        [10]uint32{0xffffffff, 0xffffffc0, 0xfc0, 0, 0, 0, 0, 0, 0, 0},
-		normalized: [10]uint32{0x03ffffff, 0x03ffffff, 0xfff, 0, 0, 0, 0, 0, 0, 0},
-	}, {
-		name:       "2^64",
-		raw:        [10]uint32{0x04000000, 0x03ffffff, 0x0fff, 0, 0, 0, 0, 0, 0, 0},
-		normalized: [10]uint32{0x00000000, 0x00000000, 0x1000, 0, 0, 0, 0, 0, 0, 0},
-	}, {
-		name:       "2^64 + 1",
-		raw:        [10]uint32{0x04000001, 0x03ffffff, 0x0fff, 0, 0, 0, 0, 0, 0, 0},
-		normalized: [10]uint32{0x00000001, 0x00000000, 0x1000, 0, 0, 0, 0, 0, 0, 0},
-	}, {
-		name:       "2^96 - 1",
-		raw:        [10]uint32{0xffffffff, 0xffffffc0, 0xffffffc0, 0x3ffc0, 0, 0, 0, 0, 0, 0},
-		normalized: [10]uint32{0x03ffffff, 0x03ffffff, 0x03ffffff, 0x3ffff, 0, 0, 0, 0, 0, 0},
-	}, {
-		name:       "2^96",
-		raw:        [10]uint32{0x04000000, 0x03ffffff, 0x03ffffff, 0x3ffff, 0, 0, 0, 0, 0, 0},
-		normalized: [10]uint32{0x00000000, 0x00000000, 0x00000000, 0x40000, 0, 0, 0, 0, 0, 0},
-	}, {
-		name:       "2^128 - 1",
-		raw:        [10]uint32{0xffffffff, 0xffffffc0, 0xffffffc0, 0xffffffc0, 0xffffc0, 0, 0, 0, 0, 0},
-		normalized: [10]uint32{0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0xffffff, 0, 0, 0, 0, 0},
-	}, {
-		name:       "2^128",
-		raw:        [10]uint32{0x04000000, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x0ffffff, 0, 0, 0, 0, 0},
-		normalized: [10]uint32{0x00000000, 0x00000000, 0x00000000, 0x00000000, 0x1000000, 0, 0, 0, 0, 0},
-	}, {
-		name:       "2^256 - 4294968273 (secp256k1 prime)",
-		raw:        [10]uint32{0xfffffc2f, 0xffffff80, 0xffffffc0, 0xffffffc0, 0xffffffc0, 0xffffffc0, 0xffffffc0, 0xffffffc0, 0xffffffc0, 0x3fffc0},
-		normalized: [10]uint32{0x00000000, 0x00000000, 0x00000000, 0x00000000, 0x00000000, 0x00000000, 0x00000000, 0x00000000, 0x00000000, 0x000000},
-	}, {
-		// Value larger than P where both first and second words are larger than
-		// P's first and second words
-		name:       "Value > P with 1st and 2nd words > P's 1st and 2nd words",
-		raw:        [10]uint32{0xfffffc30, 0xffffff86, 0xffffffc0, 0xffffffc0, 0xffffffc0, 0xffffffc0, 0xffffffc0, 0xffffffc0, 0xffffffc0, 0x3fffc0},
-		normalized: [10]uint32{0x00000001, 0x00000006, 0x00000000, 0x00000000, 0x00000000, 0x00000000, 0x00000000, 0x00000000, 0x00000000, 0x000000},
-	}, {
-		// Value larger than P where only the second word is larger than P's
-		// second word.
-		name:       "Value > P with 2nd word > P's 2nd word",
-		raw:        [10]uint32{0xfffffc2a, 0xffffff87, 0xffffffc0, 0xffffffc0, 0xffffffc0, 0xffffffc0, 0xffffffc0, 0xffffffc0, 0xffffffc0, 0x3fffc0},
-		normalized: [10]uint32{0x03fffffb, 0x00000006, 0x00000000, 0x00000000, 0x00000000, 0x00000000, 0x00000000, 0x00000000, 0x00000000, 0x000000},
-	}, {
-		name:       "2^256 - 1",
-		raw:        [10]uint32{0xffffffff, 0xffffffc0, 0xffffffc0, 0xffffffc0, 0xffffffc0, 0xffffffc0, 0xffffffc0, 0xffffffc0, 0xffffffc0, 0x3fffc0},
-		normalized: [10]uint32{0x000003d0, 0x00000040, 0x00000000, 0x00000000, 0x00000000, 0x00000000, 0x00000000, 0x00000000, 0x00000000, 0x000000},
-	}, {
-		// Prime with field representation such that the initial reduction does
-		// not result in a carry to bit 256.
-		//
-		// 2^256 - 4294968273 (secp256k1 prime)
-		name:       "2^256 - 4294968273 (secp256k1 prime)",
-		raw:        [10]uint32{0x03fffc2f, 0x03ffffbf, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x003fffff},
-		normalized: [10]uint32{0x00000000, 0x00000000, 0x00000000, 0x00000000, 0x00000000, 0x00000000, 0x00000000, 0x00000000, 0x00000000, 0x00000000},
-	}, {
-		// Value larger than P that reduces to a value which is still larger
-		// than P when it has a magnitude of 1 due to its first word and does
-		// not result in a carry to bit 256.
-		//
-		// 2^256 - 4294968272 (secp256k1 prime + 1)
-		name:       "2^256 - 4294968272 (secp256k1 prime + 1)",
-		raw:        [10]uint32{0x03fffc30, 0x03ffffbf, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x003fffff},
-		normalized: [10]uint32{0x00000001, 0x00000000, 0x00000000, 0x00000000, 0x00000000, 0x00000000, 0x00000000, 0x00000000, 0x00000000, 0x00000000},
-	}, {
-		// Value larger than P that reduces to a value which is still larger
-		// than P when it has a magnitude of 1 due to its second word and does
-		// not result in a carry to bit 256.
-		//
-		// 2^256 - 4227859409 (secp256k1 prime + 0x4000000)
-		name:       "2^256 - 4227859409 (secp256k1 prime + 0x4000000)",
-		raw:        [10]uint32{0x03fffc2f, 0x03ffffc0, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x003fffff},
-		normalized: [10]uint32{0x00000000, 0x00000001, 0x00000000, 0x00000000, 0x00000000, 0x00000000, 0x00000000, 0x00000000, 0x00000000, 0x00000000},
-	}, {
-		// Value larger than P that reduces to a value which is still larger
-		// than P when it has a magnitude of 1 due to a carry to bit 256, but
-		// would not be without the carry.  These values come from the fact that
-		// P is 2^256 - 4294968273 and 977 is the low order word in the internal
-		// field representation.
-		//
-		// 2^256 * 5 - ((4294968273 - (977+1)) * 4)
-		name:       "2^256 * 5 - ((4294968273 - (977+1)) * 4)",
-		raw:        [10]uint32{0x03ffffff, 0x03fffeff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x0013fffff},
-		normalized: [10]uint32{0x00001314, 0x00000040, 0x00000000, 0x00000000, 0x00000000, 0x00000000, 0x00000000, 0x00000000, 0x00000000, 0x000000000},
-	}, {
-		// Value larger than P that reduces to a value which is still larger
-		// than P when it has a magnitude of 1 due to both a carry to bit 256
-		// and the first word.
-		name:       "Value > P with redux > P at mag 1 due to 1st word and carry to bit 256",
-		raw:        [10]uint32{0x03fffc30, 0x03ffffbf, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x07ffffff, 0x003fffff},
-		normalized: [10]uint32{0x00000001, 0x00000000, 0x00000000, 0x00000000, 0x00000000, 0x00000000, 0x00000000, 0x00000000, 0x00000000, 0x00000001},
-	}, {
-		// Value larger than P that reduces to a value which is still larger
-		// than P when it has a magnitude of 1 due to both a carry to bit 256
-		// and the second word.
-		name:       "Value > P with redux > P at mag 1 due to 2nd word and carry to bit 256",
-		raw:        [10]uint32{0x03fffc2f, 0x03ffffc0, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x3ffffff, 0x07ffffff, 0x003fffff},
-		normalized: [10]uint32{0x00000000, 0x00000001, 0x00000000, 0x00000000, 0x00000000, 0x00000000, 0x00000000, 0x0000000, 0x00000000, 0x00000001},
-	}, {
-		// Value larger than P that reduces to a value which is still larger
-		// than P when it has a magnitude of 1 due to a carry to bit 256 and the
-		// first and second words.
-		name:       "Value > P with redux > P at mag 1 due to 1st and 2nd words and carry to bit 256",
-		raw:        [10]uint32{0x03fffc30, 0x03ffffc0, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x07ffffff, 0x003fffff},
-		normalized: [10]uint32{0x00000001, 0x00000001, 0x00000000, 0x00000000, 0x00000000, 0x00000000, 0x00000000, 0x00000000, 0x00000000, 0x00000001},
-	}, {
-		// ---------------------------------------------------------------------
-		// There are 3 main conditions that must be true if the final reduction
-		// is needed after the initial reduction to magnitude 1 when there was
-		// NOT a carry to bit 256 (in other words when the original value was <
-		// 2^256):
-		// 1) The final word of the reduced value is equal to the one of P
-		// 2) The 3rd through 9th words are equal to those of P
-		// 3) Either:
-		//    - The 2nd word is greater than the one of P; or
-		//    - The 2nd word is equal to that of P AND the 1st word is greater
-		//
-		// Therefore the eight possible combinations of those 3 main conditions
-		// can be thought of in binary where each bit starting from the left
-		// corresponds to the aforementioned conditions as such:
-		// 000, 001, 010, 011, 100, 101, 110, 111
-		//
-		// For example, combination 6 is when both conditons 1 and 2 are true,
-		// but condition 3 is NOT true.
-		//
-		// The following tests hit each of these combinations and refer to each
-		// by its decimal equivalent for ease of reference.
-		//
-		// NOTE: The final combination (7) is already tested above since it only
-		// happens when the original value is already the normalized
-		// representation of P.
-		// ---------------------------------------------------------------------
-
-		name:       "Value < 2^256 final reduction combination 0",
-		raw:        [10]uint32{0x03fff85e, 0x03ffffbf, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03fffffe, 0x003ffffe},
-		normalized: [10]uint32{0x03fff85e, 0x03ffffbf, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03fffffe, 0x003ffffe},
-	}, {
-		name:       "Value < 2^256 final reduction combination 1 via 2nd word",
-		raw:        [10]uint32{0x03fff85e, 0x03ffffc0, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03fffffe, 0x003ffffe},
-		normalized: [10]uint32{0x03fff85e, 0x03ffffc0, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03fffffe, 0x003ffffe},
-	}, {
-		name:       "Value < 2^256 final reduction combination 1 via 1st word",
-		raw:        [10]uint32{0x03fffc2f, 0x03ffffbf, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03fffffe, 0x003ffffe},
-		normalized: [10]uint32{0x03fffc2f, 0x03ffffbf, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03fffffe, 0x003ffffe},
-	}, {
-		name:       "Value < 2^256 final reduction combination 2",
-		raw:        [10]uint32{0x03fff85e, 0x03ffffbf, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x003ffffe},
-		normalized: [10]uint32{0x03fff85e, 0x03ffffbf, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x003ffffe},
-	}, {
-		name:       "Value < 2^256 final reduction combination 3 via 2nd word",
-		raw:        [10]uint32{0x03fff85e, 0x03ffffc0, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x003ffffe},
-		normalized: [10]uint32{0x03fff85e, 0x03ffffc0, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x003ffffe},
-	}, {
-		name:       "Value < 2^256 final reduction combination 3 via 1st word",
-		raw:        [10]uint32{0x03fffc2f, 0x03ffffbf, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x003ffffe},
-		normalized: [10]uint32{0x03fffc2f, 0x03ffffbf, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x003ffffe},
-	}, {
-		name:       "Value < 2^256 final reduction combination 4",
-		raw:        [10]uint32{0x03fff85e, 0x03ffffbf, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03fffffe, 0x003fffff},
-		normalized: [10]uint32{0x03fff85e, 0x03ffffbf, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03fffffe, 0x003fffff},
-	}, {
-		name:       "Value < 2^256 final reduction combination 5 via 2nd word",
-		raw:        [10]uint32{0x03fff85e, 0x03ffffc0, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03fffffe, 0x003fffff},
-		normalized: [10]uint32{0x03fff85e, 0x03ffffc0, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03fffffe, 0x003fffff},
-	}, {
-		name:       "Value < 2^256 final reduction combination 5 via 1st word",
-		raw:        [10]uint32{0x03fffc2f, 0x03ffffbf, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03fffffe, 0x003fffff},
-		normalized: [10]uint32{0x03fffc2f, 0x03ffffbf, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03fffffe, 0x003fffff},
-	}, {
-		name:       "Value < 2^256 final reduction combination 6",
-		raw:        [10]uint32{0x03fff85e, 0x03ffffbf, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x003fffff},
-		normalized: [10]uint32{0x03fff85e, 0x03ffffbf, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x03ffffff, 0x003fffff},
-	}}
-
-	for _, test := range tests {
-		f := new(FieldVal)
-		f.n = test.raw
-		f.Normalize()
-		if !reflect.DeepEqual(f.n, test.normalized) {
-			t.Errorf("%s: wrong normalized result\ngot: %x\nwant: %x",
-				test.name, f.n, test.normalized)
-			continue
-		}
-	}
-}
-
 // TestFieldIsOdd ensures that checking if a field value is odd via IsOdd and
 // IsOddBit works as expected.
 func TestFieldIsOdd(t *testing.T) {
@@ -1635,12 +1212,107 @@ func TestFieldInverse(t *testing.T) {
 	for _, test := range tests {
 		f := new(FieldVal).SetHex(test.in).Normalize()
 		expected := new(FieldVal).SetHex(test.expected).Normalize()
-		result := f.Inverse().Normalize()
+		result := new(FieldVal).Set(f).Inverse().Normalize()
 		if !result.Equals(expected) {
 			t.Errorf("%s: d wrong result\ngot: %v\nwant: %v", test.name, result,
 				expected)
 			continue
 		}
+
+		// Ensure the non-constant time variant produces the same result.
+		result2 := new(FieldVal).Set(f).InverseNonConst()
+		if !result2.Equals(expected) {
+			t.Errorf("%s: d wrong non-constant time result\ngot: %v\nwant: %v",
+				test.name, result2, expected)
+			continue
+		}
+	}
+}
+
+// TestFieldInverseRandom ensures that finding the multiplicative inverse via
+// Inverse and InverseNonConst for random values works as expected by also
+// performing the same operation with big ints and comparing the results.
+func TestFieldInverseRandom(t *testing.T) {
+	// Use a unique random seed each test instance and log it if the tests fail.
+	seed := time.Now().Unix()
+	rng := rand.New(rand.NewSource(seed))
+	defer func(t *testing.T, seed int64) {
+		if t.Failed() {
+			t.Logf("random seed: %d", seed)
+		}
+	}(t, seed)
+
+	for i := 0; i < 100; i++ {
+		// Generate big integer and field value with the same random value.
+		bigIntVal, fVal := randIntAndFieldVal(t, rng)
+
+		// Calculate the inverse of the value using big ints.
+		bigIntResult := new(big.Int).ModInverse(bigIntVal, curveParams.P)
+		if bigIntResult == nil {
+			bigIntResult = new(big.Int)
+		}
+
+		// Calculate the inverse of the value using a field value in both
+		// constant and non-constant time.
+		fValResult := new(FieldVal).Set(fVal).Inverse()
+		fValResult2 := new(FieldVal).Set(fVal).InverseNonConst()
+
+		// Ensure they match.
+		bigIntResultHex := fmt.Sprintf("%064x", bigIntResult)
+		fValResultHex := fmt.Sprintf("%v", fValResult)
+		fValResult2Hex := fmt.Sprintf("%v", fValResult2)
+		if bigIntResultHex != fValResultHex {
+			t.Fatalf("mismatched inverse\nbig int in: %x\nfield in: %v\n"+
+				"big int result: %x\nfield result %v", bigIntVal, fVal,
+				bigIntResult, fValResult)
+		}
+		if bigIntResultHex != fValResult2Hex {
+			t.Fatalf("mismatched non-constant time inverse\nbig int in: %x\n"+
+				"field in: %v\nbig int result: %x\nfield result %v", bigIntVal,
+				fVal, bigIntResult, fValResult2)
+		}
+	}
+}
+
+// TestFieldBatchInverse ensures that finding the multiplicative inverses of
+// several field values at once via BatchInverse produces the same results as
+// inverting each of them individually for random values of various lengths
+// that include zeros.
+func TestFieldBatchInverse(t *testing.T) {
+	// Use a unique random seed each test instance and log it if the tests fail.
+	seed := time.Now().Unix()
+	rng := rand.New(rand.NewSource(seed))
+	defer func(t *testing.T, seed int64) {
+		if t.Failed() {
+			t.Logf("random seed: %d", seed)
+		}
+	}(t, seed)
+
+	for _, n := range []int{0, 1, 2, 7, 8, 9, 50} {
+		vals := make([]FieldVal, n)
+		for i := range vals {
+			// Leave roughly one in five values as zero and make some of the
+			// others unnormalized with a larger magnitude.
+			if rng.Intn(5) == 0 {
+				continue
+			}
+			vals[i].Set(randFieldVal(t, rng))
+			if rng.Intn(2) == 0 {
+				vals[i].Add(randFieldVal(t, rng))
+			}
+		}
+
+		want := make([]FieldVal, n)
+		for i := range vals {
+			want[i].Set(&vals[i]).Inverse()
+		}
+		BatchInverse(vals)
+		for i := range vals {
+			if !vals[i].Equals(&want[i]) {
+				t.Fatalf("n=%d: mismatched inverse at index %d\ngot: %v\nwant: "+
+					"%v", n, i, vals[i], want[i])
+			}
+		}
 	}
 }
 
@@ -23,7 +23,7 @@ import (
 	"math/big"
 	"os"
 
-	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/KarpelesLab/secp256k1"
 )
 
 // curveParams houses the secp256k1 curve parameters for convenient access.
@@ -37,12 +37,47 @@ func bigAffineToJacobian(x, y *big.Int, result *secp256k1.JacobianPoint) {
 	result.Z.SetInt(1)
 }
 
-// serializedBytePoints returns a serialized byte slice which contains all of
-// the possible points per 8-bit window.  This is used to when generating
-// compressedbytepoints.go.
-func serializedBytePoints() []byte {
+// basePointsConfig describes a configuration of the pre-computed table used to
+// accelerate scalar base multiplication along with the file and build
+// constraint for its compressed form.
+type basePointsConfig struct {
+	fileName   string
+	buildTags  string
+	windowBits int
+	signed     bool
+}
+
+// basePointsConfigs houses all of the supported configurations of the
+// pre-computed table.  They must be kept in sync with the build tags that
+// select the window size and digit representation in the main package.
+var basePointsConfigs = []basePointsConfig{{
+	fileName:   "compressedbytepoints.go",
+	buildTags:  "!secp256k1_precomp_runtime && !secp256k1_precomp_w4 && !secp256k1_precomp_signed",
+	windowBits: 8,
+}, {
+	fileName:   "compressedbytepoints_signed.go",
+	buildTags:  "!secp256k1_precomp_runtime && !secp256k1_precomp_w4 && secp256k1_precomp_signed",
+	windowBits: 8,
+	signed:     true,
+}, {
+	fileName:   "compressedbytepoints_w4.go",
+	buildTags:  "!secp256k1_precomp_runtime && secp256k1_precomp_w4 && !secp256k1_precomp_signed",
+	windowBits: 4,
+}, {
+	fileName:   "compressedbytepoints_w4_signed.go",
+	buildTags:  "!secp256k1_precomp_runtime && secp256k1_precomp_w4 && secp256k1_precomp_signed",
+	windowBits: 4,
+	signed:     true,
+}}
+
+// serializedBasePoints returns a serialized byte slice which contains all of
+// the points per window for the passed configuration.  That is all 2^w points
+// per w-bit window for unsigned digits and only the first 2^(w-1) + 1 of them
+// for signed digits.  This is used when generating compressedbytepoints.go and
+// its variants.
+func serializedBasePoints(cfg *basePointsConfig) []byte {
 	// Calculate G^(2^i) for i in 0..255.  These are used to avoid recomputing
-	// them for each digit of the 8-bit windows.
+	// them for each digit of the windows.
 	doublingPoints := make([]secp256k1.JacobianPoint, curveParams.BitSize)
 	var q secp256k1.JacobianPoint
 	bigAffineToJacobian(curveParams.Gx, curveParams.Gy, &q)
@@ -52,20 +87,25 @@ func serializedBytePoints() []byte {
 		secp256k1.DoubleNonConst(&q, &q)
 	}
 
-	// Separate the bits into byte-sized windows.
-	curveByteSize := curveParams.BitSize / 8
-	serialized := make([]byte, curveByteSize*256*2*32)
+	// Separate the bits into windows.
+	windowBits := cfg.windowBits
+	numWindows := curveParams.BitSize / windowBits
+	numEntries := 1 << windowBits
+	if cfg.signed {
+		numEntries = 1<<(windowBits-1) + 1
+	}
+	serialized := make([]byte, numWindows*numEntries*2*32)
 	offset := 0
-	for byteNum := 0; byteNum < curveByteSize; byteNum++ {
-		// Grab the 8 bits that make up this byte from doubling points.
-		startingBit := 8 * (curveByteSize - byteNum - 1)
-		windowPoints := doublingPoints[startingBit : startingBit+8]
+	for window := 0; window < numWindows; window++ {
+		// Grab the bits that make up this window from doubling points.
+		startingBit := windowBits * (numWindows - window - 1)
+		windowPoints := doublingPoints[startingBit : startingBit+windowBits]
 
 		// Compute all points in this window, convert them to affine, and
 		// serialize them.
-		for i := 0; i < 256; i++ {
+		for i := 0; i < numEntries; i++ {
 			var point secp256k1.JacobianPoint
-			for bit := 0; bit < 8; bit++ {
+			for bit := 0; bit < windowBits; bit++ {
 				if i>>uint(bit)&1 == 1 {
 					secp256k1.AddNonConst(&point, &windowPoints[bit], &point)
 				}
@@ -82,6 +122,51 @@ func serializedBytePoints() []byte {
 	return serialized
 }
 
+// writeCompressedBasePoints generates the file that houses the compressed
+// pre-computed table for the passed configuration.
+func writeCompressedBasePoints(cfg *basePointsConfig) error {
+	fi, err := os.Create(cfg.fileName)
+	if err != nil {
+		return err
+	}
+	defer fi.Close()
+
+	// Compress the serialized base points.
+	serialized := serializedBasePoints(cfg)
+	var compressed bytes.Buffer
+	w := zlib.NewWriter(&compressed)
+	if _, err := w.Write(serialized); err != nil {
+		return err
+	}
+	w.Close()
+
+	// Encode the compressed base points with base64.
+	encoded := make([]byte, base64.StdEncoding.EncodedLen(compressed.Len()))
+	base64.StdEncoding.Encode(encoded, compressed.Bytes())
+
+	fmt.Fprintln(fi, "// Copyright (c) 2015 The btcsuite developers")
+	fmt.Fprintln(fi, "// Copyright (c) 2015-2022 The Decred developers")
+	fmt.Fprintln(fi, "// Use of this source code is governed by an ISC")
+	fmt.Fprintln(fi, "// license that can be found in the LICENSE file.")
+	fmt.Fprintln(fi)
+	fmt.Fprintf(fi, "//go:build %s\n", cfg.buildTags)
+	fmt.Fprintln(fi)
+	fmt.Fprintln(fi, "package secp256k1")
+	fmt.Fprintln(fi)
+	fmt.Fprintln(fi, "// Auto-generated file (see genprecomps.go)")
+	fmt.Fprintln(fi, "// DO NOT EDIT")
+	fmt.Fprintln(fi)
+	fmt.Fprintf(fi, "var compressedBytePoints = %q\n", string(encoded))
+	fmt.Fprintln(fi)
+	fmt.Fprintln(fi, "// Set accessor to a real function.")
+	fmt.Fprintln(fi, "func init() {")
+	fmt.Fprintln(fi, "	compressedBytePointsFn = func() string {")
+	fmt.Fprintln(fi, "		return compressedBytePoints")
+	fmt.Fprintln(fi, "	}")
+	fmt.Fprintln(fi, "}")
+	return nil
+}
+
 // sqrt returns the square root of the provided big integer using Newton's
 // method.  It's only compiled and used during generation of pre-computed
 // values, so speed is not a huge concern.
@@ -333,44 +418,11 @@ func deriveEndomorphismParams() [2]endomorphismParams {
 }
 
 func main() {
-	fi, err := os.Create("compressedbytepoints.go")
-	if err != nil {
-		log.Fatal(err)
-	}
-	defer fi.Close()
-
-	// Compress the serialized byte points.
-	serialized := serializedBytePoints()
-	var compressed bytes.Buffer
-	w := zlib.NewWriter(&compressed)
-	if _, err := w.Write(serialized); err != nil {
-		fmt.Println(err)
-		os.Exit(1)
+	for i := range basePointsConfigs {
+		if err := writeCompressedBasePoints(&basePointsConfigs[i]); err != nil {
+			log.Fatal(err)
+		}
 	}
-	w.Close()
-
-	// Encode the compressed byte points with base64.
-	encoded := make([]byte, base64.StdEncoding.EncodedLen(compressed.Len()))
-	base64.StdEncoding.Encode(encoded, compressed.Bytes())
-
-	fmt.Fprintln(fi, "// Copyright (c) 2015 The btcsuite developers")
-	fmt.Fprintln(fi, "// Copyright (c) 2015-2022 The Decred developers")
-	fmt.Fprintln(fi, "// Use of this source code is governed by an ISC")
-	fmt.Fprintln(fi, "// license that can be found in the LICENSE file.")
-	fmt.Fprintln(fi)
-	fmt.Fprintln(fi, "package secp256k1")
-	fmt.Fprintln(fi)
-	fmt.Fprintln(fi, "// Auto-generated file (see genprecomps.go)")
-	fmt.Fprintln(fi, "// DO NOT EDIT")
-	fmt.Fprintln(fi)
-	fmt.Fprintf(fi, "var compressedBytePoints = %q\n", string(encoded))
-	fmt.Fprintln(fi)
-	fmt.Fprintln(fi, "// Set accessor to a real function.")
-	fmt.Fprintln(fi, "func init() {")
-	fmt.Fprintln(fi, "	compressedBytePointsFn = func() string {")
-	fmt.Fprintln(fi, "		return compressedBytePoints")
-	fmt.Fprintln(fi, "	}")
-	fmt.Fprintln(fi, "}")
 
 	printParams := func(p *endomorphismParams) {
 		fmt.Printf("lambda: %x\n", p.lambda)
@@ -0,0 +1,103 @@
+// Package batch provides the logic that is shared by the batch verification of
+// ECDSA and Schnorr signatures, namely deriving the random coefficients of the
+// batch equation and bisecting batches that contain invalid signatures.
+package batch
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"hash"
+	"io"
+)
+
+// CoefficientSize is the size of the random coefficients the verification
+// equation of each signature in a batch is multiplied by.
+const CoefficientSize = 16
+
+// Coefficients derives the random coefficients of the batch equation from a
+// hash that commits to every signature, hash, and public key in the batch, so
+// they can't be predicted before all of the signatures are chosen.  This is
+// the approach suggested for batch verification of BIP340 Schnorr signatures
+// and avoids the need for a source of randomness while providing the same
+// security.
+//
+// The data that describes every item in the batch must be written before any
+// coefficients are requested.
+type Coefficients struct {
+	h    hash.Hash
+	seed [sha256.Size + 4]byte
+	done bool
+}
+
+// NewCoefficients returns a new instance for deriving the random coefficients
+// of a batch.
+func NewCoefficients() *Coefficients {
+	return &Coefficients{h: sha256.New()}
+}
+
+// Write commits to the passed data that describes an item in the batch.
+func (c *Coefficients) Write(data []byte) {
+	c.h.Write(data)
+}
+
+// Coefficient returns the random coefficient for the item at the passed index
+// in the batch.  The coefficient of the first item is always one since that
+// does not affect the security and saves a multiplication.
+func (c *Coefficients) Coefficient(index int) [CoefficientSize]byte {
+	var coeff [CoefficientSize]byte
+	if index == 0 {
+		coeff[CoefficientSize-1] = 1
+		return coeff
+	}
+	if !c.done {
+		c.h.Sum(c.seed[:0])
+		c.done = true
+	}
+	binary.BigEndian.PutUint32(c.seed[sha256.Size:], uint32(index))
+	digest := sha256.Sum256(c.seed[:])
+	copy(coeff[:], digest[:CoefficientSize])
+	return coeff
+}
+
+// RandomCoefficient reads the coefficient for the item at the passed index in
+// the batch from the passed source of randomness.  As with the coefficients
+// derived by Coefficients, the coefficient of the first item is always one.
+func RandomCoefficient(rand io.Reader, index int) ([CoefficientSize]byte, error) {
+	var coeff [CoefficientSize]byte
+	if index == 0 {
+		coeff[CoefficientSize-1] = 1
+		return coeff, nil
+	}
+	if _, err := io.ReadFull(rand, coeff[:]); err != nil {
+		return coeff, err
+	}
+	return coeff, nil
+}
+
+// Bisect verifies the passed entries as a batch and returns the passed failed
+// indices with the indices of the entries that are not valid appended to them.
+//
+// The holds function must return whether or not the batch equation holds for
+// the entries it is passed.  When it does not, the entries are bisected
+// recursively until the invalid entries are isolated, at which point the
+// verify function must verify the remaining entry individually and return its
+// index along with whether or not it is valid.
+func Bisect[E any](entries []E, holds func([]E) bool, verify func(*E) (int, bool), failed []int) []int {
+	if len(entries) == 0 || holds(entries) {
+		return failed
+	}
+
+	// Individually verify the entry when it is the only one left since the
+	// batch equation may also fail for signatures that are otherwise valid,
+	// such as ECDSA signatures with an incorrect recovery code.
+	if len(entries) == 1 {
+		if index, valid := verify(&entries[0]); !valid {
+			failed = append(failed, index)
+		}
+		return failed
+	}
+
+	mid := len(entries) / 2
+	failed = Bisect(entries[:mid], holds, verify, failed)
+	return Bisect(entries[mid:], holds, verify, failed)
+}
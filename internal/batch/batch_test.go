@@ -0,0 +1,137 @@
+package batch
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"reflect"
+	"testing"
+)
+
+// TestBisect ensures bisecting batches identifies exactly the invalid entries
+// in ascending order and only verifies entries individually once they are
+// isolated.
+func TestBisect(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string // test description
+		size    int    // number of entries in the batch
+		invalid []int  // indices of the invalid entries
+	}{{
+		name: "empty batch",
+		size: 0,
+	}, {
+		name: "all valid",
+		size: 17,
+	}, {
+		name:    "single invalid entry",
+		size:    1,
+		invalid: []int{0},
+	}, {
+		name:    "several invalid entries",
+		size:    32,
+		invalid: []int{0, 5, 6, 19, 31},
+	}, {
+		name:    "all invalid",
+		size:    5,
+		invalid: []int{0, 1, 2, 3, 4},
+	}}
+
+	for _, test := range tests {
+		// The entries are their own indices and the batch equation holds when
+		// none of the entries are invalid.
+		entries := make([]int, test.size)
+		isInvalid := make(map[int]bool)
+		for i := range entries {
+			entries[i] = i
+		}
+		for _, i := range test.invalid {
+			isInvalid[i] = true
+		}
+		holds := func(entries []int) bool {
+			for _, entry := range entries {
+				if isInvalid[entry] {
+					return false
+				}
+			}
+			return true
+		}
+		var numVerified int
+		verify := func(entry *int) (int, bool) {
+			numVerified++
+			return *entry, !isInvalid[*entry]
+		}
+
+		failed := Bisect(entries, holds, verify, nil)
+		if !reflect.DeepEqual(failed, test.invalid) {
+			t.Errorf("%q: unexpected failed indices -- got %v, want %v",
+				test.name, failed, test.invalid)
+			continue
+		}
+		if numVerified != len(test.invalid) {
+			t.Errorf("%q: unexpected number of individual verifications -- "+
+				"got %d, want %d", test.name, numVerified, len(test.invalid))
+			continue
+		}
+	}
+}
+
+// TestCoefficients ensures the coefficients are deterministic, that the first
+// one is always one, and that they commit to all of the written data.
+func TestCoefficients(t *testing.T) {
+	t.Parallel()
+
+	coeffs := func(data ...string) [][CoefficientSize]byte {
+		c := NewCoefficients()
+		for _, d := range data {
+			c.Write([]byte(d))
+		}
+		result := make([][CoefficientSize]byte, 3)
+		for i := range result {
+			result[i] = c.Coefficient(i)
+		}
+		return result
+	}
+
+	var one [CoefficientSize]byte
+	one[CoefficientSize-1] = 1
+	a := coeffs("item1", "item2")
+	if a[0] != one {
+		t.Fatalf("unexpected first coefficient %x", a[0])
+	}
+	if !reflect.DeepEqual(a, coeffs("item1", "item2")) {
+		t.Fatal("coefficients are not deterministic")
+	}
+	if bytes.Equal(a[1][:], a[2][:]) {
+		t.Fatal("coefficients for different items are the same")
+	}
+	if b := coeffs("item1", "item3"); a[1] == b[1] || a[2] == b[2] {
+		t.Fatal("coefficients do not commit to the written data")
+	}
+}
+
+// TestRandomCoefficient ensures random coefficients are read from the source of
+// randomness for all but the first item, whose coefficient is one, and that
+// failures to read from it are returned.
+func TestRandomCoefficient(t *testing.T) {
+	t.Parallel()
+
+	data := bytes.Repeat([]byte{0x01}, CoefficientSize)
+	var one, want [CoefficientSize]byte
+	one[CoefficientSize-1] = 1
+	copy(want[:], data)
+
+	coeff, err := RandomCoefficient(bytes.NewReader(nil), 0)
+	if err != nil || coeff != one {
+		t.Fatalf("unexpected first coefficient %x (err %v)", coeff, err)
+	}
+	coeff, err = RandomCoefficient(bytes.NewReader(data), 1)
+	if err != nil || coeff != want {
+		t.Fatalf("unexpected coefficient %x (err %v)", coeff, err)
+	}
+	_, err = RandomCoefficient(bytes.NewReader(data[1:]), 1)
+	if !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Fatalf("mismatched err -- got %v, want %v", err, io.ErrUnexpectedEOF)
+	}
+}
@@ -0,0 +1,40 @@
+// Package schnorrhook allows the schnorr package to provide the secp256k1
+// package with the functions that sign with and parse Schnorr signatures,
+// which it can't import itself since the schnorr package depends on it.
+//
+// The functions are stored as empty interfaces since this package can't refer
+// to the types of the secp256k1 package either, so the secp256k1 package
+// asserts them to the function types it expects.
+package schnorrhook
+
+import "sync"
+
+var (
+	mtx   sync.RWMutex
+	sign  any
+	parse any
+)
+
+// Register registers the functions that sign with and parse Schnorr
+// signatures.  It panics when the functions are nil or have already been
+// registered, so they can't be replaced once registered.
+func Register(signFn, parseFn any) {
+	if signFn == nil || parseFn == nil {
+		panic("schnorrhook: nil function registered")
+	}
+
+	mtx.Lock()
+	defer mtx.Unlock()
+	if sign != nil {
+		panic("schnorrhook: functions registered more than once")
+	}
+	sign, parse = signFn, parseFn
+}
+
+// Funcs returns the registered functions that sign with and parse Schnorr
+// signatures or nil when they have not been registered.
+func Funcs() (signFn, parseFn any) {
+	mtx.RLock()
+	defer mtx.RUnlock()
+	return sign, parse
+}
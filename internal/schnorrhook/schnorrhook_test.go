@@ -0,0 +1,39 @@
+package schnorrhook
+
+import "testing"
+
+// TestRegister ensures the functions are only available once registered and
+// that attempting to register nil functions or to replace the registered ones
+// panics.
+func TestRegister(t *testing.T) {
+	// mustPanic ensures the passed function panics.
+	mustPanic := func(desc string, f func()) {
+		t.Helper()
+		defer func() {
+			if recover() == nil {
+				t.Fatalf("%s did not panic", desc)
+			}
+		}()
+		f()
+	}
+
+	if signFn, parseFn := Funcs(); signFn != nil || parseFn != nil {
+		t.Fatal("functions available before they were registered")
+	}
+	mustPanic("registering nil functions", func() { Register(nil, nil) })
+
+	signFn := func() int { return 1 }
+	parseFn := func() int { return 2 }
+	Register(signFn, parseFn)
+	gotSign, gotParse := Funcs()
+	if gotSign.(func() int)() != 1 || gotParse.(func() int)() != 2 {
+		t.Fatal("mismatched registered functions")
+	}
+
+	mustPanic("registering functions twice", func() {
+		Register(parseFn, signFn)
+	})
+	if gotSign, _ := Funcs(); gotSign.(func() int)() != 1 {
+		t.Fatal("registered functions were replaced")
+	}
+}
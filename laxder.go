@@ -0,0 +1,167 @@
+package secp256k1
+
+import "fmt"
+
+// parseLaxDERInt parses the ASN.1 integer identifier and length of an integer
+// in a loosely DER-encoded signature starting at the passed position and
+// returns the position of the integer data along with its length.  The
+// provided error kind is used when the identifier is not the expected one.
+func parseLaxDERInt(sig []byte, pos int, idErr ErrorKind, name string) (int, int, error) {
+	// Integer identifier.
+	if pos == len(sig) {
+		str := fmt.Sprintf("malformed signature: no integer identifier for %s",
+			name)
+		return 0, 0, signatureError(ErrSigTooShort, str)
+	}
+	if sig[pos] != asn1IntegerID {
+		str := fmt.Sprintf("malformed signature: %s integer id %#x != %#x",
+			name, sig[pos], asn1IntegerID)
+		return 0, 0, signatureError(idErr, str)
+	}
+	pos++
+
+	// Integer length, which may be in either the short or long form and
+	// padded with leading zeros in the latter case.
+	if pos == len(sig) {
+		str := fmt.Sprintf("malformed signature: no length for %s", name)
+		return 0, 0, signatureError(ErrSigTooShort, str)
+	}
+	intLen := int(sig[pos])
+	pos++
+	if intLen&0x80 != 0 {
+		numLenBytes := intLen - 0x80
+		if numLenBytes > len(sig)-pos {
+			str := fmt.Sprintf("malformed signature: bogus %s length bytes",
+				name)
+			return 0, 0, signatureError(ErrSigInvalidDataLen, str)
+		}
+		for numLenBytes > 0 && sig[pos] == 0 {
+			pos++
+			numLenBytes--
+		}
+		if numLenBytes >= 4 {
+			str := fmt.Sprintf("malformed signature: %s length too large",
+				name)
+			return 0, 0, signatureError(ErrSigInvalidDataLen, str)
+		}
+		intLen = 0
+		for ; numLenBytes > 0; numLenBytes-- {
+			intLen = intLen<<8 | int(sig[pos])
+			pos++
+		}
+	}
+	if intLen > len(sig)-pos {
+		str := fmt.Sprintf("malformed signature: bogus %s length", name)
+		return 0, 0, signatureError(ErrSigInvalidDataLen, str)
+	}
+	return pos, intLen, nil
+}
+
+// setLaxDERInt sets the passed scalar to the big-endian integer encoded by the
+// passed bytes with any leading zeros ignored and returns whether or not it
+// overflows the group order.
+func setLaxDERInt(s *ModNScalar, b []byte) bool {
+	for len(b) > 0 && b[0] == 0 {
+		b = b[1:]
+	}
+	if len(b) > 32 {
+		return true
+	}
+	return s.SetByteSlice(b)
+}
+
+// ParseDERSignatureLax parses a signature that is loosely encoded in the
+// Distinguished Encoding Rules (DER) format.  It is equivalent to the
+// ecdsa_signature_parse_der_lax function that libsecp256k1 provides for
+// parsing the malformed signatures found in Bitcoin history prior to the
+// activation of BIP0066 and thus accepts signatures with:
+//
+//   - Sequence and integer lengths in the long form, including with leading
+//     zeros
+//   - A sequence length that does not match the remaining data
+//   - R and S values with excess zero padding
+//   - R and S values with the highest bit set, which are treated as unsigned
+//     rather than negative
+//   - R and S values of zero
+//   - Trailing data after the S value
+//
+// Just as with libsecp256k1, signatures with R or S values that are greater
+// than or equal to the group order are not rejected.  Instead, a signature
+// with both R and S set to zero, which never verifies, is returned in that
+// case.  Only signatures that can't be parsed at all result in an error.
+//
+// This should only be used for handling historical data.  ParseDERSignature
+// should be used for everything else.
+func ParseDERSignatureLax(sig []byte) (*Signature, error) {
+	// Sequence identifier.
+	pos := 0
+	if pos == len(sig) {
+		str := "malformed signature: no sequence identifier"
+		return nil, signatureError(ErrSigTooShort, str)
+	}
+	if sig[pos] != asn1SequenceID {
+		str := fmt.Sprintf("malformed signature: format has wrong type: %#x",
+			sig[pos])
+		return nil, signatureError(ErrSigInvalidSeqID, str)
+	}
+	pos++
+
+	// Sequence length, which is ignored other than skipping the additional
+	// length bytes in the long form.
+	if pos == len(sig) {
+		str := "malformed signature: no sequence length"
+		return nil, signatureError(ErrSigTooShort, str)
+	}
+	seqLen := int(sig[pos])
+	pos++
+	if seqLen&0x80 != 0 {
+		numLenBytes := seqLen - 0x80
+		if numLenBytes > len(sig)-pos {
+			str := "malformed signature: bogus sequence length bytes"
+			return nil, signatureError(ErrSigInvalidDataLen, str)
+		}
+		pos += numLenBytes
+	}
+
+	// R and S.
+	rPos, rLen, err := parseLaxDERInt(sig, pos, ErrSigInvalidRIntID, "R")
+	if err != nil {
+		return nil, err
+	}
+	sPos, sLen, err := parseLaxDERInt(sig, rPos+rLen, ErrSigInvalidSIntID, "S")
+	if err != nil {
+		return nil, err
+	}
+
+	// Return a signature that never verifies when either value overflows.
+	var signature Signature
+	signature.v = 0xff
+	rOverflow := setLaxDERInt(&signature.r, sig[rPos:rPos+rLen])
+	sOverflow := setLaxDERInt(&signature.s, sig[sPos:sPos+sLen])
+	if rOverflow || sOverflow {
+		signature.r.Zero()
+		signature.s.Zero()
+	}
+	return &signature, nil
+}
+
+// SplitSigHashType splits a signature with a trailing signature hash type byte,
+// as used in Bitcoin scripts, into the encoded signature and the hash type.
+//
+// The returned signature shares the underlying array of the passed one.
+func SplitSigHashType(sig []byte) ([]byte, byte, error) {
+	if len(sig) == 0 {
+		str := "malformed signature: no signature hash type"
+		return nil, 0, signatureError(ErrSigTooShort, str)
+	}
+	last := len(sig) - 1
+	return sig[:last:last], sig[last], nil
+}
+
+// SerializeWithSigHashType returns the signature serialized in the DER format
+// followed by the passed signature hash type byte, as used in Bitcoin scripts.
+func (sig *Signature) SerializeWithSigHashType(hashType byte) []byte {
+	// The maximum length of a DER encoded signature is 72 bytes.
+	b := sig.AppendSerialized(make([]byte, 0, 73))
+	return append(b, hashType)
+}
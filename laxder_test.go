@@ -0,0 +1,218 @@
+package secp256k1
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+)
+
+// TestParseDERSignatureLax ensures that parsing loosely-encoded DER signatures
+// accepts the encodings that libsecp256k1 accepts, returns a signature that
+// never verifies when the values overflow, and rejects data that can't be
+// parsed with the expected error.
+func TestParseDERSignatureLax(t *testing.T) {
+	t.Parallel()
+
+	const (
+		r    = "4e45e16932b8af514961a1d3a1a25fdf3f4f7732e9d624c6c61548ab5fb8cd41"
+		s    = "181522ec8eca07de4860a4acdd12909d831cc56cbbac4622082221a8768d1d09"
+		negR = "8a9bf8e3c2d1b0a99887766554433221100ffeeddccbbaa99887766554433221"
+		zero = "0000000000000000000000000000000000000000000000000000000000000000"
+		bigR = "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364141"
+	)
+
+	tests := []struct {
+		name  string // test description
+		sig   string // hex encoded signature to parse
+		wantR string // expected hex encoded R value
+		wantS string // expected hex encoded S value
+		err   error  // expected error
+	}{{
+		name:  "strict encoding",
+		sig:   "3044" + "0220" + r + "0220" + s,
+		wantR: r,
+		wantS: s,
+	}, {
+		name:  "long-form sequence length",
+		sig:   "308144" + "0220" + r + "0220" + s,
+		wantR: r,
+		wantS: s,
+	}, {
+		name:  "wrong sequence length",
+		sig:   "3000" + "0220" + r + "0220" + s,
+		wantR: r,
+		wantS: s,
+	}, {
+		name:  "long-form R length with zero padding",
+		sig:   "3046" + "02820020" + r + "0220" + s,
+		wantR: r,
+		wantS: s,
+	}, {
+		name:  "long-form S length",
+		sig:   "3045" + "0220" + r + "028120" + s,
+		wantR: r,
+		wantS: s,
+	}, {
+		name:  "excess R padding",
+		sig:   "3046" + "02220000" + r + "0220" + s,
+		wantR: r,
+		wantS: s,
+	}, {
+		name:  "excess S padding",
+		sig:   "3045" + "0220" + r + "022100" + s,
+		wantR: r,
+		wantS: s,
+	}, {
+		name:  "negative R",
+		sig:   "3044" + "0220" + negR + "0220" + s,
+		wantR: negR,
+		wantS: s,
+	}, {
+		name:  "zero R",
+		sig:   "3025" + "020100" + "0220" + s,
+		wantR: zero,
+		wantS: s,
+	}, {
+		name:  "empty R",
+		sig:   "3024" + "0200" + "0220" + s,
+		wantR: zero,
+		wantS: s,
+	}, {
+		name:  "trailing data",
+		sig:   "3044" + "0220" + r + "0220" + s + "0102",
+		wantR: r,
+		wantS: s,
+	}, {
+		name:  "R overflows 32 bytes",
+		sig:   "3045" + "022101" + r + "0220" + s,
+		wantR: zero,
+		wantS: zero,
+	}, {
+		name:  "R is group order",
+		sig:   "3044" + "0220" + bigR + "0220" + s,
+		wantR: zero,
+		wantS: zero,
+	}, {
+		name: "empty",
+		sig:  "",
+		err:  ErrSigTooShort,
+	}, {
+		name: "wrong sequence id",
+		sig:  "3144" + "0220" + r + "0220" + s,
+		err:  ErrSigInvalidSeqID,
+	}, {
+		name: "no sequence length",
+		sig:  "30",
+		err:  ErrSigTooShort,
+	}, {
+		name: "bogus sequence length bytes",
+		sig:  "308500",
+		err:  ErrSigInvalidDataLen,
+	}, {
+		name: "wrong R integer id",
+		sig:  "3044" + "0320" + r + "0220" + s,
+		err:  ErrSigInvalidRIntID,
+	}, {
+		name: "R length past end",
+		sig:  "3044" + "0221" + r,
+		err:  ErrSigInvalidDataLen,
+	}, {
+		name: "too many R length bytes",
+		sig:  "3044" + "028401000000" + r + "0220" + s,
+		err:  ErrSigInvalidDataLen,
+	}, {
+		name: "no S",
+		sig:  "3044" + "0220" + r,
+		err:  ErrSigTooShort,
+	}, {
+		name: "wrong S integer id",
+		sig:  "3044" + "0220" + r + "0320" + s,
+		err:  ErrSigInvalidSIntID,
+	}, {
+		name: "S length past end",
+		sig:  "3044" + "0220" + r + "0221" + s,
+		err:  ErrSigInvalidDataLen,
+	}}
+
+	for _, test := range tests {
+		sig, err := ParseDERSignatureLax(hexToBytes(test.sig))
+		if !errors.Is(err, test.err) {
+			t.Errorf("%s: mismatched err -- got %v, want %v", test.name, err,
+				test.err)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+
+		if !sig.r.Equals(hexToModNScalar(test.wantR)) {
+			t.Errorf("%s: unexpected R -- got %x, want %s", test.name,
+				sig.r.Bytes(), test.wantR)
+			continue
+		}
+		if !sig.s.Equals(hexToModNScalar(test.wantS)) {
+			t.Errorf("%s: unexpected S -- got %x, want %s", test.name,
+				sig.s.Bytes(), test.wantS)
+			continue
+		}
+		if _, err := sig.RecoveryCode(); err == nil {
+			t.Errorf("%s: unexpected recovery code", test.name)
+			continue
+		}
+
+		// Ensure the strict parser agrees for strictly-encoded signatures.
+		if strings.HasPrefix(test.name, "strict") {
+			strictSig, err := ParseDERSignature(hexToBytes(test.sig))
+			if err != nil {
+				t.Errorf("%s: unexpected strict parse err: %v", test.name, err)
+				continue
+			}
+			if !strictSig.IsEqual(sig) {
+				t.Errorf("%s: mismatched strict and lax signatures", test.name)
+				continue
+			}
+		}
+	}
+}
+
+// TestSigHashType ensures that signature hash type bytes are attached to and
+// split from serialized signatures as expected.
+func TestSigHashType(t *testing.T) {
+	t.Parallel()
+
+	privKey := NewPrivateKey(hexToModNScalar("a9d2fe03b2d3e3e8c5e0e4d2e1b3a7b1c2d9e8f7a6b5c4d3e2f1a0b9c8d7e6f5"))
+	hash := hexToBytes("c301ba9de5d6053caad9f5eb46523f007702add2c62fa39de03146a36b8026b7")
+	sig := Sign(privKey, hash)
+	der := sig.Serialize()
+
+	for _, hashType := range []byte{0x01, 0x02, 0x03, 0x81, 0x83} {
+		withHashType := sig.SerializeWithSigHashType(hashType)
+		wantWithHashType := append(append([]byte(nil), der...), hashType)
+		if !bytes.Equal(withHashType, wantWithHashType) {
+			t.Fatalf("unexpected serialized signature -- got %x, want %x",
+				withHashType, wantWithHashType)
+		}
+
+		gotDER, gotHashType, err := SplitSigHashType(withHashType)
+		if err != nil {
+			t.Fatalf("unexpected err: %v", err)
+		}
+		if !bytes.Equal(gotDER, der) || gotHashType != hashType {
+			t.Fatalf("unexpected split -- got %x, %#x, want %x, %#x", gotDER,
+				gotHashType, der, hashType)
+		}
+
+		// Ensure appending to the split signature does not modify the hash
+		// type byte of the original.
+		_ = append(gotDER, 0x00)
+		if withHashType[len(withHashType)-1] != hashType {
+			t.Fatal("appending to split signature modified original")
+		}
+	}
+
+	_, _, err := SplitSigHashType(nil)
+	if !errors.Is(err, ErrSigTooShort) {
+		t.Fatalf("mismatched err -- got %v, want %v", err, ErrSigTooShort)
+	}
+}
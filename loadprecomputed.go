@@ -15,82 +15,184 @@ import (
 
 //go:generate go run genprecomps.go
 
-// bytePointTable describes a table used to house pre-computed values for
+// The pre-computed table used to accelerate scalar base multiplication is
+// configurable via build tags in order to trade memory and initialization time
+// for speed:
+//
+//   - secp256k1_precomp_w4 selects 4-bit windows instead of the default 8-bit
+//     windows which reduces the number of table entries by a factor of 8
+//   - secp256k1_precomp_signed selects signed digits which halves the number of
+//     table entries
+//   - secp256k1_precomp_runtime computes the table on first use instead of
+//     embedding a compressed copy of it in the binary
+//
+// The first two may be combined with each other and with the third.  The
+// resulting table sizes are as follows:
+//
+//   Build tags     Entries   Memory     Embedded data
+//   -------------  --------  ---------  -------------
+//   (default)      32 * 256  ~960 KiB   ~683 KiB
+//   signed         32 * 129  ~484 KiB   ~345 KiB
+//   w4             64 * 16   ~120 KiB   ~84 KiB
+//   w4, signed     64 * 9    ~68 KiB    ~44 KiB
+//
+// See the README.md file for the associated performance characteristics.
+
+// baseNumWindows is the number of windows in the pre-computed table used to
+// accelerate scalar base multiplication.
+const baseNumWindows = 256 / basePointWindowBits
+
+// baseWindowEntries is the number of multiples of the base point in each window
+// of the pre-computed table used to accelerate scalar base multiplication.  It
+// includes the point at infinity for the zero digit.
+const baseWindowEntries = 1<<(basePointWindowBits-baseDigitSignBits) + baseDigitSignBits
+
+// baseSignedDigits specifies whether or not the scalars must be recoded into
+// signed digits in order to make use of the pre-computed table.
+const baseSignedDigits = baseDigitSignBits == 1
+
+// basePointTable describes a table used to house pre-computed values for
 // accelerating scalar base multiplication.
-type bytePointTable [32][256]JacobianPoint
+//
+// Window i houses the multiples v*2^(w*(baseNumWindows-1-i))*G for v in
+// [0, baseWindowEntries) where w is the number of bits in each window.  In
+// other words, the windows are in big-endian order just like the bytes of a
+// serialized scalar.
+type basePointTable [baseNumWindows][baseWindowEntries]JacobianPoint
 
 // compressedBytePointsFn is set to a real function by the code generation to
 // return the compressed pre-computed values for accelerating scalar base
-// multiplication.
+// multiplication.  It remains nil when the secp256k1_precomp_runtime build tag
+// is specified.
 var compressedBytePointsFn func() string
 
-// s256BytePoints houses pre-computed values used to accelerate scalar base
-// multiplication such that they are only loaded on first use.
-var s256BytePoints = func() func() *bytePointTable {
-	// mustLoadBytePoints decompresses and deserializes the pre-computed byte
-	// points used to accelerate scalar base multiplication for the secp256k1
-	// curve.
-	//
-	// This approach is used since it allows the compile to use significantly
-	// less ram and be performed much faster than it is with hard-coding the
-	// final in-memory data structure.  At the same time, it is quite fast to
-	// generate the in-memory data structure on first use with this approach
-	// versus computing the table.
-	//
-	// It will panic on any errors because the data is hard coded and thus any
-	// errors means something is wrong in the source code.
-	var data *bytePointTable
-	mustLoadBytePoints := func() {
-		// There will be no byte points to load when generating them.
-		if compressedBytePointsFn == nil {
-			return
-		}
-		bp := compressedBytePointsFn()
-
-		// Decompress the pre-computed table used to accelerate scalar base
-		// multiplication.
-		decoder := base64.NewDecoder(base64.StdEncoding, strings.NewReader(bp))
-		r, err := zlib.NewReader(decoder)
-		if err != nil {
-			panic(err)
-		}
-		serialized, err := io.ReadAll(r)
-		if err != nil {
-			panic(err)
-		}
+// decompressBasePoints decompresses and deserializes the passed pre-computed
+// base points used to accelerate scalar base multiplication for the secp256k1
+// curve.
+//
+// This approach is used since it allows the compile to use significantly less
+// ram and be performed much faster than it is with hard-coding the final
+// in-memory data structure.
+//
+// It will panic on any errors because the data is hard coded and thus any
+// errors means something is wrong in the source code.
+func decompressBasePoints(bp string) *basePointTable {
+	// Decompress the pre-computed table used to accelerate scalar base
+	// multiplication.
+	decoder := base64.NewDecoder(base64.StdEncoding, strings.NewReader(bp))
+	r, err := zlib.NewReader(decoder)
+	if err != nil {
+		panic(err)
+	}
+	serialized, err := io.ReadAll(r)
+	if err != nil {
+		panic(err)
+	}
 
-		// Deserialize the precomputed byte points and set the memory table to
-		// them.
-		const expectedLen = 32 * 256 * 2 * 32 // 32 windows * 256 points * 2 coords * 32 bytes
-		if len(serialized) != expectedLen {
-			panic("precomputed byte points data size mismatch")
+	// Deserialize the precomputed base points and set the memory table to
+	// them.
+	const expectedLen = baseNumWindows * baseWindowEntries * 2 * 32 // windows * points * 2 coords * 32 bytes
+	if len(serialized) != expectedLen {
+		panic("precomputed byte points data size mismatch")
+	}
+
+	offset := 0
+	basePoints := new(basePointTable)
+	for window := 0; window < len(basePoints); window++ {
+		// All points in this window.
+		for i := 0; i < len(basePoints[window]); i++ {
+			p := &basePoints[window][i]
+			p.X.SetByteSlice(serialized[offset:])
+			offset += 32
+			p.Y.SetByteSlice(serialized[offset:])
+			offset += 32
+			p.Z.SetInt(1)
 		}
+	}
+	return basePoints
+}
 
-		offset := 0
-		var bytePoints bytePointTable
-		for byteNum := 0; byteNum < len(bytePoints); byteNum++ {
-			// All points in this window.
-			for i := 0; i < len(bytePoints[byteNum]); i++ {
-				p := &bytePoints[byteNum][i]
-				p.X.SetByteSlice(serialized[offset:])
-				offset += 32
-				p.Y.SetByteSlice(serialized[offset:])
-				offset += 32
-				p.Z.SetInt(1)
-			}
+// computeBasePoints computes the pre-computed base points used to accelerate
+// scalar base multiplication for the secp256k1 curve from scratch.
+//
+// This is used in place of the embedded data when the secp256k1_precomp_runtime
+// build tag is specified in order to reduce the binary size.  It only requires
+// a single point addition per entry along with a batch conversion to affine
+// coordinates and a point doubling per window.
+func computeBasePoints() *basePointTable {
+	// Start with the base point itself for the least significant window and
+	// move up by 2^w for each subsequent window.
+	basePoints := new(basePointTable)
+	var windowBase JacobianPoint
+	windowBase.X.SetByteSlice(curveParams.Gx.Bytes())
+	windowBase.Y.SetByteSlice(curveParams.Gy.Bytes())
+	windowBase.Z.SetInt(1)
+	for window := len(basePoints) - 1; window >= 0; window-- {
+		// Calculate all of the multiples of the base point for this window
+		// and convert them to affine.
+		points := &basePoints[window]
+		points[1].Set(&windowBase)
+		for i := 2; i < len(points); i++ {
+			AddNonConst(&points[i-1], &windowBase, &points[i])
 		}
-		data = &bytePoints
+		batchToAffineNonConst(points[1:])
+
+		// The zero digit is represented by the point at infinity with the
+		// x and y coordinates set to zero like all the other affine entries.
+		points[0].X.Zero()
+		points[0].Y.Zero()
+		points[0].Z.SetInt(1)
+
+		// The base for the next window is 2^w times the base for this one,
+		// which is double the multiple 2^(w-1) that all tables house.  It is
+		// converted to affine so the additions above are cheaper.
+		DoubleNonConst(&points[1<<(basePointWindowBits-1)], &windowBase)
+		windowBase.ToAffineNonConst()
 	}
+	return basePoints
+}
 
+// loadBasePoints returns the pre-computed base points used to accelerate scalar
+// base multiplication for the secp256k1 curve from the embedded data when it is
+// available or computes them otherwise.
+func loadBasePoints() *basePointTable {
+	if compressedBytePointsFn == nil {
+		return computeBasePoints()
+	}
+	return decompressBasePoints(compressedBytePointsFn())
+}
+
+// s256BasePoints houses pre-computed values used to accelerate scalar base
+// multiplication such that they are only loaded on first use.
+var s256BasePoints = func() func() *basePointTable {
 	// Return a closure that initializes the data on first access.  This is done
 	// because the table takes a non-trivial amount of memory and initializing
 	// it unconditionally would cause anything that imports the package, either
 	// directly, or indirectly via transitive deps, to use that memory even if
 	// the caller never accesses any parts of the package that actually needs
 	// access to it.
-	var loadBytePointsOnce sync.Once
-	return func() *bytePointTable {
-		loadBytePointsOnce.Do(mustLoadBytePoints)
+	var data *basePointTable
+	var loadBasePointsOnce sync.Once
+	return func() *basePointTable {
+		loadBasePointsOnce.Do(func() {
+			data = loadBasePoints()
+		})
 		return data
 	}
 }()
+
+// Preload loads the pre-computed values used to accelerate scalar base
+// multiplication, which includes signing and signature verification, when they
+// have not already been loaded.
+//
+// The values are otherwise loaded on first use, which means that the first
+// operation that requires them is slower than the rest.  Latency sensitive
+// applications, such as servers that need to respond to their first request
+// quickly, may call this during startup, possibly in a separate goroutine, to
+// move that cost out of the critical path.
+//
+// It is safe to call concurrently and any calls after the first one are
+// effectively free.
+func Preload() {
+	s256BasePoints()
+}
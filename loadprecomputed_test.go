@@ -0,0 +1,58 @@
+package secp256k1
+
+import (
+	"math/big"
+	"testing"
+)
+
+// TestBasePoints ensures the pre-computed table used to accelerate scalar base
+// multiplication houses the expected multiples of the base point regardless of
+// whether it is loaded from the embedded data or computed at runtime.
+func TestBasePoints(t *testing.T) {
+	// Ensure the loaded table matches the one computed at runtime.  This is
+	// trivially true when the table is computed at runtime, but the embedded
+	// data must match it otherwise.
+	loaded := s256BasePoints()
+	computed := computeBasePoints()
+	for window := range loaded {
+		for i := range loaded[window] {
+			got, want := &loaded[window][i], &computed[window][i]
+			if !got.IsStrictlyEqual(want) {
+				t.Fatalf("mismatched entry %d in window %d:\ngot: (%s, %s, "+
+					"%s)\nwant: (%s, %s, %s)", i, window, got.X, got.Y,
+					got.Z, want.X, want.Y, want.Z)
+			}
+		}
+	}
+
+	// Ensure the zero digit entry of every window is the point at infinity
+	// encoded with affine coordinates and that a selection of the remaining
+	// entries are the expected multiples of the base point.
+	var g JacobianPoint
+	bigAffineToJacobian(curveParams.Gx, curveParams.Gy, &g)
+	for window := range computed {
+		entry := &computed[window][0]
+		if !entry.X.IsZero() || !entry.Y.IsZero() || !entry.Z.IsOne() {
+			t.Fatalf("unexpected zero digit entry in window %d: (%s, %s, "+
+				"%s)", window, entry.X, entry.Y, entry.Z)
+		}
+
+		shift := uint(basePointWindowBits * (baseNumWindows - 1 - window))
+		for _, v := range []int{1, 2, 3, baseWindowEntries - 1} {
+			var kBytes [32]byte
+			new(big.Int).Lsh(big.NewInt(int64(v)), shift).FillBytes(kBytes[:])
+			var k ModNScalar
+			k.SetBytes(&kBytes)
+
+			var want JacobianPoint
+			ScalarMultNonConst(&k, &g, &want)
+			want.ToAffine()
+			entry := &computed[window][v]
+			if !entry.IsStrictlyEqual(&want) {
+				t.Fatalf("mismatched entry %d in window %d:\ngot: (%s, %s, "+
+					"%s)\nwant: (%s, %s, %s)", v, window, entry.X, entry.Y,
+					entry.Z, want.X, want.Y, want.Z)
+			}
+		}
+	}
+}
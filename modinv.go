@@ -0,0 +1,464 @@
+package secp256k1
+
+import (
+	"encoding/binary"
+	"math"
+	"math/bits"
+)
+
+// References:
+//   [BY]: Fast constant-time gcd computation and modular inversion
+//     (Bernstein, Yang)
+//     https://gcd.cr.yp.to/safegcd-20190413.pdf
+//
+//   [SGCD]: The safegcd implementation in libsecp256k1 explained
+//     https://github.com/bitcoin-core/secp256k1/blob/master/doc/safegcd_implementation.md
+
+// This file implements modular inversion via the safegcd algorithm of [BY] as
+// refined by [SGCD].  It is used for both the field prime and the group order
+// since they are both odd 256-bit moduli.
+//
+// The algorithm repeatedly applies "divsteps" to a pair of values (f, g) that
+// start out as the modulus and the value to invert, respectively.  Each divstep
+// halves g after conditionally adding f to it (and swapping them) such that g
+// eventually reaches zero at which point f is ±gcd = ±1.  The divsteps are
+// performed in batches of 30 using only the low bits of f and g, which produces
+// a 2x2 transition matrix with entries bounded by 2^30 that is then applied to
+// the full values of f and g, as well as to a second pair of values (d, e) that
+// track the multiple of the original value that each of f and g represent
+// modulo the modulus.
+//
+// The full values are represented with 9 signed limbs of 30 bits each, which
+// allows the transition matrix to be applied with 64-bit arithmetic and
+// provides plenty of room for the intermediate values to be negative.
+//
+// Two variants are provided:
+//
+// 1) A constant-time variant that always performs 20 batches of 30 divsteps
+//    (600 total), which is more than the 590 divsteps that are proven to be
+//    sufficient for 256-bit inputs, without any branches that depend on the
+//    input
+// 2) A variable-time variant that skips over runs of zero bits in g, cancels
+//    several of its low bits at once via a lookup table, stops as soon as g
+//    reaches zero, and shortens the limbs as the values of f and g shrink
+
+// signed30 houses a signed integer in base 2^30 with 9 limbs such that the
+// represented value is sum(v[i] * 2^(30*i)) for i in 0..8.  The limbs are
+// permitted to be negative.
+type signed30 [9]int32
+
+// modInvInfo houses the modulus information used by the modular inversion.
+type modInvInfo struct {
+	// modulus is the modulus in signed30 form.
+	modulus signed30
+
+	// modulusInv30 is the modular multiplicative inverse of the modulus mod
+	// 2^30.
+	modulusInv30 uint32
+}
+
+var (
+	// fieldModInvInfo houses the modulus information for the secp256k1 field
+	// prime.
+	//
+	// Note that the prime is 2^256 - 2^32 - 977 which makes use of negative
+	// limbs to provide a particularly compact representation.
+	fieldModInvInfo = modInvInfo{
+		modulus:      signed30{-0x3d1, -4, 0, 0, 0, 0, 0, 0, 65536},
+		modulusInv30: 0x2ddacacf,
+	}
+
+	// orderModInvInfo houses the modulus information for the secp256k1 group
+	// order.
+	orderModInvInfo = modInvInfo{
+		modulus: signed30{0x10364141, 0x3f497a33, 0x348a03bb, 0x2bb739ab,
+			-0x146, 0, 0, 0, 65536},
+		modulusInv30: 0x2a774ec1,
+	}
+)
+
+// modInvMask30 is the mask for the bits of a single limb of a signed30.
+const modInvMask30 = int32(math.MaxUint32 >> 2)
+
+// setWords sets the signed30 to the 256-bit unsigned integer provided as 4
+// little-endian 64-bit words in constant time.
+func (s *signed30) setWords(w *[4]uint64) {
+	for i := 0; i < len(s); i++ {
+		bitPos := 30 * i
+		wordNum, shift := bitPos/64, uint(bitPos%64)
+		v := w[wordNum] >> shift
+		if shift > 34 && wordNum < len(w)-1 {
+			v |= w[wordNum+1] << (64 - shift)
+		}
+		s[i] = int32(v) & modInvMask30
+	}
+}
+
+// putWords packs the signed30 into 4 little-endian 64-bit words in constant
+// time.
+//
+// The signed30 MUST be normalized such that all limbs are in [0, 2^30) and the
+// represented value is less than 2^256.
+func (s *signed30) putWords(w *[4]uint64) {
+	*w = [4]uint64{}
+	for i := 0; i < len(s); i++ {
+		bitPos := 30 * i
+		wordNum, shift := bitPos/64, uint(bitPos%64)
+		v := uint64(s[i])
+		w[wordNum] |= v << shift
+		if shift > 34 && wordNum < len(w)-1 {
+			w[wordNum+1] |= v >> (64 - shift)
+		}
+	}
+}
+
+// setBytes sets the signed30 to the passed 256-bit big-endian unsigned integer
+// in constant time.
+func (s *signed30) setBytes(b *[32]byte) {
+	w := [4]uint64{
+		binary.BigEndian.Uint64(b[24:32]),
+		binary.BigEndian.Uint64(b[16:24]),
+		binary.BigEndian.Uint64(b[8:16]),
+		binary.BigEndian.Uint64(b[0:8]),
+	}
+	s.setWords(&w)
+}
+
+// putBytes packs the signed30 into the passed 32-byte array as a 256-bit
+// big-endian unsigned integer in constant time.
+//
+// The signed30 MUST be normalized such that all limbs are in [0, 2^30) and the
+// represented value is less than 2^256.
+func (s *signed30) putBytes(b *[32]byte) {
+	var w [4]uint64
+	s.putWords(&w)
+	binary.BigEndian.PutUint64(b[0:8], w[3])
+	binary.BigEndian.PutUint64(b[8:16], w[2])
+	binary.BigEndian.PutUint64(b[16:24], w[1])
+	binary.BigEndian.PutUint64(b[24:32], w[0])
+}
+
+// trans2x2 is a 2x2 transition matrix that is the result of applying 30
+// divsteps, scaled by 2^30.
+type trans2x2 struct {
+	u, v, q, r int32
+}
+
+// divsteps30 performs 30 divsteps on the low 30 bits of f and g, which are
+// provided as f0 and g0, starting with the given zeta, where zeta = -(delta +
+// 1/2), and returns the new zeta along with the resulting transition matrix in
+// t in constant time.
+func divsteps30(zeta int32, f0, g0 uint32, t *trans2x2) int32 {
+	// Start with the identity matrix.
+	u, v, q, r := uint32(1), uint32(0), uint32(0), uint32(1)
+	f, g := f0, g0
+	for i := 0; i < 30; i++ {
+		// Compute conditional masks for (zeta < 0) and for (g & 1).
+		c1 := uint32(zeta >> 31)
+		c2 := -(g & 1)
+
+		// Compute x, y, z as conditionally negated versions of f, u, v.
+		x := (f ^ c1) - c1
+		y := (u ^ c1) - c1
+		z := (v ^ c1) - c1
+
+		// Conditionally add x, y, z to g, q, r.
+		g += x & c2
+		q += y & c2
+		r += z & c2
+
+		// In what follows, c1 is a condition mask for (zeta < 0) and (g & 1).
+		c1 &= c2
+
+		// Conditionally change zeta into -zeta-2 or zeta-1.
+		zeta = (zeta ^ int32(c1)) - 1
+
+		// Conditionally add g, q, r to f, u, v.
+		f += g & c1
+		u += q & c1
+		v += r & c1
+
+		// Shifts.
+		g >>= 1
+		u <<= 1
+		v <<= 1
+	}
+
+	t.u, t.v, t.q, t.r = int32(u), int32(v), int32(q), int32(r)
+	return zeta
+}
+
+// negInverses256 houses -(2*i+1)^-1 (mod 256) for i in 0..127.  It is used by
+// the variable-time divsteps to cancel up to 8 low bits of g at once.
+var negInverses256 = func() [128]uint8 {
+	var table [128]uint8
+	for i := range table {
+		// Newton's method doubles the number of correct low bits with each
+		// iteration and an odd value is its own inverse modulo 8, so two
+		// iterations provide 12 bits of precision, which is more than enough.
+		a := uint8(2*i + 1)
+		inv := a
+		inv *= 2 - a*inv
+		inv *= 2 - a*inv
+		table[i] = -inv
+	}
+	return table
+}()
+
+// divsteps30Var performs 30 divsteps on the low 30 bits of f and g, which are
+// provided as f0 and g0, starting with the given eta, where eta = -delta, and
+// returns the new eta along with the resulting transition matrix in t in
+// *non-constant* time.
+func divsteps30Var(eta int32, f0, g0 uint32, t *trans2x2) int32 {
+	// Start with the identity matrix.
+	u, v, q, r := uint32(1), uint32(0), uint32(0), uint32(1)
+	f, g := f0, g0
+	i := 30
+	for {
+		// Use a sentinel bit to count zeros only up to i.
+		zeros := uint(bits.TrailingZeros32(g|(math.MaxUint32<<uint(i)))) & 31
+
+		// Perform zeros divsteps at once; they all just divide g by two.
+		g >>= zeros
+		u <<= zeros
+		v <<= zeros
+		eta -= int32(zeros)
+		i -= int(zeros)
+
+		// Done once all 30 divsteps have been performed.
+		if i == 0 {
+			break
+		}
+
+		// If eta is negative, negate it and replace f, g with g, -f.
+		if eta < 0 {
+			eta = -eta
+			f, g = g, -f
+			u, q = q, -u
+			v, r = r, -v
+		}
+
+		// eta is now >= 0.  In what follows, the bottom bits of g are
+		// cancelled out.  No more than i can be cancelled out since that is
+		// when the batch is complete and no more than eta+1 can be cancelled
+		// out since its sign flips once that happens.
+		limit := int(eta) + 1
+		if limit > i {
+			limit = i
+		}
+
+		// Determine the multiple of f that must be added to g to cancel its
+		// bottom min(limit, 8) bits and then do so.
+		m := (uint32(math.MaxUint32) >> (uint(32-limit) & 31)) & 255
+		w := (g * uint32(negInverses256[(f>>1)&127])) & m
+		g += f * w
+		q += u * w
+		r += v * w
+	}
+
+	t.u, t.v, t.q, t.r = int32(u), int32(v), int32(q), int32(r)
+	return eta
+}
+
+// updateDE applies the passed transition matrix to d and e, which are in the
+// range (-2*modulus, modulus), in constant time.  The matrix is scaled by 2^30,
+// so the result is divided by 2^30 modulo the modulus by adding the multiple of
+// the modulus that makes the low 30 bits zero prior to shifting them out.  The
+// outputs are also in the range (-2*modulus, modulus).
+func updateDE(d, e *signed30, t *trans2x2, info *modInvInfo) {
+	u, v, q, r := t.u, t.v, t.q, t.r
+
+	// [md, me] start as zero plus [u, q] if d is negative plus [v, r] if e is
+	// negative.
+	sd, se := d[8]>>31, e[8]>>31
+	md := (u & sd) + (v & se)
+	me := (q & sd) + (r & se)
+
+	// Begin computing t*[d, e].
+	di, ei := int64(d[0]), int64(e[0])
+	cd := int64(u)*di + int64(v)*ei
+	ce := int64(q)*di + int64(r)*ei
+
+	// Correct md, me so that t*[d, e] + modulus*[md, me] has 30 zero bottom
+	// bits.
+	md -= int32((info.modulusInv30*uint32(cd) + uint32(md)) & uint32(modInvMask30))
+	me -= int32((info.modulusInv30*uint32(ce) + uint32(me)) & uint32(modInvMask30))
+
+	// Update the beginning of the computation for t*[d, e] + modulus*[md, me]
+	// now that md and me are known and throw away the low 30 bits which are
+	// now zero.
+	cd += int64(info.modulus[0]) * int64(md)
+	ce += int64(info.modulus[0]) * int64(me)
+	cd >>= 30
+	ce >>= 30
+
+	// Compute limbs 1..8 of t*[d, e] + modulus*[md, me] and store them in the
+	// output limb i-1 (shifting down by 30 bits).
+	for i := 1; i < len(d); i++ {
+		di, ei = int64(d[i]), int64(e[i])
+		cd += int64(u)*di + int64(v)*ei
+		ce += int64(q)*di + int64(r)*ei
+		cd += int64(info.modulus[i]) * int64(md)
+		ce += int64(info.modulus[i]) * int64(me)
+		d[i-1] = int32(cd) & modInvMask30
+		e[i-1] = int32(ce) & modInvMask30
+		cd >>= 30
+		ce >>= 30
+	}
+
+	// What remains is limb 9 of the computation which is stored as output
+	// limb 8.
+	d[8] = int32(cd)
+	e[8] = int32(ce)
+}
+
+// updateFG applies the passed transition matrix to the first length limbs of f
+// and g and divides the results by 2^30.  The low 30 bits of the results are
+// always zero due to the properties of the divsteps, so the division is exact.
+func updateFG(length int, f, g *signed30, t *trans2x2) {
+	u, v, q, r := int64(t.u), int64(t.v), int64(t.q), int64(t.r)
+
+	// Start computing t*[f, g] and throw away the low 30 bits which are zero.
+	fi, gi := int64(f[0]), int64(g[0])
+	cf := u*fi + v*gi
+	cg := q*fi + r*gi
+	cf >>= 30
+	cg >>= 30
+
+	// Compute the remaining limbs of t*[f, g] and store them in the output
+	// limb i-1 (shifting down by 30 bits).
+	for i := 1; i < length; i++ {
+		fi, gi = int64(f[i]), int64(g[i])
+		cf += u*fi + v*gi
+		cg += q*fi + r*gi
+		f[i-1] = int32(cf) & modInvMask30
+		g[i-1] = int32(cg) & modInvMask30
+		cf >>= 30
+		cg >>= 30
+	}
+
+	// What remains is the final limb.
+	f[length-1] = int32(cf)
+	g[length-1] = int32(cg)
+}
+
+// normalize brings the passed value, which is in the range (-2*modulus,
+// modulus), into the range [0, modulus) in constant time while negating it
+// first if the provided sign is negative.  All limbs of the result are in [0,
+// 2^30).
+func (s *signed30) normalize(sign int32, info *modInvInfo) {
+	// Add the modulus if the input is negative and then negate if requested.
+	// This brings the value from the range (-2*modulus, modulus) to the range
+	// (-modulus, modulus).
+	condAdd := s[8] >> 31
+	for i := range s {
+		s[i] += info.modulus[i] & condAdd
+	}
+	condNegate := sign >> 31
+	for i := range s {
+		s[i] = (s[i] ^ condNegate) - condNegate
+	}
+
+	// Propagate the top bits to bring the limbs back to range (-2^30, 2^30).
+	for i := 0; i < len(s)-1; i++ {
+		s[i+1] += s[i] >> 30
+		s[i] &= modInvMask30
+	}
+
+	// Add the modulus again if the result is still negative, which brings it to
+	// the range [0, modulus) and propagate again.
+	condAdd = s[8] >> 31
+	for i := range s {
+		s[i] += info.modulus[i] & condAdd
+	}
+	for i := 0; i < len(s)-1; i++ {
+		s[i+1] += s[i] >> 30
+		s[i] &= modInvMask30
+	}
+}
+
+// modInv sets x to its modular multiplicative inverse with respect to the
+// modulus described by the passed info in constant time.  The value MUST be in
+// the range [0, modulus).  Zero is mapped to zero.
+func modInv(x *signed30, info *modInvInfo) {
+	// Start with d=0, e=1, f=modulus, g=x, zeta=-1.  Note that zeta =
+	// -(delta + 1/2) and delta is initially 1/2.
+	var d, e signed30
+	e[0] = 1
+	f := info.modulus
+	g := *x
+	zeta := int32(-1)
+
+	// Perform 20 iterations of 30 divsteps each for a total of 600 divsteps.
+	// 590 suffice for 256-bit inputs.
+	for i := 0; i < 20; i++ {
+		var t trans2x2
+		zeta = divsteps30(zeta, uint32(f[0]), uint32(g[0]), &t)
+		updateDE(&d, &e, &t, info)
+		updateFG(len(f), &f, &g, &t)
+	}
+
+	// At this point, sufficient iterations have been performed that g must
+	// have reached 0 and (if g was not originally 0) f must now equal ±gcd of
+	// the initial f and g values, which is ±1 since the modulus is prime, and
+	// d now contains ± the modular inverse.
+	//
+	// Negate d as needed, normalize it to the range [0, modulus), and store the
+	// result in x.
+	d.normalize(f[8], info)
+	*x = d
+}
+
+// modInvVar sets x to its modular multiplicative inverse with respect to the
+// modulus described by the passed info in *non-constant* time.  The value MUST
+// be in the range [0, modulus).  Zero is mapped to zero.
+func modInvVar(x *signed30, info *modInvInfo) {
+	// Start with d=0, e=1, f=modulus, g=x, eta=-1.  Note that eta = -delta and
+	// delta is initially 1, which is faster for the variable-time code.
+	var d, e signed30
+	e[0] = 1
+	f := info.modulus
+	g := *x
+	eta := int32(-1)
+	length := len(f)
+
+	// Perform iterations of 30 divsteps each until g is 0.
+	for {
+		var t trans2x2
+		eta = divsteps30Var(eta, uint32(f[0]), uint32(g[0]), &t)
+		updateDE(&d, &e, &t, info)
+		updateFG(length, &f, &g, &t)
+
+		// There is a chance that g is zero when its bottom limb is.
+		if g[0] == 0 {
+			var cond int32
+			for j := 1; j < length; j++ {
+				cond |= g[j]
+			}
+			if cond == 0 {
+				break
+			}
+		}
+
+		// Reduce the length when both f and g have a top limb that is either 0
+		// or -1 by propagating its sign into the limb below.
+		fn, gn := f[length-1], g[length-1]
+		cond := int32(length-2) >> 31
+		cond |= fn ^ (fn >> 31)
+		cond |= gn ^ (gn >> 31)
+		if cond == 0 {
+			f[length-2] |= int32(uint32(fn) << 30)
+			g[length-2] |= int32(uint32(gn) << 30)
+			length--
+		}
+	}
+
+	// At this point g is 0 and (if g was not originally 0) f must now equal
+	// ±gcd of the initial f and g values, which is ±1 since the modulus is
+	// prime, and d now contains ± the modular inverse.
+	//
+	// Negate d as needed, normalize it to the range [0, modulus), and store the
+	// result in x.
+	d.normalize(f[length-1], info)
+	*x = d
+}
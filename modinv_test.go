@@ -0,0 +1,102 @@
+package secp256k1
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+	"time"
+)
+
+// signed30ToBig converts the passed signed30 to a big integer.  The limbs are
+// permitted to be negative.
+func signed30ToBig(s *signed30) *big.Int {
+	result := new(big.Int)
+	for i := len(s) - 1; i >= 0; i-- {
+		result.Lsh(result, 30)
+		result.Add(result, big.NewInt(int64(s[i])))
+	}
+	return result
+}
+
+// TestModInvInfo ensures the moduli and their inverses modulo 2^30 used by the
+// safegcd modular inversion are correct.
+func TestModInvInfo(t *testing.T) {
+	tests := []struct {
+		name    string      // test description
+		info    *modInvInfo // modulus info to test
+		modulus *big.Int    // expected modulus
+	}{{
+		name:    "field prime",
+		info:    &fieldModInvInfo,
+		modulus: curveParams.P,
+	}, {
+		name:    "group order",
+		info:    &orderModInvInfo,
+		modulus: curveParams.N,
+	}}
+
+	two30 := new(big.Int).Lsh(big.NewInt(1), 30)
+	for _, test := range tests {
+		modulus := signed30ToBig(&test.info.modulus)
+		if modulus.Cmp(test.modulus) != 0 {
+			t.Errorf("%s: mismatched modulus -- got %x, want %x", test.name,
+				modulus, test.modulus)
+			continue
+		}
+
+		wantInv := new(big.Int).ModInverse(test.modulus, two30).Uint64()
+		if uint64(test.info.modulusInv30) != wantInv {
+			t.Errorf("%s: mismatched modulus inverse -- got %x, want %x",
+				test.name, test.info.modulusInv30, wantInv)
+			continue
+		}
+	}
+}
+
+// TestNegInverses256 ensures the table used by the variable-time divsteps to
+// cancel the low bits of g is correct.
+func TestNegInverses256(t *testing.T) {
+	for i, negInv := range negInverses256 {
+		if got := uint8(2*i+1) * negInv; got != 0xff {
+			t.Fatalf("entry %d: (2*i+1) * table entry is %x, want ff", i, got)
+		}
+	}
+}
+
+// TestSigned30Bytes ensures converting 256-bit big-endian unsigned integers to
+// and from signed30 works as expected for random values.
+func TestSigned30Bytes(t *testing.T) {
+	// Use a unique random seed each test instance and log it if the tests fail.
+	seed := time.Now().Unix()
+	rng := rand.New(rand.NewSource(seed))
+	defer func(t *testing.T, seed int64) {
+		if t.Failed() {
+			t.Logf("random seed: %d", seed)
+		}
+	}(t, seed)
+
+	for i := 0; i < 100; i++ {
+		var buf [32]byte
+		if _, err := rng.Read(buf[:]); err != nil {
+			t.Fatalf("failed to read random: %v", err)
+		}
+
+		var s signed30
+		s.setBytes(&buf)
+		for j, limb := range s {
+			if limb < 0 || limb > modInvMask30 {
+				t.Fatalf("limb %d of %x out of range: %x", j, buf, limb)
+			}
+		}
+		want := new(big.Int).SetBytes(buf[:])
+		if got := signed30ToBig(&s); got.Cmp(want) != 0 {
+			t.Fatalf("mismatched value -- got %x, want %x", got, want)
+		}
+
+		var roundTrip [32]byte
+		s.putBytes(&roundTrip)
+		if roundTrip != buf {
+			t.Fatalf("mismatched round trip -- got %x, want %x", roundTrip, buf)
+		}
+	}
+}
@@ -6,7 +6,6 @@ package secp256k1
 
 import (
 	"encoding/hex"
-	"math/big"
 )
 
 // References:
@@ -813,6 +812,13 @@ func (s *ModNScalar) reduce512(t0, t1, t2, t3, t4, t5, t6, t7, t8, t9, t10, t11,
 // The scalar is returned to support chaining.  This enables syntax like:
 // s3.Mul2(s, s2).AddInt(1) so that s3 = (s * s2) + 1.
 func (s *ModNScalar) Mul2(val, val2 *ModNScalar) *ModNScalar {
+	scalarMul(s, val, val2)
+	return s
+}
+
+// mul2Generic multiplies the passed two scalars together modulo the group order
+// in constant time using pure Go and stores the result in s.
+func (s *ModNScalar) mul2Generic(val, val2 *ModNScalar) {
 	// This could be done with for loops and an array to store the intermediate
 	// terms, but this unrolled version is significantly faster.
 
@@ -965,7 +971,6 @@ func (s *ModNScalar) Mul2(val, val2 *ModNScalar) *ModNScalar {
 	// and occupy up to 512 bits.  Reduce the result accordingly.
 	s.reduce512(t0, t1, t2, t3, t4, t5, t6, t7, t8, t9, t10, t11, t12, t13, t14,
 		t15)
-	return s
 }
 
 // Mul multiplies the passed scalar with the existing one modulo the group order
@@ -1052,33 +1057,84 @@ func (s *ModNScalar) Negate() *ModNScalar {
 	return s.NegateVal(s)
 }
 
-// InverseValNonConst finds the modular multiplicative inverse of the passed
-// scalar and stores result in s in *non-constant* time.
+// condNegate negates the scalar modulo the group order in constant time when
+// the provided flag is 1 and leaves it unmodified when the flag is 0.  The flag
+// MUST be either 0 or 1.
+//
+// The scalar is returned to support chaining.
+func (s *ModNScalar) condNegate(flag uint32) *ModNScalar {
+	var negated ModNScalar
+	negated.NegateVal(s)
+	mask := -flag
+	for i := range s.n {
+		s.n[i] ^= (s.n[i] ^ negated.n[i]) & mask
+	}
+	return s
+}
+
+// InverseVal finds the modular multiplicative inverse of the passed scalar and
+// stores result in s in constant time.  The inverse of zero is zero.
 //
 // The scalar is returned to support chaining.  This enables syntax like:
 // s3.InverseVal(s1).Mul(s2) so that s3 = s1^-1 * s2.
+func (s *ModNScalar) InverseVal(val *ModNScalar) *ModNScalar {
+	// The inverse is computed via the safegcd algorithm.  See modinv.go for
+	// details.
+	var b [32]byte
+	var v signed30
+	val.PutBytes(&b)
+	v.setBytes(&b)
+	modInv(&v, &orderModInvInfo)
+	v.putBytes(&b)
+	s.SetBytes(&b)
+	zeroArray32(&b)
+	return s
+}
+
+// Inverse finds the modular multiplicative inverse of the scalar in constant
+// time.  The existing scalar is modified.  The inverse of zero is zero.
+//
+// The scalar is returned to support chaining.  This enables syntax like:
+// s.Inverse().Mul(s2) so that s = s^-1 * s2.
+func (s *ModNScalar) Inverse() *ModNScalar {
+	return s.InverseVal(s)
+}
+
+// InverseValNonConst finds the modular multiplicative inverse of the passed
+// scalar and stores result in s in *non-constant* time.  The inverse of zero is
+// zero.
+//
+// The scalar is returned to support chaining.  This enables syntax like:
+// s3.InverseValNonConst(s1).Mul(s2) so that s3 = s1^-1 * s2.
 func (s *ModNScalar) InverseValNonConst(val *ModNScalar) *ModNScalar {
-	// This is making use of big integers for now.  Ideally it will be replaced
-	// with an implementation that does not depend on big integers.
-	valBytes := val.Bytes()
-	bigVal := new(big.Int).SetBytes(valBytes[:])
-	bigVal.ModInverse(bigVal, curveParams.N)
-	s.SetByteSlice(bigVal.Bytes())
+	var b [32]byte
+	var v signed30
+	val.PutBytes(&b)
+	v.setBytes(&b)
+	modInvVar(&v, &orderModInvInfo)
+	v.putBytes(&b)
+	s.SetBytes(&b)
 	return s
 }
 
 // InverseNonConst finds the modular multiplicative inverse of the scalar in
-// *non-constant* time.  The existing scalar is modified.
+// *non-constant* time.  The existing scalar is modified.  The inverse of zero
+// is zero.
 //
 // The scalar is returned to support chaining.  This enables syntax like:
-// s.Inverse().Mul(s2) so that s = s^-1 * s2.
+// s.InverseNonConst().Mul(s2) so that s = s^-1 * s2.
 func (s *ModNScalar) InverseNonConst() *ModNScalar {
 	return s.InverseValNonConst(s)
 }
 
-// IsOverHalfOrder returns whether or not the scalar exceeds the group order
-// divided by 2 in constant time.
-func (s *ModNScalar) IsOverHalfOrder() bool {
+// isOverHalfOrderBit returns 1 when the scalar exceeds the group order divided
+// by 2 or 0 otherwise in constant time.
+//
+// Note that a bool is not used here because it is not possible in Go to convert
+// from a bool to numeric value in constant time and many constant-time
+// operations require a numeric value.  See IsOverHalfOrder for the version
+// that returns a bool.
+func (s *ModNScalar) isOverHalfOrderBit() uint32 {
 	// The intuition here is that the scalar is greater than half of the group
 	// order if one of the higher individual words is greater than the
 	// corresponding word of the half group order and all higher words in the
@@ -1100,5 +1156,11 @@ func (s *ModNScalar) IsOverHalfOrder() bool {
 	highWordsEqual &= constantTimeEq(s.n[1], halfOrderWordOne)
 	result |= highWordsEqual & constantTimeGreater(s.n[0], halfOrderWordZero)
 
-	return result != 0
+	return result
+}
+
+// IsOverHalfOrder returns whether or not the scalar exceeds the group order
+// divided by 2 in constant time.
+func (s *ModNScalar) IsOverHalfOrder() bool {
+	return s.isOverHalfOrderBit() != 0
 }
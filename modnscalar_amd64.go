@@ -0,0 +1,31 @@
+//go:build gc && !purego
+
+package secp256k1
+
+// scalarMul512ADX calculates the full 512-bit product of the passed two scalars
+// in constant time using the MULX and ADCX/ADOX instructions and stores it in
+// l as little-endian 64-bit words.  It MUST only be called when useADX is true.
+//
+//go:noescape
+func scalarMul512ADX(l *[8]uint64, a, b *ModNScalar)
+
+// scalarReduce512ADX reduces the 512-bit value in the passed little-endian
+// 64-bit words modulo the group order in constant time using the MULX and
+// ADCX/ADOX instructions and stores the result in s.  It MUST only be called
+// when useADX is true.
+//
+//go:noescape
+func scalarReduce512ADX(s *ModNScalar, l *[8]uint64)
+
+// scalarMul multiplies the passed two scalars together modulo the group order
+// in constant time and stores the result in s.  The assembly implementation is
+// used when the CPU supports it.
+func scalarMul(s, val, val2 *ModNScalar) {
+	if useADX {
+		var l [8]uint64
+		scalarMul512ADX(&l, val, val2)
+		scalarReduce512ADX(s, &l)
+		return
+	}
+	s.mul2Generic(val, val2)
+}
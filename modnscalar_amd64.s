@@ -0,0 +1,250 @@
+//go:build gc && !purego
+
+#include "textflag.h"
+
+// The scalars are stored as 8 little-endian uint32 words, so they are treated
+// as 4 little-endian uint64 words here.
+
+// orderComplement0 and orderComplement1 are the low and middle 64-bit words of
+// the two's complement of the group order.  The high word is 1.
+#define orderComplement0 $0x402da1732fc9bebf
+#define orderComplement1 $0x4551231950b75fc4
+
+// MULROW multiplies DX by the 4 words of a and adds the result to the 5 words
+// r0..r4 via two independent carry chains where the low halves of the products
+// are accumulated via the overflow flag and the high halves via the carry
+// flag.  The previous value of r4 is overwritten.  CX is cleared.
+#define MULROW(r0, r1, r2, r3, r4) \
+	XORQ  CX, CX;        \
+	MULXQ 0(SI), AX, BX;  \
+	ADOXQ AX, r0;         \
+	ADCXQ BX, r1;         \
+	MULXQ 8(SI), AX, BX;  \
+	ADOXQ AX, r1;         \
+	ADCXQ BX, r2;         \
+	MULXQ 16(SI), AX, BX; \
+	ADOXQ AX, r2;         \
+	ADCXQ BX, r3;         \
+	MULXQ 24(SI), AX, r4; \
+	ADOXQ AX, r3;         \
+	ADCXQ CX, r4;         \
+	ADOXQ CX, r4
+
+// MULADD multiplies DX by src and adds the 128-bit product to the 192-bit
+// value c2:c1:c0.
+#define MULADD(src, c0, c1, c2) \
+	MULXQ src, AX, BX; \
+	ADDQ  AX, c0;      \
+	ADCQ  BX, c1;      \
+	ADCQ  $0, c2
+
+// MULADDFAST multiplies DX by src and adds the 128-bit product to the 128-bit
+// value c1:c0.  The caller must ensure the result does not overflow.
+#define MULADDFAST(src, c0, c1) \
+	MULXQ src, AX, BX; \
+	ADDQ  AX, c0;      \
+	ADCQ  BX, c1
+
+// SUMADD adds a to the 192-bit value c2:c1:c0.
+#define SUMADD(a, c0, c1, c2) \
+	ADDQ a, c0;  \
+	ADCQ $0, c1; \
+	ADCQ $0, c2
+
+// SUMADDFAST adds a to the 128-bit value c1:c0.  The caller must ensure the
+// result does not overflow.
+#define SUMADDFAST(a, c0, c1) \
+	ADDQ a, c0; \
+	ADCQ $0, c1
+
+// func scalarMul512ADX(l *[8]uint64, a, b *ModNScalar)
+TEXT ·scalarMul512ADX(SB), NOSPLIT, $0-24
+	MOVQ a+8(FP), SI
+	MOVQ b+16(FP), DI
+
+	// R12:R11:R10:R9:R8 = a * b[0]
+	MOVQ  0(DI), DX
+	MULXQ 0(SI), R8, R9
+	MULXQ 8(SI), AX, R10
+	ADDQ  AX, R9
+	MULXQ 16(SI), AX, R11
+	ADCQ  AX, R10
+	MULXQ 24(SI), AX, R12
+	ADCQ  AX, R11
+	ADCQ  $0, R12
+
+	// R13:R12:R11:R10:R9 += a * b[1]
+	MOVQ 8(DI), DX
+	MULROW(R9, R10, R11, R12, R13)
+
+	// R14:R13:R12:R11:R10 += a * b[2]
+	MOVQ 16(DI), DX
+	MULROW(R10, R11, R12, R13, R14)
+
+	// R15:R14:R13:R12:R11 += a * b[3]
+	MOVQ 24(DI), DX
+	MULROW(R11, R12, R13, R14, R15)
+
+	MOVQ l+0(FP), DI
+	MOVQ R8, 0(DI)
+	MOVQ R9, 8(DI)
+	MOVQ R10, 16(DI)
+	MOVQ R11, 24(DI)
+	MOVQ R12, 32(DI)
+	MOVQ R13, 40(DI)
+	MOVQ R14, 48(DI)
+	MOVQ R15, 56(DI)
+	RET
+
+// func scalarReduce512ADX(s *ModNScalar, l *[8]uint64)
+TEXT ·scalarReduce512ADX(SB), NOSPLIT, $0-16
+	// This uses the same approach as the pure Go implementation in that it
+	// reduces the result modulo the group order by adding multiples of the
+	// two's complement of the group order, NC, in three passes that reduce the
+	// 512-bit value to 385 bits, then to 258 bits, and finally to 256 bits
+	// followed by a final conditional subtraction of the group order.  The
+	// words of each intermediate result are calculated column-wise with a
+	// 192-bit accumulator.
+	MOVQ l+8(FP), SI
+
+	// Reduce 512 bits into 385.
+	// m[0..6] = l[0..3] + n[0..3] * NC where n[0..3] = l[4..7].
+	//
+	// n0..n3 are in R8..R11 and the accumulator is rotated between R12, R13,
+	// and R14 as words are extracted.
+	MOVQ 32(SI), R8
+	MOVQ 40(SI), R9
+	MOVQ 48(SI), R10
+	MOVQ 56(SI), R11
+
+	// m0 = CX
+	MOVQ 0(SI), R12
+	XORQ R13, R13
+	MOVQ orderComplement0, DX
+	MULADDFAST(R8, R12, R13)
+	MOVQ R12, CX
+	XORQ R12, R12
+	XORQ R14, R14
+
+	// m1 = DI
+	SUMADDFAST(8(SI), R13, R12)
+	MULADD(R9, R13, R12, R14)
+	MOVQ orderComplement1, DX
+	MULADD(R8, R13, R12, R14)
+	MOVQ R13, DI
+	XORQ R13, R13
+
+	// m2 = R8 (n0 is no longer needed)
+	SUMADD(16(SI), R12, R14, R13)
+	MOVQ orderComplement0, DX
+	MULADD(R10, R12, R14, R13)
+	MOVQ orderComplement1, DX
+	MULADD(R9, R12, R14, R13)
+	SUMADD(R8, R12, R14, R13)
+	MOVQ R12, R8
+	XORQ R12, R12
+
+	// m3 = R9 (n1 is no longer needed)
+	SUMADD(24(SI), R14, R13, R12)
+	MOVQ orderComplement0, DX
+	MULADD(R11, R14, R13, R12)
+	MOVQ orderComplement1, DX
+	MULADD(R10, R14, R13, R12)
+	SUMADD(R9, R14, R13, R12)
+	MOVQ R14, R9
+	XORQ R14, R14
+
+	// m4 = R10 (n2 is no longer needed)
+	MULADD(R11, R13, R12, R14)
+	SUMADD(R10, R13, R12, R14)
+	MOVQ R13, R10
+
+	// m5 = R11 (n3 is no longer needed), m6 = R14
+	SUMADDFAST(R11, R12, R14)
+	MOVQ R12, R11
+
+	// Reduce 385 bits into 258.
+	// p[0..4] = m[0..3] + m[4..6] * NC.
+
+	// p0 = CX
+	XORQ R12, R12
+	MOVQ orderComplement0, DX
+	MULADDFAST(R10, CX, R12)
+	XORQ R13, R13
+	XORQ R15, R15
+
+	// p1 = DI
+	SUMADDFAST(DI, R12, R13)
+	MULADD(R11, R12, R13, R15)
+	MOVQ orderComplement1, DX
+	MULADD(R10, R12, R13, R15)
+	MOVQ R12, DI
+	XORQ R12, R12
+
+	// p2 = R8
+	SUMADD(R8, R13, R15, R12)
+	MOVQ orderComplement0, DX
+	MULADD(R14, R13, R15, R12)
+	MOVQ orderComplement1, DX
+	MULADD(R11, R13, R15, R12)
+	SUMADD(R10, R13, R15, R12)
+	MOVQ R13, R8
+
+	// p3 = R15, p4 = R12
+	SUMADDFAST(R9, R15, R12)
+	MULADDFAST(R14, R15, R12)
+	SUMADDFAST(R11, R15, R12)
+	ADDQ R14, R12
+
+	// Reduce 258 bits into 256.
+	// r[0..3] = p[0..3] + p[4] * NC.
+	//
+	// The products are accumulated via two independent carry chains and the
+	// final carry, which is at most one, ends up in AX.
+	MOVQ  R12, DX
+	MOVQ  orderComplement0, R10
+	MULXQ R10, R10, R11
+	MOVQ  orderComplement1, R13
+	MULXQ R13, R13, R14
+	XORQ  BX, BX
+	XORQ  AX, AX
+	ADCXQ R10, CX
+	ADCXQ R11, DI
+	ADOXQ R13, DI
+	ADCXQ R14, R8
+	ADOXQ DX, R8
+	ADCXQ BX, R15
+	ADOXQ BX, R15
+	ADCXQ BX, AX
+	ADOXQ BX, AX
+
+	// Final reduction of r.
+	//
+	// The value is guaranteed to be less than twice the group order at this
+	// point, so it only needs to be reduced by the group order when it
+	// overflows 256 bits or adding the two's complement of the group order
+	// overflows 256 bits.  Select the reduced value in constant time in that
+	// case.
+	MOVQ  orderComplement0, R10
+	MOVQ  CX, R11
+	ADDQ  R10, R11
+	MOVQ  orderComplement1, R10
+	MOVQ  DI, R13
+	ADCQ  R10, R13
+	MOVQ  R8, R14
+	ADCQ  $1, R14
+	MOVQ  R15, R9
+	ADCQ  $0, R9
+	ADCQ  $0, AX
+	TESTQ AX, AX
+	CMOVQNE R11, CX
+	CMOVQNE R13, DI
+	CMOVQNE R14, R8
+	CMOVQNE R9, R15
+
+	MOVQ s+0(FP), SI
+	MOVQ CX, 0(SI)
+	MOVQ DI, 8(SI)
+	MOVQ R8, 16(SI)
+	MOVQ R15, 24(SI)
+	RET
@@ -0,0 +1,100 @@
+//go:build gc && !purego
+
+package secp256k1
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+	"time"
+)
+
+// TestModNScalarMulADX ensures that the assembly implementations of the 512-bit
+// scalar multiplication and reduction modulo the group order produce the same
+// results as the pure Go implementations for edge cases as well as random
+// values.
+func TestModNScalarMulADX(t *testing.T) {
+	if !useADX {
+		t.Skip("CPU does not support the BMI2 and ADX instructions")
+	}
+
+	// Use a unique random seed each test instance and log it if the tests fail.
+	seed := time.Now().Unix()
+	rng := rand.New(rand.NewSource(seed))
+	defer func(t *testing.T, seed int64) {
+		if t.Failed() {
+			t.Logf("random seed: %d", seed)
+		}
+	}(t, seed)
+
+	// scalarToBig returns the passed scalar as a big integer without reducing
+	// it modulo the group order.
+	scalarToBig := func(s *ModNScalar) *big.Int {
+		result := new(big.Int)
+		for i := len(s.n) - 1; i >= 0; i-- {
+			result.Lsh(result, 32)
+			result.Add(result, new(big.Int).SetUint64(uint64(s.n[i])))
+		}
+		return result
+	}
+
+	check := func(a, b *ModNScalar) {
+		t.Helper()
+
+		// Ensure the full 512-bit product is correct.
+		var l [8]uint64
+		scalarMul512ADX(&l, a, b)
+		gotProduct := new(big.Int)
+		for i := len(l) - 1; i >= 0; i-- {
+			gotProduct.Lsh(gotProduct, 64)
+			gotProduct.Add(gotProduct, new(big.Int).SetUint64(l[i]))
+		}
+		wantProduct := new(big.Int).Mul(scalarToBig(a), scalarToBig(b))
+		if gotProduct.Cmp(wantProduct) != 0 {
+			t.Fatalf("mismatched product for %v * %v\ngot: %x\nwant: %x", a, b,
+				gotProduct, wantProduct)
+		}
+
+		// Ensure the reduction matches the pure Go reduction of the same
+		// 512-bit value.
+		var got, want ModNScalar
+		scalarReduce512ADX(&got, &l)
+		const m = uint32Mask
+		want.reduce512(l[0]&m, l[0]>>32, l[1]&m, l[1]>>32, l[2]&m, l[2]>>32,
+			l[3]&m, l[3]>>32, l[4]&m, l[4]>>32, l[5]&m, l[5]>>32, l[6]&m,
+			l[6]>>32, l[7]&m, l[7]>>32)
+		if got != want {
+			t.Fatalf("mismatched reduction of %x\ngot: %v\nwant: %v",
+				gotProduct, got, want)
+		}
+
+		// Ensure the full multiplication matches the pure Go version.
+		want.mul2Generic(a, b)
+		if got != want {
+			t.Fatalf("mismatched result for %v * %v\ngot: %v\nwant: %v", a, b,
+				got, want)
+		}
+	}
+
+	// Also test unreduced values with all bits set since they produce the
+	// largest possible 512-bit product.
+	var maxUint256 ModNScalar
+	for i := range maxUint256.n {
+		maxUint256.n[i] = uint32Mask
+	}
+	edgeCases := []*ModNScalar{
+		new(ModNScalar),
+		new(ModNScalar).SetInt(1),
+		hexToModNScalar("fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364140"),
+		hexToModNScalar("7fffffffffffffffffffffffffffffff5d576e7357a4501ddfe92f46681b20a0"),
+		&maxUint256,
+	}
+	for _, a := range edgeCases {
+		for _, b := range edgeCases {
+			check(a, b)
+		}
+	}
+	for i := 0; i < 10000; i++ {
+		check(randModNScalar(t, rng), randModNScalar(t, rng))
+	}
+}
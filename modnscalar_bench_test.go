@@ -265,6 +265,20 @@ func BenchmarkModNScalarInverse(b *testing.B) {
 	}
 }
 
+// BenchmarkModNScalarInverseConst benchmarks calculating the multiplicative
+// inverse of an unsigned 256-bit big-endian integer modulo the group order in
+// constant time with the specialized type.
+func BenchmarkModNScalarInverseConst(b *testing.B) {
+	var s1 ModNScalar
+	s1.SetByteSlice(benchmarkVals()[0])
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = new(ModNScalar).InverseVal(&s1)
+	}
+}
+
 // BenchmarkBigIntIsOverHalfOrder benchmarks determining if an unsigned 256-bit
 // big-endian integer modulo the group order exceeds half the group order with
 // stdlib big integers.
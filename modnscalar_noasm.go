@@ -0,0 +1,9 @@
+//go:build !amd64 || !gc || purego
+
+package secp256k1
+
+// scalarMul multiplies the passed two scalars together modulo the group order
+// in constant time and stores the result in s.
+func scalarMul(s, val, val2 *ModNScalar) {
+	s.mul2Generic(val, val2)
+}
@@ -1092,9 +1092,10 @@ func TestModNScalarNegateRandom(t *testing.T) {
 	}
 }
 
-// TestModNScalarInverseNonConst ensures that calculating the multiplicative
-// inverse of scalars in *non-constant* time works as expected for edge cases.
-func TestModNScalarInverseNonConst(t *testing.T) {
+// TestModNScalarInverse ensures that calculating the multiplicative inverse of
+// scalars in both constant and *non-constant* time works as expected for edge
+// cases.
+func TestModNScalarInverse(t *testing.T) {
 	tests := []struct {
 		name     string // test description
 		in       string // hex encoded test value
@@ -1166,14 +1167,29 @@ func TestModNScalarInverseNonConst(t *testing.T) {
 				result2, expected)
 			continue
 		}
+
+		// Ensure calculating the multiplicative inverse in constant time of
+		// another value and in place also produces the expected result.
+		result3 := new(ModNScalar).InverseVal(s)
+		if !result3.Equals(expected) {
+			t.Errorf("%s: unexpected result -- got: %v, want: %v", test.name,
+				result3, expected)
+			continue
+		}
+		result4 := new(ModNScalar).Set(s).Inverse()
+		if !result4.Equals(expected) {
+			t.Errorf("%s: unexpected result -- got: %v, want: %v", test.name,
+				result4, expected)
+			continue
+		}
 	}
 }
 
-// TestModNScalarInverseNonConstRandom ensures that calculating the
-// multiplicative inverse of scalars in *non-constant* time for random values
+// TestModNScalarInverseRandom ensures that calculating the multiplicative
+// inverse of scalars in both constant and *non-constant* time for random values
 // works as expected by also performing the same operation with big ints and
 // comparing the results.
-func TestModNScalarInverseNonConstRandom(t *testing.T) {
+func TestModNScalarInverseRandom(t *testing.T) {
 	// Use a unique random seed each test instance and log it if the tests fail.
 	seed := time.Now().Unix()
 	rng := rand.New(rand.NewSource(seed))
@@ -1190,17 +1206,25 @@ func TestModNScalarInverseNonConstRandom(t *testing.T) {
 		// Calculate the inverse of the value using big ints.
 		bigIntResult := new(big.Int).ModInverse(bigIntVal, curveParams.N)
 
-		// Calculate the inverse of the value using a mod n scalar.
+		// Calculate the inverse of the value using a mod n scalar in both
+		// constant and non-constant time.
 		modNValResult := new(ModNScalar).InverseValNonConst(modNVal)
+		modNValResult2 := new(ModNScalar).InverseVal(modNVal)
 
 		// Ensure they match.
 		bigIntResultHex := fmt.Sprintf("%064x", bigIntResult)
 		modNResultHex := fmt.Sprintf("%v", modNValResult)
+		modNResult2Hex := fmt.Sprintf("%v", modNValResult2)
 		if bigIntResultHex != modNResultHex {
 			t.Fatalf("mismatched inverse\nbig int in: %x\nscalar in: %v\n"+
 				"big int result: %x\nscalar result %v", bigIntVal, modNVal,
 				bigIntResult, modNValResult)
 		}
+		if bigIntResultHex != modNResult2Hex {
+			t.Fatalf("mismatched constant time inverse\nbig int in: %x\n"+
+				"scalar in: %v\nbig int result: %x\nscalar result %v",
+				bigIntVal, modNVal, bigIntResult, modNValResult2)
+		}
 	}
 }
 
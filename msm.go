@@ -0,0 +1,315 @@
+package secp256k1
+
+import "math/bits"
+
+// msmPippengerThreshold is the number of points, after splitting each input
+// point via the endomorphism, at which the multi-scalar multiplication switches
+// from the interleaved wNAF (Strauss) method to the bucket (Pippenger) method.
+//
+// The Strauss method needs a table of odd multiples for every point and one
+// point addition for every nonzero wNAF digit, so its cost grows linearly with
+// the number of points, while the Pippenger method amortizes the additions
+// across all points and thus overtakes it once there are enough of them.
+const msmPippengerThreshold = 48
+
+// scalarBitLen returns the minimum number of bits required to represent the
+// passed scalar.  The result is 0 when the value is 0.
+//
+// This function is NOT constant time.
+func scalarBitLen(s *ModNScalar) int {
+	for i := len(s.n) - 1; i >= 0; i-- {
+		if s.n[i] != 0 {
+			return i*32 + bits.Len32(s.n[i])
+		}
+	}
+	return 0
+}
+
+// msmNumPoints returns the number of pairs of the passed scalars and points
+// that contribute to the result of a multi-scalar multiplication, meaning the
+// scalar is not zero and the point is not the point at infinity.
+func msmNumPoints(scalars []ModNScalar, points []JacobianPoint) int {
+	var numPoints int
+	for i := range points {
+		if !scalars[i].IsZero() && !points[i].IsInfinity() {
+			numPoints++
+		}
+	}
+	return numPoints
+}
+
+// msmSplit decomposes all of the passed scalars via the endomorphism into
+// balanced half-length scalars such that k*P = k1*P + k2*φ(P) and returns the
+// resulting scalars along with the points they multiply.  The points are
+// negated as needed so that all of the resulting scalars are positive values
+// with small magnitudes and all of the returned points are in affine
+// coordinates and normalized.
+//
+// Pairs where either the scalar is zero or the point is the point at infinity
+// are skipped since they do not contribute to the result.
+func msmSplit(scalars []ModNScalar, points []JacobianPoint) ([]ModNScalar, []JacobianPoint) {
+	halfScalars := make([]ModNScalar, 0, 2*len(scalars))
+	halfPoints := make([]JacobianPoint, 0, 2*len(points))
+	for i := range scalars {
+		if scalars[i].IsZero() || points[i].IsInfinity() {
+			continue
+		}
+		k1, k2 := splitK(&scalars[i])
+		halfScalars = append(halfScalars, k1, k2)
+		halfPoints = append(halfPoints, points[i])
+	}
+
+	// Convert the points to affine coordinates with a single inversion so that
+	// the cheaper mixed additions are used when adding them and so that the
+	// endomorphism can be applied to the affine x coordinate.
+	numPoints := len(halfPoints)
+	batchToAffineNonConst(halfPoints)
+
+	// Calculate φ(P) for each point and negate the scalars that are over the
+	// half order along with their corresponding points to compensate.
+	//
+	// NOTE: φ(x,y) = (β*x,y).
+	halfPoints = halfPoints[:2*numPoints]
+	for i := numPoints - 1; i >= 0; i-- {
+		p1, p2 := &halfPoints[2*i], &halfPoints[2*i+1]
+		p1.Set(&halfPoints[i])
+		p2.Set(p1)
+		p2.X.Mul(endoBeta).Normalize()
+	}
+	for i := range halfScalars {
+		if halfScalars[i].IsOverHalfOrder() {
+			halfScalars[i].Negate()
+			halfPoints[i].Negate()
+		}
+	}
+
+	return halfScalars, halfPoints
+}
+
+// msmStackPoints is the maximum number of points for which the interleaved
+// wNAF (Strauss) method uses fixed-size arrays instead of allocating buffers.
+const msmStackPoints = 4
+
+// straussTableSize is the number of odd multiples of each point that are
+// precomputed by the interleaved wNAF (Strauss) method.
+const straussTableSize = 1 << (wnafPointWindow - 2)
+
+// straussPrepare decomposes the passed scalars via the endomorphism into
+// balanced half-length scalars such that k*P = k1*P + k2*φ(P), stores their
+// wNAF representations in the provided terms, and stores the odd multiples of
+// the associated points in affine coordinates in the provided tables.
+//
+// Pairs where either the scalar is zero or the point is the point at infinity
+// are skipped since they do not contribute to the result.  The provided terms
+// and tables must have space for exactly 2 entries and 2 tables per remaining
+// pair.  The table for the term at index i is located at index i in the tables
+// and the tables of the terms for k2 follow all of the tables of the terms for
+// k1.
+func straussPrepare(scalars []ModNScalar, points []JacobianPoint, terms []wnafTerm, tables []JacobianPoint) {
+	// Compute the odd multiples P, 3P, 5P, ..., (2^(w-1)-1)P of every point
+	// and convert them all to affine coordinates with a single inversion.
+	numPoints := len(terms) / 2
+	var pointIdx int
+	for i := range points {
+		if scalars[i].IsZero() || points[i].IsInfinity() {
+			continue
+		}
+		table := tables[pointIdx*straussTableSize : (pointIdx+1)*straussTableSize]
+		var twoP JacobianPoint
+		table[0].Set(&points[i])
+		DoubleNonConst(&points[i], &twoP)
+		for j := 1; j < straussTableSize; j++ {
+			AddNonConst(&table[j-1], &twoP, &table[j])
+		}
+
+		// Decompose the scalar and negate the resulting half-length scalars
+		// that are over the half order along with their corresponding points
+		// to compensate.
+		k1, k2 := splitK(&scalars[i])
+		term1, term2 := &terms[pointIdx], &terms[numPoints+pointIdx]
+		term1.negate = k1.IsOverHalfOrder()
+		if term1.negate {
+			k1.Negate()
+		}
+		term2.negate = k2.IsOverHalfOrder()
+		if term2.negate {
+			k2.Negate()
+		}
+		term1.digits, term1.numDigits = wnaf(&k1, wnafPointWindow)
+		term2.digits, term2.numDigits = wnaf(&k2, wnafPointWindow)
+		term1.oddOnly, term2.oddOnly = true, true
+		pointIdx++
+	}
+	pointTables := tables[:numPoints*straussTableSize]
+	batchToAffineNonConst(pointTables)
+
+	// Since φ(j*P) = j*φ(P), the odd multiples of φ(P) only require a single
+	// field multiplication each given the odd multiples of P.
+	//
+	// NOTE: φ(x,y) = (β*x,y).
+	endoTables := tables[numPoints*straussTableSize:]
+	for i := range endoTables {
+		endoTables[i].Set(&pointTables[i])
+		endoTables[i].X.Mul(endoBeta).Normalize()
+	}
+}
+
+// straussNonConst calculates the sum of the products of the passed scalars and
+// points using the interleaved wNAF (Strauss) method in *non-constant* time and
+// stores the result in the provided Jacobian point.
+func straussNonConst(scalars []ModNScalar, points []JacobianPoint, result *JacobianPoint) {
+	numPoints := msmNumPoints(scalars, points)
+
+	// Avoid allocating for small numbers of points since the allocations are a
+	// significant portion of the overall cost in that case.
+	//
+	// NOTE: The arrays are intentionally only referenced via local arrays here
+	// as opposed to slices since storing the tables in the terms via a slice
+	// would otherwise force the arrays to escape to the heap.
+	if numPoints <= msmStackPoints {
+		var terms [2 * msmStackPoints]wnafTerm
+		var tables [2 * msmStackPoints * straussTableSize]JacobianPoint
+		straussPrepare(scalars, points, terms[:2*numPoints],
+			tables[:2*numPoints*straussTableSize])
+		for i := 0; i < 2*numPoints; i++ {
+			terms[i].table = tables[i*straussTableSize : (i+1)*straussTableSize]
+		}
+		interleavedMultNonConst(terms[:2*numPoints], result)
+		return
+	}
+
+	terms := make([]wnafTerm, 2*numPoints)
+	tables := make([]JacobianPoint, 2*numPoints*straussTableSize)
+	straussPrepare(scalars, points, terms, tables)
+	for i := range terms {
+		terms[i].table = tables[i*straussTableSize : (i+1)*straussTableSize]
+	}
+	interleavedMultNonConst(terms, result)
+}
+
+// pippengerWindow returns the window size that minimizes the approximate
+// number of point additions needed by the bucket method for the given number
+// of points with scalars that are at most the provided number of bits.
+func pippengerWindow(numPoints, numBits int) uint {
+	// Each window requires an addition per point to place it in a bucket along
+	// with two additions per bucket to sum the buckets and the signed digits
+	// mean there are 2^(c-1) buckets for a window size of c.
+	bestWindow, bestCost := uint(1), -1
+	for c := uint(1); c <= 16; c++ {
+		numWindows := numBits/int(c) + 1
+		cost := numWindows * (numPoints + 1<<c)
+		if bestCost == -1 || cost < bestCost {
+			bestWindow, bestCost = c, cost
+		}
+	}
+	return bestWindow
+}
+
+// pippengerNonConst calculates the sum of the products of the passed positive
+// half-length scalars and affine points using the bucket (Pippenger) method in
+// *non-constant* time and stores the result in the provided Jacobian point.
+func pippengerNonConst(scalars []ModNScalar, points []JacobianPoint, result *JacobianPoint) {
+	// Determine the window size and number of windows based on the number of
+	// points and the length of the longest scalar.  An additional window is
+	// needed to absorb the final carry of the signed digit recoding.
+	var numBits int
+	for i := range scalars {
+		if bitLen := scalarBitLen(&scalars[i]); bitLen > numBits {
+			numBits = bitLen
+		}
+	}
+	c := pippengerWindow(len(points), numBits)
+	numWindows := numBits/int(c) + 1
+
+	// Recode all of the scalars into signed digits in base 2^c such that each
+	// digit is in the range [-2^(c-1), 2^(c-1)].  This halves the number of
+	// buckets needed versus unsigned digits since negating a point is nearly
+	// free.
+	half := int32(1) << (c - 1)
+	digits := make([]int32, len(scalars)*numWindows)
+	for i := range scalars {
+		var carry int32
+		scalarDigits := digits[i*numWindows : (i+1)*numWindows]
+		for w := range scalarDigits {
+			digit := int32(scalarBits(&scalars[i], uint(w)*c, c)) + carry
+			carry = 0
+			if digit > half {
+				digit -= 2 * half
+				carry = 1
+			}
+			scalarDigits[w] = digit
+		}
+	}
+
+	// Process the windows from most to least significant.  For each window,
+	// every point is added to (or subtracted from) the bucket that corresponds
+	// to its digit and then the sum of each bucket multiplied by its index is
+	// calculated with a running sum:
+	//
+	// ∑ j*B_j = B_m + (B_m + B_(m-1)) + ... + (B_m + B_(m-1) + ... + B_1)
+	//
+	// The overall result is then doubled c times between windows.
+	//
+	// Point Q = ∞ (point at infinity).
+	var q JacobianPoint
+	buckets := make([]JacobianPoint, half)
+	for w := numWindows - 1; w >= 0; w-- {
+		for i := uint(0); i < c; i++ {
+			DoubleNonConst(&q, &q)
+		}
+
+		for i := range buckets {
+			buckets[i] = JacobianPoint{}
+		}
+		for i := range points {
+			if digit := digits[i*numWindows+w]; digit > 0 {
+				AddNonConst(&buckets[digit-1], &points[i], &buckets[digit-1])
+			} else if digit < 0 {
+				addSignedNonConst(&points[i], true, &buckets[-digit-1])
+			}
+		}
+
+		var runningSum, windowSum JacobianPoint
+		for i := len(buckets) - 1; i >= 0; i-- {
+			AddNonConst(&runningSum, &buckets[i], &runningSum)
+			AddNonConst(&windowSum, &runningSum, &windowSum)
+		}
+		AddNonConst(&q, &windowSum, &q)
+	}
+
+	result.Set(&q)
+}
+
+// MultiScalarMultNonConst calculates the sum of the products of the passed
+// scalars and points, k_1*P_1 + k_2*P_2 + ... + k_n*P_n, where each k_i is a
+// scalar modulo the curve order and each P_i is a point in Jacobian projective
+// coordinates and stores the result in the provided Jacobian point.
+//
+// The interleaved wNAF (Strauss) method is used for smaller numbers of points
+// while the bucket (Pippenger) method is used for larger numbers of points.
+// Both methods make use of the endomorphism to halve the length of the scalars.
+// No allocations are made when there are no more than 4 points.
+//
+// The advantage over multiplying each point by its scalar individually and
+// adding the results grows with the number of points.  Per the included
+// benchmarks, it is roughly 1.3 times as fast for 2 points, twice as fast for
+// 16 points, and around 4 times as fast for 256 or more points.
+//
+// The result is the point at infinity when no points are provided.
+//
+// This function will panic if the number of scalars and points differ.
+//
+// NOTE: The points must be normalized for this function to return the correct
+// result.  The resulting point will be normalized.
+func MultiScalarMultNonConst(scalars []ModNScalar, points []JacobianPoint, result *JacobianPoint) {
+	if len(scalars) != len(points) {
+		panic("mismatched number of scalars and points")
+	}
+
+	if 2*msmNumPoints(scalars, points) < msmPippengerThreshold {
+		straussNonConst(scalars, points, result)
+		return
+	}
+	halfScalars, halfPoints := msmSplit(scalars, points)
+	pippengerNonConst(halfScalars, halfPoints, result)
+}
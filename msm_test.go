@@ -0,0 +1,127 @@
+package secp256k1
+
+import (
+	mrand "math/rand"
+	"testing"
+	"time"
+)
+
+// naiveMultiScalarMult calculates the sum of the products of the passed scalars
+// and points by multiplying each point by its scalar individually and adding
+// the results.
+func naiveMultiScalarMult(scalars []ModNScalar, points []JacobianPoint, result *JacobianPoint) {
+	var sum JacobianPoint
+	for i := range scalars {
+		var product JacobianPoint
+		ScalarMultNonConst(&scalars[i], &points[i], &product)
+		AddNonConst(&sum, &product, &sum)
+	}
+	result.Set(&sum)
+}
+
+// randMSMInputs returns the requested number of random scalars and points for
+// use in testing multi-scalar multiplication.  The points are a mix of affine
+// and non-affine points and a few edge cases such as zero scalars, the point at
+// infinity, and repeated and negated points are included when there are enough
+// of them.
+func randMSMInputs(t *testing.T, rng *mrand.Rand, n int) ([]ModNScalar, []JacobianPoint) {
+	t.Helper()
+
+	scalars := make([]ModNScalar, n)
+	points := make([]JacobianPoint, n)
+	for i := 0; i < n; i++ {
+		scalars[i] = *randModNScalar(t, rng)
+		ScalarBaseMultNonConst(randModNScalar(t, rng), &points[i])
+		if i%2 == 0 {
+			points[i].ToAffine()
+		}
+	}
+	if n >= 8 {
+		scalars[1].Zero()
+		points[2] = JacobianPoint{}
+		points[3].Set(&points[4])
+		points[5].Set(&points[6])
+		points[5].Y.Negate(1).Normalize()
+		scalars[5].Set(&scalars[6])
+		scalars[7].Set(&scalars[6]).Negate()
+		points[7].Set(&points[6])
+	}
+	return scalars, points
+}
+
+// TestMultiScalarMultNonConst ensures the multi-scalar multiplication produces
+// the same results as multiplying each point by its scalar individually and
+// adding the results for randomly-generated inputs of various sizes that cover
+// both the Strauss and Pippenger methods.
+func TestMultiScalarMultNonConst(t *testing.T) {
+	// Use a unique random seed each test instance and log it if the tests fail.
+	seed := time.Now().Unix()
+	rng := mrand.New(mrand.NewSource(seed))
+	defer func(t *testing.T, seed int64) {
+		if t.Failed() {
+			t.Logf("random seed: %d", seed)
+		}
+	}(t, seed)
+
+	sizes := []int{0, 1, 2, 3, 8, 17, msmPippengerThreshold/2 - 1,
+		msmPippengerThreshold / 2, 100, 300}
+	for _, n := range sizes {
+		scalars, points := randMSMInputs(t, rng, n)
+		var want, got JacobianPoint
+		naiveMultiScalarMult(scalars, points, &want)
+		MultiScalarMultNonConst(scalars, points, &got)
+		if !isSameAffinePoint(&got, &want) {
+			t.Fatalf("n=%d: wrong result\ngot: (%v, %v, %v)\nwant: (%v, %v, %v)",
+				n, got.X, got.Y, got.Z, want.X, want.Y, want.Z)
+		}
+	}
+}
+
+// TestMultiScalarMultMethods ensures the Strauss and Pippenger methods both
+// produce the expected results regardless of the number of points, which
+// exercises the bucket method with all of the small window sizes that are
+// never selected for it in practice.
+func TestMultiScalarMultMethods(t *testing.T) {
+	// Use a unique random seed each test instance and log it if the tests fail.
+	seed := time.Now().Unix()
+	rng := mrand.New(mrand.NewSource(seed))
+	defer func(t *testing.T, seed int64) {
+		if t.Failed() {
+			t.Logf("random seed: %d", seed)
+		}
+	}(t, seed)
+
+	for _, n := range []int{1, 2, 4, 8, 16, 40} {
+		scalars, points := randMSMInputs(t, rng, n)
+		var want JacobianPoint
+		naiveMultiScalarMult(scalars, points, &want)
+
+		var strauss, pippenger JacobianPoint
+		straussNonConst(scalars, points, &strauss)
+		halfScalars, halfPoints := msmSplit(scalars, points)
+		pippengerNonConst(halfScalars, halfPoints, &pippenger)
+		if !isSameAffinePoint(&strauss, &want) {
+			t.Fatalf("n=%d: wrong strauss result\ngot: (%v, %v, %v)\nwant: "+
+				"(%v, %v, %v)", n, strauss.X, strauss.Y, strauss.Z, want.X,
+				want.Y, want.Z)
+		}
+		if !isSameAffinePoint(&pippenger, &want) {
+			t.Fatalf("n=%d: wrong pippenger result\ngot: (%v, %v, %v)\nwant: "+
+				"(%v, %v, %v)", n, pippenger.X, pippenger.Y, pippenger.Z,
+				want.X, want.Y, want.Z)
+		}
+	}
+}
+
+// TestMultiScalarMultMismatched ensures the multi-scalar multiplication panics
+// when the number of scalars and points differ.
+func TestMultiScalarMultMismatched(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("did not panic with mismatched scalars and points")
+		}
+	}()
+	var result JacobianPoint
+	MultiScalarMultNonConst(make([]ModNScalar, 2), make([]JacobianPoint, 1),
+		&result)
+}
@@ -7,6 +7,7 @@ package secp256k1
 
 import (
 	"bytes"
+	"crypto"
 	"crypto/sha256"
 	"hash"
 )
@@ -26,82 +27,102 @@ var (
 	// here to avoid the need to create it multiple times.
 	singleZero = []byte{0x00}
 
-	// zeroInitializer is used during RFC6979 nonce generation.  It is provided
-	// here to avoid the need to create it multiple times.
-	zeroInitializer = bytes.Repeat([]byte{0x00}, sha256.BlockSize)
-
 	// singleOne is used during RFC6979 nonce generation.  It is provided
 	// here to avoid the need to create it multiple times.
 	singleOne = []byte{0x01}
-
-	// oneInitializer is used during RFC6979 nonce generation.  It is provided
-	// here to avoid the need to create it multiple times.
-	oneInitializer = bytes.Repeat([]byte{0x01}, sha256.Size)
 )
 
-// hmacsha256 implements a resettable version of HMAC-SHA256.
-type hmacsha256 struct {
+// resettableHMAC implements a resettable version of HMAC for any hash function.
+type resettableHMAC struct {
 	inner, outer hash.Hash
-	ipad, opad   [sha256.BlockSize]byte
+	ipad, opad   []byte
 }
 
 // Write adds data to the running hash.
-func (h *hmacsha256) Write(p []byte) {
+func (h *resettableHMAC) Write(p []byte) {
 	h.inner.Write(p)
 }
 
-// initKey initializes the HMAC-SHA256 instance to the provided key.
-func (h *hmacsha256) initKey(key []byte) {
+// initKey initializes the HMAC instance to the provided key.
+func (h *resettableHMAC) initKey(key []byte) {
 	// Hash the key if it is too large.
-	if len(key) > sha256.BlockSize {
+	if len(key) > len(h.ipad) {
 		h.outer.Write(key)
 		key = h.outer.Sum(nil)
 	}
-	copy(h.ipad[:], key)
-	copy(h.opad[:], key)
+	copy(h.ipad, key)
+	copy(h.opad, key)
 	for i := range h.ipad {
 		h.ipad[i] ^= 0x36
 	}
 	for i := range h.opad {
 		h.opad[i] ^= 0x5c
 	}
-	h.inner.Write(h.ipad[:])
+	h.inner.Write(h.ipad)
 }
 
-// ResetKey resets the HMAC-SHA256 to its initial state and then initializes it
-// with the provided key.  It is equivalent to creating a new instance with the
+// ResetKey resets the HMAC to its initial state and then initializes it with
+// the provided key.  It is equivalent to creating a new instance with the
 // provided key without allocating more memory.
-func (h *hmacsha256) ResetKey(key []byte) {
+func (h *resettableHMAC) ResetKey(key []byte) {
 	h.inner.Reset()
 	h.outer.Reset()
-	copy(h.ipad[:], zeroInitializer)
-	copy(h.opad[:], zeroInitializer)
+	clear(h.ipad)
+	clear(h.opad)
 	h.initKey(key)
 }
 
-// Resets the HMAC-SHA256 to its initial state using the current key.
-func (h *hmacsha256) Reset() {
+// Resets the HMAC to its initial state using the current key.
+func (h *resettableHMAC) Reset() {
 	h.inner.Reset()
-	h.inner.Write(h.ipad[:])
+	h.inner.Write(h.ipad)
 }
 
 // Sum returns the hash of the written data.
-func (h *hmacsha256) Sum() []byte {
+func (h *resettableHMAC) Sum() []byte {
 	h.outer.Reset()
-	h.outer.Write(h.opad[:])
+	h.outer.Write(h.opad)
 	h.outer.Write(h.inner.Sum(nil))
 	return h.outer.Sum(nil)
 }
 
-// newHMACSHA256 returns a new HMAC-SHA256 hasher using the provided key.
-func newHMACSHA256(key []byte) *hmacsha256 {
-	h := new(hmacsha256)
-	h.inner = sha256.New()
-	h.outer = sha256.New()
+// newResettableHMAC returns a new HMAC hasher for the hash function returned by
+// the provided constructor using the provided key.
+func newResettableHMAC(newHash func() hash.Hash, key []byte) *resettableHMAC {
+	h := new(resettableHMAC)
+	h.inner = newHash()
+	h.outer = newHash()
+	blockSize := h.inner.BlockSize()
+	pads := make([]byte, 2*blockSize)
+	h.ipad, h.opad = pads[:blockSize], pads[blockSize:]
 	h.initKey(key)
 	return h
 }
 
+// bits2int converts the provided bit string to an integer modulo the group
+// order per section 2.3.2 of RFC6979 and stores it in the provided scalar.  It
+// returns whether or not the integer, prior to the reduction, overflowed the
+// group order.
+//
+// Since the group order is 256 bits, this means bit strings that are longer
+// than 256 bits are truncated to their leftmost 256 bits while shorter ones are
+// interpreted as big-endian integers.  This is the same conversion FIPS 186-5
+// specifies for converting message hashes to integers when signing and
+// verifying, which is also what the SetByteSlice method of the scalar does.
+func bits2int(b []byte, s *ModNScalar) bool {
+	return s.SetByteSlice(b)
+}
+
+// bits2octets converts the provided bit string to an integer modulo the group
+// order per section 2.3.4 of RFC6979 and stores it in the provided byte array
+// as a 256-bit big-endian integer.
+func bits2octets(b []byte, octets *[32]byte) {
+	var s ModNScalar
+	bits2int(b, &s)
+	s.PutBytes(octets)
+	s.Zero()
+}
+
 // NonceRFC6979 generates a nonce deterministically according to RFC 6979 using
 // HMAC-SHA256 for the hashing function.  It takes a 32-byte hash as an input
 // and returns a 32-byte nonce to be used for deterministic signing.  The extra
@@ -114,11 +135,31 @@ func newHMACSHA256(key []byte) *hmacsha256 {
 // that results in a valid signature in the extremely unlikely event the
 // original nonce produced results in an invalid signature (e.g. R == 0).
 // Signing code should start with 0 and increment it if necessary.
+//
+// See NonceRFC6979Hash for hashes produced by other hash functions.
 func NonceRFC6979(privKey []byte, hash []byte, extra []byte, version []byte, extraIterations uint32) *ModNScalar {
-	// Input to HMAC is the 32-byte private key and the 32-byte hash.  In
-	// addition, it may include the optional 32-byte extra data and 16-byte
-	// version.  Create a fixed-size array to avoid extra allocs and slice it
-	// properly.
+	return nonceRFC6979(sha256.New, privKey, hash, extra, version,
+		extraIterations)
+}
+
+// NonceRFC6979Hash is identical to NonceRFC6979 except it uses HMAC with the
+// provided hash function instead of HMAC-SHA256.  Per RFC 6979, the hash
+// function should be the one that produced the provided hash, which may be of
+// any length, in order to produce the same nonces as other implementations.
+//
+// It panics if the hash function is not available.
+func NonceRFC6979Hash(h crypto.Hash, privKey []byte, hash []byte, extra []byte, version []byte, extraIterations uint32) *ModNScalar {
+	return nonceRFC6979(h.New, privKey, hash, extra, version, extraIterations)
+}
+
+// nonceRFC6979 generates a nonce deterministically according to RFC 6979 using
+// HMAC with the hash function returned by the provided constructor.  See
+// NonceRFC6979 for details regarding the parameters.
+func nonceRFC6979(newHash func() hash.Hash, privKey []byte, hash []byte, extra []byte, version []byte, extraIterations uint32) *ModNScalar {
+	// Input to HMAC is the 32-byte private key and the 32-byte hash converted
+	// per bits2octets.  In addition, it may include the optional 32-byte extra
+	// data and 16-byte version.  Create a fixed-size array to avoid extra
+	// allocs and slice it properly.
 	const (
 		privKeyLen = 32
 		hashLen    = 32
@@ -126,19 +167,20 @@ func NonceRFC6979(privKey []byte, hash []byte, extra []byte, version []byte, ext
 		versionLen = 16
 	)
 	var keyBuf [privKeyLen + hashLen + extraLen + versionLen]byte
+	defer clear(keyBuf[:])
 
-	// Truncate rightmost bytes of private key and hash if they are too long and
-	// leave left padding of zeros when they're too short.
+	// Truncate rightmost bytes of private key if it is too long and leave left
+	// padding of zeros when it is too short.
 	if len(privKey) > privKeyLen {
 		privKey = privKey[:privKeyLen]
 	}
-	if len(hash) > hashLen {
-		hash = hash[:hashLen]
-	}
 	offset := privKeyLen - len(privKey) // Zero left padding if needed.
 	offset += copy(keyBuf[offset:], privKey)
-	offset += hashLen - len(hash) // Zero left padding if needed.
-	offset += copy(keyBuf[offset:], hash)
+
+	// bits2octets(h1)
+	var hashOctets [hashLen]byte
+	bits2octets(hash, &hashOctets)
+	offset += copy(keyBuf[offset:], hashOctets[:])
 	if len(extra) == extraLen {
 		offset += copy(keyBuf[offset:], extra)
 		if len(version) == versionLen {
@@ -155,23 +197,22 @@ func NonceRFC6979(privKey []byte, hash []byte, extra []byte, version []byte, ext
 	// Step B.
 	//
 	// V = 0x01 0x01 0x01 ... 0x01 such that the length of V, in bits, is
-	// equal to 8*ceil(hashLen/8).
+	// equal to 8*ceil(hlen/8).
 	//
-	// Note that since the hash length is a multiple of 8 for the chosen hash
-	// function in this optimized implementation, the result is just the hash
-	// length, so avoid the extra calculations.  Also, since it isn't modified,
-	// start with a global value.
-	v := oneInitializer
+	// Note that since the output length of all supported hash functions is a
+	// multiple of 8, the result is just the output length.
+	hasher := newResettableHMAC(newHash, nil)
+	hLen := hasher.inner.Size()
+	v := bytes.Repeat(singleOne, hLen)
 
-	// Step C (Go zeroes all allocated memory).
+	// Step C.
 	//
 	// K = 0x00 0x00 0x00 ... 0x00 such that the length of K, in bits, is
-	// equal to 8*ceil(hashLen/8).
+	// equal to 8*ceil(hlen/8).
 	//
-	// As above, since the hash length is a multiple of 8 for the chosen hash
-	// function in this optimized implementation, the result is just the hash
-	// length, so avoid the extra calculations.
-	k := zeroInitializer[:hashLen]
+	// Note that HMAC pads keys with zeros to the block size of the hash
+	// function, so the hasher created with an empty key above is already
+	// keyed with K.
 
 	// Step D.
 	//
@@ -179,11 +220,10 @@ func NonceRFC6979(privKey []byte, hash []byte, extra []byte, version []byte, ext
 	//
 	// Note that key is the "int2octets(x) || bits2octets(h1)" portion along
 	// with potential additional data as described by section 3.6 of the RFC.
-	hasher := newHMACSHA256(k)
-	hasher.Write(oneInitializer)
-	hasher.Write(singleZero[:])
+	hasher.Write(v)
+	hasher.Write(singleZero)
 	hasher.Write(key)
-	k = hasher.Sum()
+	k := hasher.Sum()
 
 	// Step E.
 	//
@@ -200,8 +240,8 @@ func NonceRFC6979(privKey []byte, hash []byte, extra []byte, version []byte, ext
 	// with potential additional data as described by section 3.6 of the RFC.
 	hasher.Reset()
 	hasher.Write(v)
-	hasher.Write(singleOne[:])
-	hasher.Write(key[:])
+	hasher.Write(singleOne)
+	hasher.Write(key)
 	k = hasher.Sum()
 
 	// Step G.
@@ -215,6 +255,7 @@ func NonceRFC6979(privKey []byte, hash []byte, extra []byte, version []byte, ext
 	//
 	// Repeat until the value is nonzero and less than the curve order.
 	var generated uint32
+	t := make([]byte, 0, hashLen+hLen)
 	for {
 		// Step H1 and H2.
 		//
@@ -224,13 +265,13 @@ func NonceRFC6979(privKey []byte, hash []byte, extra []byte, version []byte, ext
 		// While tlen < qlen, do the following:
 		//   V = HMAC_K(V)
 		//   T = T || V
-		//
-		// Note that because the hash function output is the same length as the
-		// private key in this optimized implementation, there is no need to
-		// loop or create an intermediate T.
-		hasher.Reset()
-		hasher.Write(v)
-		v = hasher.Sum()
+		t = t[:0]
+		for len(t) < hashLen {
+			hasher.Reset()
+			hasher.Write(v)
+			v = hasher.Sum()
+			t = append(t, v...)
+		}
 
 		// Step H3.
 		//
@@ -241,7 +282,7 @@ func NonceRFC6979(privKey []byte, hash []byte, extra []byte, version []byte, ext
 		// K = HMAC_K(V || 0x00)
 		// V = HMAC_K(V)
 		var secret ModNScalar
-		overflow := secret.SetByteSlice(v)
+		overflow := bits2int(t, &secret)
 		if !overflow && !secret.IsZero() {
 			generated++
 			if generated > extraIterations {
@@ -252,7 +293,7 @@ func NonceRFC6979(privKey []byte, hash []byte, extra []byte, version []byte, ext
 		// K = HMAC_K(V || 0x00)
 		hasher.Reset()
 		hasher.Write(v)
-		hasher.Write(singleZero[:])
+		hasher.Write(singleZero)
 		k = hasher.Sum()
 
 		// V = HMAC_K(V)
@@ -7,7 +7,10 @@ package secp256k1
 
 import (
 	"bytes"
+	"crypto"
+	_ "crypto/sha1"
 	"crypto/sha256"
+	_ "crypto/sha512"
 	"encoding/hex"
 	"testing"
 )
@@ -216,3 +219,69 @@ func TestRFC6979Compat(t *testing.T) {
 		}
 	}
 }
+
+// TestNonceRFC6979Hash ensures that the deterministic nonces generated by
+// NonceRFC6979Hash with various hash functions, including those with outputs
+// that are shorter and longer than the group order, match an independent
+// implementation of RFC6979 and that hashes that exceed the group order are
+// reduced per bits2octets.
+func TestNonceRFC6979Hash(t *testing.T) {
+	t.Parallel()
+
+	const key = "a9d2fe03b2d3e3e8c5e0e4d2e1b3a7b1c2d9e8f7a6b5c4d3e2f1a0b9c8d7e6f5"
+	tests := []struct {
+		name     string      // test description
+		hashFunc crypto.Hash // hash function for the HMAC
+		hash     string      // hex encoded hash
+		expected string      // expected hex encoded nonce
+	}{{
+		name:     "SHA-1 of \"sample\"",
+		hashFunc: crypto.SHA1,
+		hash:     "8151325dcdbae9e0ff95f9f9658432dbedfdb209",
+		expected: "563c7473ba12866eea18bb44cc497a4f12e470caf7e989c8f3c7e320265d282b",
+	}, {
+		name:     "SHA-256 of \"sample\"",
+		hashFunc: crypto.SHA256,
+		hash:     "af2bdbe1aa9b6ec1e2ade1d694f41fc71a831d0268e9891562113d8a62add1bf",
+		expected: "3088cbde8864d17d612de00ea5dc51adee3c154d6a8f747f3193e97b08f72c7e",
+	}, {
+		name:     "SHA-384 of \"sample\"",
+		hashFunc: crypto.SHA384,
+		hash:     "9a9083505bc92276aec4be312696ef7bf3bf603f4bbd381196a029f340585312313bca4a9b5b890efee42c77b1ee25fe",
+		expected: "d493afc57281ff7046a8402e241b80d8ef80b7fc6e8939af0c2ca0c1f84b7149",
+	}, {
+		name:     "SHA-512 of \"sample\"",
+		hashFunc: crypto.SHA512,
+		hash:     "39a5e04aaff7455d9850c605364f514c11324ce64016960d23d5dc57d3ffd8f49a739468ab8049bf18eef820cdb1ad6c9015f838556bc7fad4138b23fdf986c7",
+		expected: "61f830024a5504abfddd4a343f78dc9df7422af9b0921b0c3f04c750dbe354bf",
+	}, {
+		name:     "SHA-256 hash that exceeds the group order",
+		hashFunc: crypto.SHA256,
+		hash:     "ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff",
+		expected: "de549a57992ce481c60e5d3fb8b8069bedf5a837916efda2a256677b3c08bb37",
+	}}
+
+	for _, test := range tests {
+		privKey := hexToBytes(key)
+		hash := hexToBytes(test.hash)
+		gotNonce := NonceRFC6979Hash(test.hashFunc, privKey, hash, nil, nil, 0)
+		gotNonceBytes := gotNonce.Bytes()
+		if !bytes.Equal(gotNonceBytes[:], hexToBytes(test.expected)) {
+			t.Errorf("%s: unexpected nonce -- got %x, want %s", test.name,
+				gotNonceBytes, test.expected)
+			continue
+		}
+
+		// Ensure NonceRFC6979 produces the same nonce for SHA-256.
+		if test.hashFunc != crypto.SHA256 {
+			continue
+		}
+		gotNonce = NonceRFC6979(privKey, hash, nil, nil, 0)
+		gotNonceBytes = gotNonce.Bytes()
+		if !bytes.Equal(gotNonceBytes[:], hexToBytes(test.expected)) {
+			t.Errorf("%s: unexpected NonceRFC6979 nonce -- got %x, want %s",
+				test.name, gotNonceBytes, test.expected)
+			continue
+		}
+	}
+}
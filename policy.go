@@ -0,0 +1,152 @@
+package secp256k1
+
+import (
+	"crypto"
+	"fmt"
+)
+
+// policyHashLen is the length of the hashes that are accepted by policies that
+// require hashes to be exactly 32 bytes when no hash function is specified.
+const policyHashLen = 32
+
+// Policy houses additional rules that are enforced when verifying signatures,
+// recovering public keys from signatures, and parsing public keys and
+// signatures.
+//
+// The package level functions and methods are deliberately permissive so that
+// they can handle the data that exists in the wild.  However, consensus code
+// and the like typically needs to enforce stricter rules, such as those
+// introduced by BIP0062, BIP0146, and BIP0066, that it is otherwise forced to
+// check separately.  The methods of a policy perform the same operations while
+// enforcing the rules it specifies in a single place.
+//
+// The zero value and a nil policy enforce no additional rules and thus are
+// equivalent to the package level functions and methods.
+type Policy struct {
+	// RequireLowS rejects signatures with an S value that is greater than half
+	// the group order per the LOW_S rule of BIP0062 and BIP0146.
+	//
+	// Every valid signature with a high S value has an equivalent valid
+	// signature with the negated S value, so rejecting one of them removes a
+	// source of signature malleability.  All signatures produced by this
+	// package already have low S values.
+	RequireLowS bool
+
+	// StrictHashLen rejects hashes that are not exactly 32 bytes, or the size
+	// of the digests of Hash when it is set, instead of truncating longer
+	// hashes and zero-extending shorter ones.
+	StrictHashLen bool
+
+	// Hash optionally specifies the hash function that produced the hashes
+	// for the purposes of StrictHashLen.
+	Hash crypto.Hash
+
+	// RejectHybridPubKeys rejects public keys serialized in the hybrid format,
+	// which makes little sense in practice and is disallowed by the STRICTENC
+	// rules of Bitcoin.
+	RejectHybridPubKeys bool
+
+	// RejectUncompressedPubKeys rejects public keys serialized in the
+	// uncompressed or hybrid formats and thus only accepts public keys
+	// serialized in the compressed format.
+	RejectUncompressedPubKeys bool
+
+	// LaxDER parses signatures with ParseDERSignatureLax instead of the strict
+	// ParseDERSignature in order to accept the loosely-encoded signatures that
+	// were allowed prior to BIP0066.
+	LaxDER bool
+}
+
+// checkHashLen returns an error when the policy requires hashes to be an exact
+// size and the passed hash is not.
+func (p *Policy) checkHashLen(hash []byte) error {
+	if p == nil || !p.StrictHashLen {
+		return nil
+	}
+	wantLen := policyHashLen
+	if p.Hash != 0 {
+		wantLen = p.Hash.Size()
+	}
+	if len(hash) != wantLen {
+		str := fmt.Sprintf("wrong size for hash (got %v, want %v)",
+			len(hash), wantLen)
+		return signatureError(ErrInvalidHashLen, str)
+	}
+	return nil
+}
+
+// Verify verifies the signature for the provided hash and secp256k1 public key
+// while enforcing the rules of the policy and either returns nil if it is valid
+// or an error that indicates why it is not.
+//
+// It is otherwise identical to the VerifyErr method of the signature.
+func (p *Policy) Verify(sig *Signature, hash []byte, pubKey *PublicKey) error {
+	if err := p.checkHashLen(hash); err != nil {
+		return err
+	}
+	requireLowS := p != nil && p.RequireLowS
+	return sig.verify(hash, pubKey, nil, requireLowS)
+}
+
+// RecoverPublicKey recovers the public key from the signature, which must have
+// a pubkey recovery code, and the provided hash while enforcing the rules of
+// the policy.
+//
+// It is otherwise identical to the RecoverPublicKey method of the signature.
+func (p *Policy) RecoverPublicKey(sig *Signature, hash []byte) (*PublicKey, error) {
+	if err := p.checkHashLen(hash); err != nil {
+		return nil, err
+	}
+	if p != nil && p.RequireLowS && sig.s.IsOverHalfOrder() {
+		str := "invalid signature: S is greater than half the group order"
+		return nil, signatureError(ErrSigHighS, str)
+	}
+	return sig.RecoverPublicKey(hash)
+}
+
+// ParsePubKey parses a secp256k1 public key encoded according to the format
+// specified by ANSI X9.62-1998 while enforcing the rules of the policy.
+//
+// It is otherwise identical to ParsePubKey.
+func (p *Policy) ParsePubKey(serialized []byte) (*PublicKey, error) {
+	if p != nil && len(serialized) > 0 {
+		switch format := serialized[0]; {
+		case p.RejectUncompressedPubKeys &&
+			format != PubKeyFormatCompressedEven &&
+			format != PubKeyFormatCompressedOdd:
+
+			str := fmt.Sprintf("invalid public key: format %x is not "+
+				"compressed", format)
+			return nil, makeError(ErrPubKeyInvalidFormat, str)
+
+		case p.RejectHybridPubKeys && (format == PubKeyFormatHybridEven ||
+			format == PubKeyFormatHybridOdd):
+
+			str := fmt.Sprintf("invalid public key: hybrid format %x is not "+
+				"allowed", format)
+			return nil, makeError(ErrPubKeyInvalidFormat, str)
+		}
+	}
+	return ParsePubKey(serialized)
+}
+
+// ParseDERSignature parses a signature in the Distinguished Encoding Rules
+// (DER) format while enforcing the rules of the policy.
+//
+// It is otherwise identical to ParseDERSignature, or ParseDERSignatureLax when
+// the policy specifies lax encoding.
+func (p *Policy) ParseDERSignature(sig []byte) (*Signature, error) {
+	parse := ParseDERSignature
+	if p != nil && p.LaxDER {
+		parse = ParseDERSignatureLax
+	}
+	signature, err := parse(sig)
+	if err != nil {
+		return nil, err
+	}
+	if p != nil && p.RequireLowS && signature.s.IsOverHalfOrder() {
+		str := "invalid signature: S is greater than half the group order"
+		return nil, signatureError(ErrSigHighS, str)
+	}
+	return signature, nil
+}
@@ -0,0 +1,254 @@
+package secp256k1
+
+import (
+	"errors"
+	"testing"
+)
+
+// TestPolicyVerify ensures that verifying signatures and recovering public
+// keys under a policy enforces the rules of the policy and is otherwise
+// identical to doing so without one.
+func TestPolicyVerify(t *testing.T) {
+	t.Parallel()
+
+	privKey := NewPrivateKey(hexToModNScalar("a9d2fe03b2d3e3e8c5e0e4d2e1b3a7b1c2d9e8f7a6b5c4d3e2f1a0b9c8d7e6f5"))
+	pubKey := privKey.PubKey()
+	hash := hexToBytes("c301ba9de5d6053caad9f5eb46523f007702add2c62fa39de03146a36b8026b7")
+	sig := Sign(privKey, hash)
+
+	// Create the equivalent signature with the negated S value along with the
+	// recovery code for it.
+	var highS ModNScalar
+	highS.NegateVal(&sig.s)
+	highSSig := NewSignature(&sig.r, &highS)
+	highSSig.v = sig.v ^ pubKeyRecoveryCodeOddnessBit
+
+	// Create a longer hash with the same prefix, which is valid without a
+	// policy since hashes are truncated to 32 bytes.
+	longHash := append(append([]byte(nil), hash...), 0x01)
+
+	strict := &Policy{RequireLowS: true, StrictHashLen: true}
+	tests := []struct {
+		name   string     // test description
+		policy *Policy    // policy to verify under
+		sig    *Signature // signature to verify
+		hash   []byte     // hash to verify
+		err    error      // expected error
+	}{{
+		name:   "nil policy, low S",
+		policy: nil,
+		sig:    sig,
+		hash:   hash,
+		err:    nil,
+	}, {
+		name:   "nil policy, high S",
+		policy: nil,
+		sig:    highSSig,
+		hash:   hash,
+		err:    nil,
+	}, {
+		name:   "nil policy, long hash",
+		policy: nil,
+		sig:    sig,
+		hash:   longHash,
+		err:    nil,
+	}, {
+		name:   "zero policy, high S",
+		policy: &Policy{},
+		sig:    highSSig,
+		hash:   hash,
+		err:    nil,
+	}, {
+		name:   "strict policy, low S",
+		policy: strict,
+		sig:    sig,
+		hash:   hash,
+		err:    nil,
+	}, {
+		name:   "strict policy, high S",
+		policy: strict,
+		sig:    highSSig,
+		hash:   hash,
+		err:    ErrSigHighS,
+	}, {
+		name:   "strict policy, long hash",
+		policy: strict,
+		sig:    sig,
+		hash:   longHash,
+		err:    ErrInvalidHashLen,
+	}, {
+		name:   "strict policy, short hash",
+		policy: strict,
+		sig:    sig,
+		hash:   hash[:31],
+		err:    ErrInvalidHashLen,
+	}}
+
+	for _, test := range tests {
+		err := test.policy.Verify(test.sig, test.hash, pubKey)
+		if !errors.Is(err, test.err) {
+			t.Errorf("%s: mismatched verify err -- got %v, want %v", test.name,
+				err, test.err)
+			continue
+		}
+
+		gotPubKey, err := test.policy.RecoverPublicKey(test.sig, test.hash)
+		if !errors.Is(err, test.err) {
+			t.Errorf("%s: mismatched recover err -- got %v, want %v",
+				test.name, err, test.err)
+			continue
+		}
+		if err == nil && !gotPubKey.IsEqual(pubKey) {
+			t.Errorf("%s: unexpected recovered pubkey -- got %x, want %x",
+				test.name, gotPubKey.SerializeCompressed(),
+				pubKey.SerializeCompressed())
+			continue
+		}
+	}
+}
+
+// TestPolicyParsePubKey ensures that parsing public keys under a policy
+// rejects the formats the policy disallows and is otherwise identical to
+// parsing them without one.
+func TestPolicyParsePubKey(t *testing.T) {
+	t.Parallel()
+
+	pubKey := NewPrivateKey(hexToModNScalar("a9d2fe03b2d3e3e8c5e0e4d2e1b3a7b1c2d9e8f7a6b5c4d3e2f1a0b9c8d7e6f5")).PubKey()
+	compressed := pubKey.SerializeCompressed()
+	uncompressed := pubKey.SerializeUncompressed()
+	hybrid := pubKey.SerializeUncompressed()
+	hybrid[0] = PubKeyFormatHybridEven
+	if pubKey.y.IsOdd() {
+		hybrid[0] = PubKeyFormatHybridOdd
+	}
+
+	noHybrid := &Policy{RejectHybridPubKeys: true}
+	onlyCompressed := &Policy{RejectUncompressedPubKeys: true}
+	tests := []struct {
+		name   string  // test description
+		policy *Policy // policy to parse under
+		key    []byte  // serialized public key
+		err    error   // expected error
+	}{{
+		name:   "nil policy, compressed",
+		policy: nil,
+		key:    compressed,
+	}, {
+		name:   "nil policy, uncompressed",
+		policy: nil,
+		key:    uncompressed,
+	}, {
+		name:   "nil policy, hybrid",
+		policy: nil,
+		key:    hybrid,
+	}, {
+		name:   "reject hybrid, compressed",
+		policy: noHybrid,
+		key:    compressed,
+	}, {
+		name:   "reject hybrid, uncompressed",
+		policy: noHybrid,
+		key:    uncompressed,
+	}, {
+		name:   "reject hybrid, hybrid",
+		policy: noHybrid,
+		key:    hybrid,
+		err:    ErrPubKeyInvalidFormat,
+	}, {
+		name:   "reject uncompressed, compressed",
+		policy: onlyCompressed,
+		key:    compressed,
+	}, {
+		name:   "reject uncompressed, uncompressed",
+		policy: onlyCompressed,
+		key:    uncompressed,
+		err:    ErrPubKeyInvalidFormat,
+	}, {
+		name:   "reject uncompressed, hybrid",
+		policy: onlyCompressed,
+		key:    hybrid,
+		err:    ErrPubKeyInvalidFormat,
+	}, {
+		name:   "reject uncompressed, empty",
+		policy: onlyCompressed,
+		key:    nil,
+		err:    ErrPubKeyInvalidLen,
+	}}
+
+	for _, test := range tests {
+		gotPubKey, err := test.policy.ParsePubKey(test.key)
+		if !errors.Is(err, test.err) {
+			t.Errorf("%s: mismatched err -- got %v, want %v", test.name, err,
+				test.err)
+			continue
+		}
+		if err == nil && !gotPubKey.IsEqual(pubKey) {
+			t.Errorf("%s: unexpected pubkey -- got %x, want %x", test.name,
+				gotPubKey.SerializeCompressed(), compressed)
+			continue
+		}
+	}
+}
+
+// TestPolicyParseDERSignature ensures that parsing signatures under a policy
+// selects the strict or lax parser and enforces low S values as specified by
+// the policy.
+func TestPolicyParseDERSignature(t *testing.T) {
+	t.Parallel()
+
+	const (
+		r     = "4e45e16932b8af514961a1d3a1a25fdf3f4f7732e9d624c6c61548ab5fb8cd41"
+		lowS  = "181522ec8eca07de4860a4acdd12909d831cc56cbbac4622082221a8768d1d09"
+		highS = "00e7eadd137135f821b79f5b5322ed6f6137921779f39c5a19b7b03ce459a92438"
+	)
+	strictSig := hexToBytes("3044" + "0220" + r + "0220" + lowS)
+	laxSig := hexToBytes("3046" + "02220000" + r + "0220" + lowS)
+	highSSig := hexToBytes("3045" + "0220" + r + "0221" + highS)
+
+	tests := []struct {
+		name   string  // test description
+		policy *Policy // policy to parse under
+		sig    []byte  // serialized signature
+		err    error   // expected error
+	}{{
+		name:   "nil policy, strict encoding",
+		policy: nil,
+		sig:    strictSig,
+	}, {
+		name:   "nil policy, lax encoding",
+		policy: nil,
+		sig:    laxSig,
+		err:    ErrSigTooMuchRPadding,
+	}, {
+		name:   "nil policy, high S",
+		policy: nil,
+		sig:    highSSig,
+	}, {
+		name:   "lax policy, strict encoding",
+		policy: &Policy{LaxDER: true},
+		sig:    strictSig,
+	}, {
+		name:   "lax policy, lax encoding",
+		policy: &Policy{LaxDER: true},
+		sig:    laxSig,
+	}, {
+		name:   "low S policy, high S",
+		policy: &Policy{RequireLowS: true},
+		sig:    highSSig,
+		err:    ErrSigHighS,
+	}, {
+		name:   "lax low S policy, high S",
+		policy: &Policy{LaxDER: true, RequireLowS: true},
+		sig:    highSSig,
+		err:    ErrSigHighS,
+	}}
+
+	for _, test := range tests {
+		_, err := test.policy.ParseDERSignature(test.sig)
+		if !errors.Is(err, test.err) {
+			t.Errorf("%s: mismatched err -- got %v, want %v", test.name, err,
+				test.err)
+			continue
+		}
+	}
+}
@@ -0,0 +1,14 @@
+//go:build secp256k1_precomp_signed
+
+package secp256k1
+
+// baseDigitSignBits is 1 when the pre-computed table used to accelerate scalar
+// base multiplication only houses the multiples of the base point for signed
+// digits and 0 when it houses all of the multiples for unsigned digits.
+//
+// The secp256k1_precomp_signed build tag recodes the scalars into signed digits
+// in the range [-2^(w-1)+1, 2^(w-1)] for each w-bit window, so the table only
+// needs to house the multiples for the digit magnitudes [0, 2^(w-1)] since the
+// negative digits are handled by negating the multiples on the fly.  That
+// halves the required memory at the cost of a few extra field negations.
+const baseDigitSignBits = 1
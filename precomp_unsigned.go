@@ -0,0 +1,11 @@
+//go:build !secp256k1_precomp_signed
+
+package secp256k1
+
+// baseDigitSignBits is 1 when the pre-computed table used to accelerate scalar
+// base multiplication only houses the multiples of the base point for signed
+// digits and 0 when it houses all of the multiples for unsigned digits.
+//
+// By default, the table houses all 2^w multiples for each w-bit window.  See
+// the secp256k1_precomp_signed build tag for a table half the size.
+const baseDigitSignBits = 0
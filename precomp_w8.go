@@ -0,0 +1,10 @@
+//go:build !secp256k1_precomp_w4
+
+package secp256k1
+
+// basePointWindowBits is the number of bits in each window of the pre-computed
+// table used to accelerate scalar base multiplication.
+//
+// The default 8-bit windows require the most memory, but also the fewest point
+// additions.  See the secp256k1_precomp_w4 build tag for smaller windows.
+const basePointWindowBits = 8
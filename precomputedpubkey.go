@@ -0,0 +1,275 @@
+package secp256k1
+
+import "fmt"
+
+const (
+	// precomputedPubKeyWindow is the window size used for the width-w NAF of
+	// the scalars that multiply a precomputed public key.  It is much wider
+	// than the one used when the odd multiples of the public key are computed
+	// on the fly since the cost of computing the table is only paid once.
+	precomputedPubKeyWindow = 8
+
+	// precomputedPubKeyTableSize is the number of odd multiples of the public
+	// key in each of the tables of a precomputed public key.
+	precomputedPubKeyTableSize = 1 << (precomputedPubKeyWindow - 2)
+
+	// precomputedPubKeySplitWords is the number of low words of the
+	// half-length scalars produced by the endomorphism that multiply the
+	// public key itself.  The remaining high words multiply the public key
+	// multiplied by 2^(32*precomputedPubKeySplitWords).
+	precomputedPubKeySplitWords = 2
+
+	// PrecomputedPubKeyBytesLen is the number of bytes of a serialized
+	// precomputed public key.
+	PrecomputedPubKeyBytesLen = PubKeyBytesLenCompressed + 1 +
+		2*precomputedPubKeyTableSize*64
+)
+
+// PrecomputedPublicKey houses a secp256k1 public key along with tables of
+// pre-computed odd multiples of the public key, Q, and of 2^64*Q as well as
+// their images under the endomorphism in order to accelerate repeated signature
+// verification with the same public key.
+//
+// The additional tables allow the scalar that multiplies the public key to be
+// split into quarter-length pieces which roughly halves the number of point
+// doublings needed versus a public key that is not precomputed.
+//
+// Building the tables has a cost that is comparable to a single signature
+// verification, so it is only worthwhile for public keys that are used to
+// verify many signatures.
+//
+// Schnorr signatures may be verified against a precomputed public key with the
+// VerifyPrecomputed method of the schnorr package signature type.
+//
+// A precomputed public key is not modified by any of its methods other than
+// UnmarshalBinary and therefore is safe for concurrent use once it has been
+// created.
+type PrecomputedPublicKey struct {
+	pubKey     PublicKey
+	table      [precomputedPubKeyTableSize]JacobianPoint
+	table64    [precomputedPubKeyTableSize]JacobianPoint
+	phiTable   [precomputedPubKeyTableSize]JacobianPoint
+	phiTable64 [precomputedPubKeyTableSize]JacobianPoint
+}
+
+// setPhiTables calculates the images under the endomorphism of the odd
+// multiples in the main tables.
+func (p *PrecomputedPublicKey) setPhiTables() {
+	// NOTE: φ(x,y) = (β*x,y).
+	for i := range p.table {
+		p.phiTable[i].Set(&p.table[i])
+		p.phiTable[i].X.Mul(endoBeta).Normalize()
+		p.phiTable64[i].Set(&p.table64[i])
+		p.phiTable64[i].X.Mul(endoBeta).Normalize()
+	}
+}
+
+// precomputedPubKeyBase64 calculates 2^64*Q for the passed point Q and stores
+// the result in the provided Jacobian point.
+func precomputedPubKeyBase64(point, result *JacobianPoint) {
+	result.Set(point)
+	for i := 0; i < 32*precomputedPubKeySplitWords; i++ {
+		DoubleNonConst(result, result)
+	}
+}
+
+// oddMultiplesNonConst calculates the odd multiples P, 3P, 5P, ... of the
+// passed point to fill the provided table.
+func oddMultiplesNonConst(point *JacobianPoint, table []JacobianPoint) {
+	var twoP JacobianPoint
+	table[0].Set(point)
+	DoubleNonConst(point, &twoP)
+	for i := 1; i < len(table); i++ {
+		AddNonConst(&table[i-1], &twoP, &table[i])
+	}
+}
+
+// NewPrecomputedPublicKey returns a new precomputed public key for the passed
+// public key.
+func NewPrecomputedPublicKey(pubKey *PublicKey) *PrecomputedPublicKey {
+	var p PrecomputedPublicKey
+	p.pubKey = *pubKey
+
+	// Compute the odd multiples Q, 3Q, 5Q, ..., (2^(w-1)-1)Q of the public key
+	// as well as those of 2^64*Q and convert them all to affine coordinates
+	// with a single inversion.
+	var q, q64 JacobianPoint
+	var tables [2 * precomputedPubKeyTableSize]JacobianPoint
+	pubKey.AsJacobian(&q)
+	precomputedPubKeyBase64(&q, &q64)
+	oddMultiplesNonConst(&q, tables[:precomputedPubKeyTableSize])
+	oddMultiplesNonConst(&q64, tables[precomputedPubKeyTableSize:])
+	batchToAffineNonConst(tables[:])
+	copy(p.table[:], tables[:precomputedPubKeyTableSize])
+	copy(p.table64[:], tables[precomputedPubKeyTableSize:])
+	p.setPhiTables()
+
+	return &p
+}
+
+// PublicKey returns the public key the precomputed public key was created
+// from.
+func (p *PrecomputedPublicKey) PublicKey() *PublicKey {
+	pubKey := p.pubKey
+	return &pubKey
+}
+
+// DoubleScalarMultNonConst multiplies u1*G + u2*Q where u1 and u2 are scalars
+// modulo the curve order, G is the base point of the group, and Q is the public
+// key and stores the result in the provided Jacobian point.
+//
+// NOTE: The resulting point will be normalized.
+func (p *PrecomputedPublicKey) DoubleScalarMultNonConst(u1, u2 *ModNScalar, result *JacobianPoint) {
+	// Decompose u2 via the endomorphism and further split the resulting
+	// half-length scalars into their low 64 bits and remaining high bits such
+	// that:
+	//
+	// u2*Q = k1Lo*Q + k1Hi*(2^64*Q) + k2Lo*φ(Q) + k2Hi*(2^64*φ(Q))
+	//
+	// Similarly, u1 is split into four 64-bit parts that multiply multiples of
+	// the base point which are already available.  All eight multiplications
+	// are then performed simultaneously which means only around 64 point
+	// doublings are needed in total.
+	var terms [8]wnafTerm
+	k1, k2, k1Neg, k2Neg := splitKPositive(u2)
+	k1Lo, k1Hi := splitWords(&k1, precomputedPubKeySplitWords)
+	k2Lo, k2Hi := splitWords(&k2, precomputedPubKeySplitWords)
+	terms[0].digits, terms[0].numDigits = wnaf(&k1Lo, precomputedPubKeyWindow)
+	terms[1].digits, terms[1].numDigits = wnaf(&k1Hi, precomputedPubKeyWindow)
+	terms[2].digits, terms[2].numDigits = wnaf(&k2Lo, precomputedPubKeyWindow)
+	terms[3].digits, terms[3].numDigits = wnaf(&k2Hi, precomputedPubKeyWindow)
+	terms[0].table, terms[0].oddOnly, terms[0].negate = p.table[:], true, k1Neg
+	terms[1].table, terms[1].oddOnly, terms[1].negate = p.table64[:], true, k1Neg
+	terms[2].table, terms[2].oddOnly, terms[2].negate = p.phiTable[:], true, k2Neg
+	terms[3].table, terms[3].oddOnly, terms[3].negate = p.phiTable64[:], true, k2Neg
+	setBaseTerms(u1, terms[4:])
+	interleavedMultNonConst(terms[:], result)
+}
+
+// Verify returns whether or not the ECDSA signature is valid for the provided
+// hash and the public key.
+//
+// This is equivalent to calling the Verify method of the signature with the
+// public key, but faster.
+func (p *PrecomputedPublicKey) Verify(sig *Signature, hash []byte) bool {
+	return sig.verify(hash, &p.pubKey, p, false) == nil
+}
+
+// MarshalBinary returns the precomputed public key serialized in the following
+// format so that it can be cached:
+//
+//	<33-byte compressed public key><1-byte window size>
+//	<2 * (2^(w-2)) * (32-byte X coordinate || 32-byte Y coordinate)>
+//
+// The coordinates are those of the odd multiples of the public key followed by
+// those of the odd multiples of 2^64 times the public key in affine
+// coordinates.
+//
+// This is part of the encoding.BinaryMarshaler interface implementation.
+func (p *PrecomputedPublicKey) MarshalBinary() ([]byte, error) {
+	b := make([]byte, 0, PrecomputedPubKeyBytesLen)
+	b = p.pubKey.AppendCompressed(b)
+	b = append(b, precomputedPubKeyWindow)
+	for _, table := range []*[precomputedPubKeyTableSize]JacobianPoint{
+		&p.table, &p.table64,
+	} {
+		for i := range table {
+			var x, y [32]byte
+			table[i].X.PutBytes(&x)
+			table[i].Y.PutBytes(&y)
+			b = append(b, x[:]...)
+			b = append(b, y[:]...)
+		}
+	}
+	return b, nil
+}
+
+// UnmarshalBinary sets the precomputed public key to the one encoded in the
+// passed data in the format described by MarshalBinary.
+//
+// Since a serialized precomputed public key is typically loaded from a cache,
+// every entry of the tables is checked against the public key in order to
+// ensure corrupted or maliciously crafted data can never cause an invalid
+// signature to verify.  The check is cheaper than building the tables.
+//
+// This is part of the encoding.BinaryUnmarshaler interface implementation.
+func (p *PrecomputedPublicKey) UnmarshalBinary(data []byte) error {
+	if len(data) != PrecomputedPubKeyBytesLen {
+		str := fmt.Sprintf("malformed precomputed public key: invalid length: "+
+			"%d", len(data))
+		return makeError(ErrPrecomputedPubKeyInvalidLen, str)
+	}
+	pubKey, err := ParsePubKey(data[:PubKeyBytesLenCompressed])
+	if err != nil {
+		return err
+	}
+	data = data[PubKeyBytesLenCompressed:]
+	if window := data[0]; window != precomputedPubKeyWindow {
+		str := fmt.Sprintf("malformed precomputed public key: unsupported "+
+			"window size %d (expected %d)", window, precomputedPubKeyWindow)
+		return makeError(ErrPrecomputedPubKeyInvalidTable, str)
+	}
+	data = data[1:]
+
+	// Parse the entries of both tables and ensure they are the expected odd
+	// multiples of the public key and 2^64 times the public key, respectively.
+	var q, q64 JacobianPoint
+	pubKey.AsJacobian(&q)
+	precomputedPubKeyBase64(&q, &q64)
+	var table, table64 [precomputedPubKeyTableSize]JacobianPoint
+	if err := parseOddMultiples(data, &q, table[:], 0); err != nil {
+		return err
+	}
+	data = data[precomputedPubKeyTableSize*64:]
+	if err := parseOddMultiples(data, &q64, table64[:], len(table)); err != nil {
+		return err
+	}
+
+	p.pubKey = *pubKey
+	p.table = table
+	p.table64 = table64
+	p.setPhiTables()
+	return nil
+}
+
+// parseOddMultiples parses the serialized affine coordinates of the odd
+// multiples P, 3P, 5P, ... of the passed point from the data into the provided
+// table and ensures every entry is the expected multiple.  The entry offset is
+// only used for error reporting.
+func parseOddMultiples(data []byte, point *JacobianPoint, table []JacobianPoint, entryOffset int) error {
+	var twoP JacobianPoint
+	DoubleNonConst(point, &twoP)
+	twoP.ToAffineNonConst()
+	for i := range table {
+		entry := &table[i]
+		overflowX := entry.X.SetByteSlice(data[:32])
+		overflowY := entry.Y.SetByteSlice(data[32:64])
+		entry.Z.SetInt(1)
+		data = data[64:]
+		if overflowX || overflowY {
+			str := fmt.Sprintf("malformed precomputed public key: entry %d "+
+				"coordinate is >= field prime", entryOffset+i)
+			return makeError(ErrPrecomputedPubKeyInvalidTable, str)
+		}
+
+		// Compare in Jacobian coordinates to avoid an inversion per entry.  An
+		// affine point (x, y) is equal to the Jacobian point (X, Y, Z) when
+		// x*Z^2 == X and y*Z^3 == Y.
+		var want JacobianPoint
+		if i == 0 {
+			want.Set(point)
+		} else {
+			AddNonConst(&table[i-1], &twoP, &want)
+		}
+		var zSquared, x, y FieldVal
+		zSquared.SquareVal(&want.Z)
+		x.Mul2(&entry.X, &zSquared).Normalize()
+		y.Mul2(&entry.Y, zSquared.Mul(&want.Z)).Normalize()
+		if !x.Equals(&want.X) || !y.Equals(&want.Y) {
+			str := fmt.Sprintf("malformed precomputed public key: entry %d "+
+				"does not match the public key", entryOffset+i)
+			return makeError(ErrPrecomputedPubKeyInvalidTable, str)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,203 @@
+package secp256k1
+
+import (
+	"bytes"
+	"errors"
+	"math/rand"
+	"testing"
+	"time"
+)
+
+// TestPrecomputedPublicKeyVerify ensures verifying ECDSA signatures with a
+// precomputed public key produces the same results as verifying them with the
+// public key directly for randomly-generated keys, hashes, and signatures.
+func TestPrecomputedPublicKeyVerify(t *testing.T) {
+	t.Parallel()
+
+	// Use a unique random seed each test instance and log it if the tests fail.
+	seed := time.Now().Unix()
+	rng := rand.New(rand.NewSource(seed))
+	defer func(t *testing.T, seed int64) {
+		if t.Failed() {
+			t.Logf("random seed: %d", seed)
+		}
+	}(t, seed)
+
+	for i := 0; i < 50; i++ {
+		// Generate a random private key and create a precomputed public key
+		// for it.
+		privKey := NewPrivateKey(randModNScalar(t, rng))
+		pubKey := privKey.PubKey()
+		precomputed := NewPrecomputedPublicKey(pubKey)
+		if !precomputed.PublicKey().IsEqual(pubKey) {
+			t.Fatalf("mismatched public key\ngot: %x\nwant: %x",
+				precomputed.PublicKey().SerializeCompressed(),
+				pubKey.SerializeCompressed())
+		}
+
+		// Ensure the double scalar multiplication matches the generic one.
+		u1, u2 := randModNScalar(t, rng), randModNScalar(t, rng)
+		var point, want, got JacobianPoint
+		pubKey.AsJacobian(&point)
+		DoubleScalarMultNonConst(u1, u2, &point, &want)
+		precomputed.DoubleScalarMultNonConst(u1, u2, &got)
+		if !isSameAffinePoint(&got, &want) {
+			t.Fatalf("wrong result for u1=%v u2=%v\ngot: (%v, %v, %v)\nwant: "+
+				"(%v, %v, %v)", u1, u2, got.X, got.Y, got.Z, want.X, want.Y,
+				want.Z)
+		}
+
+		// Generate a random hash, sign it, and ensure the signature verifies
+		// while a signature for a different hash does not.
+		var hash [32]byte
+		if _, err := rng.Read(hash[:]); err != nil {
+			t.Fatalf("failed to read random hash: %v", err)
+		}
+		sig := Sign(privKey, hash[:])
+		if !precomputed.Verify(sig, hash[:]) {
+			t.Fatalf("failed to verify signature\nsig: %x\nhash: %x\n"+
+				"public key: %x", sig.Serialize(), hash,
+				pubKey.SerializeCompressed())
+		}
+		badHash := hash
+		badHash[rng.Intn(len(badHash))] ^= 1 << rng.Intn(7)
+		if precomputed.Verify(sig, badHash[:]) {
+			t.Fatalf("verified signature for bad hash\nsig: %x\nhash: %x\n"+
+				"public key: %x", sig.Serialize(), badHash,
+				pubKey.SerializeCompressed())
+		}
+	}
+}
+
+// TestPrecomputedPublicKeySerialization ensures serializing and parsing
+// precomputed public keys works as expected including detecting malformed and
+// tampered data.
+func TestPrecomputedPublicKeySerialization(t *testing.T) {
+	pubKey := NewPrivateKey(hexToModNScalar("1")).PubKey()
+	precomputed := NewPrecomputedPublicKey(pubKey)
+	serialized, err := precomputed.MarshalBinary()
+	if err != nil {
+		t.Fatalf("unexpected marshal error: %v", err)
+	}
+	if len(serialized) != PrecomputedPubKeyBytesLen {
+		t.Fatalf("unexpected serialized len -- got %d, want %d",
+			len(serialized), PrecomputedPubKeyBytesLen)
+	}
+
+	// Ensure a round trip produces an identical precomputed public key.
+	var parsed PrecomputedPublicKey
+	if err := parsed.UnmarshalBinary(serialized); err != nil {
+		t.Fatalf("unexpected unmarshal error: %v", err)
+	}
+	if parsed != *precomputed {
+		t.Fatal("mismatched precomputed public key after round trip")
+	}
+	reserialized, _ := parsed.MarshalBinary()
+	if !bytes.Equal(reserialized, serialized) {
+		t.Fatalf("mismatched serialization\ngot: %x\nwant: %x", reserialized,
+			serialized)
+	}
+
+	// modified returns a copy of the serialized precomputed public key after
+	// applying the passed function to it.
+	modified := func(f func(b []byte) []byte) []byte {
+		b := make([]byte, len(serialized))
+		copy(b, serialized)
+		return f(b)
+	}
+	entryOffset := func(entry int) int {
+		return PubKeyBytesLenCompressed + 1 + entry*64
+	}
+
+	tests := []struct {
+		name string    // test description
+		data []byte    // serialized data to parse
+		err  ErrorKind // expected error
+	}{{
+		name: "empty",
+		data: nil,
+		err:  ErrPrecomputedPubKeyInvalidLen,
+	}, {
+		name: "truncated",
+		data: serialized[:len(serialized)-1],
+		err:  ErrPrecomputedPubKeyInvalidLen,
+	}, {
+		name: "invalid public key format",
+		data: modified(func(b []byte) []byte { b[0] = 0x05; return b }),
+		err:  ErrPubKeyInvalidFormat,
+	}, {
+		name: "unsupported window size",
+		data: modified(func(b []byte) []byte {
+			b[PubKeyBytesLenCompressed]++
+			return b
+		}),
+		err: ErrPrecomputedPubKeyInvalidTable,
+	}, {
+		name: "first entry is not the public key",
+		data: modified(func(b []byte) []byte {
+			b[entryOffset(0)+31] ^= 0x01
+			return b
+		}),
+		err: ErrPrecomputedPubKeyInvalidTable,
+	}, {
+		name: "tampered last entry of first table",
+		data: modified(func(b []byte) []byte {
+			b[entryOffset(precomputedPubKeyTableSize-1)+63] ^= 0x01
+			return b
+		}),
+		err: ErrPrecomputedPubKeyInvalidTable,
+	}, {
+		name: "first entry of second table is not 2^64 times the public key",
+		data: modified(func(b []byte) []byte {
+			b[entryOffset(precomputedPubKeyTableSize)+31] ^= 0x01
+			return b
+		}),
+		err: ErrPrecomputedPubKeyInvalidTable,
+	}, {
+		name: "tampered last entry of second table",
+		data: modified(func(b []byte) []byte {
+			b[entryOffset(2*precomputedPubKeyTableSize-1)+63] ^= 0x01
+			return b
+		}),
+		err: ErrPrecomputedPubKeyInvalidTable,
+	}, {
+		name: "swapped tables",
+		data: modified(func(b []byte) []byte {
+			first := make([]byte, precomputedPubKeyTableSize*64)
+			copy(first, b[entryOffset(0):])
+			copy(b[entryOffset(0):], b[entryOffset(precomputedPubKeyTableSize):])
+			copy(b[entryOffset(precomputedPubKeyTableSize):], first)
+			return b
+		}),
+		err: ErrPrecomputedPubKeyInvalidTable,
+	}, {
+		name: "entry coordinate overflows field prime",
+		data: modified(func(b []byte) []byte {
+			for i := entryOffset(5); i < entryOffset(5)+32; i++ {
+				b[i] = 0xff
+			}
+			return b
+		}),
+		err: ErrPrecomputedPubKeyInvalidTable,
+	}, {
+		name: "table for a different public key",
+		data: modified(func(b []byte) []byte {
+			other := NewPrivateKey(hexToModNScalar("2")).PubKey()
+			otherSerialized, _ := NewPrecomputedPublicKey(other).MarshalBinary()
+			copy(b[PubKeyBytesLenCompressed:],
+				otherSerialized[PubKeyBytesLenCompressed:])
+			return b
+		}),
+		err: ErrPrecomputedPubKeyInvalidTable,
+	}}
+
+	for _, test := range tests {
+		var p PrecomputedPublicKey
+		err := p.UnmarshalBinary(test.data)
+		if !errors.Is(err, test.err) {
+			t.Errorf("%s: mismatched err -- got %v, want %v", test.name, err,
+				test.err)
+			continue
+		}
+	}
+}
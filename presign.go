@@ -0,0 +1,221 @@
+package secp256k1
+
+import (
+	cryptorand "crypto/rand"
+	"io"
+	"sync/atomic"
+)
+
+// Presignature houses the precomputed nonce-dependent values of an ECDSA
+// signature, namely the modular inverse of a random nonce k along with the R
+// value and public key recovery code produced by kG, so that signing with it
+// only requires a couple of scalar multiplications.
+//
+// A presignature may only be used to produce a single signature since signing
+// two different hashes with the same nonce reveals the private key.  This is
+// enforced by SignWithPresignature, which consumes the presignature and clears
+// its secret material so that any attempt to use it again fails.  Unlike the
+// deterministic RFC6979 nonces used by Sign, the nonces are generated purely
+// from the provided source of randomness, so it must be cryptographically
+// secure.  Presignatures must also be kept as secret as the private key they
+// are used with since knowledge of one along with the signature it produced
+// reveals the private key.
+//
+// Presignatures must not be copied.  They should only be created by the
+// generation functions and passed around via the returned pointers.
+type Presignature struct {
+	consumed           atomic.Bool
+	kinv               ModNScalar
+	r                  ModNScalar
+	pubKeyRecoveryCode byte
+}
+
+// Zero marks the presignature as consumed without using it and manually clears
+// the memory associated with it.  This can be used to discard presignatures
+// that are no longer needed.
+func (p *Presignature) Zero() {
+	if p.consumed.CompareAndSwap(false, true) {
+		p.kinv.Zero()
+		p.r.Zero()
+		p.pubKeyRecoveryCode = 0
+	}
+}
+
+// batchInverseModN finds the modular multiplicative inverses of all of the
+// passed scalars, which must not be zero, in constant time with a single
+// scalar inversion and stores them in place.
+//
+// This uses Montgomery's trick in the same manner as BatchInverse.
+func batchInverseModN(vals []ModNScalar) {
+	if len(vals) == 0 {
+		return
+	}
+
+	// prods[i] = v[0] * v[1] * ... * v[i]
+	prods := make([]ModNScalar, len(vals))
+	prods[0].Set(&vals[0])
+	for i := 1; i < len(vals); i++ {
+		prods[i].Mul2(&prods[i-1], &vals[i])
+	}
+
+	// v[i]^-1 = (v[0] * ... * v[i])^-1 * (v[0] * ... * v[i-1])
+	// (v[0] * ... * v[i-1])^-1 = (v[0] * ... * v[i])^-1 * v[i]
+	var inv, valInv ModNScalar
+	inv.InverseVal(&prods[len(vals)-1])
+	for i := len(vals) - 1; i > 0; i-- {
+		valInv.Mul2(&inv, &prods[i-1])
+		inv.Mul(&vals[i])
+		vals[i].Set(&valInv)
+	}
+	vals[0].Set(&inv)
+
+	// Clear the intermediate products since they are derived from the secret
+	// values.
+	for i := range prods {
+		prods[i].Zero()
+	}
+	inv.Zero()
+	valInv.Zero()
+}
+
+// generatePresignatureBatch generates up to the requested number of
+// presignatures with nonces read from the provided reader.  The inverses of the
+// nonces and the Z coordinates of the resulting random points are computed in
+// batches in order to amortize the cost of the inversions.
+//
+// Fewer presignatures than requested are returned in the extremely unlikely
+// event that a nonce produces an R value of zero.
+func generatePresignatureBatch(rand io.Reader, n int, ctx *Context) ([]*Presignature, error) {
+	// Generate the random nonces in the range [1, N-1] and clear them along
+	// with the random points they produce when done.
+	nonces := make([]ModNScalar, n)
+	points := make([]JacobianPoint, n)
+	defer func() {
+		for i := range nonces {
+			nonces[i].Zero()
+			points[i].X.Zero()
+			points[i].Y.Zero()
+			points[i].Z.Zero()
+		}
+	}()
+	var b32 [32]byte
+	for i := range nonces {
+		for {
+			if _, err := io.ReadFull(rand, b32[:]); err != nil {
+				zeroArray32(&b32)
+				return nil, err
+			}
+			overflow := nonces[i].SetBytes(&b32)
+			if (nonces[i].IsZeroBit() | overflow) == 0 {
+				break
+			}
+		}
+	}
+	zeroArray32(&b32)
+
+	// Compute kG for each nonce and convert the points to affine coordinates
+	// in constant time with a single field inversion.
+	zVals := make([]FieldVal, n)
+	for i := range nonces {
+		ctx.ScalarBaseMult(&nonces[i], &points[i])
+		zVals[i].Set(&points[i].Z)
+	}
+	BatchInverse(zVals)
+	var zInv2 FieldVal
+	for i := range points {
+		p := &points[i]
+		zInv2.SquareVal(&zVals[i])
+		p.X.Mul(&zInv2).Normalize()
+		p.Y.Mul(zInv2.Mul(&zVals[i])).Normalize()
+		p.Z.SetInt(1)
+		zVals[i].Zero()
+	}
+	zInv2.Zero()
+
+	// Invert all of the nonces with a single scalar inversion.
+	batchInverseModN(nonces)
+
+	presigs := make([]*Presignature, 0, n)
+	for i := range points {
+		r, pubKeyRecoveryCode := nonceRValue(&points[i])
+		if r.IsZero() {
+			continue
+		}
+		presigs = append(presigs, &Presignature{
+			kinv:               nonces[i],
+			r:                  r,
+			pubKeyRecoveryCode: pubKeyRecoveryCode,
+		})
+	}
+	return presigs, nil
+}
+
+// GeneratePresignaturesFromRand generates the requested number of
+// presignatures using the provided reader as a source of entropy for the
+// nonces.  The provided reader must be a source of cryptographically secure
+// randomness, such as [crypto/rand.Reader], since weak nonces reveal the
+// private key.
+//
+// The nonce inversions and point conversions are performed in batches, so
+// generating many presignatures at once is significantly faster per
+// presignature than generating them individually.
+//
+// A Context may be provided via the WithContext option to blind the scalar
+// multiplications involving the nonces.
+func GeneratePresignaturesFromRand(rand io.Reader, n int, opts ...Option) ([]*Presignature, error) {
+	if n <= 0 {
+		return nil, nil
+	}
+	o := applyOptions(opts)
+	presigs := make([]*Presignature, 0, n)
+	for len(presigs) < n {
+		batch, err := generatePresignatureBatch(rand, n-len(presigs), o.ctx)
+		if err != nil {
+			for _, presig := range presigs {
+				presig.Zero()
+			}
+			return nil, err
+		}
+		presigs = append(presigs, batch...)
+	}
+	return presigs, nil
+}
+
+// GeneratePresignatures generates the requested number of presignatures using
+// a cryptographically secure source of randomness for the nonces.
+//
+// See GeneratePresignaturesFromRand for more details.
+func GeneratePresignatures(n int, opts ...Option) ([]*Presignature, error) {
+	return GeneratePresignaturesFromRand(cryptorand.Reader, n, opts...)
+}
+
+// SignWithPresignature generates an ECDSA signature over the secp256k1 curve
+// for the provided hash (which should be the result of hashing a larger
+// message) using the given private key and presignature.  The produced
+// signature is canonical in accordance with BIP0062 and includes a public key
+// recovery code.
+//
+// The presignature is consumed and its secret material cleared regardless of
+// whether or not signing succeeds.  An error with the ErrPresignatureConsumed
+// kind is returned when it has already been consumed, including when it is
+// being consumed concurrently, so a presignature can never be used to sign more
+// than once.
+//
+// Signing only fails otherwise in the astronomically unlikely event the S value
+// of the signature is zero, in which case another presignature must be used.
+func SignWithPresignature(privKey *PrivateKey, hash []byte, pre *Presignature) (*Signature, error) {
+	if !pre.consumed.CompareAndSwap(false, true) {
+		str := "presignature has already been consumed"
+		return nil, signatureError(ErrPresignatureConsumed, str)
+	}
+	sig, ok := signWithNonceInverse(&privKey.Key, &pre.kinv, &pre.r,
+		pre.pubKeyRecoveryCode, hash)
+	pre.kinv.Zero()
+	pre.r.Zero()
+	pre.pubKeyRecoveryCode = 0
+	if !ok {
+		str := "invalid signature: S is 0"
+		return nil, signatureError(ErrSigSIsZero, str)
+	}
+	return sig, nil
+}
@@ -0,0 +1,124 @@
+package secp256k1
+
+import (
+	"bytes"
+	"errors"
+	"math/rand"
+	"testing"
+	"testing/iotest"
+	"time"
+)
+
+// TestSignWithPresignature ensures that signing with presignatures produces the
+// same valid signatures as signing with the nonces they were generated from,
+// that presignatures can only be used once, and that discarded presignatures
+// can't be used.
+func TestSignWithPresignature(t *testing.T) {
+	t.Parallel()
+
+	// Use a unique random seed each test instance and log it if the tests fail.
+	seed := time.Now().Unix()
+	rng := rand.New(rand.NewSource(seed))
+	defer func(t *testing.T, seed int64) {
+		if t.Failed() {
+			t.Logf("random seed: %d", seed)
+		}
+	}(t, seed)
+
+	privKey := NewPrivateKey(hexToModNScalar("a9d2fe03b2d3e3e8c5e0e4d2e1b3a7b1c2d9e8f7a6b5c4d3e2f1a0b9c8d7e6f5"))
+	pubKey := privKey.PubKey()
+
+	// Generate the presignatures from known randomness so the nonces they
+	// were generated from are known.
+	const numPresigs = 20
+	entropy := make([]byte, numPresigs*32)
+	if _, err := rng.Read(entropy); err != nil {
+		t.Fatalf("failed to read random entropy: %v", err)
+	}
+	ctx, err := NewContext()
+	if err != nil {
+		t.Fatalf("failed to create context: %v", err)
+	}
+	presigs, err := GeneratePresignaturesFromRand(bytes.NewReader(entropy),
+		numPresigs, WithContext(ctx))
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if len(presigs) != numPresigs {
+		t.Fatalf("unexpected number of presignatures -- got %d, want %d",
+			len(presigs), numPresigs)
+	}
+
+	for i, presig := range presigs {
+		hash := make([]byte, 32)
+		if _, err := rng.Read(hash); err != nil {
+			t.Fatalf("failed to read random hash: %v", err)
+		}
+
+		var nonce ModNScalar
+		nonce.SetByteSlice(entropy[i*32 : (i+1)*32])
+		wantSig, ok := sign(&privKey.Key, &nonce, hash, nil)
+		if !ok {
+			t.Fatalf("presignature %d: failed to create expected signature", i)
+		}
+
+		sig, err := SignWithPresignature(privKey, hash, presig)
+		if err != nil {
+			t.Fatalf("presignature %d: unexpected err: %v", i, err)
+		}
+		if !sig.IsEqual(wantSig) || sig.v != wantSig.v {
+			t.Fatalf("presignature %d: unexpected signature -- got %x, want %x",
+				i, sig.Serialize(), wantSig.Serialize())
+		}
+		if !sig.Verify(hash, pubKey) {
+			t.Fatalf("presignature %d: signature failed to verify", i)
+		}
+		recoveredPubKey, err := sig.RecoverPublicKey(hash)
+		if err != nil {
+			t.Fatalf("presignature %d: unexpected recover err: %v", i, err)
+		}
+		if !recoveredPubKey.IsEqual(pubKey) {
+			t.Fatalf("presignature %d: unexpected recovered pubkey", i)
+		}
+
+		// Ensure the consumed material is cleared and reuse is rejected.
+		if !presig.kinv.IsZero() || !presig.r.IsZero() {
+			t.Fatalf("presignature %d: material not cleared", i)
+		}
+		_, err = SignWithPresignature(privKey, hash, presig)
+		if !errors.Is(err, ErrPresignatureConsumed) {
+			t.Fatalf("presignature %d: mismatched err -- got %v, want %v", i,
+				err, ErrPresignatureConsumed)
+		}
+	}
+
+	// Ensure discarded presignatures are cleared and can't be used.
+	presigs, err = GeneratePresignatures(2)
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	presigs[0].Zero()
+	if !presigs[0].kinv.IsZero() || !presigs[0].r.IsZero() {
+		t.Fatal("discarded presignature material not cleared")
+	}
+	hash := make([]byte, 32)
+	_, err = SignWithPresignature(privKey, hash, presigs[0])
+	if !errors.Is(err, ErrPresignatureConsumed) {
+		t.Fatalf("mismatched err -- got %v, want %v", err,
+			ErrPresignatureConsumed)
+	}
+	sig, err := SignWithPresignature(privKey, hash, presigs[1])
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if !sig.Verify(hash, pubKey) {
+		t.Fatal("signature failed to verify")
+	}
+
+	// Ensure errors reading the randomness are returned.
+	readErr := errors.New("read failure")
+	_, err = GeneratePresignaturesFromRand(iotest.ErrReader(readErr), 1)
+	if !errors.Is(err, readErr) {
+		t.Fatalf("mismatched err -- got %v, want %v", err, readErr)
+	}
+}
@@ -87,8 +87,22 @@ func GeneratePrivateKeyFromRand(rand io.Reader) (*PrivateKey, error) {
 
 // PubKey computes and returns the public key corresponding to this private key.
 func (p *PrivateKey) PubKey() *PublicKey {
+	return p.pubKey(nil)
+}
+
+// PubKeyWithOptions computes and returns the same public key as PubKey with the
+// provided options applied.  A Context may be provided via the WithContext
+// option to blind the scalar multiplication involving the private key.
+func (p *PrivateKey) PubKeyWithOptions(opts ...Option) *PublicKey {
+	return p.pubKey(applyOptions(opts).ctx)
+}
+
+// pubKey computes and returns the public key corresponding to this private key
+// with the scalar multiplication involving the private key blinded by the
+// provided context, which may be nil.
+func (p *PrivateKey) pubKey(ctx *Context) *PublicKey {
 	var result JacobianPoint
-	ScalarBaseMultNonConst(&p.Key, &result)
+	ctx.ScalarBaseMult(&p.Key, &result)
 	result.ToAffine()
 	return NewPublicKey(&result.X, &result.Y)
 }
@@ -115,3 +129,20 @@ func (p PrivateKey) Serialize() []byte {
 	p.Key.PutBytes(&privKeyBytes)
 	return privKeyBytes[:]
 }
+
+// PutSerialized serializes the private key as a 256-bit big-endian
+// binary-encoded number into the passed byte array.
+func (p *PrivateKey) PutSerialized(b *[PrivKeyBytesLen]byte) {
+	p.Key.PutBytes(b)
+}
+
+// AppendSerialized appends the private key serialized as a 256-bit big-endian
+// binary-encoded number to the passed slice and returns the extended slice.  It
+// does not allocate when the slice has enough capacity.
+func (p *PrivateKey) AppendSerialized(dst []byte) []byte {
+	var privKeyBytes [PrivKeyBytesLen]byte
+	p.Key.PutBytes(&privKeyBytes)
+	dst = append(dst, privKeyBytes[:]...)
+	zeroArray32(&privKeyBytes)
+	return dst
+}
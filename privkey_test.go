@@ -144,6 +144,18 @@ func TestPrivKeys(t *testing.T) {
 			t.Errorf("%s unexpected serialized private key - got: %x, want: %x",
 				test.name, serializedPrivKey, privKeyBytes)
 		}
+
+		var putPrivKey [PrivKeyBytesLen]byte
+		priv.PutSerialized(&putPrivKey)
+		if !bytes.Equal(putPrivKey[:], privKeyBytes) {
+			t.Errorf("%s unexpected put private key - got: %x, want: %x",
+				test.name, putPrivKey, privKeyBytes)
+		}
+		appendedPrivKey := priv.AppendSerialized([]byte{0x01})
+		if !bytes.Equal(appendedPrivKey, append([]byte{0x01}, privKeyBytes...)) {
+			t.Errorf("%s unexpected appended private key - got: %x, want: "+
+				"01%x", test.name, appendedPrivKey, privKeyBytes)
+		}
 	}
 }
 
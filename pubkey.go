@@ -107,6 +107,19 @@ func NewPublicKey(x, y *FieldVal) *PublicKey {
 // package will not produce public keys serialized in this format.  However,
 // this function will properly parse them since they exist in the wild.
 func ParsePubKey(serialized []byte) (key *PublicKey, err error) {
+	var pubKey PublicKey
+	if err = pubKey.ParseInto(serialized); err != nil {
+		return nil, err
+	}
+	return &pubKey, nil
+}
+
+// ParseInto parses a secp256k1 public key encoded according to the format
+// specified by ANSI X9.62-1998 into the public key.  It is identical to
+// ParsePubKey except it reuses the public key instead of allocating a new one.
+//
+// The public key is not modified when an error is returned.
+func (p *PublicKey) ParseInto(serialized []byte) error {
 	var x, y FieldVal
 	switch len(serialized) {
 	case PubKeyBytesLenUncompressed:
@@ -118,18 +131,18 @@ func ParsePubKey(serialized []byte) (key *PublicKey, err error) {
 		default:
 			str := fmt.Sprintf("invalid public key: unsupported format: %x",
 				format)
-			return nil, makeError(ErrPubKeyInvalidFormat, str)
+			return makeError(ErrPubKeyInvalidFormat, str)
 		}
 
 		// Parse the x and y coordinates while ensuring that they are in the
 		// allowed range.
 		if overflow := x.SetByteSlice(serialized[1:33]); overflow {
 			str := "invalid public key: x >= field prime"
-			return nil, makeError(ErrPubKeyXTooBig, str)
+			return makeError(ErrPubKeyXTooBig, str)
 		}
 		if overflow := y.SetByteSlice(serialized[33:]); overflow {
 			str := "invalid public key: y >= field prime"
-			return nil, makeError(ErrPubKeyYTooBig, str)
+			return makeError(ErrPubKeyYTooBig, str)
 		}
 
 		// Ensure the oddness of the y coordinate matches the specified format
@@ -139,7 +152,7 @@ func ParsePubKey(serialized []byte) (key *PublicKey, err error) {
 			if y.IsOdd() != wantOddY {
 				str := fmt.Sprintf("invalid public key: y oddness does not "+
 					"match specified value of %v", wantOddY)
-				return nil, makeError(ErrPubKeyMismatchedOddness, str)
+				return makeError(ErrPubKeyMismatchedOddness, str)
 			}
 		}
 
@@ -147,7 +160,7 @@ func ParsePubKey(serialized []byte) (key *PublicKey, err error) {
 		if !isOnCurve(&x, &y) {
 			str := fmt.Sprintf("invalid public key: [%v,%v] not on secp256k1 "+
 				"curve", x, y)
-			return nil, makeError(ErrPubKeyNotOnCurve, str)
+			return makeError(ErrPubKeyNotOnCurve, str)
 		}
 
 	case PubKeyBytesLenCompressed:
@@ -158,14 +171,14 @@ func ParsePubKey(serialized []byte) (key *PublicKey, err error) {
 		default:
 			str := fmt.Sprintf("invalid public key: unsupported format: %x",
 				format)
-			return nil, makeError(ErrPubKeyInvalidFormat, str)
+			return makeError(ErrPubKeyInvalidFormat, str)
 		}
 
 		// Parse the x coordinate while ensuring that it is in the allowed
 		// range.
 		if overflow := x.SetByteSlice(serialized[1:33]); overflow {
 			str := "invalid public key: x >= field prime"
-			return nil, makeError(ErrPubKeyXTooBig, str)
+			return makeError(ErrPubKeyXTooBig, str)
 		}
 
 		// Attempt to calculate the y coordinate for the given x coordinate such
@@ -175,32 +188,57 @@ func ParsePubKey(serialized []byte) (key *PublicKey, err error) {
 		if !DecompressY(&x, wantOddY, &y) {
 			str := fmt.Sprintf("invalid public key: x coordinate %v is not on "+
 				"the secp256k1 curve", x)
-			return nil, makeError(ErrPubKeyNotOnCurve, str)
+			return makeError(ErrPubKeyNotOnCurve, str)
 		}
 		y.Normalize()
 
 	default:
 		str := fmt.Sprintf("malformed public key: invalid length: %d",
 			len(serialized))
-		return nil, makeError(ErrPubKeyInvalidLen, str)
+		return makeError(ErrPubKeyInvalidLen, str)
 	}
 
-	return NewPublicKey(&x, &y), nil
+	p.x.Set(&x)
+	p.y.Set(&y)
+	return nil
 }
 
 // SerializeUncompressed serializes a public key in the 65-byte uncompressed
 // format.
 func (p PublicKey) SerializeUncompressed() []byte {
-	// 0x04 || 32-byte x coordinate || 32-byte y coordinate
 	var b [PubKeyBytesLenUncompressed]byte
+	p.PutUncompressed(&b)
+	return b[:]
+}
+
+// PutUncompressed serializes a public key in the 65-byte uncompressed format
+// into the passed byte array.
+func (p *PublicKey) PutUncompressed(b *[PubKeyBytesLenUncompressed]byte) {
+	// 0x04 || 32-byte x coordinate || 32-byte y coordinate
 	b[0] = PubKeyFormatUncompressed
 	p.x.PutBytesUnchecked(b[1:33])
 	p.y.PutBytesUnchecked(b[33:65])
-	return b[:]
+}
+
+// AppendUncompressed appends the public key serialized in the 65-byte
+// uncompressed format to the passed slice and returns the extended slice.  It
+// does not allocate when the slice has enough capacity.
+func (p *PublicKey) AppendUncompressed(dst []byte) []byte {
+	var b [PubKeyBytesLenUncompressed]byte
+	p.PutUncompressed(&b)
+	return append(dst, b[:]...)
 }
 
 // SerializeCompressed serializes a public key in the 33-byte compressed format.
 func (p PublicKey) SerializeCompressed() []byte {
+	var b [PubKeyBytesLenCompressed]byte
+	p.PutCompressed(&b)
+	return b[:]
+}
+
+// PutCompressed serializes a public key in the 33-byte compressed format into
+// the passed byte array.
+func (p *PublicKey) PutCompressed(b *[PubKeyBytesLenCompressed]byte) {
 	// Choose the format byte depending on the oddness of the Y coordinate.
 	format := PubKeyFormatCompressedEven
 	if p.y.IsOdd() {
@@ -208,10 +246,17 @@ func (p PublicKey) SerializeCompressed() []byte {
 	}
 
 	// 0x02 or 0x03 || 32-byte x coordinate
-	var b [PubKeyBytesLenCompressed]byte
 	b[0] = format
 	p.x.PutBytesUnchecked(b[1:33])
-	return b[:]
+}
+
+// AppendCompressed appends the public key serialized in the 33-byte compressed
+// format to the passed slice and returns the extended slice.  It does not
+// allocate when the slice has enough capacity.
+func (p *PublicKey) AppendCompressed(dst []byte) []byte {
+	var b [PubKeyBytesLenCompressed]byte
+	p.PutCompressed(&b)
+	return append(dst, b[:]...)
 }
 
 // IsEqual compares this public key instance to the one passed, returning true
@@ -210,7 +210,27 @@ func TestParsePubKey(t *testing.T) {
 				test.err)
 			continue
 		}
+
+		// Ensure parsing into an existing public key produces the same result
+		// and does not modify it on failure.
+		existing := NewPublicKey(new(FieldVal).SetInt(1), new(FieldVal).SetInt(2))
+		intoErr := existing.ParseInto(pubKeyBytes)
+		if !errors.Is(intoErr, test.err) {
+			t.Errorf("%s mismatched ParseInto err -- got %v, want %v",
+				test.name, intoErr, test.err)
+			continue
+		}
 		if err != nil {
+			if !existing.x.Equals(new(FieldVal).SetInt(1)) ||
+				!existing.y.Equals(new(FieldVal).SetInt(2)) {
+
+				t.Errorf("%s: ParseInto modified public key on failure",
+					test.name)
+			}
+			continue
+		}
+		if !existing.IsEqual(pubKey) {
+			t.Errorf("%s: mismatched ParseInto public key", test.name)
 			continue
 		}
 
@@ -320,11 +340,20 @@ func TestPubKeySerialize(t *testing.T) {
 
 		// Serialize with the correct method and ensure the result matches the
 		// expected value.
-		var serialized []byte
+		var serialized, put, appended []byte
+		prefix := []byte{0x01, 0x02}
 		if test.compress {
 			serialized = pubKey.SerializeCompressed()
+			var b [PubKeyBytesLenCompressed]byte
+			pubKey.PutCompressed(&b)
+			put = b[:]
+			appended = pubKey.AppendCompressed(prefix)
 		} else {
 			serialized = pubKey.SerializeUncompressed()
+			var b [PubKeyBytesLenUncompressed]byte
+			pubKey.PutUncompressed(&b)
+			put = b[:]
+			appended = pubKey.AppendUncompressed(prefix)
 		}
 		expected := hexToBytes(test.expected)
 		if !bytes.Equal(serialized, expected) {
@@ -332,6 +361,18 @@ func TestPubKeySerialize(t *testing.T) {
 				test.name, serialized, expected)
 			continue
 		}
+
+		// Ensure the put and appended variants match as well.
+		if !bytes.Equal(put, expected) {
+			t.Errorf("%s: mismatched put public key -- got %x, want %x",
+				test.name, put, expected)
+			continue
+		}
+		if !bytes.Equal(appended, append(prefix, expected...)) {
+			t.Errorf("%s: mismatched appended public key -- got %x, want "+
+				"%x%x", test.name, appended, prefix, expected)
+			continue
+		}
 	}
 }
 
@@ -0,0 +1,209 @@
+package schnorr
+
+import (
+	"io"
+	"sort"
+
+	"github.com/KarpelesLab/secp256k1"
+	"github.com/KarpelesLab/secp256k1/internal/batch"
+)
+
+// BatchItem houses a Schnorr signature along with the hash and public key it is
+// to be verified against as a part of a batch.
+type BatchItem struct {
+	Signature *Signature
+	Hash      []byte
+	PubKey    *secp256k1.PublicKey
+}
+
+// batchEntry houses the values needed to verify a signature as a part of the
+// batch equation.
+type batchEntry struct {
+	// index is the index of the item in the batch.
+	index int
+
+	// e is the challenge of the signature, q is the public key, and r is the
+	// point R lifted from the x coordinate encoded in the signature.
+	e    secp256k1.ModNScalar
+	q, r secp256k1.JacobianPoint
+
+	// coeff is the coefficient the equation for the item is multiplied by in
+	// the batch equation.
+	coeff secp256k1.ModNScalar
+}
+
+// batchCoefficients sets the coefficients of the passed entries to random
+// values read from the passed source of randomness or, when it is nil, to
+// values derived from a hash of all of the items in the batch.  See
+// batch.Coefficients for details regarding the latter.
+func batchCoefficients(items []BatchItem, entries []batchEntry, rand io.Reader) error {
+	if rand != nil {
+		for i := range entries {
+			coeff, err := batch.RandomCoefficient(rand, i)
+			if err != nil {
+				return err
+			}
+			entries[i].coeff.SetByteSlice(coeff[:])
+		}
+		return nil
+	}
+
+	coeffs := batch.NewCoefficients()
+	var buf [32]byte
+	var pubKeyBytes [secp256k1.PubKeyBytesLenUncompressed]byte
+	for i := range entries {
+		entry := &entries[i]
+		sig := items[entry.index].Signature
+		sig.r.PutBytes(&buf)
+		coeffs.Write(buf[:])
+		sig.s.PutBytes(&buf)
+		coeffs.Write(buf[:])
+		entry.e.PutBytes(&buf)
+		coeffs.Write(buf[:])
+		items[entry.index].PubKey.PutUncompressed(&pubKeyBytes)
+		coeffs.Write(pubKeyBytes[:])
+	}
+	for i := range entries {
+		coeff := coeffs.Coefficient(i)
+		entries[i].coeff.SetByteSlice(coeff[:])
+	}
+	return nil
+}
+
+// batchEquationHolds returns whether or not the batch equation holds for the
+// passed entries.
+//
+// Each individual signature (R, s) is valid when R = s*G + e*Q.  Rather than
+// checking that for each signature, this checks the random linear combination
+// of them:
+//
+// (∑ a_i*s_i)*G + ∑ (a_i*e_i)*Q_i - ∑ a_i*R_i = ∞
+//
+// via a single multi-scalar multiplication, where a_i are the 128-bit
+// coefficients.  It only holds for invalid signatures with negligible
+// probability.
+func batchEquationHolds(items []BatchItem, entries []batchEntry) bool {
+	var sumS, tmp secp256k1.ModNScalar
+	scalars := make([]secp256k1.ModNScalar, 0, 2*len(entries))
+	points := make([]secp256k1.JacobianPoint, 0, 2*len(entries))
+	for i := range entries {
+		entry := &entries[i]
+		sumS.Add(tmp.Mul2(&entry.coeff, &items[entry.index].Signature.s))
+		scalars = append(scalars, *tmp.Mul2(&entry.coeff, &entry.e))
+		points = append(points, entry.q)
+		scalars = append(scalars, *tmp.NegateVal(&entry.coeff))
+		points = append(points, entry.r)
+	}
+
+	var result, sumSG secp256k1.JacobianPoint
+	secp256k1.MultiScalarMultNonConst(scalars, points, &result)
+	secp256k1.ScalarBaseMultNonConst(&sumS, &sumSG)
+	secp256k1.AddNonConst(&result, &sumSG, &result)
+	return result.IsInfinity()
+}
+
+// VerifyBatch verifies all of the passed Schnorr signatures against their
+// associated hashes and public keys and returns whether or not they are all
+// valid along with the indices of the items that are not valid in ascending
+// order.
+//
+// Rather than verifying each signature individually, the point R of every
+// signature is lifted from its x coordinate using the even y coordinate and
+// all of them are verified together via a single multi-scalar multiplication
+// of a linear combination with 128-bit coefficients.  When the batch contains
+// invalid signatures, it is bisected in order to identify them.  As with
+// [secp256k1.VerifyBatch], the benefit grows with the size of the batch, as
+// shown by BenchmarkVerifyBatch.
+//
+// The coefficients are not random, but rather derived from a SHA-256 hash that
+// commits to every signature, hash, and public key in the batch, so they can't
+// be predicted before all of the signatures are chosen.  This provides the
+// same security as random coefficients without requiring a source of
+// randomness.  Use VerifyBatchRand to draw the coefficients from a source of
+// randomness instead.
+//
+// Every signature is verified with its own scheme, so signatures of different
+// schemes may be verified together.  The result for every item is the same as
+// calling Verify on it.  In particular, a signature for which s*G + e*Q is the
+// point at infinity or has an odd y coordinate is not valid because the lifted
+// point R is never the point at infinity and always has an even y coordinate.
+// Items with a nil signature or public key are not valid.
+func VerifyBatch(items []BatchItem) (bool, []int) {
+	// Deriving the coefficients from the items can't fail.
+	valid, failed, _ := verifyBatch(items, nil)
+	return valid, failed
+}
+
+// VerifyBatchRand is the same as VerifyBatch except the random 128-bit
+// coefficients are read from the passed source of randomness, such as
+// crypto/rand.Reader, instead of being derived from the items.  An error is
+// returned when reading from it fails.
+//
+// The coefficients are derived from the items as with VerifyBatch when the
+// source of randomness is nil.
+func VerifyBatchRand(items []BatchItem, rand io.Reader) (bool, []int, error) {
+	return verifyBatch(items, rand)
+}
+
+// verifyBatch verifies the passed items as a batch with coefficients read from
+// the passed source of randomness or derived from the items when it is nil.
+// See VerifyBatch and VerifyBatchRand for details.
+func verifyBatch(items []BatchItem, rand io.Reader) (bool, []int, error) {
+	var failed []int
+	entries := make([]batchEntry, 0, len(items))
+	for i := range items {
+		// Steps 1 and 2 of the verification algorithm along with a check that
+		// the signature and public key are present.
+		item := &items[i]
+		sig, hash, pubKey := item.Signature, item.Hash, item.PubKey
+		if sig == nil || pubKey == nil || len(hash) != scalarSize ||
+			!pubKey.IsOnCurve() {
+
+			failed = append(failed, i)
+			continue
+		}
+
+		// Steps 5 and 6 of the verification algorithm with the scheme of the
+		// signature.
+		//
+		// e = H(r || m) (Ensure r is padded to 32 bytes)
+		// Fail if e >= n (unless the scheme reduces it)
+		var e secp256k1.ModNScalar
+		if !sig.Scheme().challenge(&sig.r, hash, &e) {
+			failed = append(failed, i)
+			continue
+		}
+
+		// Lift R from r with the even y coordinate.  The signature is not
+		// valid when there is no such point because the calculated point R
+		// would not have the x coordinate r.
+		var R secp256k1.JacobianPoint
+		R.X.Set(&sig.r)
+		if !secp256k1.DecompressY(&R.X, false, &R.Y) {
+			failed = append(failed, i)
+			continue
+		}
+		R.Y.Normalize()
+		R.Z.SetInt(1)
+
+		entries = append(entries, batchEntry{index: i, e: e, r: R})
+		pubKey.AsJacobian(&entries[len(entries)-1].q)
+	}
+
+	// Verify the entries as a batch and bisect it to identify the invalid
+	// items when the batch equation does not hold.
+	if err := batchCoefficients(items, entries, rand); err != nil {
+		return false, nil, err
+	}
+	holds := func(entries []batchEntry) bool {
+		return batchEquationHolds(items, entries)
+	}
+	verify := func(entry *batchEntry) (int, bool) {
+		item := &items[entry.index]
+		err := schnorrVerify(item.Signature, item.Hash, item.PubKey)
+		return entry.index, err == nil
+	}
+	failed = batch.Bisect(entries, holds, verify, failed)
+	sort.Ints(failed)
+	return len(failed) == 0, failed, nil
+}
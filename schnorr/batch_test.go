@@ -0,0 +1,242 @@
+package schnorr
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"math/rand"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/KarpelesLab/secp256k1"
+)
+
+// randBatchItems returns the requested number of batch items with Schnorr
+// signatures produced by keys generated from the passed random source.  Each
+// item signs the SHA-256 hash of a message that includes its index and a random
+// value, so the hashes differ per item.
+func randBatchItems(t testing.TB, rng *rand.Rand, numItems int) []BatchItem {
+	t.Helper()
+
+	items := make([]BatchItem, numItems)
+	for i := range items {
+		privKey, err := secp256k1.GeneratePrivateKeyFromRand(rng)
+		if err != nil {
+			t.Fatalf("item %d: unexpected err: %v", i, err)
+		}
+		hash := sha256.Sum256([]byte(fmt.Sprintf("batch item %d %d", i,
+			rng.Int63())))
+		sig, err := Sign(privKey, hash[:], "test")
+		if err != nil {
+			t.Fatalf("item %d: unexpected err: %v", i, err)
+		}
+		items[i] = BatchItem{Signature: sig, Hash: hash[:], PubKey: privKey.PubKey()}
+	}
+	return items
+}
+
+// TestVerifyBatch ensures that batch verification reports the same results as
+// verifying each signature individually, including the indices of the
+// signatures that are not valid, for batches of random signatures along with
+// the various types of invalid signatures that verification detects.
+func TestVerifyBatch(t *testing.T) {
+	t.Parallel()
+
+	// Use a unique random seed each test instance and log it if the tests fail.
+	seed := time.Now().Unix()
+	rng := rand.New(rand.NewSource(seed))
+	defer func(t *testing.T, seed int64) {
+		if t.Failed() {
+			t.Logf("random seed: %d", seed)
+		}
+	}(t, seed)
+
+	// errItem returns a batch item from the verification error tests that is
+	// only invalid due to the specified reason.
+	pubKeyOne := secp256k1.NewPublicKey(
+		hexToFieldVal("79be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798"),
+		hexToFieldVal("483ada7726a3c4655da4fbfc0e1108a8fd17b448a68554199c47d08ffb10d4b8"),
+	)
+	errItem := func(sigR, sigS, hash string) BatchItem {
+		sig := NewSignature(hexToFieldVal(sigR), hexToModNScalar(sigS))
+		return BatchItem{Signature: sig, Hash: hexToBytes(hash), PubKey: pubKeyOne}
+	}
+
+	// corruptSig returns a copy of the passed signature with a random bit of
+	// its s component flipped.
+	corruptSig := func(sig *Signature) *Signature {
+		badSig := *sig
+		sBytes := badSig.s.Bytes()
+		sBytes[rng.Intn(32)] ^= 1 << rng.Intn(7)
+		badSig.s.SetBytes(&sBytes)
+		return &badSig
+	}
+
+	tests := []struct {
+		name   string                        // test description
+		size   int                           // number of items in the batch
+		modify func(items []BatchItem) []int // modifies the batch
+	}{{
+		name: "empty batch",
+		size: 0,
+	}, {
+		name: "single valid signature",
+		size: 1,
+	}, {
+		name: "all valid",
+		size: 33,
+	}, {
+		name: "single invalid signature",
+		size: 1,
+		modify: func(items []BatchItem) []int {
+			items[0].Signature = corruptSig(items[0].Signature)
+			return []int{0}
+		},
+	}, {
+		name: "random invalid signatures",
+		size: 32,
+		modify: func(items []BatchItem) []int {
+			failed := []int{0, 5, 6, 19, 31}
+			for _, i := range failed {
+				items[i].Signature = corruptSig(items[i].Signature)
+			}
+			return failed
+		},
+	}, {
+		name: "all invalid",
+		size: 9,
+		modify: func(items []BatchItem) []int {
+			failed := make([]int, len(items))
+			for i := range items {
+				items[i].Signature = corruptSig(items[i].Signature)
+				failed[i] = i
+			}
+			return failed
+		},
+	}, {
+		name: "calculated R point at infinity and odd R",
+		size: 10,
+		modify: func(items []BatchItem) []int {
+			items[2] = errItem(
+				"4c68976afe187ff0167919ad181cb30f187e2af1c8233b2cbebbbe0fc97fff61",
+				"14cc9e0544dd8fe6baa7c20fd2a141d0ee60114c419377efc850a49bd5c1ed36",
+				"c301ba9de5d6053caad9f5eb46523f007702add2c62fa39de03146a36b8026b7")
+			items[7] = errItem(
+				"2c2c71f7bf3e183238b1f20d856e068dc6d37805c8b2d872d0f23d906bc95789",
+				"eb7670ca6ff95c1d5c6785bc72e0781f27c9778758317d82d3053fdbcc9c17b0",
+				"ccf8c53a7631aad469d412963d495c729ff219dd2ae9a0c4de4bd1b4c777d49c")
+			return []int{2, 7}
+		},
+	}, {
+		name: "mismatched R and r not on curve",
+		size: 10,
+		modify: func(items []BatchItem) []int {
+			items[1] = errItem(
+				"4c68976afe187ff0167919ad181cb30f187e2af1c8233b2cbebbbe0fc97fff61",
+				"e9ae2d0e306497236d4e328dc1a34244045745e87da69d806859348bc2a74525",
+				"d4f9aea8c329f57a81397f0418269a8bd495957ea56ae0af0dfa886fb5977046")
+
+			// There is no point with an x coordinate of 5.
+			var r secp256k1.FieldVal
+			r.SetInt(5)
+			items[9].Signature = NewSignature(&r, &items[9].Signature.s)
+			return []int{1, 9}
+		},
+	}, {
+		name: "bad hash lengths and pubkey not on the curve",
+		size: 6,
+		modify: func(items []BatchItem) []int {
+			items[0].Hash = append(items[0].Hash, 0x00)
+			items[3].Hash = items[3].Hash[:31]
+			items[4].PubKey = secp256k1.NewPublicKey(
+				hexToFieldVal("6b17d1f2e12c4247f8bce6e563a440f277037d812deb33a0f4a13945d898c296"),
+				hexToFieldVal("4fe342e2fe1a7f9b8ee7eb4a7c0f9e162bce33576b315ececbb6406837bf51f5"),
+			)
+			return []int{0, 3, 4}
+		},
+	}, {
+		name: "pubkey not on the curve with the x coordinate of a valid one",
+		size: 8,
+		modify: func(items []BatchItem) []int {
+			var point secp256k1.JacobianPoint
+			items[5].PubKey.AsJacobian(&point)
+			point.Y.Add(new(secp256k1.FieldVal).SetInt(2)).Normalize()
+			items[5].PubKey = secp256k1.NewPublicKey(&point.X, &point.Y)
+			return []int{5}
+		},
+	}, {
+		name: "nil signature and public key",
+		size: 6,
+		modify: func(items []BatchItem) []int {
+			items[3].Signature = nil
+			items[5].PubKey = nil
+			return []int{3, 5}
+		},
+	}}
+
+	for _, test := range tests {
+		items := randBatchItems(t, rng, test.size)
+		var wantFailed []int
+		if test.modify != nil {
+			wantFailed = test.modify(items)
+		}
+
+		// Ensure the individual verification results match.
+		for i := range items {
+			item := &items[i]
+			if item.Signature == nil || item.PubKey == nil {
+				continue
+			}
+			wantValid := true
+			for _, index := range wantFailed {
+				if index == i {
+					wantValid = false
+				}
+			}
+			if item.Signature.Verify(item.Hash, item.PubKey) != wantValid {
+				t.Fatalf("%q: unexpected individual result for signature %d",
+					test.name, i)
+			}
+		}
+
+		valid, failed := VerifyBatch(items)
+		if valid != (len(wantFailed) == 0) {
+			t.Errorf("%q: unexpected result -- got %v, want %v", test.name,
+				valid, len(wantFailed) == 0)
+			continue
+		}
+		if !reflect.DeepEqual(failed, wantFailed) {
+			t.Errorf("%q: unexpected failed indices -- got %v, want %v",
+				test.name, failed, wantFailed)
+			continue
+		}
+
+		// Ensure the results are the same with random coefficients.
+		valid, failed, err := VerifyBatchRand(items, rng)
+		if err != nil {
+			t.Errorf("%q: unexpected err: %v", test.name, err)
+			continue
+		}
+		if valid != (len(wantFailed) == 0) ||
+			!reflect.DeepEqual(failed, wantFailed) {
+
+			t.Errorf("%q: unexpected random coefficient result -- got %v "+
+				"%v, want %v", test.name, valid, failed, wantFailed)
+			continue
+		}
+	}
+
+	// Ensure failures to read the random coefficients are returned.
+	errRead := errors.New("read failure")
+	failReader := readerFunc(func([]byte) (int, error) { return 0, errRead })
+	items := randBatchItems(t, rng, 2)
+	if _, _, err := VerifyBatchRand(items, failReader); !errors.Is(err, errRead) {
+		t.Fatalf("mismatched err -- got %v, want %v", err, errRead)
+	}
+}
+
+// readerFunc adapts a function to the io.Reader interface.
+type readerFunc func([]byte) (int, error)
+
+func (f readerFunc) Read(p []byte) (int, error) { return f(p) }
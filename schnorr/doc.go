@@ -36,7 +36,7 @@ Some of the advantages over ECDSA include:
     them excellent for scalability and allow them to provide some nice privacy
     characteristics
   - They support faster batch verification unlike the standardized version of
-    ECDSA signatures
+    ECDSA signatures, which is provided by VerifyBatch
 
 # Signature Scheme
 
@@ -48,6 +48,8 @@ The scheme has the following key design features:
     disambiguating the two possible y coordinates
   - Canonically encodes both components of the signature with 32-bytes each
   - Uses BLAKE-256 with 14 rounds for the hash function to calculate challenge e
+    with the default DecredV0 scheme.  Other variants may be defined via a
+    Scheme and selected with the WithScheme option
   - Uses RFC6979 to obviate the need for an entropy source at signing time
   - Produces deterministic signatures for a given message and private key pair
 
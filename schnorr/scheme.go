@@ -0,0 +1,201 @@
+package schnorr
+
+import (
+	"hash"
+	"strconv"
+	"sync"
+	"sync/atomic"
+
+	"github.com/KarpelesLab/blake256"
+	"github.com/KarpelesLab/secp256k1"
+)
+
+// Scheme defines the variant of the Schnorr signature algorithm that
+// signatures are produced and verified with.  It bundles the hash function
+// used to calculate the challenge e = H(r || m), the derivation of the extra
+// data that is fed to the RFC6979 nonce generation, and the rules for encoding
+// the challenge as a scalar.
+//
+// The signatures are otherwise identical for all schemes.  In particular, R
+// always has an even y coordinate and the signatures are always encoded as the
+// 32-byte x coordinate of R followed by the 32-byte s.
+//
+// DecredV0 is the default scheme when none is specified.  Other schemes, such
+// as EC-Schnorr variants with SHA-256, may be defined by creating a Scheme with
+// the appropriate fields and providing it to the functions of this package via
+// the WithScheme option.  A scheme must not be modified or copied once it is in
+// use.
+type Scheme struct {
+	// Name describes the scheme.  It does not need to be unique since the
+	// signatures of different schemes are separated in signature caches by
+	// the identity of their Scheme instances rather than by name.
+	Name string
+
+	// NewHash returns a new hash.Hash that calculates the challenge
+	// e = H(r || m), where r and m are each encoded as 32 bytes.  Only the
+	// first 32 bytes of the digest are used when it is longer.
+	NewHash func() hash.Hash
+
+	// NonceExtraData returns the 32 bytes of extra data that are fed to the
+	// RFC6979 nonce generation for the scheme name passed to Sign, which
+	// ensures that different signing schemes using the same key and message
+	// produce different nonces.  No extra data is used when it is nil or
+	// returns nil.
+	NonceExtraData func(scheme string) *[32]byte
+
+	// ReduceChallenge reduces challenges that are greater than or equal to the
+	// group order modulo the group order.  Signatures with such challenges
+	// are rejected, and signing tries again with a new nonce, otherwise.
+	ReduceChallenge bool
+
+	// id uniquely identifies the scheme in signature caches.  It is assigned
+	// from nextSchemeID the first time it is needed.
+	id atomic.Uint64
+}
+
+// nextSchemeID is the last identifier assigned to a scheme for use in
+// signature caches.
+var nextSchemeID atomic.Uint64
+
+// DecredV0 is the Schnorr signature scheme used by Decred, which calculates
+// the challenge with BLAKE-256 with 14 rounds, rejects challenges that are
+// greater than or equal to the group order, and uses the BLAKE-256 hash of the
+// scheme name as extra data for the nonce generation.
+//
+// It is the default scheme of all functions in this package.
+var DecredV0 = &Scheme{
+	Name:           "DecredV0",
+	NewHash:        blake256.New,
+	NonceExtraData: schemeExtraData,
+}
+
+// schemeHashes caches the BLAKE-256 hashes of scheme names used for RFC6979
+// domain separation, so repeated Sign calls with the same scheme don't rehash.
+var schemeHashes sync.Map // map[string][32]byte
+
+// schemeExtraData returns the BLAKE-256 hash of the given scheme name, using
+// a cache to avoid recomputing.
+func schemeExtraData(scheme string) *[32]byte {
+	if v, ok := schemeHashes.Load(scheme); ok {
+		h := v.([32]byte)
+		return &h
+	}
+	h := blake256.Sum256([]byte(scheme))
+	schemeHashes.Store(scheme, h)
+	return &h
+}
+
+// sigCacheScheme identifies Schnorr signatures of the DecredV0 scheme in
+// signature caches.
+const sigCacheScheme = "schnorr-blake256"
+
+// cacheScheme returns the identifier of the signatures of the scheme in
+// signature caches.  It is unique to each scheme, even those with the same
+// name, so the signatures of one scheme are never found in a cache as valid
+// for another one.
+func (s *Scheme) cacheScheme() string {
+	if s == DecredV0 {
+		return sigCacheScheme
+	}
+	id := s.id.Load()
+	if id == 0 {
+		s.id.CompareAndSwap(0, nextSchemeID.Add(1))
+		id = s.id.Load()
+	}
+	return "schnorr-scheme-" + strconv.FormatUint(id, 10)
+}
+
+// challenge calculates the challenge e = H(r || m) of the scheme for the
+// provided x coordinate of R and hash, which must be 32 bytes, and stores it
+// in the passed scalar.  It returns false when the challenge is greater than
+// or equal to the group order and the scheme does not reduce it.
+func (s *Scheme) challenge(r *secp256k1.FieldVal, hash []byte, e *secp256k1.ModNScalar) bool {
+	// Avoid the allocations of the generic hash.Hash interface for the default
+	// scheme.
+	if s == DecredV0 {
+		var commitmentInput [scalarSize * 2]byte
+		r.PutBytesUnchecked(commitmentInput[0:scalarSize])
+		copy(commitmentInput[scalarSize:], hash)
+		commitment := blake256.Sum256(commitmentInput[:])
+		return e.SetBytes(&commitment) == 0
+	}
+
+	commitmentInput := make([]byte, scalarSize*2)
+	r.PutBytesUnchecked(commitmentInput[0:scalarSize])
+	copy(commitmentInput[scalarSize:], hash)
+	h := s.NewHash()
+	h.Write(commitmentInput)
+	commitment := h.Sum(nil)
+	if len(commitment) > scalarSize {
+		commitment = commitment[:scalarSize]
+	}
+	overflow := e.SetByteSlice(commitment)
+	return !overflow || s.ReduceChallenge
+}
+
+// nonceExtraData returns the extra data of the scheme for the RFC6979 nonce
+// generation for the provided scheme name.
+func (s *Scheme) nonceExtraData(scheme string) []byte {
+	if s.NonceExtraData == nil {
+		return nil
+	}
+	extraData := s.NonceExtraData(scheme)
+	if extraData == nil {
+		return nil
+	}
+	return extraData[:]
+}
+
+// options houses the optional parameters that may be provided to the functions
+// of this package.
+type options struct {
+	ctx    *secp256k1.Context
+	scheme *Scheme
+}
+
+// Option is a functional option for the functions of this package.
+type Option func(*options)
+
+// SignOption is a functional option for Sign.
+//
+// It is an alias of Option since the options apply to all functions of this
+// package.
+type SignOption = Option
+
+// applyOptions returns the options that result from applying the passed
+// functional options to the defaults.
+func applyOptions(opts []Option) options {
+	// Avoid the allocation that results from passing the options to the
+	// functional options when there aren't any.
+	if len(opts) == 0 {
+		return options{scheme: DecredV0}
+	}
+	o := &options{scheme: DecredV0}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return *o
+}
+
+// WithContext specifies a context to blind the scalar multiplication that
+// involves the secret nonce with.  See [secp256k1.Context] for more details.
+//
+// A nil context disables blinding, which is the default.
+func WithContext(ctx *secp256k1.Context) Option {
+	return func(o *options) {
+		o.ctx = ctx
+	}
+}
+
+// WithScheme specifies the scheme to produce, parse, or verify signatures
+// with.  See [Scheme] for more details.
+//
+// A nil scheme selects DecredV0, which is the default.
+func WithScheme(scheme *Scheme) Option {
+	return func(o *options) {
+		o.scheme = scheme
+		if scheme == nil {
+			o.scheme = DecredV0
+		}
+	}
+}
@@ -0,0 +1,266 @@
+package schnorr
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/sha512"
+	"errors"
+	"hash"
+	"testing"
+
+	"github.com/KarpelesLab/secp256k1"
+)
+
+// sha256Scheme is an EC-Schnorr variant that calculates the challenge with
+// SHA-256 and uses the SHA-256 hash of the scheme name as extra data for the
+// nonce generation.
+var sha256Scheme = &Scheme{
+	Name:    "test-sha256",
+	NewHash: sha256.New,
+	NonceExtraData: func(scheme string) *[32]byte {
+		h := sha256.Sum256([]byte(scheme))
+		return &h
+	},
+}
+
+// TestSchemes ensures that signatures produced with the default scheme are
+// unchanged, that signatures produced with other schemes use the challenge hash
+// function and nonce extra data of the scheme, and that signatures are only
+// valid for the scheme they were produced with.
+func TestSchemes(t *testing.T) {
+	t.Parallel()
+
+	privKey := secp256k1.NewPrivateKey(hexToModNScalar("a9d2fe03b2d3e3e8c5e0e4d2e1b3a7b1c2d9e8f7a6b5c4d3e2f1a0b9c8d7e6f5"))
+	pubKey := privKey.PubKey()
+	hash := hexToBytes("c301ba9de5d6053caad9f5eb46523f007702add2c62fa39de03146a36b8026b7")
+	const schemeName = "EC-Schnorr-DCRv0"
+
+	// Ensure the default scheme, a nil scheme, and DecredV0 all produce the
+	// same signatures.
+	defaultSig, err := Sign(privKey, hash, schemeName)
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	for _, scheme := range []*Scheme{nil, DecredV0} {
+		sig, err := SignWithOptions(privKey, hash, schemeName,
+			WithScheme(scheme))
+		if err != nil {
+			t.Fatalf("unexpected err: %v", err)
+		}
+		if !sig.IsEqual(defaultSig) || sig.Scheme() != DecredV0 {
+			t.Fatalf("unexpected signature -- got %x, want %x",
+				sig.Serialize(), defaultSig.Serialize())
+		}
+	}
+
+	// Calculate the expected signature with the SHA-256 scheme independently.
+	//
+	// R = kG, negate k if R.y is odd, e = SHA-256(R.x || m), s = k - e*d
+	extra := sha256.Sum256([]byte(schemeName))
+	k := secp256k1.NonceRFC6979(privKey.Serialize(), hash, extra[:], nil, 0)
+	var R secp256k1.JacobianPoint
+	secp256k1.ScalarBaseMultNonConst(k, &R)
+	R.ToAffine()
+	if R.Y.IsOdd() {
+		k.Negate()
+	}
+	var commitmentInput [64]byte
+	R.X.PutBytesUnchecked(commitmentInput[:32])
+	copy(commitmentInput[32:], hash)
+	commitment := sha256.Sum256(commitmentInput[:])
+	var e secp256k1.ModNScalar
+	if overflow := e.SetBytes(&commitment); overflow != 0 {
+		t.Fatal("challenge overflows group order")
+	}
+	s := new(secp256k1.ModNScalar).Mul2(&e, &privKey.Key).Negate().Add(k)
+	wantSig := NewSignature(&R.X, s)
+
+	sig, err := SignWithOptions(privKey, hash, schemeName,
+		WithScheme(sha256Scheme))
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if !sig.IsEqual(wantSig) || sig.Scheme() != sha256Scheme {
+		t.Fatalf("unexpected signature -- got %x, want %x", sig.Serialize(),
+			wantSig.Serialize())
+	}
+	if err := sig.VerifyErr(hash, pubKey); err != nil {
+		t.Fatalf("signature failed to verify: %v", err)
+	}
+
+	// Ensure signatures only verify with the scheme they were produced with
+	// when parsed with either scheme.
+	sigBytes := sig.Serialize()
+	defaultSigBytes := defaultSig.Serialize()
+	tests := []struct {
+		name   string  // test description
+		sig    []byte  // serialized signature
+		scheme *Scheme // scheme to parse the signature with
+		valid  bool    // whether or not the signature is valid
+	}{{
+		name:   "sha256 signature with sha256 scheme",
+		sig:    sigBytes,
+		scheme: sha256Scheme,
+		valid:  true,
+	}, {
+		name:   "sha256 signature with default scheme",
+		sig:    sigBytes,
+		scheme: nil,
+		valid:  false,
+	}, {
+		name:   "default signature with default scheme",
+		sig:    defaultSigBytes,
+		scheme: nil,
+		valid:  true,
+	}, {
+		name:   "default signature with sha256 scheme",
+		sig:    defaultSigBytes,
+		scheme: sha256Scheme,
+		valid:  false,
+	}}
+
+	cache, err := secp256k1.NewSigCache(10)
+	if err != nil {
+		t.Fatalf("failed to create signature cache: %v", err)
+	}
+	for _, test := range tests {
+		parsedSig, err := ParseSignatureWithOptions(test.sig,
+			WithScheme(test.scheme))
+		if err != nil {
+			t.Errorf("%s: unexpected err: %v", test.name, err)
+			continue
+		}
+		if parsedSig.Verify(hash, pubKey) != test.valid {
+			t.Errorf("%s: unexpected verify result -- got %v, want %v",
+				test.name, !test.valid, test.valid)
+			continue
+		}
+
+		// Ensure the signature cache does not share entries between schemes
+		// by verifying each signature twice.
+		for pass := 0; pass < 2; pass++ {
+			if parsedSig.VerifyCached(hash, pubKey, cache) != test.valid {
+				t.Errorf("%s: unexpected cached verify result on pass %d",
+					test.name, pass)
+			}
+		}
+
+		valid, _ := VerifyBatch([]BatchItem{
+			{Signature: parsedSig, Hash: hash, PubKey: pubKey},
+			{Signature: defaultSig, Hash: hash, PubKey: pubKey},
+		})
+		if valid != test.valid {
+			t.Errorf("%s: unexpected batch verify result -- got %v, want %v",
+				test.name, valid, test.valid)
+			continue
+		}
+	}
+
+	// Ensure the signer produces signatures with its scheme.
+	signer := NewSigner(privKey, schemeName, WithScheme(sha256Scheme))
+	signerSig, err := signer.Sign(nil, hash, nil)
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if !bytes.Equal(signerSig, sigBytes) {
+		t.Fatalf("unexpected signer signature -- got %x, want %x", signerSig,
+			sigBytes)
+	}
+}
+
+// TestSchemeCacheIdentity ensures that signature caches separate the
+// signatures of schemes by their identity rather than their name, so a
+// signature that is valid for one scheme is not reported as valid for another
+// scheme with the same name that calculates the challenge with a different hash
+// function.
+func TestSchemeCacheIdentity(t *testing.T) {
+	t.Parallel()
+
+	privKey := secp256k1.NewPrivateKey(hexToModNScalar("a9d2fe03b2d3e3e8c5e0e4d2e1b3a7b1c2d9e8f7a6b5c4d3e2f1a0b9c8d7e6f5"))
+	pubKey := privKey.PubKey()
+	hash := hexToBytes("c301ba9de5d6053caad9f5eb46523f007702add2c62fa39de03146a36b8026b7")
+
+	for _, name := range []string{"", "test-same-name"} {
+		sha256Scheme := &Scheme{Name: name, NewHash: sha256.New}
+		sha512Scheme := &Scheme{Name: name, NewHash: sha512.New}
+
+		cache, err := secp256k1.NewSigCache(10)
+		if err != nil {
+			t.Fatalf("failed to create signature cache: %v", err)
+		}
+		sig, err := SignWithOptions(privKey, hash, "", WithScheme(sha256Scheme))
+		if err != nil {
+			t.Fatalf("%q: unexpected err: %v", name, err)
+		}
+		if !sig.VerifyCached(hash, pubKey, cache) {
+			t.Fatalf("%q: signature failed to verify", name)
+		}
+
+		otherSig, err := ParseSignatureWithOptions(sig.Serialize(),
+			WithScheme(sha512Scheme))
+		if err != nil {
+			t.Fatalf("%q: unexpected err: %v", name, err)
+		}
+		if otherSig.VerifyCached(hash, pubKey, cache) {
+			t.Fatalf("%q: signature verified for scheme with same name and "+
+				"different hash function", name)
+		}
+		if cache.Len() != 1 || cache.Hits() != 0 {
+			t.Fatalf("%q: unexpected cache state -- len %d, hits %d", name,
+				cache.Len(), cache.Hits())
+		}
+	}
+}
+
+// overflowHash is a hash.Hash that always produces a digest that is greater
+// than the group order.
+type overflowHash struct{}
+
+func (overflowHash) Write(p []byte) (int, error) { return len(p), nil }
+func (overflowHash) Reset()                      {}
+func (overflowHash) Size() int                   { return 32 }
+func (overflowHash) BlockSize() int              { return 64 }
+func (overflowHash) Sum(b []byte) []byte {
+	return append(b, bytes.Repeat([]byte{0xff}, 32)...)
+}
+
+// TestSchemeChallengeOverflow ensures that challenges that are greater than or
+// equal to the group order are rejected unless the scheme reduces them.
+func TestSchemeChallengeOverflow(t *testing.T) {
+	t.Parallel()
+
+	newHash := func() hash.Hash { return overflowHash{} }
+	rejecting := &Scheme{Name: "test-reject", NewHash: newHash}
+	reducing := &Scheme{Name: "test-reduce", NewHash: newHash,
+		ReduceChallenge: true}
+
+	privKey := secp256k1.NewPrivateKey(hexToModNScalar("a9d2fe03b2d3e3e8c5e0e4d2e1b3a7b1c2d9e8f7a6b5c4d3e2f1a0b9c8d7e6f5"))
+	pubKey := privKey.PubKey()
+	hash := hexToBytes("c301ba9de5d6053caad9f5eb46523f007702add2c62fa39de03146a36b8026b7")
+	k := hexToModNScalar("0000000000000000000000000000000000000000000000000000000000000001")
+
+	_, err := schnorrSign(&privKey.Key, k, hash, rejecting, nil)
+	if !errors.Is(err, ErrSchnorrHashValue) {
+		t.Fatalf("mismatched err -- got %v, want %v", err, ErrSchnorrHashValue)
+	}
+
+	sig, err := schnorrSign(&privKey.Key, k, hash, reducing, nil)
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if err := sig.VerifyErr(hash, pubKey); err != nil {
+		t.Fatalf("signature failed to verify: %v", err)
+	}
+
+	// Ensure the same signature is rejected with the scheme that does not
+	// reduce challenges.
+	rejectingSig, err := ParseSignatureWithOptions(sig.Serialize(),
+		WithScheme(rejecting))
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	err = rejectingSig.VerifyErr(hash, pubKey)
+	if !errors.Is(err, ErrSchnorrHashValue) {
+		t.Fatalf("mismatched err -- got %v, want %v", err, ErrSchnorrHashValue)
+	}
+}
@@ -7,9 +7,7 @@ package schnorr
 
 import (
 	"fmt"
-	"sync"
 
-	"github.com/KarpelesLab/blake256"
 	"github.com/KarpelesLab/secp256k1"
 )
 
@@ -21,36 +19,42 @@ const (
 	scalarSize = 32
 )
 
-// schemeHashes caches the BLAKE-256 hashes of scheme names used for RFC6979
-// domain separation, so repeated Sign calls with the same scheme don't rehash.
-var schemeHashes sync.Map // map[string][32]byte
-
-// schemeExtraData returns the BLAKE-256 hash of the given scheme name, using
-// a cache to avoid recomputing.
-func schemeExtraData(scheme string) []byte {
-	if v, ok := schemeHashes.Load(scheme); ok {
-		h := v.([32]byte)
-		return h[:]
-	}
-	h := blake256.Sum256([]byte(scheme))
-	schemeHashes.Store(scheme, h)
-	return h[:]
-}
-
-// Signature is a type representing a Schnorr signature.
+// Signature is a type representing a Schnorr signature along with the scheme
+// it is verified with.
 type Signature struct {
-	r secp256k1.FieldVal
-	s secp256k1.ModNScalar
+	r      secp256k1.FieldVal
+	s      secp256k1.ModNScalar
+	scheme *Scheme
 }
 
 // NewSignature instantiates a new signature given some r and s values.
+//
+// The signature is verified with the DecredV0 scheme.  See
+// NewSignatureWithOptions to specify another one.
 func NewSignature(r *secp256k1.FieldVal, s *secp256k1.ModNScalar) *Signature {
+	return NewSignatureWithOptions(r, s)
+}
+
+// NewSignatureWithOptions instantiates the same signature as NewSignature with
+// the provided options applied.  The signature is verified with the DecredV0
+// scheme unless another one is specified via the WithScheme option.
+func NewSignatureWithOptions(r *secp256k1.FieldVal, s *secp256k1.ModNScalar, opts ...Option) *Signature {
+	o := applyOptions(opts)
 	var sig Signature
 	sig.r.Set(r).Normalize()
 	sig.s.Set(s)
+	sig.scheme = o.scheme
 	return &sig
 }
 
+// Scheme returns the scheme the signature is verified with.
+func (sig *Signature) Scheme() *Scheme {
+	if sig.scheme == nil {
+		return DecredV0
+	}
+	return sig.scheme
+}
+
 // Serialize returns the Schnorr signature in the more strict format.
 //
 // The signatures are encoded as:
@@ -60,9 +64,24 @@ func NewSignature(r *secp256k1.FieldVal, s *secp256k1.ModNScalar) *Signature {
 func (sig Signature) Serialize() []byte {
 	// Total length of returned signature is the length of r and s.
 	var b [SignatureSize]byte
+	sig.PutSerialized(&b)
+	return b[:]
+}
+
+// PutSerialized serializes the Schnorr signature in the same format as
+// Serialize into the passed byte array.
+func (sig *Signature) PutSerialized(b *[SignatureSize]byte) {
 	sig.r.PutBytesUnchecked(b[0:32])
 	sig.s.PutBytesUnchecked(b[32:64])
-	return b[:]
+}
+
+// AppendSerialized appends the Schnorr signature serialized in the same format
+// as Serialize to the passed slice and returns the extended slice.  It does not
+// allocate when the slice has enough capacity.
+func (sig *Signature) AppendSerialized(dst []byte) []byte {
+	var b [SignatureSize]byte
+	sig.PutSerialized(&b)
+	return append(dst, b[:]...)
 }
 
 // ParseSignature parses a signature and enforces the following additional
@@ -70,7 +89,17 @@ func (sig Signature) Serialize() []byte {
 //
 // - The r component must be in the valid range for secp256k1 field elements
 // - The s component must be in the valid range for secp256k1 scalars
+//
+// The signature is verified with the DecredV0 scheme.  See
+// ParseSignatureWithOptions to specify another one.
 func ParseSignature(sig []byte) (*Signature, error) {
+	return ParseSignatureWithOptions(sig)
+}
+
+// ParseSignatureWithOptions parses a signature the same way as ParseSignature
+// with the provided options applied.  The signature is verified with the
+// DecredV0 scheme unless another one is specified via the WithScheme option.
+func ParseSignatureWithOptions(sig []byte, opts ...Option) (*Signature, error) {
 	// The signature must be the correct length.
 	sigLen := len(sig)
 	if sigLen < SignatureSize {
@@ -100,7 +129,7 @@ func ParseSignature(sig []byte) (*Signature, error) {
 	}
 
 	// Return the signature.
-	return NewSignature(&r, &s), nil
+	return NewSignatureWithOptions(&r, &s, opts...), nil
 }
 
 // IsEqual compares this Signature instance to the one passed, returning true
@@ -115,16 +144,25 @@ func (sig Signature) IsEqual(otherSig *Signature) bool {
 // indicating why it failed if not successful.
 //
 // This differs from the exported Verify method in that it returns a specific
-// error to support better testing while the exported method simply returns a
-// bool indicating success or failure.
+// error to support better testing and diagnostics while the exported method
+// simply returns a bool indicating success or failure.  See VerifyErr for the
+// exported version that returns the error.
 func schnorrVerify(sig *Signature, hash []byte, pubKey *secp256k1.PublicKey) error {
+	return schnorrVerifyPrecomputed(sig, hash, pubKey, nil)
+}
+
+// schnorrVerifyPrecomputed is identical to schnorrVerify except the
+// pre-computed tables of the provided precomputed public key are used to
+// accelerate the verification when it is not nil, in which case it MUST be for
+// the same public key.
+func schnorrVerifyPrecomputed(sig *Signature, hash []byte, pubKey *secp256k1.PublicKey, precomputed *secp256k1.PrecomputedPublicKey) error {
 	// The Schnorr verification algorithm is as follows:
 	//
 	// 1. Fail if m is not 32 bytes
 	// 2. Fail if Q is not a point on the curve
 	// 3. Fail if r >= p
 	// 4. Fail if s >= n
-	// 5. e = BLAKE-256(r || m) (Ensure r is padded to 32 bytes)
+	// 5. e = H(r || m) (Ensure r is padded to 32 bytes)
 	// 6. Fail if e >= n
 	// 7. R = s*G + e*Q
 	// 8. Fail if R is the point at infinity
@@ -162,17 +200,16 @@ func schnorrVerify(sig *Signature, hash []byte, pubKey *secp256k1.PublicKey) err
 
 	// Step 5.
 	//
-	// e = BLAKE-256(r || m) (Ensure r is padded to 32 bytes)
-	var commitmentInput [scalarSize * 2]byte
-	sig.r.PutBytesUnchecked(commitmentInput[0:scalarSize])
-	copy(commitmentInput[scalarSize:], hash[:])
-	commitment := blake256.Sum256(commitmentInput[:])
-
+	// e = H(r || m) (Ensure r is padded to 32 bytes)
+	//
+	// Note that H is the challenge hash function of the scheme of the
+	// signature, which is BLAKE-256 for the default DecredV0 scheme.
+	//
 	// Step 6.
 	//
-	// Fail if e >= n
+	// Fail if e >= n (unless the scheme reduces it)
 	var e secp256k1.ModNScalar
-	if overflow := e.SetBytes(&commitment); overflow != 0 {
+	if !sig.Scheme().challenge(&sig.r, hash, &e) {
 		str := "hash of (R || m) too big"
 		return signatureError(ErrSchnorrHashValue, str)
 	}
@@ -180,11 +217,14 @@ func schnorrVerify(sig *Signature, hash []byte, pubKey *secp256k1.PublicKey) err
 	// Step 7.
 	//
 	// R = s*G + e*Q
-	var Q, R, sG, eQ secp256k1.JacobianPoint
-	pubKey.AsJacobian(&Q)
-	secp256k1.ScalarBaseMultNonConst(&sig.s, &sG)
-	secp256k1.ScalarMultNonConst(&e, &Q, &eQ)
-	secp256k1.AddNonConst(&sG, &eQ, &R)
+	var R secp256k1.JacobianPoint
+	if precomputed != nil {
+		precomputed.DoubleScalarMultNonConst(&sig.s, &e, &R)
+	} else {
+		var Q secp256k1.JacobianPoint
+		pubKey.AsJacobian(&Q)
+		secp256k1.DoubleScalarMultNonConst(&sig.s, &e, &Q, &R)
+	}
 
 	// Step 8.
 	//
@@ -199,7 +239,7 @@ func schnorrVerify(sig *Signature, hash []byte, pubKey *secp256k1.PublicKey) err
 	// Fail if R.y is odd
 	//
 	// Note that R must be in affine coordinates for this check.
-	R.ToAffine()
+	R.ToAffineNonConst()
 	if R.Y.IsOdd() {
 		str := "calculated R y-value is odd"
 		return signatureError(ErrSigRYIsOdd, str)
@@ -224,6 +264,40 @@ func (sig *Signature) Verify(hash []byte, pubKey *secp256k1.PublicKey) bool {
 	return schnorrVerify(sig, hash, pubKey) == nil
 }
 
+// VerifyErr verifies the signature for the provided hash and secp256k1 public
+// key and either returns nil if it is valid or an error that indicates why it
+// is not.
+//
+// The result is the same as that of Verify, however, the returned error allows
+// the caller to determine the specific reason the signature is invalid.  The
+// error is of type Error and the specific reason may be determined with
+// errors.Is on the ErrorKind.
+func (sig *Signature) VerifyErr(hash []byte, pubKey *secp256k1.PublicKey) error {
+	return schnorrVerify(sig, hash, pubKey)
+}
+
+// VerifyPrecomputed returns whether or not the signature is valid for the
+// provided hash and secp256k1 precomputed public key.
+//
+// This is equivalent to calling Verify with the public key the precomputed
+// public key was created from, but faster.
+func (sig *Signature) VerifyPrecomputed(hash []byte, pubKey *secp256k1.PrecomputedPublicKey) bool {
+	return schnorrVerifyPrecomputed(sig, hash, pubKey.PublicKey(), pubKey) == nil
+}
+
+// VerifyCached returns whether or not the signature is valid for the provided
+// hash and secp256k1 public key by first checking the provided signature cache
+// and otherwise verifying it and adding it to the cache when it is valid.
+//
+// The result is the same as that of Verify.  See [secp256k1.SigCache] for more
+// details.
+func (sig *Signature) VerifyCached(hash []byte, pubKey *secp256k1.PublicKey, cache *secp256k1.SigCache) bool {
+	var sigBytes [SignatureSize]byte
+	sig.PutSerialized(&sigBytes)
+	return cache.VerifyFunc(sig.Scheme().cacheScheme(), sigBytes[:], hash, pubKey,
+		func() bool { return sig.Verify(hash, pubKey) })
+}
+
 // zeroArray zeroes the memory of a scalar array.
 func zeroArray(a *[scalarSize]byte) {
 	for i := 0; i < scalarSize; i++ {
@@ -233,13 +307,17 @@ func zeroArray(a *[scalarSize]byte) {
 
 // schnorrSign generates a Schnorr signature over the secp256k1 curve for the
 // provided hash (which should be the result of hashing a larger message) using
-// the given nonce and private key.  The produced signature is deterministic
-// (same message, nonce, and key yield the same signature) and canonical.
+// the given nonce, private key, and scheme.  The produced signature is
+// deterministic (same message, nonce, and key yield the same signature) and
+// canonical.
+//
+// The scalar multiplication involving the nonce is blinded with the passed
+// context when it is not nil.
 //
 // WARNING: The hash MUST be 32 bytes and both the nonce and private keys must
 // NOT be 0.  Since this is an internal use function, these preconditions MUST
 // be satisified by the caller.
-func schnorrSign(privKey, nonce *secp256k1.ModNScalar, hash []byte) (*Signature, error) {
+func schnorrSign(privKey, nonce *secp256k1.ModNScalar, hash []byte, scheme *Scheme, ctx *secp256k1.Context) (*Signature, error) {
 	// NOTE: Steps 1-3 of the signing algorithm are performed by the caller.
 	//
 	// Step 4.
@@ -247,7 +325,7 @@ func schnorrSign(privKey, nonce *secp256k1.ModNScalar, hash []byte) (*Signature,
 	// R = kG
 	var R secp256k1.JacobianPoint
 	k := *nonce
-	secp256k1.ScalarBaseMultNonConst(&k, &R)
+	ctx.ScalarBaseMult(&k, &R)
 
 	// Step 5.
 	//
@@ -266,17 +344,17 @@ func schnorrSign(privKey, nonce *secp256k1.ModNScalar, hash []byte) (*Signature,
 
 	// Step 7.
 	//
-	// e = BLAKE-256(r || m) (Ensure r is padded to 32 bytes)
-	var commitmentInput [scalarSize * 2]byte
-	r.PutBytesUnchecked(commitmentInput[0:scalarSize])
-	copy(commitmentInput[scalarSize:], hash[:])
-	commitment := blake256.Sum256(commitmentInput[:])
-
+	// e = H(r || m) (Ensure r is padded to 32 bytes)
+	//
+	// Note that H is the challenge hash function of the scheme, which is
+	// BLAKE-256 for the default DecredV0 scheme.
+	//
 	// Step 8.
 	//
-	// Repeat from step 1 (with iteration + 1) if e >= N
+	// Repeat from step 1 (with iteration + 1) if e >= N (unless the scheme
+	// reduces it)
 	var e secp256k1.ModNScalar
-	if overflow := e.SetBytes(&commitment); overflow != 0 {
+	if !scheme.challenge(r, hash, &e) {
 		k.Zero()
 		str := "hash of (R || m) too big"
 		return nil, signatureError(ErrSchnorrHashValue, str)
@@ -291,7 +369,9 @@ func schnorrSign(privKey, nonce *secp256k1.ModNScalar, hash []byte) (*Signature,
 	// Step 10.
 	//
 	// Return (r, s)
-	return NewSignature(r, s), nil
+	sig := NewSignature(r, s)
+	sig.scheme = scheme
+	return sig, nil
 }
 
 // Sign generates a Schnorr signature over the secp256k1 curve for the provided
@@ -300,17 +380,30 @@ func schnorrSign(privKey, nonce *secp256k1.ModNScalar, hash []byte) (*Signature,
 // key yield the same signature) and canonical.
 //
 // The scheme parameter is a string that identifies the signing scheme and is
-// used for RFC6979 domain separation.  It is hashed through BLAKE-256 and fed
-// as extra data to the nonce generation, ensuring that different schemes using
-// the same key and message produce different nonces.  The hash is cached so
-// repeated calls with the same scheme are efficient.
+// used for RFC6979 domain separation.  It is fed as extra data to the nonce
+// generation as derived by the NonceExtraData function of the Scheme, ensuring
+// that different schemes using the same key and message produce different
+// nonces.  The DecredV0 scheme hashes it through BLAKE-256 and caches the hash
+// so repeated calls with the same scheme are efficient.
 //
-// Note that the current signing implementation has a few remaining variable
-// time aspects which make use of the private key and the generated nonce, which
-// can expose the signer to constant time attacks.  As a result, this function
-// should not be used in situations where there is the possibility of someone
-// having EM field/cache/etc access.
+// The signature is produced with the DecredV0 scheme.  See SignWithOptions to
+// specify another one or to blind the signing operation.
 func Sign(privKey *secp256k1.PrivateKey, hash []byte, scheme string) (*Signature, error) {
+	return SignWithOptions(privKey, hash, scheme)
+}
+
+// SignWithOptions generates the same Schnorr signature as Sign with the
+// provided options applied.
+//
+// The signature is produced with the DecredV0 scheme unless another one is
+// specified via the WithScheme option.
+//
+// A context may be provided via the WithContext option to blind the scalar
+// multiplication involving the nonce.  The produced signature is the same
+// either way.
+func SignWithOptions(privKey *secp256k1.PrivateKey, hash []byte, scheme string, opts ...Option) (*Signature, error) {
+	o := applyOptions(opts)
+
 	// Step 1.
 	//
 	// Fail if m is not 32 bytes
@@ -339,10 +432,10 @@ func Sign(privKey *secp256k1.PrivateKey, hash []byte, scheme string) (*Signature
 		// parameterized by the private key, message being signed, extra data
 		// that identifies the scheme, and an iteration count
 		k := secp256k1.NonceRFC6979(privKeyBytes[:], hash,
-			schemeExtraData(scheme), nil, iteration)
+			o.scheme.nonceExtraData(scheme), nil, iteration)
 
 		// Steps 4-10.
-		sig, err := schnorrSign(privKeyScalar, k, hash)
+		sig, err := schnorrSign(privKeyScalar, k, hash, o.scheme, o.ctx)
 		k.Zero()
 		if err != nil {
 			// Try again with a new nonce.
@@ -6,6 +6,8 @@ package schnorr
 
 import (
 	"encoding/hex"
+	"fmt"
+	"math/rand"
 	"testing"
 
 	"github.com/KarpelesLab/secp256k1"
@@ -113,3 +115,31 @@ func BenchmarkSigSerialize(b *testing.B) {
 		sig.Serialize()
 	}
 }
+
+// BenchmarkVerifyBatch benchmarks how long it takes to verify a batch of
+// signatures as compared to verifying them individually.
+func BenchmarkVerifyBatch(b *testing.B) {
+	rng := rand.New(rand.NewSource(1))
+	for _, numItems := range []int{8, 64, 256} {
+		items := randBatchItems(b, rng, numItems)
+		if valid, _ := VerifyBatch(items); !valid {
+			b.Fatal("batch failed to verify")
+		}
+
+		b.Run(fmt.Sprintf("batch/%d", numItems), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				VerifyBatch(items)
+			}
+		})
+		b.Run(fmt.Sprintf("individual/%d", numItems), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				for j := range items {
+					item := &items[j]
+					item.Signature.Verify(item.Hash, item.PubKey)
+				}
+			}
+		})
+	}
+}
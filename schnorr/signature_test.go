@@ -278,7 +278,7 @@ func TestSchnorrSignAndVerify(t *testing.T) {
 			privKeyBytes := hexToBytes(test.key)
 			nonceBytes := hexToBytes(test.nonce)
 			calcNonce := secp256k1.NonceRFC6979(privKeyBytes, hash,
-				schemeExtraData("EC-Schnorr-DCRv0"), nil, 0)
+				schemeExtraData("EC-Schnorr-DCRv0")[:], nil, 0)
 			calcNonceBytes := calcNonce.Bytes()
 			if !bytes.Equal(calcNonceBytes[:], nonceBytes) {
 				t.Errorf("%s: mismatched test nonce -- expected: %x, given: %x",
@@ -288,7 +288,7 @@ func TestSchnorrSignAndVerify(t *testing.T) {
 		}
 
 		// Sign the hash of the message with the given private key and nonce.
-		gotSig, err := schnorrSign(privKey, nonce, hash)
+		gotSig, err := schnorrSign(privKey, nonce, hash, DecredV0, nil)
 		if err != nil {
 			t.Errorf("%s: unexpected error when signing: %v", test.name, err)
 			continue
@@ -301,6 +301,19 @@ func TestSchnorrSignAndVerify(t *testing.T) {
 				gotSigBytes, wantSig)
 			continue
 		}
+		var putSigBytes [SignatureSize]byte
+		gotSig.PutSerialized(&putSigBytes)
+		if !bytes.Equal(putSigBytes[:], wantSig) {
+			t.Errorf("%s: unexpected put signature -- got %x, want %x",
+				test.name, putSigBytes, wantSig)
+			continue
+		}
+		appendedSigBytes := gotSig.AppendSerialized([]byte{0x01})
+		if !bytes.Equal(appendedSigBytes, append([]byte{0x01}, wantSig...)) {
+			t.Errorf("%s: unexpected appended signature -- got %x, want 01%x",
+				test.name, appendedSigBytes, wantSig)
+			continue
+		}
 
 		// Ensure the produced signature verifies as well.
 		pubKey := secp256k1.NewPrivateKey(hexToModNScalar(test.key)).PubKey()
@@ -315,7 +328,8 @@ func TestSchnorrSignAndVerify(t *testing.T) {
 // TestSchnorrSignAndVerifyRandom ensures the Schnorr signing and verification
 // work as expected for randomly-generated private keys and messages.  It also
 // ensures invalid signatures are not improperly verified by mutating the valid
-// signature and changing the message the signature covers.
+// signature and changing the message the signature covers.  Finally, it
+// ensures signing with a blinding context produces the same signatures.
 func TestSchnorrSignAndVerifyRandom(t *testing.T) {
 	// Use a unique random seed each test instance and log it if the tests fail.
 	seed := time.Now().Unix()
@@ -326,6 +340,10 @@ func TestSchnorrSignAndVerifyRandom(t *testing.T) {
 		}
 	}(t, seed)
 
+	ctx, err := secp256k1.NewContextFromRand(rng)
+	if err != nil {
+		t.Fatalf("failed to create context: %v", err)
+	}
 	for i := 0; i < 100; i++ {
 		// Generate a random private key.
 		var buf [32]byte
@@ -356,6 +374,23 @@ func TestSchnorrSignAndVerifyRandom(t *testing.T) {
 				"private key: %x\npublic key: %x", sig.Serialize(), hash,
 				privKey.Serialize(), pubKey.SerializeCompressed())
 		}
+		blindedSig, err := SignWithOptions(privKey, hash[:], "test",
+			WithContext(ctx))
+		if err != nil {
+			t.Fatalf("failed to sign with context\nprivate key: %x\nhash: %x",
+				privKey.Serialize(), hash)
+		}
+		if !blindedSig.IsEqual(sig) {
+			t.Fatalf("mismatched signature with context\ngot: %x\nwant: %x",
+				blindedSig.Serialize(), sig.Serialize())
+		}
+		precomputed := secp256k1.NewPrecomputedPublicKey(pubKey)
+		if !sig.VerifyPrecomputed(hash[:], precomputed) {
+			t.Fatalf("failed to verify signature with precomputed key\nsig: "+
+				"%x\nhash: %x\nprivate key: %x\npublic key: %x",
+				sig.Serialize(), hash, privKey.Serialize(),
+				pubKey.SerializeCompressed())
+		}
 
 		// Change a random bit in the signature and ensure the bad signature
 		// fails to verify the original message.
@@ -374,6 +409,12 @@ func TestSchnorrSignAndVerifyRandom(t *testing.T) {
 				"private key: %x\npublic key: %x", badSig.Serialize(), hash,
 				privKey.Serialize(), pubKey.SerializeCompressed())
 		}
+		if badSig.VerifyPrecomputed(hash[:], precomputed) {
+			t.Fatalf("verified bad signature with precomputed key\nsig: %x\n"+
+				"hash: %x\nprivate key: %x\npublic key: %x",
+				badSig.Serialize(), hash, privKey.Serialize(),
+				pubKey.SerializeCompressed())
+		}
 
 		// Change a random bit in the hash that was originally signed and ensure
 		// the original good signature fails to verify the new bad message.
@@ -387,6 +428,11 @@ func TestSchnorrSignAndVerifyRandom(t *testing.T) {
 				"pubkey: %x", sig.Serialize(), badHash,
 				pubKey.SerializeCompressed())
 		}
+		if sig.VerifyPrecomputed(badHash[:], precomputed) {
+			t.Fatalf("verified signature for bad hash with precomputed key\n"+
+				"sig: %x\nhash: %x\npubkey: %x", sig.Serialize(), badHash,
+				pubKey.SerializeCompressed())
+		}
 	}
 }
 
@@ -539,7 +585,7 @@ func TestVerifyErrors(t *testing.T) {
 		}
 
 		// Ensure the expected error is hit.
-		err = schnorrVerify(sig, hash, pubKey)
+		err = sig.VerifyErr(hash, pubKey)
 		if !errors.Is(err, test.err) {
 			t.Errorf("%s: mismatched err -- got %v, want %v", test.name, err,
 				test.err)
@@ -547,3 +593,58 @@ func TestVerifyErrors(t *testing.T) {
 		}
 	}
 }
+
+// TestVerifyCached ensures that verifying signatures with a signature cache
+// returns the same results as verifying them directly and that the cache
+// entries are not shared with ECDSA signatures that have the same encoding.
+func TestVerifyCached(t *testing.T) {
+	t.Parallel()
+
+	cache, err := secp256k1.NewSigCache(10)
+	if err != nil {
+		t.Fatalf("failed to create signature cache: %v", err)
+	}
+
+	rng := rand.New(rand.NewSource(1))
+	items := randBatchItems(t, rng, 2)
+	for pass := 0; pass < 2; pass++ {
+		for i := range items {
+			item := &items[i]
+			if !item.Signature.VerifyCached(item.Hash, item.PubKey, cache) {
+				t.Fatalf("failed to verify signature %d", i)
+			}
+		}
+		if items[0].Signature.VerifyCached(items[1].Hash, items[0].PubKey, cache) {
+			t.Fatal("verified signature for wrong hash")
+		}
+	}
+	if cache.Len() != 2 || cache.Hits() != 2 || cache.Misses() != 4 {
+		t.Fatalf("unexpected cache state -- len %d, hits %d, misses %d",
+			cache.Len(), cache.Hits(), cache.Misses())
+	}
+
+	// Ensure the cached signature is rejected for a public key that is not on
+	// the curve and has the same x coordinate and oddness of the y coordinate
+	// as the public key it was cached for.
+	var point secp256k1.JacobianPoint
+	items[0].PubKey.AsJacobian(&point)
+	point.Y.Add(new(secp256k1.FieldVal).SetInt(2)).Normalize()
+	offCurveKey := secp256k1.NewPublicKey(&point.X, &point.Y)
+	if offCurveKey.IsOnCurve() {
+		t.Fatal("public key with adjusted y coordinate is on the curve")
+	}
+	if items[0].Signature.VerifyCached(items[0].Hash, offCurveKey, cache) {
+		t.Fatal("verified cached signature for public key not on the curve")
+	}
+
+	// Interpret the cached Schnorr signature as an ECDSA signature and ensure
+	// it is not found in the cache.
+	var r, s secp256k1.ModNScalar
+	sigBytes := items[0].Signature.Serialize()
+	r.SetByteSlice(sigBytes[0:32])
+	s.SetByteSlice(sigBytes[32:64])
+	ecdsaSig := secp256k1.NewSignature(&r, &s)
+	if ecdsaSig.VerifyCached(items[0].Hash, items[0].PubKey, cache) {
+		t.Fatal("verified Schnorr signature as ECDSA signature")
+	}
+}
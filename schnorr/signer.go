@@ -0,0 +1,128 @@
+package schnorr
+
+import (
+	"crypto"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/KarpelesLab/secp256k1"
+	"github.com/KarpelesLab/secp256k1/internal/schnorrhook"
+)
+
+// Ensure both ECDSA and Schnorr signatures may be handled as verifiers.
+var (
+	_ secp256k1.Verifier = (*secp256k1.Signature)(nil)
+	_ secp256k1.Verifier = (*Signature)(nil)
+)
+
+func init() {
+	schnorrhook.Register(signSerialized, parseVerifier)
+}
+
+// signSerialized signs the provided hash with the given private key, scheme
+// name, and context using the DecredV0 scheme and returns the serialized
+// signature.  It is registered with the secp256k1 package to provide its
+// SignFormatSchnorr format.
+func signSerialized(privKey *secp256k1.PrivateKey, hash []byte, scheme string, ctx *secp256k1.Context) ([]byte, error) {
+	sig, err := SignWithOptions(privKey, hash, scheme, WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return sig.Serialize(), nil
+}
+
+// parseVerifier parses the provided serialized signature for the DecredV0
+// scheme and returns it as a verifier.  It is registered with the secp256k1
+// package to parse signatures with its SignFormatSchnorr format.
+func parseVerifier(sig []byte) (secp256k1.Verifier, error) {
+	signature, err := ParseSignature(sig)
+	if err != nil {
+		return nil, err
+	}
+	return signature, nil
+}
+
+// Signer produces Schnorr signatures with a private key and scheme and
+// implements the crypto.Signer interface so that it may be used with code that
+// is not aware of Schnorr signatures.
+//
+// The public key is computed once when the signer is created.
+type Signer struct {
+	privKey *secp256k1.PrivateKey
+	pubKey  *secp256k1.PublicKey
+	scheme  string
+	opts    options
+}
+
+// NewSigner returns a signer that produces Schnorr signatures with the provided
+// private key and scheme name.  See Sign for details regarding the scheme name
+// and the options.
+func NewSigner(privKey *secp256k1.PrivateKey, scheme string, opts ...Option) *Signer {
+	o := applyOptions(opts)
+	return &Signer{
+		privKey: privKey,
+		pubKey:  privKey.PubKeyWithOptions(secp256k1.WithContext(o.ctx)),
+		scheme:  scheme,
+		opts:    o,
+	}
+}
+
+// PubKey returns the public key that corresponds to the private key of the
+// signer.
+func (s *Signer) PubKey() *secp256k1.PublicKey {
+	return s.pubKey
+}
+
+// Scheme returns the scheme name the signer produces signatures for.
+func (s *Signer) Scheme() string {
+	return s.scheme
+}
+
+// Public returns the same value as PubKey, but complies with the crypto.Signer
+// interface.
+func (s *Signer) Public() crypto.PublicKey {
+	return s.pubKey
+}
+
+// Sign generates a Schnorr signature for the provided digest, which must be 32
+// bytes, and returns it serialized.  The signature is deterministic, so the
+// rand argument is not used.
+//
+// The hash function specified by opts, when set, must produce 32-byte digests.
+// When opts is a [secp256k1.SignOptions], its format must be
+// [secp256k1.SignFormatSchnorr], none of the options that only apply to ECDSA
+// signatures may be set, and its Scheme and Context override the scheme name
+// and context of the signer when they are set.
+func (s *Signer) Sign(rand io.Reader, digest []byte, opts crypto.SignerOpts) ([]byte, error) {
+	if opts != nil {
+		if hash := opts.HashFunc(); hash != 0 && hash.Size() != scalarSize {
+			return nil, fmt.Errorf("schnorr: hash function %v does not "+
+				"produce 32-byte digests", hash)
+		}
+	}
+
+	scheme, ctx := s.scheme, s.opts.ctx
+	if o, ok := opts.(*secp256k1.SignOptions); ok {
+		if o.Format != secp256k1.SignFormatSchnorr {
+			return nil, fmt.Errorf("schnorr: unsupported signature format %d",
+				o.Format)
+		}
+		if o.Hedged || o.ExtraEntropy != nil || o.LowR {
+			return nil, errors.New("schnorr: hedging, extra entropy, and " +
+				"low R values only apply to ECDSA signatures")
+		}
+		if o.Scheme != "" {
+			scheme = o.Scheme
+		}
+		if o.Context != nil {
+			ctx = o.Context
+		}
+	}
+	sig, err := SignWithOptions(s.privKey, digest, scheme,
+		WithScheme(s.opts.scheme), WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return sig.Serialize(), nil
+}
@@ -0,0 +1,166 @@
+package schnorr
+
+import (
+	"bytes"
+	"crypto"
+	"errors"
+	"testing"
+
+	"github.com/KarpelesLab/secp256k1"
+)
+
+// TestSigner ensures that the Schnorr signer and the crypto.Signer
+// implementation of secp256k1 private keys with the Schnorr format produce the
+// same signatures as Sign, that the signatures they produce along with ECDSA
+// signatures may be parsed and verified through the verifier interface, and
+// that options which can't be honored for Schnorr signatures are rejected.
+func TestSigner(t *testing.T) {
+	t.Parallel()
+
+	privKey := secp256k1.NewPrivateKey(hexToModNScalar("a9d2fe03b2d3e3e8c5e0e4d2e1b3a7b1c2d9e8f7a6b5c4d3e2f1a0b9c8d7e6f5"))
+	pubKey := privKey.PubKey()
+	hash := hexToBytes("c301ba9de5d6053caad9f5eb46523f007702add2c62fa39de03146a36b8026b7")
+	const scheme, otherScheme = "test-scheme", "other-scheme"
+
+	wantSig := func(scheme string) []byte {
+		sig, err := Sign(privKey, hash, scheme)
+		if err != nil {
+			t.Fatalf("unexpected err: %v", err)
+		}
+		return sig.Serialize()
+	}
+
+	signer := NewSigner(privKey, scheme)
+	var _ crypto.Signer = signer
+	if !signer.PubKey().IsEqual(pubKey) || signer.Public() != signer.PubKey() {
+		t.Fatal("unexpected signer public key")
+	}
+	if signer.Scheme() != scheme {
+		t.Fatalf("unexpected signer scheme -- got %q, want %q",
+			signer.Scheme(), scheme)
+	}
+
+	tests := []struct {
+		name   string                    // test description
+		signer crypto.Signer             // signer to sign with
+		opts   crypto.SignerOpts         // signing options
+		format secp256k1.SignatureFormat // format to parse the signature with
+		want   []byte                    // expected signature
+	}{{
+		name:   "schnorr signer",
+		signer: signer,
+		opts:   crypto.SHA256,
+		format: secp256k1.SignFormatSchnorr,
+		want:   wantSig(scheme),
+	}, {
+		name:   "schnorr signer with overridden scheme",
+		signer: signer,
+		opts: &secp256k1.SignOptions{
+			Format: secp256k1.SignFormatSchnorr,
+			Scheme: otherScheme,
+		},
+		format: secp256k1.SignFormatSchnorr,
+		want:   wantSig(otherScheme),
+	}, {
+		name:   "private key with schnorr format",
+		signer: privKey,
+		opts: &secp256k1.SignOptions{
+			Format: secp256k1.SignFormatSchnorr,
+			Scheme: scheme,
+		},
+		format: secp256k1.SignFormatSchnorr,
+		want:   wantSig(scheme),
+	}, {
+		name:   "private key with DER format",
+		signer: privKey,
+		opts:   &secp256k1.SignOptions{Format: secp256k1.SignFormatDER},
+		format: secp256k1.SignFormatDER,
+		want:   secp256k1.Sign(privKey, hash).Serialize(),
+	}, {
+		name:   "private key with compact format",
+		signer: privKey,
+		opts:   &secp256k1.SignOptions{Format: secp256k1.SignFormatCompact},
+		format: secp256k1.SignFormatCompact,
+		want:   secp256k1.Sign(privKey, hash).ExportCompact(true, 0),
+	}}
+
+	for _, test := range tests {
+		sig, err := test.signer.Sign(nil, hash, test.opts)
+		if err != nil {
+			t.Errorf("%s: unexpected err: %v", test.name, err)
+			continue
+		}
+		if !bytes.Equal(sig, test.want) {
+			t.Errorf("%s: unexpected signature -- got %x, want %x", test.name,
+				sig, test.want)
+			continue
+		}
+
+		verifier, err := secp256k1.ParseSignatureFormat(sig, test.format)
+		if err != nil {
+			t.Errorf("%s: unexpected parse err: %v", test.name, err)
+			continue
+		}
+		if err := verifier.VerifyErr(hash, pubKey); err != nil {
+			t.Errorf("%s: signature failed to verify: %v", test.name, err)
+			continue
+		}
+		if verifier.Verify(hash[1:], pubKey) {
+			t.Errorf("%s: signature verified for wrong hash", test.name)
+			continue
+		}
+	}
+
+	// Ensure digests of the wrong size are rejected.
+	_, err := signer.Sign(nil, hash[:31], nil)
+	if !errors.Is(err, ErrInvalidHashLen) {
+		t.Fatalf("mismatched err -- got %v, want %v", err, ErrInvalidHashLen)
+	}
+
+	// Ensure hash functions that don't produce 32-byte digests, formats other
+	// than the Schnorr format, and options that only apply to ECDSA signatures
+	// are rejected by both the signer and the private key.
+	schnorrFormat := secp256k1.SignFormatSchnorr
+	var extraEntropy [32]byte
+	invalidOpts := []struct {
+		name string            // test description
+		opts crypto.SignerOpts // signing options
+	}{{
+		name: "hash function with 64-byte digests",
+		opts: &secp256k1.SignOptions{Format: schnorrFormat, Hash: crypto.SHA512},
+	}, {
+		name: "hedged",
+		opts: &secp256k1.SignOptions{Format: schnorrFormat, Hedged: true},
+	}, {
+		name: "extra entropy",
+		opts: &secp256k1.SignOptions{
+			Format:       schnorrFormat,
+			ExtraEntropy: &extraEntropy,
+		},
+	}, {
+		name: "low R",
+		opts: &secp256k1.SignOptions{Format: schnorrFormat, LowR: true},
+	}}
+	for _, test := range invalidOpts {
+		for _, signer := range []crypto.Signer{signer, privKey} {
+			if _, err := signer.Sign(nil, hash, test.opts); err == nil {
+				t.Fatalf("%s: unexpected success for %T", test.name, signer)
+			}
+		}
+	}
+	if _, err := signer.Sign(nil, hash, crypto.SHA512); err == nil {
+		t.Fatal("unexpected success for hash function with 64-byte digests")
+	}
+	derOpts := &secp256k1.SignOptions{Format: secp256k1.SignFormatDER}
+	if _, err := signer.Sign(nil, hash, derOpts); err == nil {
+		t.Fatal("unexpected success for signer with DER format")
+	}
+
+	// Ensure malformed signatures are rejected without returning a non-nil
+	// verifier.
+	verifier, err := secp256k1.ParseSignatureFormat(nil,
+		secp256k1.SignFormatSchnorr)
+	if !errors.Is(err, ErrSigTooShort) || verifier != nil {
+		t.Fatalf("mismatched err -- got %v, want %v", err, ErrSigTooShort)
+	}
+}
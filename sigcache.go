@@ -0,0 +1,192 @@
+package secp256k1
+
+import (
+	cryptorand "crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"hash"
+	"io"
+	"sync"
+	"sync/atomic"
+)
+
+// sigCacheKey is the type of the keys of the entries in a signature cache.
+type sigCacheKey [sha256.Size]byte
+
+// SigCache is a bounded cache of signatures that are known to be valid for a
+// given hash and public key.  It is intended to avoid repeatedly verifying the
+// same signatures, which is expensive, such as when the same transactions are
+// verified as they are relayed, when they are included in a block, and again
+// when the chain is reorganized.
+//
+// Only valid signatures are added to the cache so that an attacker can't cause
+// valid signatures to be rejected nor fill the cache with invalid signatures
+// without doing the work of creating valid ones.
+//
+// The entries are keyed by a hash of the signature, hash, and public key that
+// is salted with a random value generated when the cache is created so that
+// the keys can't be predicted by an attacker.  A random entry is evicted when
+// a new entry is added and the cache is full.
+//
+// A signature cache is safe for concurrent use.  A nil signature cache is valid
+// and simply verifies the signatures without caching them.
+type SigCache struct {
+	salt       [32]byte
+	maxEntries uint
+
+	mtx     sync.RWMutex
+	entries map[sigCacheKey]struct{}
+
+	hits   atomic.Uint64
+	misses atomic.Uint64
+}
+
+// NewSigCache returns a new signature cache that holds up to the provided
+// maximum number of entries.  Every entry consumes roughly 32 bytes of memory
+// in addition to the overhead of the map that houses them.
+//
+// A maximum of zero results in a cache that never holds any entries.
+func NewSigCache(maxEntries uint) (*SigCache, error) {
+	c := &SigCache{
+		maxEntries: maxEntries,
+		entries:    make(map[sigCacheKey]struct{}),
+	}
+	if _, err := io.ReadFull(cryptorand.Reader, c.salt[:]); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// writeSigCacheData writes the length of the passed data followed by the data
+// to the passed hasher so that the boundaries of all data are unambiguous.
+func writeSigCacheData(h hash.Hash, data []byte) {
+	var length [4]byte
+	binary.BigEndian.PutUint32(length[:], uint32(len(data)))
+	h.Write(length[:])
+	h.Write(data)
+}
+
+// key returns the key for the entry associated with the passed signature
+// scheme, serialized signature, hash, and public key.
+func (c *SigCache) key(scheme string, sig, hash []byte, pubKey *PublicKey) sigCacheKey {
+	h := sha256.New()
+	h.Write(c.salt[:])
+	writeSigCacheData(h, []byte(scheme))
+	writeSigCacheData(h, sig)
+	writeSigCacheData(h, hash)
+	// Commit to both coordinates of the public key since the compressed
+	// encoding only identifies points that are on the curve.
+	var pubKeyBytes [PubKeyBytesLenUncompressed]byte
+	pubKey.PutUncompressed(&pubKeyBytes)
+	writeSigCacheData(h, pubKeyBytes[:])
+
+	var key sigCacheKey
+	h.Sum(key[:0])
+	return key
+}
+
+// VerifyFunc returns whether or not the passed signature is valid for the
+// provided hash and public key by first checking the cache and otherwise
+// calling the passed function to verify it.  The entry is added to the cache
+// when the function reports the signature is valid.
+//
+// The scheme identifies the signature scheme the signature is for and the
+// signature must be serialized in a format that uniquely identifies it within
+// that scheme.  This allows the cache to be shared among multiple signature
+// schemes.
+//
+// Most callers will want to use the VerifyCached method of the signatures
+// instead, which call this with the appropriate parameters.
+func (c *SigCache) VerifyFunc(scheme string, sig, hash []byte, pubKey *PublicKey, verify func() bool) bool {
+	if c == nil {
+		return verify()
+	}
+
+	key := c.key(scheme, sig, hash, pubKey)
+	c.mtx.RLock()
+	_, ok := c.entries[key]
+	c.mtx.RUnlock()
+	if ok {
+		c.hits.Add(1)
+		return true
+	}
+	c.misses.Add(1)
+
+	// Only add valid signatures to the cache.
+	if !verify() {
+		return false
+	}
+	c.add(key)
+	return true
+}
+
+// add adds the passed key to the cache while evicting a random entry when the
+// cache is full.
+func (c *SigCache) add(key sigCacheKey) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if c.maxEntries == 0 {
+		return
+	}
+	if _, ok := c.entries[key]; ok {
+		return
+	}
+
+	// Remove a random entry from the map when it is full.  Relying on the
+	// random starting point of Go's map iteration is sufficient here since
+	// the keys are salted and thus not under the control of an attacker.
+	if uint(len(c.entries)) >= c.maxEntries {
+		for k := range c.entries {
+			delete(c.entries, k)
+			break
+		}
+	}
+	c.entries[key] = struct{}{}
+}
+
+// Len returns the number of entries in the cache.
+func (c *SigCache) Len() int {
+	if c == nil {
+		return 0
+	}
+
+	c.mtx.RLock()
+	defer c.mtx.RUnlock()
+	return len(c.entries)
+}
+
+// Hits returns the number of times a signature was found in the cache.
+func (c *SigCache) Hits() uint64 {
+	if c == nil {
+		return 0
+	}
+	return c.hits.Load()
+}
+
+// Misses returns the number of times a signature was not found in the cache
+// and thus had to be verified.
+func (c *SigCache) Misses() uint64 {
+	if c == nil {
+		return 0
+	}
+	return c.misses.Load()
+}
+
+// sigCacheSchemeECDSA identifies ECDSA signatures in signature caches.
+const sigCacheSchemeECDSA = "ecdsa"
+
+// VerifyCached returns whether or not the signature is valid for the provided
+// hash and secp256k1 public key by first checking the provided signature cache
+// and otherwise verifying it and adding it to the cache when it is valid.
+//
+// The result is the same as that of Verify.  The recovery code of the
+// signature, if any, does not affect the result and thus is not a part of the
+// cache entry.
+func (sig *Signature) VerifyCached(hash []byte, pubKey *PublicKey, cache *SigCache) bool {
+	var sigBytes [64]byte
+	sig.r.PutBytesUnchecked(sigBytes[0:32])
+	sig.s.PutBytesUnchecked(sigBytes[32:64])
+	return cache.VerifyFunc(sigCacheSchemeECDSA, sigBytes[:], hash, pubKey,
+		func() bool { return sig.Verify(hash, pubKey) })
+}
@@ -0,0 +1,182 @@
+package secp256k1
+
+import (
+	"math/rand"
+	"sync"
+	"testing"
+	"time"
+)
+
+// TestSigCache ensures that the signature cache returns the same results as
+// verifying the signatures directly, only holds valid signatures, tracks hits
+// and misses, and never exceeds its maximum number of entries.
+func TestSigCache(t *testing.T) {
+	t.Parallel()
+
+	// Use a unique random seed each test instance and log it if the tests fail.
+	seed := time.Now().Unix()
+	rng := rand.New(rand.NewSource(seed))
+	defer func(t *testing.T, seed int64) {
+		if t.Failed() {
+			t.Logf("random seed: %d", seed)
+		}
+	}(t, seed)
+
+	const maxEntries = 10
+	cache, err := NewSigCache(maxEntries)
+	if err != nil {
+		t.Fatalf("failed to create signature cache: %v", err)
+	}
+
+	// Ensure valid signatures are added to the cache on the first
+	// verification and found in it on the second one, including when the
+	// recovery code differs.
+	items := randBatchItems(t, rng, maxEntries)
+	for i := range items {
+		item := &items[i]
+		if !item.Signature.VerifyCached(item.Hash, item.PubKey, cache) {
+			t.Fatalf("failed to verify signature %d", i)
+		}
+	}
+	if cache.Len() != maxEntries || cache.Hits() != 0 ||
+		cache.Misses() != maxEntries {
+
+		t.Fatalf("unexpected cache state -- len %d, hits %d, misses %d",
+			cache.Len(), cache.Hits(), cache.Misses())
+	}
+	for i := range items {
+		item := &items[i]
+		sig := NewSignature(&item.Signature.r, &item.Signature.s)
+		if !sig.VerifyCached(item.Hash, item.PubKey, cache) {
+			t.Fatalf("failed to verify cached signature %d", i)
+		}
+	}
+	if cache.Hits() != maxEntries || cache.Misses() != maxEntries {
+		t.Fatalf("unexpected cache counts -- hits %d, misses %d",
+			cache.Hits(), cache.Misses())
+	}
+
+	// Ensure invalid signatures and valid signatures for a different hash or
+	// public key are rejected and not added to the cache.
+	item := &items[0]
+	badSig := *item.Signature
+	badSig.s.Add(new(ModNScalar).SetInt(1))
+	if badSig.VerifyCached(item.Hash, item.PubKey, cache) {
+		t.Fatal("verified bad signature")
+	}
+	if item.Signature.VerifyCached(items[1].Hash, item.PubKey, cache) {
+		t.Fatal("verified signature for wrong hash")
+	}
+	if item.Signature.VerifyCached(item.Hash, items[1].PubKey, cache) {
+		t.Fatal("verified signature for wrong public key")
+	}
+	if badSig.VerifyCached(item.Hash, item.PubKey, cache) {
+		t.Fatal("verified bad signature after rejecting it")
+	}
+	if cache.Hits() != maxEntries || cache.Misses() != maxEntries+4 {
+		t.Fatalf("unexpected cache counts -- hits %d, misses %d",
+			cache.Hits(), cache.Misses())
+	}
+
+	// Ensure a cached signature is rejected for a public key that is not on
+	// the curve and has the same x coordinate and oddness of the y coordinate
+	// as the public key it was cached for.
+	offCurveKey := offCurvePubKey(item.PubKey)
+	if offCurveKey.IsOnCurve() {
+		t.Fatal("public key with adjusted y coordinate is on the curve")
+	}
+	if item.Signature.Verify(item.Hash, offCurveKey) {
+		t.Fatal("verified signature for public key not on the curve")
+	}
+	if item.Signature.VerifyCached(item.Hash, offCurveKey, cache) {
+		t.Fatal("verified cached signature for public key not on the curve")
+	}
+	if cache.Hits() != maxEntries || cache.Misses() != maxEntries+5 {
+		t.Fatalf("unexpected cache counts -- hits %d, misses %d",
+			cache.Hits(), cache.Misses())
+	}
+
+	// Ensure entries are evicted once the cache is full.
+	moreItems := randBatchItems(t, rng, maxEntries)
+	for i := range moreItems {
+		item := &moreItems[i]
+		if !item.Signature.VerifyCached(item.Hash, item.PubKey, cache) {
+			t.Fatalf("failed to verify signature %d", i)
+		}
+		if cache.Len() != maxEntries {
+			t.Fatalf("unexpected number of entries -- got %d, want %d",
+				cache.Len(), maxEntries)
+		}
+	}
+}
+
+// TestSigCacheNoEntries ensures that signature caches with a maximum of zero
+// entries and nil signature caches verify signatures without caching them.
+func TestSigCacheNoEntries(t *testing.T) {
+	t.Parallel()
+
+	rng := rand.New(rand.NewSource(1))
+	items := randBatchItems(t, rng, 2)
+	badSig := *items[0].Signature
+	badSig.r.Add(new(ModNScalar).SetInt(1))
+
+	zeroCache, err := NewSigCache(0)
+	if err != nil {
+		t.Fatalf("failed to create signature cache: %v", err)
+	}
+	for _, cache := range []*SigCache{zeroCache, nil} {
+		for pass := 0; pass < 2; pass++ {
+			for i := range items {
+				item := &items[i]
+				if !item.Signature.VerifyCached(item.Hash, item.PubKey, cache) {
+					t.Fatalf("failed to verify signature %d", i)
+				}
+			}
+			if badSig.VerifyCached(items[0].Hash, items[0].PubKey, cache) {
+				t.Fatal("verified bad signature")
+			}
+		}
+		if cache.Len() != 0 || cache.Hits() != 0 {
+			t.Fatalf("unexpected cache state -- len %d, hits %d",
+				cache.Len(), cache.Hits())
+		}
+	}
+}
+
+// TestSigCacheConcurrent ensures that the signature cache is safe for
+// concurrent use.
+func TestSigCacheConcurrent(t *testing.T) {
+	t.Parallel()
+
+	rng := rand.New(rand.NewSource(1))
+	items := randBatchItems(t, rng, 8)
+	cache, err := NewSigCache(uint(len(items) / 2))
+	if err != nil {
+		t.Fatalf("failed to create signature cache: %v", err)
+	}
+
+	const numGoroutines = 8
+	var wg sync.WaitGroup
+	wg.Add(numGoroutines)
+	for g := 0; g < numGoroutines; g++ {
+		go func() {
+			defer wg.Done()
+			for i := range items {
+				item := &items[i]
+				if !item.Signature.VerifyCached(item.Hash, item.PubKey, cache) {
+					t.Errorf("failed to verify signature %d", i)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if total := cache.Hits() + cache.Misses(); total != numGoroutines*uint64(len(items)) {
+		t.Fatalf("unexpected number of lookups -- got %d, want %d", total,
+			numGoroutines*len(items))
+	}
+	if cache.Len() > len(items)/2 {
+		t.Fatalf("too many entries -- got %d, want at most %d", cache.Len(),
+			len(items)/2)
+	}
+}
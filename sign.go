@@ -2,7 +2,12 @@ package secp256k1
 
 import (
 	"crypto"
+	cryptorand "crypto/rand"
+	"errors"
+	"fmt"
 	"io"
+
+	"github.com/KarpelesLab/secp256k1/internal/schnorrhook"
 )
 
 type SignatureFormat uint
@@ -10,29 +15,223 @@ type SignatureFormat uint
 const (
 	SignFormatDER SignatureFormat = iota // DER format by default
 	SignFormatCompact
+
+	// SignFormatSchnorr produces a 64-byte Schnorr signature as specified by
+	// the schnorr package instead of an ECDSA signature.  The schnorr package
+	// must be imported for it to be available.
+	SignFormatSchnorr
 )
 
+// Verifier is implemented by the ECDSA signatures of this package as well as
+// the Schnorr signatures of the schnorr package so that code which handles
+// signatures of either algorithm, such as those produced by the crypto.Signer
+// implementations, can verify them without knowing which one it has.
+type Verifier interface {
+	// Verify returns whether or not the signature is valid for the provided
+	// hash and secp256k1 public key.
+	Verify(hash []byte, pubKey *PublicKey) bool
+
+	// VerifyErr verifies the signature for the provided hash and secp256k1
+	// public key and either returns nil if it is valid or an error that
+	// indicates why it is not.
+	VerifyErr(hash []byte, pubKey *PublicKey) error
+}
+
+// schnorrSignFunc and schnorrParseFunc are the types of the functions that
+// sign with and parse Schnorr signatures.  They are registered via the
+// internal schnorrhook package by the schnorr package when it is initialized
+// since it depends on this package and thus can't be imported by it.
+type (
+	schnorrSignFunc  = func(privKey *PrivateKey, hash []byte, scheme string, ctx *Context) ([]byte, error)
+	schnorrParseFunc = func(sig []byte) (Verifier, error)
+)
+
+// errSchnorrUnavailable is returned when Schnorr signatures are requested
+// without the schnorr package being imported.
+var errSchnorrUnavailable = errors.New("secp256k1: schnorr signatures are " +
+	"not available without importing the schnorr package")
+
+// schnorrFuncs returns the functions registered by the schnorr package that
+// sign with and parse Schnorr signatures or errSchnorrUnavailable when it has
+// not been imported.
+func schnorrFuncs() (schnorrSignFunc, schnorrParseFunc, error) {
+	sign, parse := schnorrhook.Funcs()
+	signFn, ok := sign.(schnorrSignFunc)
+	if !ok {
+		return nil, nil, errSchnorrUnavailable
+	}
+	parseFn, ok := parse.(schnorrParseFunc)
+	if !ok {
+		return nil, nil, errSchnorrUnavailable
+	}
+	return signFn, parseFn, nil
+}
+
+// ParseSignatureFormat parses a signature produced by the crypto.Signer
+// implementation with the provided format and returns it as a Verifier.
+//
+// The schnorr package must be imported in order to parse signatures with
+// SignFormatSchnorr.
+func ParseSignatureFormat(sig []byte, format SignatureFormat) (Verifier, error) {
+	// Note that the signatures are only converted to the interface on success
+	// so that a nil signature is never returned as a non-nil Verifier.
+	switch format {
+	case SignFormatDER:
+		signature, err := ParseDERSignature(sig)
+		if err != nil {
+			return nil, err
+		}
+		return signature, nil
+	case SignFormatCompact:
+		// The crypto.Signer implementation produces compact signatures with
+		// the public key recovery code first and no offset, so add the offset
+		// ParseCompactSignature expects.
+		if len(sig) != CompactSigSize {
+			str := fmt.Sprintf("malformed signature: wrong size: %d != %d",
+				len(sig), CompactSigSize)
+			return nil, signatureError(ErrSigInvalidLen, str)
+		}
+		if sig[0] > 3 {
+			str := fmt.Sprintf("invalid signature: public key recovery code "+
+				"%d is not in the valid range [0, 3]", sig[0])
+			return nil, signatureError(ErrSigInvalidRecoveryCode, str)
+		}
+		var b [CompactSigSize]byte
+		copy(b[:], sig)
+		b[0] += compactSigMagicOffset
+		signature, _, err := ParseCompactSignature(b[:])
+		if err != nil {
+			return nil, err
+		}
+		return signature, nil
+	case SignFormatSchnorr:
+		_, parse, err := schnorrFuncs()
+		if err != nil {
+			return nil, err
+		}
+		return parse(sig)
+	}
+	return nil, fmt.Errorf("secp256k1: unknown signature format %d", format)
+}
+
 type SignOptions struct {
 	Format SignatureFormat
-	Hash   crypto.Hash
+
+	// Scheme identifies the Schnorr signing scheme for domain separation when
+	// Format is SignFormatSchnorr.  Signing fails when it is set with any
+	// other format.  See the Sign function of the schnorr package for details.
+	Scheme string
+
+	// Hash optionally specifies the hash function that produced the digest.
+	// When it is set, the digest must be the size of its output and the nonce
+	// is generated per RFC6979 with HMAC using it instead of HMAC-SHA256 so
+	// that the signatures match other implementations.  See [WithHash].
+	Hash crypto.Hash
+
+	// Context optionally specifies a context to blind the scalar
+	// multiplication involving the nonce with.  See [Context].
+	Context *Context
+
+	// Hedged mixes 32 bytes of randomness read from the rand argument of
+	// [PrivateKey.Sign], or crypto/rand when it is nil, into the generation of
+	// the nonce.  See [WithHedging].
+	Hedged bool
+
+	// ExtraEntropy optionally specifies 32 bytes of additional data to mix
+	// into the generation of the nonce.  See [WithExtraEntropy].
+	ExtraEntropy *[32]byte
+
+	// LowR produces a signature with a low R value, which is one byte shorter
+	// in the DER format.  See [WithLowR].
+	LowR bool
 }
 
 func (s *SignOptions) HashFunc() crypto.Hash {
 	return s.Hash
 }
 
+// checkSchnorr returns an error when the options specify a hash function or
+// options that can't be honored when producing Schnorr signatures.
+func (s *SignOptions) checkSchnorr() error {
+	if s.Hash != 0 && s.Hash.Size() != 32 {
+		return fmt.Errorf("secp256k1: hash function %v does not produce the "+
+			"32-byte digests schnorr signatures require", s.Hash)
+	}
+	if s.Hedged || s.ExtraEntropy != nil || s.LowR {
+		return errors.New("secp256k1: hedging, extra entropy, and low R " +
+			"values only apply to ECDSA signatures")
+	}
+	return nil
+}
+
 // Sign will sign the provided digest, returning the resulting signature. [SignOptions] can be used
 // to pass options. By default DER format will be used for signatures, however compact format can be
 // specified via opts.
+//
+// Schnorr signatures with the scheme name specified by [SignOptions.Scheme] are produced with
+// [SignFormatSchnorr] when the schnorr package is imported.  They are always produced with its
+// DecredV0 scheme, so use the Signer of the schnorr package to sign with other schemes.  Since the
+// nonce is generated as specified by the scheme, the hash function, when set, must produce 32-byte
+// digests, and an error is returned when any of the options that only apply to ECDSA signatures,
+// such as [SignOptions.Hedged], are set.
+//
+// The rand argument is only used when [SignOptions.Hedged] is set, in which case an error is
+// returned when reading from it fails.
+//
+// Options other than [SignOptions] only specify the hash function that produced the digest, which
+// is treated the same as [SignOptions.Hash].
 func (privkey *PrivateKey) Sign(rand io.Reader, digest []byte, opts crypto.SignerOpts) ([]byte, error) {
 	var opt *SignOptions
 	if o, ok := opts.(*SignOptions); ok {
 		opt = o
 	} else {
 		opt = &SignOptions{}
+		if opts != nil {
+			opt.Hash = opts.HashFunc()
+		}
+	}
+
+	if opt.Hash != 0 {
+		if !opt.Hash.Available() {
+			return nil, fmt.Errorf("secp256k1: hash function %v is not "+
+				"available", opt.Hash)
+		}
+		if len(digest) != opt.Hash.Size() {
+			str := fmt.Sprintf("wrong size for %v digest (got %v, want %v)",
+				opt.Hash, len(digest), opt.Hash.Size())
+			return nil, signatureError(ErrInvalidHashLen, str)
+		}
+	}
+
+	if opt.Format == SignFormatSchnorr {
+		if err := opt.checkSchnorr(); err != nil {
+			return nil, err
+		}
+		sign, _, err := schnorrFuncs()
+		if err != nil {
+			return nil, err
+		}
+		return sign(privkey, digest, opt.Scheme, opt.Context)
+	}
+	if opt.Scheme != "" {
+		return nil, errors.New("secp256k1: scheme may only be specified " +
+			"for schnorr signatures")
 	}
 
-	sig := signRFC6979(privkey, digest)
+	var hedgeRand io.Reader
+	if opt.Hedged {
+		hedgeRand = rand
+		if hedgeRand == nil {
+			hedgeRand = cryptorand.Reader
+		}
+	}
+	var buf [32]byte
+	extra, err := nonceExtraData(opt.ExtraEntropy, hedgeRand, &buf)
+	if err != nil {
+		return nil, err
+	}
+	sig := signRFC6979(privkey, digest, opt.Hash, extra, opt.LowR, opt.Context)
+	zeroArray32(&buf)
 
 	switch opt.Format {
 	case SignFormatCompact:
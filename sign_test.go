@@ -0,0 +1,357 @@
+package secp256k1
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/sha512"
+	"errors"
+	"math/rand"
+	"testing"
+	"testing/iotest"
+	"time"
+)
+
+// TestSignExtraEntropy ensures that signing with extra entropy, hedging, or
+// both mixes the expected extra data into the nonce, that the resulting
+// signatures are valid, and that SignWithOptions and the crypto.Signer
+// implementation produce the same signatures.
+func TestSignExtraEntropy(t *testing.T) {
+	t.Parallel()
+
+	privKey := NewPrivateKey(hexToModNScalar("a9d2fe03b2d3e3e8c5e0e4d2e1b3a7b1c2d9e8f7a6b5c4d3e2f1a0b9c8d7e6f5"))
+	pubKey := privKey.PubKey()
+	hash := hexToBytes("c301ba9de5d6053caad9f5eb46523f007702add2c62fa39de03146a36b8026b7")
+	entropy := [32]byte{0x01, 0x02, 0x03, 0x04, 31: 0xff}
+	randBytes := [32]byte{0x10, 0x20, 0x30, 0x40, 30: 0x0f}
+	var combined [32]byte
+	for i := range combined {
+		combined[i] = entropy[i] ^ randBytes[i]
+	}
+
+	// wantSig returns the signature produced with the nonce generated with
+	// the passed extra data.
+	wantSig := func(extra []byte) *Signature {
+		privKeyBytes := privKey.Serialize()
+		for iteration := uint32(0); ; iteration++ {
+			k := NonceRFC6979(privKeyBytes, hash, extra, nil, iteration)
+			if sig, ok := sign(&privKey.Key, k, hash, nil); ok {
+				return sig
+			}
+		}
+	}
+
+	tests := []struct {
+		name  string      // test description
+		opts  []Option    // options for Sign
+		sOpts SignOptions // options for PrivateKey.Sign
+		rand  []byte      // randomness for hedging
+		want  *Signature  // expected signature
+	}{{
+		name: "deterministic",
+		want: wantSig(nil),
+	}, {
+		name:  "extra entropy",
+		opts:  []Option{WithExtraEntropy(&entropy)},
+		sOpts: SignOptions{ExtraEntropy: &entropy},
+		want:  wantSig(entropy[:]),
+	}, {
+		name:  "hedged",
+		opts:  []Option{WithHedging(bytes.NewReader(randBytes[:]))},
+		sOpts: SignOptions{Hedged: true},
+		rand:  randBytes[:],
+		want:  wantSig(randBytes[:]),
+	}, {
+		name: "hedged with extra entropy",
+		opts: []Option{
+			WithHedging(bytes.NewReader(randBytes[:])),
+			WithExtraEntropy(&entropy),
+		},
+		sOpts: SignOptions{Hedged: true, ExtraEntropy: &entropy},
+		rand:  randBytes[:],
+		want:  wantSig(combined[:]),
+	}}
+
+	for _, test := range tests {
+		sig, err := SignErr(privKey, hash, test.opts...)
+		if err != nil {
+			t.Errorf("%s: unexpected SignErr err: %v", test.name, err)
+			continue
+		}
+		if !sig.IsEqual(test.want) {
+			t.Errorf("%s: unexpected signature -- got %x, want %x", test.name,
+				sig.Serialize(), test.want.Serialize())
+			continue
+		}
+		if !sig.Verify(hash, pubKey) {
+			t.Errorf("%s: signature failed to verify", test.name)
+			continue
+		}
+
+		derSig, err := privKey.Sign(bytes.NewReader(test.rand), hash,
+			&test.sOpts)
+		if err != nil {
+			t.Errorf("%s: unexpected err: %v", test.name, err)
+			continue
+		}
+		if !bytes.Equal(derSig, test.want.Serialize()) {
+			t.Errorf("%s: unexpected crypto.Signer signature -- got %x, want "+
+				"%x", test.name, derSig, test.want.Serialize())
+			continue
+		}
+	}
+
+	// Ensure signatures hedged with real randomness differ and are valid.
+	sig1 := SignWithOptions(privKey, hash, WithHedging(nil))
+	sig2 := SignWithOptions(privKey, hash, WithHedging(nil))
+	if sig1.IsEqual(sig2) {
+		t.Fatal("hedged signatures are the same")
+	}
+	if !sig1.Verify(hash, pubKey) || !sig2.Verify(hash, pubKey) {
+		t.Fatal("hedged signature failed to verify")
+	}
+
+	// Ensure the crypto.Signer implementation and SignErr return an error when
+	// reading the randomness fails and SignWithOptions panics instead of
+	// producing a signature without it.
+	readErr := errors.New("read failure")
+	_, err := privKey.Sign(iotest.ErrReader(readErr), hash,
+		&SignOptions{Hedged: true})
+	if !errors.Is(err, readErr) {
+		t.Fatalf("mismatched err -- got %v, want %v", err, readErr)
+	}
+	failOpts := []Option{WithHedging(iotest.ErrReader(readErr)),
+		WithExtraEntropy(&entropy)}
+	if _, err := SignErr(privKey, hash, failOpts...); !errors.Is(err, readErr) {
+		t.Fatalf("mismatched SignErr err -- got %v, want %v", err, readErr)
+	}
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Fatal("SignWithOptions did not panic after read failure")
+			}
+		}()
+		SignWithOptions(privKey, hash, failOpts...)
+	}()
+}
+
+// TestSignLowR ensures that signing with low R grinding produces valid
+// signatures with low R values that serialize to no more than 71 bytes and
+// that signatures which already have a low R value are not changed.
+func TestSignLowR(t *testing.T) {
+	t.Parallel()
+
+	// Use a unique random seed each test instance and log it if the tests fail.
+	seed := time.Now().Unix()
+	rng := rand.New(rand.NewSource(seed))
+	defer func(t *testing.T, seed int64) {
+		if t.Failed() {
+			t.Logf("random seed: %d", seed)
+		}
+	}(t, seed)
+
+	var numGround int
+	for i := 0; i < 50; i++ {
+		var buf [32]byte
+		if _, err := rng.Read(buf[:]); err != nil {
+			t.Fatalf("failed to read random private key: %v", err)
+		}
+		var privKeyScalar ModNScalar
+		privKeyScalar.SetBytes(&buf)
+		privKey := NewPrivateKey(&privKeyScalar)
+		pubKey := privKey.PubKey()
+		hash := make([]byte, 32)
+		if _, err := rng.Read(hash); err != nil {
+			t.Fatalf("failed to read random hash: %v", err)
+		}
+
+		sig := SignWithOptions(privKey, hash, WithLowR())
+		if !sig.hasLowR() {
+			t.Fatalf("signature %d does not have low R", i)
+		}
+		if !sig.Verify(hash, pubKey) {
+			t.Fatalf("signature %d failed to verify", i)
+		}
+		if serialized := sig.Serialize(); len(serialized) > 71 {
+			t.Fatalf("signature %d is %d bytes", i, len(serialized))
+		}
+
+		// Ensure grinding is only performed when needed and that the crypto
+		// signer implementation produces the same signature.
+		defaultSig := Sign(privKey, hash)
+		if defaultSig.hasLowR() != sig.IsEqual(defaultSig) {
+			t.Fatalf("signature %d unexpectedly ground", i)
+		}
+		if !defaultSig.hasLowR() {
+			numGround++
+		}
+		derSig, err := privKey.Sign(nil, hash, &SignOptions{LowR: true})
+		if err != nil {
+			t.Fatalf("unexpected err: %v", err)
+		}
+		if !bytes.Equal(derSig, sig.Serialize()) {
+			t.Fatalf("signature %d: mismatched crypto.Signer signature", i)
+		}
+	}
+	if numGround == 0 {
+		t.Fatal("no signatures required grinding")
+	}
+}
+
+// TestSignHash ensures that signing hashes produced by hash functions other
+// than SHA-256 generates the nonce with HMAC using that hash function, that
+// SignWithOptions and the crypto.Signer implementation produce the same
+// signatures, and that the crypto.Signer implementation and SignErr reject
+// digests of the wrong size and unavailable hash functions while
+// SignWithOptions panics for the latter.
+func TestSignHash(t *testing.T) {
+	t.Parallel()
+
+	privKey := NewPrivateKey(hexToModNScalar("a9d2fe03b2d3e3e8c5e0e4d2e1b3a7b1c2d9e8f7a6b5c4d3e2f1a0b9c8d7e6f5"))
+	pubKey := privKey.PubKey()
+	msg := []byte("sample")
+	sha384Hash := sha512.Sum384(msg)
+	sha512Hash := sha512.Sum512(msg)
+
+	tests := []struct {
+		name     string      // test description
+		hashFunc crypto.Hash // hash function that produced the hash
+		hash     []byte      // hash to sign
+		nonce    string      // expected hex encoded nonce
+	}{{
+		name:     "SHA-384",
+		hashFunc: crypto.SHA384,
+		hash:     sha384Hash[:],
+		nonce:    "d493afc57281ff7046a8402e241b80d8ef80b7fc6e8939af0c2ca0c1f84b7149",
+	}, {
+		name:     "SHA-512",
+		hashFunc: crypto.SHA512,
+		hash:     sha512Hash[:],
+		nonce:    "61f830024a5504abfddd4a343f78dc9df7422af9b0921b0c3f04c750dbe354bf",
+	}}
+
+	for _, test := range tests {
+		wantSig, ok := sign(&privKey.Key, hexToModNScalar(test.nonce), test.hash,
+			nil)
+		if !ok {
+			t.Fatalf("%s: failed to create expected signature", test.name)
+		}
+
+		sig := SignWithOptions(privKey, test.hash, WithHash(test.hashFunc))
+		if !sig.IsEqual(wantSig) {
+			t.Errorf("%s: unexpected signature -- got %x, want %x", test.name,
+				sig.Serialize(), wantSig.Serialize())
+			continue
+		}
+		if !sig.Verify(test.hash, pubKey) {
+			t.Errorf("%s: signature failed to verify", test.name)
+			continue
+		}
+		policy := &Policy{StrictHashLen: true, Hash: test.hashFunc}
+		if err := policy.Verify(sig, test.hash, pubKey); err != nil {
+			t.Errorf("%s: signature failed to verify under policy: %v",
+				test.name, err)
+			continue
+		}
+
+		// Ensure the crypto.Signer implementation produces the same signature
+		// when the hash function is specified either directly or via the
+		// signing options.
+		for _, opts := range []crypto.SignerOpts{test.hashFunc,
+			&SignOptions{Hash: test.hashFunc}} {
+
+			derSig, err := privKey.Sign(nil, test.hash, opts)
+			if err != nil {
+				t.Errorf("%s: unexpected err: %v", test.name, err)
+				continue
+			}
+			if !bytes.Equal(derSig, wantSig.Serialize()) {
+				t.Errorf("%s: unexpected crypto.Signer signature -- got %x, "+
+					"want %x", test.name, derSig, wantSig.Serialize())
+				continue
+			}
+		}
+
+		// Ensure digests of the wrong size are rejected.
+		_, err := privKey.Sign(nil, test.hash[:32], test.hashFunc)
+		if !errors.Is(err, ErrInvalidHashLen) {
+			t.Errorf("%s: mismatched err -- got %v, want %v", test.name, err,
+				ErrInvalidHashLen)
+			continue
+		}
+		_, err = SignErr(privKey, test.hash[:32], WithHash(test.hashFunc))
+		if !errors.Is(err, ErrInvalidHashLen) {
+			t.Errorf("%s: mismatched SignErr err -- got %v, want %v",
+				test.name, err, ErrInvalidHashLen)
+			continue
+		}
+	}
+
+	// Ensure unavailable hash functions are rejected and that SignWithOptions
+	// panics for them.
+	if _, err := privKey.Sign(nil, make([]byte, 16), crypto.MD4); err == nil {
+		t.Fatal("unexpected success for unavailable hash function")
+	}
+	if _, err := SignErr(privKey, make([]byte, 16), WithHash(crypto.MD4)); err == nil {
+		t.Fatal("unexpected SignErr success for unavailable hash function")
+	}
+	defer func() {
+		if recover() == nil {
+			t.Fatal("SignWithOptions did not panic for unavailable hash " +
+				"function")
+		}
+	}()
+	SignWithOptions(privKey, make([]byte, 16), WithHash(crypto.MD4))
+}
+
+// TestSignatureFormats ensures that signatures produced by the crypto.Signer
+// implementation may be parsed with the format they were produced with, that
+// malformed signatures are rejected without returning a non-nil verifier, that
+// specifying a scheme for ECDSA signatures is rejected, and that Schnorr
+// signatures are unavailable without the schnorr package.
+func TestSignatureFormats(t *testing.T) {
+	t.Parallel()
+
+	privKey := NewPrivateKey(hexToModNScalar("a9d2fe03b2d3e3e8c5e0e4d2e1b3a7b1c2d9e8f7a6b5c4d3e2f1a0b9c8d7e6f5"))
+	pubKey := privKey.PubKey()
+	hash := hexToBytes("c301ba9de5d6053caad9f5eb46523f007702add2c62fa39de03146a36b8026b7")
+
+	for _, format := range []SignatureFormat{SignFormatDER, SignFormatCompact} {
+		sig, err := privKey.Sign(nil, hash, &SignOptions{Format: format})
+		if err != nil {
+			t.Fatalf("format %d: unexpected err: %v", format, err)
+		}
+		verifier, err := ParseSignatureFormat(sig, format)
+		if err != nil {
+			t.Fatalf("format %d: unexpected parse err: %v", format, err)
+		}
+		if err := verifier.VerifyErr(hash, pubKey); err != nil {
+			t.Fatalf("format %d: signature failed to verify: %v", format, err)
+		}
+
+		// Ensure a corrupted signature is rejected.
+		sig[0] ^= 0x80
+		verifier, err = ParseSignatureFormat(sig, format)
+		if err == nil || verifier != nil {
+			t.Fatalf("format %d: unexpected success parsing corrupted "+
+				"signature", format)
+		}
+	}
+
+	for _, format := range []SignatureFormat{SignFormatDER, SignFormatCompact} {
+		opts := &SignOptions{Format: format, Scheme: "test-scheme"}
+		if _, err := privKey.Sign(nil, hash, opts); err == nil {
+			t.Fatalf("format %d: unexpected success with scheme", format)
+		}
+	}
+
+	_, err := privKey.Sign(nil, hash, &SignOptions{Format: SignFormatSchnorr})
+	if !errors.Is(err, errSchnorrUnavailable) {
+		t.Fatalf("mismatched err -- got %v, want %v", err,
+			errSchnorrUnavailable)
+	}
+	_, err = ParseSignatureFormat(make([]byte, 64), SignFormatSchnorr)
+	if !errors.Is(err, errSchnorrUnavailable) {
+		t.Fatalf("mismatched err -- got %v, want %v", err,
+			errSchnorrUnavailable)
+	}
+}
@@ -6,8 +6,12 @@
 package secp256k1
 
 import (
+	"crypto"
+	"crypto/sha256"
 	"fmt"
+	"io"
 	"math/big"
+	"slices"
 )
 
 // References:
@@ -31,6 +35,13 @@ var (
 	}()
 )
 
+const (
+	// CompactSigSize is the size of a compact signature.  It consists of a
+	// compact signature recovery code byte followed by the R and S components
+	// serialized as 32-byte big-endian values.  1+32*2 = 65.
+	CompactSigSize = 65
+)
+
 const (
 	// asn1SequenceID is the ASN.1 identifier for a sequence and is used when
 	// parsing and serializing signatures encoded with the Distinguished
@@ -90,6 +101,13 @@ func (sig *Signature) RecoveryCode() (byte, error) {
 // Note that the serialized bytes returned do not include the appended hash type
 // used in Decred signature scripts.
 func (sig *Signature) Serialize() []byte {
+	return sig.AppendSerialized(nil)
+}
+
+// AppendSerialized appends the ECDSA signature serialized in the same format as
+// Serialize to the passed slice and returns the extended slice.  It does not
+// allocate when the slice has enough capacity, which is at most 72 bytes.
+func (sig *Signature) AppendSerialized(dst []byte) []byte {
 	// The format of a DER encoded signature is as follows:
 	//
 	// 0x30 <total length> 0x02 <length of R> <R> 0x02 <length of S> <S>
@@ -113,7 +131,8 @@ func (sig *Signature) Serialize() []byte {
 	// order of the group because both S and its negation are valid signatures
 	// modulo the order, so this forces a consistent choice to reduce signature
 	// malleability.
-	sigS := new(ModNScalar).Set(&sig.s)
+	var sigS ModNScalar
+	sigS.Set(&sig.s)
 	if sigS.IsOverHalfOrder() {
 		sigS.Negate()
 	}
@@ -140,7 +159,7 @@ func (sig *Signature) Serialize() []byte {
 	// Total length of returned signature is 1 byte for each magic and length
 	// (6 total), plus lengths of R and S.
 	totalLen := 6 + len(canonR) + len(canonS)
-	b := make([]byte, 0, totalLen)
+	b := slices.Grow(dst, totalLen)
 	b = append(b, asn1SequenceID)
 	b = append(b, byte(totalLen-2))
 	b = append(b, asn1IntegerID)
@@ -179,13 +198,22 @@ func (sig *Signature) Export() (*big.Int, *big.Int, byte) {
 
 // ExportCompact exports the signature in compact format
 func (sig *Signature) ExportCompact(recoveryCodeFirst bool, recoveryCodeOffset byte) []byte {
+	var b [CompactSigSize]byte
+	sig.PutCompact(&b, recoveryCodeFirst, recoveryCodeOffset)
+	return b[:]
+}
+
+// PutCompact exports the signature in the same compact format as ExportCompact
+// into the passed byte array.
+func (sig *Signature) PutCompact(b *[CompactSigSize]byte, recoveryCodeFirst bool, recoveryCodeOffset byte) {
 	v := sig.v
 
 	// Ensure the S component of the signature is less than or equal to the half
 	// order of the group because both S and its negation are valid signatures
 	// modulo the order, so this forces a consistent choice to reduce signature
 	// malleability.
-	sigS := new(ModNScalar).Set(&sig.s)
+	var sigS ModNScalar
+	sigS.Set(&sig.s)
 	if sigS.IsOverHalfOrder() {
 		sigS.Negate()
 
@@ -196,20 +224,26 @@ func (sig *Signature) ExportCompact(recoveryCodeFirst bool, recoveryCodeOffset b
 		v ^= 0x01
 	}
 
-	var b [compactSigSize]byte
-
 	if recoveryCodeFirst {
 		// Output <compactSigRecoveryCode><32-byte R><32-byte S>.
 		b[0] = v + recoveryCodeOffset
 		sig.r.PutBytesUnchecked(b[1:33])
-		sig.s.PutBytesUnchecked(b[33:65])
+		sigS.PutBytesUnchecked(b[33:65])
 	} else {
 		// Output <32-byte R><32-byte S><compactSigRecoveryCode>.
 		sig.r.PutBytesUnchecked(b[0:32])
-		sig.s.PutBytesUnchecked(b[32:64])
+		sigS.PutBytesUnchecked(b[32:64])
 		b[64] = v + recoveryCodeOffset
 	}
-	return b[:]
+}
+
+// AppendCompact appends the signature exported in the same compact format as
+// ExportCompact to the passed slice and returns the extended slice.  It does
+// not allocate when the slice has enough capacity.
+func (sig *Signature) AppendCompact(dst []byte, recoveryCodeFirst bool, recoveryCodeOffset byte) []byte {
+	var b [CompactSigSize]byte
+	sig.PutCompact(&b, recoveryCodeFirst, recoveryCodeOffset)
+	return append(dst, b[:]...)
 }
 
 // fieldToModNScalar converts a field value to scalar modulo the group order and
@@ -241,13 +275,9 @@ func modNScalarToField(v *ModNScalar) FieldVal {
 // rather than knowing what the code was. The process is very similar to Verify but doesn't go
 // as far since we assume the signature is valid.
 func (sig *Signature) BruteforceRecoveryCode(hash []byte, pubKey *PublicKey) bool {
-	for i := byte(0); i < 4; i++ {
-		sig.v = i
-		pub2, err := sig.RecoverPublicKey(hash)
-		if err != nil {
-			continue
-		}
-		if pubKey.IsEqual(pub2) {
+	for _, candidate := range sig.RecoverCandidates(hash) {
+		if pubKey.IsEqual(candidate.PubKey) {
+			sig.v = candidate.RecoveryCode
 			return true
 		}
 	}
@@ -258,7 +288,36 @@ func (sig *Signature) BruteforceRecoveryCode(hash []byte, pubKey *PublicKey) boo
 
 // Verify returns whether or not the signature is valid for the provided hash
 // and secp256k1 public key.
+//
+// Signatures with high S values are accepted and hashes that are not 32 bytes
+// are truncated or zero-extended.  See Policy for verifying signatures under
+// stricter rules.
 func (sig *Signature) Verify(hash []byte, pubKey *PublicKey) bool {
+	return sig.verify(hash, pubKey, nil, false) == nil
+}
+
+// VerifyErr verifies the signature for the provided hash and secp256k1 public
+// key and either returns nil if it is valid or an error that indicates why it
+// is not.
+//
+// The result is the same as that of Verify, however, the returned error allows
+// the caller to determine the specific reason the signature is invalid, such
+// as whether the public key is not a point on the curve or the signature is
+// simply not for the provided hash and public key.  The error is of type Error
+// and the specific reason may be determined with errors.Is on the ErrorKind.
+func (sig *Signature) VerifyErr(hash []byte, pubKey *PublicKey) error {
+	return sig.verify(hash, pubKey, nil, false)
+}
+
+// verify verifies the signature for the provided hash and secp256k1 public key
+// and either returns nil if it is valid or an error that indicates why it is
+// not.  The pre-computed tables of the provided precomputed public key are used
+// to accelerate the verification when it is not nil, in which case it MUST be
+// for the same public key.
+//
+// Signatures with an S value greater than half the group order are rejected
+// when requireLowS is set.
+func (sig *Signature) verify(hash []byte, pubKey *PublicKey, precomputed *PrecomputedPublicKey, requireLowS bool) error {
 	// The algorithm for verifying an ECDSA signature is given as algorithm 4.30
 	// in [GECC].
 	//
@@ -314,19 +373,45 @@ func (sig *Signature) Verify(hash []byte, pubKey *PublicKey) bool {
 	// 8. Verified if R * z == X.x (mod P)
 	// 9. Fail if R + N >= P
 	// 10. Verified if (R + N) * z == X.x (mod P)
+	//
+	// In addition, the signature is rejected when the public key is not a
+	// point on the curve, and, when requested, when S is greater than half the
+	// group order since the same signature with the negated S value is also
+	// valid.
+
+	// Fail if Q is not a point on the curve.
+	if !pubKey.IsOnCurve() {
+		str := "pubkey point is not on curve"
+		return signatureError(ErrPubKeyNotOnCurve, str)
+	}
 
 	// Step 1.
 	//
 	// Fail if R and S are not in [1, N-1].
-	if sig.r.IsZero() || sig.s.IsZero() {
-		return false
+	//
+	// Note that R and S are mod N scalars, so they are already less than N.
+	if sig.r.IsZero() {
+		str := "invalid signature: R is 0"
+		return signatureError(ErrSigRIsZero, str)
+	}
+	if sig.s.IsZero() {
+		str := "invalid signature: S is 0"
+		return signatureError(ErrSigSIsZero, str)
+	}
+	if requireLowS && sig.s.IsOverHalfOrder() {
+		str := "invalid signature: S is greater than half the group order"
+		return signatureError(ErrSigHighS, str)
 	}
 
 	// Step 2.
 	//
 	// e = H(m)
+	//
+	// Note that this actually sets e = bits2int(H(m)) mod N per FIPS 186-5,
+	// which truncates hashes that are longer than 256 bits to their leftmost
+	// 256 bits.
 	var e ModNScalar
-	e.SetByteSlice(hash)
+	bits2int(hash, &e)
 
 	// Step 3.
 	//
@@ -343,17 +428,21 @@ func (sig *Signature) Verify(hash []byte, pubKey *PublicKey) bool {
 	// Step 5.
 	//
 	// X = u1G + u2Q
-	var X, Q, u1G, u2Q JacobianPoint
-	pubKey.AsJacobian(&Q)
-	ScalarBaseMultNonConst(u1, &u1G)
-	ScalarMultNonConst(u2, &Q, &u2Q)
-	AddNonConst(&u1G, &u2Q, &X)
+	var X JacobianPoint
+	if precomputed != nil {
+		precomputed.DoubleScalarMultNonConst(u1, u2, &X)
+	} else {
+		var Q JacobianPoint
+		pubKey.AsJacobian(&Q)
+		DoubleScalarMultNonConst(u1, u2, &Q, &X)
+	}
 
 	// Step 6.
 	//
 	// Fail if X is the point at infinity
 	if (X.X.IsZero() && X.Y.IsZero()) || X.Z.IsZero() {
-		return false
+		str := "calculated X point is the point at infinity"
+		return signatureError(ErrSigInfinityResult, str)
 	}
 
 	// Step 7.
@@ -367,14 +456,15 @@ func (sig *Signature) Verify(hash []byte, pubKey *PublicKey) bool {
 	sigRModP := modNScalarToField(&sig.r)
 	result := new(FieldVal).Mul2(&sigRModP, z).Normalize()
 	if result.Equals(&X.X) {
-		return true
+		return nil
 	}
 
 	// Step 9.
 	//
 	// Fail if R + N >= P
 	if sigRModP.IsGtOrEqPrimeMinusOrder() {
-		return false
+		str := "calculated X point x-value does not match given R"
+		return signatureError(ErrSigUnequalRValues, str)
 	}
 
 	// Step 10.
@@ -382,7 +472,11 @@ func (sig *Signature) Verify(hash []byte, pubKey *PublicKey) bool {
 	// Verified if (R + N) * z == X.x (mod P)
 	sigRModP.Add(&orderAsFieldVal)
 	result.Mul2(&sigRModP, z).Normalize()
-	return result.Equals(&X.X)
+	if !result.Equals(&X.X) {
+		str := "calculated X point x-value does not match given R"
+		return signatureError(ErrSigUnequalRValues, str)
+	}
+	return nil
 }
 
 // IsEqual compares this Signature instance to the one passed, returning true if
@@ -401,6 +495,19 @@ func (sig *Signature) IsEqual(otherSig *Signature) bool {
 //   - Zero is rejected
 //   - Values greater than or equal to the secp256k1 group order are rejected
 func ParseDERSignature(sig []byte) (*Signature, error) {
+	var signature Signature
+	if err := signature.ParseDERInto(sig); err != nil {
+		return nil, err
+	}
+	return &signature, nil
+}
+
+// ParseDERInto parses a signature in the Distinguished Encoding Rules (DER)
+// format into the signature.  It is identical to ParseDERSignature except it
+// reuses the signature instead of allocating a new one.
+//
+// The signature is not modified when an error is returned.
+func (sig *Signature) ParseDERInto(der []byte) error {
 	// The format of a DER encoded signature for secp256k1 is as follows:
 	//
 	// 0x30 <total length> 0x02 <length of R> <R> 0x02 <length of S> <S>
@@ -459,31 +566,31 @@ func ParseDERSignature(sig []byte) (*Signature, error) {
 	)
 
 	// The signature must adhere to the minimum and maximum allowed length.
-	sigLen := len(sig)
+	sigLen := len(der)
 	if sigLen < minSigLen {
 		str := fmt.Sprintf("malformed signature: too short: %d < %d", sigLen,
 			minSigLen)
-		return nil, signatureError(ErrSigTooShort, str)
+		return signatureError(ErrSigTooShort, str)
 	}
 	if sigLen > maxSigLen {
 		str := fmt.Sprintf("malformed signature: too long: %d > %d", sigLen,
 			maxSigLen)
-		return nil, signatureError(ErrSigTooLong, str)
+		return signatureError(ErrSigTooLong, str)
 	}
 
 	// The signature must start with the ASN.1 sequence identifier.
-	if sig[sequenceOffset] != asn1SequenceID {
+	if der[sequenceOffset] != asn1SequenceID {
 		str := fmt.Sprintf("malformed signature: format has wrong type: %#x",
-			sig[sequenceOffset])
-		return nil, signatureError(ErrSigInvalidSeqID, str)
+			der[sequenceOffset])
+		return signatureError(ErrSigInvalidSeqID, str)
 	}
 
 	// The signature must indicate the correct amount of data for all elements
 	// related to R and S.
-	if int(sig[dataLenOffset]) != sigLen-2 {
+	if int(der[dataLenOffset]) != sigLen-2 {
 		str := fmt.Sprintf("malformed signature: bad length: %d != %d",
-			sig[dataLenOffset], sigLen-2)
-		return nil, signatureError(ErrSigInvalidDataLen, str)
+			der[dataLenOffset], sigLen-2)
+		return signatureError(ErrSigInvalidDataLen, str)
 	}
 
 	// Calculate the offsets of the elements related to S and ensure S is inside
@@ -497,16 +604,16 @@ func ParseDERSignature(sig []byte) (*Signature, error) {
 	//
 	// sLenOffset and sOffset are the byte offsets within the signature of the
 	// length of S and S itself, respectively.
-	rLen := int(sig[rLenOffset])
+	rLen := int(der[rLenOffset])
 	sTypeOffset := rOffset + rLen
 	sLenOffset := sTypeOffset + 1
 	if sTypeOffset >= sigLen {
 		str := "malformed signature: S type indicator missing"
-		return nil, signatureError(ErrSigMissingSTypeID, str)
+		return signatureError(ErrSigMissingSTypeID, str)
 	}
 	if sLenOffset >= sigLen {
 		str := "malformed signature: S length missing"
-		return nil, signatureError(ErrSigMissingSLen, str)
+		return signatureError(ErrSigMissingSLen, str)
 	}
 
 	// The lengths of R and S must match the overall length of the signature.
@@ -514,62 +621,62 @@ func ParseDERSignature(sig []byte) (*Signature, error) {
 	// sLen specifies the length of the big-endian encoded number which
 	// represents the S value of the signature.
 	sOffset := sLenOffset + 1
-	sLen := int(sig[sLenOffset])
+	sLen := int(der[sLenOffset])
 	if sOffset+sLen != sigLen {
 		str := "malformed signature: invalid S length"
-		return nil, signatureError(ErrSigInvalidSLen, str)
+		return signatureError(ErrSigInvalidSLen, str)
 	}
 
 	// R elements must be ASN.1 integers.
-	if sig[rTypeOffset] != asn1IntegerID {
+	if der[rTypeOffset] != asn1IntegerID {
 		str := fmt.Sprintf("malformed signature: R integer marker: %#x != %#x",
-			sig[rTypeOffset], asn1IntegerID)
-		return nil, signatureError(ErrSigInvalidRIntID, str)
+			der[rTypeOffset], asn1IntegerID)
+		return signatureError(ErrSigInvalidRIntID, str)
 	}
 
 	// Zero-length integers are not allowed for R.
 	if rLen == 0 {
 		str := "malformed signature: R length is zero"
-		return nil, signatureError(ErrSigZeroRLen, str)
+		return signatureError(ErrSigZeroRLen, str)
 	}
 
 	// R must not be negative.
-	if sig[rOffset]&0x80 != 0 {
+	if der[rOffset]&0x80 != 0 {
 		str := "malformed signature: R is negative"
-		return nil, signatureError(ErrSigNegativeR, str)
+		return signatureError(ErrSigNegativeR, str)
 	}
 
 	// Null bytes at the start of R are not allowed, unless R would otherwise be
 	// interpreted as a negative number.
-	if rLen > 1 && sig[rOffset] == 0x00 && sig[rOffset+1]&0x80 == 0 {
+	if rLen > 1 && der[rOffset] == 0x00 && der[rOffset+1]&0x80 == 0 {
 		str := "malformed signature: R value has too much padding"
-		return nil, signatureError(ErrSigTooMuchRPadding, str)
+		return signatureError(ErrSigTooMuchRPadding, str)
 	}
 
 	// S elements must be ASN.1 integers.
-	if sig[sTypeOffset] != asn1IntegerID {
+	if der[sTypeOffset] != asn1IntegerID {
 		str := fmt.Sprintf("malformed signature: S integer marker: %#x != %#x",
-			sig[sTypeOffset], asn1IntegerID)
-		return nil, signatureError(ErrSigInvalidSIntID, str)
+			der[sTypeOffset], asn1IntegerID)
+		return signatureError(ErrSigInvalidSIntID, str)
 	}
 
 	// Zero-length integers are not allowed for S.
 	if sLen == 0 {
 		str := "malformed signature: S length is zero"
-		return nil, signatureError(ErrSigZeroSLen, str)
+		return signatureError(ErrSigZeroSLen, str)
 	}
 
 	// S must not be negative.
-	if sig[sOffset]&0x80 != 0 {
+	if der[sOffset]&0x80 != 0 {
 		str := "malformed signature: S is negative"
-		return nil, signatureError(ErrSigNegativeS, str)
+		return signatureError(ErrSigNegativeS, str)
 	}
 
 	// Null bytes at the start of S are not allowed, unless S would otherwise be
 	// interpreted as a negative number.
-	if sLen > 1 && sig[sOffset] == 0x00 && sig[sOffset+1]&0x80 == 0 {
+	if sLen > 1 && der[sOffset] == 0x00 && der[sOffset+1]&0x80 == 0 {
 		str := "malformed signature: S value has too much padding"
-		return nil, signatureError(ErrSigTooMuchSPadding, str)
+		return signatureError(ErrSigTooMuchSPadding, str)
 	}
 
 	// The signature is validly encoded per DER at this point, however, enforce
@@ -583,7 +690,7 @@ func ParseDERSignature(sig []byte) (*Signature, error) {
 	// that do not conform to the ECDSA spec.
 
 	// Strip leading zeroes from R.
-	rBytes := sig[rOffset : rOffset+rLen]
+	rBytes := der[rOffset : rOffset+rLen]
 	for len(rBytes) > 0 && rBytes[0] == 0x00 {
 		rBytes = rBytes[1:]
 	}
@@ -594,19 +701,19 @@ func ParseDERSignature(sig []byte) (*Signature, error) {
 	var r ModNScalar
 	if len(rBytes) > 32 {
 		str := "invalid signature: R is larger than 256 bits"
-		return nil, signatureError(ErrSigRTooBig, str)
+		return signatureError(ErrSigRTooBig, str)
 	}
 	if overflow := r.SetByteSlice(rBytes); overflow {
 		str := "invalid signature: R >= group order"
-		return nil, signatureError(ErrSigRTooBig, str)
+		return signatureError(ErrSigRTooBig, str)
 	}
 	if r.IsZero() {
 		str := "invalid signature: R is 0"
-		return nil, signatureError(ErrSigRIsZero, str)
+		return signatureError(ErrSigRIsZero, str)
 	}
 
 	// Strip leading zeroes from S.
-	sBytes := sig[sOffset : sOffset+sLen]
+	sBytes := der[sOffset : sOffset+sLen]
 	for len(sBytes) > 0 && sBytes[0] == 0x00 {
 		sBytes = sBytes[1:]
 	}
@@ -617,27 +724,29 @@ func ParseDERSignature(sig []byte) (*Signature, error) {
 	var s ModNScalar
 	if len(sBytes) > 32 {
 		str := "invalid signature: S is larger than 256 bits"
-		return nil, signatureError(ErrSigSTooBig, str)
+		return signatureError(ErrSigSTooBig, str)
 	}
 	if overflow := s.SetByteSlice(sBytes); overflow {
 		str := "invalid signature: S >= group order"
-		return nil, signatureError(ErrSigSTooBig, str)
+		return signatureError(ErrSigSTooBig, str)
 	}
 	if s.IsZero() {
 		str := "invalid signature: S is 0"
-		return nil, signatureError(ErrSigSIsZero, str)
+		return signatureError(ErrSigSIsZero, str)
 	}
 
-	// Create and return the signature.
-	return NewSignature(&r, &s), nil
+	sig.r.Set(&r)
+	sig.s.Set(&s)
+	sig.v = 0xff
+	return nil
 }
 
 func ParseCompactSignature(signature []byte) (*Signature, bool, error) {
 	// A compact signature consists of a recovery byte followed by the R and
 	// S components serialized as 32-byte big-endian values.
-	if len(signature) != compactSigSize {
+	if len(signature) != CompactSigSize {
 		str := fmt.Sprintf("malformed signature: wrong size: %d != %d",
-			len(signature), compactSigSize)
+			len(signature), CompactSigSize)
 		return nil, false, signatureError(ErrSigInvalidLen, str)
 	}
 
@@ -696,7 +805,10 @@ func ParseCompactSignature(signature []byte) (*Signature, bool, error) {
 // signing logic.  It differs in that it accepts a nonce to use when signing and
 // may not successfully produce a valid signature for the given nonce.  It is
 // primarily separated for testing purposes.
-func sign(privKey, nonce *ModNScalar, hash []byte) (*Signature, bool) {
+//
+// The scalar multiplication involving the nonce is blinded with the passed
+// context when it is not nil.
+func sign(privKey, nonce *ModNScalar, hash []byte, ctx *Context) (*Signature, bool) {
 	// The algorithm for producing an ECDSA signature is given as algorithm 4.29
 	// in [GECC].
 	//
@@ -737,18 +849,30 @@ func sign(privKey, nonce *ModNScalar, hash []byte) (*Signature, bool) {
 	// Note that the point must be in affine coordinates.
 	k := nonce
 	var kG JacobianPoint
-	ScalarBaseMultNonConst(k, &kG)
+	ctx.ScalarBaseMult(k, &kG)
 	kG.ToAffine()
 
 	// Step 3.
 	//
 	// r = kG.x mod N
 	// Repeat from step 1 if r = 0
-	r, overflow := fieldToModNScalar(&kG.X)
+	r, pubKeyRecoveryCode := nonceRValue(&kG)
 	if r.IsZero() {
 		return nil, false
 	}
 
+	// Steps 4 through 6.
+	kinv := new(ModNScalar).InverseVal(k)
+	return signWithNonceInverse(privKey, kinv, &r, pubKeyRecoveryCode, hash)
+}
+
+// nonceRValue returns the R value of a signature, which is the x coordinate of
+// the passed random point modulo the group order, along with the public key
+// recovery code that corresponds to the point.  The point must be in affine
+// coordinates and the caller must reject R values of zero.
+func nonceRValue(kG *JacobianPoint) (ModNScalar, byte) {
+	r, overflow := fieldToModNScalar(&kG.X)
+
 	// Since the secp256k1 curve has a cofactor of 1, when recovering a
 	// public key from an ECDSA signature over it, there are four possible
 	// candidates corresponding to the following cases:
@@ -771,23 +895,33 @@ func sign(privKey, nonce *ModNScalar, hash []byte) (*Signature, bool) {
 	// this strongly implies with extremely high probability that there are
 	// only a few actual points for which this case is true.
 	pubKeyRecoveryCode := byte(overflow<<1) | byte(kG.Y.IsOddBit())
+	return r, pubKeyRecoveryCode
+}
 
+// signWithNonceInverse performs the final steps of generating an ECDSA
+// signature for the provided hash using the given private key along with the
+// modular inverse of the nonce, the R value it produced, and the associated
+// public key recovery code.  It returns the signature and a success indicator,
+// which is false when the resulting S value is zero.
+//
+// This is separated from sign so that presignatures, which compute the R value
+// and nonce inverse ahead of time, share the same signing logic.
+func signWithNonceInverse(privKey, kinv, r *ModNScalar, pubKeyRecoveryCode byte, hash []byte) (*Signature, bool) {
 	// Step 4.
 	//
 	// e = H(m)
 	//
-	// Note that this actually sets e = H(m) mod N which is correct since
-	// it is only used in step 5 which itself is mod N.
+	// Note that this actually sets e = bits2int(H(m)) mod N per FIPS 186-5,
+	// which is correct since it is only used in step 5 which itself is mod N.
 	var e ModNScalar
-	e.SetByteSlice(hash)
+	bits2int(hash, &e)
 
 	// Step 5 with modification B.
 	//
 	// s = k^-1(e + dr) mod N
 	// Repeat from step 1 if s = 0
 	// s = -s if s > N/2
-	kinv := new(ModNScalar).InverseValNonConst(k)
-	s := new(ModNScalar).Mul2(privKey, &r).Add(&e).Mul(kinv)
+	s := new(ModNScalar).Mul2(privKey, r).Add(&e).Mul(kinv)
 	if s.IsZero() {
 		return nil, false
 	}
@@ -804,13 +938,16 @@ func sign(privKey, nonce *ModNScalar, hash []byte) (*Signature, bool) {
 	// Step 6.
 	//
 	// Return (r,s)
-	return &Signature{r, *s, pubKeyRecoveryCode}, true
+	return &Signature{*r, *s, pubKeyRecoveryCode}, true
 }
 
 // signRFC6979 generates a deterministic ECDSA signature according to RFC 6979
 // and BIP0062 and returns it along with an additional public key recovery code
 // for efficiently recovering the public key from the signature.
-func signRFC6979(privKey *PrivateKey, hash []byte) *Signature {
+//
+// The scalar multiplication involving the nonce is blinded with the passed
+// context when it is not nil.
+func signRFC6979(privKey *PrivateKey, hash []byte, nonceHash crypto.Hash, extra []byte, lowR bool, ctx *Context) *Signature {
 	// The algorithm for producing an ECDSA signature is given as algorithm 4.29
 	// in [GECC].
 	//
@@ -842,6 +979,13 @@ func signRFC6979(privKey *PrivateKey, hash []byte) *Signature {
 	//    modulo the curve order N, so it forces a consistent choice to reduce
 	//    signature malleability
 
+	// Use HMAC-SHA256 for the nonce generation unless another hash function
+	// is specified.
+	newHash := sha256.New
+	if nonceHash != 0 {
+		newHash = nonceHash.New
+	}
+
 	privKeyScalar := &privKey.Key
 	var privKeyBytes [32]byte
 	privKeyScalar.PutBytes(&privKeyBytes)
@@ -851,35 +995,123 @@ func signRFC6979(privKey *PrivateKey, hash []byte) *Signature {
 		//
 		// Generate a deterministic nonce in [1, N-1] parameterized by the
 		// private key, message being signed, and iteration count.
-		k := NonceRFC6979(privKeyBytes[:], hash, nil, nil, iteration)
+		k := nonceRFC6979(newHash, privKeyBytes[:], hash, extra, nil, iteration)
 
 		// Steps 2-6.
-		sig, success := sign(privKeyScalar, k, hash)
+		sig, success := sign(privKeyScalar, k, hash, ctx)
 		k.Zero()
 		if !success {
 			continue
 		}
 
+		// Grind the nonce until R is low when requested.
+		if lowR && !sig.hasLowR() {
+			continue
+		}
+
 		return sig
 	}
 }
 
+// hasLowR returns whether or not the R value of the signature is less than
+// half of the maximum 256-bit value, which means it does not require a leading
+// zero byte when serialized in the DER format.
+func (sig *Signature) hasLowR() bool {
+	var rBytes [32]byte
+	sig.r.PutBytes(&rBytes)
+	return rBytes[0] < 0x80
+}
+
+// nonceExtraData returns the extra data to mix into the generation of the
+// nonce for the provided extra entropy and source of randomness for hedging,
+// either of which may be nil, using the provided buffer for storage.  It
+// returns nil when both of them are nil.
+func nonceExtraData(extraEntropy *[32]byte, hedgeRand io.Reader, buf *[32]byte) ([]byte, error) {
+	if extraEntropy == nil && hedgeRand == nil {
+		return nil, nil
+	}
+
+	*buf = [32]byte{}
+	if extraEntropy != nil {
+		*buf = *extraEntropy
+	}
+	if hedgeRand != nil {
+		var randBytes [32]byte
+		if _, err := io.ReadFull(hedgeRand, randBytes[:]); err != nil {
+			return nil, err
+		}
+		for i := range buf {
+			buf[i] ^= randBytes[i]
+		}
+		zeroArray32(&randBytes)
+	}
+	return buf[:], nil
+}
+
 // Sign generates an ECDSA signature over the secp256k1 curve for the provided
 // hash (which should be the result of hashing a larger message) using the given
 // private key.  The produced signature is deterministic (same message and same
 // key yield the same signature) and canonical in accordance with RFC6979 and
 // BIP0062.
+//
+// See SignWithOptions to provide options.
 func Sign(key *PrivateKey, hash []byte) *Signature {
-	return signRFC6979(key, hash)
+	return SignWithOptions(key, hash)
 }
 
-const (
-	// compactSigSize is the size of a compact signature.  It consists of a
-	// compact signature recovery code byte followed by the R and S components
-	// serialized as 32-byte big-endian values. 1+32*2 = 65.
-	// for the R and S components. 1+32+32=65.
-	compactSigSize = 65
+// SignWithOptions generates the same ECDSA signature as Sign with the provided
+// options applied.
+//
+// A Context may be provided via the WithContext option to blind the scalar
+// multiplication involving the nonce.  The produced signature is the same
+// either way.
+//
+// The WithHedging, WithExtraEntropy, and WithLowR options may be provided to
+// mix randomness or additional data into the nonce and to produce signatures
+// with low R values, respectively.  The WithHash option may be provided to
+// generate the nonce with the hash function that produced the hash.
+//
+// SignWithOptions panics when the hash function specified via WithHash is not
+// available or the hash is not the size of its output and in the unlikely event
+// reading the randomness for hedging fails rather than producing a signature
+// without it.  Use SignErr to handle those cases as errors instead.
+func SignWithOptions(key *PrivateKey, hash []byte, opts ...Option) *Signature {
+	sig, err := SignErr(key, hash, opts...)
+	if err != nil {
+		panic("secp256k1: " + err.Error())
+	}
+	return sig
+}
+
+// SignErr generates the same ECDSA signature as SignWithOptions except that it
+// returns an error instead of panicking when the hash function specified via
+// WithHash is not available or reading the randomness for hedging fails.
+//
+// It also returns an error with the kind ErrInvalidHashLen when the hash is not
+// the size of the output of the hash function specified via WithHash.
+func SignErr(key *PrivateKey, hash []byte, opts ...Option) (*Signature, error) {
+	o := applyOptions(opts)
+	if o.hash != 0 {
+		if !o.hash.Available() {
+			return nil, fmt.Errorf("hash function %v is not available", o.hash)
+		}
+		if len(hash) != o.hash.Size() {
+			str := fmt.Sprintf("wrong size for %v digest (got %v, want %v)",
+				o.hash, len(hash), o.hash.Size())
+			return nil, signatureError(ErrInvalidHashLen, str)
+		}
+	}
+	var buf [32]byte
+	extra, err := nonceExtraData(o.extraEntropy, o.hedgeRand, &buf)
+	if err != nil {
+		return nil, err
+	}
+	sig := signRFC6979(key, hash, o.hash, extra, o.lowR, o.ctx)
+	zeroArray32(&buf)
+	return sig, nil
+}
 
+const (
 	// compactSigMagicOffset is a value used when creating the compact signature
 	// recovery code inherited from Bitcoin and has no meaning, but has been
 	// retained for compatibility.  For historical purposes, it was originally
@@ -917,7 +1149,7 @@ const (
 func SignCompact(key *PrivateKey, hash []byte, isCompressedKey bool) []byte {
 	// Create the signature and associated pubkey recovery code and calculate
 	// the compact signature recovery code.
-	sig := signRFC6979(key, hash)
+	sig := signRFC6979(key, hash, 0, nil, false, nil)
 	compactSigRecoveryCode := byte(compactSigMagicOffset)
 	if isCompressedKey {
 		compactSigRecoveryCode += compactSigCompPubKey
@@ -944,6 +1176,65 @@ func RecoverCompact(signature, hash []byte) (*PublicKey, bool, error) {
 	return pk, wasCompressed, err
 }
 
+// recoverRPoint recovers the random point used when creating the signature
+// from its R component and pubkey recovery code, which MUST be present, and
+// stores it in the provided Jacobian point in affine coordinates.  This
+// corresponds to steps 2-5 of the public key recovery described in
+// RecoverPublicKey.
+func (sig *Signature) recoverRPoint(X *JacobianPoint) error {
+	// Step 2.
+	//
+	// Convert r to integer mod P.
+	fieldR := modNScalarToField(&sig.r)
+
+	// Step 3.
+	//
+	// If pubkey recovery code overflow bit is set:
+	if sig.v&pubKeyRecoveryCodeOverflowBit != 0 {
+		// Step 3.1.
+		//
+		// Fail if r + N >= P
+		//
+		// Either the signature or the recovery code must be invalid if the
+		// recovery code overflow bit is set and adding N to the R component
+		// would exceed the field prime since R originally came from the X
+		// coordinate of a random point on the curve.
+		if fieldR.IsGtOrEqPrimeMinusOrder() {
+			str := "invalid signature: signature R + N >= P"
+			return signatureError(ErrSigOverflowsPrime, str)
+		}
+
+		// Step 3.2.
+		//
+		// r = r + N (mod P)
+		fieldR.Add(&orderAsFieldVal)
+	}
+
+	// Step 4.
+	//
+	// y = +sqrt(r^3 + 7) (mod P)
+	// Fail if y does not exist.
+	// y = -y if needed to match pubkey recovery code oddness bit
+	//
+	// The signature must be invalid if the calculation fails because the X
+	// coord originally came from a random point on the curve which means there
+	// must be a Y coord that satisfies the equation for a valid signature.
+	oddY := sig.v&pubKeyRecoveryCodeOddnessBit != 0
+	var y FieldVal
+	if valid := DecompressY(&fieldR, oddY, &y); !valid {
+		str := "invalid signature: not for a valid curve point"
+		return signatureError(ErrPointNotOnCurve, str)
+	}
+
+	// Step 5.
+	//
+	// X = (r, y)
+	X.X.Set(fieldR.Normalize())
+	X.Y.Set(y.Normalize())
+	X.Z.SetInt(1)
+	return nil
+}
+
 // RecoverPublicKey recovers the public key
 func (sig *Signature) RecoverPublicKey(hash []byte) (*PublicKey, error) {
 	// The following is very loosely based on the information and algorithm that
@@ -1013,63 +1304,19 @@ func (sig *Signature) RecoverPublicKey(hash []byte) (*PublicKey, error) {
 		return nil, signatureError(ErrSigInvalidRecoveryCode, "cannot recover public key without recovery code")
 	}
 
-	// Step 2.
-	//
-	// Convert r to integer mod P.
-	fieldR := modNScalarToField(&sig.r)
-
-	// Step 3.
-	//
-	// If pubkey recovery code overflow bit is set:
-	if sig.v&pubKeyRecoveryCodeOverflowBit != 0 {
-		// Step 3.1.
-		//
-		// Fail if r + N >= P
-		//
-		// Either the signature or the recovery code must be invalid if the
-		// recovery code overflow bit is set and adding N to the R component
-		// would exceed the field prime since R originally came from the X
-		// coordinate of a random point on the curve.
-		if fieldR.IsGtOrEqPrimeMinusOrder() {
-			str := "invalid signature: signature R + N >= P"
-			return nil, signatureError(ErrSigOverflowsPrime, str)
-		}
-
-		// Step 3.2.
-		//
-		// r = r + N (mod P)
-		fieldR.Add(&orderAsFieldVal)
-	}
-
-	// Step 4.
-	//
-	// y = +sqrt(r^3 + 7) (mod P)
-	// Fail if y does not exist.
-	// y = -y if needed to match pubkey recovery code oddness bit
+	// Steps 2-5.
 	//
-	// The signature must be invalid if the calculation fails because the X
-	// coord originally came from a random point on the curve which means there
-	// must be a Y coord that satisfies the equation for a valid signature.
-	oddY := sig.v&pubKeyRecoveryCodeOddnessBit != 0
-	var y FieldVal
-	if valid := DecompressY(&fieldR, oddY, &y); !valid {
-		str := "invalid signature: not for a valid curve point"
-		return nil, signatureError(ErrPointNotOnCurve, str)
-	}
-
-	// Step 5.
-	//
-	// X = (r, y)
+	// X = random point recovered from r and the pubkey recovery code.
 	var X JacobianPoint
-	X.X.Set(fieldR.Normalize())
-	X.Y.Set(y.Normalize())
-	X.Z.SetInt(1)
+	if err := sig.recoverRPoint(&X); err != nil {
+		return nil, err
+	}
 
 	// Step 6.
 	//
-	// e = H(m) mod N
+	// e = bits2int(H(m)) mod N
 	var e ModNScalar
-	e.SetByteSlice(hash)
+	bits2int(hash, &e)
 
 	// Step 7.
 	//
@@ -1086,10 +1333,8 @@ func (sig *Signature) RecoverPublicKey(hash []byte) (*PublicKey, error) {
 	// Step 9.
 	//
 	// Q = u1G + u2X
-	var Q, u1G, u2X JacobianPoint
-	ScalarBaseMultNonConst(u1, &u1G)
-	ScalarMultNonConst(u2, &X, &u2X)
-	AddNonConst(&u1G, &u2X, &Q)
+	var Q JacobianPoint
+	DoubleScalarMultNonConst(u1, u2, &X, &Q)
 
 	// Step 10.
 	//
@@ -1103,7 +1348,111 @@ func (sig *Signature) RecoverPublicKey(hash []byte) (*PublicKey, error) {
 	}
 
 	// Notice that the public key is in affine coordinates.
-	Q.ToAffine()
+	Q.ToAffineNonConst()
 	pubKey := NewPublicKey(&Q.X, &Q.Y)
 	return pubKey, nil
 }
+
+// RecoveredKey houses a candidate public key recovered from a signature along
+// with the pubkey recovery code that identifies it.
+type RecoveredKey struct {
+	PubKey       *PublicKey
+	RecoveryCode byte
+}
+
+// RecoverCandidates returns every public key the signature is valid for with
+// the provided hash along with the pubkey recovery code that identifies each
+// of them.  Unlike RecoverPublicKey, it does not require the signature to have
+// a pubkey recovery code, which makes it useful for determining which public
+// keys could have produced a signature in a format that lacks one, such as
+// DER.
+//
+// There are up to four candidates, which are returned in order of their
+// recovery codes.  Typically, there are two candidates, however, there are
+// four when R + N is less than the field prime P and both R and R + N are
+// valid x coordinates, and there may also be none at all for invalid
+// signatures.
+//
+// This is more efficient than calling RecoverPublicKey with each possible
+// recovery code since the calculations that do not depend on the code are
+// shared among the candidates.
+func (sig *Signature) RecoverCandidates(hash []byte) []RecoveredKey {
+	// The candidates are calculated the same way as described by
+	// RecoverPublicKey, however, the following is noted:
+	//
+	// - The u1G term of Q = u1G + u2X does not depend on X
+	// - The candidates for X with the same x coordinate are X and -X
+	// - u2(-X) = -(u2X)
+	//
+	// Therefore, u1G is calculated once and the candidates for each x
+	// coordinate are u1G + u2X and u1G - u2X, which only requires a single
+	// scalar multiplication per x coordinate.  All of the candidates are then
+	// converted to affine with a single field inversion.
+	if sig.r.IsZero() || sig.s.IsZero() {
+		return nil
+	}
+
+	// e = bits2int(H(m)) mod N
+	// w = r^-1 mod N
+	// u1 = -(e * w) mod N
+	// u2 = s * w mod N
+	var e ModNScalar
+	bits2int(hash, &e)
+	w := new(ModNScalar).InverseValNonConst(&sig.r)
+	u1 := new(ModNScalar).Mul2(&e, w).Negate()
+	u2 := new(ModNScalar).Mul2(&sig.s, w)
+
+	var u1G JacobianPoint
+	ScalarBaseMultNonConst(u1, &u1G)
+
+	var candidates [4]JacobianPoint
+	var codes [4]byte
+	var numCandidates int
+	fieldR := modNScalarToField(&sig.r)
+	for _, overflow := range []bool{false, true} {
+		// x = r or r + N when it is less than P.
+		x := fieldR
+		var code byte
+		if overflow {
+			if fieldR.IsGtOrEqPrimeMinusOrder() {
+				break
+			}
+			x.Add(&orderAsFieldVal)
+			code = pubKeyRecoveryCodeOverflowBit
+		}
+
+		// X = (x, y) with the even y, which must exist.
+		var X JacobianPoint
+		if !DecompressY(&x, false, &X.Y) {
+			continue
+		}
+		X.X.Set(x.Normalize())
+		X.Y.Normalize()
+		X.Z.SetInt(1)
+
+		// Q = u1G + u2X for the even y and u1G - u2X for the odd y.
+		var u2X JacobianPoint
+		ScalarMultNonConst(u2, &X, &u2X)
+		AddNonConst(&u1G, &u2X, &candidates[numCandidates])
+		SubNonConst(&u1G, &u2X, &candidates[numCandidates+1])
+		codes[numCandidates] = code
+		codes[numCandidates+1] = code | pubKeyRecoveryCodeOddnessBit
+		numCandidates += 2
+	}
+
+	// Convert the candidates to affine and skip any that are the point at
+	// infinity.
+	batchToAffineNonConst(candidates[:numCandidates])
+	keys := make([]RecoveredKey, 0, numCandidates)
+	for i := 0; i < numCandidates; i++ {
+		Q := &candidates[i]
+		if Q.IsInfinity() {
+			continue
+		}
+		keys = append(keys, RecoveredKey{
+			PubKey:       NewPublicKey(&Q.X, &Q.Y),
+			RecoveryCode: codes[i],
+		})
+	}
+	return keys
+}
@@ -187,12 +187,35 @@ func TestSignatureParsing(t *testing.T) {
 	}}
 
 	for _, test := range tests {
-		_, err := ParseDERSignature(test.sig)
+		sig, err := ParseDERSignature(test.sig)
 		if !errors.Is(err, test.err) {
 			t.Errorf("%s mismatched err -- got %v, want %v", test.name, err,
 				test.err)
 			continue
 		}
+
+		// Ensure parsing into an existing signature produces the same result
+		// and does not modify it on failure.
+		existing := NewSignatureWithRecoveryCode(new(ModNScalar).SetInt(1),
+			new(ModNScalar).SetInt(2), 1)
+		orig := *existing
+		intoErr := existing.ParseDERInto(test.sig)
+		if !errors.Is(intoErr, test.err) {
+			t.Errorf("%s mismatched ParseDERInto err -- got %v, want %v",
+				test.name, intoErr, test.err)
+			continue
+		}
+		if err != nil {
+			if *existing != orig {
+				t.Errorf("%s: ParseDERInto modified signature on failure",
+					test.name)
+			}
+			continue
+		}
+		if *existing != *sig {
+			t.Errorf("%s: mismatched ParseDERInto signature", test.name)
+			continue
+		}
 	}
 }
 
@@ -255,6 +278,14 @@ func TestSignatureSerialize(t *testing.T) {
 				"got:  %x\nwant: %x", i, test.name, result,
 				test.expected)
 		}
+
+		prefix := []byte{0x01, 0x02}
+		appended := test.ecsig.AppendSerialized(prefix)
+		if !bytes.Equal(appended, append(prefix, test.expected...)) {
+			t.Errorf("AppendSerialized #%d (%s) unexpected result:\n"+
+				"got:  %x\nwant: %x%x", i, test.name, appended, prefix,
+				test.expected)
+		}
 	}
 }
 
@@ -513,7 +544,7 @@ func TestSignAndVerify(t *testing.T) {
 		wantSig := NewSignature(wantSigR, wantSigS).Serialize()
 
 		// Sign the hash of the message with the given private key and nonce.
-		gotSig, success := sign(&privKey.Key, nonce, hash)
+		gotSig, success := sign(&privKey.Key, nonce, hash, nil)
 		if !success {
 			t.Errorf("%s: unexpected error when signing", test.name)
 			continue
@@ -559,6 +590,10 @@ func TestSignAndVerify(t *testing.T) {
 			t.Errorf("%s: signature failed to verify", test.name)
 			continue
 		}
+		if err := gotSig.VerifyErr(hash, pubKey); err != nil {
+			t.Errorf("%s: signature failed to verify: %v", test.name, err)
+			continue
+		}
 
 		// Attempt to re-generate recovery code, make sure it is still the same
 		if !gotSig.BruteforceRecoveryCode(hash, pubKey) {
@@ -694,7 +729,7 @@ func TestSignFailures(t *testing.T) {
 		nonce := hexToModNScalar(test.nonce)
 
 		// Ensure the signing is NOT successful.
-		sig, success := sign(privKey, nonce, hash)
+		sig, success := sign(privKey, nonce, hash, nil)
 		if success {
 			t.Errorf("%s: unexpected success -- got sig %x", test.name,
 				sig.Serialize())
@@ -704,7 +739,7 @@ func TestSignFailures(t *testing.T) {
 }
 
 // TestVerifyFailures ensures the ECDSA verification function returns an
-// unsuccessful result for edge conditions.
+// unsuccessful result along with the expected error for edge conditions.
 func TestVerifyFailures(t *testing.T) {
 	t.Parallel()
 
@@ -713,30 +748,53 @@ func TestVerifyFailures(t *testing.T) {
 		key  string // hex encoded private key
 		hash string // hex encoded hash of the message to sign
 		r, s string // hex encoded r and s components of signature to verify
+		pubX string // optional hex encoded x coordinate of pubkey to use
+		pubY string // optional hex encoded y coordinate of pubkey to use
+		err  error  // expected error
 	}{{
 		name: "signature R is 0",
 		key:  "0000000000000000000000000000000000000000000000000000000000000001",
 		hash: "c301ba9de5d6053caad9f5eb46523f007702add2c62fa39de03146a36b8026b7",
 		r:    "0000000000000000000000000000000000000000000000000000000000000000",
 		s:    "00ba213513572e35943d5acdd17215561b03f11663192a7252196cc8b2a99560",
+		err:  ErrSigRIsZero,
 	}, {
 		name: "signature S is 0",
 		key:  "0000000000000000000000000000000000000000000000000000000000000001",
 		hash: "c301ba9de5d6053caad9f5eb46523f007702add2c62fa39de03146a36b8026b7",
 		r:    "c6c4137b0e5fbfc88ae3f293d7e80c8566c43ae20340075d44f75b009c943d09",
 		s:    "0000000000000000000000000000000000000000000000000000000000000000",
+		err:  ErrSigSIsZero,
 	}, {
 		name: "u1G + u2Q is the point at infinity",
 		key:  "0000000000000000000000000000000000000000000000000000000000000001",
 		hash: "c301ba9de5d6053caad9f5eb46523f007702add2c62fa39de03146a36b8026b7",
 		r:    "3cfe45621a29fac355260a14b9adc0fe43ac2f13e918fc9ddfa117e964b61a8a",
 		s:    "00ba213513572e35943d5acdd17215561b03f11663192a7252196cc8b2a99560",
+		err:  ErrSigInfinityResult,
 	}, {
 		name: "signature R < P-N, but invalid",
 		key:  "0000000000000000000000000000000000000000000000000000000000000001",
 		hash: "c301ba9de5d6053caad9f5eb46523f007702add2c62fa39de03146a36b8026b7",
 		r:    "000000000000000000000000000000014551231950b75fc4402da1722fc9baed",
 		s:    "00ba213513572e35943d5acdd17215561b03f11663192a7252196cc8b2a99560",
+		err:  ErrSigUnequalRValues,
+	}, {
+		name: "signature R >= P-N and invalid",
+		key:  "0000000000000000000000000000000000000000000000000000000000000001",
+		hash: "c301ba9de5d6053caad9f5eb46523f007702add2c62fa39de03146a36b8026b7",
+		r:    "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364140",
+		s:    "00ba213513572e35943d5acdd17215561b03f11663192a7252196cc8b2a99560",
+		err:  ErrSigUnequalRValues,
+	}, {
+		name: "pubkey not on curve",
+		key:  "0000000000000000000000000000000000000000000000000000000000000001",
+		hash: "c301ba9de5d6053caad9f5eb46523f007702add2c62fa39de03146a36b8026b7",
+		r:    "c6c4137b0e5fbfc88ae3f293d7e80c8566c43ae20340075d44f75b009c943d09",
+		s:    "00ba213513572e35943d5acdd17215561b03f11663192a7252196cc8b2a99560",
+		pubX: "0000000000000000000000000000000000000000000000000000000000000001",
+		pubY: "0000000000000000000000000000000000000000000000000000000000000001",
+		err:  ErrPubKeyNotOnCurve,
 	}}
 
 	for _, test := range tests {
@@ -746,13 +804,24 @@ func TestVerifyFailures(t *testing.T) {
 		s := hexToModNScalar(test.s)
 		sig := NewSignature(r, s)
 
-		// Ensure the verification is NOT successful.
+		// Ensure the verification is NOT successful and fails with the
+		// expected error.
 		pubKey := NewPrivateKey(privKey).PubKey()
+		if test.pubX != "" {
+			pubKey = NewPublicKey(hexToFieldVal(test.pubX),
+				hexToFieldVal(test.pubY))
+		}
 		if sig.Verify(hash, pubKey) {
 			t.Errorf("%s: unexpected success for invalid signature: %x",
 				test.name, sig.Serialize())
 			continue
 		}
+		err := sig.VerifyErr(hash, pubKey)
+		if !errors.Is(err, test.err) {
+			t.Errorf("%s: mismatched err -- got %v, want %v", test.name, err,
+				test.err)
+			continue
+		}
 	}
 }
 
@@ -1163,3 +1232,205 @@ func TestNewSignatureWithRecoveryCodeValidation(t *testing.T) {
 		NewSignatureWithRecoveryCode(&r, &s, 4)
 	}()
 }
+
+// TestExportCompact ensures that exporting signatures in the compact format
+// works as expected for both the regular and put and appended variants,
+// including for signatures with an S component that is over the half order.
+func TestExportCompact(t *testing.T) {
+	t.Parallel()
+
+	rng := rand.New(rand.NewSource(1))
+	for _, item := range randBatchItems(t, rng, 4) {
+		sig := item.Signature
+		wantPubKey := item.PubKey.SerializeCompressed()
+
+		// Create the equally valid high S variant of the signature, which
+		// has the opposite recovery code oddness.
+		highS := *sig
+		highS.s.Negate()
+		highS.v ^= pubKeyRecoveryCodeOddnessBit
+
+		for _, recoveryCodeFirst := range []bool{true, false} {
+			want := sig.ExportCompact(recoveryCodeFirst, compactSigMagicOffset)
+			got := highS.ExportCompact(recoveryCodeFirst, compactSigMagicOffset)
+			if !bytes.Equal(got, want) {
+				t.Fatalf("mismatched high S compact signature -- got %x, "+
+					"want %x", got, want)
+			}
+
+			var put [CompactSigSize]byte
+			highS.PutCompact(&put, recoveryCodeFirst, compactSigMagicOffset)
+			if !bytes.Equal(put[:], want) {
+				t.Fatalf("mismatched put compact signature -- got %x, "+
+					"want %x", put, want)
+			}
+			appended := sig.AppendCompact([]byte{0x01}, recoveryCodeFirst,
+				compactSigMagicOffset)
+			if !bytes.Equal(appended, append([]byte{0x01}, want...)) {
+				t.Fatalf("mismatched appended compact signature -- got %x, "+
+					"want 01%x", appended, want)
+			}
+		}
+
+		// Ensure the public key is recovered from the compact signature
+		// exported from the high S variant.
+		pubKey, _, err := RecoverCompact(highS.ExportCompact(true,
+			compactSigMagicOffset), item.Hash)
+		if err != nil {
+			t.Fatalf("unexpected error recovering public key: %v", err)
+		}
+		if got := pubKey.SerializeCompressed(); !bytes.Equal(got, wantPubKey) {
+			t.Fatalf("mismatched recovered public key -- got %x, want %x",
+				got, wantPubKey)
+		}
+	}
+}
+
+// TestAppendNoAllocs ensures the serialization and parsing functions that
+// write into caller provided buffers and structs do not allocate.
+func TestAppendNoAllocs(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	item := randBatchItems(t, rng, 1)[0]
+	privKey := NewPrivateKey(hexToModNScalar("01"))
+	sig, pubKey := item.Signature, item.PubKey
+	der := sig.Serialize()
+	pubKeyBytes := pubKey.SerializeCompressed()
+
+	buf := make([]byte, 0, 128)
+	var parsedSig Signature
+	var parsedPubKey PublicKey
+	tests := []struct {
+		name string
+		f    func()
+	}{
+		{"AppendCompressed", func() { pubKey.AppendCompressed(buf) }},
+		{"AppendUncompressed", func() { pubKey.AppendUncompressed(buf) }},
+		{"AppendSerialized", func() { sig.AppendSerialized(buf) }},
+		{"AppendCompact", func() { sig.AppendCompact(buf, true, 27) }},
+		{"PrivateKey.AppendSerialized", func() { privKey.AppendSerialized(buf) }},
+		{"AppendSharedSecret", func() { AppendSharedSecret(buf, privKey, pubKey) }},
+		{"ParseInto", func() { _ = parsedPubKey.ParseInto(pubKeyBytes) }},
+		{"ParseDERInto", func() { _ = parsedSig.ParseDERInto(der) }},
+	}
+	for _, test := range tests {
+		if allocs := testing.AllocsPerRun(10, test.f); allocs != 0 {
+			t.Errorf("%s: unexpected allocations -- got %v, want 0", test.name,
+				allocs)
+		}
+	}
+}
+
+// checkRecoverCandidates ensures that every candidate returned by
+// RecoverCandidates for the passed signature and hash verifies, has a unique
+// recovery code in order, and matches the public key RecoverPublicKey recovers
+// with that recovery code.
+func checkRecoverCandidates(t *testing.T, sig *Signature, hash []byte) []RecoveredKey {
+	t.Helper()
+
+	candidates := sig.RecoverCandidates(hash)
+	for i, candidate := range candidates {
+		if i > 0 && candidate.RecoveryCode <= candidates[i-1].RecoveryCode {
+			t.Fatalf("candidate %d recovery code %d out of order", i,
+				candidate.RecoveryCode)
+		}
+		if !sig.Verify(hash, candidate.PubKey) {
+			t.Fatalf("candidate %d with recovery code %d does not verify", i,
+				candidate.RecoveryCode)
+		}
+
+		withCode := *sig
+		withCode.v = candidate.RecoveryCode
+		pubKey, err := withCode.RecoverPublicKey(hash)
+		if err != nil {
+			t.Fatalf("failed to recover public key with recovery code %d: %v",
+				candidate.RecoveryCode, err)
+		}
+		if !pubKey.IsEqual(candidate.PubKey) {
+			t.Fatalf("candidate %d with recovery code %d does not match "+
+				"recovered public key", i, candidate.RecoveryCode)
+		}
+	}
+	return candidates
+}
+
+// TestRecoverCandidates ensures that recovering the candidate public keys of
+// signatures returns the public key that produced the signature along with
+// its recovery code, that every candidate is valid, and that the candidates
+// with x coordinates that overflow the group order are handled.
+func TestRecoverCandidates(t *testing.T) {
+	t.Parallel()
+
+	// Use a unique random seed each test instance and log it if the tests fail.
+	seed := time.Now().Unix()
+	rng := rand.New(rand.NewSource(seed))
+	defer func(t *testing.T, seed int64) {
+		if t.Failed() {
+			t.Logf("random seed: %d", seed)
+		}
+	}(t, seed)
+
+	// Ensure the candidates of random signatures include the public key that
+	// produced them with the expected recovery code, including when the
+	// signature does not have a recovery code.
+	for i, item := range randBatchItems(t, rng, 50) {
+		wantCode, err := item.Signature.RecoveryCode()
+		if err != nil {
+			t.Fatalf("signature %d has no recovery code: %v", i, err)
+		}
+		noCode := NewSignature(&item.Signature.r, &item.Signature.s)
+		candidates := checkRecoverCandidates(t, noCode, item.Hash)
+		if len(candidates) != 2 {
+			t.Fatalf("signature %d: unexpected number of candidates -- got %d, "+
+				"want 2", i, len(candidates))
+		}
+		var found bool
+		for _, candidate := range candidates {
+			if candidate.PubKey.IsEqual(item.PubKey) {
+				found = candidate.RecoveryCode == wantCode
+			}
+		}
+		if !found {
+			t.Fatalf("signature %d: public key with recovery code %d not found",
+				i, wantCode)
+		}
+	}
+
+	// Ensure signatures with R values small enough that R + N is less than P
+	// produce the candidates for both x coordinates when both of them are on
+	// the curve.
+	hash := hexToBytes("c301ba9de5d6053caad9f5eb46523f007702add2c62fa39de03146a36b8026b7")
+	s := hexToModNScalar("181522ec8eca07de4860a4acdd12909d831cc56cbbac4622082221a8768d1d09")
+	var numWithOverflow int
+	for r := uint32(1); r <= 20; r++ {
+		sig := NewSignature(new(ModNScalar).SetInt(r), s)
+		candidates := checkRecoverCandidates(t, sig, hash)
+		for _, candidate := range candidates {
+			if candidate.RecoveryCode&pubKeyRecoveryCodeOverflowBit != 0 {
+				numWithOverflow++
+			}
+		}
+		if len(candidates) == 4 {
+			return
+		}
+	}
+	t.Fatalf("no signature with four candidates (%d with overflow)",
+		numWithOverflow)
+}
+
+// TestRecoverCandidatesInvalid ensures that signatures with zero R or S values
+// have no candidates.
+func TestRecoverCandidatesInvalid(t *testing.T) {
+	t.Parallel()
+
+	var zero ModNScalar
+	one := new(ModNScalar).SetInt(1)
+	hash := hexToBytes("c301ba9de5d6053caad9f5eb46523f007702add2c62fa39de03146a36b8026b7")
+	for _, sig := range []*Signature{NewSignature(&zero, one),
+		NewSignature(one, &zero)} {
+
+		if candidates := sig.RecoverCandidates(hash); len(candidates) != 0 {
+			t.Fatalf("unexpected candidates for invalid signature: %d",
+				len(candidates))
+		}
+	}
+}
@@ -0,0 +1,17 @@
+/*
+Package sigverify provides parallel verification of streams of ECDSA and
+Schnorr signatures over secp256k1.
+
+The Verifier type accepts a stream of verification jobs, each of which consists
+of either an ECDSA or Schnorr signature along with the hash and public key it is
+to be verified against, and fans them out over a configurable number of
+goroutines.  Jobs that arrive together are grouped into batches by signature
+algorithm and verified via the batch verification provided by the secp256k1 and
+schnorr packages, which is faster than verifying them individually by an amount
+that grows with the size of the batch.
+
+The result of every job is reported on a channel along with the index of the
+job in the stream.  Verification honors the cancellation of the provided
+context and may optionally stop at the first invalid signature.
+*/
+package sigverify
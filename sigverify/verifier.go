@@ -0,0 +1,343 @@
+package sigverify
+
+import (
+	"context"
+	"runtime"
+	"sync"
+
+	"github.com/KarpelesLab/secp256k1"
+	"github.com/KarpelesLab/secp256k1/schnorr"
+)
+
+// DefaultMaxBatchSize is the maximum number of jobs that are verified together
+// as a batch when no maximum is specified.
+const DefaultMaxBatchSize = 64
+
+// Job houses a signature along with the hash and public key it is to be
+// verified against.
+type Job struct {
+	// ECDSA and Schnorr are the signature to verify.  Exactly one of them must
+	// be set, otherwise the job is not valid.
+	ECDSA   *secp256k1.Signature
+	Schnorr *schnorr.Signature
+
+	// Hash is the hash the signature is to be verified against.
+	Hash []byte
+
+	// PubKey is the public key the signature is to be verified against.  The
+	// job is not valid when it is nil.
+	PubKey *secp256k1.PublicKey
+}
+
+// Result houses the result of verifying a job.
+type Result struct {
+	// Index is the index of the job in the stream of jobs, starting from zero.
+	Index int
+
+	// Job is the job that was verified.
+	Job Job
+
+	// Valid is whether or not the signature of the job is valid for its hash
+	// and public key.
+	Valid bool
+}
+
+// Config houses the parameters of a verifier.
+type Config struct {
+	// Workers is the number of goroutines that verify the jobs.  It defaults
+	// to the number of logical CPUs when it is not positive.
+	Workers int
+
+	// MaxBatchSize is the maximum number of jobs that are verified together
+	// as a batch.  It defaults to DefaultMaxBatchSize when it is not positive.
+	MaxBatchSize int
+
+	// StopOnFailure stops the verification once any invalid signature is
+	// found.
+	StopOnFailure bool
+}
+
+// Verifier verifies streams of ECDSA and Schnorr signatures in parallel.
+//
+// A verifier is safe for concurrent use and may be used to verify any number
+// of streams.
+type Verifier struct {
+	workers       int
+	maxBatchSize  int
+	stopOnFailure bool
+}
+
+// New returns a new verifier with the provided configuration.
+func New(cfg Config) *Verifier {
+	v := &Verifier{
+		workers:       cfg.Workers,
+		maxBatchSize:  cfg.MaxBatchSize,
+		stopOnFailure: cfg.StopOnFailure,
+	}
+	if v.workers <= 0 {
+		v.workers = runtime.NumCPU()
+	}
+	if v.maxBatchSize <= 0 {
+		v.maxBatchSize = DefaultMaxBatchSize
+	}
+	return v
+}
+
+// algorithm identifies the signature algorithm of a job.
+type algorithm int
+
+const (
+	// algECDSA and algSchnorr identify jobs with ECDSA and Schnorr
+	// signatures, respectively.
+	algECDSA algorithm = iota
+	algSchnorr
+
+	// algInvalid identifies jobs that are not valid because they have no
+	// signature, multiple signatures, or no public key.
+	algInvalid
+
+	// numAlgorithms is the number of algorithms.
+	numAlgorithms
+)
+
+// jobAlgorithm returns the signature algorithm of the passed job.
+func jobAlgorithm(job *Job) algorithm {
+	switch {
+	case job.PubKey == nil:
+		return algInvalid
+	case job.ECDSA != nil && job.Schnorr == nil:
+		return algECDSA
+	case job.Schnorr != nil && job.ECDSA == nil:
+		return algSchnorr
+	}
+	return algInvalid
+}
+
+// indexedJob houses a job along with its index in the stream of jobs.
+type indexedJob struct {
+	index int
+	job   Job
+}
+
+// batch houses jobs with the same signature algorithm that are verified
+// together.
+type batch struct {
+	alg  algorithm
+	jobs []indexedJob
+}
+
+// verify returns whether or not the signature of each job in the batch is
+// valid.
+func (b *batch) verify() []bool {
+	valid := make([]bool, len(b.jobs))
+	switch b.alg {
+	case algECDSA:
+		if len(b.jobs) == 1 {
+			job := &b.jobs[0].job
+			valid[0] = job.ECDSA.Verify(job.Hash, job.PubKey)
+			return valid
+		}
+
+		items := make([]secp256k1.BatchItem, len(b.jobs))
+		for i := range b.jobs {
+			job := &b.jobs[i].job
+			items[i] = secp256k1.BatchItem{
+				Signature: job.ECDSA,
+				Hash:      job.Hash,
+				PubKey:    job.PubKey,
+			}
+		}
+		_, failed := secp256k1.VerifyBatch(items)
+		for i := range valid {
+			valid[i] = true
+		}
+		for _, i := range failed {
+			valid[i] = false
+		}
+
+	case algSchnorr:
+		if len(b.jobs) == 1 {
+			job := &b.jobs[0].job
+			valid[0] = job.Schnorr.Verify(job.Hash, job.PubKey)
+			return valid
+		}
+
+		items := make([]schnorr.BatchItem, len(b.jobs))
+		for i := range b.jobs {
+			job := &b.jobs[i].job
+			items[i] = schnorr.BatchItem{
+				Signature: job.Schnorr,
+				Hash:      job.Hash,
+				PubKey:    job.PubKey,
+			}
+		}
+		_, failed := schnorr.VerifyBatch(items)
+		for i := range valid {
+			valid[i] = true
+		}
+		for _, i := range failed {
+			valid[i] = false
+		}
+	}
+
+	return valid
+}
+
+// Verify starts verifying the signatures of the jobs received from the
+// provided channel and returns a channel that receives the result of each of
+// them.  The results are not necessarily in the same order as the jobs, so
+// they include the index of the job in the stream.
+//
+// The jobs that are available together are grouped into batches of up to the
+// configured maximum size by signature algorithm and each batch is verified by
+// one of the configured number of goroutines via batch verification.  A batch
+// is also started whenever no more jobs are immediately available so that the
+// latency of slow streams is not affected.
+//
+// The results channel is closed once the results of all jobs have been sent
+// after the jobs channel is closed.  It is also closed early when the provided
+// context is canceled or, when the verifier is configured to stop on failure,
+// once an invalid signature is found.  In either case, the remaining jobs are
+// not verified and their results are not sent, although the results of jobs
+// that were already being verified concurrently may still be sent.
+//
+// When the verification stops due to an invalid signature, the remaining jobs
+// are received and discarded until the jobs channel is closed or the provided
+// context is canceled so that senders do not block.  Otherwise, senders should
+// also stop once the provided context is canceled.
+//
+// The caller must receive from the results channel until it is closed or
+// cancel the provided context.
+func (v *Verifier) Verify(ctx context.Context, jobs <-chan Job) <-chan Result {
+	verifyCtx, cancel := context.WithCancel(ctx)
+	batches := make(chan batch, v.workers)
+	results := make(chan Result, v.maxBatchSize)
+	go v.dispatch(ctx, verifyCtx, jobs, batches)
+
+	var wg sync.WaitGroup
+	wg.Add(v.workers)
+	for i := 0; i < v.workers; i++ {
+		go func() {
+			defer wg.Done()
+			v.work(verifyCtx, cancel, batches, results)
+		}()
+	}
+	go func() {
+		wg.Wait()
+		cancel()
+		close(results)
+	}()
+	return results
+}
+
+// dispatch groups the jobs received from the passed jobs channel into batches
+// and sends them to the passed batches channel until either the jobs channel
+// is closed or the verification context is canceled.
+//
+// Once the verification context is canceled while the parent context is not,
+// which happens when the verification stops on failure, the remaining jobs are
+// received and discarded so that senders do not block.
+func (v *Verifier) dispatch(ctx, verifyCtx context.Context, jobs <-chan Job, batches chan<- batch) {
+	var pending [numAlgorithms][]indexedJob
+	send := func(alg algorithm) bool {
+		if len(pending[alg]) == 0 {
+			return true
+		}
+		select {
+		case batches <- batch{alg: alg, jobs: pending[alg]}:
+			pending[alg] = nil
+			return true
+		case <-verifyCtx.Done():
+			return false
+		}
+	}
+	sendAll := func() bool {
+		for alg := algorithm(0); alg < numAlgorithms; alg++ {
+			if !send(alg) {
+				return false
+			}
+		}
+		return true
+	}
+
+loop:
+	for index := 0; verifyCtx.Err() == nil; index++ {
+		// Start verifying the pending jobs when no more jobs are immediately
+		// available.
+		var job Job
+		var ok bool
+		select {
+		case job, ok = <-jobs:
+		default:
+			if !sendAll() {
+				break loop
+			}
+			select {
+			case job, ok = <-jobs:
+			case <-verifyCtx.Done():
+			}
+		}
+		if verifyCtx.Err() != nil {
+			break loop
+		}
+		if !ok {
+			sendAll()
+			close(batches)
+			return
+		}
+
+		alg := jobAlgorithm(&job)
+		pending[alg] = append(pending[alg], indexedJob{index: index, job: job})
+		if len(pending[alg]) == v.maxBatchSize && !send(alg) {
+			break loop
+		}
+	}
+
+	// Discard the remaining jobs when the verification stopped due to an
+	// invalid signature.  The batches channel is closed first so the results
+	// channel is closed without waiting for the jobs channel to be closed.
+	close(batches)
+	for {
+		select {
+		case _, ok := <-jobs:
+			if !ok {
+				return
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// work verifies the batches received from the passed batches channel and
+// sends the results to the passed results channel until the batches channel is
+// closed.
+func (v *Verifier) work(ctx context.Context, cancel context.CancelFunc, batches <-chan batch, results chan<- Result) {
+	for b := range batches {
+		// Discard the remaining batches once the verification is canceled.
+		if ctx.Err() != nil {
+			continue
+		}
+
+		valid := b.verify()
+		var failed bool
+		for i := range b.jobs {
+			if ctx.Err() != nil {
+				break
+			}
+			failed = failed || !valid[i]
+			result := Result{
+				Index: b.jobs[i].index,
+				Job:   b.jobs[i].job,
+				Valid: valid[i],
+			}
+			select {
+			case results <- result:
+			case <-ctx.Done():
+			}
+		}
+		if failed && v.stopOnFailure {
+			cancel()
+		}
+	}
+}
@@ -0,0 +1,254 @@
+package sigverify
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/KarpelesLab/secp256k1"
+	"github.com/KarpelesLab/secp256k1/schnorr"
+)
+
+// randJobs returns the requested number of jobs with valid signatures by keys
+// generated from the passed random source.  The jobs cycle through ECDSA
+// signatures with and without recovery codes and Schnorr signatures, so the
+// job at index i uses the kind given by i modulo 3.
+func randJobs(t *testing.T, rng *rand.Rand, numJobs int) []Job {
+	t.Helper()
+
+	jobs := make([]Job, numJobs)
+	for i := range jobs {
+		privKey, err := secp256k1.GeneratePrivateKeyFromRand(rng)
+		if err != nil {
+			t.Fatalf("job %d: unexpected err: %v", i, err)
+		}
+		var hash [32]byte
+		rng.Read(hash[:])
+
+		job := Job{Hash: hash[:], PubKey: privKey.PubKey()}
+		switch i % 3 {
+		case 0:
+			job.ECDSA = secp256k1.Sign(privKey, hash[:])
+		case 1:
+			sig, err := secp256k1.ParseDERSignature(
+				secp256k1.Sign(privKey, hash[:]).Serialize())
+			if err != nil {
+				t.Fatalf("job %d: unexpected err: %v", i, err)
+			}
+			job.ECDSA = sig
+		case 2:
+			sig, err := schnorr.Sign(privKey, hash[:], "test")
+			if err != nil {
+				t.Fatalf("job %d: unexpected err: %v", i, err)
+			}
+			job.Schnorr = sig
+		}
+		jobs[i] = job
+	}
+	return jobs
+}
+
+// sendJobs returns a channel that receives the passed jobs and is closed once
+// all of them are sent.
+func sendJobs(jobs []Job) <-chan Job {
+	c := make(chan Job)
+	go func() {
+		for _, job := range jobs {
+			c <- job
+		}
+		close(c)
+	}()
+	return c
+}
+
+// TestVerifier ensures that the verifier reports the expected result for every
+// job in streams of mixed valid and invalid ECDSA and Schnorr signatures for
+// various configurations.
+func TestVerifier(t *testing.T) {
+	t.Parallel()
+
+	// Use a unique random seed each test instance and log it if the tests fail.
+	seed := time.Now().Unix()
+	rng := rand.New(rand.NewSource(seed))
+	defer func(t *testing.T, seed int64) {
+		if t.Failed() {
+			t.Logf("random seed: %d", seed)
+		}
+	}(t, seed)
+
+	// Create jobs with several types of invalid signatures in addition to the
+	// valid ones.
+	const numJobs = 150
+	jobs := randJobs(t, rng, numJobs)
+	wantValid := make([]bool, numJobs)
+	for i := range wantValid {
+		wantValid[i] = true
+	}
+	for _, i := range []int{3, 4, 5, 50, 51, 52, 53, 149} {
+		badHash := make([]byte, len(jobs[i].Hash))
+		copy(badHash, jobs[i].Hash)
+		badHash[rng.Intn(len(badHash))] ^= 1 << rng.Intn(8)
+		jobs[i].Hash = badHash
+		wantValid[i] = false
+	}
+	jobs[10].PubKey = nil
+	jobs[20].ECDSA, jobs[20].Schnorr = nil, nil
+	jobs[30].ECDSA = jobs[31].ECDSA
+	jobs[30].Schnorr = jobs[32].Schnorr
+	wantValid[10], wantValid[20], wantValid[30] = false, false, false
+
+	// Replace the public keys of one job of each kind with a point that is not
+	// on the curve and has the same x coordinate.
+	for _, i := range []int{60, 61, 62} {
+		var point secp256k1.JacobianPoint
+		jobs[i].PubKey.AsJacobian(&point)
+		point.Y.Add(new(secp256k1.FieldVal).SetInt(2)).Normalize()
+		jobs[i].PubKey = secp256k1.NewPublicKey(&point.X, &point.Y)
+		wantValid[i] = false
+	}
+
+	tests := []struct {
+		name string // test description
+		cfg  Config // verifier configuration
+	}{{
+		name: "defaults",
+	}, {
+		name: "single worker",
+		cfg:  Config{Workers: 1},
+	}, {
+		name: "small batches",
+		cfg:  Config{Workers: 4, MaxBatchSize: 3},
+	}, {
+		name: "no batches",
+		cfg:  Config{Workers: 2, MaxBatchSize: 1},
+	}}
+
+	for _, test := range tests {
+		verifier := New(test.cfg)
+		reported := make([]bool, numJobs)
+		results := verifier.Verify(context.Background(), sendJobs(jobs))
+		for result := range results {
+			if reported[result.Index] {
+				t.Fatalf("%q: job %d reported twice", test.name, result.Index)
+			}
+			reported[result.Index] = true
+			if result.Valid != wantValid[result.Index] {
+				t.Fatalf("%q: unexpected result for job %d -- got %v, want %v",
+					test.name, result.Index, result.Valid,
+					wantValid[result.Index])
+			}
+			if result.Job.PubKey != jobs[result.Index].PubKey {
+				t.Fatalf("%q: mismatched job for index %d", test.name,
+					result.Index)
+			}
+		}
+		for i := range reported {
+			if !reported[i] {
+				t.Fatalf("%q: job %d not reported", test.name, i)
+			}
+		}
+	}
+}
+
+// TestVerifierSlowStream ensures that the verifier reports the result of jobs
+// without waiting for enough jobs to fill a batch.
+func TestVerifierSlowStream(t *testing.T) {
+	t.Parallel()
+
+	rng := rand.New(rand.NewSource(1))
+	jobs := randJobs(t, rng, 6)
+	jobsChan := make(chan Job)
+	results := New(Config{Workers: 2}).Verify(context.Background(), jobsChan)
+	for i, job := range jobs {
+		jobsChan <- job
+		result := <-results
+		if result.Index != i || !result.Valid {
+			t.Fatalf("unexpected result for job %d -- got index %d, valid %v",
+				i, result.Index, result.Valid)
+		}
+	}
+	close(jobsChan)
+	if _, ok := <-results; ok {
+		t.Fatal("unexpected result after all jobs")
+	}
+}
+
+// TestVerifierStopOnFailure ensures that the verifier stops once an invalid
+// signature is found when configured to do so while still receiving the
+// remaining jobs so that senders do not block.
+func TestVerifierStopOnFailure(t *testing.T) {
+	t.Parallel()
+
+	rng := rand.New(rand.NewSource(1))
+	jobs := randJobs(t, rng, 12)
+	jobs[0].Hash = jobs[1].Hash
+
+	// Send the invalid job followed by many valid jobs without regard for
+	// whether the verifier stopped.
+	const numJobs = 500
+	jobsChan := make(chan Job)
+	sent := make(chan struct{})
+	go func() {
+		for i := 0; i < numJobs; i++ {
+			jobsChan <- jobs[i%len(jobs)]
+		}
+		close(jobsChan)
+		close(sent)
+	}()
+
+	verifier := New(Config{Workers: 2, MaxBatchSize: 4, StopOnFailure: true})
+	var numResults int
+	var sawFailure bool
+	for result := range verifier.Verify(context.Background(), jobsChan) {
+		numResults++
+		if result.Index == 0 {
+			if result.Valid {
+				t.Fatal("invalid job reported as valid")
+			}
+			sawFailure = true
+		}
+	}
+	if !sawFailure {
+		t.Fatal("invalid job not reported")
+	}
+	if numResults >= numJobs {
+		t.Fatalf("verifier did not stop -- %d results for %d jobs", numResults,
+			numJobs)
+	}
+
+	// Ensure the verifier receives the remaining jobs after it stops.
+	select {
+	case <-sent:
+	case <-time.After(10 * time.Second):
+		t.Fatal("remaining jobs not received after stopping")
+	}
+}
+
+// TestVerifierCancel ensures that the verifier stops once the provided
+// context is canceled, even when the jobs channel is never closed.
+func TestVerifierCancel(t *testing.T) {
+	t.Parallel()
+
+	rng := rand.New(rand.NewSource(1))
+	jobs := randJobs(t, rng, 3)
+	jobsChan := make(chan Job)
+	ctx, cancel := context.WithCancel(context.Background())
+	results := New(Config{}).Verify(ctx, jobsChan)
+	for _, job := range jobs {
+		jobsChan <- job
+	}
+	cancel()
+
+	done := make(chan struct{})
+	go func() {
+		for range results {
+		}
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		t.Fatal("results channel not closed after cancel")
+	}
+}